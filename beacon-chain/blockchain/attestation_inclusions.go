@@ -0,0 +1,70 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"go.opencensus.io/trace"
+)
+
+// attestationInclusions returns the inclusions of the attestations of a block for every attesting
+// validator. The committees of the attestations are computed from the post state of the block, which
+// covers the epochs of the attestations a valid block may include.
+func attestationInclusions(
+	ctx context.Context,
+	postState iface.ReadOnlyBeaconState,
+	blk *ethpb.BeaconBlock,
+	blockRoot [32]byte,
+) ([]*db.AttestationInclusion, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.attestationInclusions")
+	defer span.End()
+
+	var inclusions []*db.AttestationInclusion
+	for _, a := range blk.Body.Attestations {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		committee, err := helpers.BeaconCommitteeFromState(postState, a.Data.Slot, a.Data.CommitteeIndex)
+		if err != nil {
+			return nil, err
+		}
+		indices, err := attestationutil.AttestingIndices(a.AggregationBits, committee)
+		if err != nil {
+			return nil, err
+		}
+		for _, idx := range indices {
+			inclusions = append(inclusions, &db.AttestationInclusion{
+				ValidatorIndex:    types.ValidatorIndex(idx),
+				Epoch:             a.Data.Target.Epoch,
+				InclusionSlot:     blk.Slot,
+				InclusionDistance: blk.Slot - a.Data.Slot,
+				BlockRoot:         blockRoot,
+			})
+		}
+	}
+	return inclusions, nil
+}
+
+// indexAttestationInclusions saves the attestation inclusions of a block to the DB if the inclusion
+// index is enabled.
+func (s *Service) indexAttestationInclusions(
+	ctx context.Context,
+	postState iface.ReadOnlyBeaconState,
+	blk *ethpb.BeaconBlock,
+	blockRoot [32]byte,
+) error {
+	if !s.cfg.IndexAttInclusions {
+		return nil
+	}
+	inclusions, err := attestationInclusions(ctx, postState, blk, blockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not compute attestation inclusions")
+	}
+	return s.cfg.BeaconDB.SaveAttestationInclusions(ctx, inclusions)
+}
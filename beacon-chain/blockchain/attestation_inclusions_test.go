@@ -0,0 +1,60 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_IndexAttestationInclusions(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	st, _ := testutil.DeterministicGenesisState(t, 2048)
+	committee, err := helpers.BeaconCommitteeFromState(st, 1, 0)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	bits.SetBitAt(2, true)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 3
+	blk.Block.Body.Attestations = []*ethpb.Attestation{{
+		Data: &ethpb.AttestationData{
+			Slot:            1,
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			BeaconBlockRoot: make([]byte, 32),
+		},
+		AggregationBits: bits,
+		Signature:       make([]byte, 96),
+	}}
+	r := [32]byte{'a'}
+
+	service, err := NewService(ctx, &Config{BeaconDB: beaconDB})
+	require.NoError(t, err)
+	require.NoError(t, service.indexAttestationInclusions(ctx, st, blk.Block, r))
+	inclusions, err := beaconDB.AttestationInclusions(ctx, committee[0], 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(inclusions), "Expected no inclusions without the index enabled")
+
+	service.cfg.IndexAttInclusions = true
+	require.NoError(t, service.indexAttestationInclusions(ctx, st, blk.Block, r))
+	for _, idx := range []types.ValidatorIndex{committee[0], committee[2]} {
+		inclusions, err := beaconDB.AttestationInclusions(ctx, idx, 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(inclusions))
+		assert.Equal(t, types.Slot(3), inclusions[0].InclusionSlot)
+		assert.Equal(t, types.Slot(2), inclusions[0].InclusionDistance)
+		assert.Equal(t, r, inclusions[0].BlockRoot)
+	}
+	inclusions, err = beaconDB.AttestationInclusions(ctx, committee[1], 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(inclusions))
+}
@@ -0,0 +1,102 @@
+package blockchain
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// This defines the number of blocks whose signature sets are verified together by a worker of the
+// batch signature verifier. Smaller chunks start verifying earlier in the batch, while larger chunks
+// benefit more from batching the pairings.
+const sigVerifyChunkSize = 8
+
+// sigBatchVerifier verifies the signature sets of a batch of blocks on a pool of workers. The sets
+// are grouped in chunks of consecutive blocks, and each chunk is verified as soon as it is full,
+// so that signature verification runs while the state transition of the next blocks proceeds.
+type sigBatchVerifier struct {
+	chunkSize    int
+	sets         []*bls.SignatureSet
+	submitted    int
+	workers      chan struct{}
+	wg           sync.WaitGroup
+	lock         sync.Mutex
+	firstInvalid int
+	err          error
+}
+
+// newSigBatchVerifier creates a verifier for a batch of the given number of blocks, running as many
+// workers as there are usable CPUs.
+func newSigBatchVerifier(numBlocks int) *sigBatchVerifier {
+	return &sigBatchVerifier{
+		chunkSize:    sigVerifyChunkSize,
+		sets:         make([]*bls.SignatureSet, 0, numBlocks),
+		workers:      make(chan struct{}, runtime.GOMAXPROCS(0)),
+		firstInvalid: -1,
+	}
+}
+
+// add appends the signature set of the next block of the batch, and starts verifying the pending
+// sets once they fill a chunk.
+func (v *sigBatchVerifier) add(set *bls.SignatureSet) {
+	v.sets = append(v.sets, set)
+	if len(v.sets)-v.submitted >= v.chunkSize {
+		v.submit()
+	}
+}
+
+// wait verifies the remaining sets and waits for all the chunks to be verified. It returns the index
+// of the first block of the batch with an invalid signature, or -1 if all the signatures are valid.
+func (v *sigBatchVerifier) wait() (int, error) {
+	if len(v.sets) > v.submitted {
+		v.submit()
+	}
+	v.wg.Wait()
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.firstInvalid, v.err
+}
+
+// submit verifies the sets added since the last submission on a worker.
+func (v *sigBatchVerifier) submit() {
+	start, sets := v.submitted, v.sets[v.submitted:]
+	v.submitted = len(v.sets)
+	v.wg.Add(1)
+	v.workers <- struct{}{}
+	go func() {
+		defer func() {
+			<-v.workers
+			v.wg.Done()
+		}()
+		i, err := verifySignatureSets(sets)
+		v.lock.Lock()
+		defer v.lock.Unlock()
+		if err != nil {
+			if v.err == nil {
+				v.err = err
+			}
+			return
+		}
+		if i >= 0 && (v.firstInvalid < 0 || start+i < v.firstInvalid) {
+			v.firstInvalid = start + i
+		}
+	}()
+}
+
+// verifySignatureSets verifies the sets in a single batch, and bisects them to find the first invalid
+// set if the batch fails. It returns -1 if all the sets are valid.
+func verifySignatureSets(sets []*bls.SignatureSet) (int, error) {
+	set := bls.NewSet()
+	for _, s := range sets {
+		set.Join(s)
+	}
+	valid, err := set.Verify()
+	if err != nil {
+		return 0, err
+	}
+	if valid {
+		return -1, nil
+	}
+	return firstInvalidSignatureSet(sets)
+}
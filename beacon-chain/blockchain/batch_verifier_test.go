@@ -0,0 +1,70 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSigBatchVerifier(t *testing.T) {
+	sets := make([]*bls.SignatureSet, 20)
+	for i := range sets {
+		priv, err := bls.RandKey()
+		require.NoError(t, err)
+		msg := [32]byte{byte(i)}
+		sets[i] = &bls.SignatureSet{
+			Signatures: [][]byte{priv.Sign(msg[:]).Marshal()},
+			PublicKeys: []bls.PublicKey{priv.PublicKey()},
+			Messages:   [][32]byte{msg},
+		}
+	}
+	corrupt := func(set *bls.SignatureSet) *bls.SignatureSet {
+		return &bls.SignatureSet{
+			Signatures: set.Signatures,
+			PublicKeys: set.PublicKeys,
+			Messages:   [][32]byte{{'x'}},
+		}
+	}
+
+	v := newSigBatchVerifier(len(sets))
+	i, err := v.wait()
+	require.NoError(t, err)
+	assert.Equal(t, -1, i, "Empty batch should be valid")
+
+	v = newSigBatchVerifier(len(sets))
+	v.chunkSize = 3
+	for _, set := range sets {
+		v.add(set)
+	}
+	i, err = v.wait()
+	require.NoError(t, err)
+	assert.Equal(t, -1, i)
+
+	// The first invalid block is reported, whichever chunk finishes verifying first.
+	v = newSigBatchVerifier(len(sets))
+	v.chunkSize = 3
+	for j, set := range sets {
+		if j == 13 || j == 4 {
+			set = corrupt(set)
+		}
+		v.add(set)
+	}
+	i, err = v.wait()
+	require.NoError(t, err)
+	assert.Equal(t, 4, i)
+
+	// The sets left over after the last full chunk are verified as well.
+	v = newSigBatchVerifier(len(sets))
+	v.chunkSize = 3
+	for j, set := range sets {
+		if j == 19 {
+			set = corrupt(set)
+		}
+		v.add(set)
+	}
+	i, err = v.wait()
+	require.NoError(t, err)
+	assert.Equal(t, 19, i)
+}
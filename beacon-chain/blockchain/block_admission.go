@@ -0,0 +1,110 @@
+package blockchain
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// queuedBlock is a block waiting to be admitted for processing.
+type queuedBlock struct {
+	slot      types.Slot
+	exclusive bool
+	admitted  chan struct{}
+}
+
+// admitBlock admits a block for processing and returns the function to call once the block is
+// processed. Blocks crossing an epoch boundary run the expensive epoch transition, during which
+// incoming blocks are queued rather than contending with it for the state. Once the transition
+// is over, the block of the current slot is processed ahead of older blocks, such as blocks of
+// forks, so that the head is updated in time.
+func (s *Service) admitBlock(ctx context.Context, b *ethpb.BeaconBlock) (func(), error) {
+	exclusive := s.isEpochTransitionBlock(b)
+
+	s.blockAdmissionLock.Lock()
+	if !s.blockAdmissionBusy {
+		s.blockAdmissionBusy = exclusive
+		s.blockAdmissionLock.Unlock()
+		return s.blockAdmissionRelease(exclusive), nil
+	}
+	q := &queuedBlock{
+		slot:      b.Slot,
+		exclusive: exclusive,
+		admitted:  make(chan struct{}),
+	}
+	s.queuedBlocks = append(s.queuedBlocks, q)
+	s.blockAdmissionLock.Unlock()
+	queuedBlocksCount.Inc()
+
+	select {
+	case <-q.admitted:
+		return s.blockAdmissionRelease(q.exclusive), nil
+	case <-ctx.Done():
+		s.blockAdmissionLock.Lock()
+		defer s.blockAdmissionLock.Unlock()
+		for i, queued := range s.queuedBlocks {
+			if queued == q {
+				s.queuedBlocks = append(s.queuedBlocks[:i], s.queuedBlocks[i+1:]...)
+				return nil, ctx.Err()
+			}
+		}
+		// The block was admitted as the context expired, so it gives up its turn to the next blocks.
+		if q.exclusive {
+			s.blockAdmissionBusy = false
+			s.admitQueuedBlocks()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// This returns the function releasing the admission of a block once it is processed.
+func (s *Service) blockAdmissionRelease(exclusive bool) func() {
+	return func() {
+		if !exclusive {
+			return
+		}
+		s.blockAdmissionLock.Lock()
+		defer s.blockAdmissionLock.Unlock()
+		s.blockAdmissionBusy = false
+		s.admitQueuedBlocks()
+	}
+}
+
+// This admits the queued blocks after an exclusive block is processed. A block of the current
+// slot is admitted first and alone. Otherwise, the blocks are admitted in the order they were
+// received, up to the next block running an epoch transition. The caller must hold the block
+// admission lock.
+func (s *Service) admitQueuedBlocks() {
+	currentSlot := s.CurrentSlot()
+	for i, q := range s.queuedBlocks {
+		if q.slot == currentSlot {
+			q.exclusive = true
+			s.blockAdmissionBusy = true
+			s.queuedBlocks = append(s.queuedBlocks[:i], s.queuedBlocks[i+1:]...)
+			close(q.admitted)
+			return
+		}
+	}
+	for len(s.queuedBlocks) > 0 {
+		q := s.queuedBlocks[0]
+		s.queuedBlocks = s.queuedBlocks[1:]
+		close(q.admitted)
+		if q.exclusive {
+			s.blockAdmissionBusy = true
+			return
+		}
+	}
+}
+
+// This returns whether processing the block runs an epoch transition, which is the case when
+// the block and its parent are in different epochs.
+func (s *Service) isEpochTransitionBlock(b *ethpb.BeaconBlock) bool {
+	parent := s.cfg.ForkChoiceStore.Node(bytesutil.ToBytes32(b.ParentRoot))
+	if parent == nil {
+		return false
+	}
+	return helpers.SlotToEpoch(b.Slot) > helpers.SlotToEpoch(parent.Slot())
+}
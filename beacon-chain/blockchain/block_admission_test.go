@@ -0,0 +1,90 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func queuedBlocksLen(s *Service) int {
+	s.blockAdmissionLock.Lock()
+	defer s.blockAdmissionLock.Unlock()
+	return len(s.queuedBlocks)
+}
+
+func waitForQueuedBlocks(t *testing.T, s *Service, n int) {
+	for i := 0; queuedBlocksLen(s) != n; i++ {
+		require.Equal(t, true, i < 100, "Blocks were not queued")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestService_admitBlock(t *testing.T) {
+	ctx := context.Background()
+	service := setupBeaconChain(t, testDB.SetupDB(t))
+	epochStart := params.BeaconConfig().SlotsPerEpoch
+	genesisRoot := [32]byte{'G'}
+	epochRoot := [32]byte{'E'}
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, genesisRoot, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, epochStart, epochRoot, genesisRoot, [32]byte{}, 0, 0))
+	currentSlot := epochStart + 1
+	service.genesisTime = time.Now().Add(-time.Duration(uint64(currentSlot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+
+	// The first block of the epoch runs the epoch transition.
+	releaseTransition, err := service.admitBlock(ctx, &ethpb.BeaconBlock{Slot: epochStart, ParentRoot: genesisRoot[:]})
+	require.NoError(t, err)
+
+	admitted := make(chan types.Slot, 2)
+	releases := make(chan func(), 2)
+	for i, b := range []*ethpb.BeaconBlock{
+		{Slot: 1, ParentRoot: genesisRoot[:]},
+		{Slot: currentSlot, ParentRoot: epochRoot[:]},
+	} {
+		go func(b *ethpb.BeaconBlock) {
+			release, err := service.admitBlock(ctx, b)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			admitted <- b.Slot
+			releases <- release
+		}(b)
+		waitForQueuedBlocks(t, service, i+1)
+	}
+
+	// The block of the current slot is processed first, ahead of the older block.
+	releaseTransition()
+	assert.Equal(t, currentSlot, <-admitted)
+	assert.Equal(t, 1, queuedBlocksLen(service))
+	(<-releases)()
+	assert.Equal(t, types.Slot(1), <-admitted)
+	assert.Equal(t, 0, queuedBlocksLen(service))
+	(<-releases)()
+
+	// Queued blocks give up when their context expires.
+	releaseTransition, err = service.admitBlock(ctx, &ethpb.BeaconBlock{Slot: epochStart, ParentRoot: genesisRoot[:]})
+	require.NoError(t, err)
+	cancelCtx, cancel := context.WithCancel(ctx)
+	errs := make(chan error, 1)
+	go func() {
+		_, err := service.admitBlock(cancelCtx, &ethpb.BeaconBlock{Slot: 1, ParentRoot: genesisRoot[:]})
+		errs <- err
+	}()
+	waitForQueuedBlocks(t, service, 1)
+	cancel()
+	assert.ErrorContains(t, context.Canceled.Error(), <-errs)
+	assert.Equal(t, 0, queuedBlocksLen(service))
+	releaseTransition()
+
+	// Without an epoch transition in progress, blocks are admitted right away.
+	release, err := service.admitBlock(ctx, &ethpb.BeaconBlock{Slot: 1, ParentRoot: genesisRoot[:]})
+	require.NoError(t, err)
+	release()
+}
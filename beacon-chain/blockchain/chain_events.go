@@ -0,0 +1,88 @@
+package blockchain
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+// ChainEventSubscriber defines the typed subscriptions to events of the blockchain service.
+// Unlike the state feed, every subscription only delivers a single kind of event. Events are
+// shared across subscribers and must not be modified.
+type ChainEventSubscriber interface {
+	SubscribeHeadChanged(ch chan<- *statefeed.HeadChangedData) event.Subscription
+	SubscribeFinalized(ch chan<- *statefeed.CheckpointData) event.Subscription
+	SubscribeReorg(ch chan<- *statefeed.ReorgData) event.Subscription
+	SubscribeBlockImported(ch chan<- *statefeed.BlockProcessedData) event.Subscription
+}
+
+// chainEventFeeds holds a feed for each kind of typed chain event.
+type chainEventFeeds struct {
+	headChanged event.Feed
+	finalized   event.Feed
+	// finalizedSubscribers counts the subscriptions to the finalized feed, as the finalized
+	// state is only loaded when there is a subscriber to send it to.
+	finalizedSubscribers int32
+	reorg                event.Feed
+	blockImported        event.Feed
+}
+
+// SubscribeHeadChanged subscribes to changes of the head block of the chain.
+func (s *Service) SubscribeHeadChanged(ch chan<- *statefeed.HeadChangedData) event.Subscription {
+	return s.chainEvents.headChanged.Subscribe(ch)
+}
+
+// SubscribeFinalized subscribes to advances of the finalized checkpoint.
+func (s *Service) SubscribeFinalized(ch chan<- *statefeed.CheckpointData) event.Subscription {
+	atomic.AddInt32(&s.chainEvents.finalizedSubscribers, 1)
+	return &countedSubscription{
+		Subscription: s.chainEvents.finalized.Subscribe(ch),
+		count:        &s.chainEvents.finalizedSubscribers,
+	}
+}
+
+// countedSubscription decrements a count of subscriptions once it is unsubscribed.
+type countedSubscription struct {
+	event.Subscription
+	count *int32
+	once  sync.Once
+}
+
+// Unsubscribe cancels the subscription and decrements the count of subscriptions.
+func (c *countedSubscription) Unsubscribe() {
+	c.once.Do(func() {
+		atomic.AddInt32(c.count, -1)
+	})
+	c.Subscription.Unsubscribe()
+}
+
+// SubscribeReorg subscribes to reorgs of the chain.
+func (s *Service) SubscribeReorg(ch chan<- *statefeed.ReorgData) event.Subscription {
+	return s.chainEvents.reorg.Subscribe(ch)
+}
+
+// SubscribeBlockImported subscribes to blocks imported into the chain.
+func (s *Service) SubscribeBlockImported(ch chan<- *statefeed.BlockProcessedData) event.Subscription {
+	return s.chainEvents.blockImported.Subscribe(ch)
+}
+
+// This sends a processed block to the state feed and to the subscribers of imported blocks.
+func (s *Service) notifyBlockProcessed(data *statefeed.BlockProcessedData) {
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: data,
+	})
+	s.chainEvents.blockImported.Send(data)
+}
+
+// This sends a reorg to the state feed and to the subscribers of reorgs.
+func (s *Service) notifyReorg(data *statefeed.ReorgData) {
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: data,
+	})
+	s.chainEvents.reorg.Send(data)
+}
@@ -0,0 +1,101 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestService_SubscribeReorg(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+
+	// The old head B descends from A, while the new head C forks off the genesis block G.
+	genesisRoot, rootA, rootB := [32]byte{'G'}, [32]byte{'A'}, [32]byte{'B'}
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, genesisRoot, params.BeaconConfig().ZeroHash, [32]byte{}, 0, 0))
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 1, rootA, genesisRoot, [32]byte{}, 0, 0))
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 2, rootB, rootA, [32]byte{}, 0, 0))
+	oldHeadState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	service.head = &head{slot: 2, root: rootB, state: oldHeadState}
+
+	newHeadBlock := testutil.NewBeaconBlock()
+	newHeadBlock.Block.Slot = 3
+	newHeadBlock.Block.ParentRoot = genesisRoot[:]
+	require.NoError(t, service.cfg.BeaconDB.SaveBlock(ctx, newHeadBlock))
+	newRoot, err := newHeadBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 3, newRoot, genesisRoot, [32]byte{}, 0, 0))
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(3))
+	require.NoError(t, service.cfg.BeaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 3, Root: newRoot[:]}))
+	require.NoError(t, service.cfg.BeaconDB.SaveState(ctx, headState, newRoot))
+
+	reorgs := make(chan *statefeed.ReorgData, 1)
+	reorgSub := service.SubscribeReorg(reorgs)
+	defer reorgSub.Unsubscribe()
+	heads := make(chan *statefeed.HeadChangedData, 1)
+	headSub := service.SubscribeHeadChanged(heads)
+	defer headSub.Unsubscribe()
+
+	require.NoError(t, service.saveHead(ctx, newRoot))
+
+	reorg := <-reorgs
+	assert.Equal(t, types.Slot(2), reorg.OldSlot)
+	assert.Equal(t, types.Slot(3), reorg.NewSlot)
+	assert.Equal(t, rootB, reorg.OldRoot)
+	assert.Equal(t, newRoot, reorg.NewRoot)
+	assert.Equal(t, uint64(2), reorg.Depth)
+	headChanged := <-heads
+	assert.Equal(t, types.Slot(3), headChanged.Slot)
+	assert.Equal(t, rootB, headChanged.OldRoot)
+	assert.Equal(t, newRoot, headChanged.NewRoot)
+}
+
+func TestService_SubscribeBlockImported(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+
+	imported := make(chan *statefeed.BlockProcessedData, 1)
+	sub := service.SubscribeBlockImported(imported)
+	defer sub.Unsubscribe()
+
+	data := &statefeed.BlockProcessedData{Slot: 5, BlockRoot: [32]byte{'a'}}
+	service.notifyBlockProcessed(data)
+	assert.Equal(t, data, <-imported)
+}
+
+func TestService_SubscribeFinalized(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+	cp := &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'a'}, 32)}
+
+	// Without subscribers, the unknown checkpoint state is never loaded.
+	service.onFinalizedCheckpoint(ctx, cp)
+	require.LogsDoNotContain(t, hook, "Could not load finalized checkpoint state")
+
+	finalized := make(chan *statefeed.CheckpointData, 1)
+	sub := service.SubscribeFinalized(finalized)
+	service.onFinalizedCheckpoint(ctx, cp)
+	require.LogsContain(t, hook, "Could not load finalized checkpoint state")
+	assert.Equal(t, 0, len(finalized))
+
+	sub.Unsubscribe()
+	sub.Unsubscribe()
+	assert.Equal(t, int32(0), service.chainEvents.finalizedSubscribers)
+}
@@ -23,6 +23,7 @@ type ChainInfoFetcher interface {
 	FinalizationFetcher
 	GenesisFetcher
 	CanonicalFetcher
+	ChainEventSubscriber
 }
 
 // TimeFetcher retrieves the Eth2 data that's related to time.
@@ -164,7 +165,7 @@ func (s *Service) HeadState(ctx context.Context) (iface.BeaconState, error) {
 		return s.headState(ctx), nil
 	}
 
-	return s.cfg.StateGen.StateByRoot(ctx, s.headRoot())
+	return s.recentStateByRoot(ctx, s.headRoot())
 }
 
 // HeadValidatorsIndices returns a list of active validator indices from the head view of a given epoch.
@@ -0,0 +1,112 @@
+package blockchain
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// This is called when the justified checkpoint of the node advances. The state of the new
+// justified checkpoint is loaded once, used to update the caches of the service which depend
+// on it, then sent to the state feed for the caches outside of the service.
+func (s *Service) onJustifiedCheckpoint(ctx context.Context, cp *ethpb.Checkpoint) error {
+	justifiedState, err := s.checkpointBlockState(ctx, s.ensureRootNotZeros(bytesutil.ToBytes32(cp.Root)))
+	if err != nil {
+		return err
+	}
+	if err := s.setJustifiedStateBalances(justifiedState); err != nil {
+		return err
+	}
+	if err := s.cacheCheckpointState(cp, justifiedState); err != nil {
+		return err
+	}
+
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.JustifiedCheckpoint,
+		Data: &statefeed.CheckpointData{
+			Checkpoint: cp,
+			State:      justifiedState,
+		},
+	})
+	return nil
+}
+
+// This is called when the finalized checkpoint of the node advances, after the finalized
+// state was migrated to the cold section of the DB. It sends the checkpoint to the state feed,
+// and the checkpoint with a copy of its state to the subscribers of finalization. The state
+// is only loaded when there are such subscribers, and failing to load it does not fail the
+// update of the finalized checkpoint.
+func (s *Service) onFinalizedCheckpoint(ctx context.Context, cp *ethpb.Checkpoint) {
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &statefeed.CheckpointData{
+			Checkpoint: cp,
+		},
+	})
+	if atomic.LoadInt32(&s.chainEvents.finalizedSubscribers) == 0 {
+		return
+	}
+	finalizedState, err := s.checkpointBlockState(ctx, s.ensureRootNotZeros(bytesutil.ToBytes32(cp.Root)))
+	if err != nil {
+		log.WithError(err).Error("Could not load finalized checkpoint state for subscribers")
+		return
+	}
+	s.chainEvents.finalized.Send(&statefeed.CheckpointData{
+		Checkpoint: cp,
+		State:      finalizedState.Copy(),
+	})
+}
+
+// This retrieves the post state of a checkpoint block, which is the genesis state for the
+// genesis block.
+func (s *Service) checkpointBlockState(ctx context.Context, root [32]byte) (iface.BeaconState, error) {
+	// Blocks need to be saved so that the state can be regenerated from the DB.
+	if err := s.cfg.BeaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
+		return nil, err
+	}
+	s.clearInitSyncBlocks()
+
+	var st iface.BeaconState
+	var err error
+	if root == s.genesisRoot {
+		st, err = s.cfg.BeaconDB.GenesisState(ctx)
+	} else {
+		st, err = s.cfg.StateGen.StateByRoot(ctx, root)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return nil, errors.New("checkpoint state can't be nil")
+	}
+	return st, nil
+}
+
+// This adds the state of a checkpoint block to the checkpoint state cache when the block is at
+// the start slot of the checkpoint epoch, in which case it is the state attestations targeting
+// the checkpoint are processed against. The state is copied to avoid sharing it across the
+// checkpoint state cache and the hot state cache.
+func (s *Service) cacheCheckpointState(cp *ethpb.Checkpoint, st iface.BeaconState) error {
+	epochStartSlot, err := helpers.StartSlot(cp.Epoch)
+	if err != nil {
+		return err
+	}
+	if st.Slot() != epochStartSlot {
+		return nil
+	}
+	cached, err := s.checkpointStateCache.StateByCheckpoint(cp)
+	if err != nil {
+		return err
+	}
+	if cached != nil {
+		return nil
+	}
+	return s.checkpointStateCache.AddCheckpointState(cp, st.Copy())
+}
@@ -0,0 +1,78 @@
+package blockchain
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbdb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// saveCommitteeCache persists the shuffled committees of the head state's current and next epoch,
+// so that a restarted node does not have to recompute them before validating attestations.
+func (s *Service) saveCommitteeCache(ctx context.Context) error {
+	s.headLock.RLock()
+	if !s.hasHeadState() {
+		s.headLock.RUnlock()
+		return nil
+	}
+	headState := s.headState(ctx)
+	s.headLock.RUnlock()
+
+	committees, err := helpers.CommitteeCacheEntries(headState, helpers.CurrentEpoch(headState))
+	if err != nil {
+		return err
+	}
+	entries := &pbdb.CommitteeCacheEntries{
+		Entries: make([]*pbdb.CommitteeCacheEntry, len(committees)),
+	}
+	for i, c := range committees {
+		seed := c.Seed
+		entries.Entries[i] = &pbdb.CommitteeCacheEntry{
+			CommitteeCount:  c.CommitteeCount,
+			Seed:            seed[:],
+			ShuffledIndices: validatorIndicesToUint64(c.ShuffledIndices),
+			SortedIndices:   validatorIndicesToUint64(c.SortedIndices),
+		}
+	}
+	return s.cfg.BeaconDB.SaveCommitteeCacheEntries(ctx, entries)
+}
+
+// loadCommitteeCache restores the shuffled committees persisted by saveCommitteeCache.
+func (s *Service) loadCommitteeCache(ctx context.Context) error {
+	entries, err := s.cfg.BeaconDB.CommitteeCacheEntries(ctx)
+	if err != nil {
+		return err
+	}
+	if entries == nil {
+		return nil
+	}
+	committees := make([]*cache.Committees, len(entries.Entries))
+	for i, e := range entries.Entries {
+		committees[i] = &cache.Committees{
+			CommitteeCount:  e.CommitteeCount,
+			Seed:            bytesutil.ToBytes32(e.Seed),
+			ShuffledIndices: uint64ToValidatorIndices(e.ShuffledIndices),
+			SortedIndices:   uint64ToValidatorIndices(e.SortedIndices),
+		}
+	}
+	return helpers.LoadCommitteeCacheEntries(committees)
+}
+
+func validatorIndicesToUint64(indices []types.ValidatorIndex) []uint64 {
+	res := make([]uint64, len(indices))
+	for i, idx := range indices {
+		res[i] = uint64(idx)
+	}
+	return res
+}
+
+func uint64ToValidatorIndices(indices []uint64) []types.ValidatorIndex {
+	res := make([]types.ValidatorIndex, len(indices))
+	for i, idx := range indices {
+		res[i] = types.ValidatorIndex(idx)
+	}
+	return res
+}
@@ -0,0 +1,67 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+)
+
+// EpochBoundaryStateFetcher defines a common interface for methods in blockchain service which
+// return the states of blocks at the start of later epochs.
+type EpochBoundaryStateFetcher interface {
+	EpochBoundaryState(ctx context.Context, root [32]byte, epoch types.Epoch) (iface.BeaconState, error)
+}
+
+// EpochBoundaryState returns the state of the given block root advanced through empty slots to the
+// start slot of the epoch, which is the state the committees and the shuffling of the epoch are
+// computed from. The post state of the block is returned as is if the block is not before the
+// start slot of the epoch.
+func (s *Service) EpochBoundaryState(ctx context.Context, root [32]byte, epoch types.Epoch) (iface.BeaconState, error) {
+	st, _, err := s.epochBoundaryState(ctx, root, epoch)
+	return st, err
+}
+
+// epochBoundaryState advances the state of the block root to the start slot of the epoch. It starts
+// from the latest boundary state of the block cached up to the epoch, so that targets referencing
+// an old block only process the slots since the last boundary they were advanced to, and falls back
+// to the post state of the block otherwise. The returned boolean is false when the block is not
+// before the start slot of the epoch, in which case its post state is returned without being cached.
+func (s *Service) epochBoundaryState(ctx context.Context, root [32]byte, epoch types.Epoch) (iface.BeaconState, bool, error) {
+	epochStartSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, false, err
+	}
+	baseState, cachedEpoch := s.epochBoundaryStateCache.NearestState(root, epoch)
+	if baseState != nil && cachedEpoch == epoch {
+		return baseState, true, nil
+	}
+
+	if baseState == nil {
+		baseState, err = s.recentStateByRoot(ctx, root)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "could not get pre state for epoch %d", epoch)
+		}
+		if epochStartSlot <= baseState.Slot() {
+			return baseState, false, nil
+		}
+		// The next slot cache only holds the state of the block advanced by a single slot, so it
+		// can only help when starting from the post state of the block.
+		if featureconfig.Get().EnableNextSlotStateCache {
+			baseState, err = state.ProcessSlotsUsingNextSlotCache(ctx, baseState, root[:], epochStartSlot)
+		} else {
+			baseState, err = state.ProcessSlots(ctx, baseState, epochStartSlot)
+		}
+	} else {
+		baseState, err = state.ProcessSlots(ctx, baseState, epochStartSlot)
+	}
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "could not process slots up to epoch %d", epoch)
+	}
+	s.epochBoundaryStateCache.Put(root, epoch, baseState)
+	return baseState, true, nil
+}
@@ -0,0 +1,54 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_EpochBoundaryState(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, &Config{BeaconDB: beaconDB, StateGen: stategen.New(beaconDB)})
+	require.NoError(t, err)
+
+	genesis, _ := testutil.DeterministicGenesisState(t, 1)
+	root := [32]byte{'a'}
+	require.NoError(t, service.cfg.BeaconDB.SaveState(ctx, genesis, root))
+
+	st, err := service.EpochBoundaryState(ctx, root, 1)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, st.Slot())
+	assert.Equal(t, uint64(0), service.epochBoundaryStateCache.Stats().Hits)
+
+	// The later boundary is reached from the cached boundary of epoch 1.
+	st, err = service.EpochBoundaryState(ctx, root, 3)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch.Mul(3), st.Slot())
+	stats := service.epochBoundaryStateCache.Stats()
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, 2, stats.Entries)
+
+	st, err = service.EpochBoundaryState(ctx, root, 3)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch.Mul(3), st.Slot())
+	assert.Equal(t, uint64(2), service.epochBoundaryStateCache.Stats().Hits)
+
+	// The post state of a block at the start of the epoch is returned as is, without being cached.
+	later, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, later.SetSlot(params.BeaconConfig().SlotsPerEpoch.Mul(2)))
+	laterRoot := [32]byte{'b'}
+	require.NoError(t, service.cfg.BeaconDB.SaveState(ctx, later, laterRoot))
+	st, advanced, err := service.epochBoundaryState(ctx, laterRoot, 2)
+	require.NoError(t, err)
+	assert.Equal(t, false, advanced)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch.Mul(2), st.Slot())
+	assert.Equal(t, 2, service.epochBoundaryStateCache.Stats().Entries)
+}
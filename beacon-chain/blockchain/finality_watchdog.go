@@ -0,0 +1,136 @@
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// ChainHealthFetcher defines a common interface for methods in blockchain service which
+// report diagnostics about the finality of the chain.
+type ChainHealthFetcher interface {
+	ChainHealth(ctx context.Context) (*ChainHealth, error)
+}
+
+// ChainHealth describes the finality of the chain as seen by the node.
+type ChainHealth struct {
+	CurrentSlot                types.Slot
+	HeadSlot                   types.Slot
+	JustifiedEpoch             types.Epoch
+	FinalizedEpoch             types.Epoch
+	EpochsSinceFinality        types.Epoch
+	JustifiedHeadDistance      types.Epoch
+	PreviousEpochParticipation float64
+	PeerCount                  int
+	Stalled                    bool
+}
+
+// ChainHealth collects the finality diagnostics of the chain. Finality is considered stalled once
+// more than the configured number of epochs have passed since the finalized epoch.
+func (s *Service) ChainHealth(ctx context.Context) (*ChainHealth, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.ChainHealth")
+	defer span.End()
+
+	s.headLock.RLock()
+	if !s.hasHeadState() {
+		s.headLock.RUnlock()
+		return nil, errors.New("head state is not available")
+	}
+	headState := s.headState(ctx)
+	s.headLock.RUnlock()
+
+	currentEpoch := helpers.SlotToEpoch(s.CurrentSlot())
+	headEpoch := helpers.SlotToEpoch(headState.Slot())
+	health := &ChainHealth{
+		CurrentSlot:    s.CurrentSlot(),
+		HeadSlot:       headState.Slot(),
+		JustifiedEpoch: s.CurrentJustifiedCheckpt().Epoch,
+		FinalizedEpoch: s.FinalizedCheckpt().Epoch,
+	}
+	if currentEpoch > health.FinalizedEpoch {
+		health.EpochsSinceFinality = currentEpoch - health.FinalizedEpoch
+	}
+	if headEpoch > health.JustifiedEpoch {
+		health.JustifiedHeadDistance = headEpoch - health.JustifiedEpoch
+	}
+	health.Stalled = s.cfg.FinalityStallEpochs > 0 && health.EpochsSinceFinality > s.cfg.FinalityStallEpochs
+
+	v, b, err := precompute.New(ctx, headState)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not set up pre compute instance")
+	}
+	_, b, err = precompute.ProcessAttestations(ctx, headState, v, b)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process attestations")
+	}
+	if b.ActivePrevEpoch > 0 {
+		health.PreviousEpochParticipation = float64(b.PrevEpochTargetAttested) / float64(b.ActivePrevEpoch)
+	}
+
+	if s.cfg.PeersFetcher != nil {
+		health.PeerCount = len(s.cfg.PeersFetcher.Peers().Connected())
+	}
+	return health, nil
+}
+
+// finalityWatchdogRoutine checks the finality of the chain at the start of every epoch and logs
+// diagnostics while finality is stalled.
+func (s *Service) finalityWatchdogRoutine() {
+	for s.genesisTime.IsZero() {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+
+	ticker := slotutil.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case slot := <-ticker.C():
+			if !helpers.IsEpochStart(slot) {
+				continue
+			}
+			if _, err := s.checkFinality(s.ctx); err != nil {
+				log.WithError(err).Debug("Could not check finality")
+			}
+		}
+	}
+}
+
+// checkFinality logs the chain health diagnostics when finality is stalled. A head more than an
+// epoch behind the clock means the node is still syncing, in which case nothing is reported.
+// It returns whether a stall was reported.
+func (s *Service) checkFinality(ctx context.Context) (bool, error) {
+	health, err := s.ChainHealth(ctx)
+	if err != nil {
+		return false, err
+	}
+	finalityStalled.Set(0)
+	if !health.Stalled || helpers.SlotToEpoch(health.HeadSlot)+1 < helpers.SlotToEpoch(health.CurrentSlot) {
+		return false, nil
+	}
+	finalityStalled.Set(1)
+	log.WithFields(logrus.Fields{
+		"currentSlot":                health.CurrentSlot,
+		"headSlot":                   health.HeadSlot,
+		"justifiedEpoch":             health.JustifiedEpoch,
+		"finalizedEpoch":             health.FinalizedEpoch,
+		"epochsSinceFinality":        health.EpochsSinceFinality,
+		"justifiedHeadDistance":      health.JustifiedHeadDistance,
+		"previousEpochParticipation": health.PreviousEpochParticipation,
+		"peerCount":                  health.PeerCount,
+	}).Warn("Finality has stalled")
+	return true, nil
+}
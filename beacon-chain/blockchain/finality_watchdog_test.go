@@ -0,0 +1,90 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func setupFinalityWatchdog(t *testing.T, stallEpochs, currentEpoch, headEpoch types.Epoch) *Service {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	headSlot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(headEpoch))
+	require.NoError(t, st.SetSlot(headSlot))
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(currentEpoch))
+	genesis := time.Now().Add(-time.Duration(uint64(currentSlot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	return &Service{
+		cfg: &Config{
+			FinalityStallEpochs: stallEpochs,
+			PeersFetcher:        &p2ptest.MockPeersProvider{},
+		},
+		genesisTime:      genesis,
+		head:             &head{slot: headSlot, state: st},
+		justifiedCheckpt: &ethpb.Checkpoint{Epoch: 5},
+		finalizedCheckpt: &ethpb.Checkpoint{Epoch: 3},
+	}
+}
+
+func TestService_ChainHealth(t *testing.T) {
+	service := setupFinalityWatchdog(t, 4, 10, 9)
+	health, err := service.ChainHealth(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, types.Epoch(5), health.JustifiedEpoch)
+	assert.Equal(t, types.Epoch(3), health.FinalizedEpoch)
+	assert.Equal(t, types.Epoch(7), health.EpochsSinceFinality)
+	assert.Equal(t, types.Epoch(4), health.JustifiedHeadDistance)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch.Mul(9), health.HeadSlot)
+	// No attestations were included, precompute floors the attested balance at one increment.
+	wantedParticipation := float64(params.BeaconConfig().EffectiveBalanceIncrement) / float64(64*params.BeaconConfig().MaxEffectiveBalance)
+	assert.Equal(t, wantedParticipation, health.PreviousEpochParticipation)
+	assert.Equal(t, 2, health.PeerCount)
+	assert.Equal(t, true, health.Stalled)
+}
+
+func TestService_ChainHealth_NotStalled(t *testing.T) {
+	service := setupFinalityWatchdog(t, 8, 10, 10)
+	health, err := service.ChainHealth(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, false, health.Stalled)
+
+	// A zero threshold disables the stall detection.
+	service = setupFinalityWatchdog(t, 0, 10, 10)
+	health, err = service.ChainHealth(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, false, health.Stalled)
+}
+
+func TestService_ChainHealth_NoHeadState(t *testing.T) {
+	service := &Service{cfg: &Config{}}
+	_, err := service.ChainHealth(context.Background())
+	assert.ErrorContains(t, "head state is not available", err)
+}
+
+func TestService_CheckFinality(t *testing.T) {
+	hook := logTest.NewGlobal()
+	service := setupFinalityWatchdog(t, 4, 10, 10)
+	stalled, err := service.checkFinality(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, true, stalled)
+	assert.LogsContain(t, hook, "Finality has stalled")
+	assert.LogsContain(t, hook, "epochsSinceFinality=7")
+}
+
+func TestService_CheckFinality_Syncing(t *testing.T) {
+	hook := logTest.NewGlobal()
+	service := setupFinalityWatchdog(t, 4, 10, 8)
+	stalled, err := service.checkFinality(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, false, stalled)
+	assert.LogsDoNotContain(t, hook, "Finality has stalled")
+}
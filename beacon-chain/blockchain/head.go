@@ -3,20 +3,16 @@ package blockchain
 import (
 	"bytes"
 	"context"
-	"fmt"
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -39,7 +35,7 @@ func (s *Service) updateHead(ctx context.Context, balances []uint64) error {
 	// ensure head gets its best justified info.
 	if s.bestJustifiedCheckpt.Epoch > s.justifiedCheckpt.Epoch {
 		s.justifiedCheckpt = s.bestJustifiedCheckpt
-		if err := s.cacheJustifiedStateBalances(ctx, bytesutil.ToBytes32(s.justifiedCheckpt.Root)); err != nil {
+		if err := s.onJustifiedCheckpoint(ctx, s.justifiedCheckpt); err != nil {
 			return err
 		}
 	}
@@ -63,7 +59,7 @@ func (s *Service) updateHead(ctx context.Context, balances []uint64) error {
 		if err != nil {
 			return err
 		}
-		s.cfg.ForkChoiceStore = protoarray.New(j.Epoch, f.Epoch, bytesutil.ToBytes32(f.Root))
+		s.cfg.ForkChoiceStore = s.newForkChoiceStore(j, f)
 		if err := s.insertBlockToForkChoiceStore(ctx, jb.Block, headStartRoot, f, j); err != nil {
 			return err
 		}
@@ -109,7 +105,7 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	}
 
 	// Get the new head state from cached state or DB.
-	newHeadState, err := s.cfg.StateGen.StateByRoot(ctx, headRoot)
+	newHeadState, err := s.recentStateByRoot(ctx, headRoot)
 	if err != nil {
 		return errors.Wrap(err, "could not retrieve head state in DB")
 	}
@@ -117,26 +113,20 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 		return errors.New("cannot save nil head state")
 	}
 
-	// A chain re-org occurred, so we fire an event notifying the rest of the services.
-	headSlot := s.HeadSlot()
-	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != bytesutil.ToBytes32(r) {
-		log.WithFields(logrus.Fields{
-			"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
-			"oldSlot": fmt.Sprintf("%d", headSlot),
-		}).Debug("Chain reorg occurred")
-		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
-			Type: statefeed.Reorg,
-			Data: &statefeed.ReorgData{
-				NewSlot: newHeadBlock.Block.Slot,
-				OldSlot: headSlot,
-			},
-		})
-
-		reorgCount.Inc()
+	// The chain may have reorged unless the new head is a child of the old head, in which case
+	// we fire an event notifying the rest of the services.
+	oldHeadRoot := bytesutil.ToBytes32(r)
+	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != oldHeadRoot {
+		s.checkReorg(ctx, oldHeadRoot, s.HeadSlot(), headRoot, newHeadBlock.Block.Slot)
 	}
 
 	// Cache the new head info.
 	s.setHead(headRoot, newHeadBlock, newHeadState)
+	s.chainEvents.headChanged.Send(&statefeed.HeadChangedData{
+		Slot:    newHeadBlock.Block.Slot,
+		NewRoot: headRoot,
+		OldRoot: oldHeadRoot,
+	})
 
 	// Save the new head root to DB.
 	if err := s.cfg.BeaconDB.SaveHeadBlockRoot(ctx, headRoot); err != nil {
@@ -162,6 +152,11 @@ func (s *Service) saveHeadNoDB(ctx context.Context, b *ethpb.SignedBeaconBlock,
 	}
 
 	s.setHeadInitialSync(r, stateV0.CopySignedBeaconBlock(b), hs)
+	s.chainEvents.headChanged.Send(&statefeed.HeadChangedData{
+		Slot:    b.Block.Slot,
+		NewRoot: r,
+		OldRoot: bytesutil.ToBytes32(cachedHeadRoot),
+	})
 	return nil
 }
 
@@ -177,6 +172,7 @@ func (s *Service) setHead(root [32]byte, block *ethpb.SignedBeaconBlock, state i
 		block: stateV0.CopySignedBeaconBlock(block),
 		state: state.Copy(),
 	}
+	stateV0.MarkShared(s.head.state, "head")
 }
 
 // This sets head view object which is used to track the head slot, root, block and state. The method
@@ -193,6 +189,7 @@ func (s *Service) setHeadInitialSync(root [32]byte, block *ethpb.SignedBeaconBlo
 		block: stateV0.CopySignedBeaconBlock(block),
 		state: state,
 	}
+	stateV0.MarkShared(state, "head")
 }
 
 // This returns the head slot.
@@ -243,29 +240,15 @@ func (s *Service) hasHeadState() bool {
 
 // This caches justified state balances to be used for fork choice.
 func (s *Service) cacheJustifiedStateBalances(ctx context.Context, justifiedRoot [32]byte) error {
-	if err := s.cfg.BeaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
+	justifiedState, err := s.checkpointBlockState(ctx, justifiedRoot)
+	if err != nil {
 		return err
 	}
+	return s.setJustifiedStateBalances(justifiedState)
+}
 
-	s.clearInitSyncBlocks()
-
-	var justifiedState iface.BeaconState
-	var err error
-	if justifiedRoot == s.genesisRoot {
-		justifiedState, err = s.cfg.BeaconDB.GenesisState(ctx)
-		if err != nil {
-			return err
-		}
-	} else {
-		justifiedState, err = s.cfg.StateGen.StateByRoot(ctx, justifiedRoot)
-		if err != nil {
-			return err
-		}
-	}
-	if justifiedState == nil {
-		return errors.New("justified state can't be nil")
-	}
-
+// This sets the balances used for fork choice from the input justified state.
+func (s *Service) setJustifiedStateBalances(justifiedState iface.ReadOnlyBeaconState) error {
 	epoch := helpers.CurrentEpoch(justifiedState)
 
 	justifiedBalances := make([]uint64, justifiedState.NumValidators())
@@ -0,0 +1,80 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// HeadUpdater defines administrative operations on the head of the chain, used to recover from
+// a corrupted head without resyncing the node.
+type HeadUpdater interface {
+	RecomputeHead(ctx context.Context) ([32]byte, error)
+	SetHead(ctx context.Context, root [32]byte) error
+}
+
+// RecomputeHead forces fork choice to re-evaluate the head and saves the result, regardless of
+// whether a new block or attestation was received. The previous head is restored if the new head
+// could not be saved. It returns the resulting head root.
+func (s *Service) RecomputeHead(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.RecomputeHead")
+	defer span.End()
+
+	if err := s.withHeadRollback(ctx, func() error {
+		return s.updateHead(ctx, s.getJustifiedBalances())
+	}); err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not recompute head")
+	}
+	r, err := s.HeadRoot(ctx)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return bytesutil.ToBytes32(r), nil
+}
+
+// SetHead sets the head to the given block root, which must be canonical in fork choice, for
+// instance to roll the head back to one of its ancestors. The head only stays there until fork
+// choice is evaluated again on the next block, attestation or call to RecomputeHead. The previous
+// head is restored if the new head could not be saved.
+func (s *Service) SetHead(ctx context.Context, root [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.SetHead")
+	defer span.End()
+
+	if !s.cfg.ForkChoiceStore.HasNode(root) {
+		return errors.Errorf("block root %#x is unknown to fork choice", root)
+	}
+	if !s.cfg.ForkChoiceStore.IsCanonical(root) {
+		return errors.Errorf("block root %#x is not canonical", root)
+	}
+	// Saving the head is a no-op for a block without state, which would hide the failure.
+	if !s.cfg.BeaconDB.HasStateSummary(ctx, root) {
+		return errors.Errorf("no state summary for block root %#x", root)
+	}
+	return s.withHeadRollback(ctx, func() error {
+		return s.saveHead(ctx, root)
+	})
+}
+
+// This runs the given head update, and restores the previous head in the service cache and
+// the DB if the update fails part way.
+func (s *Service) withHeadRollback(ctx context.Context, update func() error) error {
+	s.headLock.RLock()
+	prev := s.head
+	s.headLock.RUnlock()
+
+	err := update()
+	if err == nil || prev == nil {
+		return err
+	}
+	log.WithError(err).WithField("root", fmt.Sprintf("%#x", prev.root)).Warn("Could not update head, rolling back to previous head")
+	s.headLock.Lock()
+	s.head = prev
+	s.headLock.Unlock()
+	if err := s.cfg.BeaconDB.SaveHeadBlockRoot(ctx, prev.root); err != nil {
+		log.WithError(err).Error("Could not save previous head root in DB")
+	}
+	return err
+}
@@ -0,0 +1,100 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// saveHeadCandidate saves a block with its state and inserts it into fork choice.
+func saveHeadCandidate(t *testing.T, s *Service, slot types.Slot, parentRoot [32]byte, graffiti byte) [32]byte {
+	ctx := context.Background()
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = slot
+	b.Block.ParentRoot = append([]byte{}, parentRoot[:]...)
+	b.Block.Body.Graffiti = append(make([]byte, 31), graffiti)
+	require.NoError(t, s.cfg.BeaconDB.SaveBlock(ctx, b))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, s.cfg.BeaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: slot, Root: r[:]}))
+	require.NoError(t, s.cfg.BeaconDB.SaveState(ctx, st, r))
+	require.NoError(t, s.cfg.ForkChoiceStore.ProcessBlock(ctx, slot, r, parentRoot, [32]byte{}, 0, 0))
+	return r
+}
+
+func TestService_SetHeadAndRecomputeHead(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+
+	//      genesis
+	//       /   \
+	//      a     b
+	genesis := saveHeadCandidate(t, service, 0, [32]byte{}, 0)
+	service.cfg.ForkChoiceStore = protoarray.New(0, 0, genesis)
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, genesis, [32]byte{}, [32]byte{}, 0, 0))
+	a := saveHeadCandidate(t, service, 1, genesis, 'a')
+	b := saveHeadCandidate(t, service, 1, genesis, 'b')
+	service.genesisRoot = genesis
+	service.justifiedCheckpt = &ethpb.Checkpoint{Root: genesis[:]}
+	service.bestJustifiedCheckpt = &ethpb.Checkpoint{Root: genesis[:]}
+	service.finalizedCheckpt = &ethpb.Checkpoint{Root: genesis[:]}
+	service.head = &head{root: genesis}
+
+	headRoot, err := service.RecomputeHead(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, headRoot == a || headRoot == b, "Unexpected head %#x", headRoot)
+	assert.Equal(t, headRoot, service.headRoot())
+	sibling := a
+	if headRoot == a {
+		sibling = b
+	}
+
+	// The head is rolled back to the canonical genesis block.
+	require.NoError(t, service.SetHead(ctx, genesis))
+	assert.Equal(t, genesis, service.headRoot())
+	assert.Equal(t, types.Slot(0), service.HeadSlot())
+
+	assert.ErrorContains(t, "is not canonical", service.SetHead(ctx, sibling))
+	assert.ErrorContains(t, "is unknown to fork choice", service.SetHead(ctx, [32]byte{'c'}))
+	assert.Equal(t, genesis, service.headRoot())
+
+	// Recomputing the head moves it back to the fork choice head.
+	recomputed, err := service.RecomputeHead(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, headRoot, recomputed)
+	assert.Equal(t, headRoot, service.headRoot())
+}
+
+func TestService_SetHead_RollsBack(t *testing.T) {
+	ctx := context.Background()
+	hook := logTest.NewGlobal()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+
+	// The new head has a state summary but no block, so it cannot be saved.
+	root := [32]byte{'a'}
+	service.cfg.ForkChoiceStore = protoarray.New(0, 0, root)
+	require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, 1, root, [32]byte{}, [32]byte{}, 0, 0))
+	_, err := service.cfg.ForkChoiceStore.Head(ctx, 0, root, []uint64{}, 0)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 1, Root: root[:]}))
+	prev := &head{slot: 0, root: [32]byte{'b'}}
+	service.head = prev
+
+	assert.ErrorContains(t, "cannot save nil head block", service.SetHead(ctx, root))
+	assert.Equal(t, prev, service.head)
+	require.LogsContain(t, hook, "rolling back to previous head")
+}
@@ -1,11 +1,16 @@
 package blockchain
 
 import (
+	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/emicklei/dot"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -90,6 +95,109 @@ func (s *Service) TreeHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ForkChoiceHandler is a handler to serve /forkchoice page in metrics. It dumps every node of the
+// fork choice store as JSON, or as a DOT graph when the format query parameter is set to dot.
+func (s *Service) ForkChoiceHandler(w http.ResponseWriter, r *http.Request) {
+	dump := s.cfg.ForkChoiceStore.Store().Dump()
+
+	var enc []byte
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "json":
+		var err error
+		enc, err = json.MarshalIndent(dump, "", "  ")
+		if err != nil {
+			log.WithError(err).Error("Could not encode fork choice store")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+	case "dot":
+		enc = []byte(dump.DOT())
+		w.Header().Set("Content-Type", "text/vnd.graphviz")
+	default:
+		http.Error(w, fmt.Sprintf("invalid format: %s", format), http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(enc); err != nil {
+		log.WithError(err).Error("Failed to render fork choice page")
+	}
+}
+
+// ExitingValidatorsHandler is a handler to serve /validators/exiting page in metrics. It lists
+// the validators whose exit or withdrawable epoch falls within the epoch window given by the
+// start_epoch and end_epoch query parameters, which default to the head epoch and the end of
+// the withdrawability delay of the validators exiting at the head epoch.
+func (s *Service) ExitingValidatorsHandler(w http.ResponseWriter, r *http.Request) {
+	headState, err := s.HeadState(r.Context())
+	if err != nil {
+		log.WithError(err).Error("Could not get head state")
+		return
+	}
+	if headState == nil {
+		if _, err := w.Write([]byte("Unavailable during initial syncing")); err != nil {
+			log.WithError(err).Error("Failed to render exiting validators page")
+		}
+		return
+	}
+	// The index is only built at epoch transitions, build it from the head state if the node
+	// did not go through one yet.
+	if _, ok := s.exitingValsCache.Epoch(); !ok {
+		if err := s.exitingValsCache.Update(headState); err != nil {
+			log.WithError(err).Error("Could not update exiting validators cache")
+			return
+		}
+	}
+
+	start := helpers.CurrentEpoch(headState)
+	end := start + params.BeaconConfig().MinValidatorWithdrawabilityDelay
+	if start, err = epochQueryParam(r, "start_epoch", start); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if end, err = epochQueryParam(r, "end_epoch", end); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	buf := new(bytes.Buffer)
+	epoch, _ := s.exitingValsCache.Epoch()
+	if _, err := fmt.Fprintf(buf, "Exiting validators between epochs %d and %d, indexed at epoch %d\n\n", start, end, epoch); err != nil {
+		log.WithError(err).Error("Failed to render exiting validators page")
+		return
+	}
+	if _, err := fmt.Fprintf(buf, "%-10s %-98s %16s %20s\n", "index", "public key", "exit epoch", "withdrawable epoch"); err != nil {
+		log.WithError(err).Error("Failed to render exiting validators page")
+		return
+	}
+	for _, v := range s.exitingValsCache.InEpochWindow(start, end) {
+		if _, err := fmt.Fprintf(buf, "%-10d %#-98x %16d %20d\n", v.Index, v.PublicKey, v.ExitEpoch, v.WithdrawableEpoch); err != nil {
+			log.WithError(err).Error("Failed to render exiting validators page")
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.WithError(err).Error("Failed to render exiting validators page")
+	}
+}
+
+// epochQueryParam parses the epoch of the given query parameter, or returns the default epoch
+// if the parameter is not set.
+func epochQueryParam(r *http.Request, name string, defaultEpoch types.Epoch) (types.Epoch, error) {
+	param := r.URL.Query().Get(name)
+	if param == "" {
+		return defaultEpoch, nil
+	}
+	epoch, err := strconv.ParseUint(param, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %s", name, param)
+	}
+	return types.Epoch(epoch), nil
+}
+
 func averageBalance(balances []uint64) float64 {
 	total := uint64(0)
 	for i := 0; i < len(balances); i++ {
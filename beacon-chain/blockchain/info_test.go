@@ -2,13 +2,17 @@ package blockchain
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -46,3 +50,93 @@ func TestService_TreeHandler(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, rr.Code)
 }
+
+func TestService_ForkChoiceHandler(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewService(ctx, &Config{ForkChoiceStore: protoarray.New(0, 0, [32]byte{'a'})})
+	require.NoError(t, err)
+	require.NoError(t, s.cfg.ForkChoiceStore.ProcessBlock(ctx, 0, [32]byte{'a'}, [32]byte{'g'}, [32]byte{'c'}, 0, 0))
+	require.NoError(t, s.cfg.ForkChoiceStore.ProcessBlock(ctx, 1, [32]byte{'b'}, [32]byte{'a'}, [32]byte{'c'}, 0, 0))
+
+	req, err := http.NewRequest("GET", "/forkchoice", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(s.ForkChoiceHandler).ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	dump := &protoarray.StoreDump{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), dump))
+	require.Equal(t, 2, len(dump.Nodes))
+	assert.Equal(t, dump.Nodes[0].Root, dump.Nodes[1].ParentRoot)
+
+	req, err = http.NewRequest("GET", "/forkchoice?format=dot", nil)
+	require.NoError(t, err)
+	rr = httptest.NewRecorder()
+	http.HandlerFunc(s.ForkChoiceHandler).ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, true, strings.HasPrefix(rr.Body.String(), "digraph"), rr.Body.String())
+
+	req, err = http.NewRequest("GET", "/forkchoice?format=svg", nil)
+	require.NoError(t, err)
+	rr = httptest.NewRecorder()
+	http.HandlerFunc(s.ForkChoiceHandler).ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestService_ExitingValidatorsHandler(t *testing.T) {
+	ctx := context.Background()
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	require.NoError(t, headState.SetValidators([]*ethpb.Validator{
+		{PublicKey: bytesutil.PadTo([]byte{'a'}, 48), ExitEpoch: farFutureEpoch, WithdrawableEpoch: farFutureEpoch},
+		{PublicKey: bytesutil.PadTo([]byte{'b'}, 48), ExitEpoch: 5, WithdrawableEpoch: 261},
+		{PublicKey: bytesutil.PadTo([]byte{'c'}, 48), ExitEpoch: 300, WithdrawableEpoch: 556},
+	}))
+	s, err := NewService(ctx, &Config{})
+	require.NoError(t, err)
+	s.setHead([32]byte{'a'}, testutil.NewBeaconBlock(), headState)
+
+	tests := []struct {
+		name     string
+		query    string
+		code     int
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "default window",
+			code:     http.StatusOK,
+			contains: []string{"between epochs 0 and 256", "0x62"},
+			excludes: []string{"0x61", "0x63"},
+		},
+		{
+			name:     "requested window",
+			query:    "?start_epoch=260&end_epoch=300",
+			code:     http.StatusOK,
+			contains: []string{"0x62", "0x63"},
+			excludes: []string{"0x61"},
+		},
+		{
+			name:     "invalid epoch",
+			query:    "?start_epoch=foo",
+			code:     http.StatusBadRequest,
+			contains: []string{"invalid start_epoch: foo"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest("GET", "/validators/exiting"+tt.query, nil)
+			require.NoError(t, err)
+			rr := httptest.NewRecorder()
+			http.HandlerFunc(s.ExitingValidatorsHandler).ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.code, rr.Code)
+			for _, c := range tt.contains {
+				assert.Equal(t, true, strings.Contains(rr.Body.String(), c), "Expected %q in %s", c, rr.Body.String())
+			}
+			for _, e := range tt.excludes {
+				assert.Equal(t, false, strings.Contains(rr.Body.String(), e), "Unexpected %q in %s", e, rr.Body.String())
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -71,6 +72,18 @@ var (
 		Name: "validators_total_effective_balance",
 		Help: "The total effective balance of validators, in GWei",
 	}, []string{"state"})
+	activeValidatorsCount = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_current_active_validators",
+		Help: "The number of active validators of the current epoch",
+	})
+	totalActiveBalance = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_current_active_gwei",
+		Help: "The total effective balance, in gwei, of the active validators of the current epoch",
+	})
+	validatorChurnLimit = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_current_churn_limit",
+		Help: "The number of validators allowed to enter or exit the validator set in the current epoch",
+	})
 	currentEth1DataDepositCount = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "current_eth1_data_deposit_count",
 		Help: "The current eth1 deposit count in the last processed state eth1data field.",
@@ -99,6 +112,44 @@ var (
 		Name: "beacon_reorg_total",
 		Help: "Count the number of times beacon chain has a reorg",
 	})
+	reorgDepth = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "beacon_reorg_depth",
+			Help:    "The number of slots between the old head and the common ancestor of the old and new heads of reorgs",
+			Buckets: []float64{1, 2, 3, 4, 8, 16, 32, 64},
+		},
+	)
+	queuedBlocksCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_queued_blocks_total",
+		Help: "Count the number of blocks queued while another block was running an epoch transition or was prioritized",
+	})
+	statePrehashCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_state_prehash_total",
+		Help: "Count the number of times the head state was hashed while waiting for the block of the slot",
+	})
+	statePrehashSkippedCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_state_prehash_skipped_total",
+		Help: "Count the number of times the head state was not hashed as the block of the slot was already received",
+	})
+	statePrehashLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "beacon_state_prehash_milliseconds",
+			Help:    "Captures the time taken to hash the head state while waiting for the block of the slot",
+			Buckets: []float64{1, 5, 10, 25, 50, 100, 250, 500},
+		},
+	)
+	nextSlotHeadsAdvancedCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_next_slot_heads_advanced_total",
+		Help: "Count the number of fork choice heads advanced by one slot ahead of the block of the slot",
+	})
+	finalityStalled = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "beacon_finality_stalled",
+		Help: "Set to 1 while finality has not advanced for longer than the configured number of epochs",
+	})
+	doubleProposalsDetected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "beacon_double_proposals_detected_total",
+		Help: "Number of double proposals observed among the processed blocks",
+	})
 	attestationInclusionDelay = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "attestation_inclusion_delay_slots",
@@ -192,6 +243,14 @@ func reportEpochMetrics(ctx context.Context, postState, headState iface.BeaconSt
 	validatorsEffectiveBalance.WithLabelValues("Exiting").Set(float64(exitingEffectiveBalance))
 	validatorsEffectiveBalance.WithLabelValues("Slashing").Set(float64(slashingEffectiveBalance))
 
+	summary, err := helpers.ValidatorSummary(postState)
+	if err != nil {
+		return err
+	}
+	activeValidatorsCount.Set(float64(summary.ActiveCount))
+	totalActiveBalance.Set(float64(summary.TotalActiveBalance))
+	validatorChurnLimit.Set(float64(summary.ChurnLimit))
+
 	// Last justified slot
 	beaconCurrentJustifiedEpoch.Set(float64(postState.CurrentJustifiedCheckpoint().Epoch))
 	beaconCurrentJustifiedRoot.Set(float64(bytesutil.ToLowInt64(postState.CurrentJustifiedCheckpoint().Root)))
@@ -0,0 +1,67 @@
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"go.opencensus.io/trace"
+)
+
+// advanceHeadsRoutine advances the states of the heaviest fork choice heads by one slot at the
+// start of every slot. The block of the slot then finds its pre-state in the next slot cache even
+// when it builds on a competing head rather than on the last processed block.
+func (s *Service) advanceHeadsRoutine() {
+	for s.genesisTime.IsZero() {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+
+	ticker := slotutil.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case slot := <-ticker.C():
+			s.advanceHeads(s.ctx, slot)
+		}
+	}
+}
+
+// advanceHeads saves the states of the configured number of heaviest viable heads, advanced by
+// one slot, to the next slot cache. Heads already in the cache and heads of the given slot, which
+// are advanced once processed, are skipped. It returns the number of heads advanced.
+func (s *Service) advanceHeads(ctx context.Context, slot types.Slot) int {
+	ctx, span := trace.StartSpan(ctx, "blockChain.advanceHeads")
+	defer span.End()
+
+	advanced := 0
+	for _, n := range s.cfg.ForkChoiceStore.Store().ViableHeads(s.cfg.NextSlotCacheHeads) {
+		root := n.Root()
+		if n.Slot() >= slot || state.HasNextSlotState(root[:]) {
+			continue
+		}
+		st, err := s.recentStateByRoot(ctx, root)
+		if err != nil {
+			log.WithError(err).Debug("Could not get state of fork choice head")
+			continue
+		}
+		if st == nil {
+			continue
+		}
+		if err := state.UpdateNextSlotCache(ctx, root[:], st); err != nil {
+			log.WithError(err).Debug("Could not update next slot state cache")
+			continue
+		}
+		advanced++
+	}
+	nextSlotHeadsAdvancedCount.Add(float64(advanced))
+	return advanced
+}
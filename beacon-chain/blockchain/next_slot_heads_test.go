@@ -0,0 +1,56 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_AdvanceHeads(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	state.SetNextSlotCacheSize(2)
+	defer state.SetNextSlotCacheSize(state.DefaultNextSlotCacheSize)
+
+	//      genesis
+	//       /   \
+	//      a     b
+	genesis, a, b := [32]byte{'g'}, [32]byte{'a'}, [32]byte{'b'}
+	fc := protoarray.New(0, 0, genesis)
+	require.NoError(t, fc.ProcessBlock(ctx, 0, genesis, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, fc.ProcessBlock(ctx, 1, a, genesis, [32]byte{}, 0, 0))
+	require.NoError(t, fc.ProcessBlock(ctx, 1, b, genesis, [32]byte{}, 0, 0))
+	service, err := NewService(ctx, &Config{
+		BeaconDB:           beaconDB,
+		StateGen:           stategen.New(beaconDB),
+		ForkChoiceStore:    fc,
+		NextSlotCacheHeads: 2,
+	})
+	require.NoError(t, err)
+	for _, root := range [][32]byte{a, b} {
+		st, _ := testutil.DeterministicGenesisState(t, 8)
+		require.NoError(t, st.SetSlot(1))
+		service.recentStateCache.Put(root, st)
+	}
+
+	// Heads of the current slot are advanced once their block is processed.
+	assert.Equal(t, 0, service.advanceHeads(ctx, 1))
+
+	assert.Equal(t, 2, service.advanceHeads(ctx, 2))
+	for _, root := range [][32]byte{a, b} {
+		st, err := state.NextSlotState(ctx, root[:])
+		require.NoError(t, err)
+		require.NotNil(t, st)
+		assert.Equal(t, uint64(2), uint64(st.Slot()))
+	}
+
+	// Heads already advanced are skipped.
+	assert.Equal(t, 0, service.advanceHeads(ctx, 3))
+}
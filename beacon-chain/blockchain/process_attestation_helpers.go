@@ -9,10 +9,8 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/mputil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
@@ -33,27 +31,11 @@ func (s *Service) getAttPreState(ctx context.Context, c *ethpb.Checkpoint) (ifac
 		return cachedState, nil
 	}
 
-	baseState, err := s.cfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(c.Root))
-	if err != nil {
-		return nil, errors.Wrapf(err, "could not get pre state for epoch %d", c.Epoch)
-	}
-
-	epochStartSlot, err := helpers.StartSlot(c.Epoch)
+	baseState, advanced, err := s.epochBoundaryState(ctx, bytesutil.ToBytes32(c.Root), c.Epoch)
 	if err != nil {
 		return nil, err
 	}
-	if epochStartSlot > baseState.Slot() {
-		if featureconfig.Get().EnableNextSlotStateCache {
-			baseState, err = state.ProcessSlotsUsingNextSlotCache(ctx, baseState, c.Root, epochStartSlot)
-			if err != nil {
-				return nil, errors.Wrapf(err, "could not process slots up to epoch %d", c.Epoch)
-			}
-		} else {
-			baseState, err = state.ProcessSlots(ctx, baseState, epochStartSlot)
-			if err != nil {
-				return nil, errors.Wrapf(err, "could not process slots up to epoch %d", c.Epoch)
-			}
-		}
+	if advanced {
 		if err := s.checkpointStateCache.AddCheckpointState(c, baseState); err != nil {
 			return nil, errors.Wrap(err, "could not saved checkpoint state to cache")
 		}
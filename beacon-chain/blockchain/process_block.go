@@ -7,14 +7,13 @@ import (
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
-	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -30,6 +29,9 @@ const depositDeadline = 20 * time.Second
 // This defines size of the upper bound for initial sync block cache.
 var initialSyncBlockCacheSize = uint64(2 * params.BeaconConfig().SlotsPerEpoch)
 
+// ErrInvalidBlock is returned when a block, or one of its ancestors, has been determined invalid.
+var ErrInvalidBlock = errors.New("block is invalid")
+
 // onBlock is called when a gossip block is received. It runs regular state transition on the block.
 // The block's signing root should be computed before calling this method to avoid redundant
 // computation in this method and methods it calls into.
@@ -98,17 +100,30 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 	if err != nil {
 		return errors.Wrap(err, "could not execute state transition")
 	}
-	valid, err := set.Verify()
-	if err != nil {
-		return errors.Wrap(err, "could not batch verify signature")
-	}
-	if !valid {
-		return errors.New("signature in block failed to verify")
+	// Blocks received by a read replica over TLS have already been verified by its primary node.
+	if !s.cfg.ReadReplica {
+		valid, err := set.Verify()
+		if err != nil {
+			return errors.Wrap(err, "could not batch verify signature")
+		}
+		if !valid {
+			return errors.New("signature in block failed to verify")
+		}
 	}
 
 	if err := s.savePostStateInfo(ctx, blockRoot, signed, postState, false /* reg sync */); err != nil {
 		return err
 	}
+	s.recentStateCache.Put(blockRoot, postState)
+	s.boostProposerIfTimely(ctx, b, blockRoot)
+	if err := s.indexAttestationInclusions(ctx, postState, b, blockRoot); err != nil {
+		log.WithError(err).Error("Could not index attestation inclusions")
+	}
+	if len(b.Body.Deposits) > 0 {
+		if err := s.withdrawalCredsCache.Update(postState); err != nil {
+			log.WithError(err).Error("Could not index withdrawal credentials of new validators")
+		}
+	}
 
 	// Updating next slot state cache can happen in the background. It shouldn't block rest of the process.
 	if featureconfig.Get().EnableNextSlotStateCache {
@@ -146,14 +161,11 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 		}
 
 		// Send notification of the processed block to the state feed.
-		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
-			Type: statefeed.BlockProcessed,
-			Data: &statefeed.BlockProcessedData{
-				Slot:        signed.Block.Slot,
-				BlockRoot:   blockRoot,
-				SignedBlock: signed,
-				Verified:    true,
-			},
+		s.notifyBlockProcessed(&statefeed.BlockProcessedData{
+			Slot:        signed.Block.Slot,
+			BlockRoot:   blockRoot,
+			SignedBlock: signed,
+			Verified:    true,
 		})
 	}
 
@@ -215,17 +227,30 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 
 	jCheckpoints := make([]*ethpb.Checkpoint, len(blks))
 	fCheckpoints := make([]*ethpb.Checkpoint, len(blks))
-	sigSet := &bls.SignatureSet{
-		Signatures: [][]byte{},
-		PublicKeys: []bls.PublicKey{},
-		Messages:   [][32]byte{},
-	}
-	var set *bls.SignatureSet
+	// The signatures of the batch are verified by a pool of workers while the state transition
+	// of the following blocks proceeds.
+	verifier := newSigBatchVerifier(len(blks))
 	boundaries := make(map[[32]byte]iface.BeaconState)
+	var inclusions []*db.AttestationInclusion
+	indexInclusions := s.cfg.IndexAttInclusions
 	for i, b := range blks {
-		set, preState, err = state.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
+		set, postState, err := state.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, s.handleBatchTransitionFailure(ctx, verifier, preState, blks[:i+1], blockRoots[:i+1], err)
+		}
+		preState = postState
+		verifier.add(set)
+		// Like in onBlock, a failure to index the attestation inclusions does not fail the blocks,
+		// the inclusions of the batch are then left out of the index.
+		if indexInclusions {
+			blkInclusions, err := attestationInclusions(ctx, preState, b.Block, blockRoots[i])
+			if err != nil {
+				log.WithError(err).Error("Could not index attestation inclusions")
+				indexInclusions = false
+				inclusions = nil
+			} else {
+				inclusions = append(inclusions, blkInclusions...)
+			}
 		}
 		// Save potential boundary states.
 		if helpers.IsEpochStart(preState.Slot()) {
@@ -236,20 +261,24 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 		}
 		jCheckpoints[i] = preState.CurrentJustifiedCheckpoint()
 		fCheckpoints[i] = preState.FinalizedCheckpoint()
-		sigSet.Join(set)
 	}
-	verify, err := sigSet.Verify()
+	i, err := verifier.wait()
 	if err != nil {
 		return nil, nil, err
 	}
-	if !verify {
-		return nil, nil, errors.New("batch block signature verification failed")
+	if i >= 0 {
+		return nil, nil, invalidSignatureError(blks[i], blockRoots[i])
 	}
 	for r, st := range boundaries {
 		if err := s.cfg.StateGen.SaveState(ctx, r, st); err != nil {
 			return nil, nil, err
 		}
 	}
+	if len(inclusions) > 0 {
+		if err := s.cfg.BeaconDB.SaveAttestationInclusions(ctx, inclusions); err != nil {
+			log.WithError(err).Error("Could not index attestation inclusions")
+		}
+	}
 	// Also saves the last post state which to be used as pre state for the next batch.
 	lastB := blks[len(blks)-1]
 	lastBR := blockRoots[len(blockRoots)-1]
@@ -279,9 +308,10 @@ func (s *Service) handleBlockAfterBatchVerify(ctx context.Context, signed *ethpb
 		return err
 	}
 
-	// Rate limit how many blocks (2 epochs worth of blocks) a node keeps in the memory.
+	// Rate limit how many blocks (2 epochs worth of blocks) a node keeps in the memory. The blocks are
+	// handed to the write batch of the DB, which writes hundreds of them in a single transaction.
 	if uint64(len(s.getInitSyncBlocks())) > initialSyncBlockCacheSize {
-		if err := s.cfg.BeaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
+		if err := s.cfg.BeaconDB.SaveBlocksBatched(ctx, s.getInitSyncBlocks()); err != nil {
 			return err
 		}
 		s.clearInitSyncBlocks()
@@ -335,6 +365,12 @@ func (s *Service) handleEpochBoundary(ctx context.Context, postState iface.Beaco
 		if err := helpers.UpdateProposerIndicesInCache(postState); err != nil {
 			return err
 		}
+		if err := helpers.UpdateValidatorSummaryCache(postState); err != nil {
+			return err
+		}
+		if err := s.exitingValsCache.Update(postState); err != nil {
+			return err
+		}
 	}
 
 	return nil
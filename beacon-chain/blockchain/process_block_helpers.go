@@ -4,13 +4,17 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -23,6 +27,19 @@ func (s *Service) CurrentSlot() types.Slot {
 	return helpers.CurrentSlot(uint64(s.genesisTime.Unix()))
 }
 
+// This boosts the block in fork choice until the next slot if it is the block of the current slot
+// received within the first interval of the slot, before attesters vote for the head.
+func (s *Service) boostProposerIfTimely(ctx context.Context, b *ethpb.BeaconBlock, blockRoot [32]byte) {
+	if b.Slot != s.CurrentSlot() {
+		return
+	}
+	slotStart := s.genesisTime.Add(time.Duration(uint64(b.Slot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	interval := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / time.Duration(params.BeaconConfig().IntervalsPerSlot)
+	if time.Since(slotStart) < interval {
+		s.cfg.ForkChoiceStore.BoostProposerRoot(ctx, blockRoot)
+	}
+}
+
 // getBlockPreState returns the pre state of an incoming block. It uses the parent root of the block
 // to retrieve the state in DB. It verifies the pre state's validity and the incoming block
 // is in the correct time window.
@@ -35,7 +52,7 @@ func (s *Service) getBlockPreState(ctx context.Context, b *ethpb.BeaconBlock) (i
 		return nil, err
 	}
 
-	preState, err := s.cfg.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(b.ParentRoot))
+	preState, err := s.recentStateByRoot(ctx, bytesutil.ToBytes32(b.ParentRoot))
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not get pre state for slot %d", b.Slot)
 	}
@@ -62,6 +79,9 @@ func (s *Service) verifyBlkPreState(ctx context.Context, b *ethpb.BeaconBlock) e
 	defer span.End()
 
 	parentRoot := bytesutil.ToBytes32(b.ParentRoot)
+	if s.cfg.BeaconDB.IsInvalidBlock(ctx, parentRoot) {
+		return errors.Wrapf(ErrInvalidBlock, "parent block %#x was marked invalid", parentRoot)
+	}
 	// Loosen the check to HasBlock because state summary gets saved in batches
 	// during initial syncing. There's no risk given a state summary object is just a
 	// a subset of the block object.
@@ -200,7 +220,7 @@ func (s *Service) updateJustified(ctx context.Context, state iface.ReadOnlyBeaco
 	if canUpdate {
 		s.prevJustifiedCheckpt = s.justifiedCheckpt
 		s.justifiedCheckpt = cpt
-		if err := s.cacheJustifiedStateBalances(ctx, bytesutil.ToBytes32(s.justifiedCheckpt.Root)); err != nil {
+		if err := s.onJustifiedCheckpoint(ctx, s.justifiedCheckpt); err != nil {
 			return err
 		}
 	}
@@ -214,7 +234,7 @@ func (s *Service) updateJustified(ctx context.Context, state iface.ReadOnlyBeaco
 func (s *Service) updateJustifiedInitSync(ctx context.Context, cp *ethpb.Checkpoint) error {
 	s.prevJustifiedCheckpt = s.justifiedCheckpt
 	s.justifiedCheckpt = cp
-	if err := s.cacheJustifiedStateBalances(ctx, bytesutil.ToBytes32(s.justifiedCheckpt.Root)); err != nil {
+	if err := s.onJustifiedCheckpoint(ctx, s.justifiedCheckpt); err != nil {
 		return err
 	}
 
@@ -242,6 +262,7 @@ func (s *Service) updateFinalized(ctx context.Context, cp *ethpb.Checkpoint) err
 		return errors.Wrap(err, "could not migrate to cold")
 	}
 
+	s.onFinalizedCheckpoint(ctx, cp)
 	return nil
 }
 
@@ -338,7 +359,7 @@ func (s *Service) finalizedImpliesNewJustified(ctx context.Context, state iface.
 	if !attestationutil.CheckPointIsEqual(s.justifiedCheckpt, state.CurrentJustifiedCheckpoint()) {
 		if state.CurrentJustifiedCheckpoint().Epoch > s.justifiedCheckpt.Epoch {
 			s.justifiedCheckpt = state.CurrentJustifiedCheckpoint()
-			return s.cacheJustifiedStateBalances(ctx, bytesutil.ToBytes32(s.justifiedCheckpt.Root))
+			return s.onJustifiedCheckpoint(ctx, s.justifiedCheckpt)
 		}
 
 		// Update justified if store justified is not in chain with finalized check point.
@@ -353,7 +374,7 @@ func (s *Service) finalizedImpliesNewJustified(ctx context.Context, state iface.
 		}
 		if !bytes.Equal(anc, s.finalizedCheckpt.Root) {
 			s.justifiedCheckpt = state.CurrentJustifiedCheckpoint()
-			if err := s.cacheJustifiedStateBalances(ctx, bytesutil.ToBytes32(s.justifiedCheckpt.Root)); err != nil {
+			if err := s.onJustifiedCheckpoint(ctx, s.justifiedCheckpt); err != nil {
 				return err
 			}
 		}
@@ -454,3 +475,102 @@ func (s *Service) ensureRootNotZeros(root [32]byte) [32]byte {
 	}
 	return root
 }
+
+// This returns the index of the first signature set which fails verification. The sets are
+// bisected so that only O(log n) aggregate verifications are needed to locate the offending set.
+func firstInvalidSignatureSet(sets []*bls.SignatureSet) (int, error) {
+	lo, hi := 0, len(sets)
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		set := bls.NewSet()
+		for _, ss := range sets[lo:mid] {
+			set.Join(ss)
+		}
+		valid, err := set.Verify()
+		if err != nil {
+			return 0, err
+		}
+		if valid {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	if lo >= len(sets) {
+		return 0, errors.New("no signature sets provided")
+	}
+	return lo, nil
+}
+
+// This handles the last of the given blocks of a batch, which failed its state transition. The block is
+// only recorded as invalid once the signatures of the preceding blocks, which its pre-state builds on,
+// and its own proposer signature have been verified, as a valid block relayed with a corrupted signature
+// could otherwise be used to blacklist it. The blocks following it in the batch were never processed,
+// so they are left untouched.
+func (s *Service) handleBatchTransitionFailure(ctx context.Context, verifier *sigBatchVerifier, preState iface.BeaconState,
+	blks []*ethpb.SignedBeaconBlock, blockRoots [][32]byte, transitionErr error) error {
+	last := len(blks) - 1
+	transitionErr = errors.Wrapf(transitionErr, "could not execute state transition of block %#x at slot %d",
+		blockRoots[last], blks[last].Block.Slot)
+	if ctx.Err() != nil {
+		return transitionErr
+	}
+	i, err := verifier.wait()
+	if err != nil {
+		return err
+	}
+	if i >= 0 {
+		return invalidSignatureError(blks[i], blockRoots[i])
+	}
+	if err := verifyProposerSignature(ctx, preState, blks[last]); err != nil {
+		return invalidSignatureError(blks[last], blockRoots[last])
+	}
+	if err := s.invalidateBlock(ctx, blockRoots[last], blks[last].Signature); err != nil {
+		return errors.Wrap(err, "could not invalidate block")
+	}
+	return errors.Wrap(ErrInvalidBlock, transitionErr.Error())
+}
+
+// This returns the error of a block whose signatures failed to verify. Such a block is not recorded as
+// invalid, since its root does not commit to its signature.
+func invalidSignatureError(blk *ethpb.SignedBeaconBlock, blockRoot [32]byte) error {
+	return errors.Wrapf(ErrInvalidBlock, "signature verification failed for block %#x at slot %d", blockRoot, blk.Block.Slot)
+}
+
+// This verifies the proposer signature of a block against its pre-state, advancing the state to the
+// slot of the block if needed.
+func verifyProposerSignature(ctx context.Context, preState iface.BeaconState, signed *ethpb.SignedBeaconBlock) error {
+	st := preState
+	if st.Slot() < signed.Block.Slot {
+		var err error
+		st, err = state.ProcessSlots(ctx, preState.Copy(), signed.Block.Slot)
+		if err != nil {
+			return err
+		}
+	}
+	return blocks.VerifyBlockSignature(st, signed)
+}
+
+// This marks the given block as invalid in the db and in fork choice, so the block and its descendants
+// are rejected going forward. If the current head was invalidated, head is recomputed.
+func (s *Service) invalidateBlock(ctx context.Context, blockRoot [32]byte, signature []byte) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.invalidateBlock")
+	defer span.End()
+
+	if err := s.cfg.BeaconDB.SaveInvalidBlock(ctx, blockRoot, signature); err != nil {
+		return err
+	}
+	if !s.cfg.ForkChoiceStore.HasNode(blockRoot) {
+		return nil
+	}
+	if err := s.cfg.ForkChoiceStore.InvalidateBlock(ctx, blockRoot); err != nil {
+		return err
+	}
+
+	headRoot := s.headRoot()
+	if n := s.cfg.ForkChoiceStore.Node(headRoot); n == nil || !n.Invalid() {
+		return nil
+	}
+	log.WithField("root", fmt.Sprintf("%#x", headRoot)).Warn("Head block was invalidated, recomputing head")
+	return s.updateHead(ctx, s.getJustifiedBalances())
+}
@@ -11,8 +11,12 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	blockchainTesting "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -22,6 +26,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -177,6 +182,133 @@ func TestStore_OnBlockBatch(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// batchTestService returns a service with the first of 9 generated blocks saved, along with the blocks,
+// their roots, the genesis state and the keys of the validators.
+func batchTestService(t *testing.T) (*Service, []*ethpb.SignedBeaconBlock, [][32]byte, iface.BeaconState, []bls.SecretKey) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	cfg := &Config{
+		BeaconDB: beaconDB,
+		StateGen: stategen.New(beaconDB),
+	}
+	service, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+
+	genesisStateRoot := [32]byte{}
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	assert.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	service.finalizedCheckpt = &ethpb.Checkpoint{
+		Root: gRoot[:],
+	}
+	service.cfg.ForkChoiceStore = protoarray.New(0, 0, [32]byte{})
+	service.saveInitSyncBlock(gRoot, genesis)
+
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+
+	bState := st.Copy()
+
+	var blks []*ethpb.SignedBeaconBlock
+	var blkRoots [][32]byte
+	var firstState iface.BeaconState
+	for i := 1; i < 10; i++ {
+		b, err := testutil.GenerateFullBlock(bState, keys, testutil.DefaultBlockGenConfig(), types.Slot(i))
+		require.NoError(t, err)
+		bState, err = state.ExecuteStateTransition(ctx, bState, b)
+		require.NoError(t, err)
+		if i == 1 {
+			firstState = bState.Copy()
+		}
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		service.saveInitSyncBlock(root, b)
+		blks = append(blks, b)
+		blkRoots = append(blkRoots, root)
+	}
+
+	blks[0].Block.ParentRoot = gRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(context.Background(), blks[0]))
+	require.NoError(t, service.cfg.StateGen.SaveState(ctx, blkRoots[0], firstState))
+	return service, blks, blkRoots, st, keys
+}
+
+func TestStore_OnBlockBatch_InvalidSignature(t *testing.T) {
+	ctx := context.Background()
+	service, blks, blkRoots, _, _ := batchTestService(t)
+
+	// Replace the proposer signature of a block in the middle of the batch with one over a different message.
+	blks[5].Signature = blks[4].Signature
+	_, _, err := service.onBlockBatch(ctx, blks[1:], blkRoots[1:])
+	assert.Equal(t, true, errors.Is(err, ErrInvalidBlock), "Unexpected error: %v", err)
+	// The block itself may be valid, so neither it nor its descendants are recorded as invalid.
+	for i := range blkRoots {
+		assert.Equal(t, false, service.cfg.BeaconDB.IsInvalidBlock(ctx, blkRoots[i]), "Block %d should not be invalid", i)
+	}
+}
+
+func TestStore_OnBlockBatch_InvalidBlock(t *testing.T) {
+	for _, validSig := range []bool{false, true} {
+		t.Run(fmt.Sprintf("valid signature %v", validSig), func(t *testing.T) {
+			ctx := context.Background()
+			service, blks, blkRoots, st, keys := batchTestService(t)
+
+			// Make a block in the middle of the batch fail its state transition.
+			blks[5].Block.ParentRoot = bytesutil.PadTo([]byte{'a'}, 32)
+			root, err := blks[5].Block.HashTreeRoot()
+			require.NoError(t, err)
+			blkRoots[5] = root
+			if validSig {
+				domain, err := helpers.Domain(st.Fork(), 0, params.BeaconConfig().DomainBeaconProposer, st.GenesisValidatorRoot())
+				require.NoError(t, err)
+				signingRoot, err := helpers.ComputeSigningRoot(blks[5].Block, domain)
+				require.NoError(t, err)
+				blks[5].Signature = keys[blks[5].Block.ProposerIndex].Sign(signingRoot[:]).Marshal()
+			}
+
+			_, _, err = service.onBlockBatch(ctx, blks[1:], blkRoots[1:])
+			assert.Equal(t, true, errors.Is(err, ErrInvalidBlock), "Unexpected error: %v", err)
+			// The block is only recorded as invalid if its signature verifies, and the untried blocks
+			// following it are never recorded.
+			for i := range blkRoots {
+				assert.Equal(t, validSig && i == 5, service.cfg.BeaconDB.IsInvalidBlock(ctx, blkRoots[i]),
+					"Unexpected invalidity of block %d", i)
+			}
+
+			// Blocks building on top of an invalid block are rejected.
+			err = service.verifyBlkPreState(ctx, &ethpb.BeaconBlock{ParentRoot: blkRoots[5][:]})
+			assert.Equal(t, validSig, errors.Is(err, ErrInvalidBlock), "Unexpected error: %v", err)
+		})
+	}
+}
+
+func TestFirstInvalidSignatureSet(t *testing.T) {
+	sets := make([]*bls.SignatureSet, 7)
+	for i := range sets {
+		priv, err := bls.RandKey()
+		require.NoError(t, err)
+		msg := [32]byte{byte(i)}
+		sets[i] = &bls.SignatureSet{
+			Signatures: [][]byte{priv.Sign(msg[:]).Marshal()},
+			PublicKeys: []bls.PublicKey{priv.PublicKey()},
+			Messages:   [][32]byte{msg},
+		}
+	}
+	for bad := range sets {
+		corrupted := make([]*bls.SignatureSet, len(sets))
+		copy(corrupted, sets)
+		corrupted[bad] = &bls.SignatureSet{
+			Signatures: sets[bad].Signatures,
+			PublicKeys: sets[bad].PublicKeys,
+			Messages:   [][32]byte{{'x'}},
+		}
+		i, err := firstInvalidSignatureSet(corrupted)
+		require.NoError(t, err)
+		assert.Equal(t, bad, i)
+	}
+}
+
 func TestRemoveStateSinceLastFinalized_EmptyStartSlot(t *testing.T) {
 	ctx := context.Background()
 	beaconDB := testDB.SetupDB(t)
@@ -314,7 +446,7 @@ func TestUpdateJustified_CouldUpdateBest(t *testing.T) {
 	ctx := context.Background()
 	beaconDB := testDB.SetupDB(t)
 
-	cfg := &Config{BeaconDB: beaconDB, StateGen: stategen.New(beaconDB)}
+	cfg := &Config{BeaconDB: beaconDB, StateGen: stategen.New(beaconDB), StateNotifier: &blockchainTesting.MockStateNotifier{}}
 	service, err := NewService(ctx, cfg)
 	require.NoError(t, err)
 
@@ -567,6 +699,42 @@ func TestCurrentSlot_HandlesOverflow(t *testing.T) {
 	slot := svc.CurrentSlot()
 	require.Equal(t, types.Slot(0), slot, "Unexpected slot")
 }
+
+func TestService_boostProposerIfTimely(t *testing.T) {
+	ctx := context.Background()
+	fcs := protoarray.New(0, 0, [32]byte{'G'})
+	svc := Service{cfg: &Config{ForkChoiceStore: fcs}}
+	require.NoError(t, fcs.ProcessBlock(ctx, 0, [32]byte{'G'}, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, fcs.ProcessBlock(ctx, 10, [32]byte{'A'}, [32]byte{'G'}, [32]byte{}, 0, 0))
+	balances := make([]uint64, params.BeaconConfig().SlotsPerEpoch)
+	for i := range balances {
+		balances[i] = 100
+	}
+	boosted := func() bool {
+		_, err := fcs.Head(ctx, 0, [32]byte{'G'}, balances, 0)
+		require.NoError(t, err)
+		isBoosted := fcs.Node([32]byte{'A'}).Weight() > 0
+		fcs.ResetBoostedProposerRoot(ctx)
+		_, err = fcs.Head(ctx, 0, [32]byte{'G'}, balances, 0)
+		require.NoError(t, err)
+		return isBoosted
+	}
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+
+	// A block received at the start of its slot is boosted.
+	svc.genesisTime = time.Now().Add(-10*secondsPerSlot - time.Second)
+	svc.boostProposerIfTimely(ctx, &ethpb.BeaconBlock{Slot: 10}, [32]byte{'A'})
+	assert.Equal(t, true, boosted(), "Timely block was not boosted")
+
+	// Blocks received late in their slot, or of past slots, are not.
+	svc.genesisTime = time.Now().Add(-10*secondsPerSlot - secondsPerSlot/2)
+	svc.boostProposerIfTimely(ctx, &ethpb.BeaconBlock{Slot: 10}, [32]byte{'A'})
+	assert.Equal(t, false, boosted(), "Late block was boosted")
+	svc.genesisTime = time.Now().Add(-11*secondsPerSlot - time.Second)
+	svc.boostProposerIfTimely(ctx, &ethpb.BeaconBlock{Slot: 10}, [32]byte{'A'})
+	assert.Equal(t, false, boosted(), "Block of a past slot was boosted")
+}
+
 func TestAncestorByDB_CtxErr(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	service, err := NewService(ctx, &Config{})
@@ -755,7 +923,7 @@ func TestFinalizedImpliesNewJustified(t *testing.T) {
 		beaconState, err := testutil.NewBeaconState()
 		require.NoError(t, err)
 		require.NoError(t, beaconState.SetCurrentJustifiedCheckpoint(test.args.stateCheckPoint))
-		service, err := NewService(ctx, &Config{BeaconDB: beaconDB, StateGen: stategen.New(beaconDB), ForkChoiceStore: protoarray.New(0, 0, [32]byte{})})
+		service, err := NewService(ctx, &Config{BeaconDB: beaconDB, StateGen: stategen.New(beaconDB), ForkChoiceStore: protoarray.New(0, 0, [32]byte{}), StateNotifier: &blockchainTesting.MockStateNotifier{}})
 		require.NoError(t, err)
 		service.justifiedCheckpt = test.args.cachedCheckPoint
 		require.NoError(t, service.cfg.BeaconDB.SaveStateSummary(ctx, &pb.StateSummary{Root: bytesutil.PadTo(test.want.Root, 32)}))
@@ -864,7 +1032,7 @@ func TestVerifyBlkDescendant(t *testing.T) {
 func TestUpdateJustifiedInitSync(t *testing.T) {
 	beaconDB := testDB.SetupDB(t)
 	ctx := context.Background()
-	cfg := &Config{BeaconDB: beaconDB}
+	cfg := &Config{BeaconDB: beaconDB, StateNotifier: &blockchainTesting.MockStateNotifier{}}
 	service, err := NewService(ctx, cfg)
 	require.NoError(t, err)
 
@@ -927,9 +1095,16 @@ func TestOnBlock_CanFinalize(t *testing.T) {
 		StateGen:        stategen.New(beaconDB),
 		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
 		DepositCache:    depositCache,
+		StateNotifier:   &blockchainTesting.MockStateNotifier{},
 	}
 	service, err := NewService(ctx, cfg)
 	require.NoError(t, err)
+	events := make(chan *feed.Event, 64)
+	sub := service.cfg.StateNotifier.StateFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+	finalizedEvents := make(chan *statefeed.CheckpointData, 64)
+	finalizedSub := service.SubscribeFinalized(finalizedEvents)
+	defer finalizedSub.Unsubscribe()
 
 	gs, keys := testutil.DeterministicGenesisState(t, 32)
 	require.NoError(t, service.saveGenesisData(ctx, gs))
@@ -951,6 +1126,39 @@ func TestOnBlock_CanFinalize(t *testing.T) {
 	}
 	require.Equal(t, types.Epoch(3), service.CurrentJustifiedCheckpt().Epoch)
 	require.Equal(t, types.Epoch(2), service.FinalizedCheckpt().Epoch)
+
+	// The last checkpoint events carry the current checkpoints. The finalized state is only
+	// sent to the subscribers of finalization.
+	var justified, finalized, subscribedFinalized *statefeed.CheckpointData
+	for len(events) > 0 {
+		ev := <-events
+		switch ev.Type {
+		case statefeed.JustifiedCheckpoint:
+			justified = ev.Data.(*statefeed.CheckpointData)
+		case statefeed.FinalizedCheckpoint:
+			finalized = ev.Data.(*statefeed.CheckpointData)
+		}
+	}
+	for len(finalizedEvents) > 0 {
+		subscribedFinalized = <-finalizedEvents
+	}
+	require.NotNil(t, justified)
+	require.NotNil(t, finalized)
+	require.NotNil(t, subscribedFinalized)
+	assert.DeepSSZEqual(t, service.CurrentJustifiedCheckpt(), justified.Checkpoint)
+	assert.DeepSSZEqual(t, service.FinalizedCheckpt(), finalized.Checkpoint)
+	assert.DeepSSZEqual(t, service.FinalizedCheckpt(), subscribedFinalized.Checkpoint)
+	assert.Equal(t, nil, finalized.State)
+	for _, data := range []*statefeed.CheckpointData{justified, subscribedFinalized} {
+		blk, err := service.cfg.BeaconDB.Block(ctx, bytesutil.ToBytes32(data.Checkpoint.Root))
+		require.NoError(t, err)
+		assert.Equal(t, blk.Block.Slot, data.State.Slot())
+	}
+	// The justified checkpoint block is at the start of its epoch, so its state is cached.
+	cached, err := service.checkpointStateCache.StateByCheckpoint(justified.Checkpoint)
+	require.NoError(t, err)
+	require.NotNil(t, cached)
+	assert.Equal(t, justified.State.Slot(), cached.Slot())
 }
 
 func TestInsertFinalizedDeposits(t *testing.T) {
@@ -0,0 +1,114 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// ProposalGuard defines a last line check against double proposals, which is run before a block
+// of the local validators is signed or broadcast.
+type ProposalGuard interface {
+	VerifyNotDoubleProposal(ctx context.Context, block *ethpb.BeaconBlock) error
+}
+
+// VerifyNotDoubleProposal returns an error wrapping blocks.ErrDoubleProposal if the node has
+// recently seen a different block from the same proposer at the same slot.
+func (s *Service) VerifyNotDoubleProposal(_ context.Context, block *ethpb.BeaconBlock) error {
+	header, err := blockutil.BeaconBlockHeaderFromBlock(block)
+	if err != nil {
+		return errors.Wrap(err, "could not get block header")
+	}
+	s.seenProposalsLock.RLock()
+	defer s.seenProposalsLock.RUnlock()
+	seen := make([]*ethpb.BeaconBlockHeader, 0, len(s.seenProposals[block.Slot]))
+	for _, h := range s.seenProposals[block.Slot] {
+		seen = append(seen, h.Header)
+	}
+	return blocks.VerifyNotDoubleProposal(header, seen)
+}
+
+// recordProposal saves the signed header of a processed block, so that the local validators are
+// prevented from proposing a conflicting block at the same slot. Should a different block from
+// the same proposer have been seen at that slot, the two headers are turned into a proposer
+// slashing. Headers older than an epoch are pruned.
+func (s *Service) recordProposal(ctx context.Context, block *ethpb.SignedBeaconBlock) {
+	header, err := blockutil.SignedBeaconBlockHeaderFromBlock(block)
+	if err != nil {
+		log.WithError(err).Debug("Could not record proposal header")
+		return
+	}
+	slashing := s.saveProposalHeader(header)
+	if slashing != nil {
+		s.submitProposerSlashing(ctx, slashing)
+	}
+}
+
+// saveProposalHeader saves the signed header, and returns a proposer slashing if it conflicts with
+// a header previously seen from the same proposer at the same slot.
+func (s *Service) saveProposalHeader(header *ethpb.SignedBeaconBlockHeader) *ethpb.ProposerSlashing {
+	s.seenProposalsLock.Lock()
+	defer s.seenProposalsLock.Unlock()
+	if s.seenProposals == nil {
+		s.seenProposals = make(map[types.Slot][]*ethpb.SignedBeaconBlockHeader)
+	}
+	slot := header.Header.Slot
+	var slashing *ethpb.ProposerSlashing
+	for _, h := range s.seenProposals[slot] {
+		if h.Header.ProposerIndex != header.Header.ProposerIndex {
+			continue
+		}
+		if proto.Equal(h.Header, header.Header) {
+			return nil
+		}
+		if slashing == nil {
+			slashing = &ethpb.ProposerSlashing{Header_1: h, Header_2: header}
+		}
+	}
+	s.seenProposals[slot] = append(s.seenProposals[slot], header)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	if slot > slotsPerEpoch {
+		for seenSlot := range s.seenProposals {
+			if seenSlot < slot-slotsPerEpoch {
+				delete(s.seenProposals, seenSlot)
+			}
+		}
+	}
+	return slashing
+}
+
+// submitProposerSlashing inserts a proposer slashing built from an observed double proposal into
+// the slashing pool and broadcasts it, so the equivocation ends up slashed on chain.
+func (s *Service) submitProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) {
+	log.WithFields(logrus.Fields{
+		"proposerIndex": slashing.Header_1.Header.ProposerIndex,
+		"slot":          slashing.Header_1.Header.Slot,
+	}).Warn("Detected double proposal")
+	doubleProposalsDetected.Inc()
+	if s.cfg == nil || s.cfg.SlashingPool == nil {
+		return
+	}
+	headState, err := s.HeadState(ctx)
+	if err != nil || headState == nil {
+		log.WithError(err).Debug("Could not get head state to insert proposer slashing")
+		return
+	}
+	if err := s.cfg.SlashingPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+		log.WithError(err).Debug("Could not insert proposer slashing into pool")
+		return
+	}
+	if s.cfg.P2p == nil {
+		return
+	}
+	if err := s.cfg.P2p.Broadcast(ctx, slashing); err != nil {
+		log.WithError(err).Debug("Could not broadcast proposer slashing")
+	}
+}
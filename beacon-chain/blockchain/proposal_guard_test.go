@@ -0,0 +1,89 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_VerifyNotDoubleProposal(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{}
+
+	blk := testutil.NewBeaconBlock().Block
+	blk.Slot = 5
+	blk.ProposerIndex = 1
+	require.NoError(t, s.VerifyNotDoubleProposal(ctx, blk))
+	s.recordProposal(ctx, &ethpb.SignedBeaconBlock{Block: blk})
+	// Resubmitting the same block is not a double proposal.
+	require.NoError(t, s.VerifyNotDoubleProposal(ctx, blk))
+
+	conflicting := testutil.NewBeaconBlock().Block
+	conflicting.Slot = 5
+	conflicting.ProposerIndex = 1
+	conflicting.Body.Graffiti = bytesutil.PadTo([]byte("conflicting"), 32)
+	err := s.VerifyNotDoubleProposal(ctx, conflicting)
+	assert.Equal(t, true, errors.Is(err, blocks.ErrDoubleProposal))
+
+	// Headers older than an epoch are pruned.
+	later := testutil.NewBeaconBlock().Block
+	later.Slot = blk.Slot + params.BeaconConfig().SlotsPerEpoch + 1
+	s.recordProposal(ctx, &ethpb.SignedBeaconBlock{Block: later})
+	require.NoError(t, s.VerifyNotDoubleProposal(ctx, conflicting))
+	assert.Equal(t, 1, len(s.seenProposals))
+}
+
+func TestService_RecordProposal_DoubleProposal(t *testing.T) {
+	ctx := context.Background()
+	headState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, headState.SetSlot(5))
+	pool := slashings.NewPool()
+	broadcaster := &mockBroadcaster{}
+	s := &Service{
+		cfg:  &Config{SlashingPool: pool, P2p: broadcaster},
+		head: &head{state: headState},
+	}
+
+	signedBlock := func(graffiti string) *ethpb.SignedBeaconBlock {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = 5
+		blk.Block.ProposerIndex = 1
+		blk.Block.Body.Graffiti = bytesutil.PadTo([]byte(graffiti), 32)
+		sig, err := helpers.ComputeDomainAndSign(headState, 0, blk.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[1])
+		require.NoError(t, err)
+		blk.Signature = sig
+		return blk
+	}
+
+	blk := signedBlock("first")
+	s.recordProposal(ctx, blk)
+	s.recordProposal(ctx, blk)
+	assert.Equal(t, 1, len(s.seenProposals[5]), "Same block was recorded twice")
+	assert.Equal(t, 0, len(pool.PendingProposerSlashings(ctx, headState, true)))
+	assert.Equal(t, false, broadcaster.broadcastCalled)
+
+	// A block from another proposer at the same slot is not an equivocation.
+	other := testutil.NewBeaconBlock()
+	other.Block.Slot = 5
+	other.Block.ProposerIndex = 2
+	s.recordProposal(ctx, other)
+	assert.Equal(t, 0, len(pool.PendingProposerSlashings(ctx, headState, true)))
+
+	s.recordProposal(ctx, signedBlock("second"))
+	pending := pool.PendingProposerSlashings(ctx, headState, true)
+	require.Equal(t, 1, len(pending))
+	assert.Equal(t, types.ValidatorIndex(1), pending[0].Header_1.Header.ProposerIndex)
+	assert.DeepEqual(t, blk.Signature, pending[0].Header_1.Signature)
+	assert.Equal(t, true, broadcaster.broadcastCalled)
+}
@@ -119,6 +119,8 @@ func (s *Service) processAttestationsRoutine(subscribedToStateEvents chan<- stru
 		case <-s.ctx.Done():
 			return
 		case <-st.C():
+			// The boost of the block of the previous slot is over.
+			s.cfg.ForkChoiceStore.ResetBoostedProposerRoot(s.ctx)
 			// Continue when there's no fork choice attestation, there's nothing to process and update head.
 			// This covers the condition when the node is still initial syncing to the head of the chain.
 			if s.cfg.AttPool.ForkchoiceAttestationCount() == 0 {
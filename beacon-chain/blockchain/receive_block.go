@@ -6,7 +6,6 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
@@ -37,12 +36,21 @@ func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlo
 	receivedTime := timeutils.Now()
 	blockCopy := stateV0.CopySignedBeaconBlock(block)
 
+	release, err := s.admitBlock(ctx, blockCopy.Block)
+	if err != nil {
+		err := errors.Wrap(err, "could not admit block for processing")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	defer release()
+
 	// Apply state transition on the new block.
 	if err := s.onBlock(ctx, blockCopy, blockRoot); err != nil {
 		err := errors.Wrap(err, "could not process block")
 		traceutil.AnnotateError(span, err)
 		return err
 	}
+	s.recordProposal(ctx, blockCopy)
 
 	// Update and save head block after fork choice.
 	if !featureconfig.Get().UpdateHeadTimely {
@@ -50,14 +58,11 @@ func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlo
 			log.WithError(err).Warn("Could not update head")
 		}
 		// Send notification of the processed block to the state feed.
-		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
-			Type: statefeed.BlockProcessed,
-			Data: &statefeed.BlockProcessedData{
-				Slot:        blockCopy.Block.Slot,
-				BlockRoot:   blockRoot,
-				SignedBlock: blockCopy,
-				Verified:    true,
-			},
+		s.notifyBlockProcessed(&statefeed.BlockProcessedData{
+			Slot:        blockCopy.Block.Slot,
+			BlockRoot:   blockRoot,
+			SignedBlock: blockCopy,
+			Verified:    true,
 		})
 	}
 
@@ -105,15 +110,13 @@ func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []*ethpb.SignedB
 			traceutil.AnnotateError(span, err)
 			return err
 		}
+		s.recordProposal(ctx, blockCopy)
 		// Send notification of the processed block to the state feed.
-		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
-			Type: statefeed.BlockProcessed,
-			Data: &statefeed.BlockProcessedData{
-				Slot:        blockCopy.Block.Slot,
-				BlockRoot:   blkRoots[i],
-				SignedBlock: blockCopy,
-				Verified:    true,
-			},
+		s.notifyBlockProcessed(&statefeed.BlockProcessedData{
+			Slot:        blockCopy.Block.Slot,
+			BlockRoot:   blkRoots[i],
+			SignedBlock: blockCopy,
+			Verified:    true,
 		})
 
 		// Reports on blockCopy and fork choice metrics.
@@ -0,0 +1,25 @@
+package blockchain
+
+import (
+	"context"
+
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"go.opencensus.io/trace"
+)
+
+// recentStateByRoot returns the post state of the given block root. States of recently processed
+// blocks are served from the recent state cache, which saves a state regeneration when the block
+// sits on a branch other than the head.
+func (s *Service) recentStateByRoot(ctx context.Context, root [32]byte) (iface.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.recentStateByRoot")
+	defer span.End()
+
+	if s.recentStateCache != nil {
+		if st := s.recentStateCache.StateByRoot(root); st != nil {
+			span.AddAttributes(trace.BoolAttribute("cache_hit", true))
+			return st, nil
+		}
+	}
+	span.AddAttributes(trace.BoolAttribute("cache_hit", false))
+	return s.cfg.StateGen.StateByRoot(ctx, root)
+}
@@ -0,0 +1,40 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_RecentStateByRoot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, &Config{BeaconDB: beaconDB, StateGen: stategen.New(beaconDB)})
+	require.NoError(t, err)
+
+	cached, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, cached.SetSlot(5))
+	cachedRoot := [32]byte{'a'}
+	service.recentStateCache.Put(cachedRoot, cached)
+
+	st, err := service.recentStateByRoot(ctx, cachedRoot)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), uint64(st.Slot()))
+
+	// States missing from the cache are regenerated through stategen.
+	saved, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, saved.SetSlot(7))
+	savedRoot := [32]byte{'b'}
+	require.NoError(t, service.cfg.StateGen.SaveState(ctx, savedRoot, saved))
+
+	st, err = service.recentStateByRoot(ctx, savedRoot)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), uint64(st.Slot()))
+}
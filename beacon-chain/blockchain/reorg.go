@@ -0,0 +1,108 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+// recentReorgsSize is the number of recent reorgs kept by the service.
+const recentReorgsSize = 64
+
+// ReorgFetcher defines a common interface for methods in blockchain service which
+// directly retrieve the recent reorgs of the chain.
+type ReorgFetcher interface {
+	RecentReorgs() []*statefeed.ReorgData
+}
+
+// RecentReorgs returns the most recent reorgs of the chain, oldest first.
+func (s *Service) RecentReorgs() []*statefeed.ReorgData {
+	s.recentReorgsLock.RLock()
+	defer s.recentReorgsLock.RUnlock()
+	reorgs := make([]*statefeed.ReorgData, len(s.recentReorgs))
+	copy(reorgs, s.recentReorgs)
+	return reorgs
+}
+
+// This checks whether the head moving from the old head to the new head reorgs the chain, which
+// is the case when the new head doesn't descend from the old head. Reorgs are sent to the state
+// feed and the reorg subscribers, kept in the recent reorgs, and recorded in the metrics along with
+// their depth, the number of slots between the old head and the common ancestor of both heads.
+// Reorgs deeper than the configured depth are logged as warnings.
+func (s *Service) checkReorg(ctx context.Context, oldRoot [32]byte, oldSlot types.Slot, newRoot [32]byte, newSlot types.Slot) {
+	data := &statefeed.ReorgData{
+		NewSlot: newSlot,
+		OldSlot: oldSlot,
+		NewRoot: newRoot,
+		OldRoot: oldRoot,
+		Time:    timeutils.Now(),
+	}
+	ancestorRoot, ancestorSlot, err := s.commonAncestor(ctx, oldRoot, newRoot)
+	if err != nil {
+		// Without the common ancestor, the new head is assumed not to descend from the old head.
+		log.WithError(err).Debug("Could not find common ancestor of old and new head")
+	} else {
+		if ancestorRoot == oldRoot {
+			return
+		}
+		data.CommonAncestor = ancestorRoot
+		if ancestorSlot < oldSlot {
+			data.Depth = uint64(oldSlot - ancestorSlot)
+		}
+	}
+
+	logger := log.WithFields(logrus.Fields{
+		"newSlot": fmt.Sprintf("%d", newSlot),
+		"oldSlot": fmt.Sprintf("%d", oldSlot),
+		"depth":   data.Depth,
+	})
+	if data.Depth > uint64(flags.Get().ReorgWarningDepth) {
+		logger.Warn("Chain reorg occurred")
+	} else {
+		logger.Debug("Chain reorg occurred")
+	}
+	reorgCount.Inc()
+	reorgDepth.Observe(float64(data.Depth))
+	s.recordReorg(data)
+	s.notifyReorg(data)
+}
+
+// This keeps a reorg in the recent reorgs, dropping the oldest one when they are full.
+func (s *Service) recordReorg(data *statefeed.ReorgData) {
+	s.recentReorgsLock.Lock()
+	defer s.recentReorgsLock.Unlock()
+	if len(s.recentReorgs) == recentReorgsSize {
+		s.recentReorgs = append(s.recentReorgs[:0], s.recentReorgs[1:]...)
+	}
+	s.recentReorgs = append(s.recentReorgs, data)
+}
+
+// This returns the root and slot of the closest common ancestor of the two blocks, walking up
+// the ancestors of the first block in fork choice.
+func (s *Service) commonAncestor(ctx context.Context, root, otherRoot [32]byte) ([32]byte, types.Slot, error) {
+	n := s.cfg.ForkChoiceStore.Node(root)
+	if n == nil {
+		return [32]byte{}, 0, errors.New("block is not in fork choice")
+	}
+	nodes := s.cfg.ForkChoiceStore.Nodes()
+	for {
+		ancestor, err := s.cfg.ForkChoiceStore.AncestorRoot(ctx, otherRoot, n.Slot())
+		if err != nil {
+			return [32]byte{}, 0, err
+		}
+		if bytesutil.ToBytes32(ancestor) == n.Root() {
+			return n.Root(), n.Slot(), nil
+		}
+		if n.Parent() >= uint64(len(nodes)) {
+			return [32]byte{}, 0, errors.New("no common ancestor in fork choice")
+		}
+		n = nodes[n.Parent()]
+	}
+}
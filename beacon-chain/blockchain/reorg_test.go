@@ -0,0 +1,99 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// setupReorgForkChoice inserts blocks A(1) - B(2) - C(3) and D(4) into fork choice, both
+// branches descending from G(0).
+func setupReorgForkChoice(t *testing.T, service *Service) {
+	ctx := context.Background()
+	blocks := []struct {
+		slot         types.Slot
+		root, parent [32]byte
+	}{
+		{0, [32]byte{'G'}, params.BeaconConfig().ZeroHash},
+		{1, [32]byte{'A'}, [32]byte{'G'}},
+		{2, [32]byte{'B'}, [32]byte{'A'}},
+		{3, [32]byte{'C'}, [32]byte{'B'}},
+		{4, [32]byte{'D'}, [32]byte{'G'}},
+	}
+	for _, b := range blocks {
+		require.NoError(t, service.cfg.ForkChoiceStore.ProcessBlock(ctx, b.slot, b.root, b.parent, [32]byte{}, 0, 0))
+	}
+}
+
+func TestService_checkReorg(t *testing.T) {
+	resetFlags := flags.Get()
+	reorgFlags := *resetFlags
+	reorgFlags.ReorgWarningDepth = 2
+	flags.Init(&reorgFlags)
+	defer func() {
+		flags.Init(resetFlags)
+	}()
+
+	ctx := context.Background()
+	hook := logTest.NewGlobal()
+	service := setupBeaconChain(t, testDB.SetupDB(t))
+	setupReorgForkChoice(t, service)
+	reorgs := make(chan *statefeed.ReorgData, 1)
+	sub := service.SubscribeReorg(reorgs)
+	defer sub.Unsubscribe()
+
+	// A head moving to a descendant which is not its child is not a reorg.
+	service.checkReorg(ctx, [32]byte{'A'}, 1, [32]byte{'C'}, 3)
+	require.LogsDoNotContain(t, hook, "Chain reorg occurred")
+	assert.Equal(t, 0, len(reorgs))
+
+	service.checkReorg(ctx, [32]byte{'B'}, 2, [32]byte{'D'}, 4)
+	reorg := <-reorgs
+	assert.Equal(t, [32]byte{'G'}, reorg.CommonAncestor)
+	assert.Equal(t, uint64(2), reorg.Depth)
+	require.LogsContain(t, hook, "Chain reorg occurred")
+	assert.Equal(t, logrus.DebugLevel, hook.LastEntry().Level)
+
+	// Reorgs deeper than the warning depth are logged as warnings.
+	hook.Reset()
+	service.checkReorg(ctx, [32]byte{'C'}, 3, [32]byte{'D'}, 4)
+	reorg = <-reorgs
+	assert.Equal(t, uint64(3), reorg.Depth)
+	require.LogsContain(t, hook, "Chain reorg occurred")
+	assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+}
+
+func TestService_RecentReorgs(t *testing.T) {
+	ctx := context.Background()
+	service := setupBeaconChain(t, testDB.SetupDB(t))
+	setupReorgForkChoice(t, service)
+
+	service.checkReorg(ctx, [32]byte{'A'}, 1, [32]byte{'C'}, 3)
+	assert.Equal(t, 0, len(service.RecentReorgs()))
+	service.checkReorg(ctx, [32]byte{'B'}, 2, [32]byte{'D'}, 4)
+	service.checkReorg(ctx, [32]byte{'D'}, 4, [32]byte{'C'}, 3)
+	reorgs := service.RecentReorgs()
+	require.Equal(t, 2, len(reorgs))
+	assert.Equal(t, [32]byte{'D'}, reorgs[0].NewRoot)
+	assert.Equal(t, [32]byte{'C'}, reorgs[1].NewRoot)
+	assert.Equal(t, uint64(4), reorgs[1].Depth)
+	assert.Equal(t, false, reorgs[1].Time.IsZero())
+
+	// The oldest reorgs are dropped.
+	for i := 0; i < recentReorgsSize; i++ {
+		service.recordReorg(&statefeed.ReorgData{NewSlot: types.Slot(i)})
+	}
+	reorgs = service.RecentReorgs()
+	require.Equal(t, recentReorgsSize, len(reorgs))
+	assert.Equal(t, types.Slot(0), reorgs[0].NewSlot)
+	assert.Equal(t, types.Slot(recentReorgsSize-1), reorgs[recentReorgsSize-1].NewSlot)
+}
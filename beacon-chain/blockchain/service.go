@@ -32,8 +32,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -45,26 +47,38 @@ const headSyncMinEpochsAfterCheckpoint = 128
 // Service represents a service that handles the internal
 // logic of managing the full PoS beacon chain.
 type Service struct {
-	cfg                   *Config
-	ctx                   context.Context
-	cancel                context.CancelFunc
-	genesisTime           time.Time
-	head                  *head
-	headLock              sync.RWMutex
-	genesisRoot           [32]byte
-	justifiedCheckpt      *ethpb.Checkpoint
-	prevJustifiedCheckpt  *ethpb.Checkpoint
-	bestJustifiedCheckpt  *ethpb.Checkpoint
-	finalizedCheckpt      *ethpb.Checkpoint
-	prevFinalizedCheckpt  *ethpb.Checkpoint
-	nextEpochBoundarySlot types.Slot
-	boundaryRoots         [][32]byte
-	checkpointStateCache  *cache.CheckpointStateCache
-	initSyncBlocks        map[[32]byte]*ethpb.SignedBeaconBlock
-	initSyncBlocksLock    sync.RWMutex
-	justifiedBalances     []uint64
-	justifiedBalancesLock sync.RWMutex
-	wsVerified            bool
+	cfg                     *Config
+	ctx                     context.Context
+	cancel                  context.CancelFunc
+	genesisTime             time.Time
+	head                    *head
+	headLock                sync.RWMutex
+	genesisRoot             [32]byte
+	justifiedCheckpt        *ethpb.Checkpoint
+	prevJustifiedCheckpt    *ethpb.Checkpoint
+	bestJustifiedCheckpt    *ethpb.Checkpoint
+	finalizedCheckpt        *ethpb.Checkpoint
+	prevFinalizedCheckpt    *ethpb.Checkpoint
+	nextEpochBoundarySlot   types.Slot
+	boundaryRoots           [][32]byte
+	checkpointStateCache    *cache.CheckpointStateCache
+	exitingValsCache        *cache.ExitingValidatorsCache
+	withdrawalCredsCache    *cache.WithdrawalCredentialsCache
+	recentStateCache        *cache.RecentStateCache
+	epochBoundaryStateCache *cache.EpochBoundaryStateCache
+	initSyncBlocks          map[[32]byte]*ethpb.SignedBeaconBlock
+	initSyncBlocksLock      sync.RWMutex
+	justifiedBalances       []uint64
+	justifiedBalancesLock   sync.RWMutex
+	wsVerified              bool
+	seenProposals           map[types.Slot][]*ethpb.SignedBeaconBlockHeader
+	seenProposalsLock       sync.RWMutex
+	chainEvents             chainEventFeeds
+	recentReorgs            []*statefeed.ReorgData
+	recentReorgsLock        sync.RWMutex
+	queuedBlocks            []*queuedBlock
+	blockAdmissionBusy      bool
+	blockAdmissionLock      sync.Mutex
 }
 
 // Config options for the service.
@@ -77,6 +91,7 @@ type Config struct {
 	ExitPool          voluntaryexits.PoolManager
 	SlashingPool      slashings.PoolManager
 	P2p               p2p.Broadcaster
+	PeersFetcher      p2p.PeersProvider
 	MaxRoutines       int
 	StateNotifier     statefeed.Notifier
 	ForkChoiceStore   f.ForkChoicer
@@ -84,21 +99,42 @@ type Config struct {
 	StateGen          *stategen.State
 	WspBlockRoot      []byte
 	WspEpoch          types.Epoch
+	ReadReplica       bool
+	// FinalityStallEpochs is the number of epochs since the finalized epoch after which finality
+	// is considered stalled. Zero disables the finality watchdog.
+	FinalityStallEpochs types.Epoch
+	// ForkChoicePruneThreshold is the number of fork choice nodes before the finalized block
+	// required to prune them. Zero keeps the default threshold of the store.
+	ForkChoicePruneThreshold uint64
+	// NextSlotCacheHeads is the number of heaviest fork choice heads whose states are advanced to
+	// the next slot at the start of every slot. Zero disables it.
+	NextSlotCacheHeads int
+	// IndexAttInclusions indexes the attestation inclusions of validators in the DB at block import.
+	IndexAttInclusions bool
 }
 
 // NewService instantiates a new block service instance that will
 // be registered into a running beacon node.
 func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
-	return &Service{
-		cfg:                  cfg,
-		ctx:                  ctx,
-		cancel:               cancel,
-		boundaryRoots:        [][32]byte{},
-		checkpointStateCache: cache.NewCheckpointStateCache(),
-		initSyncBlocks:       make(map[[32]byte]*ethpb.SignedBeaconBlock),
-		justifiedBalances:    make([]uint64, 0),
-	}, nil
+	s := &Service{
+		cfg:                     cfg,
+		ctx:                     ctx,
+		cancel:                  cancel,
+		boundaryRoots:           [][32]byte{},
+		checkpointStateCache:    cache.NewCheckpointStateCache(),
+		exitingValsCache:        cache.NewExitingValidatorsCache(),
+		withdrawalCredsCache:    cache.NewWithdrawalCredentialsCache(),
+		recentStateCache:        cache.NewRecentStateCache(),
+		epochBoundaryStateCache: cache.NewEpochBoundaryStateCache(),
+		initSyncBlocks:          make(map[[32]byte]*ethpb.SignedBeaconBlock),
+		seenProposals:           make(map[types.Slot][]*ethpb.SignedBeaconBlockHeader),
+		justifiedBalances:       make([]uint64, 0),
+	}
+	cache.DefaultRegistry.Register("checkpoint-state", s.checkpointStateCache)
+	cache.DefaultRegistry.Register("recent-state", s.recentStateCache)
+	cache.DefaultRegistry.Register("epoch-boundary-state", s.epochBoundaryStateCache)
+	return s, nil
 }
 
 // Start a blockchain service's main event loop.
@@ -143,6 +179,9 @@ func (s *Service) Start() {
 		if err := s.initializeChainInfo(s.ctx); err != nil {
 			log.Fatalf("Could not set up chain info: %v", err)
 		}
+		if err := s.loadCommitteeCache(s.ctx); err != nil {
+			log.WithError(err).Warn("Could not restore committee cache")
+		}
 
 		// We start a counter to genesis, if needed.
 		gState, err := s.cfg.BeaconDB.GenesisState(s.ctx)
@@ -153,6 +192,11 @@ func (s *Service) Start() {
 		if err != nil {
 			log.Fatalf("Could not hash tree root genesis state: %v", err)
 		}
+		if s.genesisTime.After(timeutils.Now()) {
+			if err := cacheGenesisDuties(gState); err != nil {
+				log.WithError(err).Warn("Could not compute genesis duties")
+			}
+		}
 		go slotutil.CountdownToGenesis(s.ctx, s.genesisTime, uint64(gState.NumValidators()), gRoot)
 
 		justifiedCheckpoint, err := s.cfg.BeaconDB.JustifiedCheckpoint(s.ctx)
@@ -238,6 +282,16 @@ func (s *Service) Start() {
 	}
 
 	go s.processAttestationsRoutine(attestationProcessorSubscribed)
+	if featureconfig.Get().EnableStatePrehashing {
+		go s.prehashHeadStateRoutine()
+	}
+	if s.cfg.FinalityStallEpochs > 0 {
+		go s.finalityWatchdogRoutine()
+	}
+	if featureconfig.Get().EnableNextSlotStateCache && s.cfg.NextSlotCacheHeads > 0 {
+		state.SetNextSlotCacheSize(s.cfg.NextSlotCacheHeads)
+		go s.advanceHeadsRoutine()
+	}
 }
 
 // processChainStartTime initializes a series of deposits from the ChainStart deposits in the eth1
@@ -293,11 +347,7 @@ func (s *Service) initializeBeaconChain(
 	// Clear out all pre-genesis data now that the state is initialized.
 	s.cfg.ChainStartFetcher.ClearPreGenesisData()
 
-	// Update committee shuffled indices for genesis epoch.
-	if err := helpers.UpdateCommitteeCache(genesisState, 0 /* genesis epoch */); err != nil {
-		return nil, err
-	}
-	if err := helpers.UpdateProposerIndicesInCache(genesisState); err != nil {
+	if err := cacheGenesisDuties(genesisState); err != nil {
 		return nil, err
 	}
 
@@ -306,6 +356,19 @@ func (s *Service) initializeBeaconChain(
 	return genesisState, nil
 }
 
+// cacheGenesisDuties computes the committees of the genesis epoch and the epoch after it,
+// along with the proposers of the genesis epoch, so that validators can retrieve their
+// duties ahead of genesis rather than all at once when the chain starts.
+func cacheGenesisDuties(genesisState iface.BeaconState) error {
+	if err := helpers.UpdateCommitteeCache(genesisState, 0 /* genesis epoch */); err != nil {
+		return err
+	}
+	if err := helpers.UpdateCommitteeCache(genesisState, 1); err != nil {
+		return err
+	}
+	return helpers.UpdateProposerIndicesInCache(genesisState)
+}
+
 // Stop the blockchain service's main event loop and associated goroutines.
 func (s *Service) Stop() error {
 	defer s.cancel()
@@ -316,8 +379,15 @@ func (s *Service) Stop() error {
 		}
 	}
 
-	// Save initial sync cached blocks to the DB before stop.
-	return s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks())
+	if err := s.saveCommitteeCache(s.ctx); err != nil {
+		log.WithError(err).Warn("Could not persist committee cache")
+	}
+
+	// Save initial sync cached and batched blocks to the DB before stop.
+	if err := s.cfg.BeaconDB.SaveBlocks(s.ctx, s.getInitSyncBlocks()); err != nil {
+		return err
+	}
+	return s.cfg.BeaconDB.FlushBatchedWrites(s.ctx)
 }
 
 // Status always returns nil unless there is an error condition that causes
@@ -459,8 +529,17 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 // This is called when a client starts from non-genesis slot. This passes last justified and finalized
 // information to fork choice service to initializes fork choice store.
 func (s *Service) resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint *ethpb.Checkpoint) {
+	s.cfg.ForkChoiceStore = s.newForkChoiceStore(justifiedCheckpoint, finalizedCheckpoint)
+}
+
+// This initializes a fork choice store from the justified and finalized checkpoints, with the
+// configured prune threshold.
+func (s *Service) newForkChoiceStore(justifiedCheckpoint, finalizedCheckpoint *ethpb.Checkpoint) *protoarray.ForkChoice {
 	store := protoarray.New(justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch, bytesutil.ToBytes32(finalizedCheckpoint.Root))
-	s.cfg.ForkChoiceStore = store
+	if s.cfg.ForkChoicePruneThreshold > 0 {
+		store.Store().SetPruneThreshold(s.cfg.ForkChoicePruneThreshold)
+	}
+	return store
 }
 
 // This returns true if block has been processed before. Two ways to verify the block has been processed:
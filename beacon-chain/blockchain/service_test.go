@@ -252,6 +252,20 @@ func TestChainService_CorrectGenesisRoots(t *testing.T) {
 
 }
 
+func TestCacheGenesisDuties(t *testing.T) {
+	helpers.ClearCache()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+
+	entries, err := helpers.CommitteeCacheEntries(st, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(entries))
+
+	require.NoError(t, cacheGenesisDuties(st))
+	entries, err = helpers.CommitteeCacheEntries(st, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(entries), "Expected committees of the genesis epoch and the next epoch to be cached")
+}
+
 func TestChainService_InitializeChainInfo(t *testing.T) {
 	beaconDB := testDB.SetupDB(t)
 	ctx := context.Background()
@@ -464,6 +478,30 @@ func TestServiceStop_SaveCachedBlocks(t *testing.T) {
 	require.Equal(t, true, s.cfg.BeaconDB.HasBlock(ctx, r))
 }
 
+func TestService_CommitteeCachePersistence(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := &Service{cfg: &Config{BeaconDB: beaconDB}}
+	// Nothing is persisted without a head state.
+	require.NoError(t, s.saveCommitteeCache(ctx))
+	require.NoError(t, s.loadCommitteeCache(ctx))
+
+	helpers.ClearCache()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	s.head = &head{state: st}
+	require.NoError(t, helpers.UpdateCommitteeCache(st, helpers.CurrentEpoch(st)))
+	want, err := helpers.CommitteeCacheEntries(st, helpers.CurrentEpoch(st))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(want))
+	require.NoError(t, s.saveCommitteeCache(ctx))
+
+	helpers.ClearCache()
+	require.NoError(t, s.loadCommitteeCache(ctx))
+	got, err := helpers.CommitteeCacheEntries(st, helpers.CurrentEpoch(st))
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+}
+
 func TestProcessChainStartTime_ReceivedFeed(t *testing.T) {
 	beaconDB := testDB.SetupDB(t)
 	service := setupBeaconChain(t, beaconDB)
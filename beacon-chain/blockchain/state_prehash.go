@@ -0,0 +1,62 @@
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"go.opencensus.io/trace"
+)
+
+// prehashHeadStateRoutine pre-computes the hash tree root of the head state at the start
+// of every slot, while the node waits for the block of the slot. The head state is the
+// pre-state of the next block, so hashing its dirty fields ahead of time leaves an almost
+// clean trie to the hash tree root computed when processing the slot of the block.
+func (s *Service) prehashHeadStateRoutine() {
+	for s.genesisTime.IsZero() {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+
+	ticker := slotutil.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case slot := <-ticker.C():
+			s.prehashHeadState(s.ctx, slot)
+		}
+	}
+}
+
+// prehashHeadState computes the hash tree root of the head state, unless the block of
+// the current slot has already been received. It returns whether the head state was hashed.
+func (s *Service) prehashHeadState(ctx context.Context, slot types.Slot) bool {
+	ctx, span := trace.StartSpan(ctx, "blockChain.prehashHeadState")
+	defer span.End()
+
+	s.headLock.RLock()
+	if !s.hasHeadState() || s.headSlot() >= slot {
+		s.headLock.RUnlock()
+		statePrehashSkippedCount.Inc()
+		return false
+	}
+	headState := s.head.state
+	s.headLock.RUnlock()
+
+	start := timeutils.Now()
+	if _, err := headState.HashTreeRoot(ctx); err != nil {
+		log.WithError(err).Debug("Could not pre-hash head state")
+		return false
+	}
+	statePrehashCount.Inc()
+	statePrehashLatency.Observe(float64(timeutils.Since(start).Milliseconds()))
+	return true
+}
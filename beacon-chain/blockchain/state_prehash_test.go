@@ -0,0 +1,41 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+// hashCountingState counts the hash tree root computations of the wrapped state.
+type hashCountingState struct {
+	iface.BeaconState
+	hashed int
+}
+
+func (s *hashCountingState) HashTreeRoot(ctx context.Context) ([32]byte, error) {
+	s.hashed++
+	return s.BeaconState.HashTreeRoot(ctx)
+}
+
+func TestService_PrehashHeadState(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 8)
+	headState := &hashCountingState{BeaconState: st}
+	service := &Service{head: &head{slot: 5, state: headState}}
+
+	// The block of the slot has been received.
+	assert.Equal(t, false, service.prehashHeadState(ctx, 5))
+	assert.Equal(t, 0, headState.hashed)
+
+	// Still waiting for the block of the slot.
+	assert.Equal(t, true, service.prehashHeadState(ctx, 6))
+	assert.Equal(t, 1, headState.hashed)
+}
+
+func TestService_PrehashHeadState_NoHeadState(t *testing.T) {
+	service := &Service{}
+	assert.Equal(t, false, service.prehashHeadState(context.Background(), 1))
+}
@@ -17,11 +17,13 @@ import (
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -46,10 +48,13 @@ type ChainService struct {
 	stateNotifier               statefeed.Notifier
 	blockNotifier               blockfeed.Notifier
 	opNotifier                  opfeed.Notifier
+	chainEvents                 *MockChainEvents
 	ValidAttestation            bool
 	ForkChoiceStore             *protoarray.Store
 	VerifyBlkDescendantErr      error
+	DoubleProposalErr           error
 	Slot                        *types.Slot // Pointer because 0 is a useful value, so checking against it can be incorrect.
+	Reorgs                      []*statefeed.ReorgData
 }
 
 // StateNotifier mocks the same method in the chain service.
@@ -134,6 +139,47 @@ func (s *ChainService) OperationNotifier() opfeed.Notifier {
 	return s.opNotifier
 }
 
+// MockChainEvents holds the feeds behind the typed chain event subscriptions of the mock.
+type MockChainEvents struct {
+	HeadChanged   event.Feed
+	Finalized     event.Feed
+	Reorg         event.Feed
+	BlockImported event.Feed
+}
+
+// ChainEvents returns the feeds of typed chain events, which tests can send events to.
+func (s *ChainService) ChainEvents() *MockChainEvents {
+	if s.chainEvents == nil {
+		s.chainEvents = &MockChainEvents{}
+	}
+	return s.chainEvents
+}
+
+// SubscribeHeadChanged mocks the same method in the chain service.
+func (s *ChainService) SubscribeHeadChanged(ch chan<- *statefeed.HeadChangedData) event.Subscription {
+	return s.ChainEvents().HeadChanged.Subscribe(ch)
+}
+
+// SubscribeFinalized mocks the same method in the chain service.
+func (s *ChainService) SubscribeFinalized(ch chan<- *statefeed.CheckpointData) event.Subscription {
+	return s.ChainEvents().Finalized.Subscribe(ch)
+}
+
+// SubscribeReorg mocks the same method in the chain service.
+func (s *ChainService) SubscribeReorg(ch chan<- *statefeed.ReorgData) event.Subscription {
+	return s.ChainEvents().Reorg.Subscribe(ch)
+}
+
+// RecentReorgs mocks the same method in the chain service.
+func (s *ChainService) RecentReorgs() []*statefeed.ReorgData {
+	return s.Reorgs
+}
+
+// SubscribeBlockImported mocks the same method in the chain service.
+func (s *ChainService) SubscribeBlockImported(ch chan<- *statefeed.BlockProcessedData) event.Subscription {
+	return s.ChainEvents().BlockImported.Subscribe(ch)
+}
+
 // MockOperationNotifier mocks the operation notifier.
 type MockOperationNotifier struct {
 	feed *event.Feed
@@ -291,6 +337,19 @@ func (s *ChainService) AttestationPreState(_ context.Context, _ *ethpb.Attestati
 	return s.State, nil
 }
 
+// EpochBoundaryState mocks EpochBoundaryState method in chain service.
+func (s *ChainService) EpochBoundaryState(ctx context.Context, _ [32]byte, epoch types.Epoch) (iface.BeaconState, error) {
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	st := s.State.Copy()
+	if st.Slot() >= startSlot {
+		return st, nil
+	}
+	return state.ProcessSlots(ctx, st, startSlot)
+}
+
 // HeadValidatorsIndices mocks the same method in the chain service.
 func (s *ChainService) HeadValidatorsIndices(_ context.Context, epoch types.Epoch) ([]types.ValidatorIndex, error) {
 	if s.State == nil {
@@ -367,6 +426,37 @@ func (s *ChainService) VerifyBlkDescendant(_ context.Context, _ [32]byte) error
 	return s.VerifyBlkDescendantErr
 }
 
+// VerifyNotDoubleProposal mocks VerifyNotDoubleProposal and returns DoubleProposalErr.
+func (s *ChainService) VerifyNotDoubleProposal(_ context.Context, _ *ethpb.BeaconBlock) error {
+	return s.DoubleProposalErr
+}
+
+// RecomputeHead mocks RecomputeHead and returns Root.
+func (s *ChainService) RecomputeHead(_ context.Context) ([32]byte, error) {
+	return bytesutil.ToBytes32(s.Root), nil
+}
+
+// SetHead mocks SetHead and sets Root to the given root.
+func (s *ChainService) SetHead(_ context.Context, root [32]byte) error {
+	s.Root = root[:]
+	return nil
+}
+
+// ValidatorsByWithdrawalCredentials mocks ValidatorsByWithdrawalCredentials by scanning the registry of State.
+func (s *ChainService) ValidatorsByWithdrawalCredentials(_ context.Context, creds [32]byte) ([]types.ValidatorIndex, error) {
+	indices := make([]types.ValidatorIndex, 0)
+	if s.State == nil {
+		return indices, nil
+	}
+	err := s.State.ReadFromEveryValidator(func(idx int, val iface.ReadOnlyValidator) error {
+		if bytesutil.ToBytes32(val.WithdrawalCredentials()) == creds {
+			indices = append(indices, types.ValidatorIndex(idx))
+		}
+		return nil
+	})
+	return indices, err
+}
+
 // VerifyLmdFfgConsistency mocks VerifyLmdFfgConsistency and always returns nil.
 func (s *ChainService) VerifyLmdFfgConsistency(_ context.Context, a *ethpb.Attestation) error {
 	if !bytes.Equal(a.Data.BeaconBlockRoot, a.Data.Target.Root) {
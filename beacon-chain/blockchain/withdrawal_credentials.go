@@ -0,0 +1,42 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// WithdrawalCredentialsFetcher looks up the validators of the registry sharing the same
+// withdrawal credentials, such as the validators of a staking service.
+type WithdrawalCredentialsFetcher interface {
+	ValidatorsByWithdrawalCredentials(ctx context.Context, creds [32]byte) ([]types.ValidatorIndex, error)
+}
+
+// ValidatorsByWithdrawalCredentials returns the indices of the validators of the head state
+// registry with the given withdrawal credentials, in increasing order. The index is extended
+// whenever a block with deposits is processed, and caught up with the head state otherwise.
+func (s *Service) ValidatorsByWithdrawalCredentials(ctx context.Context, creds [32]byte) ([]types.ValidatorIndex, error) {
+	headState, err := s.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		return nil, errors.New("head state is not available")
+	}
+	if s.withdrawalCredsCache.Count() < headState.NumValidators() {
+		if err := s.withdrawalCredsCache.Update(headState); err != nil {
+			return nil, errors.Wrap(err, "could not index withdrawal credentials")
+		}
+	}
+
+	// The index may be ahead of the head state when blocks of another fork were processed.
+	numValidators := types.ValidatorIndex(headState.NumValidators())
+	indices := s.withdrawalCredsCache.ValidatorIndices(creds)
+	for i, idx := range indices {
+		if idx >= numValidators {
+			return indices[:i], nil
+		}
+	}
+	return indices, nil
+}
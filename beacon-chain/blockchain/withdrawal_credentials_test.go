@@ -0,0 +1,45 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_ValidatorsByWithdrawalCredentials(t *testing.T) {
+	ctx := context.Background()
+	credsA := bytesutil.PadTo([]byte{'a'}, 32)
+	credsB := bytesutil.PadTo([]byte{'b'}, 32)
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetValidators([]*ethpb.Validator{
+		{WithdrawalCredentials: credsA},
+		{WithdrawalCredentials: credsB},
+		{WithdrawalCredentials: credsA},
+	}))
+	s, err := NewService(ctx, &Config{})
+	require.NoError(t, err)
+	s.setHead([32]byte{'a'}, testutil.NewBeaconBlock(), headState)
+
+	indices, err := s.ValidatorsByWithdrawalCredentials(ctx, bytesutil.ToBytes32(credsA))
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.ValidatorIndex{0, 2}, indices)
+
+	// Validators indexed from another fork are not part of the head state registry.
+	forkState := headState.Copy()
+	require.NoError(t, forkState.AppendValidator(&ethpb.Validator{WithdrawalCredentials: credsA}))
+	require.NoError(t, s.withdrawalCredsCache.Update(forkState))
+	indices, err = s.ValidatorsByWithdrawalCredentials(ctx, bytesutil.ToBytes32(credsA))
+	require.NoError(t, err)
+	assert.DeepEqual(t, []types.ValidatorIndex{0, 2}, indices)
+
+	indices, err = s.ValidatorsByWithdrawalCredentials(ctx, [32]byte{'c'})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(indices))
+}
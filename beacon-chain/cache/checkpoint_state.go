@@ -8,6 +8,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 )
 
@@ -30,8 +31,9 @@ var (
 
 // CheckpointStateCache is a struct with 1 queue for looking up state by checkpoint.
 type CheckpointStateCache struct {
-	cache *lru.Cache
-	lock  sync.RWMutex
+	access AccessCounter
+	cache  *lru.Cache
+	lock   sync.RWMutex
 }
 
 // NewCheckpointStateCache creates a new checkpoint state cache for storing/accessing processed state.
@@ -59,11 +61,13 @@ func (c *CheckpointStateCache) StateByCheckpoint(cp *ethpb.Checkpoint) (iface.Be
 
 	if exists && item != nil {
 		checkpointStateHit.Inc()
+		c.access.Hit()
 		// Copy here is unnecessary since the return will only be used to verify attestation signature.
 		return item.(iface.BeaconState), nil
 	}
 
 	checkpointStateMiss.Inc()
+	c.access.Miss()
 	return nil, nil
 }
 
@@ -76,6 +80,27 @@ func (c *CheckpointStateCache) AddCheckpointState(cp *ethpb.Checkpoint, s iface.
 	if err != nil {
 		return err
 	}
+	stateV0.MarkShared(s, "checkpoint-state-cache")
 	c.cache.Add(h, s)
 	return nil
 }
+
+// Stats returns the number of cached checkpoint states and their estimated size.
+func (c *CheckpointStateCache) Stats() *Stats {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	size := uint64(0)
+	for _, k := range c.cache.Keys() {
+		if item, ok := c.cache.Peek(k); ok {
+			size += EstimatedStateSize(item.(iface.ReadOnlyBeaconState))
+		}
+	}
+	return c.access.Stats(c.cache.Len(), size)
+}
+
+// Flush removes all the checkpoint states from the cache.
+func (c *CheckpointStateCache) Flush() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Purge()
+}
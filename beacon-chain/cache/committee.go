@@ -33,6 +33,7 @@ var (
 
 // CommitteeCache is a struct with 1 queue for looking up shuffled indices list by seed.
 type CommitteeCache struct {
+	access         AccessCounter
 	CommitteeCache *cache.FIFO
 	lock           sync.RWMutex
 }
@@ -67,8 +68,10 @@ func (c *CommitteeCache) Committee(slot types.Slot, seed [32]byte, index types.C
 
 	if exists {
 		CommitteeCacheHit.Inc()
+		c.access.Hit()
 	} else {
 		CommitteeCacheMiss.Inc()
+		c.access.Miss()
 		return nil, nil
 	}
 
@@ -116,8 +119,10 @@ func (c *CommitteeCache) ActiveIndices(seed [32]byte) ([]types.ValidatorIndex, e
 
 	if exists {
 		CommitteeCacheHit.Inc()
+		c.access.Hit()
 	} else {
 		CommitteeCacheMiss.Inc()
+		c.access.Miss()
 		return nil, nil
 	}
 
@@ -140,8 +145,10 @@ func (c *CommitteeCache) ActiveIndicesCount(seed [32]byte) (int, error) {
 
 	if exists {
 		CommitteeCacheHit.Inc()
+		c.access.Hit()
 	} else {
 		CommitteeCacheMiss.Inc()
+		c.access.Miss()
 		return 0, nil
 	}
 
@@ -153,12 +160,52 @@ func (c *CommitteeCache) ActiveIndicesCount(seed [32]byte) (int, error) {
 	return len(item.SortedIndices), nil
 }
 
+// CommitteesBySeed returns the shuffled committees of a given seed stored in cache.
+func (c *CommitteeCache) CommitteesBySeed(seed [32]byte) (*Committees, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	obj, exists, err := c.CommitteeCache.GetByKey(key(seed))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	item, ok := obj.(*Committees)
+	if !ok {
+		return nil, ErrNotCommittee
+	}
+	return item, nil
+}
+
 // HasEntry returns true if the committee cache has a value.
 func (c *CommitteeCache) HasEntry(seed string) bool {
 	_, ok, err := c.CommitteeCache.GetByKey(seed)
 	return err == nil && ok
 }
 
+// Stats returns the number of cached committees and the estimated size of their indices.
+func (c *CommitteeCache) Stats() *Stats {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	items := c.CommitteeCache.List()
+	size := uint64(0)
+	for _, obj := range items {
+		if item, ok := obj.(*Committees); ok {
+			size += uint64(len(item.ShuffledIndices)+len(item.SortedIndices)) * 8
+		}
+	}
+	return c.access.Stats(len(items), size)
+}
+
+// Flush removes all the committees from the cache.
+func (c *CommitteeCache) Flush() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	trim(c.CommitteeCache, 0)
+}
+
 func startEndIndices(c *Committees, index uint64) (uint64, uint64) {
 	validatorCount := uint64(len(c.ShuffledIndices))
 	start := sliceutil.SplitOffset(validatorCount, c.CommitteeCount, index)
@@ -46,7 +46,21 @@ func (c *FakeCommitteeCache) ProposerIndices(seed [32]byte) ([]types.ValidatorIn
 	return nil, nil
 }
 
+// CommitteesBySeed returns the shuffled committees of a given seed stored in cache.
+func (c *FakeCommitteeCache) CommitteesBySeed(seed [32]byte) (*Committees, error) {
+	return nil, nil
+}
+
 // HasEntry returns true if the committee cache has a value.
 func (c *FakeCommitteeCache) HasEntry(string) bool {
 	return false
 }
+
+// Stats returns empty stats, as nothing is cached.
+func (c *FakeCommitteeCache) Stats() *Stats {
+	return &Stats{}
+}
+
+// Flush is a no-op.
+func (c *FakeCommitteeCache) Flush() {
+}
@@ -0,0 +1,59 @@
+package cache
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// maxDepositSignatureCacheSize defines the max number of deposit signature verification results
+	// the cache can contain. At a maximum of 16 deposits per block this covers the deposits of
+	// several deposit-heavy epochs, which is what state regeneration typically replays.
+	maxDepositSignatureCacheSize = 4096
+
+	// Metrics.
+	depositSignatureCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "deposit_signature_cache_miss",
+		Help: "The number of deposit signature verification requests that aren't present in the cache.",
+	})
+	depositSignatureCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "deposit_signature_cache_hit",
+		Help: "The number of deposit signature verification requests that are present in the cache.",
+	})
+)
+
+// DepositSignatureCache is a bounded cache which stores the result of verifying the signature
+// of a deposit, keyed by the hash tree root of the deposit data and the signing domain.
+type DepositSignatureCache struct {
+	cache *lru.Cache
+}
+
+// NewDepositSignatureCache creates a new deposit signature cache for storing/accessing
+// deposit signature verification results.
+func NewDepositSignatureCache() *DepositSignatureCache {
+	cache, err := lru.New(maxDepositSignatureCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &DepositSignatureCache{
+		cache: cache,
+	}
+}
+
+// Get returns the cached verification result of the deposit signature for the given key.
+// The second return value is false if the key is not present in the cache.
+func (c *DepositSignatureCache) Get(key [32]byte) (bool, bool) {
+	item, exists := c.cache.Get(key)
+	if !exists || item == nil {
+		depositSignatureCacheMiss.Inc()
+		return false, false
+	}
+	depositSignatureCacheHit.Inc()
+	return item.(bool), true
+}
+
+// Add stores the verification result of the deposit signature for the given key.
+func (c *DepositSignatureCache) Add(key [32]byte, valid bool) {
+	c.cache.Add(key, valid)
+}
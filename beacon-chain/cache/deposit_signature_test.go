@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestDepositSignatureCache_AddGet(t *testing.T) {
+	c := NewDepositSignatureCache()
+	valid := bytesutil.ToBytes32([]byte("valid"))
+	invalid := bytesutil.ToBytes32([]byte("invalid"))
+
+	_, ok := c.Get(valid)
+	assert.Equal(t, false, ok, "Expected key not to exist in empty cache")
+
+	c.Add(valid, true)
+	c.Add(invalid, false)
+
+	res, ok := c.Get(valid)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, true, res)
+	res, ok = c.Get(invalid)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, false, res)
+}
+
+func TestDepositSignatureCache_Bounded(t *testing.T) {
+	c := NewDepositSignatureCache()
+	for i := 0; i < maxDepositSignatureCacheSize+1; i++ {
+		c.Add(bytesutil.ToBytes32(bytesutil.Bytes8(uint64(i))), true)
+	}
+	assert.Equal(t, maxDepositSignatureCacheSize, c.cache.Len())
+	_, ok := c.Get(bytesutil.ToBytes32(bytesutil.Bytes8(0)))
+	assert.Equal(t, false, ok, "Expected oldest entry to be evicted")
+}
@@ -116,6 +116,7 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 
 	depositTrie := dc.finalizedDeposits.Deposits
 	insertIndex := int(dc.finalizedDeposits.MerkleTrieIndex + 1)
+	var items [][]byte
 	for _, d := range dc.deposits {
 		if d.Index <= dc.finalizedDeposits.MerkleTrieIndex {
 			continue
@@ -128,8 +129,11 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 			log.WithError(err).Error("Could not hash deposit data. Finalized deposit cache not updated.")
 			return
 		}
-		depositTrie.Insert(depHash[:], insertIndex)
-		insertIndex++
+		items = append(items, depHash[:])
+	}
+	if err := depositTrie.InsertBatch(items, insertIndex); err != nil {
+		log.WithError(err).Error("Could not insert deposits into trie. Finalized deposit cache not updated.")
+		return
 	}
 
 	dc.finalizedDeposits = &FinalizedDeposits{
@@ -214,6 +218,16 @@ func (dc *DepositCache) FinalizedDeposits(ctx context.Context) *FinalizedDeposit
 	}
 }
 
+// FinalizedDepositIndex returns the index of the last finalized deposit, without copying the finalized deposits trie.
+func (dc *DepositCache) FinalizedDepositIndex(ctx context.Context) int64 {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.FinalizedDepositIndex")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	return dc.finalizedDeposits.MerkleTrieIndex
+}
+
 // NonFinalizedDeposits returns the list of non-finalized deposits until the given block number (inclusive).
 // If no block is specified then this method returns all non-finalized deposits.
 func (dc *DepositCache) NonFinalizedDeposits(ctx context.Context, untilBlk *big.Int) []*ethpb.Deposit {
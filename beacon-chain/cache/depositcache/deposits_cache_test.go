@@ -380,6 +380,7 @@ func TestFinalizedDeposits_DepositsCachedCorrectly(t *testing.T) {
 	cachedDeposits := dc.FinalizedDeposits(context.Background())
 	require.NotNil(t, cachedDeposits, "Deposits not cached")
 	assert.Equal(t, int64(2), cachedDeposits.MerkleTrieIndex)
+	assert.Equal(t, int64(2), dc.FinalizedDepositIndex(context.Background()))
 
 	var deps [][]byte
 	for _, d := range finalizedDeposits {
@@ -458,6 +459,7 @@ func TestFinalizedDeposits_InitializedCorrectly(t *testing.T) {
 	assert.NotNil(t, finalizedDeposits)
 	assert.NotNil(t, finalizedDeposits.Deposits)
 	assert.Equal(t, int64(-1), finalizedDeposits.MerkleTrieIndex)
+	assert.Equal(t, int64(-1), dc.FinalizedDepositIndex(context.Background()))
 }
 
 func TestNonFinalizedDeposits_ReturnsAllNonFinalizedDeposits(t *testing.T) {
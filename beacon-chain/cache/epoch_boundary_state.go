@@ -0,0 +1,102 @@
+package cache
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+)
+
+var (
+	// maxEpochBoundaryStateSize defines the max number of states the epoch boundary state cache can contain.
+	// Choosing 16 keeps the boundary states of a few forks over the two epochs attestations can target,
+	// along with the intermediate boundaries of targets several epochs past their block.
+	maxEpochBoundaryStateSize = 16
+
+	// Metrics.
+	epochBoundaryStateMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "epoch_boundary_state_cache_miss",
+		Help: "The number of epoch boundary state requests that aren't present in the cache.",
+	})
+	epochBoundaryStateHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "epoch_boundary_state_cache_hit",
+		Help: "The number of epoch boundary state requests that are present in the cache.",
+	})
+)
+
+// epochBoundaryKey identifies the state of a block advanced to the start slot of an epoch.
+type epochBoundaryKey struct {
+	root  [32]byte
+	epoch types.Epoch
+}
+
+// EpochBoundaryStateCache keeps the states of blocks advanced through empty slots to the start
+// slot of later epochs, keyed by block root and epoch. These are the states attestation targets
+// and committees are computed from. A state of a block at an earlier epoch boundary is reused to
+// reach a later one, rather than processing the slots again from the state of the block.
+type EpochBoundaryStateCache struct {
+	access AccessCounter
+	cache  *lru.Cache
+}
+
+// NewEpochBoundaryStateCache creates a new epoch boundary state cache.
+func NewEpochBoundaryStateCache() *EpochBoundaryStateCache {
+	cache, err := lru.New(maxEpochBoundaryStateSize)
+	if err != nil {
+		panic(err)
+	}
+	return &EpochBoundaryStateCache{
+		cache: cache,
+	}
+}
+
+// NearestState returns a copy of the state of the block root advanced to the latest cached epoch
+// boundary which is not after the given epoch, along with that epoch. It returns a nil state if
+// no boundary state of the block is cached up to the epoch.
+func (c *EpochBoundaryStateCache) NearestState(root [32]byte, epoch types.Epoch) (iface.BeaconState, types.Epoch) {
+	found := false
+	nearest := epochBoundaryKey{root: root}
+	for _, k := range c.cache.Keys() {
+		key := k.(epochBoundaryKey)
+		if key.root != root || key.epoch > epoch || (found && key.epoch <= nearest.epoch) {
+			continue
+		}
+		found, nearest.epoch = true, key.epoch
+	}
+	if found {
+		if item, exists := c.cache.Get(nearest); exists && item != nil {
+			epochBoundaryStateHit.Inc()
+			c.access.Hit()
+			return item.(iface.BeaconState).Copy(), nearest.epoch
+		}
+	}
+	epochBoundaryStateMiss.Inc()
+	c.access.Miss()
+	return nil, 0
+}
+
+// Put adds a copy of the state of the block root advanced to the start slot of the epoch to the
+// cache, evicting the least recently used state once the cache is full.
+func (c *EpochBoundaryStateCache) Put(root [32]byte, epoch types.Epoch, st iface.BeaconState) {
+	if st == nil {
+		return
+	}
+	c.cache.Add(epochBoundaryKey{root: root, epoch: epoch}, st.Copy())
+}
+
+// Stats returns the number of cached states and their estimated size.
+func (c *EpochBoundaryStateCache) Stats() *Stats {
+	size := uint64(0)
+	for _, k := range c.cache.Keys() {
+		if item, ok := c.cache.Peek(k); ok {
+			size += EstimatedStateSize(item.(iface.BeaconState))
+		}
+	}
+	return c.access.Stats(c.cache.Len(), size)
+}
+
+// Flush removes all the states from the cache.
+func (c *EpochBoundaryStateCache) Flush() {
+	c.cache.Purge()
+}
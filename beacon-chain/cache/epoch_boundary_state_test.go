@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestEpochBoundaryStateCache_NearestState(t *testing.T) {
+	c := NewEpochBoundaryStateCache()
+	root := [32]byte{'a'}
+	st, e := c.NearestState(root, 5)
+	assert.Equal(t, iface.BeaconState(nil), st, "Expected state not to exist in empty cache")
+	assert.Equal(t, types.Epoch(0), e)
+
+	for _, epoch := range []types.Epoch{2, 4} {
+		st, err := stateV0.InitializeFromProto(&pb.BeaconState{
+			GenesisValidatorsRoot: params.BeaconConfig().ZeroHash[:],
+			Slot:                  params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch)),
+		})
+		require.NoError(t, err)
+		c.Put(root, epoch, st)
+	}
+
+	st, _ = c.NearestState(root, 1)
+	assert.Equal(t, iface.BeaconState(nil), st, "Expected no state before the earliest cached epoch")
+	st, e = c.NearestState(root, 3)
+	require.NotNil(t, st)
+	assert.Equal(t, types.Epoch(2), e)
+	st, e = c.NearestState(root, 4)
+	require.NotNil(t, st)
+	assert.Equal(t, types.Epoch(4), e)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch.Mul(4), st.Slot())
+	st, _ = c.NearestState([32]byte{'b'}, 4)
+	assert.Equal(t, iface.BeaconState(nil), st, "Expected no state of another root")
+
+	// Mutating a returned state does not leak into the cache.
+	st, _ = c.NearestState(root, 10)
+	require.NoError(t, st.SetSlot(1))
+	st, e = c.NearestState(root, 10)
+	assert.Equal(t, types.Epoch(4), e)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch.Mul(4), st.Slot())
+
+	stats := c.Stats()
+	assert.Equal(t, 2, stats.Entries)
+	assert.Equal(t, uint64(4), stats.Hits)
+	assert.Equal(t, uint64(3), stats.Misses)
+	c.Flush()
+	assert.Equal(t, 0, c.Stats().Entries)
+}
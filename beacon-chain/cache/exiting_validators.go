@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ExitingValidator is a validator which initiated its exit, along with the epochs at which it
+// exits and becomes withdrawable.
+type ExitingValidator struct {
+	Index             types.ValidatorIndex
+	PublicKey         [48]byte
+	ExitEpoch         types.Epoch
+	WithdrawableEpoch types.Epoch
+}
+
+// ExitingValidatorsCache indexes the validators of the registry which initiated their exit by
+// exit epoch. It is rebuilt from a single scan of the registry at every epoch transition, as
+// exit epochs are only ever assigned during block and epoch processing.
+type ExitingValidatorsCache struct {
+	validators []*ExitingValidator
+	epoch      types.Epoch
+	populated  bool
+	lock       sync.RWMutex
+}
+
+// NewExitingValidatorsCache creates an empty exiting validators cache.
+func NewExitingValidatorsCache() *ExitingValidatorsCache {
+	return &ExitingValidatorsCache{}
+}
+
+// Update rebuilds the index from the registry of the given state.
+func (c *ExitingValidatorsCache) Update(st iface.ReadOnlyBeaconState) error {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	validators := make([]*ExitingValidator, 0)
+	if err := st.ReadFromEveryValidator(func(idx int, val iface.ReadOnlyValidator) error {
+		if val.ExitEpoch() == farFutureEpoch {
+			return nil
+		}
+		validators = append(validators, &ExitingValidator{
+			Index:             types.ValidatorIndex(idx),
+			PublicKey:         val.PublicKey(),
+			ExitEpoch:         val.ExitEpoch(),
+			WithdrawableEpoch: val.WithdrawableEpoch(),
+		})
+		return nil
+	}); err != nil {
+		return err
+	}
+	sort.SliceStable(validators, func(i, j int) bool {
+		return validators[i].ExitEpoch < validators[j].ExitEpoch
+	})
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.validators = validators
+	c.epoch = types.Epoch(st.Slot() / params.BeaconConfig().SlotsPerEpoch)
+	c.populated = true
+	return nil
+}
+
+// Epoch returns the epoch of the state the index was last built from, and false if the index
+// was never built.
+func (c *ExitingValidatorsCache) Epoch() (types.Epoch, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.epoch, c.populated
+}
+
+// InEpochWindow returns the validators whose exit epoch or withdrawable epoch falls within the
+// inclusive window from start to end epoch, ordered by exit epoch.
+func (c *ExitingValidatorsCache) InEpochWindow(start, end types.Epoch) []*ExitingValidator {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if start > end {
+		return []*ExitingValidator{}
+	}
+	// The withdrawable epoch of a validator is never before its exit epoch, so no validator
+	// exiting after the end of the window can be part of it.
+	n := sort.Search(len(c.validators), func(i int) bool {
+		return c.validators[i].ExitEpoch > end
+	})
+	result := make([]*ExitingValidator, 0)
+	for _, v := range c.validators[:n] {
+		if v.ExitEpoch >= start || (v.WithdrawableEpoch >= start && v.WithdrawableEpoch <= end) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestExitingValidatorsCache_InEpochWindow(t *testing.T) {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	validators := []*ethpb.Validator{
+		{ExitEpoch: farFutureEpoch, WithdrawableEpoch: farFutureEpoch},
+		{ExitEpoch: 20, WithdrawableEpoch: 276},
+		{ExitEpoch: 5, WithdrawableEpoch: 261},
+		{ExitEpoch: 10, WithdrawableEpoch: 12},
+		{ExitEpoch: 300, WithdrawableEpoch: 556},
+	}
+	st, err := stateV0.InitializeFromProto(&pb.BeaconState{
+		Slot:       params.BeaconConfig().SlotsPerEpoch * 3,
+		Validators: validators,
+	})
+	require.NoError(t, err)
+
+	c := NewExitingValidatorsCache()
+	_, ok := c.Epoch()
+	assert.Equal(t, false, ok, "Expected empty cache not to be populated")
+	assert.Equal(t, 0, len(c.InEpochWindow(0, farFutureEpoch)))
+
+	require.NoError(t, c.Update(st))
+	epoch, ok := c.Epoch()
+	assert.Equal(t, true, ok)
+	assert.Equal(t, types.Epoch(3), epoch)
+
+	tests := []struct {
+		name       string
+		start, end types.Epoch
+		want       []types.ValidatorIndex
+	}{
+		{name: "all exits", start: 0, end: farFutureEpoch - 1, want: []types.ValidatorIndex{2, 3, 1, 4}},
+		{name: "exit epochs", start: 5, end: 10, want: []types.ValidatorIndex{2, 3}},
+		{name: "withdrawable epoch", start: 11, end: 12, want: []types.ValidatorIndex{3}},
+		{name: "exit and withdrawable epochs", start: 250, end: 300, want: []types.ValidatorIndex{2, 1, 4}},
+		{name: "empty window", start: 100, end: 200, want: []types.ValidatorIndex{}},
+		{name: "inverted window", start: 10, end: 5, want: []types.ValidatorIndex{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indices := make([]types.ValidatorIndex, 0)
+			for _, v := range c.InEpochWindow(tt.start, tt.end) {
+				indices = append(indices, v.Index)
+			}
+			assert.DeepEqual(t, tt.want, indices)
+		})
+	}
+}
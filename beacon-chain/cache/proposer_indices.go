@@ -30,6 +30,7 @@ var (
 
 // ProposerIndicesCache is a struct with 1 queue for looking up proposer indices by root.
 type ProposerIndicesCache struct {
+	access               AccessCounter
 	ProposerIndicesCache *cache.FIFO
 	lock                 sync.RWMutex
 }
@@ -86,8 +87,10 @@ func (c *ProposerIndicesCache) ProposerIndices(r [32]byte) ([]types.ValidatorInd
 
 	if exists {
 		ProposerIndicesCacheHit.Inc()
+		c.access.Hit()
 	} else {
 		ProposerIndicesCacheMiss.Inc()
+		c.access.Miss()
 		return nil, nil
 	}
 
@@ -98,3 +101,24 @@ func (c *ProposerIndicesCache) ProposerIndices(r [32]byte) ([]types.ValidatorInd
 
 	return item.ProposerIndices, nil
 }
+
+// Stats returns the number of cached proposer index assignments and their estimated size.
+func (c *ProposerIndicesCache) Stats() *Stats {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	items := c.ProposerIndicesCache.List()
+	size := uint64(0)
+	for _, obj := range items {
+		if item, ok := obj.(*ProposerIndices); ok {
+			size += uint64(len(item.ProposerIndices))*8 + 32
+		}
+	}
+	return c.access.Stats(len(items), size)
+}
+
+// Flush removes all the proposer index assignments from the cache.
+func (c *ProposerIndicesCache) Flush() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	trim(c.ProposerIndicesCache, 0)
+}
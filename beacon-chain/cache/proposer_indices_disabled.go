@@ -29,3 +29,12 @@ func (c *FakeProposerIndicesCache) ProposerIndices(r [32]byte) ([]types.Validato
 func (c *FakeProposerIndicesCache) HasProposerIndices(r [32]byte) (bool, error) {
 	return false, nil
 }
+
+// Stats returns empty stats, as nothing is cached.
+func (c *FakeProposerIndicesCache) Stats() *Stats {
+	return &Stats{}
+}
+
+// Flush is a no-op.
+func (c *FakeProposerIndicesCache) Flush() {
+}
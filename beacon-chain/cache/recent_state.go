@@ -0,0 +1,82 @@
+package cache
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+)
+
+var (
+	// maxRecentStateSize defines the max number of post states the recent state cache can contain.
+	// Choosing 8 keeps the tips of a handful of competing branches around without holding
+	// more than a few epochs worth of states in memory.
+	maxRecentStateSize = 8
+
+	// Metrics.
+	recentStateMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "recent_state_cache_miss",
+		Help: "The number of recent state requests that aren't present in the cache.",
+	})
+	recentStateHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "recent_state_cache_hit",
+		Help: "The number of recent state requests that are present in the cache.",
+	})
+)
+
+// RecentStateCache keeps the post states of recently processed blocks keyed by block root,
+// so that states of non-head branches can be served without replaying blocks.
+type RecentStateCache struct {
+	access AccessCounter
+	cache  *lru.Cache
+}
+
+// NewRecentStateCache creates a new recent state cache.
+func NewRecentStateCache() *RecentStateCache {
+	cache, err := lru.New(maxRecentStateSize)
+	if err != nil {
+		panic(err)
+	}
+	return &RecentStateCache{
+		cache: cache,
+	}
+}
+
+// StateByRoot returns a copy of the post state of the given block root, or nil if the state
+// is not in the cache.
+func (c *RecentStateCache) StateByRoot(root [32]byte) iface.BeaconState {
+	item, exists := c.cache.Get(root)
+	if exists && item != nil {
+		recentStateHit.Inc()
+		c.access.Hit()
+		return item.(iface.BeaconState).Copy()
+	}
+	recentStateMiss.Inc()
+	c.access.Miss()
+	return nil
+}
+
+// Put adds a copy of the post state of the given block root to the cache, evicting the least
+// recently used state once the cache is full.
+func (c *RecentStateCache) Put(root [32]byte, st iface.BeaconState) {
+	if st == nil {
+		return
+	}
+	c.cache.Add(root, st.Copy())
+}
+
+// Stats returns the number of cached states and their estimated size.
+func (c *RecentStateCache) Stats() *Stats {
+	size := uint64(0)
+	for _, k := range c.cache.Keys() {
+		if item, ok := c.cache.Peek(k); ok {
+			size += EstimatedStateSize(item.(iface.BeaconState))
+		}
+	}
+	return c.access.Stats(c.cache.Len(), size)
+}
+
+// Flush removes all the states from the cache.
+func (c *RecentStateCache) Flush() {
+	c.cache.Purge()
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"testing"
+
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRecentStateCache_PutAndGet(t *testing.T) {
+	c := NewRecentStateCache()
+	root := [32]byte{'a'}
+	assert.Equal(t, iface.BeaconState(nil), c.StateByRoot(root), "Expected state not to exist in empty cache")
+
+	st, err := stateV0.InitializeFromProto(&pb.BeaconState{
+		GenesisValidatorsRoot: params.BeaconConfig().ZeroHash[:],
+		Slot:                  10,
+	})
+	require.NoError(t, err)
+	c.Put(root, st)
+
+	// Mutating the original state does not leak into the cached copy.
+	require.NoError(t, st.SetSlot(11))
+	cached := c.StateByRoot(root)
+	require.NotNil(t, cached)
+	assert.Equal(t, uint64(10), uint64(cached.Slot()))
+
+	// Mutating a returned state does not leak into the cache either.
+	require.NoError(t, cached.SetSlot(12))
+	assert.Equal(t, uint64(10), uint64(c.StateByRoot(root).Slot()))
+}
+
+func TestRecentStateCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewRecentStateCache()
+	st, err := stateV0.InitializeFromProto(&pb.BeaconState{})
+	require.NoError(t, err)
+	for i := 0; i <= maxRecentStateSize; i++ {
+		c.Put([32]byte{byte(i)}, st)
+	}
+	assert.Equal(t, iface.BeaconState(nil), c.StateByRoot([32]byte{0}), "Expected oldest state to be evicted")
+	assert.NotNil(t, c.StateByRoot([32]byte{byte(maxRecentStateSize)}))
+}
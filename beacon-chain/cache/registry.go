@@ -0,0 +1,118 @@
+package cache
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrUnknownCache is returned when no cache is registered with the requested name.
+var ErrUnknownCache = errors.New("unknown cache")
+
+// DefaultRegistry is the registry the caches of the beacon node register with.
+var DefaultRegistry = NewRegistry()
+
+// Stats describes the content and the usage of a cache.
+type Stats struct {
+	Entries        int
+	EstimatedBytes uint64
+	Hits           uint64
+	Misses         uint64
+}
+
+// HitRatio returns the share of the lookups of the cache which were hits, or 0 if the cache
+// was never looked up.
+func (s *Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Inspectable is implemented by the caches registered with a registry, to report their
+// content and to be flushed on request.
+type Inspectable interface {
+	Stats() *Stats
+	Flush()
+}
+
+// NamedStats are the stats of a registered cache along with its name.
+type NamedStats struct {
+	Name string
+	*Stats
+}
+
+// Registry keeps track of the caches of the node by name, to inspect and flush them from a
+// single place.
+type Registry struct {
+	lock   sync.RWMutex
+	caches map[string]Inspectable
+}
+
+// NewRegistry creates an empty cache registry.
+func NewRegistry() *Registry {
+	return &Registry{caches: make(map[string]Inspectable)}
+}
+
+// Register adds a cache to the registry under the given name. Registering a cache under the name
+// of another cache replaces it, as caches are re-created when their owner is.
+func (r *Registry) Register(name string, c Inspectable) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.caches[name] = c
+}
+
+// Stats returns the stats of all the registered caches, ordered by name.
+func (r *Registry) Stats() []*NamedStats {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	stats := make([]*NamedStats, 0, len(r.caches))
+	for name, c := range r.caches {
+		stats = append(stats, &NamedStats{Name: name, Stats: c.Stats()})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Name < stats[j].Name
+	})
+	return stats
+}
+
+// Flush empties the cache registered under the given name.
+func (r *Registry) Flush(name string) error {
+	r.lock.RLock()
+	c, ok := r.caches[name]
+	r.lock.RUnlock()
+	if !ok {
+		return ErrUnknownCache
+	}
+	c.Flush()
+	return nil
+}
+
+// AccessCounter counts the hits and misses of the lookups of a cache. It must be the first field
+// of the cache struct, to keep its counters aligned for atomic operations on 32-bit platforms.
+type AccessCounter struct {
+	hits   uint64
+	misses uint64
+}
+
+// Hit counts a lookup finding its entry.
+func (a *AccessCounter) Hit() {
+	atomic.AddUint64(&a.hits, 1)
+}
+
+// Miss counts a lookup not finding its entry.
+func (a *AccessCounter) Miss() {
+	atomic.AddUint64(&a.misses, 1)
+}
+
+// Stats returns the stats of a cache of the given content along with the access counts.
+func (a *AccessCounter) Stats(entries int, estimatedBytes uint64) *Stats {
+	return &Stats{
+		Entries:        entries,
+		EstimatedBytes: estimatedBytes,
+		Hits:           atomic.LoadUint64(&a.hits),
+		Misses:         atomic.LoadUint64(&a.misses),
+	}
+}
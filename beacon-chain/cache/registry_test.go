@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRegistry_StatsAndFlush(t *testing.T) {
+	r := NewRegistry()
+	committees := NewCommitteesCache()
+	proposers := NewProposerIndicesCache()
+	r.Register("proposer-indices", proposers)
+	r.Register("committee", committees)
+
+	seed := [32]byte{'a'}
+	require.NoError(t, committees.AddCommitteeShuffledList(&Committees{
+		Seed:            seed,
+		ShuffledIndices: []types.ValidatorIndex{1, 2, 3},
+		SortedIndices:   []types.ValidatorIndex{1, 2, 3},
+	}))
+	_, err := committees.ActiveIndices(seed)
+	require.NoError(t, err)
+	_, err = committees.ActiveIndices([32]byte{'b'})
+	require.NoError(t, err)
+
+	stats := r.Stats()
+	require.Equal(t, 2, len(stats))
+	assert.Equal(t, "committee", stats[0].Name, "Stats should be ordered by name")
+	assert.Equal(t, 1, stats[0].Entries)
+	assert.Equal(t, uint64(48), stats[0].EstimatedBytes)
+	assert.Equal(t, 0.5, stats[0].HitRatio())
+	assert.Equal(t, "proposer-indices", stats[1].Name)
+	assert.Equal(t, 0.0, stats[1].HitRatio())
+
+	require.NoError(t, r.Flush("committee"))
+	assert.Equal(t, false, committees.HasEntry(key(seed)))
+	assert.Equal(t, 0, committees.Stats().Entries)
+	assert.ErrorContains(t, ErrUnknownCache.Error(), r.Flush("unknown"))
+}
@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"errors"
 	"math"
 	"sync"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	"go.opencensus.io/trace"
 )
 
@@ -23,26 +25,68 @@ var (
 		Name: "skip_slot_cache_miss",
 		Help: "The total number of cache misses on the skip slot cache.",
 	})
+	skipSlotCacheEvictions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "skip_slot_cache_evictions_total",
+		Help: "The total number of states evicted from the skip slot cache.",
+	})
+	skipSlotCacheEntries = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "skip_slot_cache_entries",
+		Help: "The number of states in the skip slot cache.",
+	})
+	skipSlotCacheBytes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "skip_slot_cache_estimated_bytes",
+		Help: "The estimated size in bytes of the states in the skip slot cache.",
+	})
 )
 
+// DefaultSkipSlotCacheSize is the default number of states kept by the skip slot cache.
+const DefaultSkipSlotCacheSize = 8
+
 // SkipSlotCache is used to store the cached results of processing skip slots in state.ProcessSlots.
 type SkipSlotCache struct {
+	access     AccessCounter
 	cache      *lru.Cache
 	lock       sync.RWMutex
 	disabled   bool // Allow for programmatic toggling of the cache, useful during initial sync.
 	inProgress map[[32]byte]bool
+	maxBytes   uint64 // Budget of the estimated size of the cached states, unbounded if zero.
+	bytes      uint64
+}
+
+// skipSlotCacheEntry is a cached state along with its estimated size.
+type skipSlotCacheEntry struct {
+	state iface.BeaconState
+	size  uint64
 }
 
 // NewSkipSlotCache initializes the map and underlying cache.
 func NewSkipSlotCache() *SkipSlotCache {
-	cache, err := lru.New(8)
+	c := &SkipSlotCache{
+		inProgress: make(map[[32]byte]bool),
+	}
+	cache, err := lru.NewWithEvict(DefaultSkipSlotCacheSize, c.onEvicted)
 	if err != nil {
 		panic(err)
 	}
-	return &SkipSlotCache{
-		cache:      cache,
-		inProgress: make(map[[32]byte]bool),
+	c.cache = cache
+	return c
+}
+
+// Configure sets the maximum number of states kept by the cache, and the budget in bytes of their
+// estimated size. A zero budget leaves the size unbounded. The least recently used states are
+// evicted until the cache fits in the new bounds.
+func (c *SkipSlotCache) Configure(maxEntries int, maxBytes uint64) error {
+	if maxEntries <= 0 {
+		return errors.New("skip slot cache size must be positive")
 	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.cache.Resize(maxEntries)
+	c.maxBytes = maxBytes
+	c.evictOverBudget()
+	c.updateMetrics()
+	return nil
 }
 
 // Enable the skip slot cache.
@@ -63,6 +107,7 @@ func (c *SkipSlotCache) Get(ctx context.Context, r [32]byte) (iface.BeaconState,
 	if c.disabled {
 		// Return a miss result if cache is not enabled.
 		skipSlotCacheMiss.Inc()
+		c.access.Miss()
 		return nil, nil
 	}
 
@@ -96,10 +141,12 @@ func (c *SkipSlotCache) Get(ctx context.Context, r [32]byte) (iface.BeaconState,
 
 	if exists && item != nil {
 		skipSlotCacheHit.Inc()
+		c.access.Hit()
 		span.AddAttributes(trace.BoolAttribute("hit", true))
-		return item.(iface.BeaconState).Copy(), nil
+		return item.(*skipSlotCacheEntry).state.Copy(), nil
 	}
 	skipSlotCacheMiss.Inc()
+	c.access.Miss()
 	span.AddAttributes(trace.BoolAttribute("hit", false))
 	return nil, nil
 }
@@ -142,7 +189,71 @@ func (c *SkipSlotCache) Put(_ context.Context, r [32]byte, state iface.BeaconSta
 	}
 
 	// Copy state so cached value is not mutated.
-	c.cache.Add(r, state.Copy())
+	cached := state.Copy()
+	stateV0.MarkShared(cached, "skip-slot-cache")
+	size := EstimatedStateSize(cached)
 
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// A state larger than the whole budget would evict every other state, keep them instead.
+	if c.maxBytes > 0 && size > c.maxBytes {
+		return nil
+	}
+	// Replacing an existing entry does not trigger an eviction, so its size is released here.
+	if item, ok := c.cache.Peek(r); ok {
+		c.bytes -= item.(*skipSlotCacheEntry).size
+	}
+	c.cache.Add(r, &skipSlotCacheEntry{state: cached, size: size})
+	c.bytes += size
+	c.evictOverBudget()
+	c.updateMetrics()
 	return nil
 }
+
+// evictOverBudget evicts the least recently used states until the cache fits in its byte budget.
+// The caller must hold the lock.
+func (c *SkipSlotCache) evictOverBudget() {
+	for c.maxBytes > 0 && c.bytes > c.maxBytes {
+		if _, _, ok := c.cache.RemoveOldest(); !ok {
+			return
+		}
+	}
+}
+
+// onEvicted releases the size of an evicted state. Evictions only happen on writes to the
+// underlying cache, which are done while holding the lock.
+func (c *SkipSlotCache) onEvicted(_, value interface{}) {
+	c.bytes -= value.(*skipSlotCacheEntry).size
+	skipSlotCacheEvictions.Inc()
+}
+
+// Stats returns the number of cached states and their estimated size.
+func (c *SkipSlotCache) Stats() *Stats {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.access.Stats(c.cache.Len(), c.bytes)
+}
+
+// Flush removes all the states from the cache.
+func (c *SkipSlotCache) Flush() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Purge()
+	c.updateMetrics()
+}
+
+func (c *SkipSlotCache) updateMetrics() {
+	skipSlotCacheEntries.Set(float64(c.cache.Len()))
+	skipSlotCacheBytes.Set(float64(c.bytes))
+}
+
+// EstimatedStateSize estimates the memory held by a state from its serialized size. Cached states
+// share some of their fields with other states, so this over-estimates the memory they retain,
+// keeping cache budgets conservative.
+func EstimatedStateSize(st iface.ReadOnlyBeaconState) uint64 {
+	if s, ok := st.InnerStateUnsafe().(interface{ SizeSSZ() int }); ok {
+		return uint64(s.SizeSSZ())
+	}
+	return 0
+}
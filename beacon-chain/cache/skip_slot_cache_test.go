@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
@@ -35,3 +36,45 @@ func TestSkipSlotCache_RoundTrip(t *testing.T) {
 	require.NoError(t, err)
 	assert.DeepEqual(t, res.CloneInnerState(), state.CloneInnerState(), "Expected equal protos to return from cache")
 }
+
+func TestSkipSlotCache_Configure(t *testing.T) {
+	ctx := context.Background()
+	c := cache.NewSkipSlotCache()
+	assert.ErrorContains(t, "skip slot cache size must be positive", c.Configure(0, 0))
+
+	newState := func(slot types.Slot) iface.BeaconState {
+		st, err := stateV0.InitializeFromProto(&pb.BeaconState{Slot: slot})
+		require.NoError(t, err)
+		return st
+	}
+	stateSize := uint64(newState(0).InnerStateUnsafe().(*pb.BeaconState).SizeSSZ())
+
+	// Only the most recently used states are kept once the cache is full.
+	require.NoError(t, c.Configure(2, 0))
+	for i := 0; i < 3; i++ {
+		require.NoError(t, c.Put(ctx, [32]byte{byte(i)}, newState(types.Slot(i))))
+	}
+	res, err := c.Get(ctx, [32]byte{0})
+	require.NoError(t, err)
+	assert.Equal(t, iface.BeaconState(nil), res, "Least recently used state was not evicted")
+	res, err = c.Get(ctx, [32]byte{2})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, types.Slot(2), res.Slot())
+
+	// Shrinking the budget evicts the least recently used states to fit in it.
+	require.NoError(t, c.Configure(2, stateSize))
+	res, err = c.Get(ctx, [32]byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, iface.BeaconState(nil), res, "State over budget was not evicted")
+	res, err = c.Get(ctx, [32]byte{2})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// A state larger than the whole budget is not cached.
+	require.NoError(t, c.Configure(2, stateSize-1))
+	require.NoError(t, c.Put(ctx, [32]byte{3}, newState(3)))
+	res, err = c.Get(ctx, [32]byte{3})
+	require.NoError(t, err)
+	assert.Equal(t, iface.BeaconState(nil), res, "State larger than the budget was cached")
+}
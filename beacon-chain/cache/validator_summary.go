@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	// maxValidatorSummaryCacheSize defines the max number of validator set summaries the cache holds.
+	// Keeping the summaries of a few epochs around serves forks and requests for recent states.
+	maxValidatorSummaryCacheSize = uint64(8)
+
+	// ValidatorSummaryCacheMiss tracks the number of validator summary requests that aren't present in the cache.
+	ValidatorSummaryCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "validator_summary_cache_miss",
+		Help: "The number of validator summary requests that aren't present in the cache.",
+	})
+	// ValidatorSummaryCacheHit tracks the number of validator summary requests that are in the cache.
+	ValidatorSummaryCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "validator_summary_cache_hit",
+		Help: "The number of validator summary requests that are present in the cache.",
+	})
+)
+
+// ErrNotValidatorSummary will be returned when a cache object is not a pointer to
+// a ValidatorSummary struct.
+var ErrNotValidatorSummary = errors.New("object is not a validator summary struct")
+
+// ValidatorSummary holds the aggregate values of the validator set of an epoch, which only
+// change at epoch transitions.
+type ValidatorSummary struct {
+	Root               [32]byte
+	Epoch              types.Epoch
+	ActiveCount        uint64
+	TotalActiveBalance uint64
+	ChurnLimit         uint64
+}
+
+// ValidatorSummaryCache is a struct with 1 queue for looking up validator set summaries by the
+// state root preceding the epoch transition they result from.
+type ValidatorSummaryCache struct {
+	summaryCache *cache.FIFO
+	lock         sync.RWMutex
+}
+
+// validatorSummaryKeyFn takes the state root as the key to retrieve the validator summary of an epoch.
+func validatorSummaryKeyFn(obj interface{}) (string, error) {
+	s, ok := obj.(*ValidatorSummary)
+	if !ok {
+		return "", ErrNotValidatorSummary
+	}
+	return key(s.Root), nil
+}
+
+// NewValidatorSummaryCache creates a new validator summary cache.
+func NewValidatorSummaryCache() *ValidatorSummaryCache {
+	return &ValidatorSummaryCache{
+		summaryCache: cache.NewFIFO(validatorSummaryKeyFn),
+	}
+}
+
+// AddSummary adds a validator summary to the cache, trimming the oldest summaries once the
+// cache is full.
+func (c *ValidatorSummaryCache) AddSummary(s *ValidatorSummary) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.summaryCache.AddIfNotPresent(s); err != nil {
+		return err
+	}
+	trim(c.summaryCache, maxValidatorSummaryCacheSize)
+	return nil
+}
+
+// Summary returns the validator summary of the given root, or nil if it isn't cached.
+func (c *ValidatorSummaryCache) Summary(r [32]byte) (*ValidatorSummary, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	obj, exists, err := c.summaryCache.GetByKey(key(r))
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		ValidatorSummaryCacheHit.Inc()
+	} else {
+		ValidatorSummaryCacheMiss.Inc()
+		return nil, nil
+	}
+
+	s, ok := obj.(*ValidatorSummary)
+	if !ok {
+		return nil, ErrNotValidatorSummary
+	}
+	return s, nil
+}
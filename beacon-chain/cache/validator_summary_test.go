@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestValidatorSummaryKeyFn_InvalidObj(t *testing.T) {
+	_, err := validatorSummaryKeyFn("bad")
+	assert.Equal(t, ErrNotValidatorSummary, err)
+}
+
+func TestValidatorSummaryCache_AddSummary(t *testing.T) {
+	c := NewValidatorSummaryCache()
+	r := [32]byte{'A'}
+	s, err := c.Summary(r)
+	require.NoError(t, err)
+	assert.Equal(t, (*ValidatorSummary)(nil), s, "Expected summary not to exist in empty cache")
+
+	summary := &ValidatorSummary{Root: r, Epoch: 2, ActiveCount: 10, TotalActiveBalance: 320, ChurnLimit: 4}
+	require.NoError(t, c.AddSummary(summary))
+	s, err = c.Summary(r)
+	require.NoError(t, err)
+	assert.DeepEqual(t, summary, s)
+}
+
+func TestValidatorSummaryCache_MaxSize(t *testing.T) {
+	c := NewValidatorSummaryCache()
+	for i := uint64(0); i < maxValidatorSummaryCacheSize+2; i++ {
+		require.NoError(t, c.AddSummary(&ValidatorSummary{Root: [32]byte{byte(i)}}))
+	}
+	assert.Equal(t, int(maxValidatorSummaryCacheSize), len(c.summaryCache.ListKeys()))
+	s, err := c.Summary([32]byte{0})
+	require.NoError(t, err)
+	assert.Equal(t, (*ValidatorSummary)(nil), s, "Expected the oldest summary to be trimmed")
+}
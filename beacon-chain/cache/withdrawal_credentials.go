@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// WithdrawalCredentialsCache indexes the validators of the registry by withdrawal credentials.
+// Validators are only ever appended to the registry when deposits are processed, and their
+// withdrawal credentials never change, so the index is extended with the validators added since
+// the last update rather than rebuilt from a scan of the registry.
+type WithdrawalCredentialsCache struct {
+	indices map[[32]byte][]types.ValidatorIndex
+	count   int // number of validators of the registry indexed so far.
+	lock    sync.RWMutex
+}
+
+// NewWithdrawalCredentialsCache creates an empty withdrawal credentials cache.
+func NewWithdrawalCredentialsCache() *WithdrawalCredentialsCache {
+	return &WithdrawalCredentialsCache{
+		indices: make(map[[32]byte][]types.ValidatorIndex),
+	}
+}
+
+// Update indexes the validators of the registry of the given state which were not indexed yet.
+// Deposits are processed in the order of the deposit contract, so a validator index refers to
+// the same validator on every fork and the index never needs to be rolled back.
+func (c *WithdrawalCredentialsCache) Update(st iface.ReadOnlyBeaconState) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	n := st.NumValidators()
+	for i := c.count; i < n; i++ {
+		val, err := st.ValidatorAtIndexReadOnly(types.ValidatorIndex(i))
+		if err != nil {
+			return err
+		}
+		creds := bytesutil.ToBytes32(val.WithdrawalCredentials())
+		c.indices[creds] = append(c.indices[creds], types.ValidatorIndex(i))
+	}
+	if n > c.count {
+		c.count = n
+	}
+	return nil
+}
+
+// Count returns the number of validators of the registry indexed so far.
+func (c *WithdrawalCredentialsCache) Count() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.count
+}
+
+// ValidatorIndices returns the indices of the validators with the given withdrawal credentials,
+// in increasing order.
+func (c *WithdrawalCredentialsCache) ValidatorIndices(creds [32]byte) []types.ValidatorIndex {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	indices := make([]types.ValidatorIndex, len(c.indices[creds]))
+	copy(indices, c.indices[creds])
+	return indices
+}
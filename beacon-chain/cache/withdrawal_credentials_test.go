@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestWithdrawalCredentialsCache_Update(t *testing.T) {
+	credsA := bytesutil.PadTo([]byte{'a'}, 32)
+	credsB := bytesutil.PadTo([]byte{'b'}, 32)
+	st, err := stateV0.InitializeFromProto(&pb.BeaconState{
+		Validators: []*ethpb.Validator{
+			{WithdrawalCredentials: credsA},
+			{WithdrawalCredentials: credsB},
+			{WithdrawalCredentials: credsA},
+		},
+	})
+	require.NoError(t, err)
+
+	c := NewWithdrawalCredentialsCache()
+	assert.Equal(t, 0, len(c.ValidatorIndices(bytesutil.ToBytes32(credsA))))
+	require.NoError(t, c.Update(st))
+	assert.Equal(t, 3, c.Count())
+	assert.DeepEqual(t, []types.ValidatorIndex{0, 2}, c.ValidatorIndices(bytesutil.ToBytes32(credsA)))
+	assert.DeepEqual(t, []types.ValidatorIndex{1}, c.ValidatorIndices(bytesutil.ToBytes32(credsB)))
+
+	// Only the validators appended by new deposits are indexed.
+	require.NoError(t, st.AppendValidator(&ethpb.Validator{WithdrawalCredentials: credsB}))
+	require.NoError(t, c.Update(st))
+	require.NoError(t, c.Update(st))
+	assert.Equal(t, 4, c.Count())
+	assert.DeepEqual(t, []types.ValidatorIndex{1, 3}, c.ValidatorIndices(bytesutil.ToBytes32(credsB)))
+	assert.DeepEqual(t, []types.ValidatorIndex{0, 2}, c.ValidatorIndices(bytesutil.ToBytes32(credsA)))
+}
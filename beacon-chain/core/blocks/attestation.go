@@ -99,8 +99,26 @@ func ProcessAttestationsNoVerifySignature(
 	return beaconState, nil
 }
 
+// attestationValidationStage is a single check performed by VerifyAttestationNoVerifySignature.
+// A stage returns an *AttestationRejectionError if the attestation is invalid, and any other
+// error if the check itself could not be performed.
+type attestationValidationStage func(ctx context.Context, beaconState iface.ReadOnlyBeaconState, att *ethpb.Attestation) error
+
+// attestationValidationStages are run in order, as later stages rely on the checks of earlier ones.
+var attestationValidationStages = []attestationValidationStage{
+	verifyAttestationNotNil,
+	verifyAttestationTargetEpoch,
+	verifyAttestationSource,
+	verifyAttestationSlotTargetEpoch,
+	verifyAttestationInclusion,
+	VerifyAttestationCommitteeIndex,
+	verifyAttestationBitfieldLengths,
+	verifyAttestationIndices,
+}
+
 // VerifyAttestationNoVerifySignature verifies the attestation without verifying the attestation signature. This is
-// used before processing attestation with the beacon state.
+// used before processing attestation with the beacon state. If the attestation is invalid, the returned error is an
+// *AttestationRejectionError describing the reason.
 func VerifyAttestationNoVerifySignature(
 	ctx context.Context,
 	beaconState iface.ReadOnlyBeaconState,
@@ -109,67 +127,94 @@ func VerifyAttestationNoVerifySignature(
 	ctx, span := trace.StartSpan(ctx, "core.VerifyAttestationNoVerifySignature")
 	defer span.End()
 
-	if err := helpers.ValidateNilAttestation(att); err != nil {
-		return err
+	for _, stage := range attestationValidationStages {
+		if err := stage(ctx, beaconState, att); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+func verifyAttestationNotNil(_ context.Context, _ iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
+	return rejectAttestation(RejectNilAttestation, helpers.ValidateNilAttestation(att))
+}
+
+func verifyAttestationTargetEpoch(_ context.Context, beaconState iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
 	currEpoch := helpers.CurrentEpoch(beaconState)
 	prevEpoch := helpers.PrevEpoch(beaconState)
-	data := att.Data
-	if data.Target.Epoch != prevEpoch && data.Target.Epoch != currEpoch {
-		return fmt.Errorf(
+	if att.Data.Target.Epoch != prevEpoch && att.Data.Target.Epoch != currEpoch {
+		return rejectAttestation(RejectBadTargetEpoch, fmt.Errorf(
 			"expected target epoch (%d) to be the previous epoch (%d) or the current epoch (%d)",
-			data.Target.Epoch,
+			att.Data.Target.Epoch,
 			prevEpoch,
 			currEpoch,
-		)
+		))
 	}
+	return nil
+}
 
-	if data.Target.Epoch == currEpoch {
-		if !beaconState.MatchCurrentJustifiedCheckpoint(data.Source) {
-			return errors.New("source check point not equal to current justified checkpoint")
+func verifyAttestationSource(_ context.Context, beaconState iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
+	if att.Data.Target.Epoch == helpers.CurrentEpoch(beaconState) {
+		if !beaconState.MatchCurrentJustifiedCheckpoint(att.Data.Source) {
+			return rejectAttestation(RejectWrongSource, errors.New("source check point not equal to current justified checkpoint"))
 		}
 	} else {
-		if !beaconState.MatchPreviousJustifiedCheckpoint(data.Source) {
-			return errors.New("source check point not equal to previous justified checkpoint")
+		if !beaconState.MatchPreviousJustifiedCheckpoint(att.Data.Source) {
+			return rejectAttestation(RejectWrongSource, errors.New("source check point not equal to previous justified checkpoint"))
 		}
 	}
+	return nil
+}
 
-	if err := helpers.ValidateSlotTargetEpoch(att.Data); err != nil {
-		return err
-	}
+func verifyAttestationSlotTargetEpoch(_ context.Context, _ iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
+	return rejectAttestation(RejectSlotTargetMismatch, helpers.ValidateSlotTargetEpoch(att.Data))
+}
 
+func verifyAttestationInclusion(_ context.Context, beaconState iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
 	s := att.Data.Slot
 	minInclusionCheck := s+params.BeaconConfig().MinAttestationInclusionDelay <= beaconState.Slot()
 	epochInclusionCheck := beaconState.Slot() <= s+params.BeaconConfig().SlotsPerEpoch
 	if !minInclusionCheck {
-		return fmt.Errorf(
+		return rejectAttestation(RejectInclusionTooEarly, fmt.Errorf(
 			"attestation slot %d + inclusion delay %d > state slot %d",
 			s,
 			params.BeaconConfig().MinAttestationInclusionDelay,
 			beaconState.Slot(),
-		)
+		))
 	}
 	if !epochInclusionCheck {
-		return fmt.Errorf(
+		return rejectAttestation(RejectTargetTooOld, fmt.Errorf(
 			"state slot %d > attestation slot %d + SLOTS_PER_EPOCH %d",
 			beaconState.Slot(),
 			s,
 			params.BeaconConfig().SlotsPerEpoch,
-		)
+		))
 	}
+	return nil
+}
+
+// VerifyAttestationCommitteeIndex verifies the attestation's committee index is lower than the
+// number of committees per slot in the attestation's target epoch.
+func VerifyAttestationCommitteeIndex(_ context.Context, beaconState iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
 	activeValidatorCount, err := helpers.ActiveValidatorCount(beaconState, att.Data.Target.Epoch)
 	if err != nil {
 		return err
 	}
 	c := helpers.SlotCommitteeCount(activeValidatorCount)
 	if uint64(att.Data.CommitteeIndex) >= c {
-		return fmt.Errorf("committee index %d >= committee count %d", att.Data.CommitteeIndex, c)
+		return rejectAttestation(RejectBadCommitteeIndex, fmt.Errorf("committee index %d >= committee count %d", att.Data.CommitteeIndex, c))
 	}
+	return nil
+}
 
+func verifyAttestationBitfieldLengths(_ context.Context, beaconState iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
 	if err := helpers.VerifyAttestationBitfieldLengths(beaconState, att); err != nil {
-		return errors.Wrap(err, "could not verify attestation bitfields")
+		return rejectAttestation(RejectBitfieldMismatch, errors.Wrap(err, "could not verify attestation bitfields"))
 	}
+	return nil
+}
 
+func verifyAttestationIndices(ctx context.Context, beaconState iface.ReadOnlyBeaconState, att *ethpb.Attestation) error {
 	// Verify attesting indices are correct.
 	committee, err := helpers.BeaconCommitteeFromState(beaconState, att.Data.Slot, att.Data.CommitteeIndex)
 	if err != nil {
@@ -179,8 +224,7 @@ func VerifyAttestationNoVerifySignature(
 	if err != nil {
 		return err
 	}
-
-	return attestationutil.IsValidAttestationIndices(ctx, indexedAtt)
+	return rejectAttestation(RejectInvalidIndices, attestationutil.IsValidAttestationIndices(ctx, indexedAtt))
 }
 
 // ProcessAttestationNoVerifySignature processes the attestation without verifying the attestation signature. This
@@ -0,0 +1,93 @@
+package blocks
+
+import (
+	"errors"
+)
+
+// AttestationRejection is the reason an attestation was rejected by validation.
+type AttestationRejection uint8
+
+const (
+	// RejectNilAttestation is used when the attestation or one of its required fields is nil.
+	RejectNilAttestation AttestationRejection = iota + 1
+	// RejectBadTargetEpoch is used when the target epoch is neither the previous nor the current epoch.
+	RejectBadTargetEpoch
+	// RejectWrongSource is used when the source checkpoint does not match the justified checkpoint.
+	RejectWrongSource
+	// RejectSlotTargetMismatch is used when the attestation slot is not in the target epoch.
+	RejectSlotTargetMismatch
+	// RejectInclusionTooEarly is used when the minimum inclusion delay has not passed.
+	RejectInclusionTooEarly
+	// RejectTargetTooOld is used when the attestation is older than one epoch.
+	RejectTargetTooOld
+	// RejectBadCommitteeIndex is used when the committee index exceeds the committee count.
+	RejectBadCommitteeIndex
+	// RejectBitfieldMismatch is used when the aggregation bits do not match the committee.
+	RejectBitfieldMismatch
+	// RejectInvalidIndices is used when the attesting indices are not valid.
+	RejectInvalidIndices
+	// RejectInvalidSignature is used when the attestation signature fails to verify.
+	RejectInvalidSignature
+)
+
+// String returns the name of the rejection reason, which is used as a metrics label.
+func (r AttestationRejection) String() string {
+	switch r {
+	case RejectNilAttestation:
+		return "nil_attestation"
+	case RejectBadTargetEpoch:
+		return "bad_target_epoch"
+	case RejectWrongSource:
+		return "wrong_source"
+	case RejectSlotTargetMismatch:
+		return "slot_target_mismatch"
+	case RejectInclusionTooEarly:
+		return "inclusion_too_early"
+	case RejectTargetTooOld:
+		return "target_too_old"
+	case RejectBadCommitteeIndex:
+		return "bad_committee_index"
+	case RejectBitfieldMismatch:
+		return "bitfield_mismatch"
+	case RejectInvalidIndices:
+		return "invalid_indices"
+	case RejectInvalidSignature:
+		return "invalid_signature"
+	default:
+		return "unknown"
+	}
+}
+
+// AttestationRejectionError is returned when an attestation is invalid, as opposed to
+// errors encountered while validating it.
+type AttestationRejectionError struct {
+	Reason AttestationRejection
+	err    error
+}
+
+// Error returns the message of the underlying validation error.
+func (e *AttestationRejectionError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying validation error.
+func (e *AttestationRejectionError) Unwrap() error {
+	return e.err
+}
+
+// AttestationRejectionReason returns the rejection reason of the error, if the error
+// was caused by an invalid attestation.
+func AttestationRejectionReason(err error) (AttestationRejection, bool) {
+	var rejection *AttestationRejectionError
+	if errors.As(err, &rejection) {
+		return rejection.Reason, true
+	}
+	return 0, false
+}
+
+func rejectAttestation(reason AttestationRejection, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &AttestationRejectionError{Reason: reason, err: err}
+}
@@ -2,6 +2,7 @@ package blocks_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -457,6 +458,101 @@ func TestVerifyAttestationNoVerifySignature_BadAttIdx(t *testing.T) {
 	require.ErrorContains(t, "committee index 100 >= committee count 1", err)
 }
 
+func TestVerifyAttestationNoVerifySignature_RejectionReasons(t *testing.T) {
+	var mockRoot [32]byte
+	copy(mockRoot[:], "hello-world")
+	validAtt := func() *ethpb.Attestation {
+		aggBits := bitfield.NewBitlist(3)
+		aggBits.SetBitAt(1, true)
+		return &ethpb.Attestation{
+			Data: &ethpb.AttestationData{
+				Source: &ethpb.Checkpoint{Epoch: 0, Root: mockRoot[:]},
+				Target: &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+			},
+			AggregationBits: aggBits,
+			Signature:       make([]byte, 96),
+		}
+	}
+	tests := []struct {
+		name      string
+		stateSlot types.Slot
+		mutate    func(att *ethpb.Attestation)
+		reason    blocks.AttestationRejection
+	}{
+		{
+			name:   "nil data",
+			mutate: func(att *ethpb.Attestation) { att.Data = nil },
+			reason: blocks.RejectNilAttestation,
+		},
+		{
+			name:   "bad target epoch",
+			mutate: func(att *ethpb.Attestation) { att.Data.Target.Epoch = 5 },
+			reason: blocks.RejectBadTargetEpoch,
+		},
+		{
+			name:   "wrong source",
+			mutate: func(att *ethpb.Attestation) { att.Data.Source.Root = make([]byte, 32) },
+			reason: blocks.RejectWrongSource,
+		},
+		{
+			name:   "slot target mismatch",
+			mutate: func(att *ethpb.Attestation) { att.Data.Slot = params.BeaconConfig().SlotsPerEpoch },
+			reason: blocks.RejectSlotTargetMismatch,
+		},
+		{
+			name:   "inclusion too early",
+			mutate: func(att *ethpb.Attestation) { att.Data.Slot = 1 },
+			reason: blocks.RejectInclusionTooEarly,
+		},
+		{
+			name:      "target too old",
+			stateSlot: params.BeaconConfig().SlotsPerEpoch + 2,
+			mutate: func(att *ethpb.Attestation) {
+				att.Data.Source.Root = make([]byte, 32)
+			},
+			reason: blocks.RejectTargetTooOld,
+		},
+		{
+			name:   "bad committee index",
+			mutate: func(att *ethpb.Attestation) { att.Data.CommitteeIndex = 100 },
+			reason: blocks.RejectBadCommitteeIndex,
+		},
+		{
+			name:   "bitfield mismatch",
+			mutate: func(att *ethpb.Attestation) { att.AggregationBits = bitfield.NewBitlist(5) },
+			reason: blocks.RejectBitfieldMismatch,
+		},
+		{
+			name:   "invalid indices",
+			mutate: func(att *ethpb.Attestation) { att.AggregationBits = bitfield.NewBitlist(3) },
+			reason: blocks.RejectInvalidIndices,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beaconState, _ := testutil.DeterministicGenesisState(t, 100)
+			stateSlot := tt.stateSlot
+			if stateSlot == 0 {
+				stateSlot = params.BeaconConfig().MinAttestationInclusionDelay
+			}
+			require.NoError(t, beaconState.SetSlot(stateSlot))
+			ckp := beaconState.CurrentJustifiedCheckpoint()
+			copy(ckp.Root, "hello-world")
+			require.NoError(t, beaconState.SetCurrentJustifiedCheckpoint(ckp))
+
+			att := validAtt()
+			tt.mutate(att)
+			err := blocks.VerifyAttestationNoVerifySignature(context.Background(), beaconState, att)
+			reason, ok := blocks.AttestationRejectionReason(err)
+			require.Equal(t, true, ok, "Expected a rejection, got: %v", err)
+			assert.Equal(t, tt.reason, reason)
+		})
+	}
+
+	_, ok := blocks.AttestationRejectionReason(errors.New("could not get committee"))
+	assert.Equal(t, false, ok)
+}
+
 func TestConvertToIndexed_OK(t *testing.T) {
 	helpers.ClearCache()
 	validators := make([]*ethpb.Validator, 2*params.BeaconConfig().SlotsPerEpoch)
@@ -389,7 +389,7 @@ func TestFuzzverifyDeposit_10000(t *testing.T) {
 		fuzzer.Fuzz(deposit)
 		s, err := stateV0.InitializeFromProtoUnsafe(state)
 		require.NoError(t, err)
-		err = verifyDeposit(s, deposit)
+		err = verifyDeposit(s, deposit, 0)
 		_ = err
 	}
 }
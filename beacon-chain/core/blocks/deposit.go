@@ -5,18 +5,53 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/depositutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
 )
 
+var invalidDepositSignatureCount = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "invalid_deposit_signature_count",
+	Help: "The number of processed deposits whose signature failed batch verification.",
+})
+
+// depositSignatureCache stores the results of deposit signature verifications, so that deposits
+// replayed during state regeneration do not need to be verified again.
+var depositSignatureCache = cache.NewDepositSignatureCache()
+
+// finalizedDepositCountKey is the context key of the finalized deposit count.
+type finalizedDepositCountKey struct{}
+
+// WithFinalizedDepositCount returns a context carrying the number of deposits processed by the
+// finalized beacon state, given by its eth1 deposit index. The Merkle proofs of these deposits were
+// verified when the finalized blocks were processed, so they can be skipped when replaying blocks.
+func WithFinalizedDepositCount(ctx context.Context, count uint64) context.Context {
+	return context.WithValue(ctx, finalizedDepositCountKey{}, count)
+}
+
+// finalizedDepositCount returns the finalized deposit count carried by the context, if any.
+func finalizedDepositCount(ctx context.Context) uint64 {
+	count, ok := ctx.Value(finalizedDepositCountKey{}).(uint64)
+	if !ok {
+		return 0
+	}
+	return count
+}
+
 // ProcessPreGenesisDeposits processes a deposit for the beacon state before chainstart.
 func ProcessPreGenesisDeposits(
 	ctx context.Context,
@@ -45,12 +80,7 @@ func ProcessPreGenesisDeposits(
 		if err != nil {
 			return nil, err
 		}
-		validator.EffectiveBalance = mathutil.Min(balance-balance%params.BeaconConfig().EffectiveBalanceIncrement, params.BeaconConfig().MaxEffectiveBalance)
-		if validator.EffectiveBalance ==
-			params.BeaconConfig().MaxEffectiveBalance {
-			validator.ActivationEligibilityEpoch = 0
-			validator.ActivationEpoch = 0
-		}
+		activatePreGenesisValidator(validator, balance)
 		if err := beaconState.UpdateValidatorAtIndex(index, validator); err != nil {
 			return nil, err
 		}
@@ -58,6 +88,101 @@ func ProcessPreGenesisDeposits(
 	return beaconState, nil
 }
 
+// ProcessPreGenesisDepositsBulk processes a large set of deposits for the beacon state before
+// chainstart. Rather than appending validators one at a time as ProcessPreGenesisDeposits does,
+// the validator registry and balances are pre-allocated and set on the state once, so that
+// their field tries are only built once.
+func ProcessPreGenesisDepositsBulk(
+	ctx context.Context,
+	beaconState iface.BeaconState,
+	deposits []*ethpb.Deposit,
+) (iface.BeaconState, error) {
+	for _, deposit := range deposits {
+		if deposit == nil || deposit.Data == nil {
+			return nil, errors.New("got a nil deposit")
+		}
+	}
+	eth1Data := beaconState.Eth1Data()
+	if eth1Data == nil {
+		return nil, errors.New("received nil eth1data in the beacon state")
+	}
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	invalidSignatures := make([]bool, len(deposits))
+	if err := verifyDepositDataWithDomain(ctx, deposits, domain); err != nil {
+		log.WithError(err).Debug("Failed to verify deposit data, isolating invalid deposit signatures")
+		invalidSignatures, err = findInvalidDepositSignatures(ctx, deposits, domain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	numVals := beaconState.NumValidators()
+	vals := make([]*ethpb.Validator, numVals, numVals+len(deposits))
+	copy(vals, beaconState.Validators())
+	bals := make([]uint64, numVals, numVals+len(deposits))
+	copy(bals, beaconState.Balances())
+	indices := make(map[[48]byte]types.ValidatorIndex, numVals+len(deposits))
+	for i, val := range vals {
+		indices[bytesutil.ToBytes48(val.PublicKey)] = types.ValidatorIndex(i)
+	}
+
+	depositIndex := beaconState.Eth1DepositIndex()
+	for i, deposit := range deposits {
+		if err := verifyDepositAtIndex(eth1Data, deposit, depositIndex); err != nil {
+			return nil, errors.Wrapf(err, "could not verify deposit from %#x", bytesutil.Trunc(deposit.Data.PublicKey))
+		}
+		depositIndex++
+		if invalidSignatures[i] {
+			invalidDepositSignatureCount.Inc()
+		}
+		pubKey := bytesutil.ToBytes48(deposit.Data.PublicKey)
+		if index, ok := indices[pubKey]; ok {
+			bals[index] = helpers.IncreaseBalanceWithVal(bals[index], deposit.Data.Amount)
+			continue
+		}
+		if invalidSignatures[i] {
+			if err := verifyDepositDataSigningRoot(deposit.Data, domain); err != nil {
+				// Ignore this error as in the spec pseudo code.
+				log.Debugf("Skipping deposit: could not verify deposit data signature: %v", err)
+				continue
+			}
+		}
+		indices[pubKey] = types.ValidatorIndex(len(vals))
+		vals = append(vals, validatorFromDeposit(deposit.Data))
+		bals = append(bals, deposit.Data.Amount)
+	}
+	for _, deposit := range deposits {
+		if index, ok := indices[bytesutil.ToBytes48(deposit.Data.PublicKey)]; ok {
+			activatePreGenesisValidator(vals[index], bals[index])
+		}
+	}
+
+	if err := beaconState.SetValidators(vals); err != nil {
+		return nil, err
+	}
+	if err := beaconState.SetBalances(bals); err != nil {
+		return nil, err
+	}
+	if err := beaconState.SetEth1DepositIndex(depositIndex); err != nil {
+		return nil, err
+	}
+	return beaconState, nil
+}
+
+// activatePreGenesisValidator sets the effective balance of a validator before chainstart,
+// activating it at genesis if it has the maximum effective balance.
+func activatePreGenesisValidator(validator *ethpb.Validator, balance uint64) {
+	validator.EffectiveBalance = mathutil.Min(balance-balance%params.BeaconConfig().EffectiveBalanceIncrement, params.BeaconConfig().MaxEffectiveBalance)
+	if validator.EffectiveBalance ==
+		params.BeaconConfig().MaxEffectiveBalance {
+		validator.ActivationEligibilityEpoch = 0
+		validator.ActivationEpoch = 0
+	}
+}
+
 // ProcessDeposits is one of the operations performed on each processed
 // beacon block to verify queued validators from the Ethereum 1.0 Deposit Contract
 // into the beacon chain.
@@ -81,19 +206,31 @@ func ProcessDeposits(
 		return nil, err
 	}
 
-	// Attempt to verify all deposit signatures at once, if this fails then fall back to processing
-	// individual deposits with signature verification enabled.
-	var verifySignature bool
+	// Attempt to verify all deposit signatures at once, if this fails then bisect the deposits to
+	// isolate the ones with invalid signatures. Only those are processed with signature verification
+	// enabled, the rest have been verified in sub-batches.
+	invalidSignatures := make([]bool, len(deposits))
 	if err := verifyDepositDataWithDomain(ctx, deposits, domain); err != nil {
-		log.WithError(err).Debug("Failed to verify deposit data, verifying signatures individually")
-		verifySignature = true
+		log.WithError(err).Debug("Failed to verify deposit data, isolating invalid deposit signatures")
+		invalidSignatures, err = findInvalidDepositSignatures(ctx, deposits, domain)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	for _, deposit := range deposits {
+	finalizedCount := finalizedDepositCount(ctx)
+	for i, deposit := range deposits {
 		if deposit == nil || deposit.Data == nil {
 			return nil, errors.New("got a nil deposit in block")
 		}
-		beaconState, err = ProcessDeposit(beaconState, deposit, verifySignature)
+		if invalidSignatures[i] {
+			invalidDepositSignatureCount.Inc()
+			log.WithFields(logrus.Fields{
+				"pubkey":       fmt.Sprintf("%#x", bytesutil.Trunc(deposit.Data.PublicKey)),
+				"depositIndex": beaconState.Eth1DepositIndex(),
+			}).Debug("Deposit signature failed batch verification")
+		}
+		beaconState, err = processDeposit(beaconState, deposit, invalidSignatures[i], finalizedCount)
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not process deposit from %#x", bytesutil.Trunc(deposit.Data.PublicKey))
 		}
@@ -141,7 +278,18 @@ func ProcessDeposits(
 //        index = ValidatorIndex(validator_pubkeys.index(pubkey))
 //        increase_balance(state, index, amount)
 func ProcessDeposit(beaconState iface.BeaconState, deposit *ethpb.Deposit, verifySignature bool) (iface.BeaconState, error) {
-	if err := verifyDeposit(beaconState, deposit); err != nil {
+	return processDeposit(beaconState, deposit, verifySignature, 0)
+}
+
+// processDeposit processes a deposit as ProcessDeposit does, given the number of deposits processed by
+// the finalized beacon state.
+func processDeposit(
+	beaconState iface.BeaconState,
+	deposit *ethpb.Deposit,
+	verifySignature bool,
+	finalizedCount uint64,
+) (iface.BeaconState, error) {
+	if err := verifyDeposit(beaconState, deposit, finalizedCount); err != nil {
 		if deposit == nil || deposit.Data == nil {
 			return nil, err
 		}
@@ -166,19 +314,7 @@ func ProcessDeposit(beaconState iface.BeaconState, deposit *ethpb.Deposit, verif
 			}
 		}
 
-		effectiveBalance := amount - (amount % params.BeaconConfig().EffectiveBalanceIncrement)
-		if params.BeaconConfig().MaxEffectiveBalance < effectiveBalance {
-			effectiveBalance = params.BeaconConfig().MaxEffectiveBalance
-		}
-		if err := beaconState.AppendValidator(&ethpb.Validator{
-			PublicKey:                  pubKey,
-			WithdrawalCredentials:      deposit.Data.WithdrawalCredentials,
-			ActivationEligibilityEpoch: params.BeaconConfig().FarFutureEpoch,
-			ActivationEpoch:            params.BeaconConfig().FarFutureEpoch,
-			ExitEpoch:                  params.BeaconConfig().FarFutureEpoch,
-			WithdrawableEpoch:          params.BeaconConfig().FarFutureEpoch,
-			EffectiveBalance:           effectiveBalance,
-		}); err != nil {
+		if err := beaconState.AppendValidator(validatorFromDeposit(deposit.Data)); err != nil {
 			return nil, err
 		}
 		if err := beaconState.AppendBalance(amount); err != nil {
@@ -191,7 +327,25 @@ func ProcessDeposit(beaconState iface.BeaconState, deposit *ethpb.Deposit, verif
 	return beaconState, nil
 }
 
-func verifyDeposit(beaconState iface.ReadOnlyBeaconState, deposit *ethpb.Deposit) error {
+// validatorFromDeposit returns a new validator for the given deposit data, which is not
+// yet eligible for activation.
+func validatorFromDeposit(data *ethpb.Deposit_Data) *ethpb.Validator {
+	effectiveBalance := data.Amount - (data.Amount % params.BeaconConfig().EffectiveBalanceIncrement)
+	if params.BeaconConfig().MaxEffectiveBalance < effectiveBalance {
+		effectiveBalance = params.BeaconConfig().MaxEffectiveBalance
+	}
+	return &ethpb.Validator{
+		PublicKey:                  data.PublicKey,
+		WithdrawalCredentials:      data.WithdrawalCredentials,
+		ActivationEligibilityEpoch: params.BeaconConfig().FarFutureEpoch,
+		ActivationEpoch:            params.BeaconConfig().FarFutureEpoch,
+		ExitEpoch:                  params.BeaconConfig().FarFutureEpoch,
+		WithdrawableEpoch:          params.BeaconConfig().FarFutureEpoch,
+		EffectiveBalance:           effectiveBalance,
+	}
+}
+
+func verifyDeposit(beaconState iface.ReadOnlyBeaconState, deposit *ethpb.Deposit, finalizedCount uint64) error {
 	// Verify Merkle proof of deposit and deposit trie root.
 	if deposit == nil || deposit.Data == nil {
 		return errors.New("received nil deposit or nil deposit data")
@@ -200,7 +354,20 @@ func verifyDeposit(beaconState iface.ReadOnlyBeaconState, deposit *ethpb.Deposit
 	if eth1Data == nil {
 		return errors.New("received nil eth1data in the beacon state")
 	}
+	index := beaconState.Eth1DepositIndex()
+	if featureconfig.Get().SkipFinalizedDepositProofs && index < finalizedCount {
+		// The Merkle proof only depends on the deposit, its index and the deposit root, and a deposit
+		// below the finalized deposit count was verified when its finalized block was processed. Blocks
+		// which are not replays of verified blocks must descend from the finalized checkpoint, so their
+		// deposits start at or above the finalized deposit count and are always verified.
+		return nil
+	}
+	return verifyDepositAtIndex(eth1Data, deposit, index)
+}
 
+// verifyDepositAtIndex verifies the Merkle proof of a deposit at the given deposit index
+// against the deposit root of the eth1 data.
+func verifyDepositAtIndex(eth1Data *ethpb.Eth1Data, deposit *ethpb.Deposit, index uint64) error {
 	receiptRoot := eth1Data.DepositRoot
 	leaf, err := deposit.Data.HashTreeRoot()
 	if err != nil {
@@ -209,7 +376,7 @@ func verifyDeposit(beaconState iface.ReadOnlyBeaconState, deposit *ethpb.Deposit
 	if ok := trieutil.VerifyMerkleBranch(
 		receiptRoot,
 		leaf[:],
-		int(beaconState.Eth1DepositIndex()),
+		int(index),
 		deposit.Proof,
 		params.BeaconConfig().DepositContractTreeDepth,
 	); !ok {
@@ -223,29 +390,69 @@ func verifyDeposit(beaconState iface.ReadOnlyBeaconState, deposit *ethpb.Deposit
 }
 
 func verifyDepositDataSigningRoot(obj *ethpb.Deposit_Data, domain []byte) error {
-	return depositutil.VerifyDepositSignature(obj, domain)
+	key, err := depositSignatureCacheKey(obj, domain)
+	if err != nil {
+		return err
+	}
+	if valid, ok := depositSignatureCache.Get(key); ok {
+		if !valid {
+			return errors.New("deposit signature previously failed verification")
+		}
+		return nil
+	}
+	if err := depositutil.VerifyDepositSignature(obj, domain); err != nil {
+		depositSignatureCache.Add(key, false)
+		return err
+	}
+	depositSignatureCache.Add(key, true)
+	return nil
+}
+
+// retrieves the deposit signature cache key of the deposit data, derived from its hash tree root
+// and the domain its signature is verified with.
+func depositSignatureCacheKey(obj *ethpb.Deposit_Data, domain []byte) ([32]byte, error) {
+	if obj == nil {
+		return [32]byte{}, errors.New("nil deposit data")
+	}
+	root, err := obj.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not tree hash deposit data")
+	}
+	return hashutil.Hash(append(root[:], domain...)), nil
 }
 
 func verifyDepositDataWithDomain(ctx context.Context, deps []*ethpb.Deposit, domain []byte) error {
 	if len(deps) == 0 {
 		return nil
 	}
-	pks := make([]bls.PublicKey, len(deps))
-	sigs := make([][]byte, len(deps))
-	msgs := make([][32]byte, len(deps))
-	for i, dep := range deps {
+	pks := make([]bls.PublicKey, 0, len(deps))
+	sigs := make([][]byte, 0, len(deps))
+	msgs := make([][32]byte, 0, len(deps))
+	keys := make([][32]byte, 0, len(deps))
+	for _, dep := range deps {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
 		if dep == nil || dep.Data == nil {
 			return errors.New("nil deposit")
 		}
+		// Deposits which were already verified are not verified again.
+		key, err := depositSignatureCacheKey(dep.Data, domain)
+		if err != nil {
+			return err
+		}
+		if valid, ok := depositSignatureCache.Get(key); ok {
+			if !valid {
+				return errors.New("one or more deposit signatures previously failed verification")
+			}
+			continue
+		}
 		dpk, err := bls.PublicKeyFromBytes(dep.Data.PublicKey)
 		if err != nil {
 			return err
 		}
-		pks[i] = dpk
-		sigs[i] = dep.Data.Signature
+		pks = append(pks, dpk)
+		sigs = append(sigs, dep.Data.Signature)
 		depositMessage := &pb.DepositMessage{
 			PublicKey:             dep.Data.PublicKey,
 			WithdrawalCredentials: dep.Data.WithdrawalCredentials,
@@ -255,7 +462,11 @@ func verifyDepositDataWithDomain(ctx context.Context, deps []*ethpb.Deposit, dom
 		if err != nil {
 			return err
 		}
-		msgs[i] = sr
+		msgs = append(msgs, sr)
+		keys = append(keys, key)
+	}
+	if len(sigs) == 0 {
+		return nil
 	}
 	verify, err := bls.VerifyMultipleSignatures(sigs, msgs, pks)
 	if err != nil {
@@ -264,5 +475,43 @@ func verifyDepositDataWithDomain(ctx context.Context, deps []*ethpb.Deposit, dom
 	if !verify {
 		return errors.New("one or more deposit signatures did not verify")
 	}
+	for _, key := range keys {
+		depositSignatureCache.Add(key, true)
+	}
 	return nil
 }
+
+// findInvalidDepositSignatures bisects a batch of deposits which failed signature verification,
+// verifying them in sub-batches until the deposits with invalid signatures are isolated. It returns
+// whether the signature of each deposit is invalid. Sub-batches which verify are cached as valid, which
+// preserves most of the batch verification speedup when only a few deposits are invalid.
+func findInvalidDepositSignatures(ctx context.Context, deps []*ethpb.Deposit, domain []byte) ([]bool, error) {
+	invalid := make([]bool, len(deps))
+	var bisect func(lo, hi int) error
+	bisect = func(lo, hi int) error {
+		if hi-lo == 1 {
+			invalid[lo] = true
+			return nil
+		}
+		mid := lo + (hi-lo)/2
+		for _, r := range [][2]int{{lo, mid}, {mid, hi}} {
+			if err := verifyDepositDataWithDomain(ctx, deps[r[0]:r[1]], domain); err == nil {
+				continue
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := bisect(r[0], r[1]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(deps) == 0 {
+		return invalid, nil
+	}
+	if err := bisect(0, len(deps)); err != nil {
+		return nil, err
+	}
+	return invalid, nil
+}
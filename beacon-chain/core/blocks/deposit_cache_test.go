@@ -0,0 +1,105 @@
+package blocks
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestVerifyDepositDataWithDomain_CachesResults(t *testing.T) {
+	depositSignatureCache = cache.NewDepositSignatureCache()
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	require.NoError(t, err)
+	deposits := signedDeposits(t, 2, domain)
+
+	require.NoError(t, verifyDepositDataWithDomain(context.Background(), deposits, domain))
+	for _, dep := range deposits {
+		key, err := depositSignatureCacheKey(dep.Data, domain)
+		require.NoError(t, err)
+		valid, ok := depositSignatureCache.Get(key)
+		assert.Equal(t, true, ok, "Expected deposit to be cached")
+		assert.Equal(t, true, valid)
+	}
+	// Cached deposits are accepted without being verified again.
+	require.NoError(t, verifyDepositDataWithDomain(context.Background(), deposits, domain))
+}
+
+func TestVerifyDepositDataSigningRoot_CachesInvalidSignature(t *testing.T) {
+	depositSignatureCache = cache.NewDepositSignatureCache()
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	require.NoError(t, err)
+	deposits := signedDeposits(t, 2, domain)
+	bad := &ethpb.Deposit_Data{
+		PublicKey:             deposits[0].Data.PublicKey,
+		WithdrawalCredentials: deposits[0].Data.WithdrawalCredentials,
+		Amount:                deposits[0].Data.Amount,
+		Signature:             deposits[1].Data.Signature,
+	}
+
+	require.NotNil(t, verifyDepositDataSigningRoot(bad, domain))
+	key, err := depositSignatureCacheKey(bad, domain)
+	require.NoError(t, err)
+	valid, ok := depositSignatureCache.Get(key)
+	assert.Equal(t, true, ok, "Expected deposit to be cached")
+	assert.Equal(t, false, valid)
+
+	// A batch containing a deposit known to be invalid fails without verifying the batch.
+	err = verifyDepositDataWithDomain(context.Background(), []*ethpb.Deposit{deposits[1], {Data: bad}}, domain)
+	assert.ErrorContains(t, "previously failed verification", err)
+}
+
+func TestFindInvalidDepositSignatures(t *testing.T) {
+	depositSignatureCache = cache.NewDepositSignatureCache()
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	require.NoError(t, err)
+	deposits := signedDeposits(t, 7, domain)
+	for _, i := range []int{2, 5} {
+		deposits[i].Data.Signature = deposits[i-1].Data.Signature
+	}
+	require.NotNil(t, verifyDepositDataWithDomain(context.Background(), deposits, domain))
+
+	invalid, err := findInvalidDepositSignatures(context.Background(), deposits, domain)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []bool{false, false, true, false, false, true, false}, invalid)
+	// Deposits verified in valid sub-batches are cached.
+	for i, dep := range deposits {
+		if invalid[i] {
+			continue
+		}
+		key, err := depositSignatureCacheKey(dep.Data, domain)
+		require.NoError(t, err)
+		valid, ok := depositSignatureCache.Get(key)
+		assert.Equal(t, true, ok, "Expected deposit %d to be cached", i)
+		assert.Equal(t, true, valid)
+	}
+}
+
+func signedDeposits(t *testing.T, n int, domain []byte) []*ethpb.Deposit {
+	deposits := make([]*ethpb.Deposit, n)
+	for i := range deposits {
+		priv, err := bls.RandKey()
+		require.NoError(t, err)
+		msg := &pb.DepositMessage{
+			PublicKey:             priv.PublicKey().Marshal(),
+			WithdrawalCredentials: make([]byte, 32),
+			Amount:                params.BeaconConfig().MaxEffectiveBalance,
+		}
+		sr, err := helpers.ComputeSigningRoot(msg, domain)
+		require.NoError(t, err)
+		deposits[i] = &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+			PublicKey:             msg.PublicKey,
+			WithdrawalCredentials: msg.WithdrawalCredentials,
+			Amount:                msg.Amount,
+			Signature:             priv.Sign(sr[:]).Marshal(),
+		}}
+	}
+	return deposits
+}
@@ -8,10 +8,12 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -225,6 +227,52 @@ func TestProcessDeposit_AddsNewValidatorDeposit(t *testing.T) {
 	}
 }
 
+func TestProcessDeposits_SkipFinalizedDepositProofs(t *testing.T) {
+	deposit := &ethpb.Deposit{
+		Data: &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{1, 2, 3}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			Signature:             make([]byte, 96),
+		},
+		Proof: make([][]byte, params.BeaconConfig().DepositContractTreeDepth+1),
+	}
+	b := testutil.NewBeaconBlock()
+	b.Block.Body.Deposits = []*ethpb.Deposit{deposit}
+	newState := func() iface.BeaconState {
+		beaconState, err := stateV0.InitializeFromProto(&pb.BeaconState{
+			Eth1Data: &ethpb.Eth1Data{
+				DepositRoot: []byte{0},
+				BlockHash:   []byte{1},
+			},
+		})
+		require.NoError(t, err)
+		return beaconState
+	}
+	want := "deposit root did not verify"
+	ctx := blocks.WithFinalizedDepositCount(context.Background(), 1)
+
+	// Proofs of finalized deposits are verified unless the feature is enabled.
+	_, err := blocks.ProcessDeposits(ctx, newState(), b)
+	assert.ErrorContains(t, want, err)
+
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{SkipFinalizedDepositProofs: true})
+	defer resetCfg()
+	_, err = blocks.ProcessDeposits(ctx, newState(), b)
+	require.NoError(t, err)
+
+	// Deposits above the finalized deposit count are always verified.
+	beaconState := newState()
+	require.NoError(t, beaconState.SetEth1DepositIndex(1))
+	_, err = blocks.ProcessDeposits(ctx, beaconState, b)
+	assert.ErrorContains(t, want, err)
+
+	// Deposits are verified when the finalized deposit count is not known.
+	_, err = blocks.ProcessDeposits(context.Background(), newState(), b)
+	assert.ErrorContains(t, want, err)
+	_, err = blocks.ProcessDeposit(newState(), deposit, false)
+	assert.ErrorContains(t, want, err)
+}
+
 func TestProcessDeposit_SkipsInvalidDeposit(t *testing.T) {
 	// Same test settings as in TestProcessDeposit_AddsNewValidatorDeposit, except that we use an invalid signature
 	dep, _, err := testutil.DeterministicDepositsAndKeys(1)
@@ -339,3 +387,56 @@ func TestPreGenesisDeposits_SkipInvalidDeposit(t *testing.T) {
 		t.Errorf("Expected validator balance at index 0 to stay 0, received: %v", newState.Balances()[0])
 	}
 }
+
+func TestPreGenesisDepositsBulk_MatchesPreGenesisDeposits(t *testing.T) {
+	testutil.ResetCache()
+	dep, _, err := testutil.DeterministicDepositsAndKeys(100)
+	require.NoError(t, err)
+	defer testutil.ResetCache()
+	// Include an invalid deposit and a top up of an existing validator.
+	dep[0].Data.Signature = make([]byte, 96)
+	topUp := &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+		PublicKey:             dep[1].Data.PublicKey,
+		WithdrawalCredentials: dep[1].Data.WithdrawalCredentials,
+		Amount:                params.BeaconConfig().EffectiveBalanceIncrement,
+		Signature:             make([]byte, 96),
+	}}
+	dep = append(dep, topUp)
+	trie, _, err := testutil.DepositTrieFromDeposits(dep)
+	require.NoError(t, err)
+	for i := range dep {
+		proof, err := trie.MerkleProof(i)
+		require.NoError(t, err)
+		dep[i].Proof = proof
+	}
+	root := trie.Root()
+	beaconState, err := stateV0.InitializeFromProto(&pb.BeaconState{
+		Validators: []*ethpb.Validator{{PublicKey: []byte{1}, WithdrawalCredentials: []byte{1, 2, 3}}},
+		Balances:   []uint64{0},
+		Eth1Data:   &ethpb.Eth1Data{DepositRoot: root[:], DepositCount: uint64(len(dep))},
+		Fork: &pb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+		},
+	})
+	require.NoError(t, err)
+
+	want, err := blocks.ProcessPreGenesisDeposits(context.Background(), beaconState.Copy(), dep)
+	require.NoError(t, err)
+	got, err := blocks.ProcessPreGenesisDepositsBulk(context.Background(), beaconState.Copy(), dep)
+	require.NoError(t, err)
+
+	assert.Equal(t, want.Eth1DepositIndex(), got.Eth1DepositIndex())
+	assert.DeepEqual(t, want.Validators(), got.Validators())
+	assert.DeepEqual(t, want.Balances(), got.Balances())
+	_, ok := got.ValidatorIndexByPubkey(bytesutil.ToBytes48(dep[0].Data.PublicKey))
+	assert.Equal(t, false, ok, "Bad pubkey should not exist in state")
+	index, ok := got.ValidatorIndexByPubkey(bytesutil.ToBytes48(dep[1].Data.PublicKey))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, types.ValidatorIndex(1), index)
+	wantRoot, err := want.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	gotRoot, err := got.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, gotRoot)
+}
@@ -74,6 +74,41 @@ func ProcessVoluntaryExits(
 	return beaconState, nil
 }
 
+// ProcessVoluntaryExitsNoVerifySignature processes all the voluntary exits in
+// a block without verifying their signatures. The signatures are expected to be
+// verified in a batch using the set returned by ExitSignatureSet.
+//
+// WARNING: This method does not verify exit signatures.
+func ProcessVoluntaryExitsNoVerifySignature(
+	_ context.Context,
+	beaconState iface.BeaconState,
+	b *ethpb.SignedBeaconBlock,
+) (iface.BeaconState, error) {
+	if err := helpers.VerifyNilBeaconBlock(b); err != nil {
+		return nil, err
+	}
+
+	body := b.Block.Body
+	exits := body.VoluntaryExits
+	for idx, exit := range exits {
+		if exit == nil || exit.Exit == nil {
+			return nil, errors.New("nil voluntary exit in block body")
+		}
+		val, err := beaconState.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifyExitConditions(val, beaconState.Slot(), exit.Exit); err != nil {
+			return nil, errors.Wrapf(err, "could not verify exit %d", idx)
+		}
+		beaconState, err = v.InitiateValidatorExit(beaconState, exit.Exit.ValidatorIndex)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return beaconState, nil
+}
+
 // VerifyExitAndSignature implements the spec defined validation for voluntary exits.
 //
 // Spec pseudocode definition:
@@ -130,3 +130,39 @@ func TestProcessVoluntaryExits_AppliesCorrectStatus(t *testing.T) {
 			helpers.ActivationExitEpoch(types.Epoch(state.Slot()/params.BeaconConfig().SlotsPerEpoch)), newRegistry[0].ExitEpoch)
 	}
 }
+
+func TestProcessVoluntaryExitsNoVerifySignature_SignatureSetVerifies(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
+	err := beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().ShardCommitteePeriod)))
+	require.NoError(t, err)
+
+	exits := []*ethpb.SignedVoluntaryExit{
+		{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 0, Epoch: 0}},
+		{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 1, Epoch: 0}},
+	}
+	for i, e := range exits {
+		e.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, e.Exit, params.BeaconConfig().DomainVoluntaryExit, privKeys[i])
+		require.NoError(t, err)
+	}
+	b := testutil.NewBeaconBlock()
+	b.Block.Body.VoluntaryExits = exits
+
+	newState, err := blocks.ProcessVoluntaryExitsNoVerifySignature(context.Background(), beaconState.Copy(), b)
+	require.NoError(t, err)
+	assert.NotEqual(t, params.BeaconConfig().FarFutureEpoch, newState.Validators()[0].ExitEpoch)
+
+	set, err := blocks.ExitSignatureSet(beaconState, exits)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(set.Signatures))
+	verified, err := set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, true, verified, "Could not verify signature set")
+
+	// An exit signed by the wrong key must fail the batch.
+	exits[1].Signature = exits[0].Signature
+	set, err = blocks.ExitSignatureSet(beaconState, exits)
+	require.NoError(t, err)
+	verified, err = set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, false, verified, "Expected signature set to fail verification")
+}
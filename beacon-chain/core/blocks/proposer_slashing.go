@@ -63,10 +63,63 @@ func ProcessProposerSlashings(
 	return beaconState, nil
 }
 
+// ProcessProposerSlashingsNoVerifySignature processes the proposer slashings in a
+// block without verifying the signatures of the slashed headers. The signatures are
+// expected to be verified in a batch using the set returned by ProposerSlashingSignatureSet.
+//
+// WARNING: This method does not verify proposer slashing signatures.
+func ProcessProposerSlashingsNoVerifySignature(
+	_ context.Context,
+	beaconState iface.BeaconState,
+	b *ethpb.SignedBeaconBlock,
+) (iface.BeaconState, error) {
+	if err := helpers.VerifyNilBeaconBlock(b); err != nil {
+		return nil, err
+	}
+
+	body := b.Block.Body
+	var err error
+	for idx, slashing := range body.ProposerSlashings {
+		if slashing == nil {
+			return nil, errors.New("nil proposer slashings in block body")
+		}
+		if err = verifyProposerSlashingConditions(beaconState, slashing); err != nil {
+			return nil, errors.Wrapf(err, "could not verify proposer slashing %d", idx)
+		}
+		beaconState, err = v.SlashValidator(
+			beaconState, slashing.Header_1.Header.ProposerIndex,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not slash proposer index %d", slashing.Header_1.Header.ProposerIndex)
+		}
+	}
+	return beaconState, nil
+}
+
 // VerifyProposerSlashing verifies that the data provided from slashing is valid.
 func VerifyProposerSlashing(
 	beaconState iface.BeaconState,
 	slashing *ethpb.ProposerSlashing,
+) error {
+	if err := verifyProposerSlashingConditions(beaconState, slashing); err != nil {
+		return err
+	}
+	pIdx := slashing.Header_1.Header.ProposerIndex
+	hSlot := slashing.Header_1.Header.Slot
+	headers := []*ethpb.SignedBeaconBlockHeader{slashing.Header_1, slashing.Header_2}
+	for _, header := range headers {
+		if err := helpers.ComputeDomainVerifySigningRoot(beaconState, pIdx, helpers.SlotToEpoch(hSlot),
+			header.Header, params.BeaconConfig().DomainBeaconProposer, header.Signature); err != nil {
+			return errors.Wrap(err, "could not verify beacon block header")
+		}
+	}
+	return nil
+}
+
+// verifies the proposer slashing conditions, excluding the header signatures.
+func verifyProposerSlashingConditions(
+	beaconState iface.ReadOnlyBeaconState,
+	slashing *ethpb.ProposerSlashing,
 ) error {
 	if slashing.Header_1 == nil || slashing.Header_1.Header == nil || slashing.Header_2 == nil || slashing.Header_2.Header == nil {
 		return errors.New("nil header cannot be verified")
@@ -89,11 +142,26 @@ func VerifyProposerSlashing(
 	if !helpers.IsSlashableValidatorUsingTrie(proposer, helpers.CurrentEpoch(beaconState)) {
 		return fmt.Errorf("validator with key %#x is not slashable", proposer.PublicKey())
 	}
-	headers := []*ethpb.SignedBeaconBlockHeader{slashing.Header_1, slashing.Header_2}
-	for _, header := range headers {
-		if err := helpers.ComputeDomainVerifySigningRoot(beaconState, pIdx, helpers.SlotToEpoch(hSlot),
-			header.Header, params.BeaconConfig().DomainBeaconProposer, header.Signature); err != nil {
-			return errors.Wrap(err, "could not verify beacon block header")
+	return nil
+}
+
+// ErrDoubleProposal is returned when a block header conflicts with a header previously
+// seen from the same proposer at the same slot.
+var ErrDoubleProposal = errors.New("double proposal")
+
+// VerifyNotDoubleProposal checks a block header against the headers previously seen at its
+// slot, and returns ErrDoubleProposal if signing or broadcasting it would produce a
+// slashable proposer offense.
+func VerifyNotDoubleProposal(header *ethpb.BeaconBlockHeader, seen []*ethpb.BeaconBlockHeader) error {
+	if header == nil {
+		return errors.New("nil header")
+	}
+	for _, h := range seen {
+		if h == nil || h.Slot != header.Slot || h.ProposerIndex != header.ProposerIndex {
+			continue
+		}
+		if !proto.Equal(h, header) {
+			return errors.Wrapf(ErrDoubleProposal, "proposer %d already proposed a different block at slot %d", header.ProposerIndex, header.Slot)
 		}
 	}
 	return nil
@@ -2,6 +2,7 @@ package blocks_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -295,3 +296,81 @@ func TestVerifyProposerSlashing(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessProposerSlashingsNoVerifySignature_SignatureSetVerifies(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
+	proposerIdx := types.ValidatorIndex(1)
+
+	header1 := testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			ProposerIndex: proposerIdx,
+			StateRoot:     bytesutil.PadTo([]byte("A"), 32),
+		},
+	})
+	var err error
+	header1.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, header1.Header, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
+	require.NoError(t, err)
+	header2 := testutil.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			ProposerIndex: proposerIdx,
+			StateRoot:     bytesutil.PadTo([]byte("B"), 32),
+		},
+	})
+	header2.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, header2.Header, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
+	require.NoError(t, err)
+
+	block := testutil.NewBeaconBlock()
+	block.Block.Body.ProposerSlashings = []*ethpb.ProposerSlashing{{Header_1: header1, Header_2: header2}}
+
+	newState, err := blocks.ProcessProposerSlashingsNoVerifySignature(context.Background(), beaconState.Copy(), block)
+	require.NoError(t, err)
+	assert.Equal(t, true, newState.Validators()[proposerIdx].Slashed)
+
+	set, err := blocks.ProposerSlashingSignatureSet(beaconState, block.Block.Body.ProposerSlashings)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(set.Signatures))
+	verified, err := set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, true, verified, "Could not verify signature set")
+
+	// Corrupting one header signature must fail the batch.
+	header2.Signature = header1.Signature
+	set, err = blocks.ProposerSlashingSignatureSet(beaconState, block.Block.Body.ProposerSlashings)
+	require.NoError(t, err)
+	verified, err = set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, false, verified, "Expected signature set to fail verification")
+}
+
+func TestVerifyNotDoubleProposal(t *testing.T) {
+	header := &ethpb.BeaconBlockHeader{
+		Slot:          10,
+		ProposerIndex: 3,
+		ParentRoot:    bytesutil.PadTo([]byte("parent"), 32),
+		StateRoot:     bytesutil.PadTo([]byte("state"), 32),
+		BodyRoot:      bytesutil.PadTo([]byte("body"), 32),
+	}
+	conflicting := &ethpb.BeaconBlockHeader{
+		Slot:          10,
+		ProposerIndex: 3,
+		ParentRoot:    bytesutil.PadTo([]byte("parent"), 32),
+		StateRoot:     bytesutil.PadTo([]byte("state"), 32),
+		BodyRoot:      bytesutil.PadTo([]byte("other body"), 32),
+	}
+	otherProposer := &ethpb.BeaconBlockHeader{
+		Slot:          10,
+		ProposerIndex: 4,
+		BodyRoot:      bytesutil.PadTo([]byte("body"), 32),
+	}
+	otherSlot := &ethpb.BeaconBlockHeader{
+		Slot:          11,
+		ProposerIndex: 3,
+		BodyRoot:      bytesutil.PadTo([]byte("body"), 32),
+	}
+
+	require.NoError(t, blocks.VerifyNotDoubleProposal(header, nil))
+	require.NoError(t, blocks.VerifyNotDoubleProposal(header, []*ethpb.BeaconBlockHeader{header, otherProposer, otherSlot}))
+	err := blocks.VerifyNotDoubleProposal(header, []*ethpb.BeaconBlockHeader{otherSlot, conflicting})
+	assert.Equal(t, true, errors.Is(err, blocks.ErrDoubleProposal))
+	assert.ErrorContains(t, "nil header", blocks.VerifyNotDoubleProposal(nil, nil))
+}
@@ -223,3 +223,61 @@ func AttestationSignatureSet(ctx context.Context, beaconState iface.ReadOnlyBeac
 	}
 	return set.Join(aSet), nil
 }
+
+// ExitSignatureSet retrieves the signature set of all the voluntary exits provided, verified
+// against the validator public keys and fork data of the given state.
+func ExitSignatureSet(beaconState iface.ReadOnlyBeaconState, exits []*ethpb.SignedVoluntaryExit) (*bls.SignatureSet, error) {
+	set := bls.NewSet()
+	fork := beaconState.Fork()
+	gvr := beaconState.GenesisValidatorRoot()
+	for _, e := range exits {
+		if e == nil || e.Exit == nil {
+			return nil, errors.New("nil voluntary exit")
+		}
+		domain, err := helpers.Domain(fork, e.Exit.Epoch, params.BeaconConfig().DomainVoluntaryExit, gvr)
+		if err != nil {
+			return nil, err
+		}
+		root, err := e.Exit.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash voluntary exit")
+		}
+		pub := beaconState.PubkeyAtIndex(e.Exit.ValidatorIndex)
+		eSet, err := signatureSet(root[:], pub[:], e.Signature, domain)
+		if err != nil {
+			return nil, err
+		}
+		set.Join(eSet)
+	}
+	return set, nil
+}
+
+// ProposerSlashingSignatureSet retrieves the signature set of both headers of every proposer
+// slashing provided, verified against the proposer public keys and fork data of the given state.
+func ProposerSlashingSignatureSet(beaconState iface.ReadOnlyBeaconState, slashings []*ethpb.ProposerSlashing) (*bls.SignatureSet, error) {
+	set := bls.NewSet()
+	fork := beaconState.Fork()
+	gvr := beaconState.GenesisValidatorRoot()
+	for _, s := range slashings {
+		if s == nil || s.Header_1 == nil || s.Header_1.Header == nil || s.Header_2 == nil || s.Header_2.Header == nil {
+			return nil, errors.New("nil proposer slashing header")
+		}
+		for _, header := range []*ethpb.SignedBeaconBlockHeader{s.Header_1, s.Header_2} {
+			domain, err := helpers.Domain(fork, helpers.SlotToEpoch(header.Header.Slot), params.BeaconConfig().DomainBeaconProposer, gvr)
+			if err != nil {
+				return nil, err
+			}
+			root, err := header.Header.HashTreeRoot()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not hash block header")
+			}
+			pub := beaconState.PubkeyAtIndex(header.Header.ProposerIndex)
+			hSet, err := signatureSet(root[:], pub[:], header.Signature, domain)
+			if err != nil {
+				return nil, err
+			}
+			set.Join(hSet)
+		}
+	}
+	return set, nil
+}
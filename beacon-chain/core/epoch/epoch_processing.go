@@ -14,7 +14,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
-	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
@@ -177,17 +176,14 @@ func ProcessSlashings(state iface.BeaconState) (iface.BeaconState, error) {
 	// below equally.
 	increment := params.BeaconConfig().EffectiveBalanceIncrement
 	minSlashing := mathutil.Min(totalSlashing*params.BeaconConfig().ProportionalSlashingMultiplier, totalBalance)
-	err = state.ApplyToEveryValidator(func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error) {
-		correctEpoch := (currentEpoch + exitLength/2) == val.WithdrawableEpoch
-		if val.Slashed && correctEpoch {
-			penaltyNumerator := val.EffectiveBalance / increment * minSlashing
+	err = state.ReadFromEveryValidator(func(idx int, val iface.ReadOnlyValidator) error {
+		correctEpoch := (currentEpoch + exitLength/2) == val.WithdrawableEpoch()
+		if val.Slashed() && correctEpoch {
+			penaltyNumerator := val.EffectiveBalance() / increment * minSlashing
 			penalty := penaltyNumerator / totalBalance * increment
-			if err := helpers.DecreaseBalance(state, types.ValidatorIndex(idx), penalty); err != nil {
-				return false, val, err
-			}
-			return true, val, nil
+			return helpers.DecreaseBalance(state, types.ValidatorIndex(idx), penalty)
 		}
-		return false, val, nil
+		return nil
 	})
 	return state, err
 }
@@ -251,8 +247,8 @@ func ProcessFinalUpdates(state iface.BeaconState) (iface.BeaconState, error) {
 
 	bals := state.Balances()
 	// Update effective balances with hysteresis.
-	validatorFunc := func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error) {
-		if val == nil {
+	validatorFunc := func(idx int, val iface.ReadOnlyValidator) (bool, *ethpb.Validator, error) {
+		if val.IsNil() {
 			return false, nil, fmt.Errorf("validator %d is nil in state", idx)
 		}
 		if idx >= len(bals) {
@@ -260,15 +256,15 @@ func ProcessFinalUpdates(state iface.BeaconState) (iface.BeaconState, error) {
 		}
 		balance := bals[idx]
 
-		if balance+downwardThreshold < val.EffectiveBalance || val.EffectiveBalance+upwardThreshold < balance {
-			newVal := stateV0.CopyValidator(val)
+		if balance+downwardThreshold < val.EffectiveBalance() || val.EffectiveBalance()+upwardThreshold < balance {
+			newVal := val.Copy()
 			newVal.EffectiveBalance = maxEffBalance
 			if newVal.EffectiveBalance > balance-balance%effBalanceInc {
 				newVal.EffectiveBalance = balance - balance%effBalanceInc
 			}
 			return true, newVal, nil
 		}
-		return false, val, nil
+		return false, nil, nil
 	}
 
 	if err := state.ApplyToEveryValidator(validatorFunc); err != nil {
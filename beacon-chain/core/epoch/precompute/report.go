@@ -0,0 +1,71 @@
+package precompute
+
+import (
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+)
+
+// RewardReport breaks down the rewards and penalties of a validator in an epoch transition. The
+// attestation components are those of its attestation in the previous epoch.
+type RewardReport struct {
+	// SourceReward is the reward for attesting to the correct source.
+	SourceReward uint64
+	// TargetReward is the reward for attesting to the correct target.
+	TargetReward uint64
+	// HeadReward is the reward for attesting to the correct head.
+	HeadReward uint64
+	// InclusionDelayReward is the reward for the inclusion delay of the attestation.
+	InclusionDelayReward uint64
+	// ProposerReward is the reward for including the attestations of other validators as a proposer.
+	ProposerReward uint64
+	// SourcePenalty is the penalty for missing the source.
+	SourcePenalty uint64
+	// TargetPenalty is the penalty for missing the target.
+	TargetPenalty uint64
+	// HeadPenalty is the penalty for missing the head.
+	HeadPenalty uint64
+	// InactivityPenalty is the penalty of an inactivity leak.
+	InactivityPenalty uint64
+}
+
+// EpochReport reports the rewards and penalties applied to every validator by the epoch
+// transition at the end of an epoch.
+type EpochReport struct {
+	// Epoch is the epoch at the end of which the transition happened.
+	Epoch types.Epoch
+	// Validators holds the reward report of each validator, by validator index.
+	Validators []RewardReport
+}
+
+// NewEpochReport computes the report of the rewards and penalties that
+// ProcessRewardsAndPenaltiesPrecompute applies to a state, from the same precomputed validator
+// records and total epoch balances.
+func NewEpochReport(state iface.ReadOnlyBeaconState, pBal *Balance, vp []*Validator) (*EpochReport, error) {
+	report := &EpochReport{
+		Epoch:      helpers.CurrentEpoch(state),
+		Validators: make([]RewardReport, state.NumValidators()),
+	}
+	// Can't process rewards and penalties in genesis epoch.
+	if report.Epoch == 0 {
+		return report, nil
+	}
+	if len(vp) != len(report.Validators) {
+		return nil, errors.New("precomputed registries not the same length as state registries")
+	}
+
+	prevEpoch := helpers.PrevEpoch(state)
+	finalizedEpoch := state.FinalizedCheckpointEpoch()
+	for i, v := range vp {
+		report.Validators[i] = attestationDeltaReport(pBal, v, prevEpoch, finalizedEpoch)
+	}
+	proposerRewards, err := ProposersDelta(state, pBal, vp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer delta")
+	}
+	for i, r := range proposerRewards {
+		report.Validators[i].ProposerReward = r
+	}
+	return report, nil
+}
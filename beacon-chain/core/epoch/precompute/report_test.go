@@ -0,0 +1,74 @@
+package precompute
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestNewEpochReport(t *testing.T) {
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := uint64(2048)
+	base := buildState(e+3, validatorCount)
+	atts := make([]*pb.PendingAttestation, 3)
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Target: &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Source: &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			AggregationBits: bitfield.Bitlist{0x00, 0x00, 0x00, 0x00, 0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+			InclusionDelay:  1,
+		}
+	}
+	base.PreviousEpochAttestations = atts
+	beaconState, err := stateV0.InitializeFromProto(base)
+	require.NoError(t, err)
+
+	vp, bp, err := New(context.Background(), beaconState)
+	require.NoError(t, err)
+	vp, bp, err = ProcessAttestations(context.Background(), beaconState, vp, bp)
+	require.NoError(t, err)
+	report, err := NewEpochReport(beaconState, bp, vp)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), report.Epoch)
+	require.Equal(t, int(validatorCount), len(report.Validators))
+
+	before := beaconState.Balances()
+	processedState, err := ProcessRewardsAndPenaltiesPrecompute(beaconState, bp, vp)
+	require.NoError(t, err)
+	after := processedState.Balances()
+	var attested int
+	for i, r := range report.Validators {
+		rewards := r.SourceReward + r.TargetReward + r.HeadReward + r.InclusionDelayReward + r.ProposerReward
+		penalties := r.SourcePenalty + r.TargetPenalty + r.HeadPenalty + r.InactivityPenalty
+		assert.Equal(t, after[i], before[i]+rewards-penalties, "Unexpected balance of validator %d", i)
+		if r.SourceReward > 0 {
+			attested++
+			assert.NotEqual(t, uint64(0), r.InclusionDelayReward)
+			assert.Equal(t, uint64(0), r.SourcePenalty)
+		}
+	}
+	assert.NotEqual(t, 0, attested)
+}
+
+func TestNewEpochReport_GenesisEpoch(t *testing.T) {
+	beaconState, err := stateV0.InitializeFromProto(buildState(0, 128))
+	require.NoError(t, err)
+	vp, bp, err := New(context.Background(), beaconState)
+	require.NoError(t, err)
+	report, err := NewEpochReport(beaconState, bp, vp)
+	require.NoError(t, err)
+	require.Equal(t, 128, len(report.Validators))
+	for _, r := range report.Validators {
+		assert.DeepEqual(t, RewardReport{}, r)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 )
 
 const (
@@ -22,6 +23,10 @@ const (
 	// Reorg is an event sent when the new head state's slot after a block
 	// transition is lower than its previous head state slot value.
 	Reorg
+	// JustifiedCheckpoint is sent when the justified checkpoint of the node advances.
+	JustifiedCheckpoint
+	// FinalizedCheckpoint is sent when the finalized checkpoint of the node advances.
+	FinalizedCheckpoint
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -62,4 +67,34 @@ type ReorgData struct {
 	NewSlot types.Slot
 	// OldSlot is the slot of the head state before the reorg.
 	OldSlot types.Slot
+	// NewRoot is the block root of the head after the reorg.
+	NewRoot [32]byte
+	// OldRoot is the block root of the head before the reorg.
+	OldRoot [32]byte
+	// CommonAncestor is the block root of the common ancestor of the old and new heads, if it is known.
+	CommonAncestor [32]byte
+	// Depth is the number of slots between the old head and its common ancestor with the new head.
+	Depth uint64
+	// Time is the time at which the head switched.
+	Time time.Time
+}
+
+// HeadChangedData is the data sent to subscribers of head changes.
+type HeadChangedData struct {
+	// Slot is the slot of the new head block.
+	Slot types.Slot
+	// NewRoot is the block root of the new head.
+	NewRoot [32]byte
+	// OldRoot is the block root of the previous head.
+	OldRoot [32]byte
+}
+
+// CheckpointData is the data sent with JustifiedCheckpoint and FinalizedCheckpoint events.
+type CheckpointData struct {
+	// Checkpoint is the new justified or finalized checkpoint.
+	Checkpoint *ethpb.Checkpoint
+	// State is the post state of the checkpoint block. It is shared with the caches of the
+	// node and must not be modified. It is not set for FinalizedCheckpoint events of the
+	// state feed, which only carry the checkpoint.
+	State iface.ReadOnlyBeaconState
 }
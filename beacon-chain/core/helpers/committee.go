@@ -21,6 +21,17 @@ import (
 
 var committeeCache = cache.NewCommitteesCache()
 var proposerIndicesCache = cache.NewProposerIndicesCache()
+var validatorSummaryCache = cache.NewValidatorSummaryCache()
+
+func init() {
+	registerCaches()
+}
+
+// registerCaches registers the committee and proposer indices caches with the cache registry.
+func registerCaches() {
+	cache.DefaultRegistry.Register("committee", committeeCache)
+	cache.DefaultRegistry.Register("proposer-indices", proposerIndicesCache)
+}
 
 // SlotCommitteeCount returns the number of crosslink committees of a slot. The
 // active validator count is provided as an argument rather than a imported implementation
@@ -330,6 +341,39 @@ func UpdateCommitteeCache(state iface.ReadOnlyBeaconState, epoch types.Epoch) er
 	return nil
 }
 
+// CommitteeCacheEntries returns the cached shuffled committees of the given epoch and the next epoch,
+// so that they can be persisted and restored through LoadCommitteeCacheEntries.
+func CommitteeCacheEntries(state iface.ReadOnlyBeaconState, epoch types.Epoch) ([]*cache.Committees, error) {
+	var entries []*cache.Committees
+	for _, e := range []types.Epoch{epoch, epoch + 1} {
+		seed, err := Seed(state, e, params.BeaconConfig().DomainBeaconAttester)
+		if err != nil {
+			return nil, err
+		}
+		committees, err := committeeCache.CommitteesBySeed(seed)
+		if err != nil {
+			return nil, err
+		}
+		if committees != nil {
+			entries = append(entries, committees)
+		}
+	}
+	return entries, nil
+}
+
+// LoadCommitteeCacheEntries adds previously persisted shuffled committees to the committee cache.
+func LoadCommitteeCacheEntries(entries []*cache.Committees) error {
+	for _, committees := range entries {
+		if committeeCache.HasEntry(string(committees.Seed[:])) {
+			continue
+		}
+		if err := committeeCache.AddCommitteeShuffledList(committees); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // UpdateProposerIndicesInCache updates proposer indices entry of the committee cache.
 func UpdateProposerIndicesInCache(state iface.ReadOnlyBeaconState) error {
 	// The cache uses the state root at the (current epoch - 2)'s slot as key. (e.g. for epoch 2, the key is root at slot 31)
@@ -382,6 +426,8 @@ func UpdateProposerIndicesInCache(state iface.ReadOnlyBeaconState) error {
 func ClearCache() {
 	committeeCache = cache.NewCommitteesCache()
 	proposerIndicesCache = cache.NewProposerIndicesCache()
+	validatorSummaryCache = cache.NewValidatorSummaryCache()
+	registerCaches()
 }
 
 // This computes proposer indices of the current epoch and returns a list of proposer indices,
@@ -428,6 +428,40 @@ func TestUpdateCommitteeCache_CanUpdate(t *testing.T) {
 	assert.Equal(t, params.BeaconConfig().TargetCommitteeSize, uint64(len(indices)), "Did not save correct indices lengths")
 }
 
+func TestCommitteeCacheEntries_Roundtrip(t *testing.T) {
+	ClearCache()
+	validatorCount := params.BeaconConfig().MinGenesisActiveValidatorCount
+	validators := make([]*ethpb.Validator, validatorCount)
+	for i := 0; uint64(i) < validatorCount; i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	state, err := stateV0.InitializeFromProto(&pb.BeaconState{
+		Validators:  validators,
+		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	})
+	require.NoError(t, err)
+
+	entries, err := CommitteeCacheEntries(state, CurrentEpoch(state))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(entries))
+
+	require.NoError(t, UpdateCommitteeCache(state, CurrentEpoch(state)))
+	entries, err = CommitteeCacheEntries(state, CurrentEpoch(state))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+
+	ClearCache()
+	require.NoError(t, LoadCommitteeCacheEntries(entries))
+	seed, err := Seed(state, 1, params.BeaconConfig().DomainBeaconAttester)
+	require.NoError(t, err)
+	assert.Equal(t, true, committeeCache.HasEntry(string(seed[:])))
+	restored, err := CommitteeCacheEntries(state, CurrentEpoch(state))
+	require.NoError(t, err)
+	assert.DeepEqual(t, entries, restored)
+}
+
 func BenchmarkComputeCommittee300000_WithPreCache(b *testing.B) {
 	validators := make([]*ethpb.Validator, 300000)
 	for i := 0; i < len(validators); i++ {
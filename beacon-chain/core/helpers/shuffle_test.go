@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/benchutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -124,6 +125,23 @@ func BenchmarkShuffleList(b *testing.B) {
 	}
 }
 
+func BenchmarkShuffledIndices_ValidatorCounts(b *testing.B) {
+	for _, count := range benchutil.ValidatorCounts {
+		b.Run(fmt.Sprintf("validators=%d", count), func(b *testing.B) {
+			beaconState, _, err := benchutil.NewBeaconState(count, 2)
+			require.NoError(b, err)
+			epoch := CurrentEpoch(beaconState)
+
+			b.ResetTimer()
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, err := ShuffledIndices(beaconState, epoch)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
 func TestShuffledIndex(t *testing.T) {
 	var list []types.ValidatorIndex
 	listSize := uint64(399)
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -117,6 +118,16 @@ func ActiveValidatorCount(state iface.ReadOnlyBeaconState, epoch types.Epoch) (u
 		return uint64(activeCount), nil
 	}
 
+	if epoch == CurrentEpoch(state) {
+		summary, err := cachedValidatorSummary(state)
+		if err != nil {
+			return 0, err
+		}
+		if summary != nil {
+			return summary.ActiveCount, nil
+		}
+	}
+
 	count := uint64(0)
 	if err := state.ReadFromEveryValidator(func(idx int, val iface.ReadOnlyValidator) error {
 		if IsActiveValidatorUsingTrie(val, epoch) {
@@ -134,6 +145,90 @@ func ActiveValidatorCount(state iface.ReadOnlyBeaconState, epoch types.Epoch) (u
 	return count, nil
 }
 
+// ValidatorSummary returns the active validator count, total active balance and churn limit of
+// the current epoch of the state. These only change at epoch transitions, so they are cached
+// after a single scan of the registry per epoch.
+func ValidatorSummary(state iface.ReadOnlyBeaconState) (*cache.ValidatorSummary, error) {
+	summary, err := cachedValidatorSummary(state)
+	if err != nil {
+		return nil, err
+	}
+	if summary != nil {
+		return summary, nil
+	}
+
+	epoch := CurrentEpoch(state)
+	summary = &cache.ValidatorSummary{Epoch: epoch}
+	if err := state.ReadFromEveryValidator(func(idx int, val iface.ReadOnlyValidator) error {
+		if IsActiveValidatorUsingTrie(val, epoch) {
+			summary.ActiveCount++
+			summary.TotalActiveBalance += val.EffectiveBalance()
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	summary.ChurnLimit, err = ValidatorChurnLimit(summary.ActiveCount)
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok, err := validatorSummaryKey(state)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		summary.Root = r
+		if err := validatorSummaryCache.AddSummary(summary); err != nil {
+			return nil, errors.Wrap(err, "could not update validator summary cache")
+		}
+	}
+	return summary, nil
+}
+
+// UpdateValidatorSummaryCache gets called at the beginning of every epoch to cache the validator
+// summary of the new epoch.
+func UpdateValidatorSummaryCache(state iface.ReadOnlyBeaconState) error {
+	_, err := ValidatorSummary(state)
+	return err
+}
+
+// This returns the cached validator summary of the current epoch of the state, or nil if it
+// isn't cached.
+func cachedValidatorSummary(state iface.ReadOnlyBeaconState) (*cache.ValidatorSummary, error) {
+	r, ok, err := validatorSummaryKey(state)
+	if err != nil || !ok {
+		return nil, err
+	}
+	summary, err := validatorSummaryCache.Summary(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not interface with validator summary cache")
+	}
+	return summary, nil
+}
+
+// The validator summary cache uses the state root at the last slot of the previous epoch as key,
+// as the validator set of an epoch results from the epoch transition of that state. There is no
+// such root in the genesis epoch, which is not cached.
+func validatorSummaryKey(state iface.ReadOnlyBeaconState) ([32]byte, bool, error) {
+	epoch := CurrentEpoch(state)
+	if epoch == params.BeaconConfig().GenesisEpoch {
+		return [32]byte{}, false, nil
+	}
+	s, err := EndSlot(epoch - 1)
+	if err != nil {
+		return [32]byte{}, false, err
+	}
+	r, err := StateRootAtSlot(state, s)
+	if err != nil {
+		return [32]byte{}, false, err
+	}
+	if len(r) == 0 || bytes.Equal(r, params.BeaconConfig().ZeroHash[:]) {
+		return [32]byte{}, false, nil
+	}
+	return bytesutil.ToBytes32(r), true, nil
+}
+
 // ActivationExitEpoch takes in epoch number and returns when
 // the validator is eligible for activation and exit.
 //
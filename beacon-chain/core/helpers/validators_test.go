@@ -380,6 +380,59 @@ func TestActiveValidatorCount_Genesis(t *testing.T) {
 	assert.Equal(t, uint64(c), validatorCount, "Did not get the correct validator count")
 }
 
+func TestValidatorSummary(t *testing.T) {
+	ClearCache()
+	defer ClearCache()
+
+	validators := make([]*ethpb.Validator, 100)
+	for i := 0; i < len(validators); i++ {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+		}
+	}
+	// Validators exited before the current epoch are not part of the summary.
+	validators[0].ExitEpoch = 1
+	stateRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := 0; i < len(stateRoots); i++ {
+		stateRoots[i] = make([]byte, 32)
+	}
+	epochStart, err := StartSlot(2)
+	require.NoError(t, err)
+	stateRoots[epochStart-1] = bytesutil.PadTo([]byte{'a'}, 32)
+	beaconState, err := stateV0.InitializeFromProto(&pb.BeaconState{
+		Slot:        epochStart + 1,
+		Validators:  validators,
+		RandaoMixes: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		StateRoots:  stateRoots,
+	})
+	require.NoError(t, err)
+
+	summary, err := ValidatorSummary(beaconState)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(2), summary.Epoch)
+	assert.Equal(t, uint64(99), summary.ActiveCount)
+	assert.Equal(t, 99*params.BeaconConfig().MaxEffectiveBalance, summary.TotalActiveBalance)
+	assert.Equal(t, params.BeaconConfig().MinPerEpochChurnLimit, summary.ChurnLimit)
+
+	// The summary is served from the cache, without scanning the registry again.
+	require.NoError(t, beaconState.UpdateValidatorAtIndex(1, &ethpb.Validator{ExitEpoch: 1}))
+	cached, err := ValidatorSummary(beaconState)
+	require.NoError(t, err)
+	assert.DeepEqual(t, summary, cached)
+	count, err := ActiveValidatorCount(beaconState, CurrentEpoch(beaconState))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(99), count)
+
+	// States without the root preceding the epoch transition are not cached.
+	stateRoots[epochStart-1] = make([]byte, 32)
+	require.NoError(t, beaconState.SetStateRoots(stateRoots))
+	summary, err = ValidatorSummary(beaconState)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(98), summary.ActiveCount)
+	assert.Equal(t, [32]byte{}, summary.Root)
+}
+
 func TestChurnLimit_OK(t *testing.T) {
 	tests := []struct {
 		validatorCount int
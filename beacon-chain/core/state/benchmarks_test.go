@@ -2,16 +2,21 @@ package state_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	coreState "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/benchutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -159,6 +164,121 @@ func BenchmarkUnmarshalState_FullState(b *testing.B) {
 	})
 }
 
+func BenchmarkExecuteStateTransition_ValidatorCounts(b *testing.B) {
+	for _, count := range benchutil.ValidatorCounts {
+		b.Run(fmt.Sprintf("validators=%d", count), func(b *testing.B) {
+			beaconState, secretKey, err := benchutil.NewBeaconState(count, 2)
+			require.NoError(b, err)
+			block := emptyBlockForNextSlot(b, beaconState, secretKey)
+
+			b.ResetTimer()
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				st := beaconState.Copy()
+				b.StartTimer()
+				_, err := coreState.ExecuteStateTransition(context.Background(), st, block)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+func BenchmarkProcessEpochPrecompute_ValidatorCounts(b *testing.B) {
+	for _, count := range benchutil.ValidatorCounts {
+		b.Run(fmt.Sprintf("validators=%d", count), func(b *testing.B) {
+			beaconState, _, err := benchutil.NewBeaconState(count, 2)
+			require.NoError(b, err)
+
+			b.ResetTimer()
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				st := beaconState.Copy()
+				b.StartTimer()
+				_, err := coreState.ProcessEpochPrecompute(context.Background(), st)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+func BenchmarkHashTreeRoot_ValidatorCounts(b *testing.B) {
+	ctx := context.Background()
+	for _, count := range benchutil.ValidatorCounts {
+		beaconState, _, err := benchutil.NewBeaconState(count, 2)
+		require.NoError(b, err)
+		natState, err := stateV0.ProtobufBeaconState(beaconState.InnerStateUnsafe())
+		require.NoError(b, err)
+
+		b.Run(fmt.Sprintf("full/validators=%d", count), func(b *testing.B) {
+			b.ResetTimer()
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				st, err := stateV0.InitializeFromProto(natState)
+				require.NoError(b, err)
+				b.StartTimer()
+				_, err = st.HashTreeRoot(ctx)
+				require.NoError(b, err)
+			}
+		})
+
+		// Mutates a validator and a balance per epoch slot, as a block would, on top of an
+		// already hashed state.
+		b.Run(fmt.Sprintf("dirty/validators=%d", count), func(b *testing.B) {
+			_, err := beaconState.HashTreeRoot(ctx)
+			require.NoError(b, err)
+			slots := uint64(params.BeaconConfig().SlotsPerEpoch)
+
+			b.ResetTimer()
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				for j := uint64(0); j < slots; j++ {
+					idx := types.ValidatorIndex((uint64(i)*slots + j) % count)
+					val, err := beaconState.ValidatorAtIndex(idx)
+					require.NoError(b, err)
+					val.EffectiveBalance -= params.BeaconConfig().EffectiveBalanceIncrement
+					require.NoError(b, beaconState.UpdateValidatorAtIndex(idx, val))
+					require.NoError(b, beaconState.UpdateBalancesAtIndex(idx, val.EffectiveBalance))
+				}
+				b.StartTimer()
+				_, err := beaconState.HashTreeRoot(ctx)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+// emptyBlockForNextSlot returns a signed block without operations for the slot after the
+// one of the given state.
+func emptyBlockForNextSlot(b *testing.B, beaconState iface.BeaconState, secretKey bls.SecretKey) *ethpb.SignedBeaconBlock {
+	ctx := context.Background()
+	st, err := coreState.ProcessSlots(ctx, beaconState.Copy(), beaconState.Slot()+1)
+	require.NoError(b, err)
+	proposerIdx, err := helpers.BeaconProposerIndex(st)
+	require.NoError(b, err)
+	parentRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	require.NoError(b, err)
+	epoch := helpers.CurrentEpoch(st)
+	sszEpoch := types.SSZUint64(epoch)
+	randaoReveal, err := helpers.ComputeDomainAndSign(st, epoch, &sszEpoch, params.BeaconConfig().DomainRandao, secretKey)
+	require.NoError(b, err)
+
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = st.Slot()
+	block.Block.ProposerIndex = proposerIdx
+	block.Block.ParentRoot = parentRoot[:]
+	block.Block.Body.RandaoReveal = randaoReveal
+	stateRoot, err := coreState.CalculateStateRoot(ctx, beaconState.Copy(), block)
+	require.NoError(b, err)
+	block.Block.StateRoot = stateRoot[:]
+	block.Signature, err = helpers.ComputeDomainAndSign(st, epoch, block.Block, params.BeaconConfig().DomainBeaconProposer, secretKey)
+	require.NoError(b, err)
+	return block
+}
+
 func clonedStates(beaconState iface.BeaconState) []iface.BeaconState {
 	clonedStates := make([]iface.BeaconState, runAmount)
 	for i := 0; i < runAmount; i++ {
@@ -16,6 +16,11 @@ import (
 // reasonable amount of time.
 var SkipSlotCache = cache.NewSkipSlotCache()
 
+func init() {
+	cache.DefaultRegistry.Register("skip-slot", SkipSlotCache)
+	cache.DefaultRegistry.Register("next-slot", &nsc)
+}
+
 // The key for skip slot cache is mixed between state root and state slot.
 // state root is in the mix to defend against different forks with same skip slots
 // to hit the same cache. We don't want beacon states mixed up between different chains.
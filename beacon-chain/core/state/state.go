@@ -95,7 +95,7 @@ func GenesisBeaconState(deposits []*ethpb.Deposit, genesisTime uint64, eth1Data
 		return nil, err
 	}
 
-	state, err = b.ProcessPreGenesisDeposits(context.TODO(), state, deposits)
+	state, err = b.ProcessPreGenesisDepositsBulk(context.TODO(), state, deposits)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process validator deposits")
 	}
@@ -7,17 +7,30 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 )
 
+// nextSlotCache keeps the states of the most recently updated roots advanced by one slot, so that
+// the next block is processed without advancing its parent state first, whichever of the
+// candidate heads it builds on.
 type nextSlotCache struct {
+	access cache.AccessCounter
 	sync.RWMutex
+	size    int
+	entries []*nextSlotCacheEntry // Ordered from the least to the most recently updated.
+}
+
+type nextSlotCacheEntry struct {
 	root  []byte
 	state iface.BeaconState
 }
 
+// DefaultNextSlotCacheSize is the default number of states kept by the next slot cache.
+const DefaultNextSlotCacheSize = 1
+
 var (
-	nsc nextSlotCache
+	nsc = nextSlotCache{size: DefaultNextSlotCacheSize}
 	// Metrics for the validator cache.
 	nextSlotCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "next_slot_cache_hit",
@@ -29,19 +42,41 @@ var (
 	})
 )
 
-// NextSlotState returns the saved state if the input root matches the root in `nextSlotCache`. Returns nil otherwise.
+// NextSlotState returns the saved state if the input root matches a root in `nextSlotCache`. Returns nil otherwise.
 // This is useful to check before processing slots. With a cache hit, it will return last processed state with slot plus
 // one advancement.
 func NextSlotState(ctx context.Context, root []byte) (iface.BeaconState, error) {
 	nsc.RLock()
 	defer nsc.RUnlock()
-	if !bytes.Equal(root, nsc.root) {
+	i := nsc.index(root)
+	if i < 0 {
 		nextSlotCacheMiss.Inc()
+		nsc.access.Miss()
 		return nil, nil
 	}
 	nextSlotCacheHit.Inc()
+	nsc.access.Hit()
 	// Returning copied state.
-	return nsc.state.Copy(), nil
+	return nsc.entries[i].state.Copy(), nil
+}
+
+// HasNextSlotState returns true if the `nextSlotCache` holds the advanced state of the input root.
+func HasNextSlotState(root []byte) bool {
+	nsc.RLock()
+	defer nsc.RUnlock()
+	return nsc.index(root) >= 0
+}
+
+// SetNextSlotCacheSize sets the number of roots whose advanced states are kept by the `nextSlotCache`.
+// The least recently updated states are evicted to fit in the new size.
+func SetNextSlotCacheSize(size int) {
+	if size <= 0 {
+		size = DefaultNextSlotCacheSize
+	}
+	nsc.Lock()
+	defer nsc.Unlock()
+	nsc.size = size
+	nsc.trim()
 }
 
 // UpdateNextSlotCache updates the `nextSlotCache`. It saves the input state after advancing the state slot by 1
@@ -58,7 +93,46 @@ func UpdateNextSlotCache(ctx context.Context, root []byte, state iface.BeaconSta
 	nsc.Lock()
 	defer nsc.Unlock()
 
-	nsc.root = root
-	nsc.state = copied
+	if i := nsc.index(root); i >= 0 {
+		nsc.entries = append(nsc.entries[:i], nsc.entries[i+1:]...)
+	}
+	nsc.entries = append(nsc.entries, &nextSlotCacheEntry{root: root, state: copied})
+	nsc.trim()
 	return nil
 }
+
+// Stats returns the number of cached states and their estimated size.
+func (c *nextSlotCache) Stats() *cache.Stats {
+	c.RLock()
+	defer c.RUnlock()
+	size := uint64(0)
+	for _, e := range c.entries {
+		size += cache.EstimatedStateSize(e.state)
+	}
+	return c.access.Stats(len(c.entries), size)
+}
+
+// Flush removes all the states from the cache.
+func (c *nextSlotCache) Flush() {
+	c.Lock()
+	defer c.Unlock()
+	c.entries = nil
+}
+
+// index returns the position of the entry of the input root, or -1 if there is none.
+// The caller must hold the lock.
+func (c *nextSlotCache) index(root []byte) int {
+	for i, e := range c.entries {
+		if bytes.Equal(root, e.root) {
+			return i
+		}
+	}
+	return -1
+}
+
+// trim evicts the least recently updated entries beyond the cache size. The caller must hold the lock.
+func (c *nextSlotCache) trim() {
+	if len(c.entries) > c.size {
+		c.entries = c.entries[len(c.entries)-c.size:]
+	}
+}
@@ -28,3 +28,29 @@ func TestTrailingSlotState_RoundTrip(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, types.Slot(2), s.Slot())
 }
+
+func TestTrailingSlotState_MultipleRoots(t *testing.T) {
+	ctx := context.Background()
+	state.SetNextSlotCacheSize(2)
+	defer state.SetNextSlotCacheSize(state.DefaultNextSlotCacheSize)
+
+	s, _ := testutil.DeterministicGenesisState(t, 1)
+	a, b, c := []byte{'a'}, []byte{'b'}, []byte{'c'}
+	require.NoError(t, state.UpdateNextSlotCache(ctx, a, s))
+	require.NoError(t, state.UpdateNextSlotCache(ctx, b, s))
+	require.Equal(t, true, state.HasNextSlotState(a))
+	require.Equal(t, true, state.HasNextSlotState(b))
+
+	// Updating an existing root makes it the most recent, the oldest root is evicted.
+	require.NoError(t, state.UpdateNextSlotCache(ctx, a, s))
+	require.NoError(t, state.UpdateNextSlotCache(ctx, c, s))
+	require.Equal(t, true, state.HasNextSlotState(a))
+	require.Equal(t, false, state.HasNextSlotState(b))
+	ns, err := state.NextSlotState(ctx, c)
+	require.NoError(t, err)
+	require.Equal(t, types.Slot(1), ns.Slot())
+
+	state.SetNextSlotCacheSize(1)
+	require.Equal(t, false, state.HasNextSlotState(a))
+	require.Equal(t, true, state.HasNextSlotState(c))
+}
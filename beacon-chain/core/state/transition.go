@@ -433,7 +433,7 @@ func ProcessBlock(
 
 // ProcessBlockNoVerifyAnySig creates a new, modified beacon state by applying block operation
 // transformations as defined in the Ethereum Serenity specification. It does not validate
-// any block signature except for deposit and slashing signatures. It also returns the relevant
+// any block signature except for deposit and attester slashing signatures. It also returns the relevant
 // signature set from all the respective methods.
 //
 // Spec pseudocode definition:
@@ -478,7 +478,7 @@ func ProcessBlockNoVerifyAnySig(
 		return nil, nil, errors.Wrap(err, "could not process eth1 data")
 	}
 
-	state, err = ProcessOperationsNoVerifyAttsSigs(ctx, state, signed)
+	state, err = ProcessOperationsNoVerifyAnySig(ctx, state, signed)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, nil, errors.Wrap(err, "could not process block operation")
@@ -487,10 +487,18 @@ func ProcessBlockNoVerifyAnySig(
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "could not retrieve attestation signature set")
 	}
+	psSet, err := b.ProposerSlashingSignatureSet(state, signed.Block.Body.ProposerSlashings)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not retrieve proposer slashing signature set")
+	}
+	eSet, err := b.ExitSignatureSet(state, signed.Block.Body.VoluntaryExits)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not retrieve voluntary exit signature set")
+	}
 
-	// Merge beacon block, randao and attestations signatures into a set.
+	// Merge beacon block, randao, attestations, proposer slashings and exits signatures into a set.
 	set := bls.NewSet()
-	set.Join(bSet).Join(rSet).Join(aSet)
+	set.Join(bSet).Join(rSet).Join(aSet).Join(psSet).Join(eSet)
 
 	return set, state, nil
 }
@@ -555,6 +563,46 @@ func ProcessOperationsNoVerifyAttsSigs(
 	return state, nil
 }
 
+// ProcessOperationsNoVerifyAnySig processes the operations in the beacon block and updates beacon state
+// with the operations in block. It does not verify attestation, proposer slashing or voluntary exit
+// signatures, which are expected to be verified in a batch by the caller.
+//
+// WARNING: This method does not verify attestation, proposer slashing or voluntary exit signatures.
+func ProcessOperationsNoVerifyAnySig(
+	ctx context.Context,
+	state iface.BeaconState,
+	signedBeaconBlock *ethpb.SignedBeaconBlock) (iface.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "core.state.ProcessOperationsNoVerifyAnySig")
+	defer span.End()
+
+	if _, err := VerifyOperationLengths(ctx, state, signedBeaconBlock); err != nil {
+		return nil, errors.Wrap(err, "could not verify operation lengths")
+	}
+
+	state, err := b.ProcessProposerSlashingsNoVerifySignature(ctx, state, signedBeaconBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block proposer slashings")
+	}
+	state, err = b.ProcessAttesterSlashings(ctx, state, signedBeaconBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attester slashings")
+	}
+	state, err = b.ProcessAttestationsNoVerifySignature(ctx, state, signedBeaconBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block attestations")
+	}
+	state, err = b.ProcessDeposits(ctx, state, signedBeaconBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process block validator deposits")
+	}
+	state, err = b.ProcessVoluntaryExitsNoVerifySignature(ctx, state, signedBeaconBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process validator exits")
+	}
+
+	return state, nil
+}
+
 // VerifyOperationLengths verifies that block operation lengths are valid.
 func VerifyOperationLengths(_ context.Context, state iface.BeaconState, b *ethpb.SignedBeaconBlock) (iface.BeaconState, error) {
 	if err := helpers.VerifyNilBeaconBlock(b); err != nil {
@@ -622,47 +670,65 @@ func CanProcessEpoch(state iface.ReadOnlyBeaconState) bool {
 // ProcessEpochPrecompute describes the per epoch operations that are performed on the beacon state.
 // It's optimized by pre computing validator attested info and epoch total/attested balances upfront.
 func ProcessEpochPrecompute(ctx context.Context, state iface.BeaconState) (iface.BeaconState, error) {
+	state, _, err := processEpochPrecompute(ctx, state, false /* report */)
+	return state, err
+}
+
+// ProcessEpochPrecomputeWithReport is ProcessEpochPrecompute, also returning the report of the
+// rewards and penalties applied to each validator.
+func ProcessEpochPrecomputeWithReport(ctx context.Context, state iface.BeaconState) (iface.BeaconState, *precompute.EpochReport, error) {
+	return processEpochPrecompute(ctx, state, true /* report */)
+}
+
+func processEpochPrecompute(ctx context.Context, state iface.BeaconState, report bool) (iface.BeaconState, *precompute.EpochReport, error) {
 	ctx, span := trace.StartSpan(ctx, "core.state.ProcessEpochPrecompute")
 	defer span.End()
 	span.AddAttributes(trace.Int64Attribute("epoch", int64(helpers.CurrentEpoch(state))))
 
 	if state == nil {
-		return nil, errors.New("nil state")
+		return nil, nil, errors.New("nil state")
 	}
 	vp, bp, err := precompute.New(ctx, state)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	vp, bp, err = precompute.ProcessAttestations(ctx, state, vp, bp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	state, err = precompute.ProcessJustificationAndFinalizationPreCompute(state, bp)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not process justification")
+		return nil, nil, errors.Wrap(err, "could not process justification")
 	}
 
+	var epochReport *precompute.EpochReport
+	if report {
+		epochReport, err = precompute.NewEpochReport(state, bp, vp)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not report rewards and penalties")
+		}
+	}
 	state, err = precompute.ProcessRewardsAndPenaltiesPrecompute(state, bp, vp)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not process rewards and penalties")
+		return nil, nil, errors.Wrap(err, "could not process rewards and penalties")
 	}
 
 	state, err = e.ProcessRegistryUpdates(state)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not process registry updates")
+		return nil, nil, errors.Wrap(err, "could not process registry updates")
 	}
 
 	err = precompute.ProcessSlashingsPrecompute(state, bp)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	state, err = e.ProcessFinalUpdates(state)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not process final updates")
+		return nil, nil, errors.Wrap(err, "could not process final updates")
 	}
-	return state, nil
+	return state, epochReport, nil
 }
 
 // ProcessBlockForStateRoot processes the state for state root computation. It skips proposer signature
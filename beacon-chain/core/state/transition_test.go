@@ -524,6 +524,27 @@ func TestProcessEpochPrecompute_CanProcess(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, uint64(0), newState.Slashings()[2], "Unexpected slashed balance")
 }
+
+func TestProcessEpochPrecomputeWithReport(t *testing.T) {
+	ctx := context.Background()
+	s, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, s.SetSlot(params.BeaconConfig().SlotsPerEpoch*2-1))
+
+	want, err := state.ProcessEpochPrecompute(ctx, s.Copy())
+	require.NoError(t, err)
+	newState, report, err := state.ProcessEpochPrecomputeWithReport(ctx, s.Copy())
+	require.NoError(t, err)
+	assert.DeepEqual(t, want.Balances(), newState.Balances())
+	assert.Equal(t, types.Epoch(1), report.Epoch)
+	require.Equal(t, 64, len(report.Validators))
+	for i, r := range report.Validators {
+		// Nobody attested, every validator is penalized for each vote.
+		assert.NotEqual(t, uint64(0), r.SourcePenalty)
+		assert.Equal(t, r.SourcePenalty, r.TargetPenalty)
+		assert.Equal(t, r.SourcePenalty, r.HeadPenalty)
+		assert.Equal(t, s.Balances()[i]-3*r.SourcePenalty, newState.Balances()[i])
+	}
+}
 func BenchmarkProcessBlk_65536Validators_FullBlock(b *testing.B) {
 	logrus.SetLevel(logrus.PanicLevel)
 
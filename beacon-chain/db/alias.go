@@ -23,6 +23,20 @@ type HeadAccessDatabase = iface.HeadAccessDatabase
 // not be used often. Prefer a more restrictive interface in this package.
 type Database = iface.Database
 
+// PruneStats reports what was deleted by pruning the finalized history of a database.
+type PruneStats = iface.PruneStats
+
+// AttestationInclusion records a block which included the attestation of a validator for an epoch.
+type AttestationInclusion = iface.AttestationInclusion
+
 // ErrExistingGenesisState is an error when the user attempts to save a different genesis state
 // when one already exists in a database.
 var ErrExistingGenesisState = iface.ErrExistingGenesisState
+
+// ErrExistingChainData is an error when the user attempts to start from a checkpoint while chain
+// data beyond genesis already exists in a database.
+var ErrExistingChainData = iface.ErrExistingChainData
+
+// ErrNotFinalizedSlot is an error when the finalized block root of a slot is requested while the
+// slot is not finalized or the finalized blocks up to the slot are not in a database.
+var ErrNotFinalizedSlot = iface.ErrNotFinalizedSlot
@@ -0,0 +1,74 @@
+// Package backend defines the key-value store the beacon node database is built on, as named buckets
+// of sorted keys read and written in transactions, along with its BoltDB and LevelDB implementations.
+package backend
+
+import (
+	"errors"
+)
+
+const (
+	// BoltBackend is the name of the BoltDB backend, a single file B+tree which is read through a
+	// memory map and written by a single writer.
+	BoltBackend = "bolt"
+	// LevelDBBackend is the name of the LevelDB backend, a log-structured merge tree which sustains a
+	// higher write throughput on slow disks.
+	LevelDBBackend = "leveldb"
+)
+
+var (
+	// ErrTxNotWritable is returned when writing in a read-only transaction.
+	ErrTxNotWritable = errors.New("tx not writable")
+	// ErrBucketNotFound is returned when deleting a bucket which does not exist.
+	ErrBucketNotFound = errors.New("bucket not found")
+	// ErrBucketExists is returned when creating a bucket which already exists.
+	ErrBucketExists = errors.New("bucket already exists")
+)
+
+// DB is a key-value store of named buckets.
+type DB interface {
+	// View runs fn in a read-only transaction.
+	View(fn func(tx Tx) error) error
+	// Update runs fn in a read-write transaction, which is committed if fn returns no error. Only one
+	// read-write transaction runs at a time.
+	Update(fn func(tx Tx) error) error
+	Close() error
+}
+
+// Tx is a transaction, which reads a consistent view of the store. The keys and values it returns are
+// only valid for the life of the transaction.
+type Tx interface {
+	// Bucket returns the top level bucket of the given name, or nil if it does not exist.
+	Bucket(name []byte) Bucket
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+	DeleteBucket(name []byte) error
+	// ForEach calls fn with each top level bucket.
+	ForEach(fn func(name []byte, b Bucket) error) error
+}
+
+// Bucket is a set of keys sorted in byte order, or a set of nested buckets.
+type Bucket interface {
+	// Get returns the value of the key, or nil if the key does not exist.
+	Get(key []byte) []byte
+	Put(key, value []byte) error
+	Delete(key []byte) error
+	Cursor() Cursor
+	// ForEach calls fn with each key and value of the bucket in order.
+	ForEach(fn func(k, v []byte) error) error
+	// Bucket returns the nested bucket of the given name, or nil if it does not exist.
+	Bucket(name []byte) Bucket
+	CreateBucket(name []byte) (Bucket, error)
+	DeleteBucket(name []byte) error
+	// ForEachBucket calls fn with each nested bucket.
+	ForEachBucket(fn func(name []byte, b Bucket) error) error
+}
+
+// Cursor iterates over the keys of a bucket in order. The methods return a nil key once the cursor
+// moves past the first or the last key. Nested buckets may be returned with a nil value.
+type Cursor interface {
+	First() (key, value []byte)
+	Last() (key, value []byte)
+	Next() (key, value []byte)
+	Prev() (key, value []byte)
+	// Seek moves to the given key, or to the next key if it does not exist.
+	Seek(seek []byte) (key, value []byte)
+}
@@ -0,0 +1,166 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func openBackends(t *testing.T) map[string]DB {
+	dir := t.TempDir()
+	boltDB, err := OpenBolt(filepath.Join(dir, "test.db"), 0)
+	require.NoError(t, err)
+	levelDB, err := OpenLevelDB(filepath.Join(dir, "test.ldb"))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, boltDB.Close())
+		require.NoError(t, levelDB.Close())
+	})
+	return map[string]DB{BoltBackend: boltDB, LevelDBBackend: levelDB}
+}
+
+func keys(c Cursor) []string {
+	var ks []string
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		ks = append(ks, string(k))
+	}
+	return ks
+}
+
+func TestDB_Buckets(t *testing.T) {
+	for name, db := range openBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, db.Update(func(tx Tx) error {
+				bkt, err := tx.CreateBucketIfNotExists([]byte("a"))
+				require.NoError(t, err)
+				require.NoError(t, bkt.Put([]byte("k"), []byte("v")))
+				nested, err := bkt.CreateBucket([]byte("nested"))
+				require.NoError(t, err)
+				require.NoError(t, nested.Put([]byte("k"), []byte("nested")))
+				_, err = bkt.CreateBucket([]byte("nested"))
+				assert.ErrorContains(t, ErrBucketExists.Error(), err)
+				_, err = tx.CreateBucketIfNotExists([]byte("ab"))
+				return err
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				var names []string
+				require.NoError(t, tx.ForEach(func(name []byte, _ Bucket) error {
+					names = append(names, string(name))
+					return nil
+				}))
+				assert.DeepEqual(t, []string{"a", "ab"}, names)
+				assert.Equal(t, nil, tx.Bucket([]byte("missing")))
+
+				bkt := tx.Bucket([]byte("a"))
+				assert.DeepEqual(t, []byte("v"), bkt.Get([]byte("k")))
+				assert.DeepEqual(t, []byte("nested"), bkt.Bucket([]byte("nested")).Get([]byte("k")))
+				assert.Equal(t, 0, len(tx.Bucket([]byte("ab")).Get([]byte("k"))))
+				var values []string
+				require.NoError(t, bkt.ForEach(func(k, v []byte) error {
+					values = append(values, string(v))
+					return nil
+				}))
+				assert.DeepEqual(t, []string{"v"}, values)
+				assert.ErrorContains(t, ErrTxNotWritable.Error(), bkt.Put([]byte("k"), []byte("v")))
+				return nil
+			}))
+
+			require.NoError(t, db.Update(func(tx Tx) error {
+				require.NoError(t, tx.Bucket([]byte("a")).DeleteBucket([]byte("nested")))
+				assert.ErrorContains(t, ErrBucketNotFound.Error(), tx.DeleteBucket([]byte("missing")))
+				return nil
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				assert.Equal(t, nil, tx.Bucket([]byte("a")).Bucket([]byte("nested")))
+				return nil
+			}))
+		})
+	}
+}
+
+func TestDB_Cursor(t *testing.T) {
+	for name, db := range openBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, db.Update(func(tx Tx) error {
+				bkt, err := tx.CreateBucketIfNotExists([]byte("a"))
+				require.NoError(t, err)
+				for _, k := range []string{"b", "d", "f"} {
+					require.NoError(t, bkt.Put([]byte(k), []byte(k)))
+				}
+				return nil
+			}))
+			require.NoError(t, db.Update(func(tx Tx) error {
+				bkt := tx.Bucket([]byte("a"))
+				// The cursor sees the writes of the transaction.
+				require.NoError(t, bkt.Put([]byte("c"), []byte("c")))
+				require.NoError(t, bkt.Put([]byte("d"), []byte("updated")))
+				require.NoError(t, bkt.Delete([]byte("f")))
+				assert.DeepEqual(t, []string{"b", "c", "d"}, keys(bkt.Cursor()))
+
+				c := bkt.Cursor()
+				k, v := c.Seek([]byte("cc"))
+				assert.DeepEqual(t, []byte("d"), k)
+				assert.DeepEqual(t, []byte("updated"), v)
+				k, _ = c.Prev()
+				assert.DeepEqual(t, []byte("c"), k)
+				k, _ = c.Last()
+				assert.DeepEqual(t, []byte("d"), k)
+				k, _ = c.Next()
+				assert.Equal(t, 0, len(k))
+				k, _ = c.Seek([]byte("e"))
+				assert.Equal(t, 0, len(k))
+				return nil
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				assert.DeepEqual(t, []string{"b", "c", "d"}, keys(tx.Bucket([]byte("a")).Cursor()))
+				return nil
+			}))
+		})
+	}
+}
+
+func TestDB_UpdateRollback(t *testing.T) {
+	for name, db := range openBackends(t) {
+		t.Run(name, func(t *testing.T) {
+			err := db.Update(func(tx Tx) error {
+				bkt, err := tx.CreateBucketIfNotExists([]byte("a"))
+				require.NoError(t, err)
+				require.NoError(t, bkt.Put([]byte("k"), []byte("v")))
+				return fmt.Errorf("rollback")
+			})
+			assert.ErrorContains(t, "rollback", err)
+			require.NoError(t, db.View(func(tx Tx) error {
+				assert.Equal(t, nil, tx.Bucket([]byte("a")))
+				return nil
+			}))
+		})
+	}
+}
+
+func TestCopy(t *testing.T) {
+	dbs := openBackends(t)
+	src, dst := dbs[BoltBackend], dbs[LevelDBBackend]
+	require.NoError(t, src.Update(func(tx Tx) error {
+		bkt, err := tx.CreateBucketIfNotExists([]byte("a"))
+		require.NoError(t, err)
+		for i := 0; i < 100; i++ {
+			require.NoError(t, bkt.Put([]byte(fmt.Sprintf("%03d", i)), []byte{byte(i)}))
+		}
+		nested, err := bkt.CreateBucket([]byte("nested"))
+		require.NoError(t, err)
+		return nested.Put([]byte("k"), []byte("v"))
+	}))
+
+	require.NoError(t, Copy(context.Background(), src, dst))
+	require.NoError(t, dst.View(func(tx Tx) error {
+		bkt := tx.Bucket([]byte("a"))
+		assert.Equal(t, 100, len(keys(bkt.Cursor())))
+		assert.DeepEqual(t, []byte{42}, bkt.Get([]byte("042")))
+		assert.DeepEqual(t, []byte("v"), bkt.Bucket([]byte("nested")).Get([]byte("k")))
+		return nil
+	}))
+}
@@ -0,0 +1,160 @@
+package backend
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+)
+
+const boltAllocSize = 8 * 1024 * 1024
+
+// BoltDB is the BoltDB backend.
+type BoltDB struct {
+	db *bolt.DB
+}
+
+// OpenBolt opens the BoltDB file at the given path, creating it if needed. It fails with
+// bolt.ErrTimeout if the file is locked by another process.
+func OpenBolt(path string, initialMMapSize int) (*BoltDB, error) {
+	db, err := bolt.Open(
+		path,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{
+			Timeout:         1 * time.Second,
+			InitialMmapSize: initialMMapSize,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	db.AllocSize = boltAllocSize
+	return &BoltDB{db: db}, nil
+}
+
+// DB returns the underlying BoltDB database.
+func (b *BoltDB) DB() *bolt.DB {
+	return b.db
+}
+
+// View runs fn in a read-only transaction.
+func (b *BoltDB) View(fn func(tx Tx) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx: tx})
+	})
+}
+
+// Update runs fn in a read-write transaction, which is committed if fn returns no error.
+func (b *BoltDB) Update(fn func(tx Tx) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx: tx})
+	})
+}
+
+// Close closes the database.
+func (b *BoltDB) Close() error {
+	return b.db.Close()
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t boltTx) Bucket(name []byte) Bucket {
+	return wrapBoltBucket(t.tx.Bucket(name))
+}
+
+func (t boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	b, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, boltError(err)
+	}
+	return boltBucket{b}, nil
+}
+
+func (t boltTx) DeleteBucket(name []byte) error {
+	return boltError(t.tx.DeleteBucket(name))
+}
+
+func (t boltTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return t.tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		return fn(name, boltBucket{b})
+	})
+}
+
+type boltBucket struct {
+	b *bolt.Bucket
+}
+
+// This avoids returning a non-nil interface holding a nil bucket.
+func wrapBoltBucket(b *bolt.Bucket) Bucket {
+	if b == nil {
+		return nil
+	}
+	return boltBucket{b}
+}
+
+func (b boltBucket) Get(key []byte) []byte {
+	return b.b.Get(key)
+}
+
+func (b boltBucket) Put(key, value []byte) error {
+	return boltError(b.b.Put(key, value))
+}
+
+func (b boltBucket) Delete(key []byte) error {
+	return boltError(b.b.Delete(key))
+}
+
+func (b boltBucket) Cursor() Cursor {
+	return b.b.Cursor()
+}
+
+func (b boltBucket) ForEach(fn func(k, v []byte) error) error {
+	return b.b.ForEach(func(k, v []byte) error {
+		// Nested buckets have no value.
+		if v == nil {
+			return nil
+		}
+		return fn(k, v)
+	})
+}
+
+func (b boltBucket) Bucket(name []byte) Bucket {
+	return wrapBoltBucket(b.b.Bucket(name))
+}
+
+func (b boltBucket) CreateBucket(name []byte) (Bucket, error) {
+	nested, err := b.b.CreateBucket(name)
+	if err != nil {
+		return nil, boltError(err)
+	}
+	return boltBucket{nested}, nil
+}
+
+func (b boltBucket) DeleteBucket(name []byte) error {
+	return boltError(b.b.DeleteBucket(name))
+}
+
+func (b boltBucket) ForEachBucket(fn func(name []byte, b Bucket) error) error {
+	return b.b.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return nil
+		}
+		return fn(k, boltBucket{b.b.Bucket(k)})
+	})
+}
+
+// This maps the errors of BoltDB to the errors of the package.
+func boltError(err error) error {
+	switch err {
+	case bolt.ErrTxNotWritable:
+		return ErrTxNotWritable
+	case bolt.ErrBucketNotFound:
+		return ErrBucketNotFound
+	case bolt.ErrBucketExists:
+		return ErrBucketExists
+	default:
+		return err
+	}
+}
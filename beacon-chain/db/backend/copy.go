@@ -0,0 +1,123 @@
+package backend
+
+import (
+	"context"
+)
+
+// copyBatchSize is the number of bytes written in a transaction when copying a database.
+const copyBatchSize = 64 * 1024 * 1024
+
+// Copy copies the buckets of src, along with their nested buckets, into dst. The buckets are written
+// in batches, so that copying a large database does not buffer it whole in a single transaction.
+func Copy(ctx context.Context, src, dst DB) error {
+	var names [][]byte
+	if err := src.View(func(tx Tx) error {
+		return tx.ForEach(func(name []byte, _ Bucket) error {
+			names = append(names, append([]byte{}, name...))
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := copyBucket(ctx, src, dst, [][]byte{name}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// This copies the bucket at the given path, resuming the iteration over its keys after each batch.
+func copyBucket(ctx context.Context, src, dst DB, path [][]byte) error {
+	if err := dst.Update(func(tx Tx) error {
+		_, err := createBucketPath(tx, path)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	var next []byte
+	for done := false; !done; {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var keys, values [][]byte
+		if err := src.View(func(tx Tx) error {
+			c := bucketPath(tx, path).Cursor()
+			k, v := c.First()
+			if next != nil {
+				k, v = c.Seek(next)
+			}
+			size := 0
+			for ; k != nil; k, v = c.Next() {
+				if size >= copyBatchSize {
+					next = append([]byte{}, k...)
+					return nil
+				}
+				// Nested buckets are copied on their own.
+				if v == nil {
+					continue
+				}
+				keys = append(keys, append([]byte{}, k...))
+				values = append(values, append([]byte{}, v...))
+				size += len(k) + len(v)
+			}
+			done = true
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := dst.Update(func(tx Tx) error {
+			bkt := bucketPath(tx, path)
+			for i, k := range keys {
+				if err := bkt.Put(k, values[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	var nested [][]byte
+	if err := src.View(func(tx Tx) error {
+		return bucketPath(tx, path).ForEachBucket(func(name []byte, _ Bucket) error {
+			nested = append(nested, append([]byte{}, name...))
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, name := range nested {
+		if err := copyBucket(ctx, src, dst, append(path[:len(path):len(path)], name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func bucketPath(tx Tx, path [][]byte) Bucket {
+	bkt := tx.Bucket(path[0])
+	for _, name := range path[1:] {
+		bkt = bkt.Bucket(name)
+	}
+	return bkt
+}
+
+func createBucketPath(tx Tx, path [][]byte) (Bucket, error) {
+	bkt, err := tx.CreateBucketIfNotExists(path[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range path[1:] {
+		if nested := bkt.Bucket(name); nested != nil {
+			bkt = nested
+			continue
+		}
+		if bkt, err = bkt.CreateBucket(name); err != nil {
+			return nil, err
+		}
+	}
+	return bkt, nil
+}
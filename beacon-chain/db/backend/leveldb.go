@@ -0,0 +1,433 @@
+package backend
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// LevelDB has no buckets, so each bucket is registered under 'b' followed by its encoded path, and the
+// keys of a bucket are stored under 'd' followed by the encoded path of the bucket and the key. The
+// encoded path is the depth of the bucket, followed by the length prefixed name of each bucket
+// of the path. The depth keeps the keys of nested buckets out of the keys of their parent.
+var (
+	bucketKeyPrefix = []byte{'b'}
+	dataKeyPrefix   = []byte{'d'}
+	bucketMarker    = []byte{1}
+)
+
+const (
+	levelDBWriteBuffer = 64 * 1024 * 1024
+	levelDBBlockCache  = 128 * 1024 * 1024
+	levelDBOpenFiles   = 512
+)
+
+// LevelDB is the LevelDB backend. Read-only transactions read a snapshot of the database, and the
+// writes of a read-write transaction are buffered in memory, read back by the transaction, and
+// written in a single batch on commit.
+type LevelDB struct {
+	db        *leveldb.DB
+	writeLock sync.Mutex
+}
+
+// OpenLevelDB opens the LevelDB database of the given directory, creating it if needed.
+func OpenLevelDB(path string) (*LevelDB, error) {
+	db, err := leveldb.OpenFile(path, &opt.Options{
+		WriteBuffer:            levelDBWriteBuffer,
+		BlockCacheCapacity:     levelDBBlockCache,
+		OpenFilesCacheCapacity: levelDBOpenFiles,
+		Filter:                 filter.NewBloomFilter(10),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &LevelDB{db: db}, nil
+}
+
+// DB returns the underlying LevelDB database.
+func (l *LevelDB) DB() *leveldb.DB {
+	return l.db
+}
+
+// View runs fn in a read-only transaction.
+func (l *LevelDB) View(fn func(tx Tx) error) error {
+	snap, err := l.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+	tx := &levelTx{reader: snap}
+	defer tx.release()
+	return fn(tx)
+}
+
+// Update runs fn in a read-write transaction, which is committed if fn returns no error.
+func (l *LevelDB) Update(fn func(tx Tx) error) error {
+	l.writeLock.Lock()
+	defer l.writeLock.Unlock()
+	snap, err := l.db.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.Release()
+	tx := &levelTx{
+		reader:  snap,
+		writes:  memdb.New(comparer.DefaultComparer, 0),
+		deleted: make(map[string]bool),
+	}
+	defer tx.release()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return l.db.Write(tx.batch(), &opt.WriteOptions{Sync: true})
+}
+
+// Compact compacts the whole database, discarding the deleted and overwritten values.
+func (l *LevelDB) Compact() error {
+	return l.db.CompactRange(util.Range{})
+}
+
+// Close closes the database.
+func (l *LevelDB) Close() error {
+	return l.db.Close()
+}
+
+// reader is implemented by LevelDB snapshots.
+type reader interface {
+	Get(key []byte, ro *opt.ReadOptions) ([]byte, error)
+	NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator
+}
+
+type levelTx struct {
+	reader reader
+	// The writes of a read-write transaction, and the keys it deleted. They are nil in read-only
+	// transactions.
+	writes  *memdb.DB
+	deleted map[string]bool
+	iters   []iterator.Iterator
+}
+
+func (t *levelTx) root() *levelBucket {
+	return &levelBucket{tx: t}
+}
+
+func (t *levelTx) Bucket(name []byte) Bucket {
+	return t.root().Bucket(name)
+}
+
+func (t *levelTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	if b := t.root().Bucket(name); b != nil {
+		return b, nil
+	}
+	return t.root().CreateBucket(name)
+}
+
+func (t *levelTx) DeleteBucket(name []byte) error {
+	return t.root().DeleteBucket(name)
+}
+
+func (t *levelTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return t.root().ForEachBucket(fn)
+}
+
+func (t *levelTx) get(key []byte) []byte {
+	if t.writes != nil {
+		if t.deleted[string(key)] {
+			return nil
+		}
+		// The values of the memdb are slices of its buffer, which must not be appended to.
+		if v, err := t.writes.Get(key); err == nil {
+			return append([]byte{}, v...)
+		}
+	}
+	v, err := t.reader.Get(key, nil)
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+func (t *levelTx) put(key, value []byte) error {
+	if t.writes == nil {
+		return ErrTxNotWritable
+	}
+	delete(t.deleted, string(key))
+	return t.writes.Put(key, value)
+}
+
+func (t *levelTx) delete(key []byte) error {
+	if t.writes == nil {
+		return ErrTxNotWritable
+	}
+	if err := t.writes.Delete(key); err != nil && err != memdb.ErrNotFound {
+		return err
+	}
+	t.deleted[string(key)] = true
+	return nil
+}
+
+// This returns the writes of the transaction as a batch.
+func (t *levelTx) batch() *leveldb.Batch {
+	b := new(leveldb.Batch)
+	it := t.writes.NewIterator(nil)
+	defer it.Release()
+	for it.Next() {
+		b.Put(it.Key(), it.Value())
+	}
+	for k := range t.deleted {
+		b.Delete([]byte(k))
+	}
+	return b
+}
+
+func (t *levelTx) cursor(prefix []byte) *levelCursor {
+	c := &levelCursor{tx: t, prefix: prefix}
+	c.base = t.reader.NewIterator(util.BytesPrefix(prefix), nil)
+	t.iters = append(t.iters, c.base)
+	if t.writes != nil {
+		c.overlay = t.writes.NewIterator(util.BytesPrefix(prefix))
+		t.iters = append(t.iters, c.overlay)
+	}
+	return c
+}
+
+func (t *levelTx) release() {
+	for _, it := range t.iters {
+		it.Release()
+	}
+	t.iters = nil
+}
+
+type levelBucket struct {
+	tx   *levelTx
+	path [][]byte
+}
+
+func (b *levelBucket) nested(name []byte) [][]byte {
+	path := make([][]byte, len(b.path), len(b.path)+1)
+	copy(path, b.path)
+	return append(path, append([]byte{}, name...))
+}
+
+func (b *levelBucket) dataPrefix() []byte {
+	return append(append([]byte{}, dataKeyPrefix...), encodePath(b.path)...)
+}
+
+func (b *levelBucket) dataKey(key []byte) []byte {
+	return append(b.dataPrefix(), key...)
+}
+
+func (b *levelBucket) Get(key []byte) []byte {
+	return b.tx.get(b.dataKey(key))
+}
+
+func (b *levelBucket) Put(key, value []byte) error {
+	return b.tx.put(b.dataKey(key), value)
+}
+
+func (b *levelBucket) Delete(key []byte) error {
+	return b.tx.delete(b.dataKey(key))
+}
+
+func (b *levelBucket) Cursor() Cursor {
+	return b.tx.cursor(b.dataPrefix())
+}
+
+func (b *levelBucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *levelBucket) Bucket(name []byte) Bucket {
+	path := b.nested(name)
+	if b.tx.get(bucketKey(path)) == nil {
+		return nil
+	}
+	return &levelBucket{tx: b.tx, path: path}
+}
+
+func (b *levelBucket) CreateBucket(name []byte) (Bucket, error) {
+	if b.Bucket(name) != nil {
+		return nil, ErrBucketExists
+	}
+	path := b.nested(name)
+	if err := b.tx.put(bucketKey(path), bucketMarker); err != nil {
+		return nil, err
+	}
+	return &levelBucket{tx: b.tx, path: path}, nil
+}
+
+func (b *levelBucket) DeleteBucket(name []byte) error {
+	nested, ok := b.Bucket(name).(*levelBucket)
+	if !ok {
+		return ErrBucketNotFound
+	}
+	if err := nested.ForEachBucket(func(name []byte, _ Bucket) error {
+		return nested.DeleteBucket(name)
+	}); err != nil {
+		return err
+	}
+	c := nested.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if err := nested.Delete(k); err != nil {
+			return err
+		}
+	}
+	return b.tx.delete(bucketKey(nested.path))
+}
+
+func (b *levelBucket) ForEachBucket(fn func(name []byte, b Bucket) error) error {
+	// The registrations of the nested buckets are prefixed with the path of the bucket at their depth.
+	enc := encodePath(b.path)
+	enc[0]++
+	prefix := append(append([]byte{}, bucketKeyPrefix...), enc...)
+	c := b.tx.cursor(prefix)
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		length, n := binary.Uvarint(k)
+		if n <= 0 || uint64(len(k)-n) != length {
+			continue
+		}
+		name := k[n:]
+		if err := fn(name, &levelBucket{tx: b.tx, path: b.nested(name)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func bucketKey(path [][]byte) []byte {
+	return append(append([]byte{}, bucketKeyPrefix...), encodePath(path)...)
+}
+
+func encodePath(path [][]byte) []byte {
+	enc := []byte{byte(len(path))}
+	for _, name := range path {
+		var length [binary.MaxVarintLen64]byte
+		n := binary.PutUvarint(length[:], uint64(len(name)))
+		enc = append(enc, length[:n]...)
+		enc = append(enc, name...)
+	}
+	return enc
+}
+
+// levelCursor iterates over the keys of a snapshot merged with the writes of the transaction. Every
+// move seeks from the current key, so that the cursor sees the writes made while iterating.
+type levelCursor struct {
+	tx      *levelTx
+	prefix  []byte
+	base    iterator.Iterator
+	overlay iterator.Iterator
+	key     []byte
+}
+
+func (c *levelCursor) First() ([]byte, []byte) {
+	return c.ceil(c.prefix, false)
+}
+
+func (c *levelCursor) Last() ([]byte, []byte) {
+	return c.floor(nil, false)
+}
+
+func (c *levelCursor) Next() ([]byte, []byte) {
+	if c.key == nil {
+		return nil, nil
+	}
+	return c.ceil(c.key, true)
+}
+
+func (c *levelCursor) Prev() ([]byte, []byte) {
+	if c.key == nil {
+		return nil, nil
+	}
+	return c.floor(c.key, true)
+}
+
+func (c *levelCursor) Seek(seek []byte) ([]byte, []byte) {
+	return c.ceil(append(append([]byte{}, c.prefix...), seek...), false)
+}
+
+// This moves to the first key at or after the target, or after it if strict.
+func (c *levelCursor) ceil(target []byte, strict bool) ([]byte, []byte) {
+	var baseKey, baseValue []byte
+	ok := c.base.Seek(target)
+	for ; ok; ok = c.base.Next() {
+		k := c.base.Key()
+		if (strict && bytes.Equal(k, target)) || c.tx.deleted[string(k)] {
+			continue
+		}
+		baseKey, baseValue = k, c.base.Value()
+		break
+	}
+	var overlayKey, overlayValue []byte
+	if c.overlay != nil {
+		ok := c.overlay.Seek(target)
+		if ok && strict && bytes.Equal(c.overlay.Key(), target) {
+			ok = c.overlay.Next()
+		}
+		if ok {
+			overlayKey, overlayValue = c.overlay.Key(), c.overlay.Value()
+		}
+	}
+	if overlayKey != nil && (baseKey == nil || bytes.Compare(overlayKey, baseKey) <= 0) {
+		return c.move(overlayKey, overlayValue)
+	}
+	return c.move(baseKey, baseValue)
+}
+
+// This moves to the last key before the target, or at the target if it is not strict. A nil target
+// moves to the last key.
+func (c *levelCursor) floor(target []byte, strict bool) ([]byte, []byte) {
+	seekBefore := func(it iterator.Iterator) bool {
+		if target == nil {
+			return it.Last()
+		}
+		if !it.Seek(target) {
+			return it.Last()
+		}
+		if !strict && bytes.Equal(it.Key(), target) {
+			return true
+		}
+		return it.Prev()
+	}
+	var baseKey, baseValue []byte
+	for ok := seekBefore(c.base); ok; ok = c.base.Prev() {
+		k := c.base.Key()
+		if c.tx.deleted[string(k)] {
+			continue
+		}
+		baseKey, baseValue = k, c.base.Value()
+		break
+	}
+	var overlayKey, overlayValue []byte
+	if c.overlay != nil && seekBefore(c.overlay) {
+		overlayKey, overlayValue = c.overlay.Key(), c.overlay.Value()
+	}
+	if overlayKey != nil && (baseKey == nil || bytes.Compare(overlayKey, baseKey) >= 0) {
+		return c.move(overlayKey, overlayValue)
+	}
+	return c.move(baseKey, baseValue)
+}
+
+// This sets the position of the cursor, and returns copies of the key without the prefix and of the
+// value, as the slices of the iterators only live until their next move.
+func (c *levelCursor) move(key, value []byte) ([]byte, []byte) {
+	if key == nil {
+		c.key = nil
+		return nil, nil
+	}
+	c.key = append([]byte{}, key...)
+	return c.key[len(c.prefix):], append([]byte{}, value...)
+}
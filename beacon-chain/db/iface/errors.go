@@ -8,4 +8,10 @@ var (
 	// ErrExistingGenesisState is an error when the user attempts to save a different genesis state
 	// when one already exists in a database.
 	ErrExistingGenesisState = errors.New("genesis state exists already in the DB")
+	// ErrExistingChainData is an error when the user attempts to start from a checkpoint while chain
+	// data beyond genesis already exists in a database.
+	ErrExistingChainData = errors.New("chain data beyond genesis exists already in the DB")
+	// ErrNotFinalizedSlot is an error when the finalized block root of a slot is requested while the
+	// slot is not finalized or the finalized blocks up to the slot are not in a database.
+	ErrNotFinalizedSlot = errors.New("no finalized block root for slot in the DB")
 )
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/proto/beacon/db"
@@ -25,10 +26,15 @@ type ReadOnlyDatabase interface {
 	BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error)
 	BlocksBySlot(ctx context.Context, slot types.Slot) (bool, []*eth.SignedBeaconBlock, error)
 	BlockRootsBySlot(ctx context.Context, slot types.Slot) (bool, [][32]byte, error)
+	BlocksByProposer(ctx context.Context, proposerIdx types.ValidatorIndex, startEpoch, endEpoch types.Epoch) ([]*eth.SignedBeaconBlock, [][32]byte, error)
 	HasBlock(ctx context.Context, blockRoot [32]byte) bool
 	GenesisBlock(ctx context.Context) (*eth.SignedBeaconBlock, error)
+	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
+	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
 	IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
+	IsInvalidBlock(ctx context.Context, blockRoot [32]byte) bool
 	FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (*eth.SignedBeaconBlock, error)
+	FinalizedBlockRootBySlot(ctx context.Context, slot types.Slot) ([32]byte, error)
 	HighestSlotBlocksBelow(ctx context.Context, slot types.Slot) ([]*eth.SignedBeaconBlock, error)
 	// State related methods.
 	State(ctx context.Context, blockRoot [32]byte) (iface.BeaconState, error)
@@ -56,6 +62,16 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
+	// Committee cache operations.
+	CommitteeCacheEntries(ctx context.Context) (*db.CommitteeCacheEntries, error)
+	// Operation pool persistence.
+	OperationPool(ctx context.Context, name string) ([][]byte, error)
+	// Background operation progress persistence.
+	Progress(ctx context.Context, name string) ([]byte, error)
+	// Attestation inclusion index.
+	AttestationInclusions(ctx context.Context, validatorIdx types.ValidatorIndex, startEpoch, endEpoch types.Epoch) ([]*AttestationInclusion, error)
+	// Epoch reward reports.
+	EpochReport(ctx context.Context, epoch types.Epoch) (*precompute.EpochReport, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -65,7 +81,11 @@ type NoHeadAccessDatabase interface {
 	// Block related methods.
 	SaveBlock(ctx context.Context, block *eth.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
+	SaveBlocksBatched(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
+	FlushBatchedWrites(ctx context.Context) error
+	SaveBackfillBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveInvalidBlock(ctx context.Context, blockRoot [32]byte, signature []byte) error
 	// State related methods.
 	SaveState(ctx context.Context, state iface.ReadOnlyBeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []iface.ReadOnlyBeaconState, blockRoots [][32]byte) error
@@ -85,11 +105,23 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	// Committee cache operations.
+	SaveCommitteeCacheEntries(ctx context.Context, entries *db.CommitteeCacheEntries) error
+	// Operation pool persistence.
+	SaveOperationPool(ctx context.Context, name string, ops [][]byte) error
+	// Background operation progress persistence.
+	SaveProgress(ctx context.Context, name string, cursor []byte) error
+	DeleteProgress(ctx context.Context, name string) error
+	// Attestation inclusion index.
+	SaveAttestationInclusions(ctx context.Context, inclusions []*AttestationInclusion) error
+	// Epoch reward reports.
+	SaveEpochReport(ctx context.Context, report *precompute.EpochReport) error
 
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint types.Slot) error
+	PruneFinalized(ctx context.Context, slotsPerArchivedPoint types.Slot) (*PruneStats, error)
 }
 
 // HeadAccessDatabase defines a struct with access to reading chain head data.
@@ -104,6 +136,10 @@ type HeadAccessDatabase interface {
 	LoadGenesis(ctx context.Context, r io.Reader) error
 	SaveGenesisData(ctx context.Context, state iface.BeaconState) error
 	EnsureEmbeddedGenesis(ctx context.Context) error
+
+	// Checkpoint sync operations.
+	LoadCheckpoint(ctx context.Context, stateReader, blockReader io.Reader) error
+	SaveOrigin(ctx context.Context, checkpointState iface.BeaconState, checkpointBlock *eth.SignedBeaconBlock) error
 }
 
 // Database interface with full access.
@@ -115,3 +151,20 @@ type Database interface {
 	DatabasePath() string
 	ClearDB() error
 }
+
+// PruneStats reports what was deleted by pruning the finalized history of a database.
+type PruneStats struct {
+	DeletedStates  uint64
+	DeletedBlocks  uint64
+	ReclaimedBytes uint64
+}
+
+// AttestationInclusion records a block which included the attestation of a validator for an epoch. The
+// attestation slot is the inclusion slot minus the inclusion distance.
+type AttestationInclusion struct {
+	ValidatorIndex    types.ValidatorIndex
+	Epoch             types.Epoch
+	InclusionSlot     types.Slot
+	InclusionDistance types.Slot
+	BlockRoot         [32]byte
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	dbIface "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -58,6 +60,11 @@ func (e Exporter) BlockRootsBySlot(ctx context.Context, slot types.Slot) (bool,
 	return e.db.BlockRootsBySlot(ctx, slot)
 }
 
+// BlocksByProposer -- passthrough.
+func (e Exporter) BlocksByProposer(ctx context.Context, proposerIdx types.ValidatorIndex, startEpoch, endEpoch types.Epoch) ([]*eth.SignedBeaconBlock, [][32]byte, error) {
+	return e.db.BlocksByProposer(ctx, proposerIdx, startEpoch, endEpoch)
+}
+
 // HasBlock -- passthrough.
 func (e Exporter) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
 	return e.db.HasBlock(ctx, blockRoot)
@@ -133,11 +140,36 @@ func (e Exporter) GenesisBlock(ctx context.Context) (*eth.SignedBeaconBlock, err
 	return e.db.GenesisBlock(ctx)
 }
 
+// OriginCheckpointBlockRoot -- passthrough.
+func (e Exporter) OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.OriginCheckpointBlockRoot(ctx)
+}
+
+// BackfillBlockRoot -- passthrough.
+func (e Exporter) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.BackfillBlockRoot(ctx)
+}
+
+// FlushBatchedWrites -- passthrough.
+func (e Exporter) FlushBatchedWrites(ctx context.Context) error {
+	return e.db.FlushBatchedWrites(ctx)
+}
+
+// SaveBackfillBlocks -- passthrough.
+func (e Exporter) SaveBackfillBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error {
+	return e.db.SaveBackfillBlocks(ctx, blocks)
+}
+
 // SaveGenesisBlockRoot -- passthrough.
 func (e Exporter) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error {
 	return e.db.SaveGenesisBlockRoot(ctx, blockRoot)
 }
 
+// SaveInvalidBlock -- passthrough.
+func (e Exporter) SaveInvalidBlock(ctx context.Context, blockRoot [32]byte, signature []byte) error {
+	return e.db.SaveInvalidBlock(ctx, blockRoot, signature)
+}
+
 // SaveState -- passthrough.
 func (e Exporter) SaveState(ctx context.Context, st iface.ReadOnlyBeaconState, blockRoot [32]byte) error {
 	return e.db.SaveState(ctx, st, blockRoot)
@@ -213,11 +245,21 @@ func (e Exporter) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
 	return e.db.IsFinalizedBlock(ctx, blockRoot)
 }
 
+// IsInvalidBlock -- passthrough.
+func (e Exporter) IsInvalidBlock(ctx context.Context, blockRoot [32]byte) bool {
+	return e.db.IsInvalidBlock(ctx, blockRoot)
+}
+
 // FinalizedChildBlock -- passthrough.
 func (e Exporter) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (*eth.SignedBeaconBlock, error) {
 	return e.db.FinalizedChildBlock(ctx, blockRoot)
 }
 
+// FinalizedBlockRootBySlot -- passthrough.
+func (e Exporter) FinalizedBlockRootBySlot(ctx context.Context, slot types.Slot) ([32]byte, error) {
+	return e.db.FinalizedBlockRootBySlot(ctx, slot)
+}
+
 // PowchainData -- passthrough
 func (e Exporter) PowchainData(ctx context.Context) (*db.ETH1ChainData, error) {
 	return e.db.PowchainData(ctx)
@@ -228,6 +270,61 @@ func (e Exporter) SavePowchainData(ctx context.Context, data *db.ETH1ChainData)
 	return e.db.SavePowchainData(ctx, data)
 }
 
+// CommitteeCacheEntries -- passthrough
+func (e Exporter) CommitteeCacheEntries(ctx context.Context) (*db.CommitteeCacheEntries, error) {
+	return e.db.CommitteeCacheEntries(ctx)
+}
+
+// SaveCommitteeCacheEntries -- passthrough
+func (e Exporter) SaveCommitteeCacheEntries(ctx context.Context, entries *db.CommitteeCacheEntries) error {
+	return e.db.SaveCommitteeCacheEntries(ctx, entries)
+}
+
+// OperationPool -- passthrough
+func (e Exporter) OperationPool(ctx context.Context, name string) ([][]byte, error) {
+	return e.db.OperationPool(ctx, name)
+}
+
+// SaveOperationPool -- passthrough
+func (e Exporter) SaveOperationPool(ctx context.Context, name string, ops [][]byte) error {
+	return e.db.SaveOperationPool(ctx, name, ops)
+}
+
+// Progress -- passthrough
+func (e Exporter) Progress(ctx context.Context, name string) ([]byte, error) {
+	return e.db.Progress(ctx, name)
+}
+
+// SaveProgress -- passthrough
+func (e Exporter) SaveProgress(ctx context.Context, name string, cursor []byte) error {
+	return e.db.SaveProgress(ctx, name, cursor)
+}
+
+// DeleteProgress -- passthrough
+func (e Exporter) DeleteProgress(ctx context.Context, name string) error {
+	return e.db.DeleteProgress(ctx, name)
+}
+
+// AttestationInclusions -- passthrough
+func (e Exporter) AttestationInclusions(ctx context.Context, validatorIdx types.ValidatorIndex, startEpoch, endEpoch types.Epoch) ([]*dbIface.AttestationInclusion, error) {
+	return e.db.AttestationInclusions(ctx, validatorIdx, startEpoch, endEpoch)
+}
+
+// SaveAttestationInclusions -- passthrough
+func (e Exporter) SaveAttestationInclusions(ctx context.Context, inclusions []*dbIface.AttestationInclusion) error {
+	return e.db.SaveAttestationInclusions(ctx, inclusions)
+}
+
+// EpochReport -- passthrough
+func (e Exporter) EpochReport(ctx context.Context, epoch types.Epoch) (*precompute.EpochReport, error) {
+	return e.db.EpochReport(ctx, epoch)
+}
+
+// SaveEpochReport -- passthrough
+func (e Exporter) SaveEpochReport(ctx context.Context, report *precompute.EpochReport) error {
+	return e.db.SaveEpochReport(ctx, report)
+}
+
 // ArchivedPointRoot -- passthrough
 func (e Exporter) ArchivedPointRoot(ctx context.Context, index types.Slot) [32]byte {
 	return e.db.ArchivedPointRoot(ctx, index)
@@ -268,6 +365,11 @@ func (e Exporter) CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint
 	return e.db.RunMigrations(ctx)
 }
 
+// PruneFinalized -- passthrough
+func (e Exporter) PruneFinalized(ctx context.Context, slotsPerArchivedPoint types.Slot) (*dbIface.PruneStats, error) {
+	return e.db.PruneFinalized(ctx, slotsPerArchivedPoint)
+}
+
 // LoadGenesisFromFile -- passthrough
 func (e Exporter) LoadGenesis(ctx context.Context, r io.Reader) error {
 	return e.db.LoadGenesis(ctx, r)
@@ -282,3 +384,13 @@ func (e Exporter) SaveGenesisData(ctx context.Context, state iface.BeaconState)
 func (e Exporter) EnsureEmbeddedGenesis(ctx context.Context) error {
 	return e.db.EnsureEmbeddedGenesis(ctx)
 }
+
+// LoadCheckpoint -- passthrough.
+func (e Exporter) LoadCheckpoint(ctx context.Context, stateReader, blockReader io.Reader) error {
+	return e.db.LoadCheckpoint(ctx, stateReader, blockReader)
+}
+
+// SaveOrigin -- passthrough.
+func (e Exporter) SaveOrigin(ctx context.Context, checkpointState iface.BeaconState, checkpointBlock *eth.SignedBeaconBlock) error {
+	return e.db.SaveOrigin(ctx, checkpointState, checkpointBlock)
+}
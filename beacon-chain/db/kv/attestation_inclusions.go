@@ -0,0 +1,100 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// The attestation inclusion index is keyed by validator index and epoch, both big endian so that
+// the epochs of a validator are sorted, followed by the root of the including block, so that the
+// inclusions by blocks of different forks are all kept. Its values are the inclusion slot and the
+// inclusion distance.
+const (
+	attestationInclusionKeyLength   = 8 + 8 + 32
+	attestationInclusionValueLength = 8 + 8
+)
+
+// AttestationInclusions retrieves the attestation inclusions of a validator indexed for the epochs
+// of the given range, both ends included, in epoch order and then in inclusion slot order. An
+// attestation may be included by several blocks, of the same fork or of different forks, so callers
+// resolve the canonical inclusion of an epoch by checking the block roots of its inclusions.
+func (s *Store) AttestationInclusions(
+	ctx context.Context,
+	validatorIdx types.ValidatorIndex,
+	startEpoch, endEpoch types.Epoch,
+) ([]*iface.AttestationInclusion, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AttestationInclusions")
+	defer span.End()
+
+	if endEpoch < startEpoch {
+		return nil, errors.Errorf("end epoch %d is before start epoch %d", endEpoch, startEpoch)
+	}
+	prefix := bytesutil.Uint64ToBytesBigEndian(uint64(validatorIdx))
+	var inclusions []*iface.AttestationInclusion
+	err := s.db.View(func(tx backend.Tx) error {
+		c := tx.Bucket(attestationInclusionIndexBucket).Cursor()
+		seek := append(append([]byte{}, prefix...), bytesutil.EpochToBytesBigEndian(startEpoch)...)
+		for k, v := c.Seek(seek); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			epoch := bytesutil.BytesToEpochBigEndian(k[8:16])
+			if epoch > endEpoch {
+				break
+			}
+			if len(k) != attestationInclusionKeyLength || len(v) != attestationInclusionValueLength {
+				return errors.Errorf("malformed attestation inclusion of validator %d at epoch %d", validatorIdx, epoch)
+			}
+			inclusions = append(inclusions, &iface.AttestationInclusion{
+				ValidatorIndex:    validatorIdx,
+				Epoch:             epoch,
+				InclusionSlot:     bytesutil.BytesToSlotBigEndian(v[:8]),
+				InclusionDistance: bytesutil.BytesToSlotBigEndian(v[8:]),
+				BlockRoot:         bytesutil.ToBytes32(k[16:]),
+			})
+		}
+		return nil
+	})
+	// The inclusions of an epoch are sorted by block root in the index.
+	sort.SliceStable(inclusions, func(i, j int) bool {
+		if inclusions[i].Epoch != inclusions[j].Epoch {
+			return inclusions[i].Epoch < inclusions[j].Epoch
+		}
+		return inclusions[i].InclusionSlot < inclusions[j].InclusionSlot
+	})
+	traceutil.AnnotateError(span, err)
+	return inclusions, err
+}
+
+// SaveAttestationInclusions indexes the attestation inclusions of validators. Each including block
+// has its own entry, as an attestation may be included again by later blocks or by blocks of other
+// forks, and the canonical inclusion is only known once the block is read back.
+func (s *Store) SaveAttestationInclusions(ctx context.Context, inclusions []*iface.AttestationInclusion) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveAttestationInclusions")
+	defer span.End()
+
+	err := s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(attestationInclusionIndexBucket)
+		for _, inclusion := range inclusions {
+			key := make([]byte, 0, attestationInclusionKeyLength)
+			key = append(key, bytesutil.Uint64ToBytesBigEndian(uint64(inclusion.ValidatorIndex))...)
+			key = append(key, bytesutil.EpochToBytesBigEndian(inclusion.Epoch)...)
+			key = append(key, inclusion.BlockRoot[:]...)
+			value := make([]byte, 0, attestationInclusionValueLength)
+			value = append(value, bytesutil.SlotToBytesBigEndian(inclusion.InclusionSlot)...)
+			value = append(value, bytesutil.SlotToBytesBigEndian(inclusion.InclusionDistance)...)
+			if err := bkt.Put(key, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
@@ -0,0 +1,48 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_AttestationInclusions(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	require.NoError(t, db.SaveAttestationInclusions(ctx, []*iface.AttestationInclusion{
+		{ValidatorIndex: 1, Epoch: 1, InclusionSlot: 40, InclusionDistance: 5, BlockRoot: [32]byte{'a'}},
+		{ValidatorIndex: 1, Epoch: 2, InclusionSlot: 65, InclusionDistance: 1, BlockRoot: [32]byte{'b'}},
+		{ValidatorIndex: 1, Epoch: 3, InclusionSlot: 97, InclusionDistance: 1, BlockRoot: [32]byte{'c'}},
+		{ValidatorIndex: 2, Epoch: 2, InclusionSlot: 66, InclusionDistance: 2, BlockRoot: [32]byte{'b'}},
+	}))
+	// The inclusions of the same attestation by other blocks, of the same fork or of other forks, are
+	// kept along with the first one, in inclusion slot order.
+	require.NoError(t, db.SaveAttestationInclusions(ctx, []*iface.AttestationInclusion{
+		{ValidatorIndex: 1, Epoch: 2, InclusionSlot: 70, InclusionDistance: 6, BlockRoot: [32]byte{'0'}},
+		{ValidatorIndex: 1, Epoch: 1, InclusionSlot: 36, InclusionDistance: 1, BlockRoot: [32]byte{'e'}},
+	}))
+
+	inclusions, err := db.AttestationInclusions(ctx, 1, 1, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*iface.AttestationInclusion{
+		{ValidatorIndex: 1, Epoch: 1, InclusionSlot: 36, InclusionDistance: 1, BlockRoot: [32]byte{'e'}},
+		{ValidatorIndex: 1, Epoch: 1, InclusionSlot: 40, InclusionDistance: 5, BlockRoot: [32]byte{'a'}},
+		{ValidatorIndex: 1, Epoch: 2, InclusionSlot: 65, InclusionDistance: 1, BlockRoot: [32]byte{'b'}},
+		{ValidatorIndex: 1, Epoch: 2, InclusionSlot: 70, InclusionDistance: 6, BlockRoot: [32]byte{'0'}},
+	}, inclusions)
+
+	inclusions, err = db.AttestationInclusions(ctx, 2, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(inclusions))
+	assert.Equal(t, uint64(66), uint64(inclusions[0].InclusionSlot))
+
+	inclusions, err = db.AttestationInclusions(ctx, 3, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(inclusions))
+
+	_, err = db.AttestationInclusions(ctx, 1, 2, 1)
+	assert.ErrorContains(t, "end epoch 1 is before start epoch 2", err)
+}
@@ -0,0 +1,75 @@
+package kv
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// BackfillBlockRoot returns the root of the lowest block of the chain preceding the origin checkpoint
+// block which was backfilled, or the origin checkpoint block root if no block was backfilled yet. It
+// is the progress cursor of backfilling, saved along with the blocks.
+func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+
+	var root [32]byte
+	err := s.db.View(func(tx backend.Tx) error {
+		root = backfillBlockRoot(tx)
+		return nil
+	})
+	return root, err
+}
+
+// SaveBackfillBlocks saves the given blocks preceding the lowest backfilled block. The blocks must be
+// ordered by slot and form the chain of ancestors of the lowest backfilled block, as they are indexed
+// as finalized blocks.
+func (s *Store) SaveBackfillBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlocks")
+	defer span.End()
+
+	if len(blocks) == 0 {
+		return nil
+	}
+	if err := s.SaveBlocks(ctx, blocks); err != nil {
+		return err
+	}
+	roots := make([][32]byte, len(blocks))
+	for i, b := range blocks {
+		r, err := b.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots[i] = r
+	}
+	return s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		childRoot := backfillBlockRoot(tx)
+		for i := len(blocks) - 1; i >= 0; i-- {
+			enc, err := encode(ctx, &dbpb.FinalizedBlockRootContainer{
+				ParentRoot: blocks[i].Block.ParentRoot,
+				ChildRoot:  childRoot[:],
+			})
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(roots[i][:], enc); err != nil {
+				return err
+			}
+			childRoot = roots[i]
+		}
+		return tx.Bucket(progressBucket).Put([]byte(backfillProgressName), roots[0][:])
+	})
+}
+
+// This returns the lowest backfilled block root, defaulting to the origin checkpoint block root.
+func backfillBlockRoot(tx backend.Tx) [32]byte {
+	if r := tx.Bucket(progressBucket).Get([]byte(backfillProgressName)); r != nil {
+		return bytesutil.ToBytes32(r)
+	}
+	return bytesutil.ToBytes32(tx.Bucket(blocksBucket).Get(originCheckpointBlockRootKey))
+}
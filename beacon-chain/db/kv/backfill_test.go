@@ -0,0 +1,59 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_SaveBackfillBlocks(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	// The chain 1 <- 2 <- 3 <- 4 <- 5, started from block 5.
+	blks := make([]*ethpb.SignedBeaconBlock, 5)
+	roots := make([][32]byte, 5)
+	for i := range blks {
+		blks[i] = testutil.NewBeaconBlock()
+		blks[i].Block.Slot = types.Slot(i) + 1
+		if i > 0 {
+			blks[i].Block.ParentRoot = roots[i-1][:]
+		}
+		r, err := blks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = r
+	}
+	require.NoError(t, db.SaveBlock(ctx, blks[4]))
+	require.NoError(t, db.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(blocksBucket).Put(originCheckpointBlockRootKey, roots[4][:])
+	}))
+
+	root, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[4], root)
+
+	require.NoError(t, db.SaveBackfillBlocks(ctx, blks[2:4]))
+	root, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[2], root)
+	require.NoError(t, db.SaveBackfillBlocks(ctx, blks[:2]))
+	root, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[0], root)
+	cursor, err := db.Progress(ctx, backfillProgressName)
+	require.NoError(t, err)
+	assert.DeepEqual(t, roots[0][:], cursor)
+
+	for i := 0; i < 4; i++ {
+		assert.Equal(t, true, db.HasBlock(ctx, roots[i]))
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[i]), "Block %d is not finalized", i)
+	}
+	require.NoError(t, db.SaveBackfillBlocks(ctx, nil))
+}
@@ -6,6 +6,7 @@ import (
 	"path"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	bolt "go.etcd.io/bbolt"
@@ -19,6 +20,9 @@ const backupsDirectoryName = "backups"
 func (s *Store) Backup(ctx context.Context, outputDir string) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.Backup")
 	defer span.End()
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return err
+	}
 
 	var backupsDir string
 	var err error
@@ -63,8 +67,8 @@ func (s *Store) Backup(ctx context.Context, outputDir string) error {
 	// bucket to use less memory usage when backing up.
 	bucketKeys := [][]byte{}
 	bucketMap := make(map[string][][]byte)
-	err = s.db.View(func(tx *bolt.Tx) error {
-		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+	err = s.db.View(func(tx backend.Tx) error {
+		return tx.ForEach(func(name []byte, b backend.Bucket) error {
 			newName := make([]byte, len(name))
 			copy(newName, name)
 			bucketKeys = append(bucketKeys, newName)
@@ -96,7 +100,7 @@ func (s *Store) Backup(ctx context.Context, outputDir string) error {
 		log.Debugf("Copying bucket %s\n", k)
 		innerKeys := bucketMap[string(k)]
 		for _, ik := range innerKeys {
-			err = s.db.View(func(tx *bolt.Tx) error {
+			err = s.db.View(func(tx backend.Tx) error {
 				bkt := tx.Bucket(k)
 				return copyDB.Update(func(tx2 *bolt.Tx) error {
 					b2, err := tx2.CreateBucketIfNotExists(k)
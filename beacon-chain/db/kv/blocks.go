@@ -9,11 +9,11 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -28,8 +28,11 @@ func (s *Store) Block(ctx context.Context, blockRoot [32]byte) (*ethpb.SignedBea
 	if v, ok := s.blockCache.Get(string(blockRoot[:])); v != nil && ok {
 		return v.(*ethpb.SignedBeaconBlock), nil
 	}
+	if blk := s.writeBatch.get(blockRoot); blk != nil {
+		return blk, nil
+	}
 	var block *ethpb.SignedBeaconBlock
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		enc := bkt.Get(blockRoot[:])
 		if enc == nil {
@@ -46,7 +49,7 @@ func (s *Store) HeadBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error)
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HeadBlock")
 	defer span.End()
 	var headBlock *ethpb.SignedBeaconBlock
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		headRoot := bkt.Get(headBlockRootKey)
 		if headRoot == nil {
@@ -66,10 +69,13 @@ func (s *Store) HeadBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error)
 func (s *Store) Blocks(ctx context.Context, f *filters.QueryFilter) ([]*ethpb.SignedBeaconBlock, [][32]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.Blocks")
 	defer span.End()
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return nil, nil, err
+	}
 	blocks := make([]*ethpb.SignedBeaconBlock, 0)
 	blockRoots := make([][32]byte, 0)
 
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 
 		keys, err := blockRootsByFilter(ctx, tx, f)
@@ -99,8 +105,11 @@ func (s *Store) Blocks(ctx context.Context, f *filters.QueryFilter) ([]*ethpb.Si
 func (s *Store) BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlockRoots")
 	defer span.End()
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return nil, err
+	}
 	blockRoots := make([][32]byte, 0)
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		keys, err := blockRootsByFilter(ctx, tx, f)
 		if err != nil {
 			return err
@@ -124,8 +133,11 @@ func (s *Store) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
 	if v, ok := s.blockCache.Get(string(blockRoot[:])); v != nil && ok {
 		return true
 	}
+	if s.writeBatch.get(blockRoot) != nil {
+		return true
+	}
 	exists := false
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		exists = bkt.Get(blockRoot[:]) != nil
 		return nil
@@ -139,9 +151,12 @@ func (s *Store) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
 func (s *Store) BlocksBySlot(ctx context.Context, slot types.Slot) (bool, []*ethpb.SignedBeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlocksBySlot")
 	defer span.End()
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return false, nil, err
+	}
 	blocks := make([]*ethpb.SignedBeaconBlock, 0)
 
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 
 		keys, err := blockRootsBySlot(ctx, tx, slot)
@@ -166,8 +181,11 @@ func (s *Store) BlocksBySlot(ctx context.Context, slot types.Slot) (bool, []*eth
 func (s *Store) BlockRootsBySlot(ctx context.Context, slot types.Slot) (bool, [][32]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlockRootsBySlot")
 	defer span.End()
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return false, nil, err
+	}
 	blockRoots := make([][32]byte, 0)
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		keys, err := blockRootsBySlot(ctx, tx, slot)
 		if err != nil {
 			return err
@@ -184,11 +202,64 @@ func (s *Store) BlockRootsBySlot(ctx context.Context, slot types.Slot) (bool, []
 	return len(blockRoots) > 0, blockRoots, nil
 }
 
+// BlocksByProposer retrieves the beacon blocks proposed by a validator in the epochs of the given
+// range, both ends included, along with their roots in slot order.
+func (s *Store) BlocksByProposer(
+	ctx context.Context,
+	proposerIdx types.ValidatorIndex,
+	startEpoch, endEpoch types.Epoch,
+) ([]*ethpb.SignedBeaconBlock, [][32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BlocksByProposer")
+	defer span.End()
+	if endEpoch < startEpoch {
+		return nil, nil, errors.Errorf("end epoch %d is before start epoch %d", endEpoch, startEpoch)
+	}
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return nil, nil, err
+	}
+	startSlot, err := helpers.StartSlot(startEpoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	endSlot, err := helpers.EndSlot(endEpoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	blocks := make([]*ethpb.SignedBeaconBlock, 0)
+	blockRoots := make([][32]byte, 0)
+	err = s.db.View(func(tx backend.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		prefix := bytesutil.Uint64ToBytesBigEndian(uint64(proposerIdx))
+		c := tx.Bucket(blockProposerIndicesBucket).Cursor()
+		for k, v := c.Seek(blockProposerIndex(proposerIdx, startSlot)); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if bytesutil.BytesToSlotBigEndian(k[8:]) > endSlot {
+				break
+			}
+			for i := 0; i+32 <= len(v); i += 32 {
+				block := &ethpb.SignedBeaconBlock{}
+				if err := decode(ctx, bkt.Get(v[i:i+32]), block); err != nil {
+					return err
+				}
+				blocks = append(blocks, block)
+				blockRoots = append(blockRoots, bytesutil.ToBytes32(v[i:i+32]))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not retrieve blocks by proposer")
+	}
+	return blocks, blockRoots, nil
+}
+
 // deleteBlock by block root.
 func (s *Store) deleteBlock(ctx context.Context, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.deleteBlock")
 	defer span.End()
-	return s.db.Update(func(tx *bolt.Tx) error {
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return err
+	}
+	return s.db.Update(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		enc := bkt.Get(blockRoot[:])
 		if enc == nil {
@@ -211,8 +282,11 @@ func (s *Store) deleteBlock(ctx context.Context, blockRoot [32]byte) error {
 func (s *Store) deleteBlocks(ctx context.Context, blockRoots [][32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.deleteBlocks")
 	defer span.End()
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return err
+	}
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		for _, blockRoot := range blockRoots {
 			enc := bkt.Get(blockRoot[:])
@@ -256,7 +330,7 @@ func (s *Store) SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBloc
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBlocks")
 	defer span.End()
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		for _, block := range blocks {
 			blockRoot, err := block.Block.HashTreeRoot()
@@ -271,13 +345,7 @@ func (s *Store) SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBloc
 			if err != nil {
 				return err
 			}
-			indicesByBucket := createBlockIndicesFromBlock(ctx, block.Block)
-			if err := updateValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
-				return errors.Wrap(err, "could not update DB indices")
-			}
-			s.blockCache.Set(string(blockRoot[:]), block, int64(len(enc)))
-
-			if err := bkt.Put(blockRoot[:], enc); err != nil {
+			if err := s.saveBlock(ctx, tx, blockRoot, block, enc); err != nil {
 				return err
 			}
 		}
@@ -285,11 +353,28 @@ func (s *Store) SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBloc
 	})
 }
 
+// This saves an encoded block along with its indices, unless the block is already saved.
+func (s *Store) saveBlock(ctx context.Context, tx backend.Tx, blockRoot [32]byte, block *ethpb.SignedBeaconBlock, enc []byte) error {
+	bkt := tx.Bucket(blocksBucket)
+	if existingBlock := bkt.Get(blockRoot[:]); existingBlock != nil {
+		return nil
+	}
+	indicesByBucket := createBlockIndicesFromBlock(ctx, block.Block)
+	if err := updateValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+		return errors.Wrap(err, "could not update DB indices")
+	}
+	s.blockCache.Set(string(blockRoot[:]), block, int64(len(enc)))
+	return bkt.Put(blockRoot[:], enc)
+}
+
 // SaveHeadBlockRoot to the db.
 func (s *Store) SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveHeadBlockRoot")
 	defer span.End()
-	return s.db.Update(func(tx *bolt.Tx) error {
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return err
+	}
+	return s.db.Update(func(tx backend.Tx) error {
 		hasStateSummaryInDB := s.HasStateSummary(ctx, blockRoot)
 		hasStateInDB := tx.Bucket(stateBucket).Get(blockRoot[:]) != nil
 		if !(hasStateInDB || hasStateSummaryInDB) {
@@ -306,7 +391,7 @@ func (s *Store) GenesisBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, err
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.GenesisBlock")
 	defer span.End()
 	var block *ethpb.SignedBeaconBlock
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		root := bkt.Get(genesisBlockRootKey)
 		enc := bkt.Get(root)
@@ -323,19 +408,50 @@ func (s *Store) GenesisBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, err
 func (s *Store) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveGenesisBlockRoot")
 	defer span.End()
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bucket := tx.Bucket(blocksBucket)
 		return bucket.Put(genesisBlockRootKey, blockRoot[:])
 	})
 }
 
+// SaveInvalidBlock records a block as invalid so that the block, and any of its descendants, are
+// rejected if they are received again. The record is keyed by the block root together with the
+// block's signature, as only blocks carrying a valid proposer signature may be recorded as invalid.
+func (s *Store) SaveInvalidBlock(ctx context.Context, blockRoot [32]byte, signature []byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveInvalidBlock")
+	defer span.End()
+	return s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(invalidBlocksBucket)
+		return bkt.Put(append(blockRoot[:], signature...), []byte{1})
+	})
+}
+
+// IsInvalidBlock returns true if a block with the given root has been recorded as invalid in the db.
+func (s *Store) IsInvalidBlock(ctx context.Context, blockRoot [32]byte) bool {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.IsInvalidBlock")
+	defer span.End()
+	exists := false
+	if err := s.db.View(func(tx backend.Tx) error {
+		c := tx.Bucket(invalidBlocksBucket).Cursor()
+		k, _ := c.Seek(blockRoot[:])
+		exists = k != nil && bytes.HasPrefix(k, blockRoot[:])
+		return nil
+	}); err != nil { // This view never returns an error, but we'll handle anyway for sanity.
+		panic(err)
+	}
+	return exists
+}
+
 // HighestSlotBlocksBelow returns the block with the highest slot below the input slot from the db.
 func (s *Store) HighestSlotBlocksBelow(ctx context.Context, slot types.Slot) ([]*ethpb.SignedBeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.HighestSlotBlocksBelow")
 	defer span.End()
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return nil, err
+	}
 
 	var best []byte
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blockSlotIndicesBucket)
 		// Iterate through the index, which is in byte sorted order.
 		c := bkt.Cursor()
@@ -376,7 +492,7 @@ func (s *Store) HighestSlotBlocksBelow(ctx context.Context, slot types.Slot) ([]
 }
 
 // blockRootsByFilter retrieves the block roots given the filter criteria.
-func blockRootsByFilter(ctx context.Context, tx *bolt.Tx, f *filters.QueryFilter) ([][]byte, error) {
+func blockRootsByFilter(ctx context.Context, tx backend.Tx, f *filters.QueryFilter) ([][]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.blockRootsByFilter")
 	defer span.End()
 
@@ -437,7 +553,7 @@ func blockRootsByFilter(ctx context.Context, tx *bolt.Tx, f *filters.QueryFilter
 // However, if step is one, the implemented logic won’t skip half of the slots in the range.
 func blockRootsBySlotRange(
 	ctx context.Context,
-	bkt *bolt.Bucket,
+	bkt backend.Bucket,
 	startSlotEncoded, endSlotEncoded, startEpochEncoded, endEpochEncoded, slotStepEncoded interface{},
 ) ([][]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.blockRootsBySlotRange")
@@ -504,7 +620,7 @@ func blockRootsBySlotRange(
 }
 
 // blockRootsBySlot retrieves the block roots by slot
-func blockRootsBySlot(ctx context.Context, tx *bolt.Tx, slot types.Slot) ([][]byte, error) {
+func blockRootsBySlot(ctx context.Context, tx backend.Tx, slot types.Slot) ([][]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.blockRootsBySlot")
 	defer span.End()
 
@@ -532,9 +648,11 @@ func createBlockIndicesFromBlock(ctx context.Context, block *ethpb.BeaconBlock)
 	// range scans for filtering across keys.
 	buckets := [][]byte{
 		blockSlotIndicesBucket,
+		blockProposerIndicesBucket,
 	}
 	indices := [][]byte{
 		bytesutil.SlotToBytesBigEndian(block.Slot),
+		blockProposerIndex(block.ProposerIndex, block.Slot),
 	}
 	if block.ParentRoot != nil && len(block.ParentRoot) > 0 {
 		buckets = append(buckets, blockParentRootIndicesBucket)
@@ -546,6 +664,12 @@ func createBlockIndicesFromBlock(ctx context.Context, block *ethpb.BeaconBlock)
 	return indicesByBucket
 }
 
+// blockProposerIndex returns the key of a block in the proposer indices bucket, the proposer index
+// followed by the slot, both big endian so that the blocks of a proposer are sorted by slot.
+func blockProposerIndex(proposerIdx types.ValidatorIndex, slot types.Slot) []byte {
+	return append(bytesutil.Uint64ToBytesBigEndian(uint64(proposerIdx)), bytesutil.SlotToBytesBigEndian(slot)...)
+}
+
 // createBlockFiltersFromIndices takes in filter criteria and returns
 // a map with a single key-value pair: "block-parent-root-indices” -> parentRoot (array of bytes).
 //
@@ -147,6 +147,21 @@ func TestStore_BlocksHandleInvalidEndSlot(t *testing.T) {
 	assert.Equal(t, 1, len(requested), "Unexpected number of blocks received, only expected two")
 }
 
+func TestStore_InvalidBlocks(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	r1 := [32]byte{'a'}
+	r2 := [32]byte{'b'}
+	sig := bytesutil.PadTo([]byte{'s'}, 96)
+	assert.Equal(t, false, db.IsInvalidBlock(ctx, r1))
+	require.NoError(t, db.SaveInvalidBlock(ctx, r1, sig))
+	require.NoError(t, db.SaveInvalidBlock(ctx, r2, sig))
+	assert.Equal(t, true, db.IsInvalidBlock(ctx, r1))
+	assert.Equal(t, true, db.IsInvalidBlock(ctx, r2))
+	assert.Equal(t, false, db.IsInvalidBlock(ctx, [32]byte{'c'}))
+	assert.Equal(t, false, db.IsInvalidBlock(ctx, [32]byte{'a', 1}))
+}
+
 func TestStore_GenesisBlock(t *testing.T) {
 	db := setupDB(t)
 	ctx := context.Background()
@@ -502,3 +517,54 @@ func TestStore_BlocksBySlot_BlockRootsBySlot(t *testing.T) {
 	assert.DeepEqual(t, [][32]byte{r2, r3}, retrievedBlockRoots)
 	assert.Equal(t, true, hasBlockRoots, "Expected no block roots")
 }
+
+func TestStore_BlocksByProposer(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var blocks []*ethpb.SignedBeaconBlock
+	for _, slot := range []types.Slot{1, slotsPerEpoch + 1, 2*slotsPerEpoch + 1, 3*slotsPerEpoch + 1} {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ProposerIndex = 5
+		blocks = append(blocks, b)
+	}
+	// An equivocating block of the same proposer and slot, and a block of another proposer.
+	equivocation := testutil.NewBeaconBlock()
+	equivocation.Block.Slot = slotsPerEpoch + 1
+	equivocation.Block.ProposerIndex = 5
+	equivocation.Block.ParentRoot = bytesutil.PadTo([]byte("other"), 32)
+	other := testutil.NewBeaconBlock()
+	other.Block.Slot = slotsPerEpoch + 2
+	other.Block.ProposerIndex = 6
+	require.NoError(t, db.SaveBlocks(ctx, append(blocks, equivocation, other)))
+
+	retrieved, roots, err := db.BlocksByProposer(ctx, 5, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(retrieved))
+	require.Equal(t, 3, len(roots))
+	assert.Equal(t, slotsPerEpoch+1, retrieved[0].Block.Slot)
+	assert.Equal(t, slotsPerEpoch+1, retrieved[1].Block.Slot)
+	assert.Equal(t, 2*slotsPerEpoch+1, retrieved[2].Block.Slot)
+	for i, b := range retrieved {
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, r, roots[i])
+		assert.Equal(t, types.ValidatorIndex(5), b.Block.ProposerIndex)
+	}
+
+	// Deleted blocks are removed from the index.
+	r, err := equivocation.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.deleteBlock(ctx, r))
+	retrieved, _, err = db.BlocksByProposer(ctx, 5, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(retrieved))
+
+	retrieved, _, err = db.BlocksByProposer(ctx, 7, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(retrieved))
+
+	_, _, err = db.BlocksByProposer(ctx, 5, 2, 1)
+	assert.ErrorContains(t, "end epoch 1 is before start epoch 2", err)
+}
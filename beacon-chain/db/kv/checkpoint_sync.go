@@ -0,0 +1,165 @@
+package kv
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	dbIface "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	state "github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// LoadCheckpoint loads a finalized checkpoint state and its block from SSZ encoded readers, and
+// saves them as the origin of the chain, which the node starts from instead of genesis. The state
+// may also be compressed with the snappy framing format, as exported by the node.
+func (s *Store) LoadCheckpoint(ctx context.Context, stateReader, blockReader io.Reader) error {
+	enc, err := ioutil.ReadAll(stateReader)
+	if err != nil {
+		return err
+	}
+	cs, err := decodeCheckpointState(enc)
+	if err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	enc, err = ioutil.ReadAll(blockReader)
+	if err != nil {
+		return err
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(enc); err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	return s.SaveOrigin(ctx, cs, blk)
+}
+
+// decodeCheckpointState decodes a checkpoint state from its SSZ encoding, compressed or not.
+func decodeCheckpointState(enc []byte) (*state.BeaconState, error) {
+	if state.IsSSZSnappy(enc) {
+		return state.InitializeFromSSZSnappy(enc)
+	}
+	st := &pbp2p.BeaconState{}
+	if err := st.UnmarshalSSZ(enc); err != nil {
+		return nil, err
+	}
+	return state.InitializeFromProtoUnsafe(st)
+}
+
+// SaveOrigin saves the given finalized checkpoint state and block as the origin of the chain. The
+// origin is the finalized and justified checkpoint as well as the head of the chain, from which the
+// node syncs without the blocks preceding it. It requires the genesis state of the chain.
+func (s *Store) SaveOrigin(ctx context.Context, checkpointState iface.BeaconState, checkpointBlock *ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOrigin")
+	defer span.End()
+
+	if checkpointBlock == nil || checkpointBlock.Block == nil {
+		return errors.New("nil checkpoint block")
+	}
+	blockRoot, err := checkpointBlock.Block.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	originRoot, err := s.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		return err
+	}
+	// Starting again from the same checkpoint is a no-op.
+	if originRoot == blockRoot {
+		return nil
+	}
+
+	genesisState, err := s.GenesisState(ctx)
+	if err != nil {
+		return err
+	}
+	if genesisState == nil {
+		return errors.New("a genesis state is required to start from a checkpoint")
+	}
+	if bytesutil.ToBytes32(genesisState.GenesisValidatorRoot()) != bytesutil.ToBytes32(checkpointState.GenesisValidatorRoot()) {
+		return errors.New("checkpoint state is not from the chain of the genesis state")
+	}
+	head, err := s.HeadBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if head != nil && head.Block.Slot > 0 {
+		return dbIface.ErrExistingChainData
+	}
+	if checkpointState.Slot()%params.BeaconConfig().SlotsPerEpoch != 0 {
+		return errors.Errorf("checkpoint state slot %d is not the start slot of an epoch", checkpointState.Slot())
+	}
+	// The latest block header of the state commits to the checkpoint block. Its state root is only
+	// filled in when processing the next slot, so it is the root of the state itself until then.
+	header := checkpointState.LatestBlockHeader()
+	if header == nil {
+		return errors.New("checkpoint state has no latest block header")
+	}
+	if bytesutil.ToBytes32(header.StateRoot) == params.BeaconConfig().ZeroHash {
+		stateRoot, err := checkpointState.HashTreeRoot(ctx)
+		if err != nil {
+			return err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if headerRoot != blockRoot {
+		return errors.Errorf("checkpoint block root %#x is not the latest block root %#x of the checkpoint state", blockRoot, headerRoot)
+	}
+
+	if err := s.SaveBlock(ctx, checkpointBlock); err != nil {
+		return errors.Wrap(err, "could not save checkpoint block")
+	}
+	if err := s.SaveState(ctx, checkpointState, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state")
+	}
+	if err := s.SaveStateSummary(ctx, &pbp2p.StateSummary{
+		Slot: checkpointState.Slot(),
+		Root: blockRoot[:],
+	}); err != nil {
+		return err
+	}
+	if err := s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(blocksBucket).Put(originCheckpointBlockRootKey, blockRoot[:])
+	}); err != nil {
+		return err
+	}
+	checkpoint := &ethpb.Checkpoint{
+		Epoch: helpers.SlotToEpoch(checkpointState.Slot()),
+		Root:  blockRoot[:],
+	}
+	if err := s.SaveJustifiedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := s.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	if err := s.SaveHeadBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	return nil
+}
+
+// OriginCheckpointBlockRoot returns the root of the checkpoint block the node was started from, or
+// zero hashes if the node was started from genesis.
+func (s *Store) OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OriginCheckpointBlockRoot")
+	defer span.End()
+
+	var root [32]byte
+	err := s.db.View(func(tx backend.Tx) error {
+		root = bytesutil.ToBytes32(tx.Bucket(blocksBucket).Get(originCheckpointBlockRootKey))
+		return nil
+	})
+	return root, err
+}
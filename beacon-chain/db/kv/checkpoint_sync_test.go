@@ -0,0 +1,113 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_LoadCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	gs, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, gs.SetGenesisValidatorRoot(bytesutil.PadTo([]byte{'g'}, 32)))
+	require.NoError(t, db.SaveGenesisData(ctx, gs))
+
+	// The checkpoint block is the latest block of the checkpoint state, at the start of epoch 2.
+	slot := 2 * params.BeaconConfig().SlotsPerEpoch
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte{'p'}, 32)
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	cs := gs.Copy()
+	require.NoError(t, cs.SetSlot(slot))
+	require.NoError(t, cs.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := cs.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	encState, err := cs.InnerStateUnsafe().(*pb.BeaconState).MarshalSSZ()
+	require.NoError(t, err)
+	encBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, db.LoadCheckpoint(ctx, bytes.NewReader(encState), bytes.NewReader(encBlock)))
+
+	origin, err := db.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, origin)
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.Checkpoint{Epoch: 2, Root: blockRoot[:]}, finalized)
+	justified, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, finalized, justified)
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, blockRoot))
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, head)
+	st, err := db.State(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, slot, st.Slot())
+
+	// Loading the same checkpoint again is a no-op, while other checkpoints are rejected.
+	require.NoError(t, db.SaveOrigin(ctx, cs, blk))
+	other := testutil.NewBeaconBlock()
+	other.Block.Slot = slot
+	assert.Equal(t, iface.ErrExistingChainData, db.SaveOrigin(ctx, cs, other))
+}
+
+func TestStore_SaveOrigin_Invalid(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	gs, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	assert.ErrorContains(t, "genesis state is required", db.SaveOrigin(ctx, gs, blk))
+
+	require.NoError(t, db.SaveGenesisData(ctx, gs))
+	cs := gs.Copy()
+	require.NoError(t, cs.SetSlot(params.BeaconConfig().SlotsPerEpoch+1))
+	assert.ErrorContains(t, "is not the start slot of an epoch", db.SaveOrigin(ctx, cs, blk))
+
+	require.NoError(t, cs.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	assert.ErrorContains(t, "is not the latest block root", db.SaveOrigin(ctx, cs, blk))
+
+	require.NoError(t, cs.SetGenesisValidatorRoot(bytesutil.PadTo([]byte{'o'}, 32)))
+	assert.ErrorContains(t, "not from the chain of the genesis state", db.SaveOrigin(ctx, cs, blk))
+}
+
+func TestDecodeCheckpointState_Snappy(t *testing.T) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+
+	enc, err := st.InnerStateUnsafe().(*pb.BeaconState).MarshalSSZ()
+	require.NoError(t, err)
+	decoded, err := decodeCheckpointState(enc)
+	require.NoError(t, err)
+	assert.Equal(t, st.Slot(), decoded.Slot())
+
+	enc, err = st.MarshalSSZSnappy()
+	require.NoError(t, err)
+	decoded, err = decodeCheckpointState(enc)
+	require.NoError(t, err)
+	assert.Equal(t, st.Slot(), decoded.Slot())
+}
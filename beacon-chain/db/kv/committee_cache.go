@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveCommitteeCacheEntries saves the committee cache entries to be restored on restart.
+func (s *Store) SaveCommitteeCacheEntries(ctx context.Context, entries *db.CommitteeCacheEntries) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveCommitteeCacheEntries")
+	defer span.End()
+
+	if entries == nil {
+		err := errors.New("cannot save nil committee cache entries")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
+	err := s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		enc, err := proto.Marshal(entries)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(committeeCacheKey, enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// CommitteeCacheEntries retrieves the saved committee cache entries.
+func (s *Store) CommitteeCacheEntries(ctx context.Context) (*db.CommitteeCacheEntries, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.CommitteeCacheEntries")
+	defer span.End()
+
+	var entries *db.CommitteeCacheEntries
+	err := s.db.View(func(tx backend.Tx) error {
+		bkt := tx.Bucket(chainMetadataBucket)
+		enc := bkt.Get(committeeCacheKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		entries = &db.CommitteeCacheEntries{}
+		return proto.Unmarshal(enc, entries)
+	})
+	return entries, err
+}
@@ -0,0 +1,35 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_CommitteeCacheEntries(t *testing.T) {
+	ctx := context.Background()
+	store := setupDB(t)
+
+	entries, err := store.CommitteeCacheEntries(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*db.CommitteeCacheEntries)(nil), entries)
+	assert.ErrorContains(t, "cannot save nil committee cache entries", store.SaveCommitteeCacheEntries(ctx, nil))
+
+	want := &db.CommitteeCacheEntries{
+		Entries: []*db.CommitteeCacheEntry{
+			{
+				CommitteeCount:  2,
+				Seed:            []byte("seed"),
+				ShuffledIndices: []uint64{3, 1, 2, 0},
+				SortedIndices:   []uint64{0, 1, 2, 3},
+			},
+		},
+	}
+	require.NoError(t, store.SaveCommitteeCacheEntries(ctx, want))
+	entries, err = store.CommitteeCacheEntries(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, entries)
+}
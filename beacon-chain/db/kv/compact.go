@@ -0,0 +1,215 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// compactTxSize is the number of bytes copied per write transaction while compacting, which bounds
+// the memory used by the copy.
+const compactTxSize = 64 * 1024 * 1024
+
+// CompactDatabase rewrites the database of the given backend in the given directory without its free
+// pages, which are left behind by deleted data, and returns the number of bytes the database shrank
+// by. A LevelDB database is compacted in place instead. The database must not be open.
+func CompactDatabase(ctx context.Context, dirPath, backendName string) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.CompactDatabase")
+	defer span.End()
+
+	switch backendName {
+	case "", backend.BoltBackend:
+	case backend.LevelDBBackend:
+		levelDBDir := path.Join(dirPath, LevelDBDirName)
+		if _, err := os.Stat(levelDBDir); err != nil {
+			return 0, err
+		}
+		return compactLevelDB(levelDBDir)
+	default:
+		return 0, fmt.Errorf("unknown database backend %q", backendName)
+	}
+	datafile := path.Join(dirPath, DatabaseFileName)
+	compactedFile := datafile + ".compact"
+	info, err := os.Stat(datafile)
+	if err != nil {
+		return 0, err
+	}
+	src, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		ReadOnly: true,
+		Timeout:  params.BeaconIoConfig().BoltTimeout,
+	})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return 0, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return 0, err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+	dst, err := bolt.Open(compactedFile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout: params.BeaconIoConfig().BoltTimeout,
+	})
+	if err != nil {
+		return 0, err
+	}
+	dst.AllocSize = boltAllocSize
+	if err := copyBuckets(ctx, src, dst); err != nil {
+		if closeErr := dst.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close compacted database")
+		}
+		if rmErr := os.Remove(compactedFile); rmErr != nil {
+			log.WithError(rmErr).Error("Failed to remove compacted database")
+		}
+		return 0, errors.Wrap(err, "could not copy database")
+	}
+	if err := dst.Close(); err != nil {
+		return 0, err
+	}
+	compactedInfo, err := os.Stat(compactedFile)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.Rename(compactedFile, datafile); err != nil {
+		return 0, err
+	}
+	if compactedInfo.Size() >= info.Size() {
+		return 0, nil
+	}
+	return uint64(info.Size() - compactedInfo.Size()), nil
+}
+
+// This copies the buckets of the source database to the destination database, in write transactions
+// of bounded size.
+func copyBuckets(ctx context.Context, src, dst *bolt.DB) error {
+	return src.View(func(srcTx *bolt.Tx) error {
+		dstTx, err := dst.Begin(true)
+		if err != nil {
+			return err
+		}
+		c := &bucketCopier{ctx: ctx, dst: dst, tx: dstTx}
+		err = srcTx.ForEach(func(name []byte, srcBkt *bolt.Bucket) error {
+			return c.copyBucket([][]byte{name}, srcBkt)
+		})
+		if err != nil {
+			if rollbackErr := c.tx.Rollback(); rollbackErr != nil {
+				log.WithError(rollbackErr).Error("Failed to roll back compaction transaction")
+			}
+			return err
+		}
+		return c.tx.Commit()
+	})
+}
+
+// bucketCopier copies buckets to a destination database, committing the current write transaction
+// and beginning a new one whenever it grows past the size of a compaction transaction.
+type bucketCopier struct {
+	ctx  context.Context
+	dst  *bolt.DB
+	tx   *bolt.Tx
+	size int
+}
+
+// This copies a bucket of the given path along with its nested buckets. The destination bucket is
+// looked up by its path for every key, as the write transaction holding it may have been committed.
+func (c *bucketCopier) copyBucket(path [][]byte, src *bolt.Bucket) error {
+	if err := c.createBucket(path); err != nil {
+		return err
+	}
+	return src.ForEach(func(k, v []byte) error {
+		if c.ctx.Err() != nil {
+			return c.ctx.Err()
+		}
+		// A nil value is a nested bucket, such as the buckets of the operation pools.
+		if v == nil {
+			return c.copyBucket(append(path[:len(path):len(path)], k), src.Bucket(k))
+		}
+		if c.size+len(k)+len(v) > compactTxSize {
+			if err := c.tx.Commit(); err != nil {
+				return err
+			}
+			tx, err := c.dst.Begin(true)
+			if err != nil {
+				return err
+			}
+			c.tx = tx
+			c.size = 0
+		}
+		c.size += len(k) + len(v)
+		bkt := c.bucket(path)
+		// Keys are copied in order, so the pages are filled instead of being split in halves.
+		bkt.FillPercent = 1
+		return bkt.Put(k, v)
+	})
+}
+
+func (c *bucketCopier) createBucket(path [][]byte) error {
+	if len(path) == 1 {
+		_, err := c.tx.CreateBucketIfNotExists(path[0])
+		return err
+	}
+	_, err := c.bucket(path[:len(path)-1]).CreateBucketIfNotExists(path[len(path)-1])
+	return err
+}
+
+func (c *bucketCopier) bucket(path [][]byte) *bolt.Bucket {
+	bkt := c.tx.Bucket(path[0])
+	for _, name := range path[1:] {
+		bkt = bkt.Bucket(name)
+	}
+	return bkt
+}
+
+// This compacts the whole LevelDB database of the given directory, and returns the number of bytes
+// the directory shrank by.
+func compactLevelDB(dir string) (uint64, error) {
+	sizeBefore, err := dirSize(dir)
+	if err != nil {
+		return 0, err
+	}
+	db, err := backend.OpenLevelDB(dir)
+	if err != nil {
+		return 0, err
+	}
+	if err := db.Compact(); err != nil {
+		if closeErr := db.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close database")
+		}
+		return 0, errors.Wrap(err, "could not compact database")
+	}
+	if err := db.Close(); err != nil {
+		return 0, err
+	}
+	sizeAfter, err := dirSize(dir)
+	if err != nil {
+		return 0, err
+	}
+	if sizeAfter >= sizeBefore {
+		return 0, nil
+	}
+	return uint64(sizeBefore - sizeAfter), nil
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
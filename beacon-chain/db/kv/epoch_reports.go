@@ -0,0 +1,102 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// The epoch reports are keyed by big endian epoch, and their values are the snappy compressed
+// concatenation of the reward reports of the validators, each encoded as its fields in declaration
+// order as big endian uint64s.
+const rewardReportLength = 9 * 8
+
+// EpochReport retrieves the report of the rewards and penalties of the epoch transition at the end
+// of an epoch, or nil if the report was not saved.
+func (s *Store) EpochReport(ctx context.Context, epoch types.Epoch) (*precompute.EpochReport, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.EpochReport")
+	defer span.End()
+
+	var report *precompute.EpochReport
+	err := s.db.View(func(tx backend.Tx) error {
+		enc := tx.Bucket(epochReportsBucket).Get(bytesutil.EpochToBytesBigEndian(epoch))
+		if enc == nil {
+			return nil
+		}
+		var err error
+		report, err = decodeEpochReport(epoch, enc)
+		return err
+	})
+	traceutil.AnnotateError(span, err)
+	return report, err
+}
+
+// SaveEpochReport saves the report of the rewards and penalties of an epoch transition, replacing
+// the one of the same epoch.
+func (s *Store) SaveEpochReport(ctx context.Context, report *precompute.EpochReport) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveEpochReport")
+	defer span.End()
+
+	if report == nil {
+		return errors.New("nil epoch report")
+	}
+	enc := encodeEpochReport(report)
+	err := s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(epochReportsBucket).Put(bytesutil.EpochToBytesBigEndian(report.Epoch), enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+func encodeEpochReport(report *precompute.EpochReport) []byte {
+	buf := make([]byte, len(report.Validators)*rewardReportLength)
+	for i, r := range report.Validators {
+		fields := []uint64{
+			r.SourceReward, r.TargetReward, r.HeadReward, r.InclusionDelayReward, r.ProposerReward,
+			r.SourcePenalty, r.TargetPenalty, r.HeadPenalty, r.InactivityPenalty,
+		}
+		for j, f := range fields {
+			binary.BigEndian.PutUint64(buf[i*rewardReportLength+j*8:], f)
+		}
+	}
+	return snappy.Encode(nil, buf)
+}
+
+func decodeEpochReport(epoch types.Epoch, enc []byte) (*precompute.EpochReport, error) {
+	buf, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decompress epoch report of epoch %d", epoch)
+	}
+	if len(buf)%rewardReportLength != 0 {
+		return nil, errors.Errorf("malformed epoch report of epoch %d", epoch)
+	}
+	report := &precompute.EpochReport{
+		Epoch:      epoch,
+		Validators: make([]precompute.RewardReport, len(buf)/rewardReportLength),
+	}
+	for i := range report.Validators {
+		field := func(j int) uint64 {
+			return binary.BigEndian.Uint64(buf[i*rewardReportLength+j*8:])
+		}
+		report.Validators[i] = precompute.RewardReport{
+			SourceReward:         field(0),
+			TargetReward:         field(1),
+			HeadReward:           field(2),
+			InclusionDelayReward: field(3),
+			ProposerReward:       field(4),
+			SourcePenalty:        field(5),
+			TargetPenalty:        field(6),
+			HeadPenalty:          field(7),
+			InactivityPenalty:    field(8),
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,37 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_EpochReport(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	report, err := db.EpochReport(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, (*precompute.EpochReport)(nil), report)
+
+	wanted := &precompute.EpochReport{
+		Epoch: 3,
+		Validators: []precompute.RewardReport{
+			{SourceReward: 1, TargetReward: 2, HeadReward: 3, InclusionDelayReward: 4, ProposerReward: 5},
+			{SourcePenalty: 6, TargetPenalty: 7, HeadPenalty: 8, InactivityPenalty: 9},
+			{},
+		},
+	}
+	require.NoError(t, db.SaveEpochReport(ctx, wanted))
+	report, err = db.EpochReport(ctx, 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, report)
+
+	report, err = db.EpochReport(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, (*precompute.EpochReport)(nil), report)
+	assert.ErrorContains(t, "nil epoch report", db.SaveEpochReport(ctx, nil))
+}
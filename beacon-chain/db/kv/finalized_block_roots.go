@@ -5,12 +5,15 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -32,12 +35,13 @@ var containerFinalizedButNotCanonical = []byte("recent block needs reindexing to
 //   - De-index all finalized beacon block roots from previous_finalized_epoch to
 //     new_finalized_epoch. (I.e. delete these roots from the index, to be re-indexed.)
 //   - Build the canonical finalized chain by walking up the ancestry chain from the finalized block
-//     root until a parent is found in the index or the parent is genesis.
+//     root until a parent is found in the index, the parent is genesis or the block is the origin
+//     checkpoint block the node was started from.
 //   - Add all block roots in the database where epoch(block.slot) == checkpoint.epoch.
 //
 // This method ensures that all blocks from the current finalized epoch are considered "final" while
 // maintaining only canonical and finalized blocks older than the current finalized epoch.
-func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, checkpoint *ethpb.Checkpoint) error {
+func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx backend.Tx, checkpoint *ethpb.Checkpoint) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.updateFinalizedBlockRoots")
 	defer span.End()
 
@@ -46,6 +50,7 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 	root := checkpoint.Root
 	var previousRoot []byte
 	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	originRoot := tx.Bucket(blocksBucket).Get(originCheckpointBlockRootKey)
 
 	// De-index recent finalized block roots, to be re-indexed.
 	previousFinalizedCheckpoint := &ethpb.Checkpoint{}
@@ -105,6 +110,11 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 			return err
 		}
 
+		// The blocks preceding the checkpoint block the node was started from are not in the database.
+		if bytes.Equal(root, originRoot) {
+			break
+		}
+
 		// Found parent, loop exit condition.
 		if parentBytes := bkt.Get(block.ParentRoot); parentBytes != nil {
 			parent := &dbpb.FinalizedBlockRootContainer{}
@@ -164,7 +174,7 @@ func (s *Store) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool {
 	defer span.End()
 
 	var exists bool
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		exists = tx.Bucket(finalizedBlockRootsIndexBucket).Get(blockRoot[:]) != nil
 		// Check genesis block root.
 		if !exists {
@@ -185,9 +195,12 @@ func (s *Store) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool {
 func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (*ethpb.SignedBeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.FinalizedChildBlock")
 	defer span.End()
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return nil, err
+	}
 
 	var blk *ethpb.SignedBeaconBlock
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		blkBytes := tx.Bucket(finalizedBlockRootsIndexBucket).Get(blockRoot[:])
 		if blkBytes == nil {
 			return nil
@@ -210,3 +223,57 @@ func (s *Store) FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (*e
 	traceutil.AnnotateError(span, err)
 	return blk, err
 }
+
+// FinalizedBlockRootBySlot returns the root of the finalized and canonical block at the slot, or of
+// the last one before it if the slot was skipped, as the block roots of the state record skipped
+// slots. The slot must not be past the slot of the finalized checkpoint block.
+func (s *Store) FinalizedBlockRootBySlot(ctx context.Context, slot types.Slot) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.FinalizedBlockRootBySlot")
+	defer span.End()
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return [32]byte{}, err
+	}
+
+	var root [32]byte
+	err := s.db.View(func(tx backend.Tx) error {
+		enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+		if enc == nil {
+			return errors.Wrap(iface.ErrNotFinalizedSlot, "no finalized checkpoint")
+		}
+		checkpoint := &ethpb.Checkpoint{}
+		if err := decode(ctx, enc, checkpoint); err != nil {
+			return err
+		}
+		enc = tx.Bucket(blocksBucket).Get(checkpoint.Root)
+		if enc == nil {
+			return errors.Wrapf(iface.ErrNotFinalizedSlot, "missing finalized block %#x", checkpoint.Root)
+		}
+		finalized := &ethpb.SignedBeaconBlock{}
+		if err := decode(ctx, enc, finalized); err != nil {
+			return err
+		}
+		if slot > finalized.Block.Slot {
+			return errors.Wrapf(iface.ErrNotFinalizedSlot, "slot %d is past finalized slot %d", slot, finalized.Block.Slot)
+		}
+
+		idx := tx.Bucket(finalizedBlockRootsIndexBucket)
+		genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+		c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+		k, v := c.Seek(bytesutil.SlotToBytesBigEndian(slot))
+		if k == nil || bytesutil.BytesToSlotBigEndian(k) > slot {
+			k, v = c.Prev()
+		}
+		for ; k != nil; k, v = c.Prev() {
+			for i := 0; i+32 <= len(v); i += 32 {
+				r := v[i : i+32]
+				if ctr := idx.Get(r); (ctr != nil && !bytes.Equal(ctr, containerFinalizedButNotCanonical)) || bytes.Equal(r, genesisRoot) {
+					root = bytesutil.ToBytes32(r)
+					return nil
+				}
+			}
+		}
+		return errors.Wrapf(iface.ErrNotFinalizedSlot, "no finalized block at or before slot %d", slot)
+	})
+	traceutil.AnnotateError(span, err)
+	return root, err
+}
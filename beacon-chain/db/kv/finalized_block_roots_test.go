@@ -2,10 +2,12 @@ package kv
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -188,3 +190,51 @@ func makeBlocks(t *testing.T, i, n uint64, previousRoot [32]byte) []*ethpb.Signe
 	}
 	return blocks
 }
+
+func TestStore_FinalizedBlockRootBySlot(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, err := db.FinalizedBlockRootBySlot(ctx, 0)
+	assert.Equal(t, true, errors.Is(err, iface.ErrNotFinalizedSlot), "Unexpected error: %v", err)
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	// Slot 5 is skipped by the canonical chain, the block of a fork occupies it.
+	blks := makeBlocks(t, 0, 4, genesisRoot)
+	blks = append(blks, makeBlocks(t, 5, 2*slotsPerEpoch, bytesutil.ToBytes32(sszRootOrDie(t, blks[3])))...)
+	fork := testutil.NewBeaconBlock()
+	fork.Block.Slot = 5
+	fork.Block.ParentRoot = sszRootOrDie(t, blks[2])
+	require.NoError(t, db.SaveBlocks(ctx, append(blks, fork)))
+
+	checkpointBlk := blks[slotsPerEpoch-2]
+	require.Equal(t, types.Slot(slotsPerEpoch), checkpointBlk.Block.Slot)
+	root := bytesutil.ToBytes32(sszRootOrDie(t, checkpointBlk))
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, root))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: root[:]}))
+
+	tests := []struct {
+		slot types.Slot
+		want []byte
+	}{
+		{slot: 0, want: genesisRoot[:]},
+		{slot: 3, want: sszRootOrDie(t, blks[2])},
+		{slot: 5, want: sszRootOrDie(t, blks[3])},
+		{slot: 6, want: sszRootOrDie(t, blks[4])},
+		{slot: types.Slot(slotsPerEpoch), want: root[:]},
+	}
+	for _, tt := range tests {
+		r, err := db.FinalizedBlockRootBySlot(ctx, tt.slot)
+		require.NoError(t, err)
+		assert.DeepEqual(t, bytesutil.ToBytes32(tt.want), r, "Wrong root at slot %d", tt.slot)
+	}
+	_, err = db.FinalizedBlockRootBySlot(ctx, types.Slot(slotsPerEpoch+1))
+	assert.Equal(t, true, errors.Is(err, iface.ErrNotFinalizedSlot), "Unexpected error: %v", err)
+}
@@ -1,20 +1,20 @@
-// Package kv defines a bolt-db, key-value store implementation
-// of the Database interface defined by a Prysm beacon node.
+// Package kv defines a key-value store implementation, backed by
+// BoltDB or LevelDB, of the Database interface defined by a Prysm beacon node.
 package kv
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path"
-	"time"
 
 	"github.com/dgraph-io/ristretto"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	prombolt "github.com/prysmaticlabs/prombbolt"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
-	"github.com/prysmaticlabs/prysm/shared/params"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -29,6 +29,9 @@ const (
 	BeaconNodeDbDirName = "beaconchaindata"
 	// DatabaseFileName is the name of the beacon node database.
 	DatabaseFileName = "beaconchain.db"
+	// LevelDBDirName is the name of the directory of the beacon node database using the LevelDB
+	// backend.
+	LevelDBDirName = "beaconchain.ldb"
 
 	boltAllocSize = 8 * 1024 * 1024
 )
@@ -45,26 +48,29 @@ var blockedBuckets = [][]byte{
 	stateSummaryBucket,
 	blockParentRootIndicesBucket,
 	blockSlotIndicesBucket,
+	blockProposerIndicesBucket,
 	finalizedBlockRootsIndexBucket,
 }
 
-// Config for the bolt db kv store.
+// Config for the kv store. The backend defaults to BoltDB.
 type Config struct {
 	InitialMMapSize int
+	Backend         string
 }
 
 // Store defines an implementation of the Prysm Database interface
-// using BoltDB as the underlying persistent kv-store for eth2.
+// using BoltDB or LevelDB as the underlying persistent kv-store for eth2.
 type Store struct {
-	db                  *bolt.DB
+	db                  backend.DB
 	databasePath        string
 	blockCache          *ristretto.Cache
 	validatorIndexCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
+	writeBatch          *writeBatch
 	ctx                 context.Context
 }
 
-// NewKVStore initializes a new boltDB key-value store at the directory
+// NewKVStore initializes a new key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
 func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, error) {
@@ -77,22 +83,10 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			return nil, err
 		}
 	}
-	datafile := path.Join(dirPath, DatabaseFileName)
-	boltDB, err := bolt.Open(
-		datafile,
-		params.BeaconIoConfig().ReadWritePermissions,
-		&bolt.Options{
-			Timeout:         1 * time.Second,
-			InitialMmapSize: config.InitialMMapSize,
-		},
-	)
+	db, err := openBackend(dirPath, config)
 	if err != nil {
-		if errors.Is(err, bolt.ErrTimeout) {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
 		return nil, err
 	}
-	boltDB.AllocSize = boltAllocSize
 	blockCache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1000,           // number of keys to track frequency of (1000).
 		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
@@ -112,15 +106,16 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	}
 
 	kv := &Store{
-		db:                  boltDB,
+		db:                  db,
 		databasePath:        dirPath,
 		blockCache:          blockCache,
 		validatorIndexCache: validatorCache,
 		stateSummaryCache:   newStateSummaryCache(),
+		writeBatch:          newWriteBatch(dirPath),
 		ctx:                 ctx,
 	}
 
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
+	if err := kv.db.Update(func(tx backend.Tx) error {
 		return createBuckets(
 			tx,
 			attestationsBucket,
@@ -132,6 +127,9 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			chainMetadataBucket,
 			checkpointBucket,
 			powchainBucket,
+			invalidBlocksBucket,
+			operationPoolsBucket,
+			progressBucket,
 			stateSummaryBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
@@ -142,7 +140,10 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			blockSlotIndicesBucket,
 			stateSlotIndicesBucket,
 			blockParentRootIndicesBucket,
+			blockProposerIndicesBucket,
 			finalizedBlockRootsIndexBucket,
+			attestationInclusionIndexBucket,
+			epochReportsBucket,
 			// New State Management service bucket.
 			newStateServiceCompatibleBucket,
 			// Migrations
@@ -151,29 +152,89 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	}); err != nil {
 		return nil, err
 	}
+	if err := kv.replayWriteBatchJournal(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not replay write batch journal")
+	}
 
-	err = prometheus.Register(createBoltCollector(kv.db))
-
+	if boltDB, ok := kv.db.(*backend.BoltDB); ok {
+		err = prometheus.Register(createBoltCollector(boltDB.DB()))
+	}
 	return kv, err
 }
 
+// This opens the database of the configured backend. Opening a backend fails if the database of the
+// directory was written by the other backend, as it has to be migrated first.
+func openBackend(dirPath string, config *Config) (backend.DB, error) {
+	name := config.Backend
+	if name == "" {
+		name = backend.BoltBackend
+	}
+	boltFile := path.Join(dirPath, DatabaseFileName)
+	levelDBDir := path.Join(dirPath, LevelDBDirName)
+	switch name {
+	case backend.BoltBackend:
+		if err := checkOtherBackend(levelDBDir, boltFile, backend.LevelDBBackend); err != nil {
+			return nil, err
+		}
+		db, err := backend.OpenBolt(boltFile, config.InitialMMapSize)
+		if err != nil {
+			if errors.Is(err, bolt.ErrTimeout) {
+				return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+			}
+			return nil, err
+		}
+		return db, nil
+	case backend.LevelDBBackend:
+		if err := checkOtherBackend(boltFile, levelDBDir, backend.BoltBackend); err != nil {
+			return nil, err
+		}
+		return backend.OpenLevelDB(levelDBDir)
+	default:
+		return nil, fmt.Errorf("unknown database backend %q", name)
+	}
+}
+
+// This fails if the database of the other backend exists while the opened one does not.
+func checkOtherBackend(other, opened, otherBackend string) error {
+	if _, err := os.Stat(opened); !os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := os.Stat(other); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return fmt.Errorf("database at %s uses the %s backend, run the db migrate-backend command to switch backends", other, otherBackend)
+}
+
 // ClearDB removes the previously stored database in the data directory.
 func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
-	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
+	if boltDB, ok := s.db.(*backend.BoltDB); ok {
+		prometheus.Unregister(createBoltCollector(boltDB.DB()))
+	}
+	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil && !os.IsNotExist(err) {
 		return errors.Wrap(err, "could not remove database file")
 	}
+	if err := os.RemoveAll(path.Join(s.databasePath, LevelDBDirName)); err != nil {
+		return errors.Wrap(err, "could not remove database directory")
+	}
 	return nil
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying database.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	if boltDB, ok := s.db.(*backend.BoltDB); ok {
+		prometheus.Unregister(createBoltCollector(boltDB.DB()))
+	}
 
-	// Before DB closes, we should dump the cached state summary objects to DB.
+	// Before DB closes, we should dump the batched blocks and the cached state summary objects to DB.
+	if err := s.flushWriteBatch(s.ctx); err != nil {
+		return err
+	}
 	if err := s.saveCachedStateSummariesDB(s.ctx); err != nil {
 		return err
 	}
@@ -186,7 +247,7 @@ func (s *Store) DatabasePath() string {
 	return s.databasePath
 }
 
-func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+func createBuckets(tx backend.Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
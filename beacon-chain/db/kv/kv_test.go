@@ -4,6 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -16,3 +19,50 @@ func setupDB(t testing.TB) *Store {
 	})
 	return db
 }
+
+func TestNewKVStore_Backend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{Backend: backend.LevelDBBackend})
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	r, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	require.NoError(t, db.Close())
+
+	_, err = NewKVStore(ctx, dir, &Config{})
+	assert.ErrorContains(t, "uses the leveldb backend", err)
+	_, err = NewKVStore(ctx, dir, &Config{Backend: "unknown"})
+	assert.ErrorContains(t, "unknown database backend", err)
+
+	db, err = NewKVStore(ctx, dir, &Config{Backend: backend.LevelDBBackend})
+	require.NoError(t, err)
+	assert.Equal(t, true, db.HasBlock(ctx, r))
+	require.NoError(t, db.Close())
+}
+
+func TestMigrateBackend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	r, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	require.NoError(t, db.Close())
+
+	require.NoError(t, MigrateBackend(ctx, dir, backend.LevelDBBackend))
+	assert.ErrorContains(t, "no database to migrate", MigrateBackend(ctx, dir, backend.LevelDBBackend))
+	db, err = NewKVStore(ctx, dir, &Config{Backend: backend.LevelDBBackend})
+	require.NoError(t, err)
+	assert.Equal(t, true, db.HasBlock(ctx, r))
+	require.NoError(t, db.Close())
+
+	require.NoError(t, MigrateBackend(ctx, dir, backend.BoltBackend))
+	db, err = NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	assert.Equal(t, true, db.HasBlock(ctx, r))
+	require.NoError(t, db.Close())
+}
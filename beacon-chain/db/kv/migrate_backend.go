@@ -0,0 +1,71 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"go.opencensus.io/trace"
+)
+
+// MigrateBackend copies the database of the given directory into a database of the target backend,
+// then deletes the source database. The database must not be open.
+func MigrateBackend(ctx context.Context, dirPath, target string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.MigrateBackend")
+	defer span.End()
+
+	boltFile := path.Join(dirPath, DatabaseFileName)
+	levelDBDir := path.Join(dirPath, LevelDBDirName)
+	var srcPath, dstPath string
+	switch target {
+	case backend.LevelDBBackend:
+		srcPath, dstPath = boltFile, levelDBDir
+	case backend.BoltBackend:
+		srcPath, dstPath = levelDBDir, boltFile
+	default:
+		return errors.Errorf("unknown database backend %q", target)
+	}
+	if _, err := os.Stat(srcPath); err != nil {
+		return errors.Wrapf(err, "no database to migrate to the %s backend", target)
+	}
+	if _, err := os.Stat(dstPath); !os.IsNotExist(err) {
+		return errors.Errorf("database %s already exists", dstPath)
+	}
+
+	src, err := openBackendPath(srcPath)
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+	dst, err := openBackendPath(dstPath)
+	if err != nil {
+		return errors.Wrap(err, "could not create database")
+	}
+	if err := backend.Copy(ctx, src, dst); err != nil {
+		if closeErr := dst.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close migrated database")
+		}
+		if rmErr := os.RemoveAll(dstPath); rmErr != nil {
+			log.WithError(rmErr).Error("Failed to remove migrated database")
+		}
+		return errors.Wrap(err, "could not copy database")
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return os.RemoveAll(srcPath)
+}
+
+// This opens the database at the given path of the backend its name belongs to.
+func openBackendPath(p string) (backend.DB, error) {
+	if path.Base(p) == LevelDBDirName {
+		return backend.OpenLevelDB(p)
+	}
+	return backend.OpenBolt(p, 0)
+}
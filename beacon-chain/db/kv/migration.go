@@ -2,29 +2,143 @@ package kv
 
 import (
 	"context"
+	"fmt"
 
-	bolt "go.etcd.io/bbolt"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
 )
 
 var migrationCompleted = []byte("done")
 
-type migration func(*bolt.Tx) error
+// schemaVersionKey is the key of the schema version of the DB in the migrations bucket, which is the
+// number of migrations of the migrations array applied to the DB.
+var schemaVersionKey = []byte("schema-version")
 
+// migrationBatchSize is the number of entries a resumable migration rewrites per transaction.
+const migrationBatchSize = 10000
+
+// migration upgrades the DB from the schema version of its position in the migrations array to the
+// next one. A migration runs in steps, each in its own transaction along with its progress cursor, so
+// that a migration of a large DB is not a single huge transaction and resumes where it stopped after
+// a restart.
+type migration struct {
+	// name identifies the migration in logs and in the progress bucket.
+	name string
+	// step migrates a batch of entries after the cursor, nil for the first batch, and returns the
+	// cursor of the next batch, nil once the migration is complete, along with the number of entries
+	// it migrated.
+	step func(ctx context.Context, tx backend.Tx, cursor []byte) ([]byte, int, error)
+}
+
+// migrations are applied in order, a migration is only ever appended to this array.
 var migrations = []migration{
-	migrateArchivedIndex,
-	migrateBlockSlotIndex,
+	{name: "archived-index", step: singleStep(migrateArchivedIndex)},
+	{name: "block-slot-index", step: singleStep(migrateBlockSlotIndex)},
+	{name: "block-proposer-index", step: migrateBlockProposerIndex},
+}
+
+// singleStep adapts a migration which rewrites the DB in a single transaction.
+func singleStep(fn func(backend.Tx) error) func(context.Context, backend.Tx, []byte) ([]byte, int, error) {
+	return func(_ context.Context, tx backend.Tx, _ []byte) ([]byte, int, error) {
+		return nil, 0, fn(tx)
+	}
+}
+
+// SchemaVersion is the schema version of the DB and the latest schema version known to this node.
+func (s *Store) SchemaVersion(ctx context.Context) (current, latest uint64, err error) {
+	err = s.db.View(func(tx backend.Tx) error {
+		current = schemaVersion(tx)
+		return nil
+	})
+	return current, uint64(len(migrations)), err
+}
+
+func schemaVersion(tx backend.Tx) uint64 {
+	return bytesutil.BytesToUint64BigEndian(tx.Bucket(migrationsBucket).Get(schemaVersionKey))
 }
 
-// RunMigrations defined in the migrations array.
+// RunMigrations applies the migrations of the migrations array which the DB has not been upgraded with.
 func (s *Store) RunMigrations(ctx context.Context) error {
-	for _, m := range migrations {
+	return s.Migrate(ctx, false /* dry run */)
+}
+
+// Migrate upgrades the DB to the latest schema version, resuming an interrupted migration from its
+// saved progress. In dry run mode, the pending migrations are logged and the DB is left untouched.
+// A DB of a schema version newer than the node knows of is refused.
+func (s *Store) Migrate(ctx context.Context, dryRun bool) error {
+	version, latest, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version > latest {
+		return fmt.Errorf("database schema version %d is newer than the latest version %d known to this node", version, latest)
+	}
+	if version == latest {
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"version": version,
+		"latest":  latest,
+		"dryRun":  dryRun,
+	}).Info("Migrating database schema")
+	for ; version < latest; version++ {
+		m := migrations[version]
+		cursor, err := s.Progress(ctx, migrationProgressName(m))
+		if err != nil {
+			return err
+		}
+		fields := logrus.Fields{"migration": m.name, "version": version + 1}
+		if dryRun {
+			log.WithFields(fields).WithField("resumed", cursor != nil).Info("Pending database migration")
+			continue
+		}
+		if err := s.runMigration(ctx, m, version+1, cursor); err != nil {
+			return errors.Wrapf(err, "could not apply migration %s", m.name)
+		}
+		log.WithFields(fields).Info("Applied database migration")
+	}
+	return nil
+}
+
+// This runs the steps of a migration from the cursor, then records the schema version it upgrades the
+// DB to in the transaction of its last step.
+func (s *Store) runMigration(ctx context.Context, m migration, version uint64, cursor []byte) error {
+	migrated := 0
+	for {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
-
-		if err := s.db.Update(m); err != nil {
+		done := false
+		if err := s.db.Update(func(tx backend.Tx) error {
+			next, n, err := m.step(ctx, tx, cursor)
+			if err != nil {
+				return err
+			}
+			migrated += n
+			cursor = next
+			if next != nil {
+				return tx.Bucket(progressBucket).Put([]byte(migrationProgressName(m)), next)
+			}
+			done = true
+			if err := tx.Bucket(progressBucket).Delete([]byte(migrationProgressName(m))); err != nil {
+				return err
+			}
+			return tx.Bucket(migrationsBucket).Put(schemaVersionKey, bytesutil.Uint64ToBytesBigEndian(version))
+		}); err != nil {
 			return err
 		}
+		if done {
+			return nil
+		}
+		log.WithFields(logrus.Fields{
+			"migration": m.name,
+			"migrated":  migrated,
+		}).Info("Migrating database")
 	}
-	return nil
+}
+
+func migrationProgressName(m migration) string {
+	return "migration-" + m.name
 }
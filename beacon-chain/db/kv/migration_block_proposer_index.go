@@ -0,0 +1,49 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// migrateBlockProposerIndex indexes the blocks saved before the proposer index was introduced, a
+// batch of blocks after the cursor, the root of the last indexed block, at a time. Like forEachRoot,
+// it only visits the keys of the blocks bucket which are block roots.
+func migrateBlockProposerIndex(ctx context.Context, tx backend.Tx, cursor []byte) ([]byte, int, error) {
+	c := tx.Bucket(blocksBucket).Cursor()
+	k, v := c.First()
+	if cursor != nil {
+		k, v = c.Seek(cursor)
+		if bytes.Equal(k, cursor) {
+			k, v = c.Next()
+		}
+	}
+	var last []byte
+	n := 0
+	for ; k != nil && n < migrationBatchSize; k, v = c.Next() {
+		// The bucket also holds the roots of the genesis, head and origin checkpoint blocks, under
+		// keys which are not block roots.
+		if len(k) != 32 || v == nil {
+			continue
+		}
+		block := &ethpb.SignedBeaconBlock{}
+		if err := decode(ctx, v, block); err != nil {
+			return nil, n, err
+		}
+		indicesByBucket := map[string][]byte{
+			string(blockProposerIndicesBucket): blockProposerIndex(block.Block.ProposerIndex, block.Block.Slot),
+		}
+		if err := updateValueForIndices(ctx, indicesByBucket, k, tx); err != nil {
+			return nil, n, err
+		}
+		last = bytesutil.SafeCopyBytes(k)
+		n++
+	}
+	if k == nil {
+		return nil, n, nil
+	}
+	return last, n, nil
+}
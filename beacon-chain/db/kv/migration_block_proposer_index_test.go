@@ -0,0 +1,62 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func Test_migrateBlockProposerIndex(t *testing.T) {
+	ctx := context.Background()
+	store := setupDB(t)
+	blks, roots := batchedBlocks(t, 3)
+	for _, blk := range blks {
+		blk.Block.ProposerIndex = 3
+	}
+	// The roots change with the proposer index.
+	for i, blk := range blks {
+		r, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = r
+	}
+	require.NoError(t, store.SaveBlocks(ctx, blks))
+	require.NoError(t, store.SaveGenesisBlockRoot(ctx, roots[0]))
+	require.NoError(t, store.SaveStateSummary(ctx, &pb.StateSummary{Slot: blks[2].Block.Slot, Root: roots[2][:]}))
+	require.NoError(t, store.SaveHeadBlockRoot(ctx, roots[2]))
+	// Blocks saved before the proposer index existed were not indexed.
+	require.NoError(t, store.db.Update(func(tx backend.Tx) error {
+		for _, blk := range blks {
+			if err := tx.Bucket(blockProposerIndicesBucket).Delete(blockProposerIndex(3, blk.Block.Slot)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	indexed, _, err := store.BlocksByProposer(ctx, 3, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(indexed))
+
+	var cursor []byte
+	migrated := 0
+	for steps := 0; ; steps++ {
+		require.Equal(t, true, steps <= len(blks), "Migration did not complete")
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
+			next, n, err := migrateBlockProposerIndex(ctx, tx, cursor)
+			cursor = next
+			migrated += n
+			return err
+		}))
+		if cursor == nil {
+			break
+		}
+	}
+	assert.Equal(t, len(blks), migrated)
+	indexed, indexedRoots, err := store.BlocksByProposer(ctx, 3, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, len(blks), len(indexed))
+	assert.DeepEqual(t, roots, indexedRoots)
+}
@@ -0,0 +1,85 @@
+package kv
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_Migrate(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	defer func(m []migration) {
+		migrations = m
+	}(migrations)
+
+	// The second migration writes a key per step and is interrupted after its second step.
+	var interrupt bool
+	var steps [][]byte
+	migrations = []migration{
+		{name: "first", step: singleStep(func(tx backend.Tx) error {
+			return tx.Bucket(chainMetadataBucket).Put([]byte("first"), []byte{1})
+		})},
+		{name: "second", step: func(_ context.Context, tx backend.Tx, cursor []byte) ([]byte, int, error) {
+			i := bytesutil.BytesToUint64BigEndian(cursor)
+			if interrupt && i == 2 {
+				return nil, 0, errors.New("interrupted")
+			}
+			steps = append(steps, cursor)
+			if err := tx.Bucket(chainMetadataBucket).Put(bytesutil.Uint64ToBytesBigEndian(i), []byte{1}); err != nil {
+				return nil, 0, err
+			}
+			if i == 3 {
+				return nil, 1, nil
+			}
+			return bytesutil.Uint64ToBytesBigEndian(i + 1), 1, nil
+		}},
+	}
+
+	current, latest, err := db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), current)
+	assert.Equal(t, uint64(2), latest)
+
+	require.NoError(t, db.Migrate(ctx, true /* dry run */))
+	current, _, err = db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), current)
+	assert.Equal(t, 0, len(steps))
+
+	interrupt = true
+	assert.ErrorContains(t, "interrupted", db.RunMigrations(ctx))
+	current, _, err = db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), current)
+	cursor, err := db.Progress(ctx, "migration-second")
+	require.NoError(t, err)
+	assert.DeepEqual(t, bytesutil.Uint64ToBytesBigEndian(2), cursor)
+
+	// The migration resumes from its saved progress.
+	interrupt = false
+	steps = nil
+	require.NoError(t, db.RunMigrations(ctx))
+	require.Equal(t, 2, len(steps))
+	assert.DeepEqual(t, bytesutil.Uint64ToBytesBigEndian(2), steps[0])
+	current, _, err = db.SchemaVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), current)
+	cursor, err = db.Progress(ctx, "migration-second")
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(cursor))
+
+	// Applied migrations are not run again.
+	steps = nil
+	require.NoError(t, db.RunMigrations(ctx))
+	assert.Equal(t, 0, len(steps))
+
+	// A DB migrated by a newer node is refused.
+	migrations = migrations[:1]
+	assert.ErrorContains(t, "database schema version 2 is newer than the latest version 1", db.RunMigrations(ctx))
+}
@@ -0,0 +1,59 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveOperationPool saves the encoded pending operations of the named operation pool to be
+// restored on restart. Any operations previously saved for the pool are replaced.
+func (s *Store) SaveOperationPool(ctx context.Context, name string, ops [][]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOperationPool")
+	defer span.End()
+
+	err := s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(operationPoolsBucket)
+		if bkt.Bucket([]byte(name)) != nil {
+			if err := bkt.DeleteBucket([]byte(name)); err != nil {
+				return err
+			}
+		}
+		poolBkt, err := bkt.CreateBucket([]byte(name))
+		if err != nil {
+			return err
+		}
+		for i, op := range ops {
+			if err := poolBkt.Put(bytesutil.Uint64ToBytesBigEndian(uint64(i)), op); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// OperationPool retrieves the saved encoded operations of the named operation pool, in the
+// order they were saved.
+func (s *Store) OperationPool(ctx context.Context, name string) ([][]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OperationPool")
+	defer span.End()
+
+	var ops [][]byte
+	err := s.db.View(func(tx backend.Tx) error {
+		poolBkt := tx.Bucket(operationPoolsBucket).Bucket([]byte(name))
+		if poolBkt == nil {
+			return nil
+		}
+		return poolBkt.ForEach(func(_, v []byte) error {
+			ops = append(ops, bytesutil.SafeCopyBytes(v))
+			return nil
+		})
+	})
+	traceutil.AnnotateError(span, err)
+	return ops, err
+}
@@ -0,0 +1,34 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_OperationPool(t *testing.T) {
+	ctx := context.Background()
+	store := setupDB(t)
+
+	ops, err := store.OperationPool(ctx, "voluntary_exits")
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(ops))
+
+	want := [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+	require.NoError(t, store.SaveOperationPool(ctx, "voluntary_exits", want))
+	ops, err = store.OperationPool(ctx, "voluntary_exits")
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, ops)
+
+	// Saving a pool replaces its previous contents, and leaves other pools untouched.
+	require.NoError(t, store.SaveOperationPool(ctx, "proposer_slashings", [][]byte{[]byte("d")}))
+	require.NoError(t, store.SaveOperationPool(ctx, "voluntary_exits", want[:1]))
+	ops, err = store.OperationPool(ctx, "voluntary_exits")
+	require.NoError(t, err)
+	assert.DeepEqual(t, want[:1], ops)
+	ops, err = store.OperationPool(ctx, "proposer_slashings")
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{[]byte("d")}, ops)
+}
@@ -0,0 +1,51 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// Progress retrieves the saved progress cursor of the named long-running background operation, such
+// as backfilling or pruning, to resume it on restart. It returns nil if no progress was saved.
+func (s *Store) Progress(ctx context.Context, name string) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Progress")
+	defer span.End()
+
+	var cursor []byte
+	err := s.db.View(func(tx backend.Tx) error {
+		cursor = bytesutil.SafeCopyBytes(tx.Bucket(progressBucket).Get([]byte(name)))
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return cursor, err
+}
+
+// SaveProgress saves the progress cursor of the named long-running background operation, replacing
+// the cursor previously saved for it.
+func (s *Store) SaveProgress(ctx context.Context, name string, cursor []byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveProgress")
+	defer span.End()
+
+	err := s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(progressBucket).Put([]byte(name), cursor)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// DeleteProgress deletes the progress cursor of the named long-running background operation, once
+// the operation is over.
+func (s *Store) DeleteProgress(ctx context.Context, name string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteProgress")
+	defer span.End()
+
+	err := s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(progressBucket).Delete([]byte(name))
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
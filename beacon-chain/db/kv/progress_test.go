@@ -0,0 +1,34 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_Progress(t *testing.T) {
+	ctx := context.Background()
+	store := setupDB(t)
+
+	cursor, err := store.Progress(ctx, "pruning")
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte(nil), cursor)
+
+	// Saving progress replaces the previous cursor, and leaves other operations untouched.
+	require.NoError(t, store.SaveProgress(ctx, "pruning", []byte("a")))
+	require.NoError(t, store.SaveProgress(ctx, "archive", []byte("b")))
+	require.NoError(t, store.SaveProgress(ctx, "pruning", []byte("c")))
+	cursor, err = store.Progress(ctx, "pruning")
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("c"), cursor)
+	cursor, err = store.Progress(ctx, "archive")
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("b"), cursor)
+
+	require.NoError(t, store.DeleteProgress(ctx, "pruning"))
+	cursor, err = store.Progress(ctx, "pruning")
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte(nil), cursor)
+}
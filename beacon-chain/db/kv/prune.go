@@ -0,0 +1,247 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// pruneBatchSize is the number of blocks or states deleted per transaction while pruning, so that
+// the database is not locked for the whole pruning.
+const pruneBatchSize = 256
+
+// PruneFinalized deletes the history before the finalized checkpoint which is not needed to
+// regenerate the canonical states. These are the states which are not at an archived point, that is
+// neither at a slot multiple of the slots per archived point nor of the last canonical block at or
+// before such a slot, and the blocks which are not in the finalized block roots index as they are
+// not part of the canonical chain, along with their states and state summaries.
+// The genesis, finalized, origin checkpoint and head blocks and states are always kept. With BoltDB,
+// the pages of the deleted data are freed for reuse by the database and reported as reclaimed, the
+// database file itself only shrinks once compacted. LevelDB reclaims the space of deleted data in
+// its background compactions, so nothing is reported as reclaimed.
+func (s *Store) PruneFinalized(ctx context.Context, slotsPerArchivedPoint types.Slot) (*iface.PruneStats, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneFinalized")
+	defer span.End()
+
+	if slotsPerArchivedPoint == 0 {
+		return nil, errors.New("slots per archived point must be greater than 0")
+	}
+	f, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	finalizedSlot, err := helpers.StartSlot(f.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	stats := &iface.PruneStats{}
+	if finalizedSlot == 0 {
+		return stats, nil
+	}
+	// The blocks and state summaries of orphaned blocks may still be batched or cached, they are written
+	// first so that they get deleted along with the rest.
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.saveCachedStateSummariesDB(ctx); err != nil {
+		return nil, err
+	}
+	freeBefore := s.freeBytes()
+
+	var orphanedRoots, stateRoots [][32]byte
+	var stateSlots []types.Slot
+	err = s.db.View(func(tx backend.Tx) error {
+		blocks := tx.Bucket(blocksBucket)
+		kept := make(map[[32]byte]bool)
+		for _, k := range [][]byte{genesisBlockRootKey, headBlockRootKey, originCheckpointBlockRootKey} {
+			if r := blocks.Get(k); r != nil {
+				kept[bytesutil.ToBytes32(r)] = true
+			}
+		}
+		kept[bytesutil.ToBytes32(f.Root)] = true
+
+		// Without the finalized block roots index, every finalized block would be seen as orphaned.
+		finalizedIdx := tx.Bucket(finalizedBlockRootsIndexBucket)
+		if !bytes.Equal(f.Root, blocks.Get(genesisBlockRootKey)) && finalizedIdx.Get(f.Root) == nil {
+			return errors.New("finalized block roots are not indexed")
+		}
+		isOrphaned := make(map[[32]byte]bool)
+		// The state of an archived point is saved with the root of the last canonical block at or
+		// before its slot, whose slot is before the archived point after skipped slots.
+		archivedPoint := slotsPerArchivedPoint
+		var lastCanonicalRoot [32]byte
+		if err := forEachRootBelow(ctx, tx.Bucket(blockSlotIndicesBucket), finalizedSlot, func(slot types.Slot, r [32]byte) {
+			container := finalizedIdx.Get(r[:])
+			if kept[r] || (container != nil && !bytes.Equal(container, containerFinalizedButNotCanonical)) {
+				for ; archivedPoint < slot; archivedPoint += slotsPerArchivedPoint {
+					kept[lastCanonicalRoot] = true
+				}
+				lastCanonicalRoot = r
+				return
+			}
+			orphanedRoots = append(orphanedRoots, r)
+			isOrphaned[r] = true
+		}); err != nil {
+			return err
+		}
+		for ; archivedPoint < finalizedSlot; archivedPoint += slotsPerArchivedPoint {
+			kept[lastCanonicalRoot] = true
+		}
+		return forEachRootBelow(ctx, tx.Bucket(stateSlotIndicesBucket), finalizedSlot, func(slot types.Slot, r [32]byte) {
+			// The states of orphaned blocks are deleted with their blocks.
+			if kept[r] || isOrphaned[r] || slot%slotsPerArchivedPoint == 0 {
+				return
+			}
+			stateSlots = append(stateSlots, slot)
+			stateRoots = append(stateRoots, r)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(stateRoots); i += pruneBatchSize {
+		end := i + pruneBatchSize
+		if end > len(stateRoots) {
+			end = len(stateRoots)
+		}
+		deleted, err := s.pruneStates(ctx, stateSlots[i:end], stateRoots[i:end])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not delete finalized states")
+		}
+		stats.DeletedStates += deleted
+	}
+	for i := 0; i < len(orphanedRoots); i += pruneBatchSize {
+		end := i + pruneBatchSize
+		if end > len(orphanedRoots) {
+			end = len(orphanedRoots)
+		}
+		deletedBlocks, deletedStates, err := s.pruneBlocks(ctx, orphanedRoots[i:end])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not delete orphaned blocks")
+		}
+		stats.DeletedBlocks += deletedBlocks
+		stats.DeletedStates += deletedStates
+	}
+
+	if freeAfter := s.freeBytes(); freeAfter > freeBefore {
+		stats.ReclaimedBytes = uint64(freeAfter - freeBefore)
+	}
+	log.WithFields(logrus.Fields{
+		"deletedStates":  stats.DeletedStates,
+		"deletedBlocks":  stats.DeletedBlocks,
+		"reclaimedBytes": stats.ReclaimedBytes,
+	}).Info("Pruned finalized history")
+	return stats, nil
+}
+
+// This returns the size of the free pages of the database, which is only known for BoltDB.
+func (s *Store) freeBytes() int {
+	if boltDB, ok := s.db.(*backend.BoltDB); ok {
+		return boltDB.DB().Stats().FreeAlloc
+	}
+	return 0
+}
+
+// This calls f with the slot and each root of the given slot indices bucket, for slots below the
+// given slot.
+func forEachRootBelow(ctx context.Context, bkt backend.Bucket, below types.Slot, f func(slot types.Slot, r [32]byte)) error {
+	c := bkt.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		if slot >= below {
+			break
+		}
+		for i := 0; i+32 <= len(v); i += 32 {
+			f(slot, bytesutil.ToBytes32(v[i:i+32]))
+		}
+	}
+	return nil
+}
+
+// This deletes the states of the given block roots at the given slots along with their slot
+// indices, and returns the number of deleted states.
+func (s *Store) pruneStates(ctx context.Context, slots []types.Slot, blockRoots [][32]byte) (uint64, error) {
+	var deleted uint64
+	err := s.db.Update(func(tx backend.Tx) error {
+		for i, blockRoot := range blockRoots {
+			ok, err := deleteState(ctx, tx, slots[i], blockRoot)
+			if err != nil {
+				return err
+			}
+			if ok {
+				deleted++
+			}
+		}
+		return nil
+	})
+	return deleted, err
+}
+
+// This deletes the given blocks along with their indices, states and state summaries, and returns
+// the number of deleted blocks and states.
+func (s *Store) pruneBlocks(ctx context.Context, blockRoots [][32]byte) (uint64, uint64, error) {
+	var deletedBlocks, deletedStates uint64
+	err := s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		summaries := tx.Bucket(stateSummaryBucket)
+		for _, blockRoot := range blockRoots {
+			enc := bkt.Get(blockRoot[:])
+			if enc == nil {
+				continue
+			}
+			block := &ethpb.SignedBeaconBlock{}
+			if err := decode(ctx, enc, block); err != nil {
+				return err
+			}
+			ok, err := deleteState(ctx, tx, block.Block.Slot, blockRoot)
+			if err != nil {
+				return err
+			}
+			if ok {
+				deletedStates++
+			}
+			indicesByBucket := createBlockIndicesFromBlock(ctx, block.Block)
+			if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+				return errors.Wrap(err, "could not delete root for DB indices")
+			}
+			if err := bkt.Delete(blockRoot[:]); err != nil {
+				return err
+			}
+			if err := summaries.Delete(blockRoot[:]); err != nil {
+				return err
+			}
+			s.blockCache.Del(string(blockRoot[:]))
+			s.stateSummaryCache.delete(blockRoot)
+			deletedBlocks++
+		}
+		return nil
+	})
+	return deletedBlocks, deletedStates, err
+}
+
+// This deletes the state of the given block root, indexed at the given slot, and returns whether the
+// state existed.
+func deleteState(ctx context.Context, tx backend.Tx, slot types.Slot, blockRoot [32]byte) (bool, error) {
+	bkt := tx.Bucket(stateBucket)
+	if bkt.Get(blockRoot[:]) == nil {
+		return false, nil
+	}
+	indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
+	if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+		return false, errors.Wrap(err, "could not delete root for DB indices")
+	}
+	return true, bkt.Delete(blockRoot[:])
+}
@@ -0,0 +1,167 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// This saves a chain of blocks from genesis up to the given slot, skipping the given slot, along with
+// a state for every block, and a block forking off the chain at slot 5 with its state. The chain is
+// finalized at epoch 2.
+func savePrunableChain(t *testing.T, db *Store, lastSlot, skippedSlot types.Slot) (map[types.Slot][32]byte, [32]byte) {
+	ctx := context.Background()
+	roots := make(map[types.Slot][32]byte)
+	var parentRoot [32]byte
+	for slot := types.Slot(0); slot <= lastSlot; slot++ {
+		if slot == skippedSlot {
+			continue
+		}
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = append([]byte{}, parentRoot[:]...)
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, b))
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, db.SaveState(ctx, st, r))
+		roots[slot] = r
+		parentRoot = r
+	}
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, roots[0]))
+
+	orphan := testutil.NewBeaconBlock()
+	orphan.Block.Slot = 5
+	orphan.Block.ProposerIndex = 1
+	parentRoot = roots[4]
+	orphan.Block.ParentRoot = parentRoot[:]
+	orphanRoot, err := orphan.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, orphan))
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(5))
+	require.NoError(t, db.SaveState(ctx, st, orphanRoot))
+
+	fSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	fRoot := roots[fSlot]
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: fRoot[:]}))
+	return roots, orphanRoot
+}
+
+func TestStore_PruneFinalized(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	fSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	roots, orphanRoot := savePrunableChain(t, db, fSlot+10, 16)
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 5, Root: orphanRoot[:]}))
+
+	stats, err := db.PruneFinalized(ctx, 8)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), stats.DeletedBlocks)
+
+	assert.Equal(t, false, db.HasBlock(ctx, orphanRoot))
+	assert.Equal(t, false, db.HasState(ctx, orphanRoot))
+	assert.Equal(t, false, db.HasStateSummary(ctx, orphanRoot))
+	var deletedStates uint64 = 1
+	for slot, r := range roots {
+		assert.Equal(t, true, db.HasBlock(ctx, r), "Missing canonical block of slot %d", slot)
+		// The state of slot 15 is the state of the archived point 16, whose slot is skipped.
+		kept := slot >= fSlot || slot%8 == 0 || slot == 15
+		assert.Equal(t, kept, db.HasState(ctx, r), "Unexpected state of slot %d", slot)
+		if !kept {
+			deletedStates++
+		}
+	}
+	assert.Equal(t, deletedStates, stats.DeletedStates)
+	_, blks, err := db.BlocksBySlot(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(blks))
+
+	// Pruning again has nothing left to delete.
+	stats, err = db.PruneFinalized(ctx, 8)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), stats.DeletedBlocks)
+	assert.Equal(t, uint64(0), stats.DeletedStates)
+}
+
+func TestStore_PruneFinalized_NothingFinalized(t *testing.T) {
+	db := setupDB(t)
+	stats, err := db.PruneFinalized(context.Background(), 8)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &iface.PruneStats{}, stats)
+
+	_, err = db.PruneFinalized(context.Background(), 0)
+	assert.ErrorContains(t, "slots per archived point must be greater than 0", err)
+}
+
+func TestCompactDatabase(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	roots, _ := savePrunableChain(t, db, 2*params.BeaconConfig().SlotsPerEpoch+10, 16)
+	_, err = db.PruneFinalized(ctx, 8)
+	require.NoError(t, err)
+	// The operation pools are saved in nested buckets.
+	ops := [][]byte{[]byte("exit"), []byte("slashing")}
+	require.NoError(t, db.SaveOperationPool(ctx, "pool", ops))
+	require.NoError(t, db.Close())
+
+	reclaimed, err := CompactDatabase(ctx, dir, backend.BoltBackend)
+	require.NoError(t, err)
+	assert.Equal(t, true, reclaimed > 0, "Expected the database to shrink")
+
+	db, err = NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	for slot, r := range roots {
+		assert.Equal(t, true, db.HasBlock(ctx, r), "Missing canonical block of slot %d", slot)
+	}
+	assert.Equal(t, true, db.HasState(ctx, roots[8]))
+	assert.Equal(t, false, db.HasState(ctx, roots[9]))
+	saved, err := db.OperationPool(ctx, "pool")
+	require.NoError(t, err)
+	assert.DeepEqual(t, ops, saved)
+}
+
+func TestCompactDatabase_LevelDB(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{Backend: backend.LevelDBBackend})
+	require.NoError(t, err)
+	roots, _ := savePrunableChain(t, db, 2*params.BeaconConfig().SlotsPerEpoch+10, 16)
+	_, err = db.PruneFinalized(ctx, 8)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// The database of one backend is not compacted as the other.
+	_, err = CompactDatabase(ctx, dir, backend.BoltBackend)
+	require.ErrorContains(t, "no such file or directory", err)
+	_, err = CompactDatabase(ctx, dir, "unknown")
+	require.ErrorContains(t, "unknown database backend", err)
+	_, err = CompactDatabase(ctx, dir, backend.LevelDBBackend)
+	require.NoError(t, err)
+
+	db, err = NewKVStore(ctx, dir, &Config{Backend: backend.LevelDBBackend})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	for slot, r := range roots {
+		assert.Equal(t, true, db.HasBlock(ctx, r), "Missing canonical block of slot %d", slot)
+	}
+}
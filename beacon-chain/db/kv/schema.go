@@ -17,6 +17,9 @@ var (
 	chainMetadataBucket     = []byte("chain-metadata")
 	checkpointBucket        = []byte("check-point")
 	powchainBucket          = []byte("powchain")
+	invalidBlocksBucket     = []byte("invalid-blocks")
+	operationPoolsBucket    = []byte("operation-pools")
+	progressBucket          = []byte("progress")
 
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
@@ -26,6 +29,7 @@ var (
 	// Key indices buckets.
 	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
 	blockSlotIndicesBucket              = []byte("block-slot-indices")
+	blockProposerIndicesBucket          = []byte("block-proposer-indices")
 	stateSlotIndicesBucket              = []byte("state-slot-indices")
 	attestationHeadBlockRootBucket      = []byte("attestation-head-block-root-indices")
 	attestationSourceRootIndicesBucket  = []byte("attestation-source-root-indices")
@@ -33,14 +37,21 @@ var (
 	attestationTargetRootIndicesBucket  = []byte("attestation-target-root-indices")
 	attestationTargetEpochIndicesBucket = []byte("attestation-target-epoch-indices")
 	finalizedBlockRootsIndexBucket      = []byte("finalized-block-roots-index")
+	attestationInclusionIndexBucket     = []byte("attestation-inclusion-index")
+	epochReportsBucket                  = []byte("epoch-reports")
 
 	// Specific item keys.
-	headBlockRootKey          = []byte("head-root")
-	genesisBlockRootKey       = []byte("genesis-root")
-	depositContractAddressKey = []byte("deposit-contract")
-	justifiedCheckpointKey    = []byte("justified-checkpoint")
-	finalizedCheckpointKey    = []byte("finalized-checkpoint")
-	powchainDataKey           = []byte("powchain-data")
+	headBlockRootKey             = []byte("head-root")
+	genesisBlockRootKey          = []byte("genesis-root")
+	depositContractAddressKey    = []byte("deposit-contract")
+	justifiedCheckpointKey       = []byte("justified-checkpoint")
+	finalizedCheckpointKey       = []byte("finalized-checkpoint")
+	powchainDataKey              = []byte("powchain-data")
+	committeeCacheKey            = []byte("committee-cache")
+	originCheckpointBlockRootKey = []byte("origin-checkpoint-root")
+
+	// Progress cursor names of background operations.
+	backfillProgressName = "backfill"
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
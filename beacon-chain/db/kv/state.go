@@ -8,6 +8,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/genesis"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
@@ -15,7 +16,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -24,7 +24,6 @@ import (
 func (s *Store) State(ctx context.Context, blockRoot [32]byte) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.State")
 	defer span.End()
-	var st *pb.BeaconState
 	enc, err := s.stateBytes(ctx, blockRoot)
 	if err != nil {
 		return nil, err
@@ -34,11 +33,7 @@ func (s *Store) State(ctx context.Context, blockRoot [32]byte) (iface.BeaconStat
 		return nil, nil
 	}
 
-	st, err = createState(ctx, enc)
-	if err != nil {
-		return nil, err
-	}
-	return stateV0.InitializeFromProtoUnsafe(st)
+	return createState(ctx, enc)
 }
 
 // GenesisState returns the genesis state in beacon chain.
@@ -56,8 +51,8 @@ func (s *Store) GenesisState(ctx context.Context) (iface.BeaconState, error) {
 		return cached, nil
 	}
 
-	var st *pb.BeaconState
-	err = s.db.View(func(tx *bolt.Tx) error {
+	var st iface.BeaconState
+	err = s.db.View(func(tx backend.Tx) error {
 		// Retrieve genesis block's signing root from blocks bucket,
 		// to look up what the genesis state is.
 		bucket := tx.Bucket(blocksBucket)
@@ -76,10 +71,7 @@ func (s *Store) GenesisState(ctx context.Context) (iface.BeaconState, error) {
 	if err != nil {
 		return nil, err
 	}
-	if st == nil {
-		return nil, nil
-	}
-	return stateV0.InitializeFromProtoUnsafe(st)
+	return st, nil
 }
 
 // SaveState stores a state to the db using block's signing root which was used to generate the state.
@@ -97,19 +89,20 @@ func (s *Store) SaveStates(ctx context.Context, states []iface.ReadOnlyBeaconSta
 	if states == nil {
 		return errors.New("nil state")
 	}
+	// The states may be of batched blocks, whose journal must be on disk before the states are.
+	if err := s.syncWriteBatch(); err != nil {
+		return errors.Wrap(err, "could not sync write batch journal")
+	}
 	multipleEncs := make([][]byte, len(states))
 	for i, st := range states {
-		pbState, err := stateV0.ProtobufBeaconState(st.InnerStateUnsafe())
-		if err != nil {
-			return err
-		}
-		multipleEncs[i], err = encode(ctx, pbState)
+		var err error
+		multipleEncs[i], err = st.MarshalSSZSnappy()
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bucket := tx.Bucket(stateBucket)
 		for i, rt := range blockRoots {
 			indicesByBucket := createStateIndicesFromStateSlot(ctx, states[i].Slot())
@@ -140,7 +133,7 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteState")
 	defer span.End()
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bkt := tx.Bucket(blocksBucket)
 		genesisBlockRoot := bkt.Get(genesisBlockRootKey)
 
@@ -188,13 +181,17 @@ func (s *Store) DeleteStates(ctx context.Context, blockRoots [][32]byte) error {
 	return nil
 }
 
-// creates state from marshaled proto state bytes.
-func createState(ctx context.Context, enc []byte) (*pb.BeaconState, error) {
+// creates state from marshaled state bytes. States are stored as snappy framed SSZ, states
+// saved before that are snappy block compressed and are still decoded as such.
+func createState(ctx context.Context, enc []byte) (iface.BeaconState, error) {
+	if stateV0.IsSSZSnappy(enc) {
+		return stateV0.InitializeFromSSZSnappy(enc)
+	}
 	protoState := &pb.BeaconState{}
 	if err := decode(ctx, enc, protoState); err != nil {
 		return nil, errors.Wrap(err, "failed to unmarshal encoding")
 	}
-	return protoState, nil
+	return stateV0.InitializeFromProtoUnsafe(protoState)
 }
 
 // HasState checks if a state by root exists in the db.
@@ -202,7 +199,7 @@ func (s *Store) stateBytes(ctx context.Context, blockRoot [32]byte) ([]byte, err
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.stateBytes")
 	defer span.End()
 	var dst []byte
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(stateBucket)
 		dst = bkt.Get(blockRoot[:])
 		return nil
@@ -211,7 +208,7 @@ func (s *Store) stateBytes(ctx context.Context, blockRoot [32]byte) ([]byte, err
 }
 
 // slotByBlockRoot retrieves the corresponding slot of the input block root.
-func slotByBlockRoot(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (types.Slot, error) {
+func slotByBlockRoot(ctx context.Context, tx backend.Tx, blockRoot []byte) (types.Slot, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.slotByBlockRoot")
 	defer span.End()
 
@@ -237,7 +234,7 @@ func slotByBlockRoot(ctx context.Context, tx *bolt.Tx, blockRoot []byte) (types.
 			if s == nil {
 				return 0, errors.New("state can't be nil")
 			}
-			return s.Slot, nil
+			return s.Slot(), nil
 		}
 		b := &ethpb.SignedBeaconBlock{}
 		err := decode(ctx, enc, b)
@@ -265,7 +262,7 @@ func (s *Store) HighestSlotStatesBelow(ctx context.Context, slot types.Slot) ([]
 	defer span.End()
 
 	var best []byte
-	if err := s.db.View(func(tx *bolt.Tx) error {
+	if err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(stateSlotIndicesBucket)
 		c := bkt.Cursor()
 		for s, root := c.First(); s != nil; s, root = c.Next() {
@@ -348,7 +345,7 @@ func (s *Store) CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint ty
 	}
 	deletedRoots := make([][32]byte, 0)
 
-	err = s.db.View(func(tx *bolt.Tx) error {
+	err = s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(stateSlotIndicesBucket)
 		return bkt.ForEach(func(k, v []byte) error {
 			if ctx.Err() != nil {
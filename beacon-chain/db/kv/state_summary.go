@@ -3,9 +3,10 @@ package kv
 import (
 	"context"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -22,6 +23,11 @@ func (s *Store) SaveStateSummaries(ctx context.Context, summaries []*pb.StateSum
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateSummaries")
 	defer span.End()
 
+	// The summaries may be of batched blocks, whose journal must be on disk before the summaries are.
+	if err := s.syncWriteBatch(); err != nil {
+		return errors.Wrap(err, "could not sync write batch journal")
+	}
+
 	// When we reach the state summary cache prune count,
 	// dump the cached state summaries to the DB.
 	if s.stateSummaryCache.len() >= stateSummaryCachePruneCount {
@@ -81,7 +87,7 @@ func (s *Store) stateSummaryBytes(ctx context.Context, blockRoot [32]byte) ([]by
 	defer span.End()
 
 	var enc []byte
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bucket := tx.Bucket(stateSummaryBucket)
 		enc = bucket.Get(blockRoot[:])
 		return nil
@@ -101,7 +107,7 @@ func (s *Store) saveCachedStateSummariesDB(ctx context.Context) error {
 		}
 		encs[i] = enc
 	}
-	if err := s.db.Update(func(tx *bolt.Tx) error {
+	if err := s.db.Update(func(tx backend.Tx) error {
 		bucket := tx.Bucket(stateSummaryBucket)
 		for i, s := range summaries {
 			if err := bucket.Put(s.Root, encs[i]); err != nil {
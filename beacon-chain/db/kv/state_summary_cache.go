@@ -46,6 +46,14 @@ func (c *stateSummaryCache) get(r [32]byte) *pb.StateSummary {
 	return b
 }
 
+// delete removes a state summary from the initial sync state summaries cache using the root of the
+// block.
+func (c *stateSummaryCache) delete(r [32]byte) {
+	c.initSyncStateSummariesLock.Lock()
+	defer c.initSyncStateSummariesLock.Unlock()
+	delete(c.initSyncStateSummaries, r)
+}
+
 // len retrieves the state summary count from the state summaries cache.
 func (c *stateSummaryCache) len() int {
 	c.initSyncStateSummariesLock.RLock()
@@ -7,7 +7,9 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -43,6 +45,29 @@ func TestState_CanSaveRetrieve(t *testing.T) {
 	assert.Equal(t, iface.ReadOnlyBeaconState(nil), savedS, "Unsaved state should've been nil")
 }
 
+func TestState_CanRetrieveLegacyEncoding(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	r := [32]byte{'A'}
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(100))
+
+	// States saved before the snappy framed encoding are snappy block compressed.
+	pbState, err := stateV0.ProtobufBeaconState(st.InnerStateUnsafe())
+	require.NoError(t, err)
+	enc, err := encode(ctx, pbState)
+	require.NoError(t, err)
+	require.NoError(t, db.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(stateBucket).Put(r[:], enc)
+	}))
+
+	savedS, err := db.State(ctx, r)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st.InnerStateUnsafe(), savedS.InnerStateUnsafe(), "Did not retrieve saved state")
+}
+
 func TestGenesisState_CanSaveRetrieve(t *testing.T) {
 	db := setupDB(t)
 
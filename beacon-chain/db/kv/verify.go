@@ -0,0 +1,218 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// IntegrityReport describes the result of an integrity check of the database. Issues lists the
+// problems found, a dangling index entry refers to a block or state missing from the database.
+type IntegrityReport struct {
+	Blocks               int
+	States               int
+	StateSummaries       int
+	Issues               []string
+	DanglingIndexEntries int
+	RepairedIndexEntries int
+}
+
+// This is an index entry referring to a root which is missing from the database. The key is deleted
+// from the bucket if it has no root, otherwise the root is removed from the roots at the key.
+type danglingEntry struct {
+	bucket []byte
+	key    []byte
+	root   []byte
+}
+
+// VerifyIntegrity walks the blocks, states and index buckets of the database, checking that blocks
+// and states decode, that the parent of each block is known, and that the indices, state summaries
+// and checkpoints only refer to saved blocks and states. The dangling index entries are deleted if
+// repair is set.
+func (s *Store) VerifyIntegrity(ctx context.Context, repair bool) (*IntegrityReport, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.VerifyIntegrity")
+	defer span.End()
+
+	if err := s.flushWriteBatch(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.saveCachedStateSummariesDB(ctx); err != nil {
+		return nil, err
+	}
+	report := &IntegrityReport{}
+	var dangling []*danglingEntry
+	err := s.db.View(func(tx backend.Tx) error {
+		blocks := tx.Bucket(blocksBucket)
+		states := tx.Bucket(stateBucket)
+		issue := func(format string, args ...interface{}) {
+			report.Issues = append(report.Issues, fmt.Sprintf(format, args...))
+		}
+
+		// Blocks preceding these blocks are not expected to be in the database.
+		roots := map[[32]byte]bool{
+			bytesutil.ToBytes32(blocks.Get(genesisBlockRootKey)): true,
+			backfillBlockRoot(tx):                                true,
+		}
+		parents := make(map[[32]byte][32]byte)
+		if err := forEachRoot(ctx, blocks, func(k, v []byte) error {
+			report.Blocks++
+			blk := &ethpb.SignedBeaconBlock{}
+			if err := decode(ctx, v, blk); err != nil || blk.Block == nil {
+				issue("block %#x can't be decoded: %v", k, err)
+				return nil
+			}
+			parents[bytesutil.ToBytes32(k)] = bytesutil.ToBytes32(blk.Block.ParentRoot)
+			return nil
+		}); err != nil {
+			return err
+		}
+		for r, parent := range parents {
+			if !roots[r] && blocks.Get(parent[:]) == nil {
+				issue("parent %#x of block %#x is missing", parent, r)
+			}
+		}
+
+		if err := forEachRoot(ctx, states, func(k, v []byte) error {
+			report.States++
+			if _, err := createState(ctx, v); err != nil {
+				issue("state of block %#x can't be decoded: %v", k, err)
+				return nil
+			}
+			if blocks.Get(k) == nil {
+				issue("block %#x of state is missing", k)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := forEachRoot(ctx, tx.Bucket(stateSummaryBucket), func(k, v []byte) error {
+			report.StateSummaries++
+			summary := &pb.StateSummary{}
+			if err := decode(ctx, v, summary); err != nil {
+				issue("state summary of block %#x can't be decoded: %v", k, err)
+				return nil
+			}
+			if blocks.Get(k) == nil && states.Get(k) == nil {
+				dangling = append(dangling, &danglingEntry{bucket: stateSummaryBucket, key: bytesutil.SafeCopyBytes(k)})
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, idx := range []struct {
+			bucket  []byte
+			entries backend.Bucket
+		}{
+			{blockSlotIndicesBucket, blocks},
+			{blockParentRootIndicesBucket, blocks},
+			{blockProposerIndicesBucket, blocks},
+			{stateSlotIndicesBucket, states},
+		} {
+			bucket := idx.bucket
+			c := tx.Bucket(bucket).Cursor()
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if len(v)%32 != 0 {
+					issue("malformed %s entry %#x", bucket, k)
+					continue
+				}
+				for i := 0; i < len(v); i += 32 {
+					if idx.entries.Get(v[i:i+32]) == nil {
+						dangling = append(dangling, &danglingEntry{
+							bucket: bucket,
+							key:    bytesutil.SafeCopyBytes(k),
+							root:   bytesutil.SafeCopyBytes(v[i : i+32]),
+						})
+					}
+				}
+			}
+		}
+		if err := forEachRoot(ctx, tx.Bucket(finalizedBlockRootsIndexBucket), func(k, v []byte) error {
+			if blocks.Get(k) == nil {
+				dangling = append(dangling, &danglingEntry{bucket: finalizedBlockRootsIndexBucket, key: bytesutil.SafeCopyBytes(k)})
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, key := range [][]byte{justifiedCheckpointKey, finalizedCheckpointKey} {
+			enc := tx.Bucket(checkpointBucket).Get(key)
+			if enc == nil {
+				continue
+			}
+			cp := &ethpb.Checkpoint{}
+			if err := decode(ctx, enc, cp); err != nil {
+				issue("%s can't be decoded: %v", key, err)
+				continue
+			}
+			if blocks.Get(cp.Root) == nil && bytesutil.ToBytes32(cp.Root) != params.BeaconConfig().ZeroHash {
+				issue("block %#x of %s is missing", cp.Root, key)
+			}
+		}
+		if r := blocks.Get(headBlockRootKey); r != nil && blocks.Get(r) == nil {
+			issue("head block %#x is missing", r)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report.DanglingIndexEntries = len(dangling)
+	for _, e := range dangling {
+		if e.root == nil {
+			report.Issues = append(report.Issues, fmt.Sprintf("%s entry %#x refers to a missing block", e.bucket, e.key))
+		} else {
+			report.Issues = append(report.Issues, fmt.Sprintf("%s entry %#x refers to missing %#x", e.bucket, e.key, e.root))
+		}
+	}
+	if !repair || len(dangling) == 0 {
+		return report, nil
+	}
+	if err := s.db.Update(func(tx backend.Tx) error {
+		for _, e := range dangling {
+			if e.root == nil {
+				if err := tx.Bucket(e.bucket).Delete(e.key); err != nil {
+					return err
+				}
+				continue
+			}
+			indicesByBucket := map[string][]byte{string(e.bucket): e.key}
+			if err := deleteValueForIndices(ctx, indicesByBucket, e.root, tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	report.RepairedIndexEntries = len(dangling)
+	return report, nil
+}
+
+// This calls f with the entries of the bucket whose keys are roots, skipping the other keys.
+func forEachRoot(ctx context.Context, bkt backend.Bucket, f func(k, v []byte) error) error {
+	c := bkt.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if len(k) != 32 || v == nil {
+			continue
+		}
+		if err := f(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_VerifyIntegrity(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	roots, _ := savePrunableChain(t, db, 2*params.BeaconConfig().SlotsPerEpoch+10, 16)
+
+	report, err := db.VerifyIntegrity(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(report.Issues), "Unexpected issues: %v", report.Issues)
+	// The chain has a skipped slot and an orphaned block.
+	assert.Equal(t, len(roots)+1, report.Blocks)
+	assert.Equal(t, len(roots)+1, report.States)
+
+	// Delete a block and its state without updating the indices.
+	r := roots[10]
+	require.NoError(t, db.db.Update(func(tx backend.Tx) error {
+		if err := tx.Bucket(blocksBucket).Delete(r[:]); err != nil {
+			return err
+		}
+		return tx.Bucket(stateBucket).Delete(r[:])
+	}))
+	db.blockCache.Del(string(r[:]))
+
+	report, err = db.VerifyIntegrity(ctx, false)
+	require.NoError(t, err)
+	// The slot, parent root, proposer, state slot and finalized indices refer to the block and its state.
+	assert.Equal(t, 5, report.DanglingIndexEntries)
+	assert.Equal(t, 0, report.RepairedIndexEntries)
+	// The parent of the block of slot 11 is missing.
+	assert.Equal(t, 6, len(report.Issues), "Unexpected issues: %v", report.Issues)
+
+	report, err = db.VerifyIntegrity(ctx, true)
+	require.NoError(t, err)
+	assert.Equal(t, 5, report.RepairedIndexEntries)
+	exists, _, err := db.BlockRootsBySlot(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+	assert.Equal(t, false, db.IsFinalizedBlock(ctx, r))
+
+	report, err = db.VerifyIntegrity(ctx, true)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.DanglingIndexEntries)
+	assert.Equal(t, 1, len(report.Issues), "Unexpected issues: %v", report.Issues)
+}
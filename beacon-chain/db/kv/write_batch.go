@@ -0,0 +1,278 @@
+package kv
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// writeBatchSize is the number of batched blocks which are buffered before being written to the
+	// database in a single transaction.
+	writeBatchSize = 512
+	// writeBatchSyncInterval is the interval at which the journal of the batched blocks is synced to
+	// disk. The journal is also synced before the batch is flushed and before states or state
+	// summaries, which may reference the batched blocks, are saved, so a crash loses at most the
+	// blocks journaled during this interval that nothing references yet.
+	writeBatchSyncInterval = time.Second
+	// writeBatchJournalName is the name of the journal of the batched blocks, in the database
+	// directory.
+	writeBatchJournalName = "writebatch.wal"
+)
+
+var journalTable = crc32.MakeTable(crc32.Castagnoli)
+
+// writeBatch buffers the blocks saved during initial sync, so that hundreds of blocks are written
+// along with their indices in a single transaction instead of a transaction per few blocks. Each
+// batched block is appended to a journal, which is replayed into the database on startup if the node
+// stopped before the batch was written.
+type writeBatch struct {
+	blocks   map[[32]byte]*ethpb.SignedBeaconBlock
+	encs     map[[32]byte][]byte
+	lock     sync.RWMutex
+	journal  *os.File
+	path     string
+	lastSync time.Time
+	unsynced bool
+}
+
+func newWriteBatch(dirPath string) *writeBatch {
+	return &writeBatch{
+		blocks: make(map[[32]byte]*ethpb.SignedBeaconBlock),
+		encs:   make(map[[32]byte][]byte),
+		path:   path.Join(dirPath, writeBatchJournalName),
+	}
+}
+
+// get retrieves a batched block using the root of the block.
+func (b *writeBatch) get(r [32]byte) *ethpb.SignedBeaconBlock {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.blocks[r]
+}
+
+// This appends a block to the journal, syncing the journal to disk if the sync interval elapsed.
+func (b *writeBatch) appendJournal(enc []byte) error {
+	if b.journal == nil {
+		f, err := os.OpenFile(b.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, params.BeaconIoConfig().ReadWritePermissions)
+		if err != nil {
+			return err
+		}
+		b.journal = f
+		b.lastSync = time.Now()
+	}
+	record := make([]byte, 8, 8+len(enc))
+	binary.BigEndian.PutUint32(record[:4], uint32(len(enc)))
+	binary.BigEndian.PutUint32(record[4:], crc32.Checksum(enc, journalTable))
+	if _, err := b.journal.Write(append(record, enc...)); err != nil {
+		return err
+	}
+	b.unsynced = true
+	if time.Since(b.lastSync) >= writeBatchSyncInterval {
+		return b.syncJournal()
+	}
+	return nil
+}
+
+// This syncs the records appended to the journal since its last sync to disk.
+func (b *writeBatch) syncJournal() error {
+	if b.journal == nil || !b.unsynced {
+		return nil
+	}
+	if err := b.journal.Sync(); err != nil {
+		return err
+	}
+	b.lastSync = time.Now()
+	b.unsynced = false
+	return nil
+}
+
+// This removes the journal once its blocks are written to the database.
+func (b *writeBatch) removeJournal() error {
+	if b.journal != nil {
+		if err := b.journal.Close(); err != nil {
+			return err
+		}
+		b.journal = nil
+	}
+	b.unsynced = false
+	if err := os.Remove(b.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// SaveBlocksBatched saves blocks to the write batch of initial sync, which is written to the DB once
+// it holds enough blocks, before blocks are queried by slot or filter, and before checkpoints are
+// saved. Batched blocks are readable by root right away.
+func (s *Store) SaveBlocksBatched(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBlocksBatched")
+	defer span.End()
+
+	s.writeBatch.lock.Lock()
+	defer s.writeBatch.lock.Unlock()
+	for _, blk := range blocks {
+		blockRoot, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if _, ok := s.writeBatch.blocks[blockRoot]; ok {
+			continue
+		}
+		enc, err := encode(ctx, blk)
+		if err != nil {
+			return err
+		}
+		if err := s.writeBatch.appendJournal(enc); err != nil {
+			return errors.Wrap(err, "could not journal block")
+		}
+		s.writeBatch.blocks[blockRoot] = blk
+		s.writeBatch.encs[blockRoot] = enc
+	}
+	if len(s.writeBatch.blocks) < writeBatchSize {
+		return nil
+	}
+	return s.flushWriteBatchLocked(ctx)
+}
+
+// FlushBatchedWrites writes the blocks of the write batch of initial sync, along with the cached
+// state summaries, to the DB.
+func (s *Store) FlushBatchedWrites(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.FlushBatchedWrites")
+	defer span.End()
+
+	return s.flushWriteBatch(ctx)
+}
+
+// This writes the write batch to the database if it holds blocks.
+func (s *Store) flushWriteBatch(ctx context.Context) error {
+	s.writeBatch.lock.RLock()
+	empty := len(s.writeBatch.blocks) == 0
+	s.writeBatch.lock.RUnlock()
+	if empty {
+		return nil
+	}
+	s.writeBatch.lock.Lock()
+	defer s.writeBatch.lock.Unlock()
+	return s.flushWriteBatchLocked(ctx)
+}
+
+// This syncs the journal of the write batch to disk, before saving anything which may reference the
+// batched blocks.
+func (s *Store) syncWriteBatch() error {
+	s.writeBatch.lock.Lock()
+	defer s.writeBatch.lock.Unlock()
+	return s.writeBatch.syncJournal()
+}
+
+// This writes the batched blocks and the cached state summaries in a single transaction, then
+// clears the batch and removes its journal. The journal is synced first, so its blocks survive a
+// crash while the transaction is written. The write batch lock must be held.
+func (s *Store) flushWriteBatchLocked(ctx context.Context) error {
+	if err := s.writeBatch.syncJournal(); err != nil {
+		return errors.Wrap(err, "could not sync write batch journal")
+	}
+	summaries := s.stateSummaryCache.getAll()
+	summaryEncs := make([][]byte, len(summaries))
+	for i, summary := range summaries {
+		enc, err := encode(ctx, summary)
+		if err != nil {
+			return err
+		}
+		summaryEncs[i] = enc
+	}
+	if err := s.db.Update(func(tx backend.Tx) error {
+		for r, blk := range s.writeBatch.blocks {
+			if err := s.saveBlock(ctx, tx, r, blk, s.writeBatch.encs[r]); err != nil {
+				return err
+			}
+		}
+		bkt := tx.Bucket(stateSummaryBucket)
+		for i, summary := range summaries {
+			if err := bkt.Put(summary.Root, summaryEncs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	s.stateSummaryCache.clear()
+	s.writeBatch.blocks = make(map[[32]byte]*ethpb.SignedBeaconBlock)
+	s.writeBatch.encs = make(map[[32]byte][]byte)
+	return s.writeBatch.removeJournal()
+}
+
+// This writes the blocks of the journal left by a node which stopped before writing its write batch
+// to the database. A torn record at the end of the journal, which was being written when the node
+// stopped, is discarded.
+func (s *Store) replayWriteBatchJournal(ctx context.Context) error {
+	f, err := os.Open(s.writeBatch.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var blocks []*ethpb.SignedBeaconBlock
+	var encs [][]byte
+	r := bufio.NewReader(f)
+	header := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err != io.EOF {
+				log.WithError(err).Warn("Discarding torn record of write batch journal")
+			}
+			break
+		}
+		enc := make([]byte, binary.BigEndian.Uint32(header[:4]))
+		if _, err := io.ReadFull(r, enc); err != nil {
+			log.WithError(err).Warn("Discarding torn record of write batch journal")
+			break
+		}
+		if crc32.Checksum(enc, journalTable) != binary.BigEndian.Uint32(header[4:]) {
+			log.Warn("Discarding corrupted record of write batch journal")
+			break
+		}
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := decode(ctx, enc, blk); err != nil {
+			log.WithError(err).Warn("Discarding corrupted record of write batch journal")
+			break
+		}
+		blocks = append(blocks, blk)
+		encs = append(encs, enc)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := s.db.Update(func(tx backend.Tx) error {
+		for i, blk := range blocks {
+			blockRoot, err := blk.Block.HashTreeRoot()
+			if err != nil {
+				return err
+			}
+			if err := s.saveBlock(ctx, tx, blockRoot, blk, encs[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(blocks) > 0 {
+		log.WithField("blocks", len(blocks)).Info("Replayed write batch journal")
+	}
+	return s.writeBatch.removeJournal()
+}
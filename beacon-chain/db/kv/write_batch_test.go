@@ -0,0 +1,143 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backend"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func batchedBlocks(t *testing.T, count int) ([]*ethpb.SignedBeaconBlock, [][32]byte) {
+	blks := make([]*ethpb.SignedBeaconBlock, count)
+	roots := make([][32]byte, count)
+	for i := range blks {
+		blks[i] = testutil.NewBeaconBlock()
+		blks[i].Block.Slot = types.Slot(i + 1)
+		r, err := blks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = r
+	}
+	return blks, roots
+}
+
+func TestStore_SaveBlocksBatched(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	blks, roots := batchedBlocks(t, 10)
+	require.NoError(t, db.SaveBlocksBatched(ctx, blks))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 1, Root: roots[0][:]}))
+
+	journal := path.Join(db.databasePath, writeBatchJournalName)
+	_, err := os.Stat(journal)
+	require.NoError(t, err)
+	db.blockCache.Clear()
+	for i, r := range roots {
+		assert.Equal(t, true, db.HasBlock(ctx, r))
+		blk, err := db.Block(ctx, r)
+		require.NoError(t, err)
+		assert.DeepEqual(t, blks[i], blk)
+	}
+
+	// Querying blocks by slot writes the batch first.
+	_, slotBlks, err := db.BlocksBySlot(ctx, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(slotBlks))
+	assert.Equal(t, 0, len(db.writeBatch.blocks))
+	assert.Equal(t, 0, db.stateSummaryCache.len())
+	assert.Equal(t, true, db.HasStateSummary(ctx, roots[0]))
+	_, err = os.Stat(journal)
+	assert.Equal(t, true, os.IsNotExist(err), "Expected the journal to be removed")
+}
+
+func TestStore_SaveBlocksBatched_FlushesFullBatch(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	blks, roots := batchedBlocks(t, writeBatchSize)
+	require.NoError(t, db.SaveBlocksBatched(ctx, blks[:writeBatchSize-1]))
+	assert.Equal(t, writeBatchSize-1, len(db.writeBatch.blocks))
+	require.NoError(t, db.SaveBlocksBatched(ctx, blks[writeBatchSize-1:]))
+	assert.Equal(t, 0, len(db.writeBatch.blocks))
+
+	db.blockCache.Clear()
+	for _, r := range roots {
+		assert.Equal(t, true, db.HasBlock(ctx, r))
+	}
+}
+
+func TestStore_SaveBlocksBatched_ReplaysJournal(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	// The LevelDB backend has no metrics collector to unregister when closing it directly.
+	cfg := &Config{Backend: backend.LevelDBBackend}
+	db, err := NewKVStore(ctx, dir, cfg)
+	require.NoError(t, err)
+	blks, roots := batchedBlocks(t, 10)
+	require.NoError(t, db.SaveBlocksBatched(ctx, blks))
+	// The node stops without writing the batch, in the middle of journaling another block.
+	require.NoError(t, db.writeBatch.journal.Close())
+	require.NoError(t, db.db.Close())
+	f, err := os.OpenFile(path.Join(dir, writeBatchJournalName), os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 1, 0, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	db, err = NewKVStore(ctx, dir, cfg)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	assert.Equal(t, 0, len(db.writeBatch.blocks))
+	db.blockCache.Clear()
+	for _, r := range roots {
+		assert.Equal(t, true, db.HasBlock(ctx, r))
+	}
+	_, slotBlks, err := db.BlocksBySlot(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(slotBlks))
+	_, err = os.Stat(path.Join(dir, writeBatchJournalName))
+	assert.Equal(t, true, os.IsNotExist(err), "Expected the journal to be removed")
+}
+
+func TestStore_SaveBlocksBatched_SyncsJournalBeforeState(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	cfg := &Config{Backend: backend.LevelDBBackend}
+	db, err := NewKVStore(ctx, dir, cfg)
+	require.NoError(t, err)
+	blks, roots := batchedBlocks(t, 10)
+	require.NoError(t, db.SaveBlocksBatched(ctx, blks))
+	assert.Equal(t, true, db.writeBatch.unsynced, "Expected the journal to wait for the sync interval")
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(10))
+	require.NoError(t, db.SaveState(ctx, st, roots[9]))
+	assert.Equal(t, false, db.writeBatch.unsynced, "Expected the journal to be synced before the state")
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 10, Root: roots[9][:]}))
+	assert.Equal(t, false, db.writeBatch.unsynced, "Expected the journal to be synced before the summary")
+
+	// The node crashes without writing the batch.
+	require.NoError(t, db.writeBatch.journal.Close())
+	require.NoError(t, db.db.Close())
+	db, err = NewKVStore(ctx, dir, cfg)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	assert.Equal(t, true, db.HasState(ctx, roots[9]))
+	db.blockCache.Clear()
+	for _, r := range roots {
+		assert.Equal(t, true, db.HasBlock(ctx, r))
+	}
+	blk, err := db.Block(ctx, roots[9])
+	require.NoError(t, err)
+	assert.DeepEqual(t, blks[9], blk)
+}
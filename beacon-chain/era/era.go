@@ -0,0 +1,198 @@
+// Package era reads and writes era files, portable archives of the finalized history of the chain.
+// The era file of an era holds the blocks of the SLOTS_PER_HISTORICAL_ROOT slots preceding the era
+// along with the state at the start of the era, whose block roots commit to the blocks and whose
+// historical roots commit to the previous eras. A node can hence verify the files it is given from a
+// single trusted state, and fill its history from them instead of downloading blocks from peers.
+//
+// A file is a sequence of records, each made of an 8 bytes header holding the type and the length of
+// the data, followed by the data. The file starts with a version record, followed by the snappy
+// compressed blocks ordered by slot and the snappy compressed state, and ends with the indices of the
+// offsets of the blocks by slot and of the state.
+package era
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	typeVersion         = uint16(0x3265) // "e2"
+	typeCompressedBlock = uint16(0x0001)
+	typeCompressedState = uint16(0x0002)
+	typeSlotIndex       = uint16(0x3269) // "i2"
+
+	headerSize = 8
+)
+
+// Era is the number of an era. Era N starts at slot N * SLOTS_PER_HISTORICAL_ROOT.
+type Era uint64
+
+// StartSlot returns the first slot of the era, which is the slot of the state of its era file.
+func (e Era) StartSlot() types.Slot {
+	return types.Slot(e) * params.BeaconConfig().SlotsPerHistoricalRoot
+}
+
+// BlocksStartSlot returns the slot of the first block of the era file of the era. The file of era 0
+// only holds the genesis state.
+func (e Era) BlocksStartSlot() types.Slot {
+	if e == 0 {
+		return 0
+	}
+	return (e - 1).StartSlot()
+}
+
+// FileName returns the name of the era file of the era with the given state root.
+func FileName(era Era, stateRoot [32]byte) string {
+	return fmt.Sprintf("era-%05d-%x.era", era, stateRoot[:4])
+}
+
+// Writer writes an era file, streaming the blocks of the era to the file as they are added.
+type Writer struct {
+	f            *os.File
+	w            *bufio.Writer
+	era          Era
+	offset       int64
+	blockOffsets []int64
+	blocks       int
+	lastSlot     types.Slot
+}
+
+// Create creates the era file of the given era at the given path.
+func Create(path string, era Era) (*Writer, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return nil, err
+	}
+	w := &Writer{
+		f:   f,
+		w:   bufio.NewWriter(f),
+		era: era,
+	}
+	if era > 0 {
+		w.blockOffsets = make([]int64, params.BeaconConfig().SlotsPerHistoricalRoot)
+	}
+	if err := w.writeRecord(typeVersion, nil); err != nil {
+		return nil, w.abort(err)
+	}
+	return w, nil
+}
+
+// AddBlock appends a block of the era to the file. Blocks must be added by increasing slot.
+func (w *Writer) AddBlock(blk *ethpb.SignedBeaconBlock) error {
+	if blk == nil || blk.Block == nil {
+		return errors.New("nil block")
+	}
+	slot := blk.Block.Slot
+	start := w.era.BlocksStartSlot()
+	if w.era == 0 || slot < start || slot >= w.era.StartSlot() {
+		return errors.Errorf("block slot %d is not in the range of era %d", slot, w.era)
+	}
+	if w.blocks > 0 && slot <= w.lastSlot {
+		return errors.Errorf("block slot %d is not after the slot %d of the previous block", slot, w.lastSlot)
+	}
+	enc, err := blk.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	compressed, err := compress(enc)
+	if err != nil {
+		return err
+	}
+	w.blockOffsets[slot-start] = w.offset
+	w.blocks++
+	w.lastSlot = slot
+	return w.writeRecord(typeCompressedBlock, compressed)
+}
+
+// Blocks returns the number of blocks added to the file.
+func (w *Writer) Blocks() int {
+	return w.blocks
+}
+
+// Finish writes the state at the start slot of the era along with the indices, and closes the file.
+func (w *Writer) Finish(st iface.ReadOnlyBeaconState) error {
+	if st.Slot() != w.era.StartSlot() {
+		return w.abort(errors.Errorf("state slot %d is not the start slot %d of era %d", st.Slot(), w.era.StartSlot(), w.era))
+	}
+	compressed, err := st.MarshalSSZSnappy()
+	if err != nil {
+		return w.abort(err)
+	}
+	stateOffset := w.offset
+	if err := w.writeRecord(typeCompressedState, compressed); err != nil {
+		return w.abort(err)
+	}
+	if w.era > 0 {
+		if err := w.writeRecord(typeSlotIndex, encodeSlotIndex(w.era.BlocksStartSlot(), w.blockOffsets)); err != nil {
+			return w.abort(err)
+		}
+	}
+	if err := w.writeRecord(typeSlotIndex, encodeSlotIndex(w.era.StartSlot(), []int64{stateOffset})); err != nil {
+		return w.abort(err)
+	}
+	if err := w.w.Flush(); err != nil {
+		return w.abort(err)
+	}
+	return w.f.Close()
+}
+
+// Abort closes the file without completing it.
+func (w *Writer) Abort() error {
+	return w.f.Close()
+}
+
+// This closes the file after a failure, returning the failure.
+func (w *Writer) abort(err error) error {
+	if closeErr := w.f.Close(); closeErr != nil {
+		log.WithError(closeErr).Error("Failed to close era file")
+	}
+	return err
+}
+
+func (w *Writer) writeRecord(typ uint16, data []byte) error {
+	header := make([]byte, headerSize)
+	binary.LittleEndian.PutUint16(header[0:2], typ)
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+	if _, err := w.w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(data); err != nil {
+		return err
+	}
+	w.offset += int64(headerSize + len(data))
+	return nil
+}
+
+// encodeSlotIndex encodes the start slot, the offsets of the records of the slots from the start slot,
+// zero for slots without record, and the number of slots.
+func encodeSlotIndex(start types.Slot, offsets []int64) []byte {
+	enc := make([]byte, 8*(len(offsets)+2))
+	binary.LittleEndian.PutUint64(enc[0:8], uint64(start))
+	for i, o := range offsets {
+		binary.LittleEndian.PutUint64(enc[8*(i+1):8*(i+2)], uint64(o))
+	}
+	binary.LittleEndian.PutUint64(enc[len(enc)-8:], uint64(len(offsets)))
+	return enc
+}
+
+func compress(enc []byte) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := snappy.NewBufferedWriter(buf)
+	if _, err := w.Write(enc); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
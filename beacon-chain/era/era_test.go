@@ -0,0 +1,179 @@
+package era
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// This returns a chain of blocks at the given slots of era 1 descending from the given parent, along
+// with the state at the start of era 1, whose block roots and historical roots commit to the blocks.
+func eraBlocksAndState(t *testing.T, parentRoot [32]byte, slots ...types.Slot) ([]*ethpb.SignedBeaconBlock, iface.BeaconState) {
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	blks := make([]*ethpb.SignedBeaconBlock, len(slots))
+	roots := make(map[types.Slot][32]byte)
+	latest := parentRoot
+	for i, slot := range slots {
+		blks[i] = testutil.NewBeaconBlock()
+		blks[i].Block.Slot = slot
+		blks[i].Block.ParentRoot = append([]byte{}, parentRoot[:]...)
+		r, err := blks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[slot] = r
+		parentRoot = r
+	}
+	blockRoots := make([][]byte, sphr)
+	for slot := types.Slot(0); slot < sphr; slot++ {
+		if r, ok := roots[slot]; ok {
+			latest = r
+		}
+		blockRoots[slot] = append([]byte{}, latest[:]...)
+	}
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(sphr))
+	require.NoError(t, st.SetBlockRoots(blockRoots))
+	historicalRoot, err := HistoricalRoot(st)
+	require.NoError(t, err)
+	require.NoError(t, st.AppendHistoricalRoots(historicalRoot))
+	return blks, st
+}
+
+func writeEra(t *testing.T, path string, era Era, blks []*ethpb.SignedBeaconBlock, st iface.BeaconState) {
+	w, err := Create(path, era)
+	require.NoError(t, err)
+	for _, blk := range blks {
+		require.NoError(t, w.AddBlock(blk))
+	}
+	require.NoError(t, w.Finish(st))
+}
+
+func TestWriterReader(t *testing.T) {
+	ctx := context.Background()
+	blks, st := eraBlocksAndState(t, [32]byte{}, 0, 3, 4, params.BeaconConfig().SlotsPerHistoricalRoot-1)
+	path := filepath.Join(t.TempDir(), "era.era")
+	writeEra(t, path, 1, blks, st)
+
+	r, err := Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	assert.Equal(t, Era(1), r.Era())
+
+	blk, err := r.Block(3)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(3), blk.Block.Slot)
+	blk, err = r.Block(5)
+	require.NoError(t, err)
+	assert.Equal(t, true, blk == nil)
+	_, err = r.Block(params.BeaconConfig().SlotsPerHistoricalRoot)
+	assert.ErrorContains(t, "is not in the range of era 1", err)
+
+	read, err := r.Blocks()
+	require.NoError(t, err)
+	require.Equal(t, len(blks), len(read))
+	for i := range blks {
+		want, err := blks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		got, err := read[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	verified, err := r.Verify(ctx)
+	require.NoError(t, err)
+	wantRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	gotRoot, err := verified.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, gotRoot)
+}
+
+func TestWriterReader_GenesisEra(t *testing.T) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "era.era")
+	writeEra(t, path, 0, nil, st)
+
+	r, err := Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	assert.Equal(t, Era(0), r.Era())
+	_, err = r.Verify(context.Background())
+	require.NoError(t, err)
+	blks, err := r.Blocks()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blks))
+}
+
+func TestWriter_AddBlock_OutOfRange(t *testing.T) {
+	w, err := Create(filepath.Join(t.TempDir(), "era.era"), 1)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, w.Abort())
+	}()
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = params.BeaconConfig().SlotsPerHistoricalRoot
+	assert.ErrorContains(t, "is not in the range of era 1", w.AddBlock(blk))
+	blk.Block.Slot = 2
+	require.NoError(t, w.AddBlock(blk))
+	assert.ErrorContains(t, "is not after the slot 2 of the previous block", w.AddBlock(blk))
+}
+
+func TestReader_Verify_UncommittedBlock(t *testing.T) {
+	blks, st := eraBlocksAndState(t, [32]byte{}, 1, 2)
+	blks[1].Block.ProposerIndex = 1
+	path := filepath.Join(t.TempDir(), "era.era")
+	writeEra(t, path, 1, blks, st)
+
+	r, err := Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	_, err = r.Verify(context.Background())
+	assert.ErrorContains(t, "at slot 2 is not committed to by the state", err)
+}
+
+func TestReader_Verify_UncommittedHistoricalRoot(t *testing.T) {
+	blks, st := eraBlocksAndState(t, [32]byte{}, 1, 2)
+	require.NoError(t, st.SetHistoricalRoots([][]byte{make([]byte, 32)}))
+	path := filepath.Join(t.TempDir(), "era.era")
+	writeEra(t, path, 1, blks, st)
+
+	r, err := Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	_, err = r.Verify(context.Background())
+	assert.ErrorContains(t, "historical roots of the state do not commit to era 1", err)
+}
+
+func TestIndex(t *testing.T) {
+	dir := t.TempDir()
+	idx := &Index{GenesisValidatorsRoot: "0x01"}
+	idx.Add(&IndexEntry{Era: 2, File: "b"})
+	idx.Add(&IndexEntry{Era: 1, File: "a"})
+	idx.Add(&IndexEntry{Era: 2, File: "c"})
+	require.NoError(t, WriteIndex(dir, idx))
+
+	read, err := ReadIndex(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "0x01", read.GenesisValidatorsRoot)
+	require.Equal(t, 2, len(read.Eras))
+	assert.Equal(t, "a", read.Entry(1).File)
+	assert.Equal(t, "c", read.Entry(2).File)
+	assert.Equal(t, true, read.Entry(3) == nil)
+}
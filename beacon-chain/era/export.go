@@ -0,0 +1,190 @@
+package era
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// LastFinalizedEra returns the last era whose era file can be exported, which is the last era starting
+// at or before the finalized checkpoint.
+func LastFinalizedEra(ctx context.Context, beaconDB db.ReadOnlyDatabase) (Era, error) {
+	cp, err := beaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, err
+	}
+	fSlot, err := helpers.StartSlot(cp.Epoch)
+	if err != nil {
+		return 0, err
+	}
+	return Era(fSlot / params.BeaconConfig().SlotsPerHistoricalRoot), nil
+}
+
+// Export writes the era files of the eras from start to end included to the given directory, and adds
+// them to the index of the directory. The eras must start at or before the finalized checkpoint, as
+// the files hold the finalized history of the chain.
+func Export(ctx context.Context, beaconDB db.ReadOnlyDatabase, sg *stategen.State, dir string, start, end Era) (*Index, error) {
+	ctx, span := trace.StartSpan(ctx, "era.Export")
+	defer span.End()
+
+	if start > end {
+		return nil, errors.Errorf("start era %d is after end era %d", start, end)
+	}
+	last, err := LastFinalizedEra(ctx, beaconDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last finalized era")
+	}
+	if end > last {
+		return nil, errors.Errorf("era %d is not finalized, the last finalized era is %d", end, last)
+	}
+	if err := fileutil.MkdirAll(dir); err != nil {
+		return nil, err
+	}
+	idx := &Index{}
+	if fileutil.FileExists(filepath.Join(dir, IndexFileName)) {
+		if idx, err = ReadIndex(dir); err != nil {
+			return nil, err
+		}
+	}
+
+	for era := start; era <= end; era++ {
+		var st iface.BeaconState
+		if era == 0 {
+			st, err = beaconDB.GenesisState(ctx)
+		} else {
+			st, err = sg.StateBySlot(ctx, era.StartSlot())
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get state of era %d", era)
+		}
+		if st == nil {
+			return nil, errors.Errorf("state of era %d not found", era)
+		}
+		gvr := fmt.Sprintf("%#x", bytesutil.ToBytes32(st.GenesisValidatorRoot()))
+		if idx.GenesisValidatorsRoot == "" {
+			idx.GenesisValidatorsRoot = gvr
+		}
+		if idx.GenesisValidatorsRoot != gvr {
+			return nil, errors.Errorf("era index of %s is for another chain", dir)
+		}
+		entry, err := exportEra(ctx, beaconDB, dir, era, st)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not export era %d", era)
+		}
+		idx.Add(entry)
+		if err := WriteIndex(dir, idx); err != nil {
+			return nil, errors.Wrap(err, "could not write era index")
+		}
+		log.WithFields(logrus.Fields{
+			"era":    era,
+			"blocks": entry.Blocks,
+			"file":   entry.File,
+		}).Info("Exported era")
+	}
+	return idx, nil
+}
+
+// This writes the era file of the era from its state and the blocks committed to by its block roots.
+// The file is written under a temporary name, and renamed once complete.
+func exportEra(ctx context.Context, beaconDB db.ReadOnlyDatabase, dir string, era Era, st iface.BeaconState) (*IndexEntry, error) {
+	tmpPath := filepath.Join(dir, fmt.Sprintf("era-%05d.era.tmp", era))
+	w, err := Create(tmpPath, era)
+	if err != nil {
+		return nil, err
+	}
+	if era > 0 {
+		if err := addBlocks(ctx, beaconDB, w, era, st); err != nil {
+			if abortErr := w.Abort(); abortErr != nil {
+				log.WithError(abortErr).Error("Failed to close era file")
+			}
+			return nil, err
+		}
+	}
+	if err := w.Finish(st); err != nil {
+		return nil, err
+	}
+
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := FileName(era, stateRoot)
+	if err := os.Rename(tmpPath, filepath.Join(dir, name)); err != nil {
+		return nil, err
+	}
+	checksum, err := fileChecksum(filepath.Join(dir, name))
+	if err != nil {
+		return nil, err
+	}
+	return &IndexEntry{
+		Era:       era,
+		File:      name,
+		StateRoot: fmt.Sprintf("%#x", stateRoot),
+		Blocks:    w.Blocks(),
+		Checksum:  checksum,
+	}, nil
+}
+
+// This adds the blocks of the era to the file. The block root of an empty slot is the root of the
+// block of the previous slot, so only the first slot of each distinct root may hold a block.
+func addBlocks(ctx context.Context, beaconDB db.ReadOnlyDatabase, w *Writer, era Era, st iface.ReadOnlyBeaconState) error {
+	blockRoots := st.BlockRoots()
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	var prevRoot [32]byte
+	for slot := era.BlocksStartSlot(); slot < era.StartSlot(); slot++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		root := bytesutil.ToBytes32(blockRoots[slot%sphr])
+		if root == prevRoot {
+			continue
+		}
+		prevRoot = root
+		blk, err := beaconDB.Block(ctx, root)
+		if err != nil {
+			return err
+		}
+		if blk == nil || blk.Block == nil {
+			return errors.Errorf("block %#x of slot %d not found, the database may not be backfilled", root, slot)
+		}
+		// The first slot of the era is empty if its root is the root of a block of the previous era.
+		if blk.Block.Slot != slot {
+			continue
+		}
+		if err := w.AddBlock(blk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Failed to close era file")
+		}
+	}()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
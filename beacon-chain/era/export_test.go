@@ -0,0 +1,81 @@
+package era
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestExport(t *testing.T) {
+	// The mainnet genesis state is embedded, so the chain of the test is named differently.
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ConfigName = "era-test"
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	genesisState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, genesisState))
+	genesis, err := beaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	blks, st := eraBlocksAndState(t, genesisRoot, 5, 6)
+	require.NoError(t, beaconDB.SaveBlocks(ctx, blks))
+	// The genesis block is the first block of era 1.
+	blks = append([]*ethpb.SignedBeaconBlock{genesis}, blks...)
+
+	// The state of era 1 is the state of the block at its start slot, which is finalized.
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = sphr
+	parentRoot, err := blks[len(blks)-1].Block.HashTreeRoot()
+	require.NoError(t, err)
+	blk.Block.ParentRoot = parentRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, blk))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, st, root))
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: sphr, Root: root[:]}))
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{
+		Epoch: helpers.SlotToEpoch(sphr),
+		Root:  root[:],
+	}))
+
+	dir := filepath.Join(t.TempDir(), "eras")
+	_, err = Export(ctx, beaconDB, stategen.New(beaconDB), dir, 0, 2)
+	assert.ErrorContains(t, "era 2 is not finalized, the last finalized era is 1", err)
+	idx, err := Export(ctx, beaconDB, stategen.New(beaconDB), dir, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(idx.Eras))
+	assert.Equal(t, 0, idx.Entry(0).Blocks)
+	assert.Equal(t, len(blks), idx.Entry(1).Blocks)
+
+	read, err := ReadIndex(dir)
+	require.NoError(t, err)
+	assert.Equal(t, idx.GenesisValidatorsRoot, read.GenesisValidatorsRoot)
+	r, err := Open(filepath.Join(dir, read.Entry(1).File))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, r.Close())
+	}()
+	_, err = r.Verify(ctx)
+	require.NoError(t, err)
+	exported, err := r.Blocks()
+	require.NoError(t, err)
+	require.Equal(t, len(blks), len(exported))
+	for i := range blks {
+		assert.Equal(t, blks[i].Block.Slot, exported[i].Block.Slot)
+	}
+}
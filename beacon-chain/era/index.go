@@ -0,0 +1,79 @@
+package era
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+// IndexFileName is the name of the index of the era files of a directory.
+const IndexFileName = "index.json"
+
+// IndexEntry describes an era file of a directory.
+type IndexEntry struct {
+	Era       Era    `json:"era"`
+	File      string `json:"file"`
+	StateRoot string `json:"state_root"`
+	Blocks    int    `json:"blocks"`
+	Checksum  string `json:"sha256"`
+}
+
+// Index lists the era files of a directory by era, along with the genesis validators root of the
+// chain they belong to.
+type Index struct {
+	GenesisValidatorsRoot string        `json:"genesis_validators_root"`
+	Eras                  []*IndexEntry `json:"eras"`
+}
+
+// ReadIndex reads the index of the era files of the given directory.
+func ReadIndex(dir string) (*Index, error) {
+	enc, err := ioutil.ReadFile(filepath.Join(dir, IndexFileName))
+	if err != nil {
+		return nil, err
+	}
+	idx := &Index{}
+	if err := json.Unmarshal(enc, idx); err != nil {
+		return nil, errors.Wrap(err, "could not decode era index")
+	}
+	sort.Slice(idx.Eras, func(i, j int) bool {
+		return idx.Eras[i].Era < idx.Eras[j].Era
+	})
+	return idx, nil
+}
+
+// WriteIndex writes the index of the era files of the given directory.
+func WriteIndex(dir string, idx *Index) error {
+	enc, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteFile(filepath.Join(dir, IndexFileName), enc)
+}
+
+// Entry returns the entry of the given era, or nil if the index has no file for the era.
+func (idx *Index) Entry(era Era) *IndexEntry {
+	for _, e := range idx.Eras {
+		if e.Era == era {
+			return e
+		}
+	}
+	return nil
+}
+
+// Add adds an entry to the index, replacing the entry of the same era if any.
+func (idx *Index) Add(entry *IndexEntry) {
+	for i, e := range idx.Eras {
+		if e.Era == entry.Era {
+			idx.Eras[i] = entry
+			return
+		}
+	}
+	idx.Eras = append(idx.Eras, entry)
+	sort.Slice(idx.Eras, func(i, j int) bool {
+		return idx.Eras[i].Era < idx.Eras[j].Era
+	})
+}
@@ -0,0 +1,7 @@
+package era
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "era")
@@ -0,0 +1,244 @@
+package era
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	state "github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ErrInvalidFile is returned when an era file is malformed or does not match its content.
+var ErrInvalidFile = errors.New("invalid era file")
+
+// Reader reads the blocks and the state of an era file by slot.
+type Reader struct {
+	f            *os.File
+	size         int64
+	era          Era
+	blockOffsets []int64
+	stateOffset  int64
+}
+
+// Open opens the era file at the given path and reads its indices.
+func Open(path string) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &Reader{f: f}
+	if err := r.readIndices(); err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close era file")
+		}
+		return nil, err
+	}
+	return r, nil
+}
+
+// Close closes the file.
+func (r *Reader) Close() error {
+	return r.f.Close()
+}
+
+// Era returns the era of the file.
+func (r *Reader) Era() Era {
+	return r.era
+}
+
+// State returns the state at the start slot of the era.
+func (r *Reader) State() (iface.BeaconState, error) {
+	enc, err := r.readRecord(r.stateOffset, typeCompressedState)
+	if err != nil {
+		return nil, err
+	}
+	return state.InitializeFromSSZSnappy(enc)
+}
+
+// Block returns the block of the given slot, or nil if the slot of the era has no block.
+func (r *Reader) Block(slot types.Slot) (*ethpb.SignedBeaconBlock, error) {
+	start := r.era.BlocksStartSlot()
+	if r.era == 0 || slot < start || slot >= r.era.StartSlot() {
+		return nil, errors.Errorf("slot %d is not in the range of era %d", slot, r.era)
+	}
+	offset := r.blockOffsets[slot-start]
+	if offset == 0 {
+		return nil, nil
+	}
+	compressed, err := r.readRecord(offset, typeCompressedBlock)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := ioutil.ReadAll(snappy.NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress block")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	if blk.Block.Slot != slot {
+		return nil, errors.Wrapf(ErrInvalidFile, "block at index of slot %d has slot %d", slot, blk.Block.Slot)
+	}
+	return blk, nil
+}
+
+// Blocks returns the blocks of the era ordered by slot.
+func (r *Reader) Blocks() ([]*ethpb.SignedBeaconBlock, error) {
+	var blks []*ethpb.SignedBeaconBlock
+	for i, offset := range r.blockOffsets {
+		if offset == 0 {
+			continue
+		}
+		blk, err := r.Block(r.era.BlocksStartSlot() + types.Slot(i))
+		if err != nil {
+			return nil, err
+		}
+		blks = append(blks, blk)
+	}
+	return blks, nil
+}
+
+// Verify verifies that the blocks of the file are the blocks committed to by the block roots of its
+// state, and that the historical roots of the state commit to them. It returns the state, whose root
+// must be trusted, or be committed to by the historical roots of the state of a trusted later era.
+func (r *Reader) Verify(ctx context.Context) (iface.BeaconState, error) {
+	st, err := r.State()
+	if err != nil {
+		return nil, err
+	}
+	if st.Slot() != r.era.StartSlot() {
+		return nil, errors.Wrapf(ErrInvalidFile, "state slot %d is not the start slot of era %d", st.Slot(), r.era)
+	}
+	if r.era == 0 {
+		return st, nil
+	}
+	blockRoots := st.BlockRoots()
+	sphr := params.BeaconConfig().SlotsPerHistoricalRoot
+	blks, err := r.Blocks()
+	if err != nil {
+		return nil, err
+	}
+	for _, blk := range blks {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		if root != bytesutil.ToBytes32(blockRoots[blk.Block.Slot%sphr]) {
+			return nil, errors.Wrapf(ErrInvalidFile, "block %#x at slot %d is not committed to by the state", root, blk.Block.Slot)
+		}
+	}
+	historicalRoot, err := HistoricalRoot(st)
+	if err != nil {
+		return nil, err
+	}
+	historicalRoots := st.HistoricalRoots()
+	if uint64(len(historicalRoots)) < uint64(r.era) || bytesutil.ToBytes32(historicalRoots[r.era-1]) != historicalRoot {
+		return nil, errors.Wrapf(ErrInvalidFile, "historical roots of the state do not commit to era %d", r.era)
+	}
+	return st, nil
+}
+
+// HistoricalRoot returns the root of the historical batch of the block and state roots of the era
+// preceding the state, as appended to the historical roots at the start of each era.
+func HistoricalRoot(st iface.ReadOnlyBeaconState) ([32]byte, error) {
+	batch := &pb.HistoricalBatch{
+		BlockRoots: st.BlockRoots(),
+		StateRoots: st.StateRoots(),
+	}
+	return batch.HashTreeRoot()
+}
+
+// This reads the state index at the end of the file, preceded by the block index except in era 0.
+func (r *Reader) readIndices() error {
+	info, err := r.f.Stat()
+	if err != nil {
+		return err
+	}
+	r.size = info.Size()
+	end := r.size
+	start, offsets, err := r.readSlotIndex(end)
+	if err != nil {
+		return err
+	}
+	if len(offsets) != 1 || start%params.BeaconConfig().SlotsPerHistoricalRoot != 0 {
+		return errors.Wrap(ErrInvalidFile, "invalid state index")
+	}
+	r.era = Era(start / params.BeaconConfig().SlotsPerHistoricalRoot)
+	r.stateOffset = offsets[0]
+	if r.era == 0 {
+		return nil
+	}
+	end -= int64(headerSize + 8*(len(offsets)+2))
+	start, offsets, err = r.readSlotIndex(end)
+	if err != nil {
+		return err
+	}
+	if start != r.era.BlocksStartSlot() || types.Slot(len(offsets)) != params.BeaconConfig().SlotsPerHistoricalRoot {
+		return errors.Wrap(ErrInvalidFile, "invalid block index")
+	}
+	r.blockOffsets = offsets
+	return nil
+}
+
+// This reads the slot index record ending at the given offset.
+func (r *Reader) readSlotIndex(end int64) (types.Slot, []int64, error) {
+	count := make([]byte, 8)
+	if end < headerSize+16 {
+		return 0, nil, errors.Wrap(ErrInvalidFile, "missing slot index")
+	}
+	if _, err := r.f.ReadAt(count, end-8); err != nil {
+		return 0, nil, err
+	}
+	n := binary.LittleEndian.Uint64(count)
+	size := int64(8 * (n + 2))
+	if n == 0 || n > uint64(params.BeaconConfig().SlotsPerHistoricalRoot) || end < headerSize+size {
+		return 0, nil, errors.Wrap(ErrInvalidFile, "invalid slot index")
+	}
+	enc, err := r.readRecord(end-size-headerSize, typeSlotIndex)
+	if err != nil {
+		return 0, nil, err
+	}
+	if int64(len(enc)) != size {
+		return 0, nil, errors.Wrap(ErrInvalidFile, "invalid slot index length")
+	}
+	offsets := make([]int64, n)
+	for i := range offsets {
+		offsets[i] = int64(binary.LittleEndian.Uint64(enc[8*(i+1) : 8*(i+2)]))
+	}
+	return types.Slot(binary.LittleEndian.Uint64(enc[0:8])), offsets, nil
+}
+
+// This reads the data of the record of the given type at the given offset.
+func (r *Reader) readRecord(offset int64, typ uint16) ([]byte, error) {
+	header := make([]byte, headerSize)
+	if _, err := r.f.ReadAt(header, offset); err != nil {
+		return nil, errors.Wrap(ErrInvalidFile, err.Error())
+	}
+	if binary.LittleEndian.Uint16(header[0:2]) != typ {
+		return nil, errors.Wrapf(ErrInvalidFile, "unexpected record type at offset %d", offset)
+	}
+	length := int64(binary.LittleEndian.Uint32(header[2:6]))
+	if offset+headerSize+length > r.size {
+		return nil, errors.Wrapf(ErrInvalidFile, "record at offset %d exceeds the file", offset)
+	}
+	data := make([]byte, length)
+	if _, err := r.f.ReadAt(data, offset+headerSize); err != nil && err != io.EOF {
+		return nil, errors.Wrap(ErrInvalidFile, err.Error())
+	}
+	return data, nil
+}
@@ -14,6 +14,7 @@ type ForkChoicer interface {
 	AttestationProcessor // to track new attestation for fork choice.
 	Pruner               // to clean old data for fork choice.
 	Getter               // to retrieve fork choice information.
+	ProposerBooster      // to boost the timely block of the current slot.
 }
 
 // HeadRetriever retrieves head root of the current chain.
@@ -24,6 +25,7 @@ type HeadRetriever interface {
 // BlockProcessor processes the block that's used for accounting fork choice.
 type BlockProcessor interface {
 	ProcessBlock(context.Context, types.Slot, [32]byte, [32]byte, [32]byte, types.Epoch, types.Epoch) error
+	InvalidateBlock(context.Context, [32]byte) error
 }
 
 // AttestationProcessor processes the attestation that's used for accounting fork choice.
@@ -31,6 +33,12 @@ type AttestationProcessor interface {
 	ProcessAttestation(context.Context, []uint64, [32]byte, types.Epoch)
 }
 
+// ProposerBooster boosts the weight of the block of the current slot received in time.
+type ProposerBooster interface {
+	BoostProposerRoot(context.Context, [32]byte)
+	ResetBoostedProposerRoot(context.Context)
+}
+
 // Pruner prunes the fork choice upon new finalization. This is used to keep fork choice sane.
 type Pruner interface {
 	Prune(context.Context, [32]byte) error
@@ -0,0 +1,110 @@
+package protoarray
+
+import (
+	"fmt"
+
+	"github.com/emicklei/dot"
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// StoreDump is a snapshot of the fork choice store, meant to be exported as JSON or as a DOT
+// graph to inspect fork choice while investigating an incident.
+type StoreDump struct {
+	JustifiedEpoch types.Epoch `json:"justified_epoch"`
+	FinalizedEpoch types.Epoch `json:"finalized_epoch"`
+	FinalizedRoot  string      `json:"finalized_root"`
+	PruneThreshold uint64      `json:"prune_threshold"`
+	Nodes          []*NodeDump `json:"nodes"`
+}
+
+// NodeDump is a snapshot of a fork choice node. The parent, best child and best descendant
+// are referenced by root, and are empty when the node has none.
+type NodeDump struct {
+	Slot               types.Slot  `json:"slot"`
+	Root               string      `json:"root"`
+	ParentRoot         string      `json:"parent_root"`
+	JustifiedEpoch     types.Epoch `json:"justified_epoch"`
+	FinalizedEpoch     types.Epoch `json:"finalized_epoch"`
+	Weight             uint64      `json:"weight"`
+	BestChildRoot      string      `json:"best_child_root"`
+	BestDescendantRoot string      `json:"best_descendant_root"`
+	Invalid            bool        `json:"invalid"`
+}
+
+// Dump returns a snapshot of the store, with its nodes in insertion order.
+func (s *Store) Dump() *StoreDump {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+
+	rootAt := func(i uint64) string {
+		if i == NonExistentNode || i >= uint64(len(s.nodes)) {
+			return ""
+		}
+		return fmt.Sprintf("%#x", s.nodes[i].root)
+	}
+	nodes := make([]*NodeDump, len(s.nodes))
+	for i, n := range s.nodes {
+		nodes[i] = &NodeDump{
+			Slot:               n.slot,
+			Root:               fmt.Sprintf("%#x", n.root),
+			ParentRoot:         rootAt(n.parent),
+			JustifiedEpoch:     n.justifiedEpoch,
+			FinalizedEpoch:     n.finalizedEpoch,
+			Weight:             n.weight,
+			BestChildRoot:      rootAt(n.bestChild),
+			BestDescendantRoot: rootAt(n.bestDescendant),
+			Invalid:            n.invalid,
+		}
+	}
+	return &StoreDump{
+		JustifiedEpoch: s.justifiedEpoch,
+		FinalizedEpoch: s.finalizedEpoch,
+		FinalizedRoot:  fmt.Sprintf("%#x", s.finalizedRoot),
+		PruneThreshold: s.pruneThreshold,
+		Nodes:          nodes,
+	}
+}
+
+// DOT renders the dump as a directed graph in the DOT language, with an edge from each node
+// to its parent. The edge to the best child is highlighted, and invalid nodes are drawn in red.
+func (d *StoreDump) DOT() string {
+	graph := dot.NewGraph(dot.Directed)
+	graph.Attr("rankdir", "RL")
+	graph.Attr("labeljust", "l")
+
+	dotNodes := make(map[string]dot.Node, len(d.Nodes))
+	for _, n := range d.Nodes {
+		label := fmt.Sprintf(
+			"slot: %d\nroot: %s\nweight: %d\njustified: %d\nfinalized: %d",
+			n.Slot, shortRoot(n.Root), n.Weight, n.JustifiedEpoch, n.FinalizedEpoch,
+		)
+		dotN := graph.Node(n.Root).Box().Attr("label", label)
+		if n.Invalid {
+			dotN = dotN.Attr("color", "red")
+		}
+		dotNodes[n.Root] = dotN
+	}
+	bestChildren := make(map[string]string, len(d.Nodes))
+	for _, n := range d.Nodes {
+		bestChildren[n.Root] = n.BestChildRoot
+	}
+	for _, n := range d.Nodes {
+		parent, ok := dotNodes[n.ParentRoot]
+		if !ok {
+			continue
+		}
+		edge := graph.Edge(dotNodes[n.Root], parent)
+		if bestChildren[n.ParentRoot] == n.Root {
+			edge.Attr("color", "green")
+		}
+	}
+	return graph.String()
+}
+
+// shortRoot returns the first bytes of a hex encoded root, enough to tell nodes apart in a graph.
+func shortRoot(root string) string {
+	if len(root) > 10 {
+		return root[:10]
+	}
+	return root
+}
@@ -0,0 +1,45 @@
+package protoarray
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_Dump(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+
+	//            0
+	//           / \
+	//  +vote -> 1  2
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 2)
+	_, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{10}, 1)
+	require.NoError(t, err)
+
+	d := f.Store().Dump()
+	assert.Equal(t, f.Store().JustifiedEpoch(), d.JustifiedEpoch)
+	assert.Equal(t, f.Store().FinalizedEpoch(), d.FinalizedEpoch)
+	require.Equal(t, 3, len(d.Nodes))
+	genesis := fmt.Sprintf("%#x", params.BeaconConfig().ZeroHash)
+	voted := fmt.Sprintf("%#x", indexToHash(1))
+	assert.Equal(t, "", d.Nodes[0].ParentRoot)
+	assert.Equal(t, voted, d.Nodes[0].BestChildRoot)
+	assert.Equal(t, voted, d.Nodes[0].BestDescendantRoot)
+	assert.Equal(t, voted, d.Nodes[1].Root)
+	assert.Equal(t, genesis, d.Nodes[1].ParentRoot)
+	assert.Equal(t, uint64(10), d.Nodes[1].Weight)
+	assert.Equal(t, "", d.Nodes[2].BestChildRoot)
+
+	graph := d.DOT()
+	assert.Equal(t, true, strings.HasPrefix(graph, "digraph"), graph)
+	assert.Equal(t, 2, strings.Count(graph, "->"), graph)
+	assert.Equal(t, 1, strings.Count(graph, "color=\"green\""), graph)
+}
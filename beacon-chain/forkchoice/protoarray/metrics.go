@@ -48,4 +48,17 @@ var (
 			Help: "The number of times pruning happened.",
 		},
 	)
+	pruneDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "proto_array_prune_duration_milliseconds",
+			Help:    "The time it takes to prune the nodes before the finalized root.",
+			Buckets: []float64{1, 5, 10, 50, 100, 500, 1000},
+		},
+	)
+	invalidatedNodeCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "proto_array_invalidated_node_count",
+			Help: "The number of nodes marked invalid, including the descendants of invalid blocks.",
+		},
+	)
 )
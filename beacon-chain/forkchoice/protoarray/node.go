@@ -48,3 +48,8 @@ func (n *Node) BestDescendant() uint64 {
 func (n *Node) Graffiti() [32]byte {
 	return n.graffiti
 }
+
+// Invalid returns true if the block of the fork choice node, or one of its ancestors, was marked invalid.
+func (n *Node) Invalid() bool {
+	return n.invalid
+}
@@ -0,0 +1,72 @@
+package protoarray
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// BoostProposerRoot boosts the weight of the given block root, which is the block of the current
+// slot received in time, until the boost is reset at the start of the next slot. The boost lets
+// honest proposers outweigh the votes an attacker withheld to balance competing forks.
+func (f *ForkChoice) BoostProposerRoot(ctx context.Context, root [32]byte) {
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.BoostProposerRoot")
+	defer span.End()
+
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = root
+}
+
+// ResetBoostedProposerRoot removes the boost of the block of the previous slot. It is applied to the
+// weights on the next head computation.
+func (f *ForkChoice) ResetBoostedProposerRoot(ctx context.Context) {
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.ResetBoostedProposerRoot")
+	defer span.End()
+
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = params.BeaconConfig().ZeroHash
+}
+
+// This adds the proposer boost to the weight deltas, removing the boost applied in the previous
+// head computation. The caller must hold the nodes lock.
+func (s *Store) applyProposerBoostScore(delta []int, balances []uint64) error {
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+
+	if len(s.nodes) != len(delta) {
+		return errInvalidDeltaLength
+	}
+	// The previously boosted node may have been pruned along with its weight.
+	if s.previousProposerBoostRoot != params.BeaconConfig().ZeroHash {
+		if i, ok := s.nodesIndices[s.previousProposerBoostRoot]; ok {
+			delta[i] -= int(s.previousProposerBoostScore)
+		}
+	}
+	s.previousProposerBoostRoot = params.BeaconConfig().ZeroHash
+	s.previousProposerBoostScore = 0
+	if s.proposerBoostRoot == params.BeaconConfig().ZeroHash {
+		return nil
+	}
+	i, ok := s.nodesIndices[s.proposerBoostRoot]
+	if !ok {
+		return nil
+	}
+	score := computeProposerBoostScore(balances)
+	delta[i] += int(score)
+	s.previousProposerBoostRoot = s.proposerBoostRoot
+	s.previousProposerBoostScore = score
+	return nil
+}
+
+// This returns the proposer boost, a percentage of the weight of the committees of a slot.
+func computeProposerBoostScore(balances []uint64) uint64 {
+	totalActiveBalance := uint64(0)
+	for _, b := range balances {
+		totalActiveBalance += b
+	}
+	committeeWeight := totalActiveBalance / uint64(params.BeaconConfig().SlotsPerEpoch)
+	return committeeWeight * params.BeaconConfig().ProposerScoreBoost / 100
+}
@@ -0,0 +1,55 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestForkChoice_BoostProposerRoot(t *testing.T) {
+	ctx := context.Background()
+	// The committee weight of a slot is 3150 / 32 = 98, and the boost is 70% of it.
+	balances := make([]uint64, 32)
+	for i := range balances {
+		balances[i] = 100
+	}
+	balances[0] = 50
+	f := setup(1, 1)
+
+	//            0
+	//           / \
+	//  +vote -> 1  2
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head without boost")
+
+	// The boosted block outweighs the vote, and the boost is only applied once.
+	f.BoostProposerRoot(ctx, indexToHash(2))
+	for i := 0; i < 2; i++ {
+		r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+		require.NoError(t, err)
+		assert.Equal(t, indexToHash(2), r, "Incorrect head with boost")
+		assert.Equal(t, uint64(68), f.Node(indexToHash(2)).Weight())
+	}
+
+	// Boosting another block moves the boost.
+	f.BoostProposerRoot(ctx, indexToHash(1))
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head with moved boost")
+	assert.Equal(t, uint64(118), f.Node(indexToHash(1)).Weight())
+	assert.Equal(t, uint64(0), f.Node(indexToHash(2)).Weight())
+
+	// The weights are back to the votes once the boost is reset.
+	f.ResetBoostedProposerRoot(ctx)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head after reset")
+	assert.Equal(t, uint64(50), f.Node(indexToHash(1)).Weight())
+}
@@ -0,0 +1,122 @@
+package protoarray
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"go.opencensus.io/trace"
+)
+
+// HeadSimulation describes a hypothetical course of the chain to run fork choice against, to
+// study how susceptible the current head is to a reorg.
+type HeadSimulation struct {
+	// MissedSlots is the number of upcoming slots whose proposals are missed. The attesters of
+	// each missed slot vote for the head as it is at that slot.
+	MissedSlots types.Slot
+	// CommitteeWeight is the weight of the attestations of a single slot.
+	CommitteeWeight uint64
+	// BranchRoot is the root of a block gaining BranchWeight of extra attestations.
+	BranchRoot [32]byte
+	// BranchWeight is the weight of the extra attestations for BranchRoot.
+	BranchWeight uint64
+}
+
+// SimulatedHead is the head selected by fork choice at the end of a simulation.
+type SimulatedHead struct {
+	Root   [32]byte
+	Slot   types.Slot
+	Weight uint64
+}
+
+// SimulateHead returns the head fork choice would select from the justified root if the given
+// simulation played out. The simulation runs on a copy of the store, leaving the store untouched.
+// Once a proposal is missed there is no timely block to boost, so the current proposer boost is
+// removed before the votes of the missed slots are applied.
+func (s *Store) SimulateHead(ctx context.Context, justifiedRoot [32]byte, sim *HeadSimulation) (*SimulatedHead, error) {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.SimulateHead")
+	defer span.End()
+
+	cpy := s.copy()
+	delta := make([]int, len(cpy.nodes))
+	if sim.MissedSlots > 0 && cpy.previousProposerBoostScore > 0 {
+		if i, ok := cpy.nodesIndices[cpy.previousProposerBoostRoot]; ok {
+			delta[i] -= int(cpy.previousProposerBoostScore)
+		}
+	}
+	if sim.BranchWeight > 0 {
+		i, ok := cpy.nodesIndices[sim.BranchRoot]
+		if !ok {
+			return nil, errUnknownNodeRoot
+		}
+		delta[i] += int(sim.BranchWeight)
+	}
+	if err := cpy.applyWeightChanges(ctx, cpy.justifiedEpoch, cpy.finalizedEpoch, delta); err != nil {
+		return nil, err
+	}
+
+	for slot := types.Slot(0); slot < sim.MissedSlots; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		n, err := cpy.bestNode(justifiedRoot)
+		if err != nil {
+			return nil, err
+		}
+		delta := make([]int, len(cpy.nodes))
+		delta[cpy.nodesIndices[n.root]] = int(sim.CommitteeWeight)
+		if err := cpy.applyWeightChanges(ctx, cpy.justifiedEpoch, cpy.finalizedEpoch, delta); err != nil {
+			return nil, err
+		}
+	}
+
+	n, err := cpy.bestNode(justifiedRoot)
+	if err != nil {
+		return nil, err
+	}
+	return &SimulatedHead{Root: n.root, Slot: n.slot, Weight: n.weight}, nil
+}
+
+// IsDescendant returns true if the node of the given root is the node of the ancestor root or
+// one of its descendants.
+func (s *Store) IsDescendant(root, ancestorRoot [32]byte) bool {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+
+	i, ok := s.nodesIndices[root]
+	for ok && i < uint64(len(s.nodes)) {
+		if s.nodes[i].root == ancestorRoot {
+			return true
+		}
+		i = s.nodes[i].parent
+	}
+	return false
+}
+
+// copy returns a copy of the nodes and the checkpoints of the store, along with the proposer
+// boost applied in the last head computation.
+func (s *Store) copy() *Store {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+
+	nodes := make([]*Node, len(s.nodes))
+	for i, n := range s.nodes {
+		nodes[i] = copyNode(n)
+	}
+	nodesIndices := make(map[[32]byte]uint64, len(s.nodesIndices))
+	for k, v := range s.nodesIndices {
+		nodesIndices[k] = v
+	}
+	return &Store{
+		pruneThreshold:             s.pruneThreshold,
+		justifiedEpoch:             s.justifiedEpoch,
+		finalizedEpoch:             s.finalizedEpoch,
+		finalizedRoot:              s.finalizedRoot,
+		nodes:                      nodes,
+		nodesIndices:               nodesIndices,
+		canonicalNodes:             make(map[[32]byte]bool),
+		previousProposerBoostRoot:  s.previousProposerBoostRoot,
+		previousProposerBoostScore: s.previousProposerBoostScore,
+	}
+}
@@ -0,0 +1,73 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_SimulateHead(t *testing.T) {
+	ctx := context.Background()
+	balances := make([]uint64, 32)
+	for i := range balances {
+		balances[i] = 100
+	}
+	balances[0] = 50
+	f := setup(1, 1)
+
+	//            0
+	//           / \
+	//  +vote -> 1  2 <- boost
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 2)
+	f.BoostProposerRoot(ctx, indexToHash(2))
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(2), r)
+	require.Equal(t, uint64(68), f.Node(indexToHash(2)).Weight())
+
+	// Without a proposal the boost is gone, and the vote of 1 takes over.
+	head, err := f.Store().SimulateHead(ctx, params.BeaconConfig().ZeroHash, &HeadSimulation{MissedSlots: 2, CommitteeWeight: 98})
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), head.Root)
+	assert.Equal(t, uint64(1), uint64(head.Slot))
+	assert.Equal(t, uint64(50+2*98), head.Weight)
+
+	// The boosted block keeps the head unless the other branch outweighs the boost.
+	head, err = f.Store().SimulateHead(ctx, params.BeaconConfig().ZeroHash, &HeadSimulation{BranchRoot: indexToHash(1), BranchWeight: 10})
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), head.Root)
+	head, err = f.Store().SimulateHead(ctx, params.BeaconConfig().ZeroHash, &HeadSimulation{BranchRoot: indexToHash(1), BranchWeight: 20})
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), head.Root)
+	assert.Equal(t, uint64(70), head.Weight)
+
+	_, err = f.Store().SimulateHead(ctx, params.BeaconConfig().ZeroHash, &HeadSimulation{BranchRoot: indexToHash(3), BranchWeight: 20})
+	assert.ErrorContains(t, errUnknownNodeRoot.Error(), err)
+
+	// The store itself is left untouched.
+	assert.Equal(t, uint64(68), f.Node(indexToHash(2)).Weight())
+	assert.Equal(t, uint64(50), f.Node(indexToHash(1)).Weight())
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r)
+}
+
+func TestStore_IsDescendant(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(2), indexToHash(1), [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(3), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+
+	assert.Equal(t, true, f.Store().IsDescendant(indexToHash(2), indexToHash(1)))
+	assert.Equal(t, true, f.Store().IsDescendant(indexToHash(2), indexToHash(2)))
+	assert.Equal(t, true, f.Store().IsDescendant(indexToHash(3), params.BeaconConfig().ZeroHash))
+	assert.Equal(t, false, f.Store().IsDescendant(indexToHash(3), indexToHash(1)))
+	assert.Equal(t, false, f.Store().IsDescendant(indexToHash(1), indexToHash(2)))
+	assert.Equal(t, false, f.Store().IsDescendant(indexToHash(4), indexToHash(1)))
+}
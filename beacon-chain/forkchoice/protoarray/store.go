@@ -5,10 +5,12 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"go.opencensus.io/trace"
 )
 
@@ -58,6 +60,9 @@ func (f *ForkChoice) Head(ctx context.Context, justifiedEpoch types.Epoch, justi
 	}
 	f.votes = newVotes
 
+	if err := f.store.applyProposerBoostScore(deltas, newBalances); err != nil {
+		return [32]byte{}, errors.Wrap(err, "Could not apply proposer boost score")
+	}
 	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, deltas); err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not apply score changes")
 	}
@@ -102,6 +107,16 @@ func (f *ForkChoice) ProcessBlock(ctx context.Context, slot types.Slot, blockRoo
 	return f.store.insert(ctx, slot, blockRoot, parentRoot, graffiti, justifiedEpoch, finalizedEpoch)
 }
 
+// InvalidateBlock marks the block of the given root and all of its descendants as invalid, so
+// that they are no longer considered viable for head. This is used when a block which was
+// already inserted into fork choice later fails verification.
+func (f *ForkChoice) InvalidateBlock(ctx context.Context, root [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.InvalidateBlock")
+	defer span.End()
+
+	return f.store.invalidate(ctx, root)
+}
+
 // Prune prunes the fork choice store with the new finalized root. The store is only pruned if the input
 // root is different than the current store finalized root, and the number of the store has met prune threshold.
 func (f *ForkChoice) Prune(ctx context.Context, finalizedRoot [32]byte) error {
@@ -230,19 +245,73 @@ func (s *Store) NodesIndices() map[[32]byte]uint64 {
 	return s.nodesIndices
 }
 
+// ViableHeads returns up to k of the nodes viable for head without a viable child, heaviest
+// first. Nodes of equal weight are ordered by descending slot. These are the candidate parents
+// of the next block. A negative k returns all of them.
+func (s *Store) ViableHeads(k int) []*Node {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+
+	hasChild := make([]bool, len(s.nodes))
+	for _, n := range s.nodes {
+		if n.parent != NonExistentNode && n.parent < uint64(len(s.nodes)) && s.viableForHead(n) {
+			hasChild[n.parent] = true
+		}
+	}
+	heads := make([]*Node, 0)
+	for i, n := range s.nodes {
+		if !hasChild[i] && s.viableForHead(n) {
+			heads = append(heads, copyNode(n))
+		}
+	}
+	sort.Slice(heads, func(i, j int) bool {
+		if heads[i].weight != heads[j].weight {
+			return heads[i].weight > heads[j].weight
+		}
+		return heads[i].slot > heads[j].slot
+	})
+	if k >= 0 && len(heads) > k {
+		heads = heads[:k]
+	}
+	return heads
+}
+
 // head starts from justified root and then follows the best descendant links
 // to find the best block for head.
 func (s *Store) head(ctx context.Context, justifiedRoot [32]byte) ([32]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.head")
 	defer span.End()
 
+	bestNode, err := s.bestNode(justifiedRoot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	// Update metrics.
+	if bestNode.root != lastHeadRoot {
+		headChangesCount.Inc()
+		headSlotNumber.Set(float64(bestNode.slot))
+		lastHeadRoot = bestNode.root
+	}
+
+	// Update canonical mapping given the head root.
+	if err := s.updateCanonicalNodes(ctx, bestNode.root); err != nil {
+		return [32]byte{}, err
+	}
+
+	return bestNode.root, nil
+}
+
+// bestNode starts from justified root and follows the best descendant link to the best block,
+// which has to be viable for head.
+func (s *Store) bestNode(justifiedRoot [32]byte) (*Node, error) {
 	// Justified index has to be valid in node indices map, and can not be out of bound.
 	justifiedIndex, ok := s.nodesIndices[justifiedRoot]
 	if !ok {
-		return [32]byte{}, errUnknownJustifiedRoot
+		return nil, errUnknownJustifiedRoot
 	}
 	if justifiedIndex >= uint64(len(s.nodes)) {
-		return [32]byte{}, errInvalidJustifiedIndex
+		return nil, errInvalidJustifiedIndex
 	}
 
 	justifiedNode := s.nodes[justifiedIndex]
@@ -253,29 +322,17 @@ func (s *Store) head(ctx context.Context, justifiedRoot [32]byte) ([32]byte, err
 		bestDescendantIndex = justifiedIndex
 	}
 	if bestDescendantIndex >= uint64(len(s.nodes)) {
-		return [32]byte{}, errInvalidBestDescendantIndex
+		return nil, errInvalidBestDescendantIndex
 	}
 
 	bestNode := s.nodes[bestDescendantIndex]
 
 	if !s.viableForHead(bestNode) {
-		return [32]byte{}, fmt.Errorf("head at slot %d with weight %d is not eligible, finalizedEpoch %d != %d, justifiedEpoch %d != %d",
+		return nil, fmt.Errorf("head at slot %d with weight %d is not eligible, finalizedEpoch %d != %d, justifiedEpoch %d != %d",
 			bestNode.slot, bestNode.weight/10e9, bestNode.finalizedEpoch, s.finalizedEpoch, bestNode.justifiedEpoch, s.justifiedEpoch)
 	}
 
-	// Update metrics.
-	if bestNode.root != lastHeadRoot {
-		headChangesCount.Inc()
-		headSlotNumber.Set(float64(bestNode.slot))
-		lastHeadRoot = bestNode.root
-	}
-
-	// Update canonical mapping given the head root.
-	if err := s.updateCanonicalNodes(ctx, bestNode.root); err != nil {
-		return [32]byte{}, err
-	}
-
-	return bestNode.root, nil
+	return bestNode, nil
 }
 
 // updateCanonicalNodes updates the canonical nodes mapping given the input block root.
@@ -346,6 +403,10 @@ func (s *Store) insert(ctx context.Context,
 		bestDescendant: NonExistentNode,
 		weight:         0,
 	}
+	// A block building on top of an invalid block is invalid as well.
+	if parentIndex != NonExistentNode && s.nodes[parentIndex].invalid {
+		n.invalid = true
+	}
 
 	s.nodesIndices[root] = index
 	s.nodes = append(s.nodes, n)
@@ -364,6 +425,61 @@ func (s *Store) insert(ctx context.Context,
 	return nil
 }
 
+// invalidate marks the node of the input root and all of its descendants as invalid. As a child
+// node is always inserted after its parent, the descendants are found in a single pass over the
+// nodes following the invalid node. The best child and descendant of every node is then updated,
+// so the invalid branch is no longer considered for head.
+func (s *Store) invalidate(ctx context.Context, root [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.invalidate")
+	defer span.End()
+
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+
+	if root == s.finalizedRoot {
+		return errInvalidateFinalizedRoot
+	}
+	index, ok := s.nodesIndices[root]
+	if !ok {
+		return errUnknownNodeRoot
+	}
+	if index >= uint64(len(s.nodes)) {
+		return errInvalidNodeIndex
+	}
+
+	s.markInvalid(s.nodes[index])
+	for i := index + 1; i < uint64(len(s.nodes)); i++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		n := s.nodes[i]
+		if n.parent != NonExistentNode && n.parent >= index && s.nodes[n.parent].invalid {
+			s.markInvalid(n)
+		}
+	}
+
+	for i := len(s.nodes) - 1; i >= 0; i-- {
+		n := s.nodes[i]
+		if n.parent != NonExistentNode {
+			if err := s.updateBestChildAndDescendant(n.parent, uint64(i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// markInvalid marks a single node as invalid and removes it from the canonical nodes mapping.
+func (s *Store) markInvalid(n *Node) {
+	if n.invalid {
+		return
+	}
+	n.invalid = true
+	delete(s.canonicalNodes, n.root)
+	invalidatedNodeCount.Inc()
+}
+
 // applyWeightChanges iterates backwards through the nodes in store. It checks all nodes parent
 // and its best child. For each node, it updates the weight with input delta and
 // back propagate the nodes delta to its parents delta. After scoring changes,
@@ -542,37 +658,66 @@ func (s *Store) updateBestChildAndDescendant(parentIndex, childIndex uint64) err
 // pruned if the input finalized root are different than the one in stored and
 // the number of the nodes in store has met prune threshold.
 func (s *Store) prune(ctx context.Context, finalizedRoot [32]byte) error {
-	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.prune")
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.prune")
 	defer span.End()
 
 	s.nodesLock.Lock()
 	defer s.nodesLock.Unlock()
 
+	_, err := s.pruneBefore(finalizedRoot, s.pruneThreshold)
+	return err
+}
+
+// PruneBefore removes the nodes inserted before the given finalized root regardless of the prune
+// threshold, and returns the number of pruned nodes. This lets an operator release the memory held
+// by the store when it grew during a long period of non-finality.
+func (s *Store) PruneBefore(ctx context.Context, finalizedRoot [32]byte) (int, error) {
+	_, span := trace.StartSpan(ctx, "protoArrayForkChoice.PruneBefore")
+	defer span.End()
+
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+
+	return s.pruneBefore(finalizedRoot, 0)
+}
+
+// SetPruneThreshold sets the minimal number of nodes before the finalized root for the store to
+// be pruned upon new finalization.
+func (s *Store) SetPruneThreshold(threshold uint64) {
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+	s.pruneThreshold = threshold
+}
+
+// pruneBefore removes the nodes before the finalized root once their number meets the threshold,
+// and returns the number of pruned nodes. The caller must hold the nodes lock.
+func (s *Store) pruneBefore(finalizedRoot [32]byte, threshold uint64) (int, error) {
 	// The node would have seen finalized root or else it'd
 	// be able to prune it.
 	finalizedIndex, ok := s.nodesIndices[finalizedRoot]
 	if !ok {
-		return errUnknownFinalizedRoot
+		return 0, errUnknownFinalizedRoot
 	}
 
 	// The number of the nodes has not met the prune threshold.
 	// Pruning at small numbers incurs more cost than benefit.
-	if finalizedIndex < s.pruneThreshold {
-		return nil
+	if finalizedIndex < threshold || finalizedIndex == 0 {
+		return 0, nil
 	}
+	start := timeutils.Now()
 
 	// Remove the key/values from indices mapping on to be pruned nodes.
 	// These nodes are before the finalized index.
 	for i := uint64(0); i < finalizedIndex; i++ {
 		if int(i) >= len(s.nodes) {
-			return errInvalidNodeIndex
+			return 0, errInvalidNodeIndex
 		}
 		delete(s.nodesIndices, s.nodes[i].root)
 	}
 
 	// Finalized index can not be greater than the length of the node.
 	if int(finalizedIndex) >= len(s.nodes) {
-		return errors.New("invalid finalized index")
+		return 0, errors.New("invalid finalized index")
 	}
 	s.nodes = s.nodes[finalizedIndex:]
 
@@ -593,13 +738,13 @@ func (s *Store) prune(ctx context.Context, finalizedRoot [32]byte) error {
 		}
 		if node.bestChild != NonExistentNode {
 			if node.bestChild < finalizedIndex {
-				return errInvalidBestChildIndex
+				return 0, errInvalidBestChildIndex
 			}
 			node.bestChild -= finalizedIndex
 		}
 		if node.bestDescendant != NonExistentNode {
 			if node.bestDescendant < finalizedIndex {
-				return errInvalidBestDescendantIndex
+				return 0, errInvalidBestDescendantIndex
 			}
 			node.bestDescendant -= finalizedIndex
 		}
@@ -608,8 +753,10 @@ func (s *Store) prune(ctx context.Context, finalizedRoot [32]byte) error {
 	}
 
 	prunedCount.Inc()
+	nodeCount.Set(float64(len(s.nodes)))
+	pruneDuration.Observe(float64(timeutils.Since(start).Milliseconds()))
 
-	return nil
+	return int(finalizedIndex), nil
 }
 
 // leadsToViableHead returns true if the node or the best descendent of the node is viable for head.
@@ -637,8 +784,11 @@ func (s *Store) leadsToViableHead(node *Node) (bool, error) {
 
 // viableForHead returns true if the node is viable to head.
 // Any node with diff finalized or justified epoch than the ones in fork choice store
-// should not be viable to head.
+// should not be viable to head, nor should any node marked invalid.
 func (s *Store) viableForHead(node *Node) bool {
+	if node.invalid {
+		return false
+	}
 	// `node` is viable if its justified epoch and finalized epoch are the same as the one in `Store`.
 	// It's also viable if we are in genesis epoch.
 	justified := s.justifiedEpoch == node.justifiedEpoch || s.justifiedEpoch == 0
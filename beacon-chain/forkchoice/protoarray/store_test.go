@@ -6,6 +6,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -589,3 +590,111 @@ func TestStore_UpdateCanonicalNodes_ContextCancelled(t *testing.T) {
 	cancel()
 	require.ErrorContains(t, "context canceled", f.store.updateCanonicalNodes(ctx, [32]byte{'c'}))
 }
+
+func TestForkChoice_InvalidateBlock(t *testing.T) {
+	ctx := context.Background()
+	balances := make([]uint64, 16)
+	f := setup(1, 1)
+
+	// Build the following tree, where 2 leads to the head:
+	//            0
+	//           / \
+	//          2  1
+	//          |  |
+	//  head -> 4  3
+	require.NoError(t, f.ProcessBlock(ctx, 0, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 0, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 0, indexToHash(3), indexToHash(1), [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 0, indexToHash(4), indexToHash(2), [32]byte{}, 1, 1))
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(4), r, "Incorrect head")
+
+	// Invalidating 2 also invalidates 4, the head moves over to 3.
+	require.NoError(t, f.InvalidateBlock(ctx, indexToHash(2)))
+	assert.Equal(t, true, f.Node(indexToHash(2)).Invalid())
+	assert.Equal(t, true, f.Node(indexToHash(4)).Invalid())
+	assert.Equal(t, false, f.Node(indexToHash(1)).Invalid())
+	assert.Equal(t, false, f.IsCanonical(indexToHash(4)))
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), r, "Incorrect head after invalidation")
+
+	// A block building on an invalid block is invalid as well, and never becomes head.
+	require.NoError(t, f.ProcessBlock(ctx, 0, indexToHash(5), indexToHash(4), [32]byte{}, 1, 1))
+	assert.Equal(t, true, f.Node(indexToHash(5)).Invalid())
+	f.ProcessAttestation(ctx, []uint64{0, 1, 2}, indexToHash(5), 2)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{10, 10, 10}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), r, "Incorrect head after attesting to invalid block")
+}
+
+func TestForkChoice_InvalidateBlock_Errors(t *testing.T) {
+	f := setup(1, 1)
+	assert.ErrorContains(t, errUnknownNodeRoot.Error(), f.InvalidateBlock(context.Background(), indexToHash(1)))
+	assert.ErrorContains(t, errInvalidateFinalizedRoot.Error(), f.InvalidateBlock(context.Background(), params.BeaconConfig().ZeroHash))
+}
+
+func TestStore_PruneBefore(t *testing.T) {
+	ctx := context.Background()
+	numOfNodes := 100
+	indices := make(map[[32]byte]uint64)
+	nodes := make([]*Node, 0)
+	for i := 0; i < numOfNodes; i++ {
+		indices[indexToHash(uint64(i))] = uint64(i)
+		nodes = append(nodes, &Node{slot: types.Slot(i), root: indexToHash(uint64(i)), parent: uint64(i) - 1,
+			bestDescendant: NonExistentNode, bestChild: NonExistentNode})
+	}
+	nodes[0].parent = NonExistentNode
+	s := &Store{nodes: nodes, nodesIndices: indices}
+	s.SetPruneThreshold(100)
+	assert.Equal(t, uint64(100), s.PruneThreshold())
+
+	// Pruning upon finalization respects the threshold.
+	require.NoError(t, s.prune(ctx, indexToHash(10)))
+	assert.Equal(t, 100, len(s.nodes), "Incorrect nodes count")
+
+	// Pruning on request does not.
+	pruned, err := s.PruneBefore(ctx, indexToHash(10))
+	require.NoError(t, err)
+	assert.Equal(t, 10, pruned)
+	assert.Equal(t, 90, len(s.nodes), "Incorrect nodes count")
+	assert.Equal(t, 90, len(s.nodesIndices), "Incorrect node indices count")
+	assert.Equal(t, NonExistentNode, s.nodes[0].parent)
+	assert.Equal(t, uint64(0), s.nodes[1].parent)
+
+	pruned, err = s.PruneBefore(ctx, indexToHash(10))
+	require.NoError(t, err)
+	assert.Equal(t, 0, pruned)
+
+	_, err = s.PruneBefore(ctx, indexToHash(5))
+	assert.ErrorContains(t, errUnknownFinalizedRoot.Error(), err)
+}
+
+func TestStore_ViableHeads(t *testing.T) {
+	// Tree of nodes:
+	//   0 <- 1 <- 2
+	//     <- 3 <- 4 (invalid)
+	//     <- 5
+	nodes := []*Node{
+		{slot: 0, root: indexToHash(0), parent: NonExistentNode, weight: 30},
+		{slot: 1, root: indexToHash(1), parent: 0, weight: 10},
+		{slot: 2, root: indexToHash(2), parent: 1, weight: 10},
+		{slot: 3, root: indexToHash(3), parent: 0, weight: 10},
+		{slot: 4, root: indexToHash(4), parent: 3, weight: 20, invalid: true},
+		{slot: 5, root: indexToHash(5), parent: 0, weight: 10},
+	}
+	s := &Store{nodes: nodes}
+
+	heads := s.ViableHeads(-1)
+	require.Equal(t, 3, len(heads))
+	assert.Equal(t, indexToHash(5), heads[0].Root(), "Equal weights should be ordered by slot")
+	assert.Equal(t, indexToHash(3), heads[1].Root(), "Parent of an invalid node should be a head")
+	assert.Equal(t, indexToHash(2), heads[2].Root())
+
+	nodes[2].weight = 15
+	heads = s.ViableHeads(1)
+	require.Equal(t, 1, len(heads))
+	assert.Equal(t, indexToHash(2), heads[0].Root())
+	assert.Equal(t, 0, len(s.ViableHeads(0)))
+}
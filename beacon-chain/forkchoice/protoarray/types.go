@@ -16,14 +16,18 @@ type ForkChoice struct {
 
 // Store defines the fork choice store which includes block nodes and the last view of checkpoint information.
 type Store struct {
-	pruneThreshold uint64              // do not prune tree unless threshold is reached.
-	justifiedEpoch types.Epoch         // latest justified epoch in store.
-	finalizedEpoch types.Epoch         // latest finalized epoch in store.
-	finalizedRoot  [32]byte            // latest finalized root in store.
-	nodes          []*Node             // list of block nodes, each node is a representation of one block.
-	nodesIndices   map[[32]byte]uint64 // the root of block node and the nodes index in the list.
-	canonicalNodes map[[32]byte]bool   // the canonical block nodes.
-	nodesLock      sync.RWMutex
+	pruneThreshold             uint64              // do not prune tree unless threshold is reached.
+	justifiedEpoch             types.Epoch         // latest justified epoch in store.
+	finalizedEpoch             types.Epoch         // latest finalized epoch in store.
+	finalizedRoot              [32]byte            // latest finalized root in store.
+	nodes                      []*Node             // list of block nodes, each node is a representation of one block.
+	nodesIndices               map[[32]byte]uint64 // the root of block node and the nodes index in the list.
+	canonicalNodes             map[[32]byte]bool   // the canonical block nodes.
+	nodesLock                  sync.RWMutex
+	proposerBoostRoot          [32]byte // the timely block root of the current slot to boost.
+	previousProposerBoostRoot  [32]byte // the block root boosted in the last head computation.
+	previousProposerBoostScore uint64   // the boost applied in the last head computation.
+	proposerBoostLock          sync.Mutex
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
@@ -38,6 +42,7 @@ type Node struct {
 	bestChild      uint64      // bestChild index of this node.
 	bestDescendant uint64      // bestDescendant of this node.
 	graffiti       [32]byte    // graffiti of the block node.
+	invalid        bool        // invalid is true if the block or one of its ancestors failed verification.
 }
 
 // Vote defines an individual validator's vote.
@@ -0,0 +1,270 @@
+package apiv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
+)
+
+// The JSON of the standard API names fields as the protobuf definitions of the eth/v1 API do, and
+// encodes integers as decimal strings, byte arrays as 0x-prefixed hex strings and enums as lower
+// case strings, which neither encoding/json nor jsonpb do with the generated types. Times are unix
+// times in seconds.
+
+var timestampType = reflect.TypeOf(&ptypes.Timestamp{})
+
+// protoField returns the protobuf name of a field of a generated message, along with the name of
+// its enum type if it is an enum. Fields without a protobuf tag, such as the XXX_ fields, are not
+// part of the message.
+func protoField(f reflect.StructField) (name, enum string, ok bool) {
+	tag := f.Tag.Get("protobuf")
+	if tag == "" {
+		return "", "", false
+	}
+	for _, opt := range strings.Split(tag, ",") {
+		if strings.HasPrefix(opt, "name=") {
+			name = strings.TrimPrefix(opt, "name=")
+		}
+		if strings.HasPrefix(opt, "enum=") {
+			enum = strings.TrimPrefix(opt, "enum=")
+		}
+	}
+	return name, enum, name != ""
+}
+
+// encodeValue converts a value of a generated message into its standard API JSON value.
+func encodeValue(v reflect.Value, enum string) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type() == timestampType {
+			return strconv.FormatInt(v.Interface().(*ptypes.Timestamp).Seconds, 10)
+		}
+		return encodeValue(v.Elem(), enum)
+	case reflect.Struct:
+		obj := make(map[string]interface{})
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name, fieldEnum, ok := protoField(t.Field(i))
+			if !ok {
+				continue
+			}
+			obj[name] = encodeValue(v.Field(i), fieldEnum)
+		}
+		return obj
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return hexutil.Encode(v.Bytes())
+		}
+		arr := make([]interface{}, v.Len())
+		for i := range arr {
+			arr[i] = encodeValue(v.Index(i), enum)
+		}
+		return arr
+	case reflect.Map:
+		obj := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			obj[fmt.Sprint(iter.Key().Interface())] = encodeValue(iter.Value(), enum)
+		}
+		return obj
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if stringer, ok := v.Interface().(fmt.Stringer); ok && enum != "" {
+			return strings.ToLower(stringer.String())
+		}
+		return strconv.FormatInt(v.Int(), 10)
+	default:
+		return v.Interface()
+	}
+}
+
+// decodeValue sets a value of a generated message from its standard API JSON value, decoded with
+// numbers as json.Number.
+func decodeValue(data interface{}, v reflect.Value, enum string) error {
+	if data == nil {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		if v.Type() == timestampType {
+			seconds, err := strconv.ParseInt(numberString(data), 10, 64)
+			if err != nil {
+				return err
+			}
+			v.Interface().(*ptypes.Timestamp).Seconds = seconds
+			return nil
+		}
+		return decodeValue(data, v.Elem(), enum)
+	case reflect.Struct:
+		obj, ok := data.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected an object, got %v", data)
+		}
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name, fieldEnum, ok := protoField(t.Field(i))
+			if !ok {
+				continue
+			}
+			if err := decodeValue(obj[name], v.Field(i), fieldEnum); err != nil {
+				return fmt.Errorf("%s: %v", name, err)
+			}
+		}
+		return nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			s, ok := data.(string)
+			if !ok {
+				return fmt.Errorf("expected a hex string, got %v", data)
+			}
+			b, err := hexutil.Decode(s)
+			if err != nil {
+				return err
+			}
+			v.SetBytes(b)
+			return nil
+		}
+		arr, ok := data.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected an array, got %v", data)
+		}
+		v.Set(reflect.MakeSlice(v.Type(), len(arr), len(arr)))
+		for i, elem := range arr {
+			if err := decodeValue(elem, v.Index(i), enum); err != nil {
+				return fmt.Errorf("%d: %v", i, err)
+			}
+		}
+		return nil
+	case reflect.Map:
+		obj, ok := data.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected an object, got %v", data)
+		}
+		v.Set(reflect.MakeMapWithSize(v.Type(), len(obj)))
+		for k, elem := range obj {
+			val := reflect.New(v.Type().Elem()).Elem()
+			if err := decodeValue(elem, val, enum); err != nil {
+				return fmt.Errorf("%s: %v", k, err)
+			}
+			v.SetMapIndex(reflect.ValueOf(k).Convert(v.Type().Key()), val)
+		}
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(numberString(data), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s := numberString(data)
+		if enum != "" {
+			n, ok := proto.EnumValueMap(enum)[strings.ToUpper(s)]
+			if !ok {
+				return fmt.Errorf("unknown value %q", s)
+			}
+			v.SetInt(int64(n))
+			return nil
+		}
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Bool:
+		switch b := data.(type) {
+		case bool:
+			v.SetBool(b)
+			return nil
+		case string:
+			parsed, err := strconv.ParseBool(b)
+			if err != nil {
+				return err
+			}
+			v.SetBool(parsed)
+			return nil
+		default:
+			return fmt.Errorf("expected a boolean, got %v", data)
+		}
+	case reflect.String:
+		s, ok := data.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %v", data)
+		}
+		v.SetString(s)
+		return nil
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+func numberString(data interface{}) string {
+	switch n := data.(type) {
+	case string:
+		return n
+	case json.Number:
+		return n.String()
+	default:
+		return fmt.Sprint(data)
+	}
+}
+
+// setParam sets the field of a request from a path or query parameter. Repeated fields take a comma
+// separated list of values, and a value of a byte array field is the hex decoding of a 0x-prefixed
+// value, the text itself otherwise, so that identifiers such as a state id of "head" or of a state
+// root reach the server as the gRPC API expects them.
+func setParam(req reflect.Value, name, value string) error {
+	t := req.Type()
+	for i := 0; i < t.NumField(); i++ {
+		fieldName, enum, ok := protoField(t.Field(i))
+		if !ok || fieldName != name {
+			continue
+		}
+		field := req.Field(i)
+		if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+			for _, part := range strings.Split(value, ",") {
+				elem := reflect.New(field.Type().Elem()).Elem()
+				if err := setText(elem, strings.TrimSpace(part), enum); err != nil {
+					return fmt.Errorf("invalid %s: %v", name, err)
+				}
+				field.Set(reflect.Append(field, elem))
+			}
+			return nil
+		}
+		if err := setText(field, value, enum); err != nil {
+			return fmt.Errorf("invalid %s: %v", name, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown parameter %s", name)
+}
+
+func setText(v reflect.Value, s, enum string) error {
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+		if !strings.HasPrefix(s, "0x") {
+			v.SetBytes([]byte(s))
+			return nil
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return err
+		}
+		v.SetBytes(b)
+		return nil
+	}
+	return decodeValue(s, v, enum)
+}
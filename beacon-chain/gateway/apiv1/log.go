@@ -0,0 +1,5 @@
+package apiv1
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "gateway/apiv1")
@@ -0,0 +1,119 @@
+package apiv1
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+)
+
+// route maps an endpoint of the standard API to a method of an eth/v1 gRPC client.
+type route struct {
+	method   string
+	segments []string
+	// rpc is a method of a gRPC client, of the form
+	// func(context.Context, *Request, ...grpc.CallOption) (*Response, error).
+	rpc interface{}
+	// body is the field of the request a JSON body is the value of, the body being the whole
+	// request otherwise.
+	body string
+	// encodeSSZ encodes the response of an endpoint which can respond with SSZ.
+	encodeSSZ func(resp interface{}) ([]byte, error)
+	// decodeSSZ decodes the body of an endpoint which accepts SSZ into its request.
+	decodeSSZ func(body []byte, req interface{}) error
+}
+
+func newRoute(method, path string, rpc interface{}) *route {
+	return &route{
+		method:   method,
+		segments: strings.Split(strings.Trim(path, "/"), "/"),
+		rpc:      rpc,
+	}
+}
+
+// routes lists the endpoints of the standard API, as annotated in the eth/v1 service definitions.
+// An endpoint which the beacon node does not implement responds with the Not Implemented status of
+// its gRPC method.
+func routes(
+	beacon ethpb.BeaconChainClient,
+	node ethpb.BeaconNodeClient,
+	validator ethpb.BeaconValidatorClient,
+) []*route {
+	getBlock := newRoute(http.MethodGet, "/eth/v1/beacon/blocks/{block_id}", beacon.GetBlock)
+	getBlock.encodeSSZ = encodeBlockSSZ
+	submitBlock := newRoute(http.MethodPost, "/eth/v1/beacon/blocks", beacon.SubmitBlock)
+	submitBlock.decodeSSZ = decodeBlockSSZ
+	submitAttestations := newRoute(http.MethodPost, "/eth/v1/beacon/pool/attestations", beacon.SubmitAttestations)
+	submitAttestations.body = "data"
+	submitAggregates := newRoute(http.MethodPost, "/eth/v1/validator/aggregate_and_proofs", validator.SubmitAggregateAndProofs)
+	submitAggregates.body = "data"
+	subscribe := newRoute(http.MethodPost, "/eth/v1/validator/beacon_committee_subscriptions", validator.SubmitBeaconCommitteeSubscription)
+	subscribe.body = "data"
+
+	return []*route{
+		newRoute(http.MethodGet, "/eth/v1/beacon/genesis", beacon.GetGenesis),
+		newRoute(http.MethodGet, "/eth/v1/beacon/states/{state_id}/root", beacon.GetStateRoot),
+		newRoute(http.MethodGet, "/eth/v1/beacon/states/{state_id}/fork", beacon.GetStateFork),
+		newRoute(http.MethodGet, "/eth/v1/beacon/states/{state_id}/finality_checkpoints", beacon.GetFinalityCheckpoints),
+		newRoute(http.MethodGet, "/eth/v1/beacon/states/{state_id}/validators", beacon.ListValidators),
+		newRoute(http.MethodGet, "/eth/v1/beacon/states/{state_id}/validators/{validator_id}", beacon.GetValidator),
+		newRoute(http.MethodGet, "/eth/v1/beacon/states/{state_id}/validator_balances", beacon.ListValidatorBalances),
+		newRoute(http.MethodGet, "/eth/v1/beacon/states/{state_id}/committees/{epoch}", beacon.ListCommittees),
+		newRoute(http.MethodGet, "/eth/v1/beacon/headers", beacon.ListBlockHeaders),
+		newRoute(http.MethodGet, "/eth/v1/beacon/headers/{block_id}", beacon.GetBlockHeader),
+		submitBlock,
+		getBlock,
+		newRoute(http.MethodGet, "/eth/v1/beacon/blocks/{block_id}/root", beacon.GetBlockRoot),
+		newRoute(http.MethodGet, "/eth/v1/beacon/blocks/{block_id}/attestations", beacon.ListBlockAttestations),
+		newRoute(http.MethodGet, "/eth/v1/beacon/pool/attestations", beacon.ListPoolAttestations),
+		submitAttestations,
+		newRoute(http.MethodGet, "/eth/v1/beacon/pool/attester_slashings", beacon.ListPoolAttesterSlashings),
+		newRoute(http.MethodPost, "/eth/v1/beacon/pool/attester_slashings", beacon.SubmitAttesterSlashing),
+		newRoute(http.MethodGet, "/eth/v1/beacon/pool/proposer_slashings", beacon.ListPoolProposerSlashings),
+		newRoute(http.MethodPost, "/eth/v1/beacon/pool/proposer_slashings", beacon.SubmitProposerSlashing),
+		newRoute(http.MethodGet, "/eth/v1/beacon/pool/voluntary_exits", beacon.ListPoolVoluntaryExits),
+		newRoute(http.MethodPost, "/eth/v1/beacon/pool/voluntary_exits", beacon.SubmitVoluntaryExit),
+
+		newRoute(http.MethodGet, "/eth/v1/config/fork_schedule", beacon.GetForkSchedule),
+		newRoute(http.MethodGet, "/eth/v1/config/spec", beacon.GetSpec),
+		newRoute(http.MethodGet, "/eth/v1/config/deposit_contract", beacon.GetDepositContract),
+
+		newRoute(http.MethodGet, "/eth/v1/node/identity", node.GetIdentity),
+		newRoute(http.MethodGet, "/eth/v1/node/peers", node.ListPeers),
+		newRoute(http.MethodGet, "/eth/v1/node/peers/{peer_id}", node.GetPeer),
+		newRoute(http.MethodGet, "/eth/v1/node/peer_count", node.PeerCount),
+		newRoute(http.MethodGet, "/eth/v1/node/version", node.GetVersion),
+		newRoute(http.MethodGet, "/eth/v1/node/syncing", node.GetSyncStatus),
+		newRoute(http.MethodGet, "/eth/v1/node/health", node.GetHealth),
+
+		newRoute(http.MethodGet, "/eth/v1/validator/duties/attester/{epoch}", validator.GetAttesterDuties),
+		newRoute(http.MethodGet, "/eth/v1/validator/duties/proposer/{epoch}", validator.GetProposerDuties),
+		newRoute(http.MethodGet, "/eth/v1/validator/blocks/{slot}", validator.GetBlock),
+		newRoute(http.MethodGet, "/eth/v1/validator/attestation_data", validator.GetAttestationData),
+		newRoute(http.MethodGet, "/eth/v1/validator/aggregate_attestation", validator.GetAggregateAttestation),
+		submitAggregates,
+		subscribe,
+	}
+}
+
+// encodeBlockSSZ encodes the signed block of a block response.
+func encodeBlockSSZ(resp interface{}) ([]byte, error) {
+	data := resp.(*ethpb.BlockResponse).Data
+	if data == nil {
+		return nil, errors.New("no block in response")
+	}
+	blk := &ethpb.SignedBeaconBlock{Block: data.Message, Signature: data.Signature}
+	return blk.MarshalSSZ()
+}
+
+// decodeBlockSSZ decodes a signed block into a block submission.
+func decodeBlockSSZ(body []byte, req interface{}) error {
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(body); err != nil {
+		return err
+	}
+	container := req.(*ethpb.BeaconBlockContainer)
+	container.Message, container.Signature = blk.Block, blk.Signature
+	return nil
+}
@@ -0,0 +1,235 @@
+// Package apiv1 serves the standard Eth2 beacon node REST API, https://ethereum.github.io/eth2.0-APIs/,
+// over HTTP by calling the eth/v1 gRPC services of a beacon node.
+package apiv1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+
+	ptypes "github.com/gogo/protobuf/types"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	jsonMediaType = "application/json"
+	sszMediaType  = "application/octet-stream"
+	// maxBodySize bounds the size of a request body, a batch of attestations being the largest
+	// request of the API.
+	maxBodySize = 1 << 24
+)
+
+// Server is an http.Handler serving the standard API from the eth/v1 gRPC services of a beacon node.
+type Server struct {
+	routes []*route
+}
+
+// NewServer returns the standard API server of the beacon node of the gRPC connection.
+func NewServer(conn *grpc.ClientConn) *Server {
+	return &Server{
+		routes: routes(
+			ethpb.NewBeaconChainClient(conn),
+			ethpb.NewBeaconNodeClient(conn),
+			ethpb.NewBeaconValidatorClient(conn),
+		),
+	}
+}
+
+type errorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ServeHTTP serves a request of the standard API. A response is JSON, unless the client accepts SSZ
+// only or ahead of JSON and the endpoint supports it. A request body is JSON, or SSZ where an
+// endpoint supports it.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rt, params, err := s.match(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// The media type of the response is negotiated before the gRPC call, so that a request which
+	// cannot be answered has no effect on the beacon node.
+	ssz, err := acceptsSSZ(r.Header.Get("Accept"), rt.encodeSSZ != nil)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	fn := reflect.ValueOf(rt.rpc)
+	req := reflect.New(fn.Type().In(1).Elem())
+	if err := rt.decodeRequest(r, req, params); err != nil {
+		writeError(w, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+	out := fn.Call([]reflect.Value{reflect.ValueOf(r.Context()), req})
+	if errOut := out[1].Interface(); errOut != nil {
+		writeError(w, errOut.(error))
+		return
+	}
+	resp := out[0].Interface()
+	if ssz {
+		enc, err := rt.encodeSSZ(resp)
+		if err != nil {
+			writeError(w, status.Errorf(codes.Internal, "Could not encode response to SSZ: %v", err))
+			return
+		}
+		w.Header().Set("Content-Type", sszMediaType)
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(enc); err != nil {
+			log.WithError(err).Debug("Could not write response")
+		}
+		return
+	}
+	if _, ok := resp.(*ptypes.Empty); ok {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	writeJSON(w, http.StatusOK, encodeValue(reflect.ValueOf(resp), ""))
+}
+
+// match returns the route of a request along with the values of the parameters of its path.
+func (s *Server) match(r *http.Request) (*route, map[string]string, error) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	methodMismatch := false
+	for _, rt := range s.routes {
+		params, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			methodMismatch = true
+			continue
+		}
+		return rt, params, nil
+	}
+	if methodMismatch {
+		return nil, nil, &httpError{code: http.StatusMethodNotAllowed, message: "Method not allowed"}
+	}
+	return nil, nil, &httpError{code: http.StatusNotFound, message: "Unknown endpoint " + r.URL.Path}
+}
+
+func (rt *route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, seg := range rt.segments {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			params[strings.Trim(seg, "{}")] = segments[i]
+			continue
+		}
+		if seg != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// decodeRequest sets the request of the gRPC method of a route from the path parameters, the query
+// and the body of an HTTP request.
+func (rt *route) decodeRequest(r *http.Request, req reflect.Value, params map[string]string) error {
+	for name, value := range params {
+		if err := setParam(req.Elem(), name, value); err != nil {
+			return err
+		}
+	}
+	for name, values := range r.URL.Query() {
+		for _, value := range values {
+			if err := setParam(req.Elem(), name, value); err != nil {
+				return err
+			}
+		}
+	}
+	if r.Method != http.MethodPost {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(nil, r.Body, maxBodySize))
+	if err != nil {
+		return fmt.Errorf("could not read body: %v", err)
+	}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err == nil && mediaType == sszMediaType {
+		if rt.decodeSSZ == nil {
+			return fmt.Errorf("endpoint does not accept SSZ")
+		}
+		return rt.decodeSSZ(body, req.Interface())
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var data interface{}
+	if err := dec.Decode(&data); err != nil {
+		return fmt.Errorf("could not decode body: %v", err)
+	}
+	if rt.body != "" {
+		data = map[string]interface{}{rt.body: data}
+	}
+	return decodeValue(data, req, "")
+}
+
+// acceptsSSZ returns whether a response should be SSZ, given the Accept header of the request,
+// the first of the media types listed which the endpoint can respond with being the one chosen.
+func acceptsSSZ(accept string, sszSupported bool) (bool, error) {
+	if accept == "" {
+		return false, nil
+	}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case jsonMediaType, "*/*", "application/*":
+			return false, nil
+		case sszMediaType:
+			if sszSupported {
+				return true, nil
+			}
+		}
+	}
+	return false, &httpError{
+		code:    http.StatusNotAcceptable,
+		message: "Cannot respond with any of the accepted media types " + accept,
+	}
+}
+
+// httpError is an error of the HTTP layer rather than of a gRPC call.
+type httpError struct {
+	code    int
+	message string
+}
+
+func (e *httpError) Error() string {
+	return e.message
+}
+
+// writeError responds with the HTTP status of an error, that of its gRPC code unless it is an
+// httpError.
+func writeError(w http.ResponseWriter, err error) {
+	resp := &errorResponse{}
+	if e, ok := err.(*httpError); ok {
+		resp.Code, resp.Message = e.code, e.message
+	} else {
+		st := status.Convert(err)
+		resp.Code, resp.Message = gwruntime.HTTPStatusFromCode(st.Code()), st.Message()
+	}
+	writeJSON(w, resp.Code, resp)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", jsonMediaType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Debug("Could not write response")
+	}
+}
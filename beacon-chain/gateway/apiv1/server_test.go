@@ -0,0 +1,250 @@
+package apiv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockBeaconClient struct {
+	ethpb.BeaconChainClient
+	block        *ethpb.SignedBeaconBlock
+	submitted    *ethpb.BeaconBlockContainer
+	attestations []*ethpb.Attestation
+	stateID      []byte
+	headers      *ethpb.BlockHeadersRequest
+}
+
+func (m *mockBeaconClient) GetGenesis(context.Context, *ptypes.Empty, ...grpc.CallOption) (*ethpb.GenesisResponse, error) {
+	return &ethpb.GenesisResponse{
+		Data: &ethpb.GenesisResponse_Genesis{
+			GenesisTime:           &ptypes.Timestamp{Seconds: 1606824023},
+			GenesisValidatorsRoot: bytesutil.PadTo([]byte{0xab}, 32),
+			GenesisForkVersion:    []byte{0, 0, 0, 1},
+		},
+	}, nil
+}
+
+func (m *mockBeaconClient) GetStateRoot(_ context.Context, req *ethpb.StateRequest, _ ...grpc.CallOption) (*ethpb.StateRootResponse, error) {
+	m.stateID = req.StateId
+	return &ethpb.StateRootResponse{Data: &ethpb.StateRootResponse_StateRoot{StateRoot: make([]byte, 32)}}, nil
+}
+
+func (m *mockBeaconClient) ListBlockHeaders(_ context.Context, req *ethpb.BlockHeadersRequest, _ ...grpc.CallOption) (*ethpb.BlockHeadersResponse, error) {
+	m.headers = req
+	return &ethpb.BlockHeadersResponse{}, nil
+}
+
+func (m *mockBeaconClient) GetBlock(context.Context, *ethpb.BlockRequest, ...grpc.CallOption) (*ethpb.BlockResponse, error) {
+	return &ethpb.BlockResponse{
+		Data: &ethpb.BeaconBlockContainer{Message: m.block.Block, Signature: m.block.Signature},
+	}, nil
+}
+
+func (m *mockBeaconClient) SubmitBlock(_ context.Context, req *ethpb.BeaconBlockContainer, _ ...grpc.CallOption) (*ptypes.Empty, error) {
+	m.submitted = req
+	return &ptypes.Empty{}, nil
+}
+
+func (m *mockBeaconClient) SubmitAttestations(_ context.Context, req *ethpb.SubmitAttestationsRequest, _ ...grpc.CallOption) (*ptypes.Empty, error) {
+	m.attestations = req.Data
+	return &ptypes.Empty{}, nil
+}
+
+type mockNodeClient struct {
+	ethpb.BeaconNodeClient
+	peers *ethpb.PeersRequest
+}
+
+func (m *mockNodeClient) ListPeers(_ context.Context, req *ethpb.PeersRequest, _ ...grpc.CallOption) (*ethpb.PeersResponse, error) {
+	m.peers = req
+	return &ethpb.PeersResponse{
+		Data: []*ethpb.Peer{{PeerId: "peer", State: ethpb.ConnectionState_CONNECTED, Direction: ethpb.PeerDirection_INBOUND}},
+	}, nil
+}
+
+func (m *mockNodeClient) GetHealth(context.Context, *ptypes.Empty, ...grpc.CallOption) (*ptypes.Empty, error) {
+	return nil, status.Error(codes.Unavailable, "Syncing")
+}
+
+type mockValidatorClient struct {
+	ethpb.BeaconValidatorClient
+}
+
+func (m *mockValidatorClient) GetAttesterDuties(context.Context, *ethpb.AttesterDutiesRequest, ...grpc.CallOption) (*ethpb.AttesterDutiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown service ethereum.eth.v1.BeaconValidator")
+}
+
+func testServer() (*Server, *mockBeaconClient, *mockNodeClient) {
+	beacon := &mockBeaconClient{block: testutil.HydrateV1SignedBeaconBlock(&ethpb.SignedBeaconBlock{})}
+	beacon.block.Block.Slot = 7
+	node := &mockNodeClient{}
+	return &Server{routes: routes(beacon, node, &mockValidatorClient{})}, beacon, node
+}
+
+func serve(s *Server, method, target string, body []byte, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestServer_JSON(t *testing.T) {
+	s, _, node := testServer()
+
+	rec := serve(s, http.MethodGet, "/eth/v1/beacon/genesis", nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var genesis map[string]map[string]string
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &genesis))
+	assert.DeepEqual(t, map[string]string{
+		"genesis_time":            "1606824023",
+		"genesis_validators_root": "0xab00000000000000000000000000000000000000000000000000000000000000",
+		"genesis_fork_version":    "0x00000001",
+	}, genesis["data"])
+
+	rec = serve(s, http.MethodGet, "/eth/v1/node/peers?state=connected,connecting&direction=inbound", nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.DeepEqual(t, []string{"connected", "connecting"}, node.peers.State)
+	assert.DeepEqual(t, []string{"inbound"}, node.peers.Direction)
+	var peers map[string][]map[string]string
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &peers))
+	assert.DeepEqual(t, []map[string]string{{
+		"peer_id":   "peer",
+		"enr":       "",
+		"address":   "",
+		"state":     "connected",
+		"direction": "inbound",
+	}}, peers["data"])
+}
+
+func TestServer_Params(t *testing.T) {
+	s, beacon, _ := testServer()
+
+	rec := serve(s, http.MethodGet, "/eth/v1/beacon/states/head/root", nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.DeepEqual(t, []byte("head"), beacon.stateID)
+
+	// A root is passed to the gRPC API as bytes.
+	root := bytesutil.PadTo([]byte{0xcd}, 32)
+	rec = serve(s, http.MethodGet, "/eth/v1/beacon/states/0xcd00000000000000000000000000000000000000000000000000000000000000/root", nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.DeepEqual(t, root, beacon.stateID)
+
+	rec = serve(s, http.MethodGet, "/eth/v1/beacon/headers?slot=5&parent_root=0xcd00000000000000000000000000000000000000000000000000000000000000", nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, uint64(5), uint64(beacon.headers.Slot))
+	assert.DeepEqual(t, root, beacon.headers.ParentRoot)
+
+	rec = serve(s, http.MethodGet, "/eth/v1/beacon/headers?slot=five", nil, nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = serve(s, http.MethodGet, "/eth/v1/beacon/headers?epoch=5", nil, nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, true, strings.Contains(rec.Body.String(), "unknown parameter epoch"), rec.Body.String())
+}
+
+func TestServer_Block(t *testing.T) {
+	s, beacon, _ := testServer()
+	wanted, err := beacon.block.MarshalSSZ()
+	require.NoError(t, err)
+
+	rec := serve(s, http.MethodGet, "/eth/v1/beacon/blocks/head", nil, map[string]string{"Accept": "application/octet-stream"})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+	assert.DeepEqual(t, wanted, rec.Body.Bytes())
+
+	rec = serve(s, http.MethodPost, "/eth/v1/beacon/blocks", wanted, map[string]string{"Content-Type": "application/octet-stream"})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.DeepEqual(t, beacon.block.Block, beacon.submitted.Message)
+	assert.DeepEqual(t, beacon.block.Signature, beacon.submitted.Signature)
+
+	// The JSON of a block is accepted back as a submission.
+	rec = serve(s, http.MethodGet, "/eth/v1/beacon/blocks/head", nil, map[string]string{"Accept": "application/json, application/octet-stream"})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var resp map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, true, strings.Contains(string(resp["data"]), `"slot":"7"`), string(resp["data"]))
+	beacon.submitted = nil
+	rec = serve(s, http.MethodPost, "/eth/v1/beacon/blocks", resp["data"], nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.DeepEqual(t, beacon.block.Block, beacon.submitted.Message)
+	assert.DeepEqual(t, beacon.block.Signature, beacon.submitted.Signature)
+}
+
+func TestServer_SubmitAttestations(t *testing.T) {
+	s, beacon, _ := testServer()
+	body := `[{
+		"aggregation_bits": "0x03",
+		"data": {
+			"slot": "3",
+			"committee_index": "1",
+			"beacon_block_root": "0xcd00000000000000000000000000000000000000000000000000000000000000",
+			"source": {"epoch": "0", "root": "0x0000000000000000000000000000000000000000000000000000000000000000"},
+			"target": {"epoch": 1, "root": "0x0000000000000000000000000000000000000000000000000000000000000000"}
+		},
+		"signature": "0x01"
+	}]`
+	rec := serve(s, http.MethodPost, "/eth/v1/beacon/pool/attestations", []byte(body), nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, 1, len(beacon.attestations))
+	att := beacon.attestations[0]
+	assert.DeepEqual(t, []byte{0x03}, []byte(att.AggregationBits))
+	assert.Equal(t, uint64(3), uint64(att.Data.Slot))
+	assert.Equal(t, uint64(1), uint64(att.Data.CommitteeIndex))
+	assert.Equal(t, uint64(1), uint64(att.Data.Target.Epoch))
+	assert.DeepEqual(t, []byte{0x01}, att.Signature)
+
+	rec = serve(s, http.MethodPost, "/eth/v1/beacon/pool/attestations", []byte(`[{"data": {"slot": "-1"}}]`), nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// A request which cannot be answered in an accepted media type is not submitted.
+	beacon.attestations = nil
+	rec = serve(s, http.MethodPost, "/eth/v1/beacon/pool/attestations", []byte(body), map[string]string{"Accept": sszMediaType})
+	assert.Equal(t, http.StatusNotAcceptable, rec.Code)
+	assert.Equal(t, 0, len(beacon.attestations))
+}
+
+func TestServer_Errors(t *testing.T) {
+	s, _, _ := testServer()
+	tests := []struct {
+		name   string
+		method string
+		target string
+		accept string
+		code   int
+	}{
+		{name: "unknown endpoint", method: http.MethodGet, target: "/eth/v1/beacon/unknown", code: http.StatusNotFound},
+		{name: "wrong method", method: http.MethodPost, target: "/eth/v1/beacon/genesis", code: http.StatusMethodNotAllowed},
+		{name: "no SSZ", method: http.MethodGet, target: "/eth/v1/beacon/genesis", accept: "application/octet-stream", code: http.StatusNotAcceptable},
+		{name: "gRPC error", method: http.MethodGet, target: "/eth/v1/node/health", code: http.StatusServiceUnavailable},
+		{name: "unimplemented", method: http.MethodGet, target: "/eth/v1/validator/duties/attester/1", code: http.StatusNotImplemented},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(s, tt.method, tt.target, nil, map[string]string{"Accept": tt.accept})
+			assert.Equal(t, tt.code, rec.Code)
+			resp := &errorResponse{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+			assert.Equal(t, tt.code, resp.Code)
+			assert.NotEqual(t, "", resp.Message)
+		})
+	}
+}
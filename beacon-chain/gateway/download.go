@@ -0,0 +1,119 @@
+package gateway
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	types "github.com/prysmaticlabs/eth2-types"
+	rpcpb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// handleDownloads serves the block and state downloads of the Debug service over HTTP.
+func handleDownloads(mux *http.ServeMux, conn *grpc.ClientConn) {
+	client := rpcpb.NewDebugClient(conn)
+	mux.Handle("/eth/v1alpha1/debug/download/block", downloadHandler(
+		func(r *http.Request, req *rpcpb.SSZDownloadRequest) (downloadStream, error) {
+			return client.DownloadBlock(r.Context(), req)
+		},
+	))
+	mux.Handle("/eth/v1alpha1/debug/download/state", downloadHandler(
+		func(r *http.Request, req *rpcpb.SSZDownloadRequest) (downloadStream, error) {
+			return client.DownloadBeaconState(r.Context(), req)
+		},
+	))
+}
+
+// downloadStream is the client stream of a download of the Debug service.
+type downloadStream interface {
+	Recv() (*rpcpb.SSZChunk, error)
+}
+
+// downloadHandler serves a download of the Debug service as raw bytes, which the gRPC gateway cannot
+// do with a stream. The download is selected by a slot or a block_root query parameter, and is
+// compressed with the snappy framing format if the snappy query parameter is true.
+func downloadHandler(download func(*http.Request, *rpcpb.SSZDownloadRequest) (downloadStream, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		req, err := downloadRequest(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		stream, err := download(r, req)
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+		// The first chunk carries the size of the download, and the error of a failed download.
+		chunk, err := stream.Recv()
+		if err != nil {
+			writeStatusError(w, err)
+			return
+		}
+		contentType := "application/octet-stream"
+		if req.Snappy {
+			contentType = "application/x-snappy-framed"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Length", strconv.FormatUint(chunk.TotalSize, 10))
+		w.WriteHeader(http.StatusOK)
+		for {
+			if _, err := w.Write(chunk.Data); err != nil {
+				log.WithError(err).Debug("Could not write download")
+				return
+			}
+			chunk, err = stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				// The status is already sent, the client sees a response shorter than its length.
+				log.WithError(err).Error("Download failed")
+				return
+			}
+		}
+	}
+}
+
+func downloadRequest(r *http.Request) (*rpcpb.SSZDownloadRequest, error) {
+	query := r.URL.Query()
+	req := &rpcpb.SSZDownloadRequest{}
+	if s := query.Get("snappy"); s != "" {
+		snappy, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid snappy: %v", err)
+		}
+		req.Snappy = snappy
+	}
+	switch {
+	case query.Get("block_root") != "":
+		root, err := hexutil.Decode(query.Get("block_root"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid block_root: %v", err)
+		}
+		req.QueryFilter = &rpcpb.SSZDownloadRequest_BlockRoot{BlockRoot: root}
+	case query.Get("slot") != "":
+		slot, err := strconv.ParseUint(query.Get("slot"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid slot: %v", err)
+		}
+		req.QueryFilter = &rpcpb.SSZDownloadRequest_Slot{Slot: types.Slot(slot)}
+	default:
+		return nil, fmt.Errorf("need to specify either a block_root or a slot")
+	}
+	return req, nil
+}
+
+func writeStatusError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	http.Error(w, st.Message(), gwruntime.HTTPStatusFromCode(st.Code()))
+}
@@ -0,0 +1,78 @@
+package gateway
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	rpcpb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type chunkStream struct {
+	chunks []*rpcpb.SSZChunk
+	err    error
+}
+
+func (s *chunkStream) Recv() (*rpcpb.SSZChunk, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func TestDownloadHandler(t *testing.T) {
+	var req *rpcpb.SSZDownloadRequest
+	stream := &chunkStream{}
+	handler := downloadHandler(func(_ *http.Request, r *rpcpb.SSZDownloadRequest) (downloadStream, error) {
+		req = r
+		return stream, nil
+	})
+
+	stream.chunks = []*rpcpb.SSZChunk{
+		{Data: []byte{1, 2}, Offset: 0, TotalSize: 3},
+		{Data: []byte{3}, Offset: 2, TotalSize: 3},
+	}
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/eth/v1alpha1/debug/download/state?slot=7&snappy=true", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/x-snappy-framed", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "3", rec.Header().Get("Content-Length"))
+	assert.DeepEqual(t, []byte{1, 2, 3}, rec.Body.Bytes())
+	assert.DeepEqual(t, &rpcpb.SSZDownloadRequest{
+		QueryFilter: &rpcpb.SSZDownloadRequest_Slot{Slot: types.Slot(7)},
+		Snappy:      true,
+	}, req)
+
+	stream.chunks = []*rpcpb.SSZChunk{{Data: []byte{4}, TotalSize: 1}}
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/eth/v1alpha1/debug/download/block?block_root=0x0a0b", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+	assert.DeepEqual(t, []byte{4}, rec.Body.Bytes())
+	assert.DeepEqual(t, &rpcpb.SSZDownloadRequest{
+		QueryFilter: &rpcpb.SSZDownloadRequest_BlockRoot{BlockRoot: []byte{0x0a, 0x0b}},
+	}, req)
+
+	stream.err = status.Error(codes.NotFound, "Block not found")
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/eth/v1alpha1/debug/download/block?slot=1", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/eth/v1alpha1/debug/download/block", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodPost, "/eth/v1alpha1/debug/download/block?slot=1", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
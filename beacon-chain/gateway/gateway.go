@@ -11,6 +11,8 @@ import (
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1_gateway"
+	"github.com/prysmaticlabs/prysm/beacon-chain/gateway/apiv1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/gateway/graphql"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
 	"github.com/prysmaticlabs/prysm/shared"
 	"google.golang.org/grpc"
@@ -34,6 +36,7 @@ type Gateway struct {
 	allowedOrigins          []string
 	startFailure            error
 	enableDebugRPCEndpoints bool
+	enableGraphQL           bool
 	maxCallRecvMsgSize      uint64
 }
 
@@ -78,6 +81,14 @@ func (g *Gateway) Start() {
 	}
 
 	g.mux.Handle("/", gwmux)
+	if g.enableDebugRPCEndpoints {
+		handleDownloads(g.mux, conn)
+	}
+	// The standard API is served by hand, the eth/v1 API having no generated gateway.
+	g.mux.Handle("/eth/v1/", apiv1.NewServer(conn))
+	if g.enableGraphQL {
+		g.mux.Handle("/graphql", graphql.NewHandler(conn))
+	}
 
 	g.server = &http.Server{
 		Addr:    g.gatewayAddr,
@@ -130,6 +141,7 @@ func New(
 	mux *http.ServeMux,
 	allowedOrigins []string,
 	enableDebugRPCEndpoints bool,
+	enableGraphQL bool,
 	maxCallRecvMsgSize uint64,
 ) *Gateway {
 	if mux == nil {
@@ -144,6 +156,7 @@ func New(
 		mux:                     mux,
 		allowedOrigins:          allowedOrigins,
 		enableDebugRPCEndpoints: enableDebugRPCEndpoints,
+		enableGraphQL:           enableGraphQL,
 		maxCallRecvMsgSize:      maxCallRecvMsgSize,
 	}
 }
@@ -0,0 +1,227 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// maxQueryCost bounds the number of items, blocks, committees, validators and balances, a query
+// resolves. The depth and parallelism limits alone let a query fan out over the validators of
+// every block or committee it resolves.
+const maxQueryCost = 10000
+
+type resolutionKey struct{}
+
+// epochKey identifies the state validators and balances are looked up in, that of an epoch or that
+// of the head.
+type epochKey struct {
+	epoch types.Epoch
+	head  bool
+}
+
+func newEpochKey(epoch *types.Epoch) epochKey {
+	if epoch == nil {
+		return epochKey{head: true}
+	}
+	return epochKey{epoch: *epoch}
+}
+
+// resolution holds the state of resolving a single query. Its loaders batch the lookups of
+// validators and balances, so that the fields of the many items of a query are resolved with a
+// request per epoch rather than a request per item.
+type resolution struct {
+	client ethpb.BeaconChainClient
+	lock   sync.Mutex
+	cost   int
+	// resolved holds the indices of the validators resolved by the query, whose balances may be
+	// looked up.
+	resolved   map[types.ValidatorIndex]bool
+	validators map[epochKey]*loader
+	balances   map[epochKey]*loader
+}
+
+func newResolution(client ethpb.BeaconChainClient) *resolution {
+	return &resolution{
+		client:     client,
+		resolved:   make(map[types.ValidatorIndex]bool),
+		validators: make(map[epochKey]*loader),
+		balances:   make(map[epochKey]*loader),
+	}
+}
+
+// charge adds the number of items resolved to the cost of the query, failing once the cost is over
+// the limit.
+func (q *resolution) charge(n int) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.cost += n
+	if q.cost > maxQueryCost {
+		return fmt.Errorf("query resolves more than %d items", maxQueryCost)
+	}
+	return nil
+}
+
+// expectValidators registers the indices of validators the fields of resolved items are going to
+// look up in the state of an epoch, so that they are looked up in the same request.
+func (q *resolution) expectValidators(epoch *types.Epoch, indices []types.ValidatorIndex) {
+	l := q.loader(q.validators, epoch)
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for _, idx := range indices {
+		l.pending[idx] = true
+	}
+}
+
+// loadValidators looks up validators by index in the state of an epoch, or in the head state if
+// the epoch is nil. Unknown validators are left out of the result.
+func (q *resolution) loadValidators(
+	ctx context.Context,
+	indices []types.ValidatorIndex,
+	epoch *types.Epoch,
+) (map[types.ValidatorIndex]*ethpb.Validator, error) {
+	l := q.loader(q.validators, epoch)
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if fetch := l.missing(indices, l.pending); len(fetch) > 0 {
+		req := &ethpb.ListValidatorsRequest{Indices: fetch}
+		if epoch != nil {
+			req.QueryFilter = &ethpb.ListValidatorsRequest_Epoch{Epoch: *epoch}
+		}
+		for {
+			res, err := q.client.ListValidators(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range res.ValidatorList {
+				if c.Validator != nil {
+					l.validators[c.Index] = c.Validator
+				}
+			}
+			if len(res.ValidatorList) == 0 || res.NextPageToken == "" {
+				break
+			}
+			req.PageToken = res.NextPageToken
+		}
+		l.markFetched(fetch)
+	}
+	found := make(map[types.ValidatorIndex]*ethpb.Validator, len(indices))
+	for _, idx := range indices {
+		if v, ok := l.validators[idx]; ok {
+			found[idx] = v
+		}
+	}
+	q.lock.Lock()
+	for idx := range found {
+		q.resolved[idx] = true
+	}
+	q.lock.Unlock()
+	return found, nil
+}
+
+// loadBalance looks up the balance of a validator in the state of an epoch, or in the head state if
+// the epoch is nil, along with the balances of the other validators resolved by the query.
+func (q *resolution) loadBalance(ctx context.Context, idx types.ValidatorIndex, epoch *types.Epoch) (uint64, error) {
+	q.lock.Lock()
+	resolved := make(map[types.ValidatorIndex]bool, len(q.resolved))
+	for i := range q.resolved {
+		resolved[i] = true
+	}
+	q.lock.Unlock()
+
+	l := q.loader(q.balances, epoch)
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if fetch := l.missing([]types.ValidatorIndex{idx}, resolved); len(fetch) > 0 {
+		req := &ethpb.ListValidatorBalancesRequest{Indices: fetch}
+		if epoch != nil {
+			req.QueryFilter = &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: *epoch}
+		}
+		for {
+			res, err := q.client.ListValidatorBalances(ctx, req)
+			if err != nil {
+				return 0, err
+			}
+			for _, b := range res.Balances {
+				l.balances[b.Index] = b.Balance
+			}
+			if len(res.Balances) == 0 || res.NextPageToken == "" {
+				break
+			}
+			req.PageToken = res.NextPageToken
+		}
+		l.markFetched(fetch)
+	}
+	balance, ok := l.balances[idx]
+	if !ok {
+		return 0, fmt.Errorf("no balance for validator %d", idx)
+	}
+	return balance, nil
+}
+
+func (q *resolution) loader(loaders map[epochKey]*loader, epoch *types.Epoch) *loader {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	key := newEpochKey(epoch)
+	l, ok := loaders[key]
+	if !ok {
+		l = &loader{
+			pending:    make(map[types.ValidatorIndex]bool),
+			fetched:    make(map[types.ValidatorIndex]bool),
+			validators: make(map[types.ValidatorIndex]*ethpb.Validator),
+			balances:   make(map[types.ValidatorIndex]uint64),
+		}
+		loaders[key] = l
+	}
+	return l
+}
+
+// loader holds the validators or the balances of a state looked up by a query. Its lock is held
+// while looking them up, so that concurrent fields wait for the request of the first one.
+type loader struct {
+	lock       sync.Mutex
+	pending    map[types.ValidatorIndex]bool
+	fetched    map[types.ValidatorIndex]bool
+	validators map[types.ValidatorIndex]*ethpb.Validator
+	balances   map[types.ValidatorIndex]uint64
+}
+
+// missing returns the indices to look up for the given indices, in index order: none if all of
+// them were looked up already, otherwise those not looked up yet along with those expected by the
+// query. The loader lock must be held.
+func (l *loader) missing(indices []types.ValidatorIndex, expected map[types.ValidatorIndex]bool) []types.ValidatorIndex {
+	fetch := make(map[types.ValidatorIndex]bool)
+	for _, idx := range indices {
+		if !l.fetched[idx] {
+			fetch[idx] = true
+		}
+	}
+	if len(fetch) == 0 {
+		return nil
+	}
+	for idx := range expected {
+		if !l.fetched[idx] {
+			fetch[idx] = true
+		}
+	}
+	sorted := make([]types.ValidatorIndex, 0, len(fetch))
+	for idx := range fetch {
+		sorted = append(sorted, idx)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	return sorted
+}
+
+// markFetched records indices as looked up, unknown validators included. The loader lock must be
+// held.
+func (l *loader) markFetched(indices []types.ValidatorIndex) {
+	for _, idx := range indices {
+		l.fetched[idx] = true
+	}
+}
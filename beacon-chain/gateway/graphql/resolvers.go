@@ -0,0 +1,454 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ptypes "github.com/gogo/protobuf/types"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	// maxEpochRange bounds the number of epochs a blocks query spans, each epoch being a request to
+	// the beacon node.
+	maxEpochRange = 256
+	// maxValidators bounds the number of validators a validators query looks up.
+	maxValidators = 1000
+)
+
+// Long is the GraphQL scalar of a uint64. It is encoded as a decimal string, as is done by the
+// standard API, since JSON numbers above 2^53 lose precision in most clients.
+type Long uint64
+
+// ImplementsGraphQLType maps the type to the Long scalar of the schema.
+func (Long) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+// UnmarshalGraphQL decodes a Long from a query literal or variable, given as a number or a string.
+func (l *Long) UnmarshalGraphQL(input interface{}) error {
+	switch v := input.(type) {
+	case int32:
+		if v < 0 {
+			return fmt.Errorf("negative value %d", v)
+		}
+		*l = Long(v)
+	case float64:
+		if v < 0 || v != float64(uint64(v)) {
+			return fmt.Errorf("invalid value %v", v)
+		}
+		*l = Long(v)
+	case string:
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*l = Long(n)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// MarshalJSON encodes a Long as a decimal string.
+func (l Long) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(l), 10))
+}
+
+// resolver resolves the queries of the schema with the beacon chain service of a beacon node.
+type resolver struct {
+	client ethpb.BeaconChainClient
+}
+
+// resolution returns the state of resolving the query of a context, set by the handler.
+func (r *resolver) resolution(ctx context.Context) *resolution {
+	if q, ok := ctx.Value(resolutionKey{}).(*resolution); ok {
+		return q
+	}
+	return newResolution(r.client)
+}
+
+type chainHeadResolver struct {
+	head *ethpb.ChainHead
+}
+
+// ChainHead resolves the head, justified and finalized checkpoints of the chain.
+func (r *resolver) ChainHead(ctx context.Context) (*chainHeadResolver, error) {
+	head, err := r.client.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	return &chainHeadResolver{head: head}, nil
+}
+
+func (c *chainHeadResolver) HeadSlot() Long {
+	return Long(c.head.HeadSlot)
+}
+
+func (c *chainHeadResolver) HeadEpoch() Long {
+	return Long(c.head.HeadEpoch)
+}
+
+func (c *chainHeadResolver) HeadBlockRoot() string {
+	return hexutil.Encode(c.head.HeadBlockRoot)
+}
+
+func (c *chainHeadResolver) JustifiedEpoch() Long {
+	return Long(c.head.JustifiedEpoch)
+}
+
+func (c *chainHeadResolver) JustifiedBlockRoot() string {
+	return hexutil.Encode(c.head.JustifiedBlockRoot)
+}
+
+func (c *chainHeadResolver) FinalizedEpoch() Long {
+	return Long(c.head.FinalizedEpoch)
+}
+
+func (c *chainHeadResolver) FinalizedBlockRoot() string {
+	return hexutil.Encode(c.head.FinalizedBlockRoot)
+}
+
+type blocksArgs struct {
+	FromEpoch     Long
+	ToEpoch       Long
+	Proposers     *[]Long
+	CanonicalOnly *bool
+}
+
+// Blocks resolves the blocks of a range of epochs, in slot order.
+func (r *resolver) Blocks(ctx context.Context, args blocksArgs) ([]*blockResolver, error) {
+	if args.ToEpoch < args.FromEpoch {
+		return nil, fmt.Errorf("toEpoch %d is before fromEpoch %d", args.ToEpoch, args.FromEpoch)
+	}
+	if args.ToEpoch-args.FromEpoch >= maxEpochRange {
+		return nil, fmt.Errorf("cannot query more than %d epochs of blocks", maxEpochRange)
+	}
+	var proposers map[types.ValidatorIndex]bool
+	if args.Proposers != nil {
+		proposers = make(map[types.ValidatorIndex]bool, len(*args.Proposers))
+		for _, idx := range *args.Proposers {
+			proposers[types.ValidatorIndex(idx)] = true
+		}
+	}
+	canonicalOnly := args.CanonicalOnly == nil || *args.CanonicalOnly
+
+	blocks := make([]*blockResolver, 0)
+	for epoch := types.Epoch(args.FromEpoch); epoch <= types.Epoch(args.ToEpoch); epoch++ {
+		req := &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: epoch}}
+		for {
+			res, err := r.client.ListBlocks(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range res.BlockContainers {
+				if c.Block == nil || c.Block.Block == nil {
+					continue
+				}
+				if canonicalOnly && !c.Canonical {
+					continue
+				}
+				if proposers != nil && !proposers[c.Block.Block.ProposerIndex] {
+					continue
+				}
+				blocks = append(blocks, &blockResolver{r: r, container: c})
+			}
+			if len(res.BlockContainers) == 0 || res.NextPageToken == "" {
+				break
+			}
+			req.PageToken = res.NextPageToken
+		}
+	}
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return blocks[i].block().Slot < blocks[j].block().Slot
+	})
+	q := r.resolution(ctx)
+	if err := q.charge(len(blocks)); err != nil {
+		return nil, err
+	}
+	proposerIndices := make([]types.ValidatorIndex, len(blocks))
+	for i, b := range blocks {
+		proposerIndices[i] = b.block().ProposerIndex
+	}
+	q.expectValidators(nil, proposerIndices)
+	return blocks, nil
+}
+
+type blockResolver struct {
+	r         *resolver
+	container *ethpb.BeaconBlockContainer
+}
+
+func (b *blockResolver) block() *ethpb.BeaconBlock {
+	return b.container.Block.Block
+}
+
+func (b *blockResolver) Root() string {
+	return hexutil.Encode(b.container.BlockRoot)
+}
+
+func (b *blockResolver) Slot() Long {
+	return Long(b.block().Slot)
+}
+
+func (b *blockResolver) Epoch() Long {
+	return Long(b.block().Slot / params.BeaconConfig().SlotsPerEpoch)
+}
+
+func (b *blockResolver) Canonical() bool {
+	return b.container.Canonical
+}
+
+func (b *blockResolver) ParentRoot() string {
+	return hexutil.Encode(b.block().ParentRoot)
+}
+
+func (b *blockResolver) StateRoot() string {
+	return hexutil.Encode(b.block().StateRoot)
+}
+
+func (b *blockResolver) Graffiti() string {
+	if b.block().Body == nil {
+		return hexutil.Encode(nil)
+	}
+	return hexutil.Encode(b.block().Body.Graffiti)
+}
+
+func (b *blockResolver) ProposerIndex() Long {
+	return Long(b.block().ProposerIndex)
+}
+
+// Proposer resolves the proposer of the block, as of the head state.
+func (b *blockResolver) Proposer(ctx context.Context) (*validatorResolver, error) {
+	vals, err := b.r.validators(ctx, []types.ValidatorIndex{b.block().ProposerIndex}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) == 0 {
+		return nil, fmt.Errorf("proposer %d not found", b.block().ProposerIndex)
+	}
+	return vals[0], nil
+}
+
+func (b *blockResolver) AttestationCount() int32 {
+	if b.block().Body == nil {
+		return 0
+	}
+	return int32(len(b.block().Body.Attestations))
+}
+
+func (b *blockResolver) DepositCount() int32 {
+	if b.block().Body == nil {
+		return 0
+	}
+	return int32(len(b.block().Body.Deposits))
+}
+
+func (b *blockResolver) VoluntaryExitCount() int32 {
+	if b.block().Body == nil {
+		return 0
+	}
+	return int32(len(b.block().Body.VoluntaryExits))
+}
+
+func (b *blockResolver) ProposerSlashingCount() int32 {
+	if b.block().Body == nil {
+		return 0
+	}
+	return int32(len(b.block().Body.ProposerSlashings))
+}
+
+func (b *blockResolver) AttesterSlashingCount() int32 {
+	if b.block().Body == nil {
+		return 0
+	}
+	return int32(len(b.block().Body.AttesterSlashings))
+}
+
+type validatorsArgs struct {
+	Indices []Long
+	Epoch   *Long
+}
+
+// Validators resolves validators by index, in the order of the indices. Unknown validators are
+// left out.
+func (r *resolver) Validators(ctx context.Context, args validatorsArgs) ([]*validatorResolver, error) {
+	if len(args.Indices) > maxValidators {
+		return nil, fmt.Errorf("cannot query more than %d validators", maxValidators)
+	}
+	indices := make([]types.ValidatorIndex, len(args.Indices))
+	for i, idx := range args.Indices {
+		indices[i] = types.ValidatorIndex(idx)
+	}
+	var epoch *types.Epoch
+	if args.Epoch != nil {
+		e := types.Epoch(*args.Epoch)
+		epoch = &e
+	}
+	return r.validators(ctx, indices, epoch)
+}
+
+// validators looks up validators by index in the state of an epoch, or in the head state if the
+// epoch is nil. The validators of the same epoch are looked up in a single request per query.
+func (r *resolver) validators(ctx context.Context, indices []types.ValidatorIndex, epoch *types.Epoch) ([]*validatorResolver, error) {
+	if len(indices) == 0 {
+		return []*validatorResolver{}, nil
+	}
+	q := r.resolution(ctx)
+	found, err := q.loadValidators(ctx, indices, epoch)
+	if err != nil {
+		return nil, err
+	}
+	vals := make([]*validatorResolver, 0, len(found))
+	for _, idx := range indices {
+		if v, ok := found[idx]; ok {
+			vals = append(vals, &validatorResolver{r: r, index: idx, validator: v, epoch: epoch})
+		}
+	}
+	if err := q.charge(len(vals)); err != nil {
+		return nil, err
+	}
+	return vals, nil
+}
+
+type validatorResolver struct {
+	r         *resolver
+	index     types.ValidatorIndex
+	validator *ethpb.Validator
+	// epoch is the epoch the validator was looked up at, nil for the head state.
+	epoch *types.Epoch
+}
+
+func (v *validatorResolver) Index() Long {
+	return Long(v.index)
+}
+
+func (v *validatorResolver) PublicKey() string {
+	return hexutil.Encode(v.validator.PublicKey)
+}
+
+func (v *validatorResolver) WithdrawalCredentials() string {
+	return hexutil.Encode(v.validator.WithdrawalCredentials)
+}
+
+func (v *validatorResolver) EffectiveBalance() Long {
+	return Long(v.validator.EffectiveBalance)
+}
+
+func (v *validatorResolver) Slashed() bool {
+	return v.validator.Slashed
+}
+
+func (v *validatorResolver) ActivationEligibilityEpoch() Long {
+	return Long(v.validator.ActivationEligibilityEpoch)
+}
+
+func (v *validatorResolver) ActivationEpoch() Long {
+	return Long(v.validator.ActivationEpoch)
+}
+
+func (v *validatorResolver) ExitEpoch() Long {
+	return Long(v.validator.ExitEpoch)
+}
+
+func (v *validatorResolver) WithdrawableEpoch() Long {
+	return Long(v.validator.WithdrawableEpoch)
+}
+
+// Balance resolves the balance of the validator at an epoch, by default the epoch the validator
+// was looked up at. The balances of the same epoch are looked up in a single request per query.
+func (v *validatorResolver) Balance(ctx context.Context, args struct{ Epoch *Long }) (Long, error) {
+	epoch := v.epoch
+	if args.Epoch != nil {
+		e := types.Epoch(*args.Epoch)
+		epoch = &e
+	}
+	q := v.r.resolution(ctx)
+	if err := q.charge(1); err != nil {
+		return 0, err
+	}
+	balance, err := q.loadBalance(ctx, v.index, epoch)
+	if err != nil {
+		return 0, err
+	}
+	return Long(balance), nil
+}
+
+type committeesArgs struct {
+	Epoch *Long
+	Slot  *Long
+}
+
+// Committees resolves the committees of an epoch, in slot and committee index order.
+func (r *resolver) Committees(ctx context.Context, args committeesArgs) ([]*committeeResolver, error) {
+	req := &ethpb.ListCommitteesRequest{}
+	if args.Epoch != nil {
+		req.QueryFilter = &ethpb.ListCommitteesRequest_Epoch{Epoch: types.Epoch(*args.Epoch)}
+	}
+	res, err := r.client.ListBeaconCommittees(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	slots := make([]uint64, 0, len(res.Committees))
+	for slot := range res.Committees {
+		if args.Slot == nil || slot == uint64(*args.Slot) {
+			slots = append(slots, slot)
+		}
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i] < slots[j]
+	})
+	q := r.resolution(ctx)
+	committees := make([]*committeeResolver, 0)
+	for _, slot := range slots {
+		for i, c := range res.Committees[slot].Committees {
+			committees = append(committees, &committeeResolver{
+				r:       r,
+				epoch:   res.Epoch,
+				slot:    types.Slot(slot),
+				index:   types.CommitteeIndex(i),
+				members: c.ValidatorIndices,
+			})
+			q.expectValidators(&res.Epoch, c.ValidatorIndices)
+		}
+	}
+	if err := q.charge(len(committees)); err != nil {
+		return nil, err
+	}
+	return committees, nil
+}
+
+type committeeResolver struct {
+	r       *resolver
+	epoch   types.Epoch
+	slot    types.Slot
+	index   types.CommitteeIndex
+	members []types.ValidatorIndex
+}
+
+func (c *committeeResolver) Slot() Long {
+	return Long(c.slot)
+}
+
+func (c *committeeResolver) Index() Long {
+	return Long(c.index)
+}
+
+func (c *committeeResolver) ValidatorIndices() []Long {
+	indices := make([]Long, len(c.members))
+	for i, idx := range c.members {
+		indices[i] = Long(idx)
+	}
+	return indices
+}
+
+// Validators resolves the members of the committee, as of the epoch of the committee.
+func (c *committeeResolver) Validators(ctx context.Context) ([]*validatorResolver, error) {
+	return c.r.validators(ctx, c.members, &c.epoch)
+}
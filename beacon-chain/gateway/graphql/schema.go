@@ -0,0 +1,72 @@
+package graphql
+
+// schema is the GraphQL schema of the beacon chain data served by the endpoint. Uint64 values are
+// of the Long scalar, and byte strings are hex encoded with a 0x prefix.
+const schema = `
+	schema {
+		query: Query
+	}
+
+	# Long is a 64 bit unsigned integer, encoded as a decimal string.
+	scalar Long
+
+	type Query {
+		# The head, justified and finalized checkpoints of the chain.
+		chainHead: ChainHead!
+		# The blocks of a range of epochs, at most 256 epochs long, optionally filtered by proposer.
+		# Only canonical blocks are returned unless canonicalOnly is false.
+		blocks(fromEpoch: Long!, toEpoch: Long!, proposers: [Long!], canonicalOnly: Boolean): [Block!]!
+		# The validators of the given indices, at most 1000, in the state of an epoch or of the head.
+		validators(indices: [Long!]!, epoch: Long): [Validator!]!
+		# The committees of an epoch, or of the head epoch, optionally filtered by slot.
+		committees(epoch: Long, slot: Long): [Committee!]!
+	}
+
+	type ChainHead {
+		headSlot: Long!
+		headEpoch: Long!
+		headBlockRoot: String!
+		justifiedEpoch: Long!
+		justifiedBlockRoot: String!
+		finalizedEpoch: Long!
+		finalizedBlockRoot: String!
+	}
+
+	type Block {
+		root: String!
+		slot: Long!
+		epoch: Long!
+		canonical: Boolean!
+		parentRoot: String!
+		stateRoot: String!
+		graffiti: String!
+		proposerIndex: Long!
+		proposer: Validator!
+		attestationCount: Int!
+		depositCount: Int!
+		voluntaryExitCount: Int!
+		proposerSlashingCount: Int!
+		attesterSlashingCount: Int!
+	}
+
+	type Validator {
+		index: Long!
+		publicKey: String!
+		withdrawalCredentials: String!
+		effectiveBalance: Long!
+		slashed: Boolean!
+		activationEligibilityEpoch: Long!
+		activationEpoch: Long!
+		exitEpoch: Long!
+		withdrawableEpoch: Long!
+		# The balance at an epoch, by default the one the validator was looked up at.
+		balance(epoch: Long): Long!
+	}
+
+	type Committee {
+		slot: Long!
+		index: Long!
+		validatorIndices: [Long!]!
+		validators: [Validator!]!
+	}
+`
@@ -0,0 +1,50 @@
+// Package graphql serves a GraphQL endpoint over the beacon chain data of a beacon node, letting
+// clients compose queries across blocks, validators, committees and balances in a single request.
+// The queries are resolved with the eth/v1alpha1 gRPC services of the beacon node.
+package graphql
+
+import (
+	"context"
+	"net/http"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+const (
+	// maxDepth bounds the nesting of a query, the deepest path of the schema being the validators
+	// of the committees of a query.
+	maxDepth = 8
+	// maxParallelism bounds the number of fields of a query resolved at once. The cost of a query
+	// is bounded by maxQueryCost.
+	maxParallelism = 16
+)
+
+// NewHandler returns the GraphQL handler of the beacon node of the gRPC connection. It serves
+// queries sent as a JSON POST body.
+func NewHandler(conn *grpc.ClientConn) http.Handler {
+	return newHandler(ethpb.NewBeaconChainClient(conn))
+}
+
+func newHandler(client ethpb.BeaconChainClient) http.Handler {
+	s := graphqlgo.MustParseSchema(
+		schema,
+		&resolver{client: client},
+		graphqlgo.MaxDepth(maxDepth),
+		graphqlgo.MaxParallelism(maxParallelism),
+	)
+	return &handler{client: client, relay: &relay.Handler{Schema: s}}
+}
+
+// handler serves the queries of a schema, each with its own loaders and cost.
+type handler struct {
+	client ethpb.BeaconChainClient
+	relay  *relay.Handler
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := context.WithValue(r.Context(), resolutionKey{}, newResolution(h.client))
+	h.relay.ServeHTTP(w, r.WithContext(ctx))
+}
@@ -0,0 +1,224 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type mockBeaconClient struct {
+	ethpb.BeaconChainClient
+	blocks     map[types.Epoch][]*ethpb.BeaconBlockContainer
+	validators map[types.ValidatorIndex]*ethpb.Validator
+	// The number of validator and balance requests, made concurrently by the fields of a query.
+	validatorCalls int32
+	balanceCalls   int32
+}
+
+func (m *mockBeaconClient) GetChainHead(context.Context, *ptypes.Empty, ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{HeadSlot: 70, HeadEpoch: 2, HeadBlockRoot: []byte{0x01}}, nil
+}
+
+func (m *mockBeaconClient) ListBlocks(_ context.Context, req *ethpb.ListBlocksRequest, _ ...grpc.CallOption) (*ethpb.ListBlocksResponse, error) {
+	epoch := req.QueryFilter.(*ethpb.ListBlocksRequest_Epoch).Epoch
+	// Blocks are served one per page to exercise pagination.
+	blks := m.blocks[epoch]
+	if len(blks) == 0 {
+		return &ethpb.ListBlocksResponse{NextPageToken: "0"}, nil
+	}
+	page := 0
+	if req.PageToken != "" {
+		var err error
+		page, err = strconv.Atoi(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res := &ethpb.ListBlocksResponse{BlockContainers: blks[page : page+1]}
+	if page+1 < len(blks) {
+		res.NextPageToken = strconv.Itoa(page + 1)
+	}
+	return res, nil
+}
+
+func (m *mockBeaconClient) ListValidators(_ context.Context, req *ethpb.ListValidatorsRequest, _ ...grpc.CallOption) (*ethpb.Validators, error) {
+	atomic.AddInt32(&m.validatorCalls, 1)
+	res := &ethpb.Validators{}
+	for _, idx := range req.Indices {
+		if v, ok := m.validators[idx]; ok {
+			res.ValidatorList = append(res.ValidatorList, &ethpb.Validators_ValidatorContainer{Index: idx, Validator: v})
+		}
+	}
+	return res, nil
+}
+
+func (m *mockBeaconClient) ListValidatorBalances(_ context.Context, req *ethpb.ListValidatorBalancesRequest, _ ...grpc.CallOption) (*ethpb.ValidatorBalances, error) {
+	atomic.AddInt32(&m.balanceCalls, 1)
+	res := &ethpb.ValidatorBalances{}
+	var epoch uint64
+	if q, ok := req.QueryFilter.(*ethpb.ListValidatorBalancesRequest_Epoch); ok {
+		epoch = uint64(q.Epoch)
+	}
+	for _, idx := range req.Indices {
+		res.Balances = append(res.Balances, &ethpb.ValidatorBalances_Balance{Index: idx, Balance: 32000000000 + epoch})
+	}
+	return res, nil
+}
+
+func (m *mockBeaconClient) ListBeaconCommittees(context.Context, *ethpb.ListCommitteesRequest, ...grpc.CallOption) (*ethpb.BeaconCommittees, error) {
+	return &ethpb.BeaconCommittees{
+		Epoch: 1,
+		Committees: map[uint64]*ethpb.BeaconCommittees_CommitteesList{
+			33: {Committees: []*ethpb.BeaconCommittees_CommitteeItem{{ValidatorIndices: []types.ValidatorIndex{2}}}},
+			32: {Committees: []*ethpb.BeaconCommittees_CommitteeItem{
+				{ValidatorIndices: []types.ValidatorIndex{1}},
+				{ValidatorIndices: []types.ValidatorIndex{3, 2}},
+			}},
+		},
+	}, nil
+}
+
+func block(slot types.Slot, proposer types.ValidatorIndex, attestations int, canonical bool) *ethpb.BeaconBlockContainer {
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = slot
+	b.Block.ProposerIndex = proposer
+	b.Block.Body.Attestations = make([]*ethpb.Attestation, attestations)
+	return &ethpb.BeaconBlockContainer{Block: b, BlockRoot: []byte{byte(slot)}, Canonical: canonical}
+}
+
+func query(t *testing.T, h http.Handler, q string) map[string]interface{} {
+	body, err := json.Marshal(map[string]string{"query": q})
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	res := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return res
+}
+
+func TestHandler_Blocks(t *testing.T) {
+	client := &mockBeaconClient{
+		blocks: map[types.Epoch][]*ethpb.BeaconBlockContainer{
+			1: {block(33, 5, 2, true), block(32, 7, 1, true), block(34, 5, 0, false)},
+			2: {block(64, 5, 3, true)},
+		},
+		validators: map[types.ValidatorIndex]*ethpb.Validator{
+			5: {PublicKey: []byte{0x05}, EffectiveBalance: 32000000000},
+		},
+	}
+	h := newHandler(client)
+
+	res := query(t, h, `{ blocks(fromEpoch: 1, toEpoch: 2, proposers: [5]) { slot attestationCount proposer { index balance(epoch: 2) } } }`)
+	require.Equal(t, nil, res["errors"])
+	want := map[string]interface{}{
+		"blocks": []interface{}{
+			map[string]interface{}{
+				"slot":             "33",
+				"attestationCount": float64(2),
+				"proposer":         map[string]interface{}{"index": "5", "balance": "32000000002"},
+			},
+			map[string]interface{}{
+				"slot":             "64",
+				"attestationCount": float64(3),
+				"proposer":         map[string]interface{}{"index": "5", "balance": "32000000002"},
+			},
+		},
+	}
+	assert.DeepEqual(t, want, res["data"])
+	// The proposers and their balances are looked up once for the whole query.
+	assert.Equal(t, int32(1), atomic.LoadInt32(&client.validatorCalls))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&client.balanceCalls))
+
+	res = query(t, h, `{ blocks(fromEpoch: 1, toEpoch: 1, canonicalOnly: false) { slot } }`)
+	assert.DeepEqual(t, map[string]interface{}{
+		"blocks": []interface{}{
+			map[string]interface{}{"slot": "32"},
+			map[string]interface{}{"slot": "33"},
+			map[string]interface{}{"slot": "34"},
+		},
+	}, res["data"])
+
+	res = query(t, h, `{ blocks(fromEpoch: 0, toEpoch: 256) { slot } }`)
+	assert.NotNil(t, res["errors"])
+	res = query(t, h, `{ blocks(fromEpoch: 2, toEpoch: 1) { slot } }`)
+	assert.NotNil(t, res["errors"])
+}
+
+func TestHandler_ValidatorsAndCommittees(t *testing.T) {
+	client := &mockBeaconClient{
+		validators: map[types.ValidatorIndex]*ethpb.Validator{
+			1: {PublicKey: []byte{0x01}, EffectiveBalance: 31000000000},
+			2: {PublicKey: []byte{0x02}, EffectiveBalance: 32000000000, Slashed: true},
+		},
+	}
+	h := newHandler(client)
+
+	res := query(t, h, `{ validators(indices: [2, 9, "1"]) { index publicKey effectiveBalance slashed balance } }`)
+	require.Equal(t, nil, res["errors"])
+	assert.DeepEqual(t, map[string]interface{}{
+		"validators": []interface{}{
+			map[string]interface{}{"index": "2", "publicKey": "0x02", "effectiveBalance": "32000000000", "slashed": true, "balance": "32000000000"},
+			map[string]interface{}{"index": "1", "publicKey": "0x01", "effectiveBalance": "31000000000", "slashed": false, "balance": "32000000000"},
+		},
+	}, res["data"])
+
+	atomic.StoreInt32(&client.validatorCalls, 0)
+	res = query(t, h, `{ committees(slot: 32) { slot index validatorIndices validators { index } } }`)
+	require.Equal(t, nil, res["errors"])
+	assert.Equal(t, int32(1), atomic.LoadInt32(&client.validatorCalls), "Expected the committee members to be looked up once")
+	assert.DeepEqual(t, map[string]interface{}{
+		"committees": []interface{}{
+			map[string]interface{}{
+				"slot": "32", "index": "0", "validatorIndices": []interface{}{"1"},
+				"validators": []interface{}{map[string]interface{}{"index": "1"}},
+			},
+			map[string]interface{}{
+				"slot": "32", "index": "1", "validatorIndices": []interface{}{"3", "2"},
+				"validators": []interface{}{map[string]interface{}{"index": "2"}},
+			},
+		},
+	}, res["data"])
+
+	res = query(t, h, `{ chainHead { headSlot headEpoch headBlockRoot } }`)
+	assert.DeepEqual(t, map[string]interface{}{
+		"chainHead": map[string]interface{}{"headSlot": "70", "headEpoch": "2", "headBlockRoot": "0x01"},
+	}, res["data"])
+}
+
+func TestHandler_QueryCost(t *testing.T) {
+	client := &mockBeaconClient{validators: make(map[types.ValidatorIndex]*ethpb.Validator)}
+	indices := make([]string, maxValidators)
+	for i := range indices {
+		client.validators[types.ValidatorIndex(i)] = &ethpb.Validator{PublicKey: []byte{byte(i)}}
+		indices[i] = strconv.Itoa(i)
+	}
+	h := newHandler(client)
+	list := "[" + strings.Join(indices, ",") + "]"
+
+	res := query(t, h, `{ validators(indices: `+list+`) { index balance } }`)
+	require.Equal(t, nil, res["errors"])
+
+	// Each balance is an item of its own, so looking up the balances of many epochs exceeds the cost.
+	fields := make([]string, maxQueryCost/maxValidators)
+	for i := range fields {
+		fields[i] = fmt.Sprintf("b%d: balance(epoch: %d)", i, i)
+	}
+	res = query(t, h, `{ validators(indices: `+list+`) { index `+strings.Join(fields, " ")+` } }`)
+	assert.NotNil(t, res["errors"])
+}
@@ -22,6 +22,7 @@ var (
 	debug                   = flag.Bool("debug", false, "Enable debug logging")
 	allowedOrigins          = flag.String("corsdomain", "localhost:4242", "A comma separated list of CORS domains to allow")
 	enableDebugRPCEndpoints = flag.Bool("enable-debug-rpc-endpoints", false, "Enable debug rpc endpoints such as /eth/v1alpha1/beacon/state")
+	enableGraphQL           = flag.Bool("enable-graphql", false, "Serve a GraphQL endpoint at /graphql")
 	grpcMaxMsgSize          = flag.Int("grpc-max-msg-size", 1<<22, "Integer to define max recieve message call size")
 )
 
@@ -44,6 +45,7 @@ func main() {
 		mux,
 		strings.Split(*allowedOrigins, ","),
 		*enableDebugRPCEndpoints,
+		*enableGraphQL,
 		uint64(*grpcMaxMsgSize),
 	)
 	mux.HandleFunc("/swagger/", gateway.SwaggerServer())
@@ -0,0 +1,87 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// checkpointDownloadTimeout bounds the download of the checkpoint state and block from a remote URL.
+const checkpointDownloadTimeout = 5 * time.Minute
+
+// loadCheckpoint initializes the database from the finalized checkpoint state and block given by
+// the checkpoint flags, so that the node syncs from the checkpoint instead of genesis.
+func (b *BeaconNode) loadCheckpoint(cliCtx *cli.Context) error {
+	statePath := cliCtx.String(flags.CheckpointState.Name)
+	blockPath := cliCtx.String(flags.CheckpointBlock.Name)
+	if statePath == "" || blockPath == "" {
+		return fmt.Errorf("--%s and --%s must be set together", flags.CheckpointState.Name, flags.CheckpointBlock.Name)
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, checkpointDownloadTimeout)
+	defer cancel()
+	stateReader, err := openCheckpointSource(ctx, statePath)
+	if err != nil {
+		return errors.Wrap(err, "could not open checkpoint state")
+	}
+	defer func() {
+		if err := stateReader.Close(); err != nil {
+			log.WithError(err).Error("Failed to close checkpoint state")
+		}
+	}()
+	blockReader, err := openCheckpointSource(ctx, blockPath)
+	if err != nil {
+		return errors.Wrap(err, "could not open checkpoint block")
+	}
+	defer func() {
+		if err := blockReader.Close(); err != nil {
+			log.WithError(err).Error("Failed to close checkpoint block")
+		}
+	}()
+
+	if err := b.db.LoadCheckpoint(ctx, stateReader, blockReader); err != nil {
+		if err == db.ErrExistingChainData {
+			return errors.New("Checkpoint flags specified but the database holds chain data already. Run " +
+				"again with --clear-db to start from the given checkpoint.")
+		}
+		return errors.Wrap(err, "could not load checkpoint")
+	}
+	root, err := b.db.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		return err
+	}
+	log.WithField("blockRoot", fmt.Sprintf("%#x", root)).Info("Starting from finalized checkpoint")
+	return nil
+}
+
+// openCheckpointSource opens the checkpoint data at the given http(s) URL or file path.
+func openCheckpointSource(ctx context.Context, source string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return os.Open(source)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Failed to close response body")
+		}
+		return nil, fmt.Errorf("unexpected status %q from %s", resp.Status, source)
+	}
+	return resp.Body, nil
+}
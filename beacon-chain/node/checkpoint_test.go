@@ -0,0 +1,46 @@
+package node
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestOpenCheckpointSource(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("checkpoint")
+
+	path := filepath.Join(t.TempDir(), "state.ssz")
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+	r, err := openCheckpointSource(ctx, path)
+	require.NoError(t, err)
+	read, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.DeepEqual(t, data, read)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/state" {
+			http.NotFound(w, r)
+			return
+		}
+		_, err := w.Write(data)
+		require.NoError(t, err)
+	}))
+	defer srv.Close()
+	r, err = openCheckpointSource(ctx, srv.URL+"/state")
+	require.NoError(t, err)
+	read, err = ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.DeepEqual(t, data, read)
+
+	_, err = openCheckpointSource(ctx, srv.URL+"/missing")
+	assert.ErrorContains(t, "404", err)
+}
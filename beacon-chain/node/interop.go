@@ -0,0 +1,70 @@
+package node
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/urfave/cli/v2"
+)
+
+// interopModeNumValidators is the number of genesis validators used by interop mode when
+// --interop-num-validators is not specified.
+const interopModeNumValidators = 64
+
+// configureInteropMode fills in the flags implied by --interop-mode which were not set
+// explicitly: a deterministic genesis with interop validators, mocked eth1 data votes, and
+// no initial sync, as a single node has no peers to sync from.
+func configureInteropMode(cliCtx *cli.Context) error {
+	if !cliCtx.Bool(flags.InteropModeFlag.Name) {
+		return nil
+	}
+	if !cliCtx.IsSet(flags.InteropNumValidatorsFlag.Name) {
+		if err := cliCtx.Set(flags.InteropNumValidatorsFlag.Name, strconv.Itoa(interopModeNumValidators)); err != nil {
+			return err
+		}
+	}
+	if cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name) == 0 {
+		return errors.New("interop mode requires at least one genesis validator")
+	}
+	for _, name := range []string{flags.InteropMockEth1DataVotesFlag.Name, flags.DisableSync.Name} {
+		if err := cliCtx.Set(name, "true"); err != nil {
+			return err
+		}
+	}
+	log.WithField("numValidators", cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)).Warn(
+		"Running in interop mode, this should not be used with public testnets")
+	return nil
+}
+
+// InteropValidatorFunc creates the service running validator duties for the interop keys
+// in-process, against the RPC server of the beacon node.
+type InteropValidatorFunc func(ctx context.Context, cliCtx *cli.Context) (shared.Service, error)
+
+// WithInteropValidator sets the constructor of the in-process validator service which is
+// registered in interop mode.
+func WithInteropValidator(newService InteropValidatorFunc) Option {
+	return func(b *BeaconNode) error {
+		b.interopValidator = newService
+		return nil
+	}
+}
+
+// registerInteropValidator runs validator duties for every interop key in-process, if the node
+// was given a constructor for the validator service.
+func (b *BeaconNode) registerInteropValidator() error {
+	if !b.cliCtx.Bool(flags.InteropModeFlag.Name) {
+		return nil
+	}
+	if b.interopValidator == nil {
+		log.Warn("No in-process validator available, interop validators will not perform their duties")
+		return nil
+	}
+	v, err := b.interopValidator(b.ctx, b.cliCtx)
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(v)
+}
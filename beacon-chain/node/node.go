@@ -19,7 +19,9 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
@@ -32,9 +34,13 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/replica"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/timing"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
@@ -59,27 +65,32 @@ const testSkipPowFlag = "test-skip-pow"
 // full PoS node. It handles the lifecycle of the entire system and registers
 // services to a service registry.
 type BeaconNode struct {
-	cliCtx          *cli.Context
-	ctx             context.Context
-	cancel          context.CancelFunc
-	services        *shared.ServiceRegistry
-	lock            sync.RWMutex
-	stop            chan struct{} // Channel to wait for termination notifications.
-	db              db.Database
-	attestationPool attestations.Pool
-	exitPool        voluntaryexits.PoolManager
-	slashingsPool   slashings.PoolManager
-	depositCache    *depositcache.DepositCache
-	stateFeed       *event.Feed
-	blockFeed       *event.Feed
-	opFeed          *event.Feed
-	forkChoiceStore forkchoice.ForkChoicer
-	stateGen        *stategen.State
+	cliCtx           *cli.Context
+	ctx              context.Context
+	cancel           context.CancelFunc
+	services         *shared.ServiceRegistry
+	lock             sync.RWMutex
+	stop             chan struct{} // Channel to wait for termination notifications.
+	db               db.Database
+	attestationPool  attestations.Pool
+	exitPool         *voluntaryexits.Pool
+	slashingsPool    *slashings.Pool
+	arrivals         *timing.Recorder
+	depositCache     *depositcache.DepositCache
+	stateFeed        *event.Feed
+	blockFeed        *event.Feed
+	opFeed           *event.Feed
+	forkChoiceStore  forkchoice.ForkChoicer
+	stateGen         *stategen.State
+	interopValidator InteropValidatorFunc
 }
 
+// Option configures the beacon node before its services are registered.
+type Option func(b *BeaconNode) error
+
 // New creates a new node instance, sets up configuration options, and registers
 // every required service to the node.
-func New(cliCtx *cli.Context) (*BeaconNode, error) {
+func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 	if err := tracing.Setup(
 		"beacon-chain", // service name
 		cliCtx.String(cmd.TracingProcessNameFlag.Name),
@@ -95,6 +106,9 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 
 	featureconfig.ConfigureBeaconChain(cliCtx)
 	cmd.ConfigureBeaconChain(cliCtx)
+	if err := configureInteropMode(cliCtx); err != nil {
+		return nil, err
+	}
 	flags.ConfigureGlobalFlags(cliCtx)
 
 	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
@@ -140,6 +154,17 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		c.DepositContractAddress = cliCtx.String(flags.DepositContractFlag.Name)
 		params.OverrideBeaconConfig(c)
 	}
+	if err := configureEth1Voting(cliCtx); err != nil {
+		return nil, err
+	}
+	if cliCtx.IsSet(flags.SkipSlotCacheSize.Name) || cliCtx.IsSet(flags.SkipSlotCacheMaxMemory.Name) {
+		if err := state.SkipSlotCache.Configure(
+			cliCtx.Int(flags.SkipSlotCacheSize.Name),
+			cliCtx.Uint64(flags.SkipSlotCacheMaxMemory.Name)*1024*1024,
+		); err != nil {
+			return nil, err
+		}
+	}
 
 	// Setting chain network specific flags.
 	if cliCtx.IsSet(cmd.BootstrapNode.Name) {
@@ -168,12 +193,20 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		attestationPool: attestations.NewPool(),
 		exitPool:        voluntaryexits.NewPool(),
 		slashingsPool:   slashings.NewPool(),
+		arrivals:        timing.NewRecorder(timing.DefaultSize),
+	}
+	for _, opt := range opts {
+		if err := opt(beacon); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := beacon.startDB(cliCtx); err != nil {
 		return nil, err
 	}
 
+	beacon.loadOperationPools()
+
 	beacon.startStateGen()
 
 	if err := beacon.registerP2P(cliCtx); err != nil {
@@ -198,12 +231,22 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
-	if err := beacon.registerInitialSyncService(); err != nil {
-		return nil, err
-	}
+	if cliCtx.IsSet(flags.ReadReplicaSource.Name) {
+		if err := beacon.registerReplicaService(); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := beacon.registerInitialSyncService(); err != nil {
+			return nil, err
+		}
 
-	if err := beacon.registerSyncService(); err != nil {
-		return nil, err
+		if err := beacon.registerSyncService(); err != nil {
+			return nil, err
+		}
+
+		if err := beacon.registerBackfillService(); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := beacon.registerRPCService(); err != nil {
@@ -214,6 +257,10 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerInteropValidator(); err != nil {
+		return nil, err
+	}
+
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
 		if err := beacon.registerPrometheusService(cliCtx); err != nil {
 			return nil, err
@@ -279,6 +326,7 @@ func (b *BeaconNode) Close() {
 
 	log.Info("Stopping beacon node")
 	b.services.StopAll()
+	b.saveOperationPools()
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
@@ -286,8 +334,58 @@ func (b *BeaconNode) Close() {
 	close(b.stop)
 }
 
+// configureEth1Voting applies the eth1 follow distance and voting period overrides, which
+// allow private devnets to speed up genesis and deposit processing, and checks the
+// resulting chain config values, whether they come from flags or a chain config file.
+func configureEth1Voting(cliCtx *cli.Context) error {
+	c := params.BeaconConfig()
+	if cliCtx.IsSet(flags.Eth1FollowDistance.Name) {
+		c.Eth1FollowDistance = cliCtx.Uint64(flags.Eth1FollowDistance.Name)
+	}
+	if cliCtx.IsSet(flags.SecondsPerEth1Block.Name) {
+		c.SecondsPerETH1Block = cliCtx.Uint64(flags.SecondsPerEth1Block.Name)
+	}
+	if cliCtx.IsSet(flags.EpochsPerEth1VotingPeriod.Name) {
+		c.EpochsPerEth1VotingPeriod = types.Epoch(cliCtx.Uint64(flags.EpochsPerEth1VotingPeriod.Name))
+	}
+	if c.Eth1FollowDistance == 0 {
+		return errors.New("eth1 follow distance must be greater than 0")
+	}
+	if c.SecondsPerETH1Block == 0 {
+		return errors.New("seconds per eth1 block must be greater than 0")
+	}
+	if c.EpochsPerEth1VotingPeriod == 0 {
+		return errors.New("epochs per eth1 voting period must be greater than 0")
+	}
+	params.OverrideBeaconConfig(c)
+	return nil
+}
+
+// loadOperationPools restores the pending exits and slashings saved on the last shutdown.
+func (b *BeaconNode) loadOperationPools() {
+	if err := b.exitPool.Load(b.ctx, b.db); err != nil {
+		log.WithError(err).Warn("Could not restore voluntary exit pool")
+	}
+	if err := b.slashingsPool.Load(b.ctx, b.db); err != nil {
+		log.WithError(err).Warn("Could not restore slashings pool")
+	}
+}
+
+// saveOperationPools persists the pending exits and slashings so they survive a restart.
+func (b *BeaconNode) saveOperationPools() {
+	if err := b.exitPool.Save(b.ctx, b.db); err != nil {
+		log.WithError(err).Error("Failed to save voluntary exit pool")
+	}
+	if err := b.slashingsPool.Save(b.ctx, b.db); err != nil {
+		log.WithError(err).Error("Failed to save slashings pool")
+	}
+}
+
 func (b *BeaconNode) startForkChoice() {
 	f := protoarray.New(0, 0, params.BeaconConfig().ZeroHash)
+	if threshold := b.cliCtx.Uint64(flags.ForkChoicePruneThreshold.Name); threshold > 0 {
+		f.Store().SetPruneThreshold(threshold)
+	}
 	b.forkChoiceStore = f
 }
 
@@ -301,6 +399,7 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 
 	d, err := db.NewDB(b.ctx, dbPath, &kv.Config{
 		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		Backend:         cliCtx.String(flags.DBBackend.Name),
 	})
 	if err != nil {
 		return err
@@ -325,6 +424,7 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 		}
 		d, err = db.NewDB(b.ctx, dbPath, &kv.Config{
 			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+			Backend:         cliCtx.String(flags.DBBackend.Name),
 		})
 		if err != nil {
 			return errors.Wrap(err, "could not create new database")
@@ -335,6 +435,13 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 		return err
 	}
 
+	if cliCtx.Bool(flags.PruneStates.Name) {
+		d, err = pruneDB(b.ctx, cliCtx, d, dbPath)
+		if err != nil {
+			return errors.Wrap(err, "could not prune database")
+		}
+	}
+
 	b.db = d
 
 	depositCache, err := depositcache.New()
@@ -368,9 +475,41 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 		return err
 	}
 
+	if cliCtx.IsSet(flags.CheckpointState.Name) || cliCtx.IsSet(flags.CheckpointBlock.Name) {
+		if err := b.loadCheckpoint(cliCtx); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// pruneDB deletes the finalized history which is not needed to regenerate the canonical states, then
+// compacts the database to shrink its file, and returns the reopened database.
+func pruneDB(ctx context.Context, cliCtx *cli.Context, d db.Database, dbPath string) (db.Database, error) {
+	stats, err := d.PruneFinalized(ctx, params.BeaconConfig().SlotsPerArchivedPoint)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Close(); err != nil {
+		return nil, errors.Wrap(err, "could not close db prior to compaction")
+	}
+	log.Info("Compacting database, this may take a while")
+	reclaimed, err := kv.CompactDatabase(ctx, dbPath, cliCtx.String(flags.DBBackend.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compact database")
+	}
+	log.WithFields(logrus.Fields{
+		"deletedStates":  stats.DeletedStates,
+		"deletedBlocks":  stats.DeletedBlocks,
+		"reclaimedBytes": reclaimed,
+	}).Info("Pruned database")
+	return db.NewDB(ctx, dbPath, &kv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		Backend:         cliCtx.String(flags.DBBackend.Name),
+	})
+}
+
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db)
 }
@@ -429,6 +568,9 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
 		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
 		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		MaxPeersPerClient: cliCtx.Uint(cmd.P2PMaxPeersPerClient.Name),
+		ColocationLimit:   cliCtx.Uint(cmd.P2PColocationLimit.Name),
+		MinSubnetPeers:    cliCtx.Uint(cmd.P2PMinSubnetPeers.Name),
 		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
 		DenyListCIDR:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
 		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
@@ -477,21 +619,29 @@ func (b *BeaconNode) registerBlockchainService() error {
 	}
 
 	maxRoutines := b.cliCtx.Int(cmd.MaxGoroutines.Name)
+	// Blocks are only trusted to have been verified by the primary node if they are received over TLS.
+	readReplica := b.cliCtx.IsSet(flags.ReadReplicaSource.Name) && b.cliCtx.IsSet(flags.ReplicaTLSCert.Name)
 	blockchainService, err := blockchain.NewService(b.ctx, &blockchain.Config{
-		BeaconDB:          b.db,
-		DepositCache:      b.depositCache,
-		ChainStartFetcher: web3Service,
-		AttPool:           b.attestationPool,
-		ExitPool:          b.exitPool,
-		SlashingPool:      b.slashingsPool,
-		P2p:               b.fetchP2P(),
-		MaxRoutines:       maxRoutines,
-		StateNotifier:     b,
-		ForkChoiceStore:   b.forkChoiceStore,
-		OpsService:        opsService,
-		StateGen:          b.stateGen,
-		WspBlockRoot:      bRoot,
-		WspEpoch:          epoch,
+		BeaconDB:                 b.db,
+		DepositCache:             b.depositCache,
+		ChainStartFetcher:        web3Service,
+		AttPool:                  b.attestationPool,
+		ExitPool:                 b.exitPool,
+		SlashingPool:             b.slashingsPool,
+		P2p:                      b.fetchP2P(),
+		PeersFetcher:             b.fetchP2P(),
+		MaxRoutines:              maxRoutines,
+		StateNotifier:            b,
+		ForkChoiceStore:          b.forkChoiceStore,
+		OpsService:               opsService,
+		StateGen:                 b.stateGen,
+		WspBlockRoot:             bRoot,
+		WspEpoch:                 epoch,
+		ReadReplica:              readReplica,
+		FinalityStallEpochs:      types.Epoch(b.cliCtx.Uint64(flags.FinalityStallEpochs.Name)),
+		ForkChoicePruneThreshold: b.cliCtx.Uint64(flags.ForkChoicePruneThreshold.Name),
+		NextSlotCacheHeads:       b.cliCtx.Int(flags.NextSlotCacheHeads.Name),
+		IndexAttInclusions:       b.cliCtx.Bool(flags.IndexAttestationInclusions.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
@@ -512,7 +662,7 @@ func (b *BeaconNode) registerPOWChainService() error {
 		log.Fatalf("Invalid deposit contract address given: %s", depAddress)
 	}
 
-	if b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name) == "" {
+	if b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name) == "" && !b.cliCtx.Bool(flags.InteropModeFlag.Name) {
 		log.Error("No ETH1 node specified to run with the beacon node. Please consider running your own ETH1 node for better uptime, security, and decentralization of ETH2. Visit https://docs.prylabs.network/docs/prysm-usage/setup-eth1 for more information.")
 		log.Error("You will need to specify --http-web3provider to attach an eth1 node to the prysm node. Without an eth1 node block proposals for your validator will be affected and the beacon node will not be able to initialize the genesis state.")
 	}
@@ -580,6 +730,7 @@ func (b *BeaconNode) registerSyncService() error {
 		ExitPool:            b.exitPool,
 		SlashingPool:        b.slashingsPool,
 		StateGen:            b.stateGen,
+		ArrivalRecorder:     b.arrivals,
 	})
 
 	return b.services.RegisterService(rs)
@@ -601,6 +752,38 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	bs := backfill.NewService(b.ctx, &backfill.Config{
+		DB:          b.db,
+		P2P:         b.fetchP2P(),
+		InitialSync: initSync,
+		EraDir:      b.cliCtx.String(flags.EraDir.Name),
+	})
+	return b.services.RegisterService(bs)
+}
+
+func (b *BeaconNode) registerReplicaService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	rs := replica.NewService(b.ctx, &replica.Config{
+		Endpoint:      b.cliCtx.String(flags.ReadReplicaSource.Name),
+		Cert:          b.cliCtx.String(flags.ReplicaTLSCert.Name),
+		MaxMsgSize:    b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		DB:            b.db,
+		Chain:         chainService,
+		StateNotifier: b,
+	})
+	return b.services.RegisterService(rs)
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -612,9 +795,19 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
-	var syncService *initialsync.Service
-	if err := b.services.FetchService(&syncService); err != nil {
-		return err
+	var syncService regularsync.Checker
+	if b.cliCtx.IsSet(flags.ReadReplicaSource.Name) {
+		var replicaService *replica.Service
+		if err := b.services.FetchService(&replicaService); err != nil {
+			return err
+		}
+		syncService = replicaService
+	} else {
+		var initSync *initialsync.Service
+		if err := b.services.FetchService(&initSync); err != nil {
+			return err
+		}
+		syncService = initSync
 	}
 
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
@@ -641,44 +834,56 @@ func (b *BeaconNode) registerRPCService() error {
 	key := b.cliCtx.String(flags.KeyFlag.Name)
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+	enableAdminRPCEndpoints := b.cliCtx.Bool(flags.EnableAdminRPCEndpoints.Name)
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
-		Host:                    host,
-		Port:                    port,
-		BeaconMonitoringHost:    beaconMonitoringHost,
-		BeaconMonitoringPort:    beaconMonitoringPort,
-		CertFlag:                cert,
-		KeyFlag:                 key,
-		BeaconDB:                b.db,
-		Broadcaster:             p2pService,
-		PeersFetcher:            p2pService,
-		PeerManager:             p2pService,
-		MetadataProvider:        p2pService,
-		ChainInfoFetcher:        chainService,
-		HeadFetcher:             chainService,
-		CanonicalFetcher:        chainService,
-		ForkFetcher:             chainService,
-		FinalizationFetcher:     chainService,
-		BlockReceiver:           chainService,
-		AttestationReceiver:     chainService,
-		GenesisTimeFetcher:      chainService,
-		GenesisFetcher:          chainService,
-		AttestationsPool:        b.attestationPool,
-		ExitPool:                b.exitPool,
-		SlashingsPool:           b.slashingsPool,
-		POWChainService:         web3Service,
-		ChainStartFetcher:       chainStartFetcher,
-		MockEth1Votes:           mockEth1DataVotes,
-		SyncService:             syncService,
-		DepositFetcher:          depositFetcher,
-		PendingDepositFetcher:   b.depositCache,
-		BlockNotifier:           b,
-		StateNotifier:           b,
-		OperationNotifier:       b,
-		StateGen:                b.stateGen,
-		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
-		MaxMsgSize:              maxMsgSize,
+		Host:                      host,
+		Port:                      port,
+		BeaconMonitoringHost:      beaconMonitoringHost,
+		BeaconMonitoringPort:      beaconMonitoringPort,
+		CertFlag:                  cert,
+		KeyFlag:                   key,
+		BeaconDB:                  b.db,
+		DatabasePath:              b.db.DatabasePath(),
+		Broadcaster:               p2pService,
+		PeersFetcher:              p2pService,
+		PeerManager:               p2pService,
+		MetadataProvider:          p2pService,
+		ChainInfoFetcher:          chainService,
+		HeadFetcher:               chainService,
+		CanonicalFetcher:          chainService,
+		ForkFetcher:               chainService,
+		FinalizationFetcher:       chainService,
+		BlockReceiver:             chainService,
+		ProposalGuard:             chainService,
+		WithdrawalCredsFetcher:    chainService,
+		EpochBoundaryStateFetcher: chainService,
+		HeadUpdater:               chainService,
+		ChainHealthFetcher:        chainService,
+		ReorgFetcher:              chainService,
+		AttestationReceiver:       chainService,
+		GenesisTimeFetcher:        chainService,
+		GenesisFetcher:            chainService,
+		AttestationsPool:          b.attestationPool,
+		ExitPool:                  b.exitPool,
+		SlashingsPool:             b.slashingsPool,
+		ArrivalRecorder:           b.arrivals,
+		CacheRegistry:             cache.DefaultRegistry,
+		POWChainService:           web3Service,
+		ChainStartFetcher:         chainStartFetcher,
+		ChainStartStatusFetcher:   web3Service,
+		MockEth1Votes:             mockEth1DataVotes,
+		SyncService:               syncService,
+		DepositFetcher:            depositFetcher,
+		PendingDepositFetcher:     b.depositCache,
+		BlockNotifier:             b,
+		StateNotifier:             b,
+		OperationNotifier:         b,
+		StateGen:                  b.stateGen,
+		EnableDebugRPCEndpoints:   enableDebugRPCEndpoints,
+		EnableAdminRPCEndpoints:   enableAdminRPCEndpoints,
+		MaxMsgSize:                maxMsgSize,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -708,6 +913,9 @@ func (b *BeaconNode) registerPrometheusService(cliCtx *cli.Context) error {
 	}
 
 	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/tree", Handler: c.TreeHandler})
+	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/forkchoice", Handler: c.ForkChoiceHandler})
+	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/validators/exiting", Handler: c.ExitingValidatorsHandler})
+	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/state/fields", Handler: stateV0.FieldMetricsHandler})
 
 	service := prometheus.NewService(
 		fmt.Sprintf("%s:%d", b.cliCtx.String(cmd.MonitoringHostFlag.Name), b.cliCtx.Int(flags.MonitoringPortFlag.Name)),
@@ -730,6 +938,7 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	gatewayAddress := fmt.Sprintf("%s:%d", gatewayHost, gatewayPort)
 	allowedOrigins := strings.Split(b.cliCtx.String(flags.GPRCGatewayCorsDomain.Name), ",")
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+	enableGraphQL := b.cliCtx.Bool(flags.EnableGRPCGatewayGraphQL.Name)
 	selfCert := b.cliCtx.String(flags.CertFlag.Name)
 	return b.services.RegisterService(
 		gateway.New(
@@ -740,6 +949,7 @@ func (b *BeaconNode) registerGRPCGateway() error {
 			nil, /*optional mux*/
 			allowedOrigins,
 			enableDebugRPCEndpoints,
+			enableGraphQL,
 			b.cliCtx.Uint64(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
 		),
 	)
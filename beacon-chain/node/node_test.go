@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"io/ioutil"
@@ -9,6 +10,8 @@ import (
 	"testing"
 
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -81,3 +84,68 @@ func TestClearDB(t *testing.T) {
 	require.LogsContain(t, hook, "Removing database")
 	require.NoError(t, os.RemoveAll(tmp))
 }
+
+func TestConfigureInteropMode(t *testing.T) {
+	newContext := func(t *testing.T, args ...string) *cli.Context {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.Bool(flags.InteropModeFlag.Name, false, "")
+		set.Uint64(flags.InteropNumValidatorsFlag.Name, 0, "")
+		set.Bool(flags.InteropMockEth1DataVotesFlag.Name, false, "")
+		set.Bool(flags.DisableSync.Name, false, "")
+		require.NoError(t, set.Parse(args))
+		return cli.NewContext(&app, set, nil)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		cliCtx := newContext(t)
+		require.NoError(t, configureInteropMode(cliCtx))
+		assert.Equal(t, uint64(0), cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name))
+		assert.Equal(t, false, cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name))
+		assert.Equal(t, false, cliCtx.Bool(flags.DisableSync.Name))
+	})
+	t.Run("defaults", func(t *testing.T) {
+		cliCtx := newContext(t, "--interop-mode")
+		require.NoError(t, configureInteropMode(cliCtx))
+		assert.Equal(t, uint64(interopModeNumValidators), cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name))
+		assert.Equal(t, true, cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name))
+		assert.Equal(t, true, cliCtx.Bool(flags.DisableSync.Name))
+	})
+	t.Run("custom validator count", func(t *testing.T) {
+		cliCtx := newContext(t, "--interop-mode", "--interop-num-validators=8")
+		require.NoError(t, configureInteropMode(cliCtx))
+		assert.Equal(t, uint64(8), cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name))
+	})
+	t.Run("no validators", func(t *testing.T) {
+		cliCtx := newContext(t, "--interop-mode", "--interop-num-validators=0")
+		assert.ErrorContains(t, "at least one genesis validator", configureInteropMode(cliCtx))
+	})
+}
+
+type mockInteropValidator struct{}
+
+func (*mockInteropValidator) Start()        {}
+func (*mockInteropValidator) Stop() error   { return nil }
+func (*mockInteropValidator) Status() error { return nil }
+
+func TestRegisterInteropValidator(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Bool(flags.InteropModeFlag.Name, true, "")
+	b := &BeaconNode{
+		ctx:      context.Background(),
+		cliCtx:   cli.NewContext(&app, set, nil),
+		services: shared.NewServiceRegistry(),
+	}
+
+	hook := logTest.NewGlobal()
+	require.NoError(t, b.registerInteropValidator())
+	require.LogsContain(t, hook, "No in-process validator available")
+
+	require.NoError(t, WithInteropValidator(func(_ context.Context, _ *cli.Context) (shared.Service, error) {
+		return &mockInteropValidator{}, nil
+	})(b))
+	require.NoError(t, b.registerInteropValidator())
+	var v *mockInteropValidator
+	require.NoError(t, b.services.FetchService(&v))
+}
@@ -0,0 +1,6 @@
+// Package pool defines a generic in-memory pool of block operations,
+// such as voluntary exits and slashings, which are keyed by the
+// validators they apply to. Each operation type plugs into the pool
+// through a Handler, and the pool takes care of insertion, pruning,
+// selection for block proposals, persistence and metrics.
+package pool
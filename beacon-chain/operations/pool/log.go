@@ -0,0 +1,7 @@
+package pool
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "pool/operations")
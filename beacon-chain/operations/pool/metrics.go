@@ -0,0 +1,23 @@
+package pool
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	numPendingOperations = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "num_pending_operations",
+			Help: "Number of pending operations in the pool, by operation type",
+		},
+		[]string{"operation"},
+	)
+	numOperationsIncluded = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "operations_included_total",
+			Help: "Number of pool operations included in blocks, by operation type",
+		},
+		[]string{"operation"},
+	)
+)
@@ -0,0 +1,305 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+)
+
+// Pool keeps pending operations of a single type, along with the validators for which an
+// operation was recently included in a block. Operations must be verified by the caller
+// before they are inserted.
+type Pool struct {
+	handler         Handler
+	lock            sync.RWMutex
+	pending         []*Entry
+	included        map[types.ValidatorIndex]bool
+	pendingGauge    prometheus.Gauge
+	includedCounter prometheus.Counter
+}
+
+// Entry is a pending operation for a single validator. An operation which applies to several
+// validators has one entry per validator.
+type Entry struct {
+	ValidatorIndex types.ValidatorIndex
+	Operation      Operation
+}
+
+// New returns an empty pool for the operations handled by the given handler.
+func New(handler Handler) *Pool {
+	return &Pool{
+		handler:  handler,
+		pending:  make([]*Entry, 0),
+		included: make(map[types.ValidatorIndex]bool),
+	}
+}
+
+// NewWithMetrics returns an empty pool which also reports its pending and included operations to
+// the given metrics, in addition to the metrics labeled by operation type. It allows the metrics of
+// pools which predate the generic pool to be kept.
+func NewWithMetrics(handler Handler, pending prometheus.Gauge, included prometheus.Counter) *Pool {
+	p := New(handler)
+	p.pendingGauge = pending
+	p.includedCounter = included
+	return p
+}
+
+// Name returns the name of the operation type kept in the pool.
+func (p *Pool) Name() string {
+	return p.handler.Name()
+}
+
+// Insert adds the operation to the pool for each of its validators which is eligible and has
+// no pending operation, or whose pending operation should be replaced. An error is returned
+// if the operation was not added for any of its validators.
+func (p *Pool) Insert(state iface.ReadOnlyBeaconState, op Operation) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	keys := p.handler.Keys(op)
+	if len(keys) == 0 {
+		return fmt.Errorf("%s does not apply to any validator", p.handler.Name())
+	}
+	var insertErr error
+	inserted := 0
+	for _, key := range keys {
+		ok, err := p.eligible(state, key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			insertErr = fmt.Errorf("validator at index %d is not eligible for %s", key, p.handler.Name())
+			continue
+		}
+		i, found := p.find(key)
+		if found {
+			if !p.handler.Replace(p.pending[i].Operation, op) {
+				insertErr = fmt.Errorf("%s for validator at index %d already exists in pool", p.handler.Name(), key)
+				continue
+			}
+			p.pending[i].Operation = op
+		} else {
+			p.insertAt(i, &Entry{ValidatorIndex: key, Operation: op})
+		}
+		inserted++
+	}
+	p.updateMetrics()
+
+	if inserted == 0 {
+		if len(keys) == 1 {
+			return insertErr
+		}
+		return fmt.Errorf("could not add %s for any of %d validators", p.handler.Name(), len(keys))
+	}
+	return nil
+}
+
+// Select returns the pending operations which can be included in a block at the given slot,
+// pruning those which are no longer eligible on top of the given state. Unless noLimit is set,
+// no more than the maximum number of operations per block is returned. Selected operations
+// never apply to the same validator twice.
+func (p *Pool) Select(state iface.ReadOnlyBeaconState, slot types.Slot, noLimit bool) []Operation {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	limit := p.handler.MaxPerBlock()
+	if noLimit {
+		limit = uint64(len(p.pending))
+	}
+	selected := make([]Operation, 0, limit)
+	covered := make(map[types.ValidatorIndex]bool)
+	for i := 0; i < len(p.pending) && uint64(len(selected)) < limit; i++ {
+		e := p.pending[i]
+		if covered[e.ValidatorIndex] {
+			continue
+		}
+		ok, err := p.eligible(state, e.ValidatorIndex)
+		if err != nil {
+			log.WithError(err).WithField("operation", p.handler.Name()).Error("Could not check operation eligibility")
+			continue
+		}
+		if !ok {
+			p.pending = append(p.pending[:i], p.pending[i+1:]...)
+			i--
+			continue
+		}
+		if !p.handler.Ready(e.Operation, slot) {
+			continue
+		}
+		for _, key := range p.handler.Keys(e.Operation) {
+			covered[key] = true
+		}
+		selected = append(selected, e.Operation)
+	}
+	p.updateMetrics()
+	return selected
+}
+
+// Prune removes the pending operations which are no longer eligible on top of the given state.
+func (p *Pool) Prune(state iface.ReadOnlyBeaconState) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	kept := p.pending[:0]
+	for _, e := range p.pending {
+		ok, err := p.eligible(state, e.ValidatorIndex)
+		if err != nil {
+			return err
+		}
+		if ok {
+			kept = append(kept, e)
+		}
+	}
+	p.pending = kept
+	p.updateMetrics()
+	return nil
+}
+
+// MarkIncluded is used when an operation has been included in a beacon block. The pending
+// operations for its validators are removed, and further operations for them are rejected.
+func (p *Pool) MarkIncluded(op Operation) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, key := range p.handler.Keys(op) {
+		if i, found := p.find(key); found {
+			p.pending = append(p.pending[:i], p.pending[i+1:]...)
+		}
+		p.included[key] = true
+		numOperationsIncluded.WithLabelValues(p.handler.Name()).Inc()
+		if p.includedCounter != nil {
+			p.includedCounter.Inc()
+		}
+	}
+	p.updateMetrics()
+}
+
+// Remove drops the operation from the pending entries of all its validators, without marking
+// it as included. It is used when an operation turns out to be invalid.
+func (p *Pool) Remove(op Operation) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	kept := p.pending[:0]
+	for _, e := range p.pending {
+		if e.Operation != op {
+			kept = append(kept, e)
+		}
+	}
+	p.pending = kept
+	p.updateMetrics()
+}
+
+// Entries returns the pending entries of the pool, ordered by validator index.
+func (p *Pool) Entries() []Entry {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	entries := make([]Entry, len(p.pending))
+	for i, e := range p.pending {
+		entries[i] = *e
+	}
+	return entries
+}
+
+// Pending returns all pending operations, ordered by the lowest validator index they apply to.
+func (p *Pool) Pending() []Operation {
+	entries := p.Entries()
+	ops := make([]Operation, 0, len(entries))
+	seen := make(map[Operation]bool, len(entries))
+	for _, e := range entries {
+		if seen[e.Operation] {
+			continue
+		}
+		seen[e.Operation] = true
+		ops = append(ops, e.Operation)
+	}
+	return ops
+}
+
+// Included returns whether an operation for the validator was recently included in a block.
+func (p *Pool) Included(idx types.ValidatorIndex) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.included[idx]
+}
+
+// Save persists the pending operations of the pool.
+func (p *Pool) Save(ctx context.Context, store Store) error {
+	ops := p.Pending()
+	encoded := make([][]byte, len(ops))
+	for i, op := range ops {
+		enc, err := op.MarshalSSZ()
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal %s", p.handler.Name())
+		}
+		encoded[i] = enc
+	}
+	return store.SaveOperationPool(ctx, p.handler.Name(), encoded)
+}
+
+// Load restores the operations persisted with Save. Restored operations are not checked for
+// eligibility until they are selected or the pool is pruned.
+func (p *Pool) Load(ctx context.Context, store Store) error {
+	encoded, err := store.OperationPool(ctx, p.handler.Name())
+	if err != nil {
+		return err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, enc := range encoded {
+		op, err := p.handler.Unmarshal(enc)
+		if err != nil {
+			return errors.Wrapf(err, "could not unmarshal %s", p.handler.Name())
+		}
+		for _, key := range p.handler.Keys(op) {
+			if i, found := p.find(key); !found {
+				p.insertAt(i, &Entry{ValidatorIndex: key, Operation: op})
+			}
+		}
+	}
+	p.updateMetrics()
+	return nil
+}
+
+// eligible returns whether an operation for the validator can be kept in the pool.
+// Note: this method requires the caller to hold the lock.
+func (p *Pool) eligible(state iface.ReadOnlyBeaconState, key types.ValidatorIndex) (bool, error) {
+	if p.included[key] {
+		return false, nil
+	}
+	return p.handler.Eligible(state, key)
+}
+
+// find returns the position of the validator's pending operation, or the position at which
+// it should be inserted if there is none.
+// Note: this method requires the caller to hold the lock.
+func (p *Pool) find(key types.ValidatorIndex) (int, bool) {
+	i := sort.Search(len(p.pending), func(j int) bool {
+		return p.pending[j].ValidatorIndex >= key
+	})
+	return i, i < len(p.pending) && p.pending[i].ValidatorIndex == key
+}
+
+// insertAt inserts the entry at the given position, keeping the pending list sorted.
+// Note: this method requires the caller to hold the lock.
+func (p *Pool) insertAt(i int, e *Entry) {
+	p.pending = append(p.pending, nil)
+	copy(p.pending[i+1:], p.pending[i:])
+	p.pending[i] = e
+}
+
+// Note: this method requires the caller to hold the lock.
+func (p *Pool) updateMetrics() {
+	numPendingOperations.WithLabelValues(p.handler.Name()).Set(float64(len(p.pending)))
+	if p.pendingGauge != nil {
+		p.pendingGauge.Set(float64(len(p.pending)))
+	}
+}
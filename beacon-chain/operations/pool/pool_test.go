@@ -0,0 +1,208 @@
+package pool
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// testOp applies to a set of validators from a given slot onwards.
+type testOp struct {
+	slot types.Slot
+	keys []types.ValidatorIndex
+}
+
+func (o *testOp) MarshalSSZ() ([]byte, error) {
+	enc := make([]byte, 8*(len(o.keys)+1))
+	binary.LittleEndian.PutUint64(enc, uint64(o.slot))
+	for i, key := range o.keys {
+		binary.LittleEndian.PutUint64(enc[8*(i+1):], uint64(key))
+	}
+	return enc, nil
+}
+
+// testHandler considers validators below 10 eligible, and prefers operations with an earlier slot.
+type testHandler struct{}
+
+func (testHandler) Name() string { return "test_operations" }
+
+func (testHandler) MaxPerBlock() uint64 { return 2 }
+
+func (testHandler) Keys(op Operation) []types.ValidatorIndex { return op.(*testOp).keys }
+
+func (testHandler) Eligible(_ iface.ReadOnlyBeaconState, key types.ValidatorIndex) (bool, error) {
+	return key < 10, nil
+}
+
+func (testHandler) Ready(op Operation, slot types.Slot) bool { return op.(*testOp).slot <= slot }
+
+func (testHandler) Replace(pending, op Operation) bool {
+	return op.(*testOp).slot < pending.(*testOp).slot
+}
+
+func (testHandler) Unmarshal(enc []byte) (Operation, error) {
+	if len(enc)%8 != 0 || len(enc) == 0 {
+		return nil, errors.New("bad encoding")
+	}
+	op := &testOp{slot: types.Slot(binary.LittleEndian.Uint64(enc))}
+	for i := 8; i < len(enc); i += 8 {
+		op.keys = append(op.keys, types.ValidatorIndex(binary.LittleEndian.Uint64(enc[i:])))
+	}
+	return op, nil
+}
+
+type memoryStore map[string][][]byte
+
+func (s memoryStore) SaveOperationPool(_ context.Context, name string, ops [][]byte) error {
+	s[name] = ops
+	return nil
+}
+
+func (s memoryStore) OperationPool(_ context.Context, name string) ([][]byte, error) {
+	return s[name], nil
+}
+
+func keysOf(entries []Entry) []types.ValidatorIndex {
+	keys := make([]types.ValidatorIndex, len(entries))
+	for i, e := range entries {
+		keys[i] = e.ValidatorIndex
+	}
+	return keys
+}
+
+func TestPool_Insert(t *testing.T) {
+	p := New(testHandler{})
+	require.NoError(t, p.Insert(nil, &testOp{slot: 5, keys: []types.ValidatorIndex{3}}))
+	require.NoError(t, p.Insert(nil, &testOp{slot: 5, keys: []types.ValidatorIndex{1, 12}}))
+	assert.DeepEqual(t, []types.ValidatorIndex{1, 3}, keysOf(p.Entries()))
+
+	assert.ErrorContains(t, "already exists in pool", p.Insert(nil, &testOp{slot: 6, keys: []types.ValidatorIndex{3}}))
+	assert.ErrorContains(t, "is not eligible", p.Insert(nil, &testOp{keys: []types.ValidatorIndex{11}}))
+	assert.ErrorContains(t, "could not add test_operations for any of 2 validators", p.Insert(nil, &testOp{slot: 9, keys: []types.ValidatorIndex{1, 11}}))
+	assert.ErrorContains(t, "does not apply to any validator", p.Insert(nil, &testOp{}))
+
+	// A preferred operation replaces the pending one.
+	replacement := &testOp{slot: 2, keys: []types.ValidatorIndex{3}}
+	require.NoError(t, p.Insert(nil, replacement))
+	assert.Equal(t, Operation(replacement), p.Entries()[1].Operation)
+}
+
+func TestPool_Select(t *testing.T) {
+	p := New(testHandler{})
+	shared := &testOp{slot: 1, keys: []types.ValidatorIndex{2, 4}}
+	require.NoError(t, p.Insert(nil, &testOp{slot: 3, keys: []types.ValidatorIndex{1}}))
+	require.NoError(t, p.Insert(nil, shared))
+	require.NoError(t, p.Insert(nil, &testOp{slot: 1, keys: []types.ValidatorIndex{5}}))
+	require.NoError(t, p.Insert(nil, &testOp{slot: 1, keys: []types.ValidatorIndex{6}}))
+
+	// Operations which are not ready are skipped, and operations applying to several
+	// validators are only selected once.
+	selected := p.Select(nil, 2, false)
+	require.Equal(t, 2, len(selected))
+	assert.Equal(t, Operation(shared), selected[0])
+	assert.DeepEqual(t, []types.ValidatorIndex{5}, selected[1].(*testOp).keys)
+	assert.Equal(t, 4, len(p.Select(nil, 3, true)))
+
+	// Operations for validators which are no longer eligible are pruned.
+	p.handler = prunedHandler{}
+	assert.Equal(t, 0, len(p.Select(nil, 3, true)))
+	assert.Equal(t, 0, len(p.Entries()))
+}
+
+// prunedHandler considers no validator eligible.
+type prunedHandler struct {
+	testHandler
+}
+
+func (prunedHandler) Eligible(_ iface.ReadOnlyBeaconState, _ types.ValidatorIndex) (bool, error) {
+	return false, nil
+}
+
+func TestPool_MarkIncluded(t *testing.T) {
+	p := New(testHandler{})
+	op := &testOp{keys: []types.ValidatorIndex{1, 2}}
+	require.NoError(t, p.Insert(nil, op))
+	require.NoError(t, p.Insert(nil, &testOp{keys: []types.ValidatorIndex{3}}))
+
+	p.MarkIncluded(op)
+	assert.DeepEqual(t, []types.ValidatorIndex{3}, keysOf(p.Entries()))
+	assert.Equal(t, true, p.Included(1))
+	assert.Equal(t, true, p.Included(2))
+	assert.Equal(t, false, p.Included(3))
+	assert.ErrorContains(t, "is not eligible", p.Insert(nil, &testOp{keys: []types.ValidatorIndex{1}}))
+}
+
+// recordingGauge and recordingCounter record the values reported to them by a pool.
+type recordingGauge struct {
+	prometheus.Gauge
+	value float64
+}
+
+func (g *recordingGauge) Set(value float64) {
+	g.value = value
+}
+
+type recordingCounter struct {
+	prometheus.Counter
+	count int
+}
+
+func (c *recordingCounter) Inc() {
+	c.count++
+}
+
+func TestPool_NewWithMetrics(t *testing.T) {
+	pending, included := &recordingGauge{}, &recordingCounter{}
+	p := NewWithMetrics(testHandler{}, pending, included)
+	op := &testOp{keys: []types.ValidatorIndex{1, 2}}
+	require.NoError(t, p.Insert(nil, op))
+	require.NoError(t, p.Insert(nil, &testOp{keys: []types.ValidatorIndex{3}}))
+	assert.Equal(t, float64(3), pending.value)
+
+	p.MarkIncluded(op)
+	assert.Equal(t, float64(1), pending.value)
+	assert.Equal(t, 2, included.count)
+}
+
+func TestPool_Remove(t *testing.T) {
+	p := New(testHandler{})
+	op := &testOp{keys: []types.ValidatorIndex{1, 2}}
+	require.NoError(t, p.Insert(nil, op))
+	require.NoError(t, p.Insert(nil, &testOp{keys: []types.ValidatorIndex{3}}))
+
+	p.Remove(op)
+	assert.DeepEqual(t, []types.ValidatorIndex{3}, keysOf(p.Entries()))
+	assert.Equal(t, false, p.Included(1))
+	require.NoError(t, p.Insert(nil, &testOp{keys: []types.ValidatorIndex{1}}))
+}
+
+func TestPool_Prune(t *testing.T) {
+	p := New(testHandler{})
+	require.NoError(t, p.Insert(nil, &testOp{keys: []types.ValidatorIndex{1}}))
+	require.NoError(t, p.Insert(nil, &testOp{keys: []types.ValidatorIndex{2}}))
+	p.handler = prunedHandler{}
+	require.NoError(t, p.Prune(nil))
+	assert.Equal(t, 0, len(p.Entries()))
+}
+
+func TestPool_SaveLoad(t *testing.T) {
+	ctx := context.Background()
+	store := make(memoryStore)
+	p := New(testHandler{})
+	require.NoError(t, p.Insert(nil, &testOp{slot: 1, keys: []types.ValidatorIndex{1, 2}}))
+	require.NoError(t, p.Insert(nil, &testOp{slot: 2, keys: []types.ValidatorIndex{3}}))
+	require.NoError(t, p.Save(ctx, store))
+	assert.Equal(t, 2, len(store["test_operations"]))
+
+	loaded := New(testHandler{})
+	require.NoError(t, loaded.Load(ctx, store))
+	assert.DeepEqual(t, p.Entries(), loaded.Entries())
+	assert.Equal(t, 2, len(loaded.Pending()))
+}
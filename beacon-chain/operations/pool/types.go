@@ -0,0 +1,41 @@
+package pool
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+)
+
+// Operation is a block operation which can be kept in a pool.
+type Operation interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// Handler defines how the operations of a given type are kept in a pool. New operation
+// types plug into the pool by implementing this interface.
+type Handler interface {
+	// Name identifies the operation type in metrics and persistence.
+	Name() string
+	// MaxPerBlock is the maximum number of operations of this type in a block.
+	MaxPerBlock() uint64
+	// Keys returns the indices of the validators the operation applies to. The pool holds at
+	// most one pending operation per validator.
+	Keys(op Operation) []types.ValidatorIndex
+	// Eligible returns whether an operation for the validator could still be included in a
+	// block built on top of the given state. Operations which are no longer eligible are
+	// pruned from the pool.
+	Eligible(state iface.ReadOnlyBeaconState, key types.ValidatorIndex) (bool, error)
+	// Ready returns whether the operation can be included in a block at the given slot.
+	Ready(op Operation, slot types.Slot) bool
+	// Replace returns whether op should replace the pending operation for the same validator.
+	Replace(pending, op Operation) bool
+	// Unmarshal decodes an operation encoded with MarshalSSZ.
+	Unmarshal(enc []byte) (Operation, error)
+}
+
+// Store persists the pending operations of pools across restarts.
+type Store interface {
+	SaveOperationPool(ctx context.Context, name string, ops [][]byte) error
+	OperationPool(ctx context.Context, name string) ([][]byte, error)
+}
@@ -0,0 +1,109 @@
+package slashings
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/pool"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// attesterSlashingHandler keeps attester slashings in a pool, keyed by each of the slashed validators.
+type attesterSlashingHandler struct{}
+
+// Name of attester slashings in metrics and persistence.
+func (attesterSlashingHandler) Name() string {
+	return "attester_slashings"
+}
+
+// MaxPerBlock returns MaxAttesterSlashings.
+func (attesterSlashingHandler) MaxPerBlock() uint64 {
+	return params.BeaconConfig().MaxAttesterSlashings
+}
+
+// Keys returns the validators attesting in both attestations of the slashing.
+func (attesterSlashingHandler) Keys(op pool.Operation) []types.ValidatorIndex {
+	slashing := op.(*ethpb.AttesterSlashing)
+	slashedVal := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+	keys := make([]types.ValidatorIndex, len(slashedVal))
+	for i, val := range slashedVal {
+		keys[i] = types.ValidatorIndex(val)
+	}
+	return keys
+}
+
+// Eligible returns whether the validator is slashable.
+func (attesterSlashingHandler) Eligible(state iface.ReadOnlyBeaconState, key types.ValidatorIndex) (bool, error) {
+	return slashable(state, key)
+}
+
+// Ready always returns true, slashings can be included as soon as they are received.
+func (attesterSlashingHandler) Ready(_ pool.Operation, _ types.Slot) bool {
+	return true
+}
+
+// Replace always returns false, the first slashing received for a validator is kept.
+func (attesterSlashingHandler) Replace(_, _ pool.Operation) bool {
+	return false
+}
+
+// Unmarshal decodes an attester slashing.
+func (attesterSlashingHandler) Unmarshal(enc []byte) (pool.Operation, error) {
+	slashing := &ethpb.AttesterSlashing{}
+	if err := slashing.UnmarshalSSZ(enc); err != nil {
+		return nil, err
+	}
+	return slashing, nil
+}
+
+// proposerSlashingHandler keeps proposer slashings in a pool, keyed by the slashed proposer.
+type proposerSlashingHandler struct{}
+
+// Name of proposer slashings in metrics and persistence.
+func (proposerSlashingHandler) Name() string {
+	return "proposer_slashings"
+}
+
+// MaxPerBlock returns MaxProposerSlashings.
+func (proposerSlashingHandler) MaxPerBlock() uint64 {
+	return params.BeaconConfig().MaxProposerSlashings
+}
+
+// Keys returns the slashed proposer.
+func (proposerSlashingHandler) Keys(op pool.Operation) []types.ValidatorIndex {
+	return []types.ValidatorIndex{op.(*ethpb.ProposerSlashing).Header_1.Header.ProposerIndex}
+}
+
+// Eligible returns whether the validator is slashable.
+func (proposerSlashingHandler) Eligible(state iface.ReadOnlyBeaconState, key types.ValidatorIndex) (bool, error) {
+	return slashable(state, key)
+}
+
+// Ready always returns true, slashings can be included as soon as they are received.
+func (proposerSlashingHandler) Ready(_ pool.Operation, _ types.Slot) bool {
+	return true
+}
+
+// Replace always returns false, the first slashing received for a validator is kept.
+func (proposerSlashingHandler) Replace(_, _ pool.Operation) bool {
+	return false
+}
+
+// Unmarshal decodes a proposer slashing.
+func (proposerSlashingHandler) Unmarshal(enc []byte) (pool.Operation, error) {
+	slashing := &ethpb.ProposerSlashing{}
+	if err := slashing.UnmarshalSSZ(enc); err != nil {
+		return nil, err
+	}
+	return slashing, nil
+}
+
+func slashable(state iface.ReadOnlyBeaconState, idx types.ValidatorIndex) (bool, error) {
+	validator, err := state.ValidatorAtIndexReadOnly(idx)
+	if err != nil {
+		return false, err
+	}
+	return helpers.IsSlashableValidatorUsingTrie(validator, helpers.CurrentEpoch(state)), nil
+}
@@ -4,4 +4,4 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-var log = logrus.WithField("prefix", "pool/slashings")
+var log = logrus.WithField("prefix", "slashings/operations")
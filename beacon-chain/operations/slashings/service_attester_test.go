@@ -7,6 +7,8 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -57,8 +59,14 @@ func attesterSlashingForValIdx(valIdx ...uint64) *ethpb.AttesterSlashing {
 	}
 }
 
-func pendingSlashingForValIdx(valIdx ...uint64) *PendingAttesterSlashing {
-	return &PendingAttesterSlashing{
+// pendingAttesterSlashing is an entry of the attester slashing pool.
+type pendingAttesterSlashing struct {
+	attesterSlashing *ethpb.AttesterSlashing
+	validatorToSlash types.ValidatorIndex
+}
+
+func pendingSlashingForValIdx(valIdx ...uint64) *pendingAttesterSlashing {
+	return &pendingAttesterSlashing{
 		attesterSlashing: attesterSlashingForValIdx(valIdx...),
 		validatorToSlash: types.ValidatorIndex(valIdx[0]),
 	}
@@ -66,7 +74,7 @@ func pendingSlashingForValIdx(valIdx ...uint64) *PendingAttesterSlashing {
 
 func TestPool_InsertAttesterSlashing(t *testing.T) {
 	type fields struct {
-		pending  []*PendingAttesterSlashing
+		pending  []*pendingAttesterSlashing
 		included map[types.ValidatorIndex]bool
 		wantErr  []bool
 	}
@@ -75,12 +83,12 @@ func TestPool_InsertAttesterSlashing(t *testing.T) {
 	}
 
 	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
-	pendingSlashings := make([]*PendingAttesterSlashing, 20)
+	pendingSlashings := make([]*pendingAttesterSlashing, 20)
 	slashings := make([]*ethpb.AttesterSlashing, 20)
 	for i := 0; i < len(pendingSlashings); i++ {
 		sl, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[i], types.ValidatorIndex(i))
 		require.NoError(t, err)
-		pendingSlashings[i] = &PendingAttesterSlashing{
+		pendingSlashings[i] = &pendingAttesterSlashing{
 			attesterSlashing: sl,
 			validatorToSlash: types.ValidatorIndex(i),
 		}
@@ -115,20 +123,20 @@ func TestPool_InsertAttesterSlashing(t *testing.T) {
 		name   string
 		fields fields
 		args   args
-		want   []*PendingAttesterSlashing
+		want   []*pendingAttesterSlashing
 		err    string
 	}{
 		{
 			name: "Empty list",
 			fields: fields{
-				pending:  make([]*PendingAttesterSlashing, 0),
+				pending:  make([]*pendingAttesterSlashing, 0),
 				included: make(map[types.ValidatorIndex]bool),
 				wantErr:  []bool{false},
 			},
 			args: args{
 				slashings: slashings[0:1],
 			},
-			want: []*PendingAttesterSlashing{
+			want: []*pendingAttesterSlashing{
 				{
 					attesterSlashing: slashings[0],
 					validatorToSlash: 0,
@@ -138,7 +146,7 @@ func TestPool_InsertAttesterSlashing(t *testing.T) {
 		{
 			name: "Empty list two validators slashed",
 			fields: fields{
-				pending:  make([]*PendingAttesterSlashing, 0),
+				pending:  make([]*pendingAttesterSlashing, 0),
 				included: make(map[types.ValidatorIndex]bool),
 				wantErr:  []bool{false, false},
 			},
@@ -150,7 +158,7 @@ func TestPool_InsertAttesterSlashing(t *testing.T) {
 		{
 			name: "Duplicate identical slashing",
 			fields: fields{
-				pending: []*PendingAttesterSlashing{
+				pending: []*pendingAttesterSlashing{
 					pendingSlashings[1],
 				},
 				included: make(map[types.ValidatorIndex]bool),
@@ -164,31 +172,31 @@ func TestPool_InsertAttesterSlashing(t *testing.T) {
 		{
 			name: "Slashing for already exit validator",
 			fields: fields{
-				pending:  []*PendingAttesterSlashing{},
+				pending:  []*pendingAttesterSlashing{},
 				included: make(map[types.ValidatorIndex]bool),
 				wantErr:  []bool{true},
 			},
 			args: args{
 				slashings: slashings[5:6],
 			},
-			want: []*PendingAttesterSlashing{},
+			want: []*pendingAttesterSlashing{},
 		},
 		{
 			name: "Slashing for withdrawable validator",
 			fields: fields{
-				pending:  []*PendingAttesterSlashing{},
+				pending:  []*pendingAttesterSlashing{},
 				included: make(map[types.ValidatorIndex]bool),
 				wantErr:  []bool{true},
 			},
 			args: args{
 				slashings: slashings[2:3],
 			},
-			want: []*PendingAttesterSlashing{},
+			want: []*pendingAttesterSlashing{},
 		},
 		{
 			name: "Slashing for slashed validator",
 			fields: fields{
-				pending:  []*PendingAttesterSlashing{},
+				pending:  []*pendingAttesterSlashing{},
 				included: make(map[types.ValidatorIndex]bool),
 				wantErr:  []bool{false},
 			},
@@ -200,7 +208,7 @@ func TestPool_InsertAttesterSlashing(t *testing.T) {
 		{
 			name: "Already included",
 			fields: fields{
-				pending: []*PendingAttesterSlashing{},
+				pending: []*pendingAttesterSlashing{},
 				included: map[types.ValidatorIndex]bool{
 					1: true,
 				},
@@ -209,12 +217,12 @@ func TestPool_InsertAttesterSlashing(t *testing.T) {
 			args: args{
 				slashings: slashings[1:2],
 			},
-			want: []*PendingAttesterSlashing{},
+			want: []*pendingAttesterSlashing{},
 		},
 		{
 			name: "Maintains sorted order",
 			fields: fields{
-				pending: []*PendingAttesterSlashing{
+				pending: []*pendingAttesterSlashing{
 					pendingSlashings[0],
 					pendingSlashings[2],
 				},
@@ -229,7 +237,7 @@ func TestPool_InsertAttesterSlashing(t *testing.T) {
 		{
 			name: "Doesn't reject partially slashed slashings",
 			fields: fields{
-				pending:  []*PendingAttesterSlashing{},
+				pending:  []*pendingAttesterSlashing{},
 				included: make(map[types.ValidatorIndex]bool),
 				wantErr:  []bool{false, false, false, true},
 			},
@@ -241,7 +249,7 @@ func TestPool_InsertAttesterSlashing(t *testing.T) {
 					aggSlashing4,
 				},
 			},
-			want: []*PendingAttesterSlashing{
+			want: []*pendingAttesterSlashing{
 				{
 					attesterSlashing: aggSlashing1,
 					validatorToSlash: 0,
@@ -271,10 +279,7 @@ func TestPool_InsertAttesterSlashing(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &Pool{
-				pendingAttesterSlashing: tt.fields.pending,
-				included:                tt.fields.included,
-			}
+			p := poolWithSlashings(t, tt.fields.pending, nil, tt.fields.included)
 			var err error
 			for i := 0; i < len(tt.args.slashings); i++ {
 				err = p.InsertAttesterSlashing(context.Background(), beaconState, tt.args.slashings[i])
@@ -284,11 +289,12 @@ func TestPool_InsertAttesterSlashing(t *testing.T) {
 					assert.NoError(t, err)
 				}
 			}
-			assert.Equal(t, len(tt.want), len(p.pendingAttesterSlashing))
+			pending := pendingAttesterSlashings(p)
+			assert.Equal(t, len(tt.want), len(pending))
 
-			for i := range p.pendingAttesterSlashing {
-				assert.Equal(t, tt.want[i].validatorToSlash, p.pendingAttesterSlashing[i].validatorToSlash)
-				assert.DeepEqual(t, tt.want[i].attesterSlashing, p.pendingAttesterSlashing[i].attesterSlashing, "At index %d", i)
+			for i := range pending {
+				assert.Equal(t, tt.want[i].validatorToSlash, pending[i].validatorToSlash)
+				assert.DeepEqual(t, tt.want[i].attesterSlashing, pending[i].attesterSlashing, "At index %d", i)
 			}
 		})
 	}
@@ -300,12 +306,12 @@ func TestPool_InsertAttesterSlashing_SigFailsVerify_ClearPool(t *testing.T) {
 	conf.MaxAttesterSlashings = 2
 	params.OverrideBeaconConfig(conf)
 	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
-	pendingSlashings := make([]*PendingAttesterSlashing, 2)
+	pendingSlashings := make([]*pendingAttesterSlashing, 2)
 	slashings := make([]*ethpb.AttesterSlashing, 2)
 	for i := 0; i < 2; i++ {
 		sl, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[i], types.ValidatorIndex(i))
 		require.NoError(t, err)
-		pendingSlashings[i] = &PendingAttesterSlashing{
+		pendingSlashings[i] = &pendingAttesterSlashing{
 			attesterSlashing: sl,
 			validatorToSlash: types.ValidatorIndex(i),
 		}
@@ -316,18 +322,16 @@ func TestPool_InsertAttesterSlashing_SigFailsVerify_ClearPool(t *testing.T) {
 	copy(badSig, "muahaha")
 	pendingSlashings[1].attesterSlashing.Attestation_1.Signature = badSig
 	slashings[1].Attestation_1.Signature = badSig
-	p := &Pool{
-		pendingAttesterSlashing: make([]*PendingAttesterSlashing, 0),
-	}
+	p := NewPool()
 	require.NoError(t, p.InsertAttesterSlashing(context.Background(), beaconState, slashings[0]))
 	err := p.InsertAttesterSlashing(context.Background(), beaconState, slashings[1])
 	require.ErrorContains(t, "could not verify attester slashing", err, "Expected error when inserting slashing with bad sig")
-	assert.Equal(t, 1, len(p.pendingAttesterSlashing))
+	assert.Equal(t, 1, len(pendingAttesterSlashings(p)))
 }
 
 func TestPool_MarkIncludedAttesterSlashing(t *testing.T) {
 	type fields struct {
-		pending  []*PendingAttesterSlashing
+		pending  []*pendingAttesterSlashing
 		included map[types.ValidatorIndex]bool
 	}
 	type args struct {
@@ -342,7 +346,7 @@ func TestPool_MarkIncludedAttesterSlashing(t *testing.T) {
 		{
 			name: "Included, does not exist in pending",
 			fields: fields{
-				pending: []*PendingAttesterSlashing{
+				pending: []*pendingAttesterSlashing{
 					{
 						attesterSlashing: attesterSlashingForValIdx(1),
 						validatorToSlash: 1,
@@ -354,7 +358,7 @@ func TestPool_MarkIncludedAttesterSlashing(t *testing.T) {
 				slashing: attesterSlashingForValIdx(3),
 			},
 			want: fields{
-				pending: []*PendingAttesterSlashing{
+				pending: []*pendingAttesterSlashing{
 					pendingSlashingForValIdx(1),
 				},
 				included: map[types.ValidatorIndex]bool{
@@ -365,7 +369,7 @@ func TestPool_MarkIncludedAttesterSlashing(t *testing.T) {
 		{
 			name: "Removes from pending list",
 			fields: fields{
-				pending: []*PendingAttesterSlashing{
+				pending: []*pendingAttesterSlashing{
 					pendingSlashingForValIdx(1),
 					pendingSlashingForValIdx(2),
 					pendingSlashingForValIdx(3),
@@ -378,7 +382,7 @@ func TestPool_MarkIncludedAttesterSlashing(t *testing.T) {
 				slashing: attesterSlashingForValIdx(2),
 			},
 			want: fields{
-				pending: []*PendingAttesterSlashing{
+				pending: []*pendingAttesterSlashing{
 					pendingSlashingForValIdx(1),
 					pendingSlashingForValIdx(3),
 				},
@@ -391,7 +395,7 @@ func TestPool_MarkIncludedAttesterSlashing(t *testing.T) {
 		{
 			name: "Removes from long pending list",
 			fields: fields{
-				pending: []*PendingAttesterSlashing{
+				pending: []*pendingAttesterSlashing{
 					pendingSlashingForValIdx(1),
 					pendingSlashingForValIdx(2),
 					pendingSlashingForValIdx(3),
@@ -412,7 +416,7 @@ func TestPool_MarkIncludedAttesterSlashing(t *testing.T) {
 				slashing: attesterSlashingForValIdx(6),
 			},
 			want: fields{
-				pending: []*PendingAttesterSlashing{
+				pending: []*pendingAttesterSlashing{
 					pendingSlashingForValIdx(1),
 					pendingSlashingForValIdx(2),
 					pendingSlashingForValIdx(3),
@@ -433,33 +437,33 @@ func TestPool_MarkIncludedAttesterSlashing(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &Pool{
-				pendingAttesterSlashing: tt.fields.pending,
-				included:                tt.fields.included,
-			}
+			p := poolWithSlashings(t, tt.fields.pending, nil, tt.fields.included)
 			p.MarkIncludedAttesterSlashing(tt.args.slashing)
-			assert.Equal(t, len(tt.want.pending), len(p.pendingAttesterSlashing))
-			for i := range p.pendingAttesterSlashing {
-				assert.DeepEqual(t, tt.want.pending[i], p.pendingAttesterSlashing[i])
+			pending := pendingAttesterSlashings(p)
+			assert.Equal(t, len(tt.want.pending), len(pending))
+			for i := range pending {
+				assert.DeepEqual(t, tt.want.pending[i], pending[i])
+			}
+			for idx := range tt.want.included {
+				assert.Equal(t, true, p.attesterSlashings.Included(idx), "Validator %d not included", idx)
 			}
-			assert.DeepEqual(t, tt.want.included, p.included)
 		})
 	}
 }
 
 func TestPool_PendingAttesterSlashings(t *testing.T) {
 	type fields struct {
-		pending []*PendingAttesterSlashing
+		pending []*pendingAttesterSlashing
 		all     bool
 	}
 	params.SetupTestConfigCleanup(t)
 	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
-	pendingSlashings := make([]*PendingAttesterSlashing, 20)
+	pendingSlashings := make([]*pendingAttesterSlashing, 20)
 	slashings := make([]*ethpb.AttesterSlashing, 20)
 	for i := 0; i < len(pendingSlashings); i++ {
 		sl, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[i], types.ValidatorIndex(i))
 		require.NoError(t, err)
-		pendingSlashings[i] = &PendingAttesterSlashing{
+		pendingSlashings[i] = &pendingAttesterSlashing{
 			attesterSlashing: sl,
 			validatorToSlash: types.ValidatorIndex(i),
 		}
@@ -473,7 +477,7 @@ func TestPool_PendingAttesterSlashings(t *testing.T) {
 		{
 			name: "Empty list",
 			fields: fields{
-				pending: []*PendingAttesterSlashing{},
+				pending: []*pendingAttesterSlashing{},
 			},
 			want: []*ethpb.AttesterSlashing{},
 		},
@@ -502,9 +506,7 @@ func TestPool_PendingAttesterSlashings(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &Pool{
-				pendingAttesterSlashing: tt.fields.pending,
-			}
+			p := poolWithSlashings(t, tt.fields.pending, nil, nil)
 			assert.DeepEqual(t, tt.want, p.PendingAttesterSlashings(context.Background(), beaconState, tt.fields.all))
 		})
 	}
@@ -512,7 +514,7 @@ func TestPool_PendingAttesterSlashings(t *testing.T) {
 
 func TestPool_PendingAttesterSlashings_Slashed(t *testing.T) {
 	type fields struct {
-		pending []*PendingAttesterSlashing
+		pending []*pendingAttesterSlashing
 		all     bool
 	}
 	params.SetupTestConfigCleanup(t)
@@ -528,17 +530,17 @@ func TestPool_PendingAttesterSlashings_Slashed(t *testing.T) {
 	require.NoError(t, err)
 	val.Slashed = true
 	require.NoError(t, beaconState.UpdateValidatorAtIndex(5, val))
-	pendingSlashings := make([]*PendingAttesterSlashing, 20)
-	pendingSlashings2 := make([]*PendingAttesterSlashing, 20)
+	pendingSlashings := make([]*pendingAttesterSlashing, 20)
+	pendingSlashings2 := make([]*pendingAttesterSlashing, 20)
 	slashings := make([]*ethpb.AttesterSlashing, 20)
 	for i := 0; i < len(pendingSlashings); i++ {
 		sl, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[i], types.ValidatorIndex(i))
 		require.NoError(t, err)
-		pendingSlashings[i] = &PendingAttesterSlashing{
+		pendingSlashings[i] = &pendingAttesterSlashing{
 			attesterSlashing: sl,
 			validatorToSlash: types.ValidatorIndex(i),
 		}
-		pendingSlashings2[i] = &PendingAttesterSlashing{
+		pendingSlashings2[i] = &pendingAttesterSlashing{
 			attesterSlashing: sl,
 			validatorToSlash: types.ValidatorIndex(i),
 		}
@@ -575,7 +577,7 @@ func TestPool_PendingAttesterSlashings_Slashed(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &Pool{pendingAttesterSlashing: tt.fields.pending}
+			p := poolWithSlashings(t, tt.fields.pending, nil, nil)
 			assert.DeepEqual(t, tt.want, p.PendingAttesterSlashings(context.Background(), beaconState, tt.fields.all /*noLimit*/))
 		})
 	}
@@ -587,12 +589,12 @@ func TestPool_PendingAttesterSlashings_NoDuplicates(t *testing.T) {
 	conf.MaxAttesterSlashings = 2
 	params.OverrideBeaconConfig(conf)
 	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
-	pendingSlashings := make([]*PendingAttesterSlashing, 3)
+	pendingSlashings := make([]*pendingAttesterSlashing, 3)
 	slashings := make([]*ethpb.AttesterSlashing, 3)
 	for i := 0; i < 2; i++ {
 		sl, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[i], types.ValidatorIndex(i))
 		require.NoError(t, err)
-		pendingSlashings[i] = &PendingAttesterSlashing{
+		pendingSlashings[i] = &pendingAttesterSlashing{
 			attesterSlashing: sl,
 			validatorToSlash: types.ValidatorIndex(i),
 		}
@@ -601,8 +603,55 @@ func TestPool_PendingAttesterSlashings_NoDuplicates(t *testing.T) {
 	// We duplicate the last slashing.
 	pendingSlashings[2] = pendingSlashings[1]
 	slashings[2] = slashings[1]
-	p := &Pool{
-		pendingAttesterSlashing: pendingSlashings,
-	}
+	p := poolWithSlashings(t, pendingSlashings, nil, nil)
 	assert.DeepEqual(t, slashings[0:2], p.PendingAttesterSlashings(context.Background(), beaconState, false /*noLimit*/))
 }
+
+// poolWithSlashings returns a pool holding the given slashings and recently included validators,
+// regardless of whether the validators are slashable.
+func poolWithSlashings(
+	t *testing.T,
+	attesterSlashings []*pendingAttesterSlashing,
+	proposerSlashings []*ethpb.ProposerSlashing,
+	included map[types.ValidatorIndex]bool,
+) *Pool {
+	validators := make([]*ethpb.Validator, 64)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	s, err := stateV0.InitializeFromProtoUnsafe(&pb.BeaconState{Validators: validators})
+	require.NoError(t, err)
+
+	p := NewPool()
+	seen := make(map[*ethpb.AttesterSlashing]bool)
+	for _, slashing := range attesterSlashings {
+		if seen[slashing.attesterSlashing] {
+			continue
+		}
+		seen[slashing.attesterSlashing] = true
+		require.NoError(t, p.attesterSlashings.Insert(s, slashing.attesterSlashing))
+	}
+	for _, slashing := range proposerSlashings {
+		require.NoError(t, p.proposerSlashings.Insert(s, slashing))
+	}
+	for idx := range included {
+		p.attesterSlashings.MarkIncluded(attesterSlashingForValIdx(uint64(idx)))
+		p.proposerSlashings.MarkIncluded(proposerSlashingForValIdx(idx))
+	}
+	return p
+}
+
+func pendingAttesterSlashings(p *Pool) []*pendingAttesterSlashing {
+	entries := p.attesterSlashings.Entries()
+	pending := make([]*pendingAttesterSlashing, len(entries))
+	for i, e := range entries {
+		pending[i] = &pendingAttesterSlashing{
+			attesterSlashing: e.Operation.(*ethpb.AttesterSlashing),
+			validatorToSlash: e.ValidatorIndex,
+		}
+	}
+	return pending
+}
@@ -79,7 +79,7 @@ func TestPool_InsertProposerSlashing(t *testing.T) {
 			fields: fields{
 				pending:   slashings[0:1],
 				included:  make(map[types.ValidatorIndex]bool),
-				wantedErr: "slashing object already exists in pending proposer slashings",
+				wantedErr: "already exists in pool",
 			},
 			args: args{
 				slashings: slashings[0:1],
@@ -128,7 +128,7 @@ func TestPool_InsertProposerSlashing(t *testing.T) {
 				included: map[types.ValidatorIndex]bool{
 					1: true,
 				},
-				wantedErr: "cannot be slashed",
+				wantedErr: "is not eligible",
 			},
 			args: args{
 				slashings: slashings[1:2],
@@ -156,10 +156,7 @@ func TestPool_InsertProposerSlashing(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &Pool{
-				pendingProposerSlashing: tt.fields.pending,
-				included:                tt.fields.included,
-			}
+			p := poolWithSlashings(t, nil, tt.fields.pending, tt.fields.included)
 			var err error
 			for i := 0; i < len(tt.args.slashings); i++ {
 				err = p.InsertProposerSlashing(context.Background(), beaconState, tt.args.slashings[i])
@@ -169,10 +166,11 @@ func TestPool_InsertProposerSlashing(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 			}
-			assert.Equal(t, len(tt.want), len(p.pendingProposerSlashing))
-			for i := range p.pendingAttesterSlashing {
-				assert.Equal(t, p.pendingProposerSlashing[i].Header_1.Header.ProposerIndex, tt.want[i].Header_1.Header.ProposerIndex)
-				assert.DeepEqual(t, tt.want[i], p.pendingProposerSlashing[i], "Proposer slashing at index %d does not match expected", i)
+			pending := pendingProposerSlashings(p)
+			assert.Equal(t, len(tt.want), len(pending))
+			for i := range pending {
+				assert.Equal(t, pending[i].Header_1.Header.ProposerIndex, tt.want[i].Header_1.Header.ProposerIndex)
+				assert.DeepEqual(t, tt.want[i], pending[i], "Proposer slashing at index %d does not match expected", i)
 			}
 		})
 	}
@@ -194,14 +192,12 @@ func TestPool_InsertProposerSlashing_SigFailsVerify_ClearPool(t *testing.T) {
 	badSig := make([]byte, 96)
 	copy(badSig, "muahaha")
 	slashings[1].Header_1.Signature = badSig
-	p := &Pool{
-		pendingProposerSlashing: make([]*ethpb.ProposerSlashing, 0),
-	}
+	p := NewPool()
 	// We only want a single slashing to remain.
 	require.NoError(t, p.InsertProposerSlashing(context.Background(), beaconState, slashings[0]))
 	err := p.InsertProposerSlashing(context.Background(), beaconState, slashings[1])
 	require.ErrorContains(t, "could not verify proposer slashing", err, "Expected slashing with bad signature to fail")
-	assert.Equal(t, 1, len(p.pendingProposerSlashing))
+	assert.Equal(t, 1, len(pendingProposerSlashings(p)))
 }
 
 func TestPool_MarkIncludedProposerSlashing(t *testing.T) {
@@ -307,16 +303,16 @@ func TestPool_MarkIncludedProposerSlashing(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &Pool{
-				pendingProposerSlashing: tt.fields.pending,
-				included:                tt.fields.included,
-			}
+			p := poolWithSlashings(t, nil, tt.fields.pending, tt.fields.included)
 			p.MarkIncludedProposerSlashing(tt.args.slashing)
-			assert.Equal(t, len(tt.want.pending), len(p.pendingProposerSlashing))
-			for i := range p.pendingProposerSlashing {
-				assert.DeepSSZEqual(t, tt.want.pending[i], p.pendingProposerSlashing[i], "Unexpected pending proposer slashing at index %d", i)
+			pending := pendingProposerSlashings(p)
+			assert.Equal(t, len(tt.want.pending), len(pending))
+			for i := range pending {
+				assert.DeepSSZEqual(t, tt.want.pending[i], pending[i], "Unexpected pending proposer slashing at index %d", i)
+			}
+			for idx := range tt.want.included {
+				assert.Equal(t, true, p.proposerSlashings.Included(idx), "Validator %d not included", idx)
 			}
-			assert.DeepEqual(t, tt.want.included, p.included)
 		})
 	}
 }
@@ -370,9 +366,7 @@ func TestPool_PendingProposerSlashings(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &Pool{
-				pendingProposerSlashing: tt.fields.pending,
-			}
+			p := poolWithSlashings(t, nil, tt.fields.pending, nil)
 			assert.DeepEqual(t, tt.want, p.PendingProposerSlashings(context.Background(), beaconState, tt.fields.noLimit))
 		})
 	}
@@ -426,9 +420,7 @@ func TestPool_PendingProposerSlashings_Slashed(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &Pool{
-				pendingProposerSlashing: tt.fields.pending,
-			}
+			p := poolWithSlashings(t, nil, tt.fields.pending, nil)
 			result := p.PendingProposerSlashings(context.Background(), beaconState, tt.fields.all /*noLimit*/)
 			t.Log(tt.want[0].Header_1.Header.ProposerIndex)
 			t.Log(result[0].Header_1.Header.ProposerIndex)
@@ -436,3 +428,12 @@ func TestPool_PendingProposerSlashings_Slashed(t *testing.T) {
 		})
 	}
 }
+
+func pendingProposerSlashings(p *Pool) []*ethpb.ProposerSlashing {
+	ops := p.proposerSlashings.Pending()
+	pending := make([]*ethpb.ProposerSlashing, len(ops))
+	for i, op := range ops {
+		pending[i] = op.(*ethpb.ProposerSlashing)
+	}
+	return pending
+}
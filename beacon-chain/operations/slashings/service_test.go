@@ -1,13 +0,0 @@
-package slashings
-
-import (
-	"testing"
-
-	"github.com/prysmaticlabs/prysm/shared/testutil/require"
-)
-
-func TestPool_validatorSlashingPreconditionCheck_requiresLock(t *testing.T) {
-	p := &Pool{}
-	_, err := p.validatorSlashingPreconditionCheck(nil, 0)
-	require.ErrorContains(t, "caller must hold read/write lock", err)
-}
@@ -2,10 +2,9 @@ package slashings
 
 import (
 	"context"
-	"sync"
 
-	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/pool"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 )
 
@@ -30,15 +29,6 @@ type PoolManager interface {
 
 // Pool is a concrete implementation of PoolManager.
 type Pool struct {
-	lock                    sync.RWMutex
-	pendingProposerSlashing []*ethpb.ProposerSlashing
-	pendingAttesterSlashing []*PendingAttesterSlashing
-	included                map[types.ValidatorIndex]bool
-}
-
-// PendingAttesterSlashing represents an attester slashing in the operation pool.
-// Allows for easy binary searching of included validator indexes.
-type PendingAttesterSlashing struct {
-	attesterSlashing *ethpb.AttesterSlashing
-	validatorToSlash types.ValidatorIndex
+	attesterSlashings *pool.Pool
+	proposerSlashings *pool.Pool
 }
@@ -0,0 +1,36 @@
+package slashings
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// ErrNoSlashableValidator is returned when none of the validators targeted by an attester slashing
+// can still be slashed, so that including the slashing in a block would have no effect.
+var ErrNoSlashableValidator = errors.New("no slashable validator in attester slashing")
+
+// Validate checks the attester slashing against the given state. The slashing must be valid, and
+// at least one of the validators attesting in both attestations must still be slashable, that is
+// active or exited but not yet withdrawable, and not already slashed.
+func Validate(ctx context.Context, state iface.ReadOnlyBeaconState, slashing *ethpb.AttesterSlashing) error {
+	if err := blocks.VerifyAttesterSlashing(ctx, state, slashing); err != nil {
+		return errors.Wrap(err, "could not verify attester slashing")
+	}
+	slashedVal := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+	for _, val := range slashedVal {
+		ok, err := slashable(state, types.ValidatorIndex(val))
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+	return ErrNoSlashableValidator
+}
@@ -0,0 +1,56 @@
+package slashings
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestValidate(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	slashing := validAttesterSlashingForValIdx(t, beaconState, privKeys, 1, 2)
+	require.NoError(t, Validate(context.Background(), beaconState, slashing))
+
+	// The slashing remains useful as long as one of its validators is slashable.
+	val, err := beaconState.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, beaconState.UpdateValidatorAtIndex(1, val))
+	require.NoError(t, Validate(context.Background(), beaconState, slashing))
+
+	val, err = beaconState.ValidatorAtIndex(2)
+	require.NoError(t, err)
+	val.ExitEpoch = 0
+	val.WithdrawableEpoch = 0
+	require.NoError(t, beaconState.UpdateValidatorAtIndex(2, val))
+	assert.ErrorContains(t, ErrNoSlashableValidator.Error(), Validate(context.Background(), beaconState, slashing))
+
+	assert.ErrorContains(t, "could not verify attester slashing", Validate(context.Background(), beaconState, attesterSlashingForValIdx(3)))
+}
+
+func TestPool_PendingAttesterSlashings_RemovesInvalid(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	valid, err := testutil.GenerateAttesterSlashingForValidator(beaconState, privKeys[2], 2)
+	require.NoError(t, err)
+	pending := []*pendingAttesterSlashing{
+		pendingSlashingForValIdx(1),
+		{attesterSlashing: valid, validatorToSlash: 2},
+	}
+	p := poolWithSlashings(t, pending, nil, nil)
+
+	// Slashings listed without limit are not re-validated.
+	assert.Equal(t, 2, len(p.PendingAttesterSlashings(context.Background(), beaconState, true /*noLimit*/)))
+
+	assert.DeepEqual(t, []*ethpb.AttesterSlashing{valid}, p.PendingAttesterSlashings(context.Background(), beaconState, false /*noLimit*/))
+	entries := p.attesterSlashings.Entries()
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, types.ValidatorIndex(2), entries[0].ValidatorIndex)
+}
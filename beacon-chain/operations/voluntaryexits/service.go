@@ -2,12 +2,11 @@ package voluntaryexits
 
 import (
 	"context"
-	"sort"
-	"sync"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/pool"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
@@ -23,103 +22,100 @@ type PoolManager interface {
 
 // Pool is a concrete implementation of PoolManager.
 type Pool struct {
-	lock    sync.RWMutex
-	pending []*ethpb.SignedVoluntaryExit
+	exits *pool.Pool
 }
 
-// NewPool accepts a head fetcher (for reading the validator set) and returns an initialized
-// voluntary exit pool.
+// NewPool returns an initialized voluntary exit pool.
 func NewPool() *Pool {
 	return &Pool{
-		pending: make([]*ethpb.SignedVoluntaryExit, 0),
+		exits: pool.New(exitHandler{}),
 	}
 }
 
 // PendingExits returns exits that are ready for inclusion at the given slot. This method will not
 // return more than the block enforced MaxVoluntaryExits.
 func (p *Pool) PendingExits(state iface.ReadOnlyBeaconState, slot types.Slot, noLimit bool) []*ethpb.SignedVoluntaryExit {
-	p.lock.RLock()
-	defer p.lock.RUnlock()
-
-	// Allocate pending slice with a capacity of min(len(p.pending), maxVoluntaryExits) since the
-	// array cannot exceed the max and is typically less than the max value.
-	maxExits := params.BeaconConfig().MaxVoluntaryExits
-	if noLimit {
-		maxExits = uint64(len(p.pending))
-	}
-	pending := make([]*ethpb.SignedVoluntaryExit, 0, maxExits)
-	for _, e := range p.pending {
-		if e.Exit.Epoch > helpers.SlotToEpoch(slot) {
-			continue
-		}
-		if v, err := state.ValidatorAtIndexReadOnly(e.Exit.ValidatorIndex); err == nil &&
-			v.ExitEpoch() == params.BeaconConfig().FarFutureEpoch {
-			pending = append(pending, e)
-			if uint64(len(pending)) == maxExits {
-				break
-			}
-		}
+	ops := p.exits.Select(state, slot, noLimit)
+	pending := make([]*ethpb.SignedVoluntaryExit, len(ops))
+	for i, op := range ops {
+		pending[i] = op.(*ethpb.SignedVoluntaryExit)
 	}
 	return pending
 }
 
 // InsertVoluntaryExit into the pool. This method is a no-op if the pending exit already exists,
-// or the validator is already exited.
+// or the validator is already exited. A pending exit is replaced by an exit with an earlier epoch.
 func (p *Pool) InsertVoluntaryExit(ctx context.Context, state iface.ReadOnlyBeaconState, exit *ethpb.SignedVoluntaryExit) {
-	ctx, span := trace.StartSpan(ctx, "exitPool.InsertVoluntaryExit")
+	_, span := trace.StartSpan(ctx, "exitPool.InsertVoluntaryExit")
 	defer span.End()
-	p.lock.Lock()
-	defer p.lock.Unlock()
 
 	// Prevent malformed messages from being inserted.
 	if exit == nil || exit.Exit == nil {
 		return
 	}
-
-	existsInPending, index := existsInList(p.pending, exit.Exit.ValidatorIndex)
-	// If the item exists in the pending list and includes a more favorable, earlier
-	// exit epoch, we replace it in the pending list. If it exists but the prior condition is false,
-	// we simply return.
-	if existsInPending {
-		if exit.Exit.Epoch < p.pending[index].Exit.Epoch {
-			p.pending[index] = exit
-		}
-		return
-	}
-
-	// Has the validator been exited already?
-	if v, err := state.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex); err != nil ||
-		v.ExitEpoch() != params.BeaconConfig().FarFutureEpoch {
-		return
-	}
-
-	// Insert into pending list and sort.
-	p.pending = append(p.pending, exit)
-	sort.Slice(p.pending, func(i, j int) bool {
-		return p.pending[i].Exit.ValidatorIndex < p.pending[j].Exit.ValidatorIndex
-	})
+	// Exits which cannot be added to the pool are dropped silently.
+	_ = p.exits.Insert(state, exit)
 }
 
 // MarkIncluded is used when an exit has been included in a beacon block. Every block seen by this
 // node should call this method to include the exit. This will remove the exit from
 // the pending exits slice.
 func (p *Pool) MarkIncluded(exit *ethpb.SignedVoluntaryExit) {
-	p.lock.Lock()
-	defer p.lock.Unlock()
-	exists, index := existsInList(p.pending, exit.Exit.ValidatorIndex)
-	if exists {
-		// Exit we want is present at p.pending[index], so we remove it.
-		p.pending = append(p.pending[:index], p.pending[index+1:]...)
+	p.exits.MarkIncluded(exit)
+}
+
+// Save persists the pending exits of the pool.
+func (p *Pool) Save(ctx context.Context, store pool.Store) error {
+	return p.exits.Save(ctx, store)
+}
+
+// Load restores the pending exits persisted with Save.
+func (p *Pool) Load(ctx context.Context, store pool.Store) error {
+	return p.exits.Load(ctx, store)
+}
+
+// exitHandler keeps signed voluntary exits in a pool, keyed by the exiting validator.
+type exitHandler struct{}
+
+// Name of voluntary exits in metrics and persistence.
+func (exitHandler) Name() string {
+	return "voluntary_exits"
+}
+
+// MaxPerBlock returns MaxVoluntaryExits.
+func (exitHandler) MaxPerBlock() uint64 {
+	return params.BeaconConfig().MaxVoluntaryExits
+}
+
+// Keys returns the exiting validator.
+func (exitHandler) Keys(op pool.Operation) []types.ValidatorIndex {
+	return []types.ValidatorIndex{op.(*ethpb.SignedVoluntaryExit).Exit.ValidatorIndex}
+}
+
+// Eligible returns whether the validator exists and has not exited yet.
+func (exitHandler) Eligible(state iface.ReadOnlyBeaconState, key types.ValidatorIndex) (bool, error) {
+	v, err := state.ValidatorAtIndexReadOnly(key)
+	if err != nil {
+		return false, nil
 	}
+	return v.ExitEpoch() == params.BeaconConfig().FarFutureEpoch, nil
+}
+
+// Ready returns whether the exit epoch has been reached at the given slot.
+func (exitHandler) Ready(op pool.Operation, slot types.Slot) bool {
+	return op.(*ethpb.SignedVoluntaryExit).Exit.Epoch <= helpers.SlotToEpoch(slot)
+}
+
+// Replace returns whether the exit has a more favorable, earlier epoch than the pending exit.
+func (exitHandler) Replace(pending, op pool.Operation) bool {
+	return op.(*ethpb.SignedVoluntaryExit).Exit.Epoch < pending.(*ethpb.SignedVoluntaryExit).Exit.Epoch
 }
 
-// Binary search to check if the index exists in the list of pending exits.
-func existsInList(pending []*ethpb.SignedVoluntaryExit, searchingFor types.ValidatorIndex) (bool, int) {
-	i := sort.Search(len(pending), func(j int) bool {
-		return pending[j].Exit.ValidatorIndex >= searchingFor
-	})
-	if i < len(pending) && pending[i].Exit.ValidatorIndex == searchingFor {
-		return true, i
+// Unmarshal decodes a signed voluntary exit.
+func (exitHandler) Unmarshal(enc []byte) (pool.Operation, error) {
+	exit := &ethpb.SignedVoluntaryExit{}
+	if err := exit.UnmarshalSSZ(enc); err != nil {
+		return nil, err
 	}
-	return false, -1
+	return exit, nil
 }
@@ -267,18 +267,17 @@ func TestPool_InsertVoluntaryExit(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &Pool{
-				pending: tt.fields.pending,
-			}
+			p := poolWithExits(t, tt.fields.pending)
 			s, err := stateV0.InitializeFromProtoUnsafe(&p2ppb.BeaconState{Validators: validators})
 			require.NoError(t, err)
 			p.InsertVoluntaryExit(ctx, s, tt.args.exit)
-			if len(p.pending) != len(tt.want) {
-				t.Fatalf("Mismatched lengths of pending list. Got %d, wanted %d.", len(p.pending), len(tt.want))
+			pending := pendingExits(p)
+			if len(pending) != len(tt.want) {
+				t.Fatalf("Mismatched lengths of pending list. Got %d, wanted %d.", len(pending), len(tt.want))
 			}
-			for i := range p.pending {
-				if !proto.Equal(p.pending[i], tt.want[i]) {
-					t.Errorf("Pending exit at index %d does not match expected. Got=%v wanted=%v", i, p.pending[i], tt.want[i])
+			for i := range pending {
+				if !proto.Equal(pending[i], tt.want[i]) {
+					t.Errorf("Pending exit at index %d does not match expected. Got=%v wanted=%v", i, pending[i], tt.want[i])
 				}
 			}
 		})
@@ -332,16 +331,15 @@ func TestPool_MarkIncluded(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &Pool{
-				pending: tt.fields.pending,
-			}
+			p := poolWithExits(t, tt.fields.pending)
 			p.MarkIncluded(tt.args.exit)
-			if len(p.pending) != len(tt.want.pending) {
-				t.Fatalf("Mismatched lengths of pending list. Got %d, wanted %d.", len(p.pending), len(tt.want.pending))
+			pending := pendingExits(p)
+			if len(pending) != len(tt.want.pending) {
+				t.Fatalf("Mismatched lengths of pending list. Got %d, wanted %d.", len(pending), len(tt.want.pending))
 			}
-			for i := range p.pending {
-				if !proto.Equal(p.pending[i], tt.want.pending[i]) {
-					t.Errorf("Pending exit at index %d does not match expected. Got=%v wanted=%v", i, p.pending[i], tt.want.pending[i])
+			for i := range pending {
+				if !proto.Equal(pending[i], tt.want.pending[i]) {
+					t.Errorf("Pending exit at index %d does not match expected. Got=%v wanted=%v", i, pending[i], tt.want.pending[i])
 				}
 			}
 		})
@@ -376,22 +374,22 @@ func TestPool_PendingExits(t *testing.T) {
 			name: "All eligible",
 			fields: fields{
 				pending: []*ethpb.SignedVoluntaryExit{
-					{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 0, ValidatorIndex: 0}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 1}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 2, ValidatorIndex: 2}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 3}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 4}},
 				},
 			},
 			args: args{
 				slot: 1000000,
 			},
 			want: []*ethpb.SignedVoluntaryExit{
-				{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 0, ValidatorIndex: 0}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 1}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 2, ValidatorIndex: 2}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 3}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 4}},
 			},
 		},
 		{
@@ -399,129 +397,131 @@ func TestPool_PendingExits(t *testing.T) {
 			fields: fields{
 				noLimit: true,
 				pending: []*ethpb.SignedVoluntaryExit{
-					{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 5}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 6}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 7}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 8}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 9}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 10}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 11}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 12}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 13}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 14}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 15}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 16}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 17}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 18}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 19}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 0, ValidatorIndex: 0}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 1}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 2, ValidatorIndex: 2}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 3}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 4}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 5}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 6, ValidatorIndex: 6}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 7, ValidatorIndex: 7}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 8, ValidatorIndex: 8}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 9, ValidatorIndex: 9}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: 10}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 11, ValidatorIndex: 11}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 12, ValidatorIndex: 12}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 13, ValidatorIndex: 13}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 14, ValidatorIndex: 14}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 15, ValidatorIndex: 15}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 16, ValidatorIndex: 16}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 17, ValidatorIndex: 17}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 18, ValidatorIndex: 18}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 19, ValidatorIndex: 19}},
 				},
 			},
 			args: args{
 				slot: 1000000,
 			},
 			want: []*ethpb.SignedVoluntaryExit{
-				{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 5}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 6}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 7}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 8}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 9}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 10}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 11}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 12}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 13}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 14}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 15}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 16}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 17}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 18}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 19}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 0, ValidatorIndex: 0}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 1}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 2, ValidatorIndex: 2}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 3}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 4}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 5}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 6, ValidatorIndex: 6}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 7, ValidatorIndex: 7}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 8, ValidatorIndex: 8}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 9, ValidatorIndex: 9}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: 10}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 11, ValidatorIndex: 11}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 12, ValidatorIndex: 12}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 13, ValidatorIndex: 13}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 14, ValidatorIndex: 14}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 15, ValidatorIndex: 15}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 16, ValidatorIndex: 16}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 17, ValidatorIndex: 17}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 18, ValidatorIndex: 18}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 19, ValidatorIndex: 19}},
 			},
 		},
 		{
 			name: "All eligible, block max",
 			fields: fields{
 				pending: []*ethpb.SignedVoluntaryExit{
-					{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 5}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 6}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 7}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 8}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 9}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 10}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 11}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 12}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 13}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 14}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 15}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 16}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 17}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 18}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 19}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 0, ValidatorIndex: 0}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 1}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 2, ValidatorIndex: 2}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 3}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 4}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 5}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 6, ValidatorIndex: 6}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 7, ValidatorIndex: 7}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 8, ValidatorIndex: 8}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 9, ValidatorIndex: 9}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: 10}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 11, ValidatorIndex: 11}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 12, ValidatorIndex: 12}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 13, ValidatorIndex: 13}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 14, ValidatorIndex: 14}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 15, ValidatorIndex: 15}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 16, ValidatorIndex: 16}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 17, ValidatorIndex: 17}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 18, ValidatorIndex: 18}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 19, ValidatorIndex: 19}},
 				},
 			},
 			args: args{
 				slot: 1000000,
 			},
 			want: []*ethpb.SignedVoluntaryExit{
-				{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 5}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 6}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 7}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 8}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 9}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 10}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 11}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 12}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 13}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 14}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 15}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 0, ValidatorIndex: 0}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 1}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 2, ValidatorIndex: 2}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 3}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 4}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 5}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 6, ValidatorIndex: 6}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 7, ValidatorIndex: 7}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 8, ValidatorIndex: 8}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 9, ValidatorIndex: 9}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: 10}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 11, ValidatorIndex: 11}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 12, ValidatorIndex: 12}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 13, ValidatorIndex: 13}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 14, ValidatorIndex: 14}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 15, ValidatorIndex: 15}},
 			},
 		},
 		{
 			name: "Some eligible",
 			fields: fields{
 				pending: []*ethpb.SignedVoluntaryExit{
-					{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 3}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 4}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-					{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 0, ValidatorIndex: 0}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 3, ValidatorIndex: 3}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 4}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 2, ValidatorIndex: 2}},
+					{Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 1}},
 				},
 			},
 			args: args{
 				slot: 2 * params.BeaconConfig().SlotsPerEpoch,
 			},
 			want: []*ethpb.SignedVoluntaryExit{
-				{Exit: &ethpb.VoluntaryExit{Epoch: 0}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 2}},
-				{Exit: &ethpb.VoluntaryExit{Epoch: 1}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 0, ValidatorIndex: 0}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 1, ValidatorIndex: 1}},
+				{Exit: &ethpb.VoluntaryExit{Epoch: 2, ValidatorIndex: 2}},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := &Pool{
-				pending: tt.fields.pending,
+			p := poolWithExits(t, tt.fields.pending)
+			validators := make([]*ethpb.Validator, 20)
+			for i := range validators {
+				validators[i] = &ethpb.Validator{ExitEpoch: params.BeaconConfig().FarFutureEpoch}
 			}
-			s, err := stateV0.InitializeFromProtoUnsafe(&p2ppb.BeaconState{Validators: []*ethpb.Validator{{ExitEpoch: params.BeaconConfig().FarFutureEpoch}}})
+			s, err := stateV0.InitializeFromProtoUnsafe(&p2ppb.BeaconState{Validators: validators})
 			require.NoError(t, err)
 			if got := p.PendingExits(s, tt.args.slot, tt.fields.noLimit); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("PendingExits() = %v, want %v", got, tt.want)
@@ -529,3 +529,56 @@ func TestPool_PendingExits(t *testing.T) {
 		})
 	}
 }
+
+func TestPool_SaveLoad(t *testing.T) {
+	ctx := context.Background()
+	exit := &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: 12, ValidatorIndex: 1},
+		Signature: make([]byte, 96),
+	}
+	store := make(memoryStore)
+	require.NoError(t, poolWithExits(t, []*ethpb.SignedVoluntaryExit{exit}).Save(ctx, store))
+
+	p := NewPool()
+	require.NoError(t, p.Load(ctx, store))
+	pending := pendingExits(p)
+	require.Equal(t, 1, len(pending))
+	require.Equal(t, true, proto.Equal(exit, pending[0]))
+}
+
+// poolWithExits returns a pool holding the given exits, regardless of the validators' status.
+func poolWithExits(t *testing.T, pending []*ethpb.SignedVoluntaryExit) *Pool {
+	p := NewPool()
+	var validators []*ethpb.Validator
+	for _, exit := range pending {
+		for types.ValidatorIndex(len(validators)) <= exit.Exit.ValidatorIndex {
+			validators = append(validators, &ethpb.Validator{ExitEpoch: params.BeaconConfig().FarFutureEpoch})
+		}
+	}
+	s, err := stateV0.InitializeFromProtoUnsafe(&p2ppb.BeaconState{Validators: validators})
+	require.NoError(t, err)
+	for _, exit := range pending {
+		require.NoError(t, p.exits.Insert(s, exit))
+	}
+	return p
+}
+
+func pendingExits(p *Pool) []*ethpb.SignedVoluntaryExit {
+	ops := p.exits.Pending()
+	pending := make([]*ethpb.SignedVoluntaryExit, len(ops))
+	for i, op := range ops {
+		pending[i] = op.(*ethpb.SignedVoluntaryExit)
+	}
+	return pending
+}
+
+type memoryStore map[string][][]byte
+
+func (s memoryStore) SaveOperationPool(_ context.Context, name string, ops [][]byte) error {
+	s[name] = ops
+	return nil
+}
+
+func (s memoryStore) OperationPool(_ context.Context, name string) ([][]byte, error) {
+	return s[name], nil
+}
@@ -23,6 +23,9 @@ type Config struct {
 	TCPPort             uint
 	UDPPort             uint
 	MaxPeers            uint
+	MaxPeersPerClient   uint
+	ColocationLimit     uint
+	MinSubnetPeers      uint
 	AllowListCIDR       string
 	DenyListCIDR        []string
 	StateNotifier       statefeed.Notifier
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/event"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
@@ -60,14 +61,7 @@ func (s *Service) AddConnectionHandler(reqFunc, goodByeFunc func(ctx context.Con
 		ConnectedF: func(net network.Network, conn network.Conn) {
 			remotePeer := conn.RemotePeer()
 			disconnectFromPeer := func() {
-				s.peers.SetConnectionState(remotePeer, peers.PeerDisconnecting)
-				// Only attempt a goodbye if we are still connected to the peer.
-				if s.host.Network().Connectedness(remotePeer) == network.Connected {
-					if err := goodByeFunc(context.TODO(), remotePeer); err != nil {
-						log.WithError(err).Error("Unable to disconnect from peer")
-					}
-				}
-				s.peers.SetConnectionState(remotePeer, peers.PeerDisconnected)
+				s.disconnectFromPeer(remotePeer, goodByeFunc)
 			}
 			// Connection handler must be non-blocking as part of libp2p design.
 			go func() {
@@ -90,6 +84,19 @@ func (s *Service) AddConnectionHandler(reqFunc, goodByeFunc func(ctx context.Con
 					disconnectFromPeer()
 					return
 				}
+				// Disconnect from peers whose client implementation is already
+				// over-represented amongst our active peers. The client is only known
+				// here if the peer was identified before the handshake completed,
+				// otherwise the limit is applied once the peer is identified.
+				aboveClientLimit := func() bool {
+					s.setPeerAgentVersion(remotePeer)
+					if s.peers.IsAboveClientLimit(remotePeer) {
+						log.WithField("reason", "client limit").Trace("Ignoring connection request")
+						disconnectFromPeer()
+						return true
+					}
+					return false
+				}
 				validPeerConnection := func() {
 					s.peers.SetConnectionState(conn.RemotePeer(), peers.PeerConnected)
 					// Go through the handshake process.
@@ -132,6 +139,9 @@ func (s *Service) AddConnectionHandler(reqFunc, goodByeFunc func(ctx context.Con
 							return
 						}
 					}
+					if aboveClientLimit() {
+						return
+					}
 					validPeerConnection()
 					return
 				}
@@ -142,10 +152,64 @@ func (s *Service) AddConnectionHandler(reqFunc, goodByeFunc func(ctx context.Con
 					disconnectFromPeer()
 					return
 				}
+				if aboveClientLimit() {
+					return
+				}
 				validPeerConnection()
 			}()
 		},
 	})
+	s.enforceClientLimitOnIdentify(goodByeFunc)
+}
+
+// enforceClientLimitOnIdentify disconnects from peers whose client implementation is already
+// over-represented amongst our active peers, as soon as the identify protocol completes for
+// them. Identification can complete after the peer connected and its handshake completed.
+func (s *Service) enforceClientLimitOnIdentify(goodByeFunc func(ctx context.Context, id peer.ID) error) {
+	sub, err := s.host.EventBus().Subscribe(new(event.EvtPeerIdentificationCompleted))
+	if err != nil {
+		log.WithError(err).Error("Could not subscribe to peer identification events")
+		return
+	}
+	go func() {
+		defer func() {
+			if err := sub.Close(); err != nil {
+				log.WithError(err).Debug("Could not close peer identification subscription")
+			}
+		}()
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case evt, ok := <-sub.Out():
+				if !ok {
+					return
+				}
+				identified, ok := evt.(event.EvtPeerIdentificationCompleted)
+				if !ok {
+					continue
+				}
+				s.setPeerAgentVersion(identified.Peer)
+				if s.peers.IsAboveClientLimit(identified.Peer) {
+					log.WithField("reason", "client limit").Trace("Disconnecting from identified peer")
+					s.disconnectFromPeer(identified.Peer, goodByeFunc)
+				}
+			}
+		}
+	}()
+}
+
+// disconnectFromPeer says goodbye to a peer if we are still connected to it, updating its
+// connection state.
+func (s *Service) disconnectFromPeer(pid peer.ID, goodByeFunc func(ctx context.Context, id peer.ID) error) {
+	s.peers.SetConnectionState(pid, peers.PeerDisconnecting)
+	// Only attempt a goodbye if we are still connected to the peer.
+	if s.host.Network().Connectedness(pid) == network.Connected {
+		if err := goodByeFunc(context.TODO(), pid); err != nil {
+			log.WithError(err).Error("Unable to disconnect from peer")
+		}
+	}
+	s.peers.SetConnectionState(pid, peers.PeerDisconnected)
 }
 
 // AddDisconnectionHandler disconnects from peers.  It handles updating the peer status.
@@ -178,3 +242,17 @@ func (s *Service) AddDisconnectionHandler(handler func(ctx context.Context, id p
 		},
 	})
 }
+
+// setPeerAgentVersion records the agent version the peer advertised
+// through the identify protocol in the peer status.
+func (s *Service) setPeerAgentVersion(pid peer.ID) {
+	rawVersion, err := s.host.Peerstore().Get(pid, "AgentVersion")
+	if err != nil {
+		return
+	}
+	agentVersion, ok := rawVersion.(string)
+	if !ok {
+		return
+	}
+	s.peers.SetAgentVersion(pid, agentVersion)
+}
@@ -0,0 +1,85 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/event"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_ClientLimitAppliedOnIdentify(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h1, _, _ := createHost(t, 0)
+	defer func() {
+		require.NoError(t, h1.Close())
+	}()
+	h2, _, _ := createHost(t, 0)
+	defer func() {
+		require.NoError(t, h2.Close())
+	}()
+	s := &Service{
+		ctx:  ctx,
+		host: h1,
+		peers: peers.NewStatus(ctx, &peers.StatusConfig{
+			PeerLimit:         30,
+			MaxPeersPerClient: 1,
+			ScorerParams:      &scorers.Config{},
+		}),
+	}
+	// An active peer already runs the same client as the remote peer.
+	existing := addPeer(t, s.peers, peers.PeerConnected)
+	s.peers.SetAgentVersion(existing, "Prysm/v1.3.0")
+
+	goodbyes := make(chan peer.ID, 1)
+	s.AddConnectionHandler(func(_ context.Context, _ peer.ID) error {
+		return nil
+	}, func(_ context.Context, id peer.ID) error {
+		goodbyes <- id
+		return nil
+	})
+
+	// The remote peer connects before it is identified as running the same client.
+	require.NoError(t, h1.Connect(ctx, peer.AddrInfo{ID: h2.ID(), Addrs: h2.Addrs()}))
+	waitForState := func(want peerdata.PeerConnectionState) {
+		for i := 0; i < 100; i++ {
+			if state, err := s.peers.ConnectionState(h2.ID()); err == nil && state == want {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("Peer did not reach connection state %v", want)
+	}
+	waitForState(peers.PeerConnected)
+	assert.Equal(t, 0, len(goodbyes))
+
+	// Wait for the identification by libp2p, before it is replaced by a late one.
+	for i := 0; i < 100; i++ {
+		if _, err := h1.Peerstore().Get(h2.ID(), "AgentVersion"); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.NoError(t, h1.Peerstore().Put(h2.ID(), "AgentVersion", "Prysm/v1.3.1"))
+	emitter, err := h1.EventBus().Emitter(new(event.EvtPeerIdentificationCompleted))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, emitter.Close())
+	}()
+	require.NoError(t, emitter.Emit(event.EvtPeerIdentificationCompleted{Peer: h2.ID()}))
+
+	select {
+	case id := <-goodbyes:
+		assert.Equal(t, h2.ID(), id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not say goodbye to the peer above the client limit")
+	}
+	waitForState(peers.PeerDisconnected)
+}
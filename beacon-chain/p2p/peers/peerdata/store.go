@@ -48,6 +48,7 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	AgentVersion  string
 	// Chain related data.
 	MetaData                  *pb.MetaData
 	ChainState                *pb.Status
@@ -25,6 +25,7 @@ package peers
 import (
 	"context"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
@@ -58,6 +59,9 @@ const (
 	// ColocationLimit restricts how many peer identities we can see from a single ip or ipv6 subnet.
 	ColocationLimit = 5
 
+	// unknownClient is the client type assigned to peers which have not advertised an agent version.
+	unknownClient = "unknown"
+
 	// Additional buffer beyond current peer limit, from which we can store the relevant peer statuses.
 	maxLimitBuffer = 150
 
@@ -67,16 +71,27 @@ const (
 
 // Status is the structure holding the peer status information.
 type Status struct {
-	ctx       context.Context
-	scorers   *scorers.Service
-	store     *peerdata.Store
-	ipTracker map[string]uint64
+	ctx               context.Context
+	scorers           *scorers.Service
+	store             *peerdata.Store
+	ipTracker         map[string]uint64
+	colocationLimit   uint64
+	maxPeersPerClient int
+	minSubnetPeers    int
 }
 
 // StatusConfig represents peer status service params.
 type StatusConfig struct {
 	// PeerLimit specifies maximum amount of concurrent peers that are expected to be connect to the node.
 	PeerLimit int
+	// ColocationLimit restricts how many peers can share a single ip address, defaults to ColocationLimit.
+	ColocationLimit int
+	// MaxPeersPerClient restricts how many active peers can run the same client implementation,
+	// a value of 0 disables the limit.
+	MaxPeersPerClient int
+	// MinSubnetPeers is the number of peers per attestation subnet which are protected from pruning,
+	// a value of 0 disables the protection.
+	MinSubnetPeers int
 	// ScorerParams holds peer scorer configuration params.
 	ScorerParams *scorers.Config
 }
@@ -86,11 +101,18 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 	store := peerdata.NewStore(ctx, &peerdata.StoreConfig{
 		MaxPeers: maxLimitBuffer + config.PeerLimit,
 	})
+	colocationLimit := uint64(ColocationLimit)
+	if config.ColocationLimit > 0 {
+		colocationLimit = uint64(config.ColocationLimit)
+	}
 	return &Status{
-		ctx:       ctx,
-		store:     store,
-		scorers:   scorers.NewService(ctx, store, config.ScorerParams),
-		ipTracker: map[string]uint64{},
+		ctx:               ctx,
+		store:             store,
+		scorers:           scorers.NewService(ctx, store, config.ScorerParams),
+		ipTracker:         map[string]uint64{},
+		colocationLimit:   colocationLimit,
+		maxPeersPerClient: config.MaxPeersPerClient,
+		minSubnetPeers:    config.MinSubnetPeers,
 	}
 }
 
@@ -237,6 +259,60 @@ func (p *Status) Metadata(pid peer.ID) (*pb.MetaData, error) {
 	return nil, peerdata.ErrPeerUnknown
 }
 
+// SetAgentVersion sets the agent version advertised by the given remote peer.
+func (p *Status) SetAgentVersion(pid peer.ID, agentVersion string) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	peerData.AgentVersion = agentVersion
+}
+
+// ClientType returns the client implementation of the given remote peer, as derived
+// from its agent version.
+func (p *Status) ClientType(pid peer.ID) (string, error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		return clientType(peerData.AgentVersion), nil
+	}
+	return "", peerdata.ErrPeerUnknown
+}
+
+// IsAboveClientLimit checks whether accepting the given peer would take the number of
+// active peers running the same client implementation above our configured limit. Peers
+// which have not advertised an agent version are never limited.
+func (p *Status) IsAboveClientLimit(pid peer.ID) bool {
+	if p.maxPeersPerClient <= 0 {
+		return false
+	}
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	peerData, ok := p.store.PeerData(pid)
+	if !ok {
+		return false
+	}
+	client := clientType(peerData.AgentVersion)
+	if client == unknownClient {
+		return false
+	}
+	total := 0
+	for id, data := range p.store.Peers() {
+		if id == pid {
+			continue
+		}
+		if data.ConnState != PeerConnected && data.ConnState != PeerConnecting {
+			continue
+		}
+		if clientType(data.AgentVersion) == client {
+			total++
+		}
+	}
+	return total >= p.maxPeersPerClient
+}
+
 // CommitteeIndices retrieves the committee subnets the peer is subscribed to.
 func (p *Status) CommitteeIndices(pid peer.ID) ([]uint64, error) {
 	p.store.RLock()
@@ -703,11 +779,21 @@ func (p *Status) PeersToPrune() []peer.ID {
 	if excessInbound > amountToPrune {
 		amountToPrune = excessInbound
 	}
-	if amountToPrune < len(peersToPrune) {
-		peersToPrune = peersToPrune[:amountToPrune]
-	}
-	ids := make([]peer.ID, 0, len(peersToPrune))
+	subnetPeers := p.connectedSubnetPeers()
+	ids := make([]peer.ID, 0, amountToPrune)
 	for _, pr := range peersToPrune {
+		if len(ids) >= amountToPrune {
+			break
+		}
+		// Retain peers which we need to maintain the minimum
+		// amount of peers in any of their subnets.
+		subnets := p.peerSubnets(pr.pid)
+		if p.isNeededForSubnets(subnets, subnetPeers) {
+			continue
+		}
+		for _, idx := range subnets {
+			subnetPeers[idx]--
+		}
 		ids = append(ids, pr.pid)
 	}
 	return ids
@@ -736,6 +822,48 @@ func (p *Status) ConnectedPeerLimit() uint64 {
 	return uint64(maxLim) - maxLimitBuffer
 }
 
+// connectedSubnetPeers tallies the connected peers subscribed to each
+// attestation subnet. It is assumed that the store mutex is locked.
+func (p *Status) connectedSubnetPeers() map[uint64]int {
+	subnetPeers := make(map[uint64]int)
+	if p.minSubnetPeers <= 0 {
+		return subnetPeers
+	}
+	for pid, peerData := range p.store.Peers() {
+		if peerData.ConnState != PeerConnected {
+			continue
+		}
+		for _, idx := range p.peerSubnets(pid) {
+			subnetPeers[idx]++
+		}
+	}
+	return subnetPeers
+}
+
+// peerSubnets returns the attestation subnets the peer advertises in
+// its metadata. It is assumed that the store mutex is locked.
+func (p *Status) peerSubnets(pid peer.ID) []uint64 {
+	peerData, ok := p.store.PeerData(pid)
+	if !ok || peerData.MetaData == nil {
+		return []uint64{}
+	}
+	return indicesFromBitfield(peerData.MetaData.Attnets)
+}
+
+// isNeededForSubnets checks whether removing a peer subscribed to the provided subnets
+// would take any of them below our minimum amount of subnet peers.
+func (p *Status) isNeededForSubnets(subnets []uint64, subnetPeers map[uint64]int) bool {
+	if p.minSubnetPeers <= 0 {
+		return false
+	}
+	for _, idx := range subnets {
+		if subnetPeers[idx] <= p.minSubnetPeers {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Status) isfromBadIP(pid peer.ID) bool {
 	p.store.RLock()
 	defer p.store.RUnlock()
@@ -752,7 +880,7 @@ func (p *Status) isfromBadIP(pid peer.ID) bool {
 		return true
 	}
 	if val, ok := p.ipTracker[ip.String()]; ok {
-		if val > ColocationLimit {
+		if val > p.colocationLimit {
 			return true
 		}
 	}
@@ -818,6 +946,16 @@ func sameIP(firstAddr, secondAddr ma.Multiaddr) bool {
 	return firstIP.Equal(secondIP)
 }
 
+// clientType derives the client implementation from the agent version advertised
+// by a peer, i.e. "Prysm/v1.3.0/..." resolves to "prysm".
+func clientType(agentVersion string) string {
+	client := strings.ToLower(strings.TrimSpace(strings.Split(agentVersion, "/")[0]))
+	if client == "" {
+		return unknownClient
+	}
+	return client
+}
+
 func indicesFromBitfield(bitV bitfield.Bitvector64) []uint64 {
 	committeeIdxs := make([]uint64, 0, bitV.Count())
 	for i := uint64(0); i < 64; i++ {
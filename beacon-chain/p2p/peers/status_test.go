@@ -719,6 +719,87 @@ func TestPrunePeers(t *testing.T) {
 	}
 }
 
+func TestPrunePeers_MinSubnetPeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:      2,
+		MinSubnetPeers: 1,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+	})
+	createPeer(t, p, nil, network.DirOutbound, peers.PeerConnected)
+	createPeer(t, p, nil, network.DirOutbound, peers.PeerConnected)
+
+	// The only peer subscribed to subnet 3.
+	bitV := bitfield.NewBitvector64()
+	bitV.SetBitAt(3, true)
+	subnetPeer := createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+	p.SetMetadata(subnetPeer, &pb.MetaData{Attnets: bitV})
+	// Two peers subscribed to subnet 5.
+	bitV = bitfield.NewBitvector64()
+	bitV.SetBitAt(5, true)
+	for i := 0; i < 2; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+		p.SetMetadata(pid, &pb.MetaData{Attnets: bitV})
+	}
+
+	peersToPrune := p.PeersToPrune()
+	// Only one of the subnet 5 peers can be pruned.
+	require.Equal(t, 1, len(peersToPrune))
+	assert.NotEqual(t, subnetPeer, peersToPrune[0])
+	indices, err := p.CommitteeIndices(peersToPrune[0])
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{5}, indices)
+}
+
+func TestStatus_IsAboveClientLimit(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:         30,
+		MaxPeersPerClient: 2,
+		ScorerParams:      &scorers.Config{},
+	})
+	for i := 0; i < 2; i++ {
+		pid := createPeer(t, p, nil, network.DirOutbound, peers.PeerConnected)
+		p.SetAgentVersion(pid, "Prysm/v1.3.0/abcdef")
+	}
+	// Disconnected peers are not counted towards the limit.
+	pid := createPeer(t, p, nil, network.DirOutbound, peers.PeerDisconnected)
+	p.SetAgentVersion(pid, "Lighthouse/v1.2.0")
+
+	prysmPeer := createPeer(t, p, nil, network.DirInbound, peers.PeerConnecting)
+	p.SetAgentVersion(prysmPeer, "prysm/v1.3.1")
+	client, err := p.ClientType(prysmPeer)
+	require.NoError(t, err)
+	assert.Equal(t, "prysm", client)
+	assert.Equal(t, true, p.IsAboveClientLimit(prysmPeer))
+
+	lighthousePeer := createPeer(t, p, nil, network.DirInbound, peers.PeerConnecting)
+	p.SetAgentVersion(lighthousePeer, "Lighthouse/v1.2.0")
+	assert.Equal(t, false, p.IsAboveClientLimit(lighthousePeer))
+
+	unknownPeer := createPeer(t, p, nil, network.DirInbound, peers.PeerConnecting)
+	assert.Equal(t, false, p.IsAboveClientLimit(unknownPeer))
+}
+
+func TestPeerIPTracker_ColocationLimit(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:       30,
+		ColocationLimit: 2,
+		ScorerParams:    &scorers.Config{},
+	})
+	var colocatedPeers []peer.ID
+	for i := 0; i < 3; i++ {
+		addr, err := ma.NewMultiaddr("/ip4/211.227.218.116/tcp/" + strconv.Itoa(3000+i))
+		require.NoError(t, err)
+		colocatedPeers = append(colocatedPeers, createPeer(t, p, addr, network.DirUnknown, peers.PeerDisconnected))
+	}
+	for _, pr := range colocatedPeers {
+		assert.Equal(t, true, p.IsBad(pr), "colocated peer is not bad")
+	}
+}
+
 func TestStatus_BestPeer(t *testing.T) {
 	type peerConfig struct {
 		headSlot       types.Slot
@@ -161,7 +161,10 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	s.pubsub = gs
 
 	s.peers = peers.NewStatus(ctx, &peers.StatusConfig{
-		PeerLimit: int(s.cfg.MaxPeers),
+		PeerLimit:         int(s.cfg.MaxPeers),
+		ColocationLimit:   int(s.cfg.ColocationLimit),
+		MaxPeersPerClient: int(s.cfg.MaxPeersPerClient),
+		MinSubnetPeers:    int(s.cfg.MinSubnetPeers),
 		ScorerParams: &scorers.Config{
 			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
 				Threshold:     maxBadResponses,
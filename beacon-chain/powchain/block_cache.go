@@ -19,11 +19,6 @@ var (
 	// a headerInfo struct.
 	ErrNotAHeaderInfo = errors.New("object is not a header info")
 
-	// maxCacheSize is 2x of the follow distance for additional cache padding.
-	// Requests should be only accessing blocks within recent blocks within the
-	// Eth1FollowDistance.
-	maxCacheSize = 2 * params.BeaconConfig().Eth1FollowDistance
-
 	// Metrics
 	headerCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "powchain_header_cache_miss",
@@ -149,8 +144,8 @@ func (c *headerCache) AddHeader(hdr *gethTypes.Header) error {
 		return err
 	}
 
-	trim(c.hashCache, maxCacheSize)
-	trim(c.heightCache, maxCacheSize)
+	trim(c.hashCache, maxCacheSize())
+	trim(c.heightCache, maxCacheSize())
 
 	headerCacheSize.Set(float64(len(c.hashCache.ListKeys())))
 
@@ -171,3 +166,11 @@ func trim(queue *cache.FIFO, maxSize uint64) {
 func popProcessNoopFunc(_ interface{}) error {
 	return nil
 }
+
+// maxCacheSize is 2x of the follow distance for additional cache padding.
+// Requests should be only accessing blocks within recent blocks within the
+// Eth1FollowDistance. It is read from the chain config on use, so that the
+// follow distance may be overridden after startup.
+func maxCacheSize() uint64 {
+	return 2 * params.BeaconConfig().Eth1FollowDistance
+}
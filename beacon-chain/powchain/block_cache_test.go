@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/types"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -91,7 +92,7 @@ func TestBlockCache_byHeight(t *testing.T) {
 func TestBlockCache_maxSize(t *testing.T) {
 	cache := newHeaderCache()
 
-	for i := int64(0); i < int64(maxCacheSize+10); i++ {
+	for i := int64(0); i < int64(maxCacheSize()+10); i++ {
 		header := &gethTypes.Header{
 			Number: big.NewInt(i),
 		}
@@ -100,6 +101,21 @@ func TestBlockCache_maxSize(t *testing.T) {
 
 	}
 
-	assert.Equal(t, int(maxCacheSize), len(cache.hashCache.ListKeys()))
-	assert.Equal(t, int(maxCacheSize), len(cache.heightCache.ListKeys()))
+	assert.Equal(t, int(maxCacheSize()), len(cache.hashCache.ListKeys()))
+	assert.Equal(t, int(maxCacheSize()), len(cache.heightCache.ListKeys()))
+}
+
+func TestBlockCache_maxSize_FollowDistanceOverride(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.Eth1FollowDistance = 4
+	params.OverrideBeaconConfig(cfg)
+	cache := newHeaderCache()
+
+	for i := int64(0); i < 20; i++ {
+		require.NoError(t, cache.AddHeader(&gethTypes.Header{Number: big.NewInt(i)}))
+	}
+
+	assert.Equal(t, 8, len(cache.hashCache.ListKeys()))
+	assert.Equal(t, 8, len(cache.heightCache.ListKeys()))
 }
@@ -0,0 +1,84 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// restoreDepositTree rebuilds the deposit tree from the persisted powchain data. The finalized
+// portion of the tree is restored from its snapshot, and only the non-finalized deposits are
+// replayed from the stored deposit containers.
+func restoreDepositTree(eth1Data *protodb.ETH1ChainData) (*trieutil.DepositTree, error) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	tree := trieutil.NewDepositTree(depth)
+	switch {
+	case eth1Data.DepositSnapshot != nil:
+		var err error
+		tree, err = trieutil.DepositTreeFromSnapshot(eth1Data.DepositSnapshot, depth)
+		if err != nil {
+			return nil, err
+		}
+	case eth1Data.Trie != nil:
+		// Powchain data saved before deposit snapshots were introduced holds the full
+		// sparse Merkle trie, so its items are inserted into the tree.
+		var zeroHash [32]byte
+		items := trieutil.CreateTrieFromProto(eth1Data.Trie).Items()
+		if len(items) == 1 && bytes.Equal(items[0], zeroHash[:]) {
+			// An empty sparse Merkle trie holds a single zero item.
+			items = nil
+		}
+		if err := tree.InsertBatch(items, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	ctrs := make([]*protodb.DepositContainer, len(eth1Data.DepositContainers))
+	copy(ctrs, eth1Data.DepositContainers)
+	sort.SliceStable(ctrs, func(i, j int) bool { return ctrs[i].Index < ctrs[j].Index })
+	for _, c := range ctrs {
+		if c.Index < int64(tree.NumOfItems()) {
+			continue
+		}
+		depositHash, err := c.Deposit.Data.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash deposit data")
+		}
+		if err := tree.Insert(depositHash[:], int(c.Index)); err != nil {
+			return nil, err
+		}
+	}
+	return tree, nil
+}
+
+// finalizeDepositTree prunes the deposits which have been finalized by the beacon chain
+// from the deposit tree, so that only their subtree roots are persisted.
+func (s *Service) finalizeDepositTree(ctx context.Context, ctrs []*protodb.DepositContainer) error {
+	count := uint64(s.cfg.DepositCache.FinalizedDepositIndex(ctx) + 1)
+	if count > uint64(s.depositTrie.NumOfItems()) {
+		count = uint64(s.depositTrie.NumOfItems())
+	}
+	if count <= s.depositTrie.FinalizedCount() {
+		return nil
+	}
+	i := sort.Search(len(ctrs), func(i int) bool { return ctrs[i].Index >= int64(count-1) })
+	if i == len(ctrs) || ctrs[i].Index != int64(count-1) {
+		return errors.Errorf("could not find deposit container with index %d", count-1)
+	}
+	height := ctrs[i].Eth1BlockHeight
+	var blockHash [32]byte
+	exists, info, err := s.headerCache.HeaderInfoByHeight(new(big.Int).SetUint64(height))
+	if err != nil {
+		return err
+	}
+	if exists {
+		blockHash = info.Hash
+	}
+	return s.depositTrie.Finalize(count, blockHash, height)
+}
@@ -0,0 +1,108 @@
+package powchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func depositContainersAndItems(t *testing.T, n uint64) ([]*protodb.DepositContainer, [][]byte) {
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(n)
+	require.NoError(t, err)
+	ctrs := make([]*protodb.DepositContainer, n)
+	items := make([][]byte, n)
+	for i, d := range deposits {
+		ctrs[i] = &protodb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i) + 10, Deposit: d}
+		h, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		items[i] = h[:]
+	}
+	return ctrs, items
+}
+
+func TestRestoreDepositTree_FromSnapshot(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	ctrs, items := depositContainersAndItems(t, 10)
+	tree := trieutil.NewDepositTree(depth)
+	for i, item := range items {
+		require.NoError(t, tree.Insert(item, i))
+	}
+	require.NoError(t, tree.Finalize(6, [32]byte{}, 15))
+	snapshot, err := tree.Snapshot()
+	require.NoError(t, err)
+
+	restored, err := restoreDepositTree(&protodb.ETH1ChainData{
+		DepositSnapshot:   snapshot,
+		DepositContainers: ctrs,
+	})
+	require.NoError(t, err)
+	trie, err := trieutil.GenerateTrieFromItems(items, depth)
+	require.NoError(t, err)
+	assert.Equal(t, 10, restored.NumOfItems())
+	assert.Equal(t, uint64(6), restored.FinalizedCount())
+	assert.Equal(t, trie.Root(), restored.Root())
+}
+
+func TestRestoreDepositTree_FromLegacyTrie(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	ctrs, items := depositContainersAndItems(t, 10)
+	legacy, err := trieutil.GenerateTrieFromItems(items[:7], depth)
+	require.NoError(t, err)
+
+	restored, err := restoreDepositTree(&protodb.ETH1ChainData{
+		Trie:              legacy.ToProto(),
+		DepositContainers: ctrs,
+	})
+	require.NoError(t, err)
+	trie, err := trieutil.GenerateTrieFromItems(items, depth)
+	require.NoError(t, err)
+	assert.Equal(t, 10, restored.NumOfItems())
+	assert.Equal(t, trie.Root(), restored.Root())
+
+	empty, err := trieutil.NewTrie(depth)
+	require.NoError(t, err)
+	restored, err = restoreDepositTree(&protodb.ETH1ChainData{Trie: empty.ToProto()})
+	require.NoError(t, err)
+	assert.Equal(t, 0, restored.NumOfItems())
+}
+
+func TestSavePowchainData_RestoresDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbutil.SetupDB(t)
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	cfg := &Web3ServiceConfig{
+		BeaconDB:     beaconDB,
+		DepositCache: depositCache,
+	}
+	s, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+
+	ctrs, items := depositContainersAndItems(t, 12)
+	for i, c := range ctrs {
+		depositCache.InsertDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, [32]byte{})
+		require.NoError(t, s.depositTrie.Insert(items[i], i))
+	}
+	depositCache.InsertFinalizedDeposits(ctx, 8)
+	require.NoError(t, s.savePowchainData(ctx))
+	assert.Equal(t, uint64(9), s.depositTrie.FinalizedCount())
+
+	eth1Data, err := beaconDB.PowchainData(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, eth1Data.DepositSnapshot)
+	assert.Equal(t, uint64(9), eth1Data.DepositSnapshot.DepositCount)
+	assert.Equal(t, ctrs[8].Eth1BlockHeight, eth1Data.DepositSnapshot.ExecutionDepth)
+
+	restarted, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, s.depositTrie.Root(), restarted.depositTrie.Root())
+	assert.Equal(t, int64(11), restarted.lastReceivedMerkleIndex)
+}
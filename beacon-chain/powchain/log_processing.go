@@ -141,7 +141,9 @@ func (s *Service) ProcessDepositLog(ctx context.Context, depositLog gethTypes.Lo
 		return errors.Wrap(err, "Unable to determine hashed value of deposit")
 	}
 
-	s.depositTrie.Insert(depositHash[:], int(index))
+	if err := s.depositTrie.Insert(depositHash[:], int(index)); err != nil {
+		return errors.Wrap(err, "Unable to insert deposit into deposit tree")
+	}
 
 	proof, err := s.depositTrie.MerkleProof(int(index))
 	if err != nil {
@@ -535,12 +537,20 @@ func (s *Service) savePowchainData(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	ctrs := s.cfg.DepositCache.AllDepositContainers(ctx)
+	if err := s.finalizeDepositTree(ctx, ctrs); err != nil {
+		return errors.Wrap(err, "could not finalize deposit tree")
+	}
+	snapshot, err := s.depositTrie.Snapshot()
+	if err != nil {
+		return err
+	}
 	eth1Data := &protodb.ETH1ChainData{
 		CurrentEth1Data:   s.latestEth1Data,
 		ChainstartData:    s.chainStartData,
 		BeaconState:       pbState, // I promise not to mutate it!
-		Trie:              s.depositTrie.ToProto(),
-		DepositContainers: s.cfg.DepositCache.AllDepositContainers(ctx),
+		DepositContainers: ctrs,
+		DepositSnapshot:   snapshot,
 	}
 	return s.cfg.BeaconDB.SavePowchainData(ctx, eth1Data)
 }
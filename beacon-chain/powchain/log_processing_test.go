@@ -624,8 +624,7 @@ func TestWeb3ServiceProcessDepositLog_RequestMissedDeposits(t *testing.T) {
 	web3Service.preGenesisState = genSt
 	require.NoError(t, web3Service.preGenesisState.SetEth1Data(&ethpb.Eth1Data{}))
 	web3Service.chainStartData.ChainstartDeposits = []*ethpb.Deposit{}
-	web3Service.depositTrie, err = trieutil.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
-	require.NoError(t, err)
+	web3Service.depositTrie = trieutil.NewDepositTree(params.BeaconConfig().DepositContractTreeDepth)
 
 	logsToBeProcessed = append(logs[:depositsWanted-8], logs[depositsWanted-2:]...)
 	// We purposely miss processing the middle 7 logs so that the service, re-requests them.
@@ -78,6 +78,27 @@ type ChainStartFetcher interface {
 	ClearPreGenesisData()
 }
 
+// ChainStartStatusFetcher retrieves the progress of the eth1 chain towards the chain start
+// of the beacon chain.
+type ChainStartStatusFetcher interface {
+	ChainStartStatus(ctx context.Context) (*ChainStartStatus, error)
+}
+
+// ChainStartStatus describes the progress of the eth1 chain towards the chain start of the
+// beacon chain.
+type ChainStartStatus struct {
+	// Started is true once chain start was triggered, which makes the genesis time final.
+	Started bool
+	// GenesisTime is the unix time of genesis. Before chain start, it is the earliest genesis
+	// time allowed by the latest eth1 block processed, or zero if not enough validators
+	// deposited yet.
+	GenesisTime uint64
+	// ActiveValidators is the number of validators active in the pre-genesis state.
+	ActiveValidators uint64
+	// ValidatorsNeeded is the number of additional validators required for chain start.
+	ValidatorsNeeded uint64
+}
+
 // ChainInfoFetcher retrieves information about eth1 metadata at the eth2 genesis time.
 type ChainInfoFetcher interface {
 	Eth2GenesisPowchainInfo() (uint64, *big.Int)
@@ -143,7 +164,7 @@ type Service struct {
 	headerCache             *headerCache // cache to store block hash/block height.
 	latestEth1Data          *protodb.LatestETH1Data
 	depositContractCaller   *contracts.DepositContractCaller
-	depositTrie             *trieutil.SparseMerkleTrie
+	depositTrie             *trieutil.DepositTree
 	chainStartData          *protodb.ChainStartData
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	runError                error
@@ -166,11 +187,6 @@ type Web3ServiceConfig struct {
 func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	_ = cancel // govet fix for lost cancel. Cancel is handled in service.Stop()
-	depositTrie, err := trieutil.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
-	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "could not setup deposit trie")
-	}
 	genState, err := state.EmptyGenesisState()
 	if err != nil {
 		return nil, errors.Wrap(err, "could not setup genesis state")
@@ -198,7 +214,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 			LastRequestedBlock: 0,
 		},
 		headerCache: newHeaderCache(),
-		depositTrie: depositTrie,
+		depositTrie: trieutil.NewDepositTree(params.BeaconConfig().DepositContractTreeDepth),
 		chainStartData: &protodb.ChainStartData{
 			Eth1Data:           &ethpb.Eth1Data{},
 			ChainstartDeposits: make([]*ethpb.Deposit, 0),
@@ -213,7 +229,10 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		return nil, errors.Wrap(err, "unable to retrieve eth1 data")
 	}
 	if eth1Data != nil {
-		s.depositTrie = trieutil.CreateTrieFromProto(eth1Data.Trie)
+		s.depositTrie, err = restoreDepositTree(eth1Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not restore deposit tree")
+		}
 		s.chainStartData = eth1Data.ChainstartData
 		if !reflect.ValueOf(eth1Data.BeaconState).IsZero() {
 			s.preGenesisState, err = stateV0.InitializeFromProto(eth1Data.BeaconState)
@@ -222,7 +241,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 			}
 		}
 		s.latestEth1Data = eth1Data.CurrentEth1Data
-		s.lastReceivedMerkleIndex = int64(len(s.depositTrie.Items()) - 1)
+		s.lastReceivedMerkleIndex = int64(s.depositTrie.NumOfItems() - 1)
 		if err := s.initDepositCaches(ctx, eth1Data.DepositContainers); err != nil {
 			return nil, errors.Wrap(err, "could not initialize caches")
 		}
@@ -317,9 +336,9 @@ func (s *Service) DepositRoot() [32]byte {
 	return s.depositTrie.Root()
 }
 
-// DepositTrie returns the sparse Merkle trie used for storing
+// DepositTrie returns the deposit tree used for storing
 // deposits from the ETH1.0 deposit contract.
-func (s *Service) DepositTrie() *trieutil.SparseMerkleTrie {
+func (s *Service) DepositTrie() *trieutil.DepositTree {
 	return s.depositTrie
 }
 
@@ -794,6 +813,41 @@ func (s *Service) logTillChainStart() {
 	log.WithFields(fields).Info("Currently waiting for chainstart")
 }
 
+// ChainStartStatus returns the progress of the eth1 chain towards the chain start of the
+// beacon chain, estimating the genesis time from the latest eth1 block processed.
+func (s *Service) ChainStartStatus(ctx context.Context) (*ChainStartStatus, error) {
+	if s.chainStartData.Chainstarted {
+		return &ChainStartStatus{
+			Started:          true,
+			GenesisTime:      s.chainStartData.GenesisTime,
+			ActiveValidators: uint64(len(s.chainStartData.ChainstartDeposits)),
+		}, nil
+	}
+	_, blockTime, err := s.retrieveBlockHashAndTime(ctx, big.NewInt(int64(s.latestEth1Data.LastRequestedBlock)))
+	if err != nil {
+		return nil, err
+	}
+	valCount, genesisTime := s.currentCountAndTime(blockTime)
+	return estimateChainStart(valCount, genesisTime), nil
+}
+
+// estimateChainStart estimates the chain start from the active validator count of the
+// pre-genesis state and the candidate genesis time of the latest eth1 block processed. Once
+// enough validators deposited, chain start is triggered by the first eth1 block whose candidate
+// genesis time is past the minimum genesis time.
+func estimateChainStart(valCount uint64, genesisTime uint64) *ChainStartStatus {
+	status := &ChainStartStatus{ActiveValidators: valCount}
+	if valCount < params.BeaconConfig().MinGenesisActiveValidatorCount {
+		status.ValidatorsNeeded = params.BeaconConfig().MinGenesisActiveValidatorCount - valCount
+		return status
+	}
+	status.GenesisTime = genesisTime
+	if genesisTime < params.BeaconConfig().MinGenesisTime {
+		status.GenesisTime = params.BeaconConfig().MinGenesisTime
+	}
+	return status
+}
+
 // cacheHeadersForEth1DataVote makes sure that voting for eth1data after startup utilizes cached headers
 // instead of making multiple RPC requests to the ETH1 endpoint.
 func (s *Service) cacheHeadersForEth1DataVote(ctx context.Context) error {
@@ -423,6 +423,54 @@ func TestLogTillGenesis_OK(t *testing.T) {
 	assert.LogsContain(t, hook, "Currently waiting for chainstart")
 }
 
+func TestEstimateChainStart(t *testing.T) {
+	minValidators := params.BeaconConfig().MinGenesisActiveValidatorCount
+	minGenesisTime := params.BeaconConfig().MinGenesisTime
+	tests := []struct {
+		name        string
+		valCount    uint64
+		genesisTime uint64
+		want        *ChainStartStatus
+	}{
+		{
+			name:        "not enough validators",
+			valCount:    minValidators - 10,
+			genesisTime: minGenesisTime + 100,
+			want:        &ChainStartStatus{ActiveValidators: minValidators - 10, ValidatorsNeeded: 10},
+		},
+		{
+			name:        "before min genesis time",
+			valCount:    minValidators,
+			genesisTime: minGenesisTime - 100,
+			want:        &ChainStartStatus{ActiveValidators: minValidators, GenesisTime: minGenesisTime},
+		},
+		{
+			name:        "after min genesis time",
+			valCount:    minValidators + 10,
+			genesisTime: minGenesisTime + 100,
+			want:        &ChainStartStatus{ActiveValidators: minValidators + 10, GenesisTime: minGenesisTime + 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.DeepEqual(t, tt.want, estimateChainStart(tt.valCount, tt.genesisTime))
+		})
+	}
+}
+
+func TestChainStartStatus_ChainStarted(t *testing.T) {
+	s := &Service{
+		chainStartData: &protodb.ChainStartData{
+			Chainstarted:       true,
+			GenesisTime:        1000,
+			ChainstartDeposits: make([]*ethpb.Deposit, 64),
+		},
+	}
+	st, err := s.ChainStartStatus(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ChainStartStatus{Started: true, GenesisTime: 1000, ActiveValidators: 64}, st)
+}
+
 func TestInitDepositCache_OK(t *testing.T) {
 	ctrs := []*protodb.DepositContainer{
 		{Index: 0, Eth1BlockHeight: 2, Deposit: &ethpb.Deposit{Proof: [][]byte{[]byte("A")}}},
@@ -0,0 +1,79 @@
+// Package admin defines a gRPC server implementation of an administration service
+// which changes the runtime behavior of a beacon node without restarting it, this
+// server is gated behind the flag --enable-admin-rpc-endpoints.
+package admin
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC Admin service, this server is
+// gated behind the flag --enable-admin-rpc-endpoints.
+type Server struct {
+	CacheRegistry *cache.Registry
+}
+
+// ListFeatureFlags returns the feature flags which can be toggled at runtime, sorted by name.
+func (as *Server) ListFeatureFlags(_ context.Context, _ *empty.Empty) (*pbrpc.FeatureFlagsResponse, error) {
+	values := featureconfig.RuntimeFlags()
+	resp := &pbrpc.FeatureFlagsResponse{Flags: make([]*pbrpc.FeatureFlag, 0, len(values))}
+	for name, enabled := range values {
+		resp.Flags = append(resp.Flags, &pbrpc.FeatureFlag{Name: name, Enabled: enabled})
+	}
+	sort.Slice(resp.Flags, func(i, j int) bool {
+		return resp.Flags[i].Name < resp.Flags[j].Name
+	})
+	return resp, nil
+}
+
+// SetFeatureFlag toggles a feature flag which can be changed at runtime.
+func (as *Server) SetFeatureFlag(_ context.Context, req *pbrpc.FeatureFlag) (*empty.Empty, error) {
+	if err := featureconfig.SetRuntimeFlag(req.Name, req.Enabled); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not set feature flag: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetLogLevel sets the log level of a module, or of the modules without a level of their own if
+// no module is given.
+func (as *Server) SetLogLevel(_ context.Context, req *pbrpc.LogLevelRequest) (*empty.Empty, error) {
+	level, err := logrus.ParseLevel(req.Level)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not parse log level: %v", err)
+	}
+	logutil.SetModuleLevel(req.Module, level)
+	log.WithField("module", req.Module).WithField("level", level.String()).Info("Changed log level")
+	return &empty.Empty{}, nil
+}
+
+// FlushCaches empties the registered caches of the given names, or all of them if no name is given.
+func (as *Server) FlushCaches(_ context.Context, req *pbrpc.FlushCachesRequest) (*pbrpc.FlushCachesResponse, error) {
+	if as.CacheRegistry == nil {
+		return nil, status.Error(codes.Unavailable, "Cache registry is not available")
+	}
+	names := req.Names
+	if len(names) == 0 {
+		for _, s := range as.CacheRegistry.Stats() {
+			names = append(names, s.Name)
+		}
+	}
+	resp := &pbrpc.FlushCachesResponse{Flushed: make([]string, 0, len(names))}
+	for _, name := range names {
+		if err := as.CacheRegistry.Flush(name); err != nil {
+			return resp, status.Errorf(codes.NotFound, "Could not flush cache %q: %v", name, err)
+		}
+		resp.Flushed = append(resp.Flushed, name)
+	}
+	log.WithField("caches", resp.Flushed).Info("Flushed caches")
+	return resp, nil
+}
@@ -0,0 +1,75 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+)
+
+func TestServer_SetFeatureFlag(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{})
+	defer resetCfg()
+	ctx := context.Background()
+	as := &Server{}
+
+	_, err := as.SetFeatureFlag(ctx, &pbrpc.FeatureFlag{Name: "disable-broadcast-slashings", Enabled: true})
+	require.NoError(t, err)
+	assert.Equal(t, true, featureconfig.Get().DisableBroadcastSlashings)
+
+	resp, err := as.ListFeatureFlags(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, true, len(resp.Flags) > 1)
+	for i, f := range resp.Flags {
+		if i > 0 {
+			assert.Equal(t, true, resp.Flags[i-1].Name < f.Name, "Flags are not sorted")
+		}
+		assert.Equal(t, f.Name == "disable-broadcast-slashings", f.Enabled, "Wrong value of flag %s", f.Name)
+	}
+
+	_, err = as.SetFeatureFlag(ctx, &pbrpc.FeatureFlag{Name: "update-head-timely", Enabled: true})
+	assert.ErrorContains(t, "flag update-head-timely can not be changed at runtime", err)
+}
+
+func TestServer_SetLogLevel(t *testing.T) {
+	prevLevel := logrus.GetLevel()
+	defer logrus.SetLevel(prevLevel)
+	ctx := context.Background()
+	as := &Server{}
+
+	_, err := as.SetLogLevel(ctx, &pbrpc.LogLevelRequest{Module: "sync", Level: "trace"})
+	require.NoError(t, err)
+	assert.Equal(t, logrus.TraceLevel, logrus.GetLevel())
+	_, err = as.SetLogLevel(ctx, &pbrpc.LogLevelRequest{Module: "sync", Level: "info"})
+	require.NoError(t, err)
+	_, err = as.SetLogLevel(ctx, &pbrpc.LogLevelRequest{Level: "info"})
+	require.NoError(t, err)
+	assert.Equal(t, logrus.InfoLevel, logrus.GetLevel())
+
+	_, err = as.SetLogLevel(ctx, &pbrpc.LogLevelRequest{Level: "verbose"})
+	assert.ErrorContains(t, "Could not parse log level", err)
+}
+
+func TestServer_FlushCaches(t *testing.T) {
+	ctx := context.Background()
+	as := &Server{CacheRegistry: cache.NewRegistry()}
+	as.CacheRegistry.Register("recent-state", cache.NewRecentStateCache())
+	as.CacheRegistry.Register("checkpoint-state", cache.NewCheckpointStateCache())
+
+	resp, err := as.FlushCaches(ctx, &pbrpc.FlushCachesRequest{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"checkpoint-state", "recent-state"}, resp.Flushed)
+
+	resp, err = as.FlushCaches(ctx, &pbrpc.FlushCachesRequest{Names: []string{"recent-state"}})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"recent-state"}, resp.Flushed)
+
+	_, err = as.FlushCaches(ctx, &pbrpc.FlushCachesRequest{Names: []string{"recent-state", "unknown"}})
+	assert.ErrorContains(t, "Could not flush cache \"unknown\"", err)
+}
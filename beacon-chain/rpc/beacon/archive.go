@@ -0,0 +1,198 @@
+package beacon
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamValidatorSet streams the full validator registry, along with each validator's status
+// and balance, as of the start of a finalized epoch. Archived boundary states are used when
+// available so that historical epochs can be served without replaying blocks on the client.
+func (bs *Server) StreamValidatorSet(req *pbrpc.ValidatorSetRequest, stream pbrpc.Archive_StreamValidatorSetServer) error {
+	ctx := stream.Context()
+	if req.PageSize < 0 || int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Requested page size %d must be between 0 and max size %d",
+			req.PageSize,
+			cmd.Get().MaxRPCPageSize,
+		)
+	}
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = params.BeaconConfig().DefaultPageSize
+	}
+
+	finalizedEpoch := bs.FinalizationFetcher.FinalizedCheckpt().Epoch
+	if req.Epoch > finalizedEpoch {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Requested epoch %d is not finalized, finalized epoch %d",
+			req.Epoch,
+			finalizedEpoch,
+		)
+	}
+
+	st, err := bs.archivedEpochState(ctx, req.Epoch)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not retrieve state at epoch %d: %v", req.Epoch, err)
+	}
+
+	total := types.ValidatorIndex(st.NumValidators())
+	if req.StartIndex > total {
+		return status.Errorf(
+			codes.InvalidArgument,
+			"Start index %d is greater than the number of validators %d",
+			req.StartIndex,
+			total,
+		)
+	}
+
+	idx := req.StartIndex
+	for {
+		if ctx.Err() != nil {
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+		end := idx + types.ValidatorIndex(pageSize)
+		if end > total {
+			end = total
+		}
+		page := &pbrpc.ValidatorSetPage{
+			Epoch:      req.Epoch,
+			TotalSize:  uint64(total),
+			Validators: make([]*pbrpc.ArchivedValidator, 0, end-idx),
+			NextIndex:  end,
+		}
+		for i := idx; i < end; i++ {
+			val, err := st.ValidatorAtIndex(i)
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not retrieve validator %d: %v", i, err)
+			}
+			balance, err := st.BalanceAtIndex(i)
+			if err != nil {
+				return status.Errorf(codes.Internal, "Could not retrieve balance of validator %d: %v", i, err)
+			}
+			page.Validators = append(page.Validators, &pbrpc.ArchivedValidator{
+				Index:     i,
+				Validator: val,
+				Status:    validatorStatus(val, req.Epoch),
+				Balance:   balance,
+			})
+		}
+		if err := stream.Send(page); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+		if end == total {
+			return nil
+		}
+		idx = end
+	}
+}
+
+// GetCommitteeSnapshot returns the beacon committees of a finalized epoch, along with the attester
+// seed they are shuffled with and a Merkle proof of the randao mix of the seed against the root of
+// the state at the start of the epoch. Given the active validators of the epoch, third parties are
+// able to recompute the committees and verify the attestation duties claimed for the epoch.
+func (bs *Server) GetCommitteeSnapshot(ctx context.Context, req *pbrpc.CommitteeSnapshotRequest) (*pbrpc.CommitteeSnapshot, error) {
+	finalizedEpoch := bs.FinalizationFetcher.FinalizedCheckpt().Epoch
+	if req.Epoch > finalizedEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested epoch %d is not finalized, finalized epoch %d",
+			req.Epoch,
+			finalizedEpoch,
+		)
+	}
+
+	st, err := bs.archivedEpochState(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve state at epoch %d: %v", req.Epoch, err)
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+	}
+	seed, err := helpers.Seed(st, req.Epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get seed: %v", err)
+	}
+	// The seed of an epoch uses the randao mix of the epoch MIN_SEED_LOOKAHEAD + 1 epochs earlier.
+	mixIndex := uint64((req.Epoch + params.BeaconConfig().EpochsPerHistoricalVector -
+		params.BeaconConfig().MinSeedLookahead - 1) % params.BeaconConfig().EpochsPerHistoricalVector)
+	mix, err := st.RandaoMixAtIndex(mixIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get randao mix: %v", err)
+	}
+	generalizedIndex, err := stateV0.ElementGeneralizedIndex("randaoMixes", mixIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get generalized index of randao mix: %v", err)
+	}
+	proof, err := st.Proof(ctx, generalizedIndex)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not prove randao mix: %v", err)
+	}
+
+	activeIndices, err := helpers.ActiveValidatorIndices(st, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active indices: %v", err)
+	}
+	startSlot, err := helpers.StartSlot(req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", req.Epoch, err)
+	}
+	committeesBySlot, err := computeCommittees(startSlot, activeIndices, seed)
+	if err != nil {
+		return nil, err
+	}
+	committees := make([]*pbrpc.EpochCommittee, 0)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		for i, committee := range committeesBySlot[slot].Committees {
+			committees = append(committees, &pbrpc.EpochCommittee{
+				Slot:             slot,
+				CommitteeIndex:   types.CommitteeIndex(i),
+				ValidatorIndices: committee.ValidatorIndices,
+			})
+		}
+	}
+
+	return &pbrpc.CommitteeSnapshot{
+		Epoch:                req.Epoch,
+		StateSlot:            st.Slot(),
+		StateRoot:            stateRoot[:],
+		Seed:                 seed[:],
+		RandaoMix:            mix,
+		RandaoMixIndex:       mixIndex,
+		GeneralizedIndex:     generalizedIndex,
+		Proof:                proof,
+		ActiveValidatorCount: uint64(len(activeIndices)),
+		Committees:           committees,
+	}, nil
+}
+
+// archivedEpochState returns the state at the start slot of the given epoch, preferring the
+// archived point saved for that slot and falling back to state regeneration otherwise.
+func (bs *Server) archivedEpochState(ctx context.Context, epoch types.Epoch) (iface.BeaconState, error) {
+	slot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	if bs.BeaconDB.HasArchivedPoint(ctx, slot) {
+		st, err := bs.BeaconDB.State(ctx, bs.BeaconDB.ArchivedPointRoot(ctx, slot))
+		if err != nil {
+			return nil, err
+		}
+		if st != nil && st.Slot() == slot {
+			return st, nil
+		}
+	}
+	return bs.StateGen.StateBySlot(ctx, slot)
+}
@@ -0,0 +1,181 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type validatorSetStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	pages   []*pbrpc.ValidatorSetPage
+	sendErr error
+}
+
+func (s *validatorSetStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *validatorSetStream) Send(page *pbrpc.ValidatorSetPage) error {
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+	s.pages = append(s.pages, page)
+	return nil
+}
+
+func setupArchiveServer(t *testing.T, count uint64, finalized types.Epoch) *Server {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	vals := make([]*ethpb.Validator, count)
+	balances := make([]uint64, count)
+	for i := uint64(0); i < count; i++ {
+		vals[i] = &ethpb.Validator{
+			PublicKey:             pubKey(i),
+			WithdrawalCredentials: make([]byte, 32),
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	vals[1].ExitEpoch = 1
+	balances[2] = 42
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	slot := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetValidators(vals))
+	require.NoError(t, st.SetBalances(balances))
+
+	root := [32]byte{'a'}
+	require.NoError(t, beaconDB.SaveState(ctx, st, root))
+	require.Equal(t, true, beaconDB.HasArchivedPoint(ctx, slot))
+	return &Server{
+		BeaconDB:            beaconDB,
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: finalized}},
+		StateGen:            stategen.New(beaconDB),
+	}
+}
+
+func TestServer_StreamValidatorSet_Paginates(t *testing.T) {
+	bs := setupArchiveServer(t, 5, 2)
+	stream := &validatorSetStream{ctx: context.Background()}
+	require.NoError(t, bs.StreamValidatorSet(&pbrpc.ValidatorSetRequest{Epoch: 1, PageSize: 2}, stream))
+
+	require.Equal(t, 3, len(stream.pages))
+	assert.Equal(t, types.ValidatorIndex(2), stream.pages[0].NextIndex)
+	assert.Equal(t, types.ValidatorIndex(4), stream.pages[1].NextIndex)
+	assert.Equal(t, types.ValidatorIndex(5), stream.pages[2].NextIndex)
+	assert.Equal(t, 1, len(stream.pages[2].Validators))
+	for _, page := range stream.pages {
+		assert.Equal(t, types.Epoch(1), page.Epoch)
+		assert.Equal(t, uint64(5), page.TotalSize)
+	}
+
+	vals := append(stream.pages[0].Validators, stream.pages[1].Validators...)
+	assert.Equal(t, types.ValidatorIndex(1), vals[1].Index)
+	assert.Equal(t, ethpb.ValidatorStatus_EXITED, vals[1].Status)
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, vals[0].Status)
+	assert.Equal(t, uint64(42), vals[2].Balance)
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, vals[3].Balance)
+}
+
+func TestServer_StreamValidatorSet_StartIndex(t *testing.T) {
+	bs := setupArchiveServer(t, 5, 1)
+	stream := &validatorSetStream{ctx: context.Background()}
+	require.NoError(t, bs.StreamValidatorSet(&pbrpc.ValidatorSetRequest{Epoch: 1, StartIndex: 3}, stream))
+
+	require.Equal(t, 1, len(stream.pages))
+	require.Equal(t, 2, len(stream.pages[0].Validators))
+	assert.Equal(t, types.ValidatorIndex(3), stream.pages[0].Validators[0].Index)
+	assert.Equal(t, types.ValidatorIndex(5), stream.pages[0].NextIndex)
+}
+
+func TestServer_StreamValidatorSet_InvalidRequests(t *testing.T) {
+	bs := setupArchiveServer(t, 5, 1)
+	ctx := context.Background()
+
+	exceedsMax := int32(cmd.Get().MaxRPCPageSize + 1)
+	err := bs.StreamValidatorSet(&pbrpc.ValidatorSetRequest{Epoch: 1, PageSize: exceedsMax}, &validatorSetStream{ctx: ctx})
+	assert.ErrorContains(t, fmt.Sprintf("Requested page size %d must be between 0 and max size", exceedsMax), err)
+
+	err = bs.StreamValidatorSet(&pbrpc.ValidatorSetRequest{Epoch: 2}, &validatorSetStream{ctx: ctx})
+	assert.ErrorContains(t, "Requested epoch 2 is not finalized, finalized epoch 1", err)
+
+	err = bs.StreamValidatorSet(&pbrpc.ValidatorSetRequest{Epoch: 1, StartIndex: 6}, &validatorSetStream{ctx: ctx})
+	assert.ErrorContains(t, "Start index 6 is greater than the number of validators 5", err)
+
+	err = bs.StreamValidatorSet(&pbrpc.ValidatorSetRequest{Epoch: 1}, &validatorSetStream{ctx: ctx, sendErr: errors.New("closed")})
+	assert.ErrorContains(t, "Could not send over stream: closed", err)
+}
+
+func TestServer_GetCommitteeSnapshot(t *testing.T) {
+	// The test state has the vector lengths of the mainnet config, which are needed to hash it.
+	params.SetupTestConfigCleanup(t)
+	params.UseMainnetConfig()
+	bs := setupArchiveServer(t, 5, 1)
+	ctx := context.Background()
+
+	res, err := bs.GetCommitteeSnapshot(ctx, &pbrpc.CommitteeSnapshotRequest{Epoch: 1})
+	require.NoError(t, err)
+	st, err := bs.archivedEpochState(ctx, 1)
+	require.NoError(t, err)
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch, res.StateSlot)
+	assert.DeepEqual(t, stateRoot[:], res.StateRoot)
+
+	// The seed is the hash of the attester domain, the epoch and the randao mix.
+	domain := params.BeaconConfig().DomainBeaconAttester
+	seed := hashutil.Hash(append(append(domain[:], bytesutil.Bytes8(1)...), res.RandaoMix...))
+	assert.DeepEqual(t, seed[:], res.Seed)
+	wantedIndex := uint64(params.BeaconConfig().EpochsPerHistoricalVector - params.BeaconConfig().MinSeedLookahead)
+	assert.Equal(t, wantedIndex, res.RandaoMixIndex)
+
+	// The randao mix hashes up its Merkle branch to the state root.
+	node := bytesutil.ToBytes32(res.RandaoMix)
+	generalizedIndex := res.GeneralizedIndex
+	for _, sibling := range res.Proof {
+		if generalizedIndex%2 == 1 {
+			node = hashutil.Hash(append(sibling, node[:]...))
+		} else {
+			node = hashutil.Hash(append(node[:], sibling...))
+		}
+		generalizedIndex /= 2
+	}
+	assert.Equal(t, uint64(1), generalizedIndex)
+	assert.Equal(t, stateRoot, node)
+
+	// Validator 1 exited at epoch 1, the other validators are shuffled into the committees.
+	assert.Equal(t, uint64(4), res.ActiveValidatorCount)
+	require.Equal(t, uint64(params.BeaconConfig().SlotsPerEpoch), uint64(len(res.Committees)))
+	seen := make(map[types.ValidatorIndex]bool)
+	for i, committee := range res.Committees {
+		assert.Equal(t, params.BeaconConfig().SlotsPerEpoch+types.Slot(i), committee.Slot)
+		assert.Equal(t, types.CommitteeIndex(0), committee.CommitteeIndex)
+		for _, idx := range committee.ValidatorIndices {
+			seen[idx] = true
+		}
+	}
+	assert.DeepEqual(t, map[types.ValidatorIndex]bool{0: true, 2: true, 3: true, 4: true}, seen)
+
+	_, err = bs.GetCommitteeSnapshot(ctx, &pbrpc.CommitteeSnapshotRequest{Epoch: 2})
+	assert.ErrorContains(t, "Requested epoch 2 is not finalized, finalized epoch 1", err)
+}
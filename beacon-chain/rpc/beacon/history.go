@@ -0,0 +1,376 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// historyCursor is the position of a page in the slot range of a history request: the slot of
+// its first item, and the number of items of that slot returned by the previous pages.
+type historyCursor struct {
+	slot types.Slot
+	skip uint64
+}
+
+// String encodes the cursor as a page token.
+func (c historyCursor) String() string {
+	return fmt.Sprintf("%d-%d", c.slot, c.skip)
+}
+
+// parseHistoryCursor decodes a page token into a cursor.
+func parseHistoryCursor(token string) (historyCursor, error) {
+	parts := strings.Split(token, "-")
+	if len(parts) != 2 {
+		return historyCursor{}, errors.New("malformed cursor")
+	}
+	slot, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return historyCursor{}, errors.Wrap(err, "malformed cursor slot")
+	}
+	skip, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return historyCursor{}, errors.Wrap(err, "malformed cursor offset")
+	}
+	return historyCursor{slot: types.Slot(slot), skip: skip}, nil
+}
+
+// historyPager collects the items of a page, skipping the items of the cursor slot returned by
+// the previous pages, and records the cursor of the next page once the page is full.
+type historyPager struct {
+	pageSize int
+	size     int
+	skipped  historyCursor
+	pos      historyCursor
+	next     string
+}
+
+func newHistoryPager(cursor historyCursor, pageSize int) *historyPager {
+	return &historyPager{
+		pageSize: pageSize,
+		skipped:  cursor,
+		pos:      cursor,
+	}
+}
+
+// take reports whether the next item, at the given slot, is part of the page, and whether the
+// page is able to take more items.
+func (p *historyPager) take(slot types.Slot) (bool, bool) {
+	if slot == p.skipped.slot && p.skipped.skip > 0 {
+		p.skipped.skip--
+		return false, true
+	}
+	if p.size == p.pageSize {
+		p.next = p.pos.String()
+		return false, false
+	}
+	if slot != p.pos.slot {
+		p.pos = historyCursor{slot: slot}
+	}
+	p.pos.skip++
+	p.size++
+	return true, true
+}
+
+// ListBlockHistory returns a page of the blocks of a slot range matching the proposer and
+// canonical filters of the request, by increasing slot and root.
+func (bs *Server) ListBlockHistory(ctx context.Context, req *pbrpc.HistoryRequest) (*pbrpc.BlocksPage, error) {
+	cursor, endSlot, pageSize, err := bs.historyRange(req)
+	if err != nil {
+		return nil, err
+	}
+	return bs.blockHistoryPage(ctx, req, cursor, endSlot, pageSize)
+}
+
+// StreamBlockHistory streams the pages of the blocks of a slot range matching the proposer and
+// canonical filters of the request, from the page of the request token to the end of the range.
+func (bs *Server) StreamBlockHistory(req *pbrpc.HistoryRequest, stream pbrpc.History_StreamBlockHistoryServer) error {
+	ctx := stream.Context()
+	cursor, endSlot, pageSize, err := bs.historyRange(req)
+	if err != nil {
+		return err
+	}
+	for {
+		if ctx.Err() != nil {
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+		page, err := bs.blockHistoryPage(ctx, req, cursor, endSlot, pageSize)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(page); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+		if page.NextPageToken == "" {
+			return nil
+		}
+		cursor, err = parseHistoryCursor(page.NextPageToken)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not parse next page token: %v", err)
+		}
+	}
+}
+
+// ListAttestationHistory returns a page of the attestations included in the blocks of a slot
+// range matching the proposer and canonical filters of the request, by increasing slot and root
+// of the including block.
+func (bs *Server) ListAttestationHistory(ctx context.Context, req *pbrpc.HistoryRequest) (*pbrpc.AttestationsPage, error) {
+	cursor, endSlot, pageSize, err := bs.historyRange(req)
+	if err != nil {
+		return nil, err
+	}
+	return bs.attestationHistoryPage(ctx, req, cursor, endSlot, pageSize)
+}
+
+// StreamAttestationHistory streams the pages of the attestations included in the blocks of a
+// slot range matching the proposer and canonical filters of the request, from the page of the
+// request token to the end of the range.
+func (bs *Server) StreamAttestationHistory(
+	req *pbrpc.HistoryRequest,
+	stream pbrpc.History_StreamAttestationHistoryServer,
+) error {
+	ctx := stream.Context()
+	cursor, endSlot, pageSize, err := bs.historyRange(req)
+	if err != nil {
+		return err
+	}
+	for {
+		if ctx.Err() != nil {
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+		page, err := bs.attestationHistoryPage(ctx, req, cursor, endSlot, pageSize)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(page); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+		if page.NextPageToken == "" {
+			return nil
+		}
+		cursor, err = parseHistoryCursor(page.NextPageToken)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not parse next page token: %v", err)
+		}
+	}
+}
+
+func (bs *Server) blockHistoryPage(
+	ctx context.Context,
+	req *pbrpc.HistoryRequest,
+	cursor historyCursor,
+	endSlot types.Slot,
+	pageSize int,
+) (*pbrpc.BlocksPage, error) {
+	pager := newHistoryPager(cursor, pageSize)
+	page := &pbrpc.BlocksPage{
+		BlockContainers: make([]*ethpb.BeaconBlockContainer, 0),
+	}
+	err := bs.walkHistory(ctx, req, cursor.slot, endSlot, func(c *ethpb.BeaconBlockContainer) bool {
+		take, more := pager.take(c.Block.Block.Slot)
+		if take {
+			page.BlockContainers = append(page.BlockContainers, c)
+		}
+		return more
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve blocks: %v", err)
+	}
+	page.NextPageToken = pager.next
+	return page, nil
+}
+
+func (bs *Server) attestationHistoryPage(
+	ctx context.Context,
+	req *pbrpc.HistoryRequest,
+	cursor historyCursor,
+	endSlot types.Slot,
+	pageSize int,
+) (*pbrpc.AttestationsPage, error) {
+	pager := newHistoryPager(cursor, pageSize)
+	page := &pbrpc.AttestationsPage{
+		Attestations: make([]*pbrpc.IncludedAttestation, 0),
+	}
+	err := bs.walkHistory(ctx, req, cursor.slot, endSlot, func(c *ethpb.BeaconBlockContainer) bool {
+		blk := c.Block.Block
+		for _, att := range blk.Body.Attestations {
+			take, more := pager.take(blk.Slot)
+			if !more {
+				return false
+			}
+			if take {
+				page.Attestations = append(page.Attestations, &pbrpc.IncludedAttestation{
+					Attestation: att,
+					BlockSlot:   blk.Slot,
+					BlockRoot:   c.BlockRoot,
+					Canonical:   c.Canonical,
+				})
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve blocks: %v", err)
+	}
+	page.NextPageToken = pager.next
+	return page, nil
+}
+
+// historyRange validates a history request, and returns the cursor of the requested page, the
+// end of the slot range cut at the current slot, and the page size.
+func (bs *Server) historyRange(req *pbrpc.HistoryRequest) (historyCursor, types.Slot, int, error) {
+	if req.PageSize < 0 || int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return historyCursor{}, 0, 0, status.Errorf(
+			codes.InvalidArgument,
+			"Requested page size %d must be between 0 and max size %d",
+			req.PageSize,
+			cmd.Get().MaxRPCPageSize,
+		)
+	}
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = params.BeaconConfig().DefaultPageSize
+	}
+	if req.EndSlot < req.StartSlot {
+		return historyCursor{}, 0, 0, status.Errorf(
+			codes.InvalidArgument,
+			"End slot %d is before start slot %d",
+			req.EndSlot,
+			req.StartSlot,
+		)
+	}
+
+	cursor := historyCursor{slot: req.StartSlot}
+	if req.PageToken != "" {
+		var err error
+		cursor, err = parseHistoryCursor(req.PageToken)
+		if err != nil {
+			return historyCursor{}, 0, 0, status.Errorf(codes.InvalidArgument, "Invalid page token: %v", err)
+		}
+		if cursor.slot < req.StartSlot || cursor.slot > req.EndSlot {
+			return historyCursor{}, 0, 0, status.Errorf(
+				codes.InvalidArgument,
+				"Page token slot %d is outside of the requested slot range",
+				cursor.slot,
+			)
+		}
+	}
+
+	endSlot := req.EndSlot
+	if currentSlot := bs.GenesisTimeFetcher.CurrentSlot(); endSlot > currentSlot {
+		endSlot = currentSlot
+	}
+	return cursor, endSlot, pageSize, nil
+}
+
+// walkHistory calls fn with the blocks of the slot range matching the request, by increasing slot
+// and root, until fn returns false. Blocks are read one epoch at a time, so that a page only reads
+// the epochs it covers.
+func (bs *Server) walkHistory(
+	ctx context.Context,
+	req *pbrpc.HistoryRequest,
+	startSlot, endSlot types.Slot,
+	fn func(c *ethpb.BeaconBlockContainer) bool,
+) error {
+	for slot := startSlot; slot <= endSlot; {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		epochEnd, err := helpers.EndSlot(helpers.SlotToEpoch(slot))
+		if err != nil {
+			return err
+		}
+		if epochEnd > endSlot {
+			epochEnd = endSlot
+		}
+		containers, err := bs.historyBlocks(ctx, req, slot, epochEnd)
+		if err != nil {
+			return err
+		}
+		for _, c := range containers {
+			if !fn(c) {
+				return nil
+			}
+		}
+		if epochEnd == endSlot {
+			return nil
+		}
+		slot = epochEnd + 1
+	}
+	return nil
+}
+
+// historyBlocks returns the blocks of the slots of an epoch matching the request, by increasing
+// slot and root. Blocks are looked up in the proposer index when proposers are requested.
+func (bs *Server) historyBlocks(
+	ctx context.Context,
+	req *pbrpc.HistoryRequest,
+	startSlot, endSlot types.Slot,
+) ([]*ethpb.BeaconBlockContainer, error) {
+	var blks []*ethpb.SignedBeaconBlock
+	var roots [][32]byte
+	if len(req.ProposerIndices) == 0 {
+		var err error
+		blks, roots, err = bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		epoch := helpers.SlotToEpoch(startSlot)
+		seen := make(map[types.ValidatorIndex]bool, len(req.ProposerIndices))
+		for _, idx := range req.ProposerIndices {
+			if seen[idx] {
+				continue
+			}
+			seen[idx] = true
+			proposed, proposedRoots, err := bs.BeaconDB.BlocksByProposer(ctx, idx, epoch, epoch)
+			if err != nil {
+				return nil, err
+			}
+			for i, b := range proposed {
+				if b.Block.Slot >= startSlot && b.Block.Slot <= endSlot {
+					blks = append(blks, b)
+					roots = append(roots, proposedRoots[i])
+				}
+			}
+		}
+	}
+
+	containers := make([]*ethpb.BeaconBlockContainer, 0, len(blks))
+	for i, b := range blks {
+		root := roots[i]
+		canonical, err := bs.CanonicalFetcher.IsCanonical(ctx, root)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not determine if block is canonical")
+		}
+		if req.CanonicalOnly && !canonical {
+			continue
+		}
+		containers = append(containers, &ethpb.BeaconBlockContainer{
+			Block:     b,
+			BlockRoot: root[:],
+			Canonical: canonical,
+		})
+	}
+	sort.Slice(containers, func(i, j int) bool {
+		if containers[i].Block.Block.Slot != containers[j].Block.Block.Slot {
+			return containers[i].Block.Block.Slot < containers[j].Block.Block.Slot
+		}
+		return bytes.Compare(containers[i].BlockRoot, containers[j].BlockRoot) < 0
+	})
+	return containers, nil
+}
@@ -0,0 +1,235 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type blockHistoryStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	pages []*pbrpc.BlocksPage
+}
+
+func (s *blockHistoryStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *blockHistoryStream) Send(page *pbrpc.BlocksPage) error {
+	s.pages = append(s.pages, page)
+	return nil
+}
+
+type attestationHistoryStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	pages []*pbrpc.AttestationsPage
+}
+
+func (s *attestationHistoryStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *attestationHistoryStream) Send(page *pbrpc.AttestationsPage) error {
+	s.pages = append(s.pages, page)
+	return nil
+}
+
+// setupBlockHistory saves a canonical block proposed by validator slot % 4 with two attestations
+// at each slot up to the current slot 39, and an orphaned block at slot 10. It returns the server
+// and the saved blocks by increasing slot and root.
+func setupBlockHistory(t *testing.T) (*Server, []*ethpb.BeaconBlockContainer) {
+	params.SetupTestConfigCleanup(t)
+	params.UseMinimalConfig()
+
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	currentSlot := types.Slot(39)
+	chain := &mock.ChainService{
+		CanonicalRoots: map[[32]byte]bool{},
+		Slot:           &currentSlot,
+	}
+	var blks []*ethpb.SignedBeaconBlock
+	var containers []*ethpb.BeaconBlockContainer
+	for i := types.Slot(0); i <= currentSlot; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = i
+		b.Block.ProposerIndex = types.ValidatorIndex(i % 4)
+		for j := 0; j < 2; j++ {
+			att := testutil.NewAttestation()
+			att.Data.Slot = i
+			att.Data.CommitteeIndex = types.CommitteeIndex(j)
+			b.Block.Body.Attestations = append(b.Block.Body.Attestations, att)
+		}
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		chain.CanonicalRoots[root] = true
+		blks = append(blks, b)
+		containers = append(containers, &ethpb.BeaconBlockContainer{Block: b, BlockRoot: root[:], Canonical: true})
+	}
+	orphaned := testutil.NewBeaconBlock()
+	orphaned.Block.Slot = 10
+	orphaned.Block.ProposerIndex = 3
+	orphaned.Block.Body.Graffiti = bytes.Repeat([]byte{'a'}, 32)
+	orphaned.Block.Body.Attestations = []*ethpb.Attestation{testutil.NewAttestation()}
+	root, err := orphaned.Block.HashTreeRoot()
+	require.NoError(t, err)
+	blks = append(blks, orphaned)
+	containers = append(containers, &ethpb.BeaconBlockContainer{Block: orphaned, BlockRoot: root[:]})
+	// A block past the current slot is never returned.
+	future := testutil.NewBeaconBlock()
+	future.Block.Slot = currentSlot + 1
+	blks = append(blks, future)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+
+	sort.Slice(containers, func(i, j int) bool {
+		if containers[i].Block.Block.Slot != containers[j].Block.Block.Slot {
+			return containers[i].Block.Block.Slot < containers[j].Block.Block.Slot
+		}
+		return bytes.Compare(containers[i].BlockRoot, containers[j].BlockRoot) < 0
+	})
+	return &Server{
+		BeaconDB:           db,
+		CanonicalFetcher:   chain,
+		GenesisTimeFetcher: chain,
+	}, containers
+}
+
+// listAllBlockHistory follows the page tokens of ListBlockHistory until the last page.
+func listAllBlockHistory(t *testing.T, bs *Server, req *pbrpc.HistoryRequest) []*ethpb.BeaconBlockContainer {
+	var containers []*ethpb.BeaconBlockContainer
+	for {
+		page, err := bs.ListBlockHistory(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, true, len(page.BlockContainers) <= int(req.PageSize))
+		containers = append(containers, page.BlockContainers...)
+		if page.NextPageToken == "" {
+			return containers
+		}
+		req.PageToken = page.NextPageToken
+	}
+}
+
+func TestServer_ListBlockHistory(t *testing.T) {
+	bs, containers := setupBlockHistory(t)
+
+	res := listAllBlockHistory(t, bs, &pbrpc.HistoryRequest{StartSlot: 0, EndSlot: 1000, PageSize: 3})
+	require.Equal(t, len(containers), len(res))
+	for i := range containers {
+		assert.DeepEqual(t, containers[i].BlockRoot, res[i].BlockRoot)
+		assert.Equal(t, containers[i].Canonical, res[i].Canonical)
+	}
+
+	res = listAllBlockHistory(t, bs, &pbrpc.HistoryRequest{StartSlot: 9, EndSlot: 11, PageSize: 1})
+	require.Equal(t, 4, len(res))
+	assert.Equal(t, types.Slot(9), res[0].Block.Block.Slot)
+	assert.Equal(t, types.Slot(10), res[1].Block.Block.Slot)
+	assert.Equal(t, types.Slot(10), res[2].Block.Block.Slot)
+	assert.Equal(t, types.Slot(11), res[3].Block.Block.Slot)
+}
+
+func TestServer_ListBlockHistory_Filters(t *testing.T) {
+	bs, _ := setupBlockHistory(t)
+
+	res := listAllBlockHistory(t, bs, &pbrpc.HistoryRequest{StartSlot: 8, EndSlot: 15, CanonicalOnly: true, PageSize: 2})
+	require.Equal(t, 8, len(res))
+	for i, c := range res {
+		assert.Equal(t, types.Slot(8+i), c.Block.Block.Slot)
+		assert.Equal(t, true, c.Canonical)
+	}
+
+	res = listAllBlockHistory(t, bs, &pbrpc.HistoryRequest{
+		StartSlot:       5,
+		EndSlot:         20,
+		ProposerIndices: []types.ValidatorIndex{3, 1, 3},
+		PageSize:        4,
+	})
+	want := []types.Slot{5, 7, 9, 10, 11, 13, 15, 17, 19}
+	require.Equal(t, len(want), len(res))
+	for i, c := range res {
+		assert.Equal(t, want[i], c.Block.Block.Slot)
+		assert.Equal(t, true, c.Block.Block.ProposerIndex == 1 || c.Block.Block.ProposerIndex == 3)
+	}
+}
+
+func TestServer_ListBlockHistory_Errors(t *testing.T) {
+	bs, _ := setupBlockHistory(t)
+	ctx := context.Background()
+
+	_, err := bs.ListBlockHistory(ctx, &pbrpc.HistoryRequest{StartSlot: 5, EndSlot: 4})
+	assert.ErrorContains(t, "End slot 4 is before start slot 5", err)
+	_, err = bs.ListBlockHistory(ctx, &pbrpc.HistoryRequest{EndSlot: 4, PageSize: -1})
+	assert.ErrorContains(t, "Requested page size -1 must be between 0 and max size", err)
+	_, err = bs.ListBlockHistory(ctx, &pbrpc.HistoryRequest{EndSlot: 4, PageToken: "4"})
+	assert.ErrorContains(t, "Invalid page token", err)
+	_, err = bs.ListBlockHistory(ctx, &pbrpc.HistoryRequest{StartSlot: 2, EndSlot: 4, PageToken: "1-0"})
+	assert.ErrorContains(t, "Page token slot 1 is outside of the requested slot range", err)
+}
+
+func TestServer_StreamBlockHistory(t *testing.T) {
+	bs, containers := setupBlockHistory(t)
+
+	stream := &blockHistoryStream{ctx: context.Background()}
+	require.NoError(t, bs.StreamBlockHistory(&pbrpc.HistoryRequest{EndSlot: 1000, PageSize: 10}, stream))
+	require.Equal(t, 5, len(stream.pages))
+	var res []*ethpb.BeaconBlockContainer
+	for i, page := range stream.pages {
+		assert.Equal(t, i == len(stream.pages)-1, page.NextPageToken == "")
+		res = append(res, page.BlockContainers...)
+	}
+	require.Equal(t, len(containers), len(res))
+	for i := range containers {
+		assert.DeepEqual(t, containers[i].BlockRoot, res[i].BlockRoot)
+	}
+}
+
+func TestServer_ListAttestationHistory(t *testing.T) {
+	bs, _ := setupBlockHistory(t)
+
+	req := &pbrpc.HistoryRequest{StartSlot: 9, EndSlot: 11, PageSize: 3}
+	var res []*pbrpc.IncludedAttestation
+	for {
+		page, err := bs.ListAttestationHistory(context.Background(), req)
+		require.NoError(t, err)
+		res = append(res, page.Attestations...)
+		if page.NextPageToken == "" {
+			break
+		}
+		req.PageToken = page.NextPageToken
+	}
+	// Two attestations in each canonical block, and one in the orphaned block.
+	require.Equal(t, 7, len(res))
+	canonical := 0
+	for _, att := range res {
+		if att.Canonical {
+			canonical++
+			assert.Equal(t, att.BlockSlot, att.Attestation.Data.Slot)
+		}
+	}
+	assert.Equal(t, 6, canonical)
+
+	stream := &attestationHistoryStream{ctx: context.Background()}
+	require.NoError(t, bs.StreamAttestationHistory(&pbrpc.HistoryRequest{
+		StartSlot:     9,
+		EndSlot:       11,
+		CanonicalOnly: true,
+		PageSize:      4,
+	}, stream))
+	require.Equal(t, 2, len(stream.pages))
+	assert.Equal(t, 4, len(stream.pages[0].Attestations))
+	assert.Equal(t, 2, len(stream.pages[1].Attestations))
+	assert.Equal(t, types.Slot(11), stream.pages[1].Attestations[1].BlockSlot)
+}
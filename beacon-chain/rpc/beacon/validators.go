@@ -583,14 +583,11 @@ func (bs *Server) GetValidatorQueue(
 	})
 
 	// Only activate just enough validators according to the activation churn limit.
-	activeValidatorCount, err := helpers.ActiveValidatorCount(headState, helpers.CurrentEpoch(headState))
+	summary, err := helpers.ValidatorSummary(headState)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
-	}
-	churnLimit, err := helpers.ValidatorChurnLimit(activeValidatorCount)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not compute churn limit: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not get validator summary: %v", err)
 	}
+	churnLimit := summary.ChurnLimit
 
 	exitQueueEpoch := types.Epoch(0)
 	for _, i := range exitEpochs {
@@ -12,13 +12,21 @@ import (
 	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/proto/migration"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// finalizedMetadataKey is the response metadata key telling whether the returned block headers are
+// finalized, as the v1 block header containers carry the canonical flag only.
+const finalizedMetadataKey = "finalized"
+
 // GetBlockHeader retrieves block header for given block id.
 func (bs *Server) GetBlockHeader(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BlockHeaderResponse, error) {
 	blk, err := bs.blockFromBlockID(ctx, req.BlockId)
@@ -28,35 +36,21 @@ func (bs *Server) GetBlockHeader(ctx context.Context, req *ethpb.BlockRequest) (
 	if blk == nil {
 		return nil, status.Errorf(codes.NotFound, "Could not find requested block header")
 	}
-
-	v1BlockHdr, err := migration.V1Alpha1BlockToV1BlockHeader(blk)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get block header from block: %v", err)
-	}
-
 	blkRoot, err := blk.Block.HashTreeRoot()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not hash block: %v", err)
 	}
-	canonical, err := bs.ChainInfoFetcher.IsCanonical(ctx, blkRoot)
+	finalizedSlot, err := bs.finalizedSlot(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not determine if block root is canonical: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not get finalized slot: %v", err)
 	}
-	root, err := v1BlockHdr.HashTreeRoot()
+	container, finalized, err := bs.blockHeaderContainer(ctx, blk, blkRoot, finalizedSlot)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not hash block header: %v", err)
+		return nil, err
 	}
+	setFinalizedMetadata(ctx, finalized)
 
-	return &ethpb.BlockHeaderResponse{
-		Data: &ethpb.BlockHeaderContainer{
-			Root:      root[:],
-			Canonical: canonical,
-			Header: &ethpb.BeaconBlockHeaderContainer{
-				Message:   v1BlockHdr.Header,
-				Signature: v1BlockHdr.Signature,
-			},
-		},
-	}, nil
+	return &ethpb.BlockHeaderResponse{Data: container}, nil
 }
 
 // ListBlockHeaders retrieves block headers matching given query. By default it will fetch current head slot blocks.
@@ -83,33 +77,74 @@ func (bs *Server) ListBlockHeaders(ctx context.Context, req *ethpb.BlockHeadersR
 		return nil, status.Error(codes.NotFound, "Could not find requested blocks")
 	}
 
+	finalizedSlot, err := bs.finalizedSlot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get finalized slot: %v", err)
+	}
+	allFinalized := true
 	blkHdrs := make([]*ethpb.BlockHeaderContainer, len(blks))
 	for i, blk := range blks {
-		blkHdr, err := migration.V1Alpha1BlockToV1BlockHeader(blk)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not get block header from block: %v", err)
-		}
-		canonical, err := bs.ChainInfoFetcher.IsCanonical(ctx, blkRoots[i])
+		container, finalized, err := bs.blockHeaderContainer(ctx, blk, blkRoots[i], finalizedSlot)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not determine if block root is canonical: %v", err)
-		}
-		root, err := blkHdr.Header.HashTreeRoot()
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not hash block header: %v", err)
-		}
-		blkHdrs[i] = &ethpb.BlockHeaderContainer{
-			Root:      root[:],
-			Canonical: canonical,
-			Header: &ethpb.BeaconBlockHeaderContainer{
-				Message:   blkHdr.Header,
-				Signature: blkHdr.Signature,
-			},
+			return nil, err
 		}
+		blkHdrs[i] = container
+		allFinalized = allFinalized && finalized
 	}
+	setFinalizedMetadata(ctx, allFinalized)
 
 	return &ethpb.BlockHeadersResponse{Data: blkHdrs}, nil
 }
 
+// This returns the header container of the block with the given root, along with whether the block
+// is finalized, which is the case for canonical blocks up to the finalized checkpoint block.
+func (bs *Server) blockHeaderContainer(
+	ctx context.Context, blk *ethpb_alpha.SignedBeaconBlock, blkRoot [32]byte, finalizedSlot types.Slot,
+) (*ethpb.BlockHeaderContainer, bool, error) {
+	blkHdr, err := migration.V1Alpha1BlockToV1BlockHeader(blk)
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "Could not get block header from block: %v", err)
+	}
+	canonical, err := bs.ChainInfoFetcher.IsCanonical(ctx, blkRoot)
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "Could not determine if block root is canonical: %v", err)
+	}
+	return &ethpb.BlockHeaderContainer{
+		Root:      blkRoot[:],
+		Canonical: canonical,
+		Header: &ethpb.BeaconBlockHeaderContainer{
+			Message:   blkHdr.Header,
+			Signature: blkHdr.Signature,
+		},
+	}, canonical && blk.Block.Slot <= finalizedSlot, nil
+}
+
+// This returns the slot of the finalized checkpoint block.
+func (bs *Server) finalizedSlot(ctx context.Context) (types.Slot, error) {
+	finalized := bs.ChainInfoFetcher.FinalizedCheckpt()
+	if finalized == nil || bytesutil.ToBytes32(finalized.Root) == params.BeaconConfig().ZeroHash {
+		return 0, nil
+	}
+	blk, err := bs.BeaconDB.Block(ctx, bytesutil.ToBytes32(finalized.Root))
+	if err != nil {
+		return 0, err
+	}
+	// The finalized checkpoint block may be missing from the database of nodes started from a
+	// checkpoint, in which case the checkpoint epoch bounds its slot.
+	if blk == nil || blk.Block == nil {
+		return helpers.StartSlot(finalized.Epoch)
+	}
+	return blk.Block.Slot, nil
+}
+
+// This sets the response metadata telling whether the returned data is finalized. Calls outside of
+// a gRPC server, such as in process calls, have no response metadata.
+func setFinalizedMetadata(ctx context.Context, finalized bool) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(finalizedMetadataKey, strconv.FormatBool(finalized))); err != nil {
+		log.WithError(err).Debug("Could not set finalized response metadata")
+	}
+}
+
 // SubmitBlock instructs the beacon node to broadcast a newly signed beacon block to the beacon network, to be
 // included in the beacon chain. The beacon node is not required to validate the signed BeaconBlock, and a successful
 // response (20X) only indicates that the broadcast has been successful. The beacon node is expected to integrate the
@@ -20,6 +20,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func fillDBTestBlocks(ctx context.Context, t *testing.T, beaconDB db.Database) (*ethpb_alpha.SignedBeaconBlock, []*ethpb_alpha.BeaconBlockContainer) {
@@ -589,3 +591,109 @@ func TestServer_ListBlockAttestations(t *testing.T) {
 		})
 	}
 }
+
+// headerCaptureStream captures the response metadata set by a server.
+type headerCaptureStream struct {
+	md metadata.MD
+}
+
+func (s *headerCaptureStream) Method() string { return "" }
+
+func (s *headerCaptureStream) SetHeader(md metadata.MD) error {
+	s.md = metadata.Join(s.md, md)
+	return nil
+}
+
+func (s *headerCaptureStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerCaptureStream) SetTrailer(metadata.MD) error { return nil }
+
+func TestServer_BlockHeaders_CanonicalAndFinalized(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	_, blkContainers := fillDBTestBlocks(ctx, t, beaconDB)
+	headBlock := blkContainers[len(blkContainers)-1]
+	canonicalRoots := make(map[[32]byte]bool)
+	for _, c := range blkContainers {
+		canonicalRoots[bytesutil.ToBytes32(c.BlockRoot)] = true
+	}
+	fork := testutil.NewBeaconBlock()
+	fork.Block.Slot = 30
+	fork.Block.ParentRoot = bytesutil.PadTo([]byte{'f'}, 32)
+	require.NoError(t, beaconDB.SaveBlock(ctx, fork))
+	forkRoot, err := fork.Block.HashTreeRoot()
+	require.NoError(t, err)
+	bs := &Server{
+		BeaconDB: beaconDB,
+		ChainInfoFetcher: &mock.ChainService{
+			DB:                  beaconDB,
+			Block:               headBlock.Block,
+			Root:                headBlock.BlockRoot,
+			FinalizedCheckPoint: &ethpb_alpha.Checkpoint{Epoch: 2, Root: blkContainers[64].BlockRoot},
+			CanonicalRoots:      canonicalRoots,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		blockID   []byte
+		root      []byte
+		canonical bool
+		finalized string
+	}{
+		{
+			name:      "finalized",
+			blockID:   []byte("20"),
+			root:      blkContainers[20].BlockRoot,
+			canonical: true,
+			finalized: "true",
+		},
+		{
+			name:      "finalized checkpoint",
+			blockID:   []byte("finalized"),
+			root:      blkContainers[64].BlockRoot,
+			canonical: true,
+			finalized: "true",
+		},
+		{
+			name:      "not finalized",
+			blockID:   blkContainers[70].BlockRoot,
+			root:      blkContainers[70].BlockRoot,
+			canonical: true,
+			finalized: "false",
+		},
+		{
+			name:      "not canonical",
+			blockID:   forkRoot[:],
+			root:      forkRoot[:],
+			canonical: false,
+			finalized: "false",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &headerCaptureStream{}
+			header, err := bs.GetBlockHeader(grpc.NewContextWithServerTransportStream(ctx, stream), &ethpb.BlockRequest{
+				BlockId: tt.blockID,
+			})
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.root, header.Data.Root)
+			assert.Equal(t, tt.canonical, header.Data.Canonical)
+			assert.DeepEqual(t, []string{tt.finalized}, stream.md.Get(finalizedMetadataKey))
+		})
+	}
+
+	// Headers are only reported finalized if all of them are.
+	stream := &headerCaptureStream{}
+	headers, err := bs.ListBlockHeaders(grpc.NewContextWithServerTransportStream(ctx, stream), &ethpb.BlockHeadersRequest{Slot: 30})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(headers.Data))
+	assert.Equal(t, true, headers.Data[0].Canonical)
+	assert.Equal(t, false, headers.Data[1].Canonical)
+	assert.DeepEqual(t, []string{"false"}, stream.md.Get(finalizedMetadataKey))
+	stream = &headerCaptureStream{}
+	_, err = bs.ListBlockHeaders(grpc.NewContextWithServerTransportStream(ctx, stream), &ethpb.BlockHeadersRequest{Slot: 31})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"true"}, stream.md.Get(finalizedMetadataKey))
+}
@@ -210,7 +210,9 @@ func TestSubmitAttesterSlashing_Ok(t *testing.T) {
 	_, keys, err := testutil.DeterministicDepositsAndKeys(1)
 	require.NoError(t, err)
 	validator := &eth.Validator{
-		PublicKey: keys[0].PublicKey().Marshal(),
+		PublicKey:         keys[0].PublicKey().Marshal(),
+		ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+		WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
 	}
 	state, err := testutil.NewBeaconState(func(state *pb.BeaconState) {
 		state.Validators = []*eth.Validator{validator}
@@ -4,7 +4,13 @@ import (
 	"context"
 	"errors"
 
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetValidator returns a validator specified by state and id or public key along with status and balance.
@@ -22,7 +28,62 @@ func (bs *Server) ListValidatorBalances(ctx context.Context, req *ethpb.Validato
 	return nil, errors.New("unimplemented")
 }
 
-// ListCommittees retrieves the committees for the given state at the given epoch.
+// ListCommittees retrieves the committees for the given state at the given epoch, which can be at
+// most the epoch after the one of the state. The committees can be filtered by slot and committee
+// index, a filter only being applied when it is non-zero as an unset field cannot be told apart
+// from a zero one.
 func (bs *Server) ListCommittees(ctx context.Context, req *ethpb.StateCommitteesRequest) (*ethpb.StateCommitteesResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListCommittees")
+	defer span.End()
+
+	st, err := bs.StateFetcher.State(ctx, req.StateId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+	}
+	if nextEpoch := helpers.NextEpoch(st); req.Epoch > nextEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve committees of epoch %d from a state of epoch %d",
+			req.Epoch,
+			helpers.CurrentEpoch(st),
+		)
+	}
+	startSlot, err := helpers.StartSlot(req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid epoch: %v", err)
+	}
+	if req.Slot != 0 && helpers.SlotToEpoch(req.Slot) != req.Epoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Slot %d is not in epoch %d", req.Slot, req.Epoch)
+	}
+	activeCount, err := helpers.ActiveValidatorCount(st, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	committeesPerSlot := helpers.SlotCommitteeCount(activeCount)
+
+	committees := make([]*ethpb.Committee, 0)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if req.Slot != 0 && slot != req.Slot {
+			continue
+		}
+		for index := uint64(0); index < committeesPerSlot; index++ {
+			if req.Index != 0 && index != req.Index {
+				continue
+			}
+			committee, err := helpers.BeaconCommitteeFromState(st, slot, types.CommitteeIndex(index))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute committee: %v", err)
+			}
+			validators := make([]uint64, len(committee))
+			for i, v := range committee {
+				validators[i] = uint64(v)
+			}
+			committees = append(committees, &ethpb.Committee{
+				Index:      index,
+				Slot:       slot,
+				Validators: validators,
+			})
+		}
+	}
+	return &ethpb.StateCommitteesResponse{Data: committees}, nil
 }
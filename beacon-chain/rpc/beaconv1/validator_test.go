@@ -0,0 +1,68 @@
+package beaconv1
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestListCommittees(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 128)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	s := Server{
+		StateFetcher: statefetcher.StateFetcher{
+			ChainInfoFetcher: &chainMock.ChainService{State: st},
+		},
+	}
+
+	t.Run("All committees", func(t *testing.T) {
+		resp, err := s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Epoch: 1})
+		require.NoError(t, err)
+		require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(resp.Data))
+		for i, c := range resp.Data {
+			slot := params.BeaconConfig().SlotsPerEpoch + types.Slot(i)
+			assert.Equal(t, slot, c.Slot)
+			assert.Equal(t, uint64(0), c.Index)
+			committee, err := helpers.BeaconCommitteeFromState(st, slot, 0)
+			require.NoError(t, err)
+			require.Equal(t, len(committee), len(c.Validators))
+			for j, v := range committee {
+				assert.Equal(t, uint64(v), c.Validators[j])
+			}
+		}
+	})
+
+	t.Run("Slot filter", func(t *testing.T) {
+		slot := params.BeaconConfig().SlotsPerEpoch*2 + 5
+		resp, err := s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Epoch: 2, Slot: slot})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, slot, resp.Data[0].Slot)
+	})
+
+	t.Run("Index filter", func(t *testing.T) {
+		resp, err := s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Epoch: 1, Index: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(resp.Data))
+	})
+
+	t.Run("Slot outside of epoch", func(t *testing.T) {
+		_, err := s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Epoch: 1, Slot: 1})
+		assert.ErrorContains(t, "is not in epoch", err)
+	})
+
+	t.Run("Epoch too far ahead", func(t *testing.T) {
+		_, err := s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Epoch: 3})
+		assert.ErrorContains(t, "Cannot retrieve committees of epoch 3", err)
+	})
+}
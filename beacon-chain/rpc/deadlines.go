@@ -0,0 +1,49 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Services whose requests serve validator duties, which are stale once their slot is over.
+var slotBoundServices = []string{
+	"/ethereum.eth.v1alpha1.BeaconNodeValidator/",
+	"/ethereum.beacon.rpc.v1.Duties/",
+}
+
+// requestTimeout returns the timeout of unary requests to the given method. Requests serving
+// validator duties are bound to a slot, while other requests, which may replay historical
+// states, are bound to an epoch.
+func requestTimeout(fullMethod string) time.Duration {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	for _, prefix := range slotBoundServices {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return slotDuration
+		}
+	}
+	return slotDuration * time.Duration(params.BeaconConfig().SlotsPerEpoch)
+}
+
+// Unary interceptor bounding requests by a deadline, unless the client set an earlier one. The
+// deadline is carried by the context of the request, so that overdue or abandoned requests stop
+// the state replays and transitions they started.
+func (s *Service) requestDeadlineUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout(info.FullMethod))
+	defer cancel()
+	res, err := handler(ctx, req)
+	if err != nil && ctx.Err() != nil {
+		// Handlers wrap context errors in various codes, which are reported consistently instead.
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	return res, err
+}
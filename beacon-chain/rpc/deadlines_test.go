@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestTimeout(t *testing.T) {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	assert.Equal(t, slotDuration, requestTimeout("/ethereum.eth.v1alpha1.BeaconNodeValidator/GetBlock"))
+	assert.Equal(t, slotDuration, requestTimeout("/ethereum.beacon.rpc.v1.Duties/GetEpochDuties"))
+	assert.Equal(t, slotDuration*time.Duration(params.BeaconConfig().SlotsPerEpoch), requestTimeout("/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances"))
+}
+
+func TestRequestDeadlineUnaryInterceptor(t *testing.T) {
+	s := &Service{}
+	info := &grpc.UnaryServerInfo{FullMethod: "/ethereum.eth.v1alpha1.BeaconNodeValidator/GetBlock"}
+
+	var deadline time.Time
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		var ok bool
+		deadline, ok = ctx.Deadline()
+		require.Equal(t, true, ok, "Request has no deadline")
+		return "ok", nil
+	}
+	res, err := s.requestDeadlineUnaryInterceptor(context.Background(), nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	assert.Equal(t, true, time.Until(deadline) <= slotDuration, "Deadline is later than a slot")
+
+	// An earlier deadline of the client is kept.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	clientDeadline, _ := ctx.Deadline()
+	_, err = s.requestDeadlineUnaryInterceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, clientDeadline, deadline)
+
+	// Errors caused by an expired request are reported as such.
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = s.requestDeadlineUnaryInterceptor(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return nil, status.Errorf(codes.Internal, "could not replay state: %v", ctx.Err())
+	})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
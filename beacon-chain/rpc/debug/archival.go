@@ -0,0 +1,61 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxReplayCostSlots is the maximum number of slots the replay cost can be requested for at once.
+const maxReplayCostSlots = 64
+
+// GetArchivalConfig returns the number of slots between the states saved in the cold section of the database.
+func (ds *Server) GetArchivalConfig(_ context.Context, _ *empty.Empty) (*pbrpc.ArchivalConfig, error) {
+	return &pbrpc.ArchivalConfig{SlotsPerArchivedPoint: ds.StateGen.SlotsPerArchivedPoint()}, nil
+}
+
+// SetArchivalConfig changes the number of slots between the states saved in the cold section of the
+// database, and re-indexes the existing cold states on the new interval.
+func (ds *Server) SetArchivalConfig(ctx context.Context, req *pbrpc.ArchivalConfig) (*pbrpc.ArchivalMigrationResponse, error) {
+	if req.SlotsPerArchivedPoint == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Slots per archived point must be positive")
+	}
+	migration, err := ds.StateGen.SetSlotsPerArchivedPoint(ctx, req.SlotsPerArchivedPoint)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not change slots per archived point: %v", err)
+	}
+	return &pbrpc.ArchivalMigrationResponse{
+		PreviousSlotsPerArchivedPoint: migration.PreviousSlotsPerArchivedPoint,
+		SlotsPerArchivedPoint:         migration.SlotsPerArchivedPoint,
+		SavedStates:                   uint64(migration.SavedStates),
+		DeletedStates:                 uint64(migration.DeletedStates),
+	}, nil
+}
+
+// ListReplayCosts returns the number of blocks and slots to replay to regenerate the states at the
+// requested slots.
+func (ds *Server) ListReplayCosts(ctx context.Context, req *pbrpc.ReplayCostsRequest) (*pbrpc.ReplayCostsResponse, error) {
+	if len(req.Slots) > maxReplayCostSlots {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested %d slots, the maximum is %d", len(req.Slots), maxReplayCostSlots)
+	}
+	resp := &pbrpc.ReplayCostsResponse{Costs: make([]*pbrpc.ReplayCost, len(req.Slots))}
+	for i, slot := range req.Slots {
+		cost, err := ds.StateGen.ReplayCost(ctx, slot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get replay cost of slot %d: %v", slot, err)
+		}
+		resp.Costs[i] = &pbrpc.ReplayCost{
+			Slot:      cost.Slot,
+			BlockRoot: cost.BlockRoot[:],
+			StartSlot: cost.StartSlot,
+			StartRoot: cost.StartRoot[:],
+			InMemory:  cost.InMemory,
+			Blocks:    cost.Blocks,
+			Slots:     cost.Slots,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,70 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ArchivalConfig(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	ds := &Server{StateGen: stategen.New(db)}
+
+	res, err := ds.GetArchivalConfig(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconConfig().SlotsPerArchivedPoint, res.SlotsPerArchivedPoint)
+
+	_, err = ds.SetArchivalConfig(ctx, &pbrpc.ArchivalConfig{})
+	assert.ErrorContains(t, "Slots per archived point must be positive", err)
+
+	// Nothing is finalized, so there are no cold states to re-index.
+	migration, err := ds.SetArchivalConfig(ctx, &pbrpc.ArchivalConfig{SlotsPerArchivedPoint: 64})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pbrpc.ArchivalMigrationResponse{
+		PreviousSlotsPerArchivedPoint: params.BeaconConfig().SlotsPerArchivedPoint,
+		SlotsPerArchivedPoint:         64,
+	}, migration)
+	res, err = ds.GetArchivalConfig(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(64), res.SlotsPerArchivedPoint)
+}
+
+func TestServer_ListReplayCosts(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	ds := &Server{StateGen: stategen.New(db)}
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, gRoot))
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 2
+	b.Block.ParentRoot = gRoot[:]
+	require.NoError(t, db.SaveBlock(ctx, b))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	res, err := ds.ListReplayCosts(ctx, &pbrpc.ReplayCostsRequest{Slots: []types.Slot{0, 5}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Costs))
+	assert.DeepEqual(t, &pbrpc.ReplayCost{Slot: 0, BlockRoot: gRoot[:], StartRoot: gRoot[:]}, res.Costs[0])
+	assert.DeepEqual(t, &pbrpc.ReplayCost{Slot: 5, BlockRoot: root[:], StartRoot: gRoot[:], Blocks: 1, Slots: 5}, res.Costs[1])
+
+	_, err = ds.ListReplayCosts(ctx, &pbrpc.ReplayCostsRequest{Slots: make([]types.Slot, maxReplayCostSlots+1)})
+	assert.ErrorContains(t, "the maximum is 64", err)
+}
@@ -0,0 +1,36 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/timing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListArrivalEvents returns the most recent arrival times of gossiped blocks and aggregate
+// attestations relative to the start of their slot, newest first.
+func (ds *Server) ListArrivalEvents(_ context.Context, req *pbrpc.ArrivalEventsRequest) (*pbrpc.ArrivalEventsResponse, error) {
+	if ds.ArrivalRecorder == nil {
+		return nil, status.Error(codes.Unavailable, "Arrival timing is not being recorded")
+	}
+	events := ds.ArrivalRecorder.Recent(int(req.Limit))
+	resp := &pbrpc.ArrivalEventsResponse{Events: make([]*pbrpc.ArrivalEvent, len(events))}
+	for i, e := range events {
+		kind := pbrpc.ArrivalEvent_BLOCK
+		if e.Kind == timing.Aggregate {
+			kind = pbrpc.ArrivalEvent_AGGREGATE
+		}
+		root := e.Root
+		resp.Events[i] = &pbrpc.ArrivalEvent{
+			Kind:              kind,
+			Slot:              e.Slot,
+			Root:              root[:],
+			ValidatorIndex:    e.ValidatorIndex,
+			DelayMilliseconds: e.Delay.Milliseconds(),
+			Late:              e.Late,
+		}
+	}
+	return resp, nil
+}
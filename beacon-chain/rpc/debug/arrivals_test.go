@@ -0,0 +1,44 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/timing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListArrivalEvents(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{}
+	_, err := ds.ListArrivalEvents(ctx, &pbrpc.ArrivalEventsRequest{})
+	assert.ErrorContains(t, "Arrival timing is not being recorded", err)
+
+	genesis := time.Now()
+	slotStart := genesis.Add(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	ds.ArrivalRecorder = timing.NewRecorder(16)
+	blockRoot := [32]byte{'a'}
+	ds.ArrivalRecorder.RecordBlock(genesis, 1, 3, blockRoot, slotStart.Add(500*time.Millisecond))
+	ds.ArrivalRecorder.RecordAggregate(genesis, 1, 4, [32]byte{'b'}, slotStart.Add(20*time.Second))
+
+	resp, err := ds.ListArrivalEvents(ctx, &pbrpc.ArrivalEventsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Events))
+	assert.Equal(t, pbrpc.ArrivalEvent_AGGREGATE, resp.Events[0].Kind)
+	assert.Equal(t, types.ValidatorIndex(4), resp.Events[0].ValidatorIndex)
+	assert.Equal(t, true, resp.Events[0].Late)
+	assert.Equal(t, pbrpc.ArrivalEvent_BLOCK, resp.Events[1].Kind)
+	assert.Equal(t, types.Slot(1), resp.Events[1].Slot)
+	assert.DeepEqual(t, blockRoot[:], resp.Events[1].Root)
+	assert.Equal(t, int64(500), resp.Events[1].DelayMilliseconds)
+	assert.Equal(t, false, resp.Events[1].Late)
+
+	resp, err = ds.ListArrivalEvents(ctx, &pbrpc.ArrivalEventsRequest{Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(resp.Events))
+}
@@ -51,6 +51,26 @@ func (ds *Server) GetInclusionSlot(ctx context.Context, req *pbrpc.InclusionSlot
 		return nil, fmt.Errorf("attestation has one epoch window, please request slot older than %d", epochBack)
 	}
 
+	// The attestation inclusion index, if the node maintains it, answers without replaying states.
+	// Its inclusions are in inclusion slot order, the first one by a canonical block is the answer.
+	epoch := helpers.SlotToEpoch(req.Slot)
+	inclusions, err := ds.BeaconDB.AttestationInclusions(ctx, types.ValidatorIndex(req.Id), epoch, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve attestation inclusions: %v", err)
+	}
+	for _, inclusion := range inclusions {
+		if inclusion.InclusionSlot-inclusion.InclusionDistance != req.Slot {
+			continue
+		}
+		canonical, err := ds.CanonicalFetcher.IsCanonical(ctx, inclusion.BlockRoot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
+		}
+		if canonical {
+			return &pbrpc.InclusionSlotResponse{Slot: inclusion.InclusionSlot}, nil
+		}
+	}
+
 	// Attestation could be in blocks between slot + 1 to slot + epoch_duration.
 	startSlot := req.Slot + params.BeaconConfig().MinAttestationInclusionDelay
 	endSlot := req.Slot + params.BeaconConfig().SlotsPerEpoch
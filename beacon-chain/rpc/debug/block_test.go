@@ -5,10 +5,12 @@ import (
 	"testing"
 	"time"
 
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -84,3 +86,29 @@ func TestServer_GetAttestationInclusionSlot(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, params.BeaconConfig().FarFutureSlot, res.Slot)
 }
+
+func TestServer_GetAttestationInclusionSlot_Indexed(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+	offset := int64(2 * params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot))
+	canonical, fork := [32]byte{'a'}, [32]byte{'b'}
+	bs := &Server{
+		BeaconDB:           beaconDB,
+		StateGen:           stategen.New(beaconDB),
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now().Add(time.Duration(-1*offset) * time.Second)},
+		CanonicalFetcher:   &mock.ChainService{CanonicalRoots: map[[32]byte]bool{canonical: true}},
+	}
+
+	// No block is saved, the inclusions are only known to the index. The earlier inclusion is by a
+	// block of another fork.
+	require.NoError(t, beaconDB.SaveAttestationInclusions(ctx, []*db.AttestationInclusion{
+		{ValidatorIndex: 3, Epoch: 0, InclusionSlot: 4, InclusionDistance: 3, BlockRoot: canonical},
+		{ValidatorIndex: 3, Epoch: 0, InclusionSlot: 2, InclusionDistance: 1, BlockRoot: fork},
+	}))
+	res, err := bs.GetInclusionSlot(ctx, &pbrpc.InclusionSlotRequest{Slot: 1, Id: 3})
+	require.NoError(t, err)
+	require.Equal(t, types.Slot(4), res.Slot)
+	res, err = bs.GetInclusionSlot(ctx, &pbrpc.InclusionSlotRequest{Slot: 2, Id: 3})
+	require.NoError(t, err)
+	require.Equal(t, params.BeaconConfig().FarFutureSlot, res.Slot)
+}
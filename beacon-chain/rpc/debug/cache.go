@@ -0,0 +1,42 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListCaches returns the entry count, estimated memory and hit ratio of the registered caches.
+func (ds *Server) ListCaches(_ context.Context, _ *empty.Empty) (*pbrpc.CachesResponse, error) {
+	if ds.CacheRegistry == nil {
+		return nil, status.Error(codes.Unavailable, "Cache registry is not available")
+	}
+	stats := ds.CacheRegistry.Stats()
+	resp := &pbrpc.CachesResponse{Caches: make([]*pbrpc.CacheInfo, len(stats))}
+	for i, s := range stats {
+		resp.Caches[i] = &pbrpc.CacheInfo{
+			Name:           s.Name,
+			Entries:        uint64(s.Entries),
+			EstimatedBytes: s.EstimatedBytes,
+			Hits:           s.Hits,
+			Misses:         s.Misses,
+			HitRatio:       s.HitRatio(),
+		}
+	}
+	return resp, nil
+}
+
+// FlushCache removes all the entries of the registered cache of the given name.
+func (ds *Server) FlushCache(_ context.Context, req *pbrpc.FlushCacheRequest) (*empty.Empty, error) {
+	if ds.CacheRegistry == nil {
+		return nil, status.Error(codes.Unavailable, "Cache registry is not available")
+	}
+	if err := ds.CacheRegistry.Flush(req.Name); err != nil {
+		return nil, status.Errorf(codes.NotFound, "Could not flush cache %q: %v", req.Name, err)
+	}
+	log.WithField("cache", req.Name).Info("Flushed cache")
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,40 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListCaches(t *testing.T) {
+	ctx := context.Background()
+	registry := cache.NewRegistry()
+	c := cache.NewRecentStateCache()
+	registry.Register("recent-state", c)
+	c.StateByRoot([32]byte{'a'})
+	ds := &Server{CacheRegistry: registry}
+
+	resp, err := ds.ListCaches(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Caches))
+	assert.Equal(t, "recent-state", resp.Caches[0].Name)
+	assert.Equal(t, uint64(0), resp.Caches[0].Entries)
+	assert.Equal(t, uint64(0), resp.Caches[0].Hits)
+	assert.Equal(t, uint64(1), resp.Caches[0].Misses)
+}
+
+func TestServer_FlushCache(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{CacheRegistry: cache.NewRegistry()}
+	ds.CacheRegistry.Register("recent-state", cache.NewRecentStateCache())
+
+	_, err := ds.FlushCache(ctx, &pbrpc.FlushCacheRequest{Name: "recent-state"})
+	require.NoError(t, err)
+	_, err = ds.FlushCache(ctx, &pbrpc.FlushCacheRequest{Name: "unknown"})
+	assert.ErrorContains(t, "Could not flush cache", err)
+}
@@ -0,0 +1,171 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sszChunkSize is the size of the chunks of a download, well below the default maximum gRPC message
+// size of 4MB.
+var sszChunkSize = 1 << 20
+
+// sszSender is the stream of a download.
+type sszSender interface {
+	Send(*pbrpc.SSZChunk) error
+	Context() context.Context
+}
+
+// DownloadBlock streams the SSZ encoding of the block of a root, or of the canonical block of a slot.
+func (ds *Server) DownloadBlock(req *pbrpc.SSZDownloadRequest, stream pbrpc.Debug_DownloadBlockServer) error {
+	ctx := stream.Context()
+	var root [32]byte
+	switch q := req.QueryFilter.(type) {
+	case *pbrpc.SSZDownloadRequest_BlockRoot:
+		root = bytesutil.ToBytes32(q.BlockRoot)
+	case *pbrpc.SSZDownloadRequest_Slot:
+		r, err := ds.canonicalBlockRootAtSlot(ctx, q.Slot)
+		if err != nil {
+			return err
+		}
+		root = r
+	default:
+		return status.Error(codes.InvalidArgument, "Need to specify either a block root or slot to download a block")
+	}
+	blk, err := ds.BeaconDB.Block(ctx, root)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not retrieve block by root: %v", err)
+	}
+	if blk == nil || blk.Block == nil {
+		return status.Errorf(codes.NotFound, "Block %#x not found", root)
+	}
+	enc, err := blk.MarshalSSZ()
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not ssz encode block: %v", err)
+	}
+	if req.Snappy {
+		if enc, err = compressSnappy(enc); err != nil {
+			return status.Errorf(codes.Internal, "Could not compress block: %v", err)
+		}
+	}
+	return sendSSZ(stream, enc)
+}
+
+// DownloadBeaconState streams the SSZ encoding of the state of a block root, or of the canonical
+// state of a slot. As with ExportState, a single state is regenerated at a time.
+func (ds *Server) DownloadBeaconState(req *pbrpc.SSZDownloadRequest, stream pbrpc.Debug_DownloadBeaconStateServer) error {
+	ctx := stream.Context()
+	if !atomic.CompareAndSwapInt32(&ds.exporting, 0, 1) {
+		return status.Error(codes.ResourceExhausted, "Another state export is in progress")
+	}
+	defer atomic.StoreInt32(&ds.exporting, 0)
+
+	var st iface.BeaconState
+	var err error
+	switch q := req.QueryFilter.(type) {
+	case *pbrpc.SSZDownloadRequest_BlockRoot:
+		st, err = ds.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(q.BlockRoot))
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not compute state by block root: %v", err)
+		}
+	case *pbrpc.SSZDownloadRequest_Slot:
+		currentSlot := ds.GenesisTimeFetcher.CurrentSlot()
+		if q.Slot > currentSlot {
+			return status.Errorf(
+				codes.InvalidArgument,
+				"Cannot retrieve information about a slot in the future, current slot %d, requested slot %d",
+				currentSlot,
+				q.Slot,
+			)
+		}
+		st, err = ds.StateGen.StateBySlot(ctx, q.Slot)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Could not compute state by slot: %v", err)
+		}
+	default:
+		return status.Error(codes.InvalidArgument, "Need to specify either a block root or slot to download a state")
+	}
+	var enc []byte
+	if req.Snappy {
+		enc, err = st.MarshalSSZSnappy()
+	} else {
+		enc, err = st.MarshalSSZ()
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not ssz encode beacon state: %v", err)
+	}
+	return sendSSZ(stream, enc)
+}
+
+// canonicalBlockRootAtSlot returns the root of the block of a slot, the finalized one when a fork
+// also has a block at the slot.
+func (ds *Server) canonicalBlockRootAtSlot(ctx context.Context, slot types.Slot) ([32]byte, error) {
+	hasRoots, roots, err := ds.BeaconDB.BlockRootsBySlot(ctx, slot)
+	if err != nil {
+		return [32]byte{}, status.Errorf(codes.Internal, "Could not retrieve blocks for slot %d: %v", slot, err)
+	}
+	if !hasRoots {
+		return [32]byte{}, status.Errorf(codes.NotFound, "No block at slot %d", slot)
+	}
+	if len(roots) == 1 {
+		return roots[0], nil
+	}
+	ambiguous := status.Errorf(codes.FailedPrecondition, "Slot %d has %d blocks, request a block by root", slot, len(roots))
+	finalized, err := ds.BeaconDB.FinalizedBlockRootBySlot(ctx, slot)
+	if errors.Is(err, db.ErrNotFinalizedSlot) {
+		return [32]byte{}, ambiguous
+	}
+	if err != nil {
+		return [32]byte{}, status.Errorf(codes.Internal, "Could not get finalized block root: %v", err)
+	}
+	for _, r := range roots {
+		if r == finalized {
+			return r, nil
+		}
+	}
+	return [32]byte{}, ambiguous
+}
+
+// sendSSZ streams an encoding in chunks of sszChunkSize.
+func sendSSZ(stream sszSender, enc []byte) error {
+	size := uint64(len(enc))
+	for offset := 0; offset == 0 || offset < len(enc); offset += sszChunkSize {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		end := offset + sszChunkSize
+		if end > len(enc) {
+			end = len(enc)
+		}
+		if err := stream.Send(&pbrpc.SSZChunk{
+			Data:      enc[offset:end],
+			Offset:    uint64(offset),
+			TotalSize: size,
+		}); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send chunk: %v", err)
+		}
+	}
+	return nil
+}
+
+func compressSnappy(enc []byte) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := snappy.NewBufferedWriter(buf)
+	if _, err := w.Write(enc); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,140 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/golang/snappy"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type sszStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []*pbrpc.SSZChunk
+}
+
+func (s *sszStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sszStream) Send(chunk *pbrpc.SSZChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+// download reassembles the chunks of a download, checking their offsets and sizes.
+func (s *sszStream) download(t *testing.T) []byte {
+	var enc []byte
+	for _, chunk := range s.chunks {
+		assert.Equal(t, uint64(len(enc)), chunk.Offset)
+		assert.Equal(t, s.chunks[0].TotalSize, chunk.TotalSize)
+		enc = append(enc, chunk.Data...)
+	}
+	require.Equal(t, s.chunks[0].TotalSize, uint64(len(enc)))
+	s.chunks = nil
+	return enc
+}
+
+func TestServer_DownloadBlock(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	defer func(size int) {
+		sszChunkSize = size
+	}(sszChunkSize)
+	sszChunkSize = 100
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 3
+	require.NoError(t, db.SaveBlock(ctx, b))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	wanted, err := b.MarshalSSZ()
+	require.NoError(t, err)
+	ds := &Server{BeaconDB: db}
+	stream := &sszStream{ctx: ctx}
+
+	require.NoError(t, ds.DownloadBlock(&pbrpc.SSZDownloadRequest{
+		QueryFilter: &pbrpc.SSZDownloadRequest_BlockRoot{BlockRoot: root[:]},
+	}, stream))
+	assert.Equal(t, (len(wanted)+sszChunkSize-1)/sszChunkSize, len(stream.chunks))
+	assert.DeepEqual(t, wanted, stream.download(t))
+
+	require.NoError(t, ds.DownloadBlock(&pbrpc.SSZDownloadRequest{
+		QueryFilter: &pbrpc.SSZDownloadRequest_Slot{Slot: 3},
+		Snappy:      true,
+	}, stream))
+	decompressed, err := ioutil.ReadAll(snappy.NewReader(bytes.NewReader(stream.download(t))))
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, decompressed)
+
+	err = ds.DownloadBlock(&pbrpc.SSZDownloadRequest{
+		QueryFilter: &pbrpc.SSZDownloadRequest_Slot{Slot: 4},
+	}, stream)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// A fork block at the slot makes it ambiguous until it is finalized.
+	fork := testutil.NewBeaconBlock()
+	fork.Block.Slot = 3
+	fork.Block.ProposerIndex = 1
+	require.NoError(t, db.SaveBlock(ctx, fork))
+	err = ds.DownloadBlock(&pbrpc.SSZDownloadRequest{
+		QueryFilter: &pbrpc.SSZDownloadRequest_Slot{Slot: 3},
+	}, stream)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestServer_DownloadBeaconState(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(5))
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 5
+	require.NoError(t, db.SaveBlock(ctx, b))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	gen := stategen.New(db)
+	require.NoError(t, gen.SaveState(ctx, root, st))
+	require.NoError(t, db.SaveState(ctx, st, root))
+	wanted, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	ds := &Server{
+		BeaconDB:           db,
+		StateGen:           gen,
+		GenesisTimeFetcher: &mock.ChainService{},
+	}
+	stream := &sszStream{ctx: ctx}
+
+	require.NoError(t, ds.DownloadBeaconState(&pbrpc.SSZDownloadRequest{
+		QueryFilter: &pbrpc.SSZDownloadRequest_BlockRoot{BlockRoot: root[:]},
+	}, stream))
+	assert.DeepEqual(t, wanted, stream.download(t))
+
+	require.NoError(t, ds.DownloadBeaconState(&pbrpc.SSZDownloadRequest{
+		QueryFilter: &pbrpc.SSZDownloadRequest_BlockRoot{BlockRoot: root[:]},
+		Snappy:      true,
+	}, stream))
+	decompressed, err := ioutil.ReadAll(snappy.NewReader(bytes.NewReader(stream.download(t))))
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, decompressed)
+
+	err = ds.DownloadBeaconState(&pbrpc.SSZDownloadRequest{
+		QueryFilter: &pbrpc.SSZDownloadRequest_Slot{Slot: ds.GenesisTimeFetcher.CurrentSlot() + 1},
+	}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = ds.DownloadBeaconState(&pbrpc.SSZDownloadRequest{}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,51 @@
+package debug
+
+import (
+	"context"
+	"sync/atomic"
+
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportState returns the canonical state at the requested slot along with its latest block, ssz
+// encoded to be loaded with --checkpoint-state and --checkpoint-block. Regenerating an old state
+// can replay many blocks, so a single export runs at a time.
+func (ds *Server) ExportState(ctx context.Context, req *pbrpc.ExportStateRequest) (*pbrpc.ExportStateResponse, error) {
+	currentSlot := ds.GenesisTimeFetcher.CurrentSlot()
+	if req.Slot > currentSlot {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot export a state of a slot in the future, current slot %d, requested slot %d",
+			currentSlot,
+			req.Slot,
+		)
+	}
+	if !atomic.CompareAndSwapInt32(&ds.exporting, 0, 1) {
+		return nil, status.Error(codes.ResourceExhausted, "Another state export is in progress")
+	}
+	defer atomic.StoreInt32(&ds.exporting, 0)
+
+	snapshot, err := ds.StateGen.SnapshotBySlot(ctx, req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state by slot: %v", err)
+	}
+	var encState []byte
+	if req.Snappy {
+		encState, err = snapshot.State.MarshalSSZSnappy()
+	} else {
+		encState, err = snapshot.State.MarshalSSZ()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not ssz encode beacon state: %v", err)
+	}
+	encBlock, err := snapshot.Block.MarshalSSZ()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not ssz encode block: %v", err)
+	}
+	return &pbrpc.ExportStateResponse{
+		State: encState,
+		Block: encBlock,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ExportState(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	slot := types.Slot(100)
+	require.NoError(t, st.SetSlot(slot))
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = slot
+	require.NoError(t, db.SaveBlock(ctx, b))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	gen := stategen.New(db)
+	require.NoError(t, gen.SaveState(ctx, root, st))
+	require.NoError(t, db.SaveState(ctx, st, root))
+	ds := &Server{
+		StateGen:           gen,
+		GenesisTimeFetcher: &mock.ChainService{},
+	}
+
+	res, err := ds.ExportState(ctx, &pbrpc.ExportStateRequest{Slot: slot})
+	require.NoError(t, err)
+	wantedState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantedState, res.State)
+	wantedBlock, err := b.MarshalSSZ()
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantedBlock, res.Block)
+
+	res, err = ds.ExportState(ctx, &pbrpc.ExportStateRequest{Slot: slot, Snappy: true})
+	require.NoError(t, err)
+	wantedState, err = st.MarshalSSZSnappy()
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantedState, res.State)
+}
+
+func TestServer_ExportState_RequestFutureSlot(t *testing.T) {
+	ds := &Server{GenesisTimeFetcher: &mock.ChainService{}}
+	req := &pbrpc.ExportStateRequest{Slot: ds.GenesisTimeFetcher.CurrentSlot() + 1}
+	_, err := ds.ExportState(context.Background(), req)
+	assert.ErrorContains(t, "Cannot export a state of a slot in the future", err)
+}
+
+func TestServer_ExportState_InProgress(t *testing.T) {
+	ds := &Server{GenesisTimeFetcher: &mock.ChainService{}, exporting: 1}
+	_, err := ds.ExportState(context.Background(), &pbrpc.ExportStateRequest{})
+	assert.ErrorContains(t, "Another state export is in progress", err)
+}
@@ -0,0 +1,175 @@
+package debug
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetFinalizedBlockRootBySlot returns the finalized block root of a slot, along with its Merkle
+// proof against the state root of the finalized block. The state of the finalized block records
+// the block roots of the slots of its last historical period in state.block_roots, the roots of
+// older slots are proven through the historical batch of their period in state.historical_roots.
+func (ds *Server) GetFinalizedBlockRootBySlot(
+	ctx context.Context,
+	req *pbrpc.FinalizedBlockRootRequest,
+) (*pbrpc.FinalizedBlockRootResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "debug.GetFinalizedBlockRootBySlot")
+	defer span.End()
+
+	cp, err := ds.BeaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get finalized checkpoint: %v", err)
+	}
+	fRoot := bytesutil.ToBytes32(cp.Root)
+	fBlk, err := ds.BeaconDB.Block(ctx, fRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get finalized block: %v", err)
+	}
+	if fBlk == nil || fBlk.Block == nil {
+		return nil, status.Errorf(codes.NotFound, "Finalized block %#x not found", fRoot)
+	}
+	if req.Slot >= fBlk.Block.Slot {
+		return nil, status.Errorf(codes.InvalidArgument, "Slot %d is not before slot %d of the finalized block", req.Slot, fBlk.Block.Slot)
+	}
+	blockRoot, err := ds.BeaconDB.FinalizedBlockRootBySlot(ctx, req.Slot)
+	if errors.Is(err, db.ErrNotFinalizedSlot) {
+		return nil, status.Errorf(codes.NotFound, "Could not get finalized block root: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get finalized block root: %v", err)
+	}
+	st, err := ds.StateGen.StateByRoot(ctx, fRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get finalized state: %v", err)
+	}
+
+	var leaf [32]byte
+	var generalizedIndex uint64
+	var proof [][]byte
+	if req.Slot+params.BeaconConfig().SlotsPerHistoricalRoot >= st.Slot() {
+		leaf, generalizedIndex, proof, err = blockRootProof(ctx, st, req.Slot)
+	} else {
+		// The block roots of the historical batch of a period are the ones of the state at the start
+		// of the next period.
+		period := req.Slot / params.BeaconConfig().SlotsPerHistoricalRoot
+		batch, stErr := ds.StateGen.StateBySlot(ctx, (period+1)*params.BeaconConfig().SlotsPerHistoricalRoot)
+		if stErr != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get state of historical batch: %v", stErr)
+		}
+		leaf, generalizedIndex, proof, err = historicalBlockRootProof(ctx, st, batch, req.Slot)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not prove block root: %v", err)
+	}
+	if leaf != blockRoot {
+		return nil, status.Errorf(codes.Internal, "Block root %#x of the finalized state does not match block root %#x of the finalized index", leaf, blockRoot)
+	}
+	return &pbrpc.FinalizedBlockRootResponse{
+		Slot:             req.Slot,
+		BlockRoot:        blockRoot[:],
+		StateRoot:        fBlk.Block.StateRoot,
+		StateSlot:        st.Slot(),
+		GeneralizedIndex: generalizedIndex,
+		Proof:            proof,
+	}, nil
+}
+
+// blockRootProof returns the block root of a slot of the last historical period of the state, along
+// with its generalized index and Merkle branch in state.block_roots.
+func blockRootProof(ctx context.Context, st iface.BeaconState, slot types.Slot) ([32]byte, uint64, [][]byte, error) {
+	idx := uint64(slot % params.BeaconConfig().SlotsPerHistoricalRoot)
+	root, err := st.BlockRootAtIndex(idx)
+	if err != nil {
+		return [32]byte{}, 0, nil, err
+	}
+	generalizedIndex, err := stateV0.ElementGeneralizedIndex("blockRoots", idx)
+	if err != nil {
+		return [32]byte{}, 0, nil, err
+	}
+	proof, err := st.Proof(ctx, generalizedIndex)
+	if err != nil {
+		return [32]byte{}, 0, nil, err
+	}
+	return bytesutil.ToBytes32(root), generalizedIndex, proof, nil
+}
+
+// historicalBlockRootProof returns the block root of a slot of an older historical period than the
+// last one of the state, along with its generalized index and Merkle branch through the historical
+// batch of the period in state.historical_roots. The batch state is the state whose block roots and
+// state roots form the historical batch of the period.
+func historicalBlockRootProof(
+	ctx context.Context,
+	st, batch iface.BeaconState,
+	slot types.Slot,
+) ([32]byte, uint64, [][]byte, error) {
+	slotsPerHistoricalRoot := uint64(params.BeaconConfig().SlotsPerHistoricalRoot)
+	historicalRootsLimit := params.BeaconConfig().HistoricalRootsLimit
+	period := uint64(slot) / slotsPerHistoricalRoot
+	idx := uint64(slot) % slotsPerHistoricalRoot
+	historicalRoots := st.HistoricalRoots()
+	if period >= uint64(len(historicalRoots)) {
+		return [32]byte{}, 0, nil, errors.New("no historical root for the period of the slot")
+	}
+	blockRoots, stateRoots := batch.BlockRoots(), batch.StateRoots()
+	if uint64(len(blockRoots)) != slotsPerHistoricalRoot || uint64(len(stateRoots)) != slotsPerHistoricalRoot {
+		return [32]byte{}, 0, nil, errors.New("historical batch has the wrong number of roots")
+	}
+
+	hasher := htrutils.NewHasherFunc(hashutil.CustomSHA256Hasher())
+	leaves := func(roots [][]byte) func(i uint64) []byte {
+		return func(i uint64) []byte {
+			return roots[i]
+		}
+	}
+	// The historical batch is a container of its block roots and its state roots.
+	blockRootsRoot := htrutils.Merkleize(hasher, slotsPerHistoricalRoot, slotsPerHistoricalRoot, leaves(blockRoots))
+	stateRootsRoot := htrutils.Merkleize(hasher, slotsPerHistoricalRoot, slotsPerHistoricalRoot, leaves(stateRoots))
+	if hasher.Combi(blockRootsRoot, stateRootsRoot) != bytesutil.ToBytes32(historicalRoots[period]) {
+		return [32]byte{}, 0, nil, errors.New("historical batch does not match the historical root of its period")
+	}
+
+	proof := make([][]byte, 0)
+	for _, node := range htrutils.ConstructProof(hasher, slotsPerHistoricalRoot, slotsPerHistoricalRoot, leaves(blockRoots), idx) {
+		node := node
+		proof = append(proof, node[:])
+	}
+	proof = append(proof, stateRootsRoot[:])
+	count := uint64(len(historicalRoots))
+	for _, node := range htrutils.ConstructProof(hasher, count, historicalRootsLimit, leaves(historicalRoots), period) {
+		node := node
+		proof = append(proof, node[:])
+	}
+	length := make([]byte, 32)
+	binary.LittleEndian.PutUint64(length, count)
+	proof = append(proof, length)
+
+	fieldIndex, err := stateV0.FieldGeneralizedIndex("historicalRoots")
+	if err != nil {
+		return [32]byte{}, 0, nil, err
+	}
+	fieldProof, err := st.Proof(ctx, fieldIndex)
+	if err != nil {
+		return [32]byte{}, 0, nil, err
+	}
+	proof = append(proof, fieldProof...)
+
+	// The data of the list is the left child of the field, and the block roots are the left child
+	// of the historical batch.
+	batchIndex := fieldIndex<<(1+htrutils.Depth(historicalRootsLimit)) + period
+	generalizedIndex := batchIndex<<(1+htrutils.Depth(slotsPerHistoricalRoot)) + idx
+	return bytesutil.ToBytes32(blockRoots[idx]), generalizedIndex, proof, nil
+}
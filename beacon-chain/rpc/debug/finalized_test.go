@@ -0,0 +1,132 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// proofRoot hashes a leaf up its Merkle branch to the root of the tree.
+func proofRoot(t *testing.T, leaf []byte, generalizedIndex uint64, proof [][]byte) [32]byte {
+	node := bytesutil.ToBytes32(leaf)
+	for _, sibling := range proof {
+		if generalizedIndex%2 == 1 {
+			node = hashutil.Hash(append(sibling, node[:]...))
+		} else {
+			node = hashutil.Hash(append(node[:], sibling...))
+		}
+		generalizedIndex /= 2
+	}
+	require.Equal(t, uint64(1), generalizedIndex, "Proof does not reach the root")
+	return node
+}
+
+func TestServer_GetFinalizedBlockRootBySlot(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	// Slot 2 is skipped.
+	roots := [][32]byte{genesisRoot}
+	for _, slot := range []types.Slot{1, 3} {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(roots[len(roots)-1][:])
+		require.NoError(t, db.SaveBlock(ctx, b))
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, r)
+	}
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(4))
+	for slot, root := range [][32]byte{roots[0], roots[1], roots[1], roots[2]} {
+		require.NoError(t, st.UpdateBlockRootAtIndex(uint64(slot), root))
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	finalized := testutil.NewBeaconBlock()
+	finalized.Block.Slot = 4
+	finalized.Block.ParentRoot = roots[2][:]
+	finalized.Block.StateRoot = stateRoot[:]
+	require.NoError(t, db.SaveBlock(ctx, finalized))
+	finalizedRoot, err := finalized.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, finalizedRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+
+	ds := &Server{
+		BeaconDB: db,
+		StateGen: stategen.New(db),
+	}
+	for slot, want := range [][32]byte{roots[0], roots[1], roots[1], roots[2]} {
+		res, err := ds.GetFinalizedBlockRootBySlot(ctx, &pbrpc.FinalizedBlockRootRequest{Slot: types.Slot(slot)})
+		require.NoError(t, err)
+		assert.DeepEqual(t, want[:], res.BlockRoot, "Wrong root at slot %d", slot)
+		assert.DeepEqual(t, stateRoot[:], res.StateRoot)
+		assert.Equal(t, types.Slot(4), res.StateSlot)
+		assert.Equal(t, stateRoot, proofRoot(t, res.BlockRoot, res.GeneralizedIndex, res.Proof))
+	}
+
+	_, err = ds.GetFinalizedBlockRootBySlot(ctx, &pbrpc.FinalizedBlockRootRequest{Slot: 4})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_historicalBlockRootProof(t *testing.T) {
+	ctx := context.Background()
+	slotsPerHistoricalRoot := params.BeaconConfig().SlotsPerHistoricalRoot
+
+	batch, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	blockRoots := make([][]byte, slotsPerHistoricalRoot)
+	stateRoots := make([][]byte, slotsPerHistoricalRoot)
+	for i := range blockRoots {
+		blockRoots[i] = bytesutil.PadTo(bytesutil.Bytes8(uint64(i)), 32)
+		stateRoots[i] = bytesutil.PadTo(bytesutil.Bytes8(uint64(i)+1<<32), 32)
+	}
+	require.NoError(t, batch.SetBlockRoots(blockRoots))
+	require.NoError(t, batch.SetStateRoots(stateRoots))
+	batchRoot, err := (&pb.HistoricalBatch{BlockRoots: blockRoots, StateRoots: stateRoots}).HashTreeRoot()
+	require.NoError(t, err)
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(4*slotsPerHistoricalRoot))
+	require.NoError(t, st.SetHistoricalRoots([][]byte{
+		bytesutil.PadTo([]byte{'a'}, 32),
+		batchRoot[:],
+		bytesutil.PadTo([]byte{'c'}, 32),
+	}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	slot := slotsPerHistoricalRoot + 5
+	leaf, generalizedIndex, proof, err := historicalBlockRootProof(ctx, st, batch, slot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blockRoots[5], leaf[:])
+	assert.Equal(t, stateRoot, proofRoot(t, leaf[:], generalizedIndex, proof))
+
+	_, _, _, err = historicalBlockRootProof(ctx, st, batch, 2*slotsPerHistoricalRoot)
+	assert.ErrorContains(t, "does not match the historical root", err)
+	_, _, _, err = historicalBlockRootProof(ctx, st, batch, 3*slotsPerHistoricalRoot)
+	assert.ErrorContains(t, "no historical root", err)
+}
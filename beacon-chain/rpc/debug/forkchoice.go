@@ -3,9 +3,16 @@ package debug
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetProtoArrayForkChoice returns proto array fork choice store.
@@ -42,3 +49,100 @@ func (ds *Server) GetProtoArrayForkChoice(_ context.Context, _ *empty.Empty) (*p
 		Indices:         indices,
 	}, nil
 }
+
+// PruneForkChoice prunes the fork choice nodes before the finalized block regardless of the prune
+// threshold, to release the memory held by fork choice during long periods of non-finality.
+func (ds *Server) PruneForkChoice(ctx context.Context, _ *empty.Empty) (*pbrpc.PruneForkChoiceResponse, error) {
+	finalizedRoot := bytesutil.ToBytes32(ds.FinalizationFetcher.FinalizedCheckpt().Root)
+	if finalizedRoot == params.BeaconConfig().ZeroHash {
+		return nil, status.Error(codes.FailedPrecondition, "No finalized block to prune before")
+	}
+	store := ds.HeadFetcher.ProtoArrayStore()
+	pruned, err := store.PruneBefore(ctx, finalizedRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not prune fork choice: %v", err)
+	}
+	log.WithField("prunedNodes", pruned).Info("Pruned fork choice on request")
+	return &pbrpc.PruneForkChoiceResponse{
+		PrunedNodes: uint64(pruned),
+		NodeCount:   uint64(len(store.Nodes())),
+	}, nil
+}
+
+// SimulateHead returns the head fork choice would select if the next proposals are missed, or if a
+// branch gains extra attestations, along with whether that head reorgs the current head. The
+// attestations of a missed slot weigh as much as a committee, and an extra attestation weighs as
+// much as the average active validator, both as of the head state.
+func (ds *Server) SimulateHead(ctx context.Context, req *pbrpc.SimulateHeadRequest) (*pbrpc.SimulateHeadResponse, error) {
+	if req.MissedSlots > params.BeaconConfig().SlotsPerEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Missed slots %d must be at most %d",
+			req.MissedSlots,
+			params.BeaconConfig().SlotsPerEpoch,
+		)
+	}
+	if req.BranchAttestations > 0 && len(req.BranchRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Branch root must be 32 bytes, received %d", len(req.BranchRoot))
+	}
+
+	headState, err := ds.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	totalBalance, err := helpers.TotalActiveBalance(headState)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get total active balance: %v", err)
+	}
+	activeCount, err := helpers.ActiveValidatorCount(headState, helpers.CurrentEpoch(headState))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	sim := &protoarray.HeadSimulation{
+		MissedSlots:     req.MissedSlots,
+		CommitteeWeight: totalBalance / uint64(params.BeaconConfig().SlotsPerEpoch),
+		BranchRoot:      bytesutil.ToBytes32(req.BranchRoot),
+	}
+	if activeCount > 0 {
+		sim.BranchWeight = req.BranchAttestations * (totalBalance / activeCount)
+	}
+
+	justifiedRoot, err := ds.justifiedRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get justified root: %v", err)
+	}
+	store := ds.HeadFetcher.ProtoArrayStore()
+	head, err := store.SimulateHead(ctx, justifiedRoot, sim)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Could not simulate head: %v", err)
+	}
+	headRoot, err := ds.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	return &pbrpc.SimulateHeadResponse{
+		HeadSlot:            ds.HeadFetcher.HeadSlot(),
+		HeadRoot:            headRoot,
+		SimulatedHeadSlot:   head.Slot,
+		SimulatedHeadRoot:   head.Root[:],
+		SimulatedHeadWeight: head.Weight,
+		Reorg:               !store.IsDescendant(head.Root, bytesutil.ToBytes32(headRoot)),
+	}, nil
+}
+
+// justifiedRoot returns the root fork choice starts from, which is the genesis block root until
+// the first epoch is justified.
+func (ds *Server) justifiedRoot(ctx context.Context) ([32]byte, error) {
+	root := bytesutil.ToBytes32(ds.FinalizationFetcher.CurrentJustifiedCheckpt().Root)
+	if root != params.BeaconConfig().ZeroHash {
+		return root, nil
+	}
+	genesisBlock, err := ds.BeaconDB.GenesisBlock(ctx)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if genesisBlock == nil || genesisBlock.Block == nil {
+		return [32]byte{}, errors.New("no genesis block")
+	}
+	return genesisBlock.Block.HashTreeRoot()
+}
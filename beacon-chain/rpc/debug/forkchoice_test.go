@@ -5,8 +5,14 @@ import (
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -20,3 +26,78 @@ func TestServer_GetForkChoice(t *testing.T) {
 	assert.Equal(t, store.JustifiedEpoch(), res.JustifiedEpoch, "Did not get wanted justified epoch")
 	assert.Equal(t, store.FinalizedEpoch(), res.FinalizedEpoch, "Did not get wanted finalized epoch")
 }
+
+func TestServer_SimulateHead(t *testing.T) {
+	ctx := context.Background()
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	balances := make([]uint64, 64)
+	for i := range balances {
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+
+	//            j
+	//           / \
+	//  +vote -> a  b <- boost
+	j, a, b := [32]byte{'j'}, [32]byte{'a'}, [32]byte{'b'}
+	f := protoarray.New(0, 0, j)
+	require.NoError(t, f.ProcessBlock(ctx, 0, j, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 1, a, j, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 2, b, j, [32]byte{}, 0, 0))
+	f.ProcessAttestation(ctx, []uint64{0}, a, 0)
+	f.BoostProposerRoot(ctx, b)
+	head, err := f.Head(ctx, 0, j, balances, 0)
+	require.NoError(t, err)
+	require.Equal(t, b, head)
+
+	ds := &Server{
+		HeadFetcher: &mock.ChainService{State: headState, Root: b[:], ForkChoiceStore: f.Store()},
+		FinalizationFetcher: &mock.ChainService{
+			CurrentJustifiedCheckPoint: &ethpb.Checkpoint{Root: j[:]},
+		},
+	}
+
+	// Once the proposal is missed the boost is gone, and the voted branch takes over.
+	res, err := ds.SimulateHead(ctx, &pbrpc.SimulateHeadRequest{MissedSlots: 1})
+	require.NoError(t, err)
+	assert.DeepEqual(t, b[:], res.HeadRoot)
+	assert.DeepEqual(t, a[:], res.SimulatedHeadRoot)
+	assert.Equal(t, types.Slot(1), res.SimulatedHeadSlot)
+	assert.Equal(t, 3*params.BeaconConfig().MaxEffectiveBalance, res.SimulatedHeadWeight)
+	assert.Equal(t, true, res.Reorg)
+
+	// An extra attestation for the boosted branch keeps the head.
+	res, err = ds.SimulateHead(ctx, &pbrpc.SimulateHeadRequest{MissedSlots: 1, BranchRoot: b[:], BranchAttestations: 2})
+	require.NoError(t, err)
+	assert.DeepEqual(t, b[:], res.SimulatedHeadRoot)
+	assert.Equal(t, false, res.Reorg)
+
+	_, err = ds.SimulateHead(ctx, &pbrpc.SimulateHeadRequest{MissedSlots: params.BeaconConfig().SlotsPerEpoch + 1})
+	assert.ErrorContains(t, "Missed slots 33 must be at most 32", err)
+	_, err = ds.SimulateHead(ctx, &pbrpc.SimulateHeadRequest{BranchRoot: []byte{'b'}, BranchAttestations: 1})
+	assert.ErrorContains(t, "Branch root must be 32 bytes", err)
+	_, err = ds.SimulateHead(ctx, &pbrpc.SimulateHeadRequest{BranchRoot: bytesutil.PadTo([]byte{'c'}, 32), BranchAttestations: 1})
+	assert.ErrorContains(t, "Could not simulate head", err)
+}
+
+func TestServer_PruneForkChoice(t *testing.T) {
+	ctx := context.Background()
+	j, a, b := [32]byte{'j'}, [32]byte{'a'}, [32]byte{'b'}
+	f := protoarray.New(0, 0, j)
+	require.NoError(t, f.ProcessBlock(ctx, 0, j, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 1, a, j, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 2, b, a, [32]byte{}, 0, 0))
+
+	ds := &Server{
+		HeadFetcher:         &mock.ChainService{ForkChoiceStore: f.Store()},
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: a[:]}},
+	}
+	res, err := ds.PruneForkChoice(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), res.PrunedNodes)
+	assert.Equal(t, uint64(2), res.NodeCount)
+	assert.Equal(t, false, f.HasNode(j))
+
+	ds.FinalizationFetcher = &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: make([]byte, 32)}}
+	_, err = ds.PruneForkChoice(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "No finalized block to prune before", err)
+}
@@ -0,0 +1,73 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecomputeHead forces fork choice to re-evaluate the head of the chain and returns the
+// resulting head.
+func (ds *Server) RecomputeHead(ctx context.Context, _ *empty.Empty) (*pbrpc.HeadResponse, error) {
+	log.Warn("Recomputing head on request")
+	if _, err := ds.HeadUpdater.RecomputeHead(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not recompute head: %v", err)
+	}
+	return ds.headResponse(ctx)
+}
+
+// SetHead sets the head of the chain to the requested canonical block root, to roll back a
+// corrupted head without resyncing the node.
+func (ds *Server) SetHead(ctx context.Context, req *pbrpc.SetHeadRequest) (*pbrpc.HeadResponse, error) {
+	if len(req.BlockRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Block root must be 32 bytes, received %d", len(req.BlockRoot))
+	}
+	log.WithField("root", fmt.Sprintf("%#x", req.BlockRoot)).Warn("Setting head on request")
+	if err := ds.HeadUpdater.SetHead(ctx, bytesutil.ToBytes32(req.BlockRoot)); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Could not set head: %v", err)
+	}
+	return ds.headResponse(ctx)
+}
+
+func (ds *Server) headResponse(ctx context.Context) (*pbrpc.HeadResponse, error) {
+	root, err := ds.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	return &pbrpc.HeadResponse{
+		Slot:      ds.HeadFetcher.HeadSlot(),
+		BlockRoot: root,
+	}, nil
+}
+
+// ListRecentReorgs returns the most recent reorgs of the chain, oldest first.
+func (ds *Server) ListRecentReorgs(_ context.Context, _ *empty.Empty) (*pbrpc.RecentReorgsResponse, error) {
+	if ds.ReorgFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Reorgs are not available")
+	}
+	reorgs := ds.ReorgFetcher.RecentReorgs()
+	resp := &pbrpc.RecentReorgsResponse{Reorgs: make([]*pbrpc.Reorg, len(reorgs))}
+	for i, r := range reorgs {
+		oldRoot, newRoot := r.OldRoot, r.NewRoot
+		reorg := &pbrpc.Reorg{
+			OldSlot:              r.OldSlot,
+			OldRoot:              oldRoot[:],
+			NewSlot:              r.NewSlot,
+			NewRoot:              newRoot[:],
+			Depth:                r.Depth,
+			TimeUnixMilliseconds: r.Time.UnixNano() / int64(time.Millisecond),
+		}
+		if r.CommonAncestor != [32]byte{} {
+			ancestor := r.CommonAncestor
+			reorg.CommonAncestor = ancestor[:]
+		}
+		resp.Reorgs[i] = reorg
+	}
+	return resp, nil
+}
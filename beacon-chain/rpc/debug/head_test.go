@@ -0,0 +1,62 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_SetHeadAndRecomputeHead(t *testing.T) {
+	ctx := context.Background()
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(5))
+	chain := &mock.ChainService{State: headState, Root: bytesutil.PadTo([]byte{'a'}, 32)}
+	ds := &Server{HeadFetcher: chain, HeadUpdater: chain}
+
+	res, err := ds.RecomputeHead(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5), res.Slot)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'a'}, 32), res.BlockRoot)
+
+	res, err = ds.SetHead(ctx, &pbrpc.SetHeadRequest{BlockRoot: bytesutil.PadTo([]byte{'b'}, 32)})
+	require.NoError(t, err)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'b'}, 32), res.BlockRoot)
+
+	_, err = ds.SetHead(ctx, &pbrpc.SetHeadRequest{BlockRoot: []byte{'b'}})
+	assert.ErrorContains(t, "Block root must be 32 bytes", err)
+}
+
+func TestServer_ListRecentReorgs(t *testing.T) {
+	now := time.Unix(1000, 5e6)
+	ds := &Server{ReorgFetcher: &mock.ChainService{Reorgs: []*statefeed.ReorgData{
+		{OldSlot: 3, OldRoot: [32]byte{'C'}, NewSlot: 4, NewRoot: [32]byte{'D'}, CommonAncestor: [32]byte{'G'}, Depth: 3, Time: now},
+		{OldSlot: 5, OldRoot: [32]byte{'E'}, NewSlot: 6, NewRoot: [32]byte{'F'}},
+	}}}
+
+	resp, err := ds.ListRecentReorgs(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Reorgs))
+	assert.DeepEqual(t, &pbrpc.Reorg{
+		OldSlot:              3,
+		OldRoot:              bytesutil.PadTo([]byte{'C'}, 32),
+		NewSlot:              4,
+		NewRoot:              bytesutil.PadTo([]byte{'D'}, 32),
+		CommonAncestor:       bytesutil.PadTo([]byte{'G'}, 32),
+		Depth:                3,
+		TimeUnixMilliseconds: 1000005,
+	}, resp.Reorgs[0])
+	// An unknown common ancestor is left empty.
+	assert.Equal(t, 0, len(resp.Reorgs[1].CommonAncestor))
+	assert.Equal(t, types.Slot(6), resp.Reorgs[1].NewSlot)
+}
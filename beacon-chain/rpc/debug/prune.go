@@ -0,0 +1,25 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PruneDatabase deletes the finalized states which are not at an archived point and the finalized
+// blocks which are not canonical from the database, and returns the number of bytes freed for reuse
+// in the database file.
+func (ds *Server) PruneDatabase(ctx context.Context, _ *empty.Empty) (*pbrpc.PruneDatabaseResponse, error) {
+	stats, err := ds.StateGen.PruneFinalized(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not prune database: %v", err)
+	}
+	return &pbrpc.PruneDatabaseResponse{
+		DeletedStates:  stats.DeletedStates,
+		DeletedBlocks:  stats.DeletedBlocks,
+		ReclaimedBytes: stats.ReclaimedBytes,
+	}, nil
+}
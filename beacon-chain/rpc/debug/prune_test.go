@@ -0,0 +1,23 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_PruneDatabase(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ds := &Server{StateGen: stategen.New(db)}
+
+	// Nothing is finalized, so there is nothing to prune.
+	res, err := ds.PruneDatabase(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pbrpc.PruneDatabaseResponse{}, res)
+}
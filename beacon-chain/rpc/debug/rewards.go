@@ -0,0 +1,107 @@
+package debug
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetValidatorEpochRewards returns the rewards and penalties of validators in the epoch transition
+// at the end of a finalized epoch. The report of the epoch is computed by replaying the transition
+// from the state of the last slot of the epoch, and saved to the database since the transitions of
+// finalized epochs do not change.
+func (ds *Server) GetValidatorEpochRewards(
+	ctx context.Context,
+	req *pbrpc.ValidatorEpochRewardsRequest,
+) (*pbrpc.ValidatorEpochRewardsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "debug.GetValidatorEpochRewards")
+	defer span.End()
+
+	cp, err := ds.BeaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get finalized checkpoint: %v", err)
+	}
+	// The transition at the end of an epoch is finalized with the first slot of the next epoch.
+	if req.Epoch >= cp.Epoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"The transition of epoch %d is not finalized, finalized epoch %d",
+			req.Epoch,
+			cp.Epoch,
+		)
+	}
+
+	report, err := ds.BeaconDB.EpochReport(ctx, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve epoch report: %v", err)
+	}
+	if report == nil {
+		if report, err = ds.computeEpochReport(ctx, req.Epoch); err != nil {
+			return nil, err
+		}
+		if err := ds.BeaconDB.SaveEpochReport(ctx, report); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not save epoch report: %v", err)
+		}
+	}
+
+	indices := req.Validators
+	if len(indices) == 0 {
+		indices = make([]types.ValidatorIndex, len(report.Validators))
+		for i := range indices {
+			indices[i] = types.ValidatorIndex(i)
+		}
+	}
+	rewards := make([]*pbrpc.ValidatorEpochRewards, len(indices))
+	for i, idx := range indices {
+		if uint64(idx) >= uint64(len(report.Validators)) {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"Validator index %d is out of range, %d validators at epoch %d",
+				idx,
+				len(report.Validators),
+				req.Epoch,
+			)
+		}
+		r := report.Validators[idx]
+		rewards[i] = &pbrpc.ValidatorEpochRewards{
+			ValidatorIndex:       idx,
+			SourceReward:         r.SourceReward,
+			TargetReward:         r.TargetReward,
+			HeadReward:           r.HeadReward,
+			InclusionDelayReward: r.InclusionDelayReward,
+			ProposerReward:       r.ProposerReward,
+			SourcePenalty:        r.SourcePenalty,
+			TargetPenalty:        r.TargetPenalty,
+			HeadPenalty:          r.HeadPenalty,
+			InactivityPenalty:    r.InactivityPenalty,
+		}
+	}
+	return &pbrpc.ValidatorEpochRewardsResponse{
+		Epoch:   req.Epoch,
+		Rewards: rewards,
+	}, nil
+}
+
+// computeEpochReport replays the epoch transition at the end of an epoch.
+func (ds *Server) computeEpochReport(ctx context.Context, epoch types.Epoch) (*precompute.EpochReport, error) {
+	nextSlot, err := helpers.StartSlot(epoch + 1)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get start slot of epoch %d: %v", epoch+1, err)
+	}
+	st, err := ds.StateGen.StateBySlot(ctx, nextSlot-1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state by slot: %v", err)
+	}
+	_, report, err := state.ProcessEpochPrecomputeWithReport(ctx, st.Copy())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not process epoch transition: %v", err)
+	}
+	return report, nil
+}
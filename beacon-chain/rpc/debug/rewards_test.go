@@ -0,0 +1,69 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_GetValidatorEpochRewards(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	b := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, b))
+	gRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	gen := stategen.New(db)
+	require.NoError(t, gen.SaveState(ctx, gRoot, st))
+	require.NoError(t, db.SaveState(ctx, st, gRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: gRoot[:]}))
+	ds := &Server{
+		BeaconDB: db,
+		StateGen: gen,
+	}
+
+	res, err := ds.GetValidatorEpochRewards(ctx, &pbrpc.ValidatorEpochRewardsRequest{
+		Epoch:      1,
+		Validators: []types.ValidatorIndex{7, 3},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), res.Epoch)
+	require.Equal(t, 2, len(res.Rewards))
+	assert.Equal(t, types.ValidatorIndex(7), res.Rewards[0].ValidatorIndex)
+	assert.Equal(t, types.ValidatorIndex(3), res.Rewards[1].ValidatorIndex)
+	// Nobody attested in epoch 0, every validator is penalized for each vote.
+	assert.NotEqual(t, uint64(0), res.Rewards[0].SourcePenalty)
+	assert.Equal(t, res.Rewards[0].SourcePenalty, res.Rewards[0].TargetPenalty)
+	assert.Equal(t, uint64(0), res.Rewards[0].SourceReward)
+
+	// The report is cached in the database.
+	report, err := db.EpochReport(ctx, 1)
+	require.NoError(t, err)
+	require.NotNil(t, report)
+	assert.Equal(t, 64, len(report.Validators))
+	assert.Equal(t, res.Rewards[1].HeadPenalty, report.Validators[3].HeadPenalty)
+
+	res, err = ds.GetValidatorEpochRewards(ctx, &pbrpc.ValidatorEpochRewardsRequest{Epoch: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 64, len(res.Rewards))
+
+	_, err = ds.GetValidatorEpochRewards(ctx, &pbrpc.ValidatorEpochRewardsRequest{
+		Epoch:      1,
+		Validators: []types.ValidatorIndex{64},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ds.GetValidatorEpochRewards(ctx, &pbrpc.ValidatorEpochRewardsRequest{Epoch: 2})
+	assert.ErrorContains(t, "The transition of epoch 2 is not finalized", err)
+}
@@ -11,9 +11,11 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	golog "github.com/ipfs/go-log/v2"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/timing"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -24,12 +26,19 @@ import (
 // providing RPC endpoints for runtime debugging of a node, this server is
 // gated behind the feature flag --enable-debug-rpc-endpoints.
 type Server struct {
-	BeaconDB           db.NoHeadAccessDatabase
-	GenesisTimeFetcher blockchain.TimeFetcher
-	StateGen           *stategen.State
-	HeadFetcher        blockchain.HeadFetcher
-	PeerManager        p2p.PeerManager
-	PeersFetcher       p2p.PeersProvider
+	BeaconDB            db.NoHeadAccessDatabase
+	GenesisTimeFetcher  blockchain.TimeFetcher
+	StateGen            *stategen.State
+	HeadFetcher         blockchain.HeadFetcher
+	HeadUpdater         blockchain.HeadUpdater
+	FinalizationFetcher blockchain.FinalizationFetcher
+	CanonicalFetcher    blockchain.CanonicalFetcher
+	ReorgFetcher        blockchain.ReorgFetcher
+	PeerManager         p2p.PeerManager
+	PeersFetcher        p2p.PeersProvider
+	ArrivalRecorder     *timing.Recorder
+	CacheRegistry       *cache.Registry
+	exporting           int32
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
@@ -3,6 +3,7 @@ package debug
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"google.golang.org/grpc/codes"
@@ -55,3 +56,37 @@ func (ds *Server) GetBeaconState(
 		return nil, status.Error(codes.InvalidArgument, "Need to specify either a block root or slot to request state")
 	}
 }
+
+// GetHeadState retrieves the ssz-encoded head state of the beacon node.
+func (ds *Server) GetHeadState(ctx context.Context, _ *empty.Empty) (*pbrpc.SSZResponse, error) {
+	st, err := ds.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if st == nil {
+		return nil, status.Error(codes.Unavailable, "Head state is not available")
+	}
+	encoded, err := st.MarshalSSZ()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not ssz encode beacon state: %v", err)
+	}
+	return &pbrpc.SSZResponse{
+		Encoded: encoded,
+	}, nil
+}
+
+// GetCachedStates lists the states held in memory by the state management service, to inspect
+// which states can be served without a replay.
+func (ds *Server) GetCachedStates(_ context.Context, _ *empty.Empty) (*pbrpc.CachedStatesResponse, error) {
+	cached := ds.StateGen.CachedStates()
+	resp := &pbrpc.CachedStatesResponse{States: make([]*pbrpc.CachedState, len(cached))}
+	for i, c := range cached {
+		root := c.Root
+		resp.States[i] = &pbrpc.CachedState{
+			Cache:     c.Cache,
+			BlockRoot: root[:],
+			Slot:      c.Slot,
+		}
+	}
+	return resp, nil
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	types "github.com/prysmaticlabs/eth2-types"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -66,3 +68,34 @@ func TestServer_GetBeaconState_RequestFutureSlot(t *testing.T) {
 	_, err := ds.GetBeaconState(context.Background(), req)
 	assert.ErrorContains(t, wanted, err)
 }
+
+func TestServer_GetHeadState(t *testing.T) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(7))
+	ds := &Server{HeadFetcher: &mock.ChainService{State: st}}
+
+	res, err := ds.GetHeadState(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	wanted, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, res.Encoded)
+}
+
+func TestServer_GetCachedStates(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(3))
+	gen := stategen.New(db)
+	gen.SaveFinalizedState(3, [32]byte{'F'}, st)
+	ds := &Server{StateGen: gen}
+
+	res, err := ds.GetCachedStates(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.States))
+	assert.Equal(t, "finalized", res.States[0].Cache)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{'F'}, 32), res.States[0].BlockRoot)
+	assert.Equal(t, types.Slot(3), res.States[0].Slot)
+}
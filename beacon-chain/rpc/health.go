@@ -0,0 +1,34 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// servingStatus is the status of the standard gRPC health service, which reports the node as not
+// serving while it syncs, as duties and chain data are not reliable until the node reaches the head.
+func (s *Service) servingStatus() healthpb.HealthCheckResponse_ServingStatus {
+	if s.cfg.SyncService.Syncing() {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+// updateHealthStatus refreshes the status of the standard gRPC health service every slot, until
+// the service stops.
+func (s *Service) updateHealthStatus(srv *health.Server) {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		srv.SetServingStatus("", s.servingStatus())
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			srv.Shutdown()
+			return
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestUpdateHealthStatus(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	syncChecker := &mockSync.Sync{IsSyncing: true}
+	s := &Service{
+		ctx: ctx,
+		cfg: &Config{SyncService: syncChecker},
+	}
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, s.servingStatus())
+	syncChecker.IsSyncing = false
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, s.servingStatus())
+
+	srv := health.NewServer()
+	done := make(chan struct{})
+	go func() {
+		s.updateHealthStatus(srv)
+		close(done)
+	}()
+	cancel()
+	<-done
+	// The health server reports not serving once the service has stopped.
+	res, err := srv.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, res.Status)
+}
@@ -15,11 +15,15 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -39,6 +43,11 @@ type Server struct {
 	PeerManager          p2p.PeerManager
 	GenesisTimeFetcher   blockchain.TimeFetcher
 	GenesisFetcher       blockchain.GenesisFetcher
+	ChainStartFetcher    powchain.ChainStartStatusFetcher
+	ChainHealthFetcher   blockchain.ChainHealthFetcher
+	HeadFetcher          blockchain.HeadFetcher
+	FinalizationFetcher  blockchain.FinalizationFetcher
+	DatabasePath         string
 	BeaconMonitoringHost string
 	BeaconMonitoringPort int
 }
@@ -77,6 +86,47 @@ func (ns *Server) GetGenesis(ctx context.Context, _ *ptypes.Empty) (*ethpb.Genes
 	}, nil
 }
 
+// GetGenesisCountdown returns the genesis time of the beacon chain along with the time left
+// until genesis. Before chain start, the genesis time is estimated from the deposits and eth1
+// blocks processed so far.
+func (ns *Server) GetGenesisCountdown(ctx context.Context, _ *empty.Empty) (*pb.GenesisCountdownResponse, error) {
+	resp := &pb.GenesisCountdownResponse{}
+	genesisTime := ns.GenesisTimeFetcher.GenesisTime()
+	if genesisTime.IsZero() {
+		if ns.ChainStartFetcher == nil {
+			return nil, status.Error(codes.Unavailable, "Chain start status is not available")
+		}
+		chainStart, err := ns.ChainStartFetcher.ChainStartStatus(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve chain start status: %v", err)
+		}
+		resp.ChainStarted = chainStart.Started
+		resp.GenesisTime = chainStart.GenesisTime
+		resp.GenesisValidators = chainStart.ActiveValidators
+		resp.ValidatorsNeeded = chainStart.ValidatorsNeeded
+	} else {
+		genesisState, err := ns.BeaconDB.GenesisState(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve genesis state: %v", err)
+		}
+		if genesisState == nil {
+			return nil, status.Error(codes.Internal, "Genesis state is nil")
+		}
+		activeCount, err := helpers.ActiveValidatorCount(genesisState, 0 /* genesis epoch */)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not count genesis validators: %v", err)
+		}
+		resp.ChainStarted = true
+		resp.GenesisTime = uint64(genesisTime.Unix())
+		resp.GenesisValidators = activeCount
+	}
+	now := uint64(timeutils.Now().Unix())
+	if resp.GenesisTime > now {
+		resp.SecondsUntilGenesis = resp.GenesisTime - now
+	}
+	return resp, nil
+}
+
 // GetVersion checks the version information of the beacon node.
 func (ns *Server) GetVersion(_ context.Context, _ *ptypes.Empty) (*ethpb.Version, error) {
 	return &ethpb.Version{
@@ -257,3 +307,61 @@ func (ns *Server) StreamBeaconLogs(_ *empty.Empty, stream pb.Health_StreamBeacon
 		}
 	}
 }
+
+// ChainHealth returns the finality diagnostics of the chain, such as the number of epochs since
+// finality and the participation of the previous epoch, for monitoring dashboards.
+func (ns *Server) ChainHealth(ctx context.Context, _ *empty.Empty) (*pb.ChainHealthResponse, error) {
+	health, err := ns.ChainHealthFetcher.ChainHealth(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not retrieve chain health: %v", err)
+	}
+	return &pb.ChainHealthResponse{
+		CurrentSlot:                health.CurrentSlot,
+		HeadSlot:                   health.HeadSlot,
+		JustifiedEpoch:             health.JustifiedEpoch,
+		FinalizedEpoch:             health.FinalizedEpoch,
+		EpochsSinceFinality:        health.EpochsSinceFinality,
+		JustifiedHeadDistance:      health.JustifiedHeadDistance,
+		PreviousEpochParticipation: health.PreviousEpochParticipation,
+		PeerCount:                  uint64(health.PeerCount),
+		Stalled:                    health.Stalled,
+	}, nil
+}
+
+// GetHealth returns the sync distance, peer counts, age of the finalized epoch and disk headroom of
+// the beacon node. Unlike ChainHealth, it does not process the head state, so that it can be
+// polled by readiness checks.
+func (ns *Server) GetHealth(ctx context.Context, _ *empty.Empty) (*pb.NodeHealthResponse, error) {
+	currentSlot := ns.GenesisTimeFetcher.CurrentSlot()
+	headSlot := ns.HeadFetcher.HeadSlot()
+	res := &pb.NodeHealthResponse{
+		Syncing:        ns.SyncChecker.Syncing(),
+		CurrentSlot:    currentSlot,
+		HeadSlot:       headSlot,
+		ConnectedPeers: uint64(len(ns.PeersFetcher.Peers().Connected())),
+		InboundPeers:   uint64(len(ns.PeersFetcher.Peers().InboundConnected())),
+		OutboundPeers:  uint64(len(ns.PeersFetcher.Peers().OutboundConnected())),
+	}
+	if currentSlot > headSlot {
+		res.SyncDistance = currentSlot - headSlot
+	}
+	if cp := ns.FinalizationFetcher.FinalizedCheckpt(); cp != nil {
+		res.FinalizedEpoch = cp.Epoch
+		startSlot, err := helpers.StartSlot(cp.Epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get start slot of finalized epoch: %v", err)
+		}
+		startTime, err := helpers.SlotToTime(uint64(ns.GenesisTimeFetcher.GenesisTime().Unix()), startSlot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get start time of finalized epoch: %v", err)
+		}
+		if age := timeutils.Now().Sub(startTime); age > 0 {
+			res.FinalizedEpochAgeSeconds = uint64(age / time.Second)
+		}
+	}
+	// Disk usage is left unknown on platforms which do not support it.
+	if free, total, err := fileutil.DiskUsage(ns.DatabasePath); err == nil {
+		res.DiskFreeBytes, res.DiskTotalBytes = free, total
+	}
+	return res, nil
+}
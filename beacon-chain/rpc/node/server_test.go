@@ -2,6 +2,7 @@ package node
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -9,19 +10,25 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/empty"
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/shared/version"
 )
 
@@ -71,6 +78,57 @@ func TestNodeServer_GetGenesis(t *testing.T) {
 	assert.Equal(t, true, res.GenesisTime.Equal(pUnix))
 }
 
+type mockChainStartStatusFetcher struct {
+	status *powchain.ChainStartStatus
+}
+
+func (m *mockChainStartStatusFetcher) ChainStartStatus(_ context.Context) (*powchain.ChainStartStatus, error) {
+	return m.status, nil
+}
+
+func TestNodeServer_GetGenesisCountdown_BeforeChainStart(t *testing.T) {
+	ns := &Server{
+		GenesisTimeFetcher: &mock.ChainService{},
+	}
+	_, err := ns.GetGenesisCountdown(context.Background(), &empty.Empty{})
+	assert.ErrorContains(t, "Chain start status is not available", err)
+
+	genesisTime := uint64(time.Now().Add(time.Hour).Unix())
+	ns.ChainStartFetcher = &mockChainStartStatusFetcher{
+		status: &powchain.ChainStartStatus{GenesisTime: genesisTime, ActiveValidators: 100, ValidatorsNeeded: 28},
+	}
+	res, err := ns.GetGenesisCountdown(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, false, res.ChainStarted)
+	assert.Equal(t, genesisTime, res.GenesisTime)
+	assert.Equal(t, uint64(100), res.GenesisValidators)
+	assert.Equal(t, uint64(28), res.ValidatorsNeeded)
+	assert.Equal(t, true, res.SecondsUntilGenesis > 3500 && res.SecondsUntilGenesis <= 3600, "Unexpected seconds until genesis %d", res.SecondsUntilGenesis)
+}
+
+func TestNodeServer_GetGenesisCountdown_AfterChainStart(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.ConfigName = "test"
+	params.OverrideBeaconConfig(cfg)
+
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, db.SaveGenesisData(ctx, st))
+
+	ns := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Genesis: time.Unix(10, 0)},
+	}
+	res, err := ns.GetGenesisCountdown(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.ChainStarted)
+	assert.Equal(t, uint64(10), res.GenesisTime)
+	assert.Equal(t, uint64(64), res.GenesisValidators)
+	assert.Equal(t, uint64(0), res.SecondsUntilGenesis)
+}
+
 func TestNodeServer_GetVersion(t *testing.T) {
 	v := version.Version()
 	ns := &Server{}
@@ -149,3 +207,80 @@ func TestNodeServer_ListPeers(t *testing.T) {
 	assert.Equal(t, int(ethpb.PeerDirection_INBOUND), int(res.Peers[0].Direction))
 	assert.Equal(t, ethpb.PeerDirection_OUTBOUND, res.Peers[1].Direction)
 }
+
+type mockChainHealthFetcher struct {
+	health *blockchain.ChainHealth
+	err    error
+}
+
+func (m *mockChainHealthFetcher) ChainHealth(_ context.Context) (*blockchain.ChainHealth, error) {
+	return m.health, m.err
+}
+
+func TestNodeServer_ChainHealth(t *testing.T) {
+	ns := &Server{
+		ChainHealthFetcher: &mockChainHealthFetcher{health: &blockchain.ChainHealth{
+			CurrentSlot:                330,
+			HeadSlot:                   329,
+			JustifiedEpoch:             7,
+			FinalizedEpoch:             3,
+			EpochsSinceFinality:        7,
+			JustifiedHeadDistance:      3,
+			PreviousEpochParticipation: 0.5,
+			PeerCount:                  12,
+			Stalled:                    true,
+		}},
+	}
+	res, err := ns.ChainHealth(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(330), res.CurrentSlot)
+	assert.Equal(t, types.Slot(329), res.HeadSlot)
+	assert.Equal(t, types.Epoch(7), res.JustifiedEpoch)
+	assert.Equal(t, types.Epoch(3), res.FinalizedEpoch)
+	assert.Equal(t, types.Epoch(7), res.EpochsSinceFinality)
+	assert.Equal(t, types.Epoch(3), res.JustifiedHeadDistance)
+	assert.Equal(t, 0.5, res.PreviousEpochParticipation)
+	assert.Equal(t, uint64(12), res.PeerCount)
+	assert.Equal(t, true, res.Stalled)
+
+	ns.ChainHealthFetcher = &mockChainHealthFetcher{err: errors.New("head state is not available")}
+	_, err = ns.ChainHealth(context.Background(), &empty.Empty{})
+	assert.ErrorContains(t, "Could not retrieve chain health: head state is not available", err)
+}
+
+func TestNodeServer_GetHealth(t *testing.T) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(60))
+	currentSlot := types.Slot(64)
+	genesis := timeutils.Now().Add(-time.Duration(uint64(currentSlot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	chain := &mock.ChainService{
+		State:               st,
+		Slot:                &currentSlot,
+		Genesis:             genesis,
+		FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+	}
+	ns := &Server{
+		SyncChecker:         &mockSync.Sync{IsSyncing: true},
+		GenesisTimeFetcher:  chain,
+		HeadFetcher:         chain,
+		FinalizationFetcher: chain,
+		PeersFetcher:        &mockP2p.MockPeersProvider{},
+		DatabasePath:        t.TempDir(),
+	}
+	res, err := ns.GetHealth(context.Background(), &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Syncing)
+	assert.Equal(t, currentSlot, res.CurrentSlot)
+	assert.Equal(t, types.Slot(60), res.HeadSlot)
+	assert.Equal(t, types.Slot(4), res.SyncDistance)
+	assert.Equal(t, uint64(2), res.ConnectedPeers)
+	assert.Equal(t, uint64(1), res.InboundPeers)
+	assert.Equal(t, uint64(1), res.OutboundPeers)
+	assert.Equal(t, types.Epoch(1), res.FinalizedEpoch)
+	// The finalized epoch starts at slot 32, 32 slots ago.
+	wanted := uint64(currentSlot-params.BeaconConfig().SlotsPerEpoch) * params.BeaconConfig().SecondsPerSlot
+	assert.Equal(t, true, res.FinalizedEpochAgeSeconds >= wanted-1 && res.FinalizedEpochAgeSeconds <= wanted+1)
+	assert.Equal(t, true, res.DiskTotalBytes > 0)
+	assert.Equal(t, true, res.DiskFreeBytes <= res.DiskTotalBytes)
+}
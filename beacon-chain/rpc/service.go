@@ -27,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/admin"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beaconv1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debug"
@@ -36,6 +37,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/timing"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -46,6 +48,8 @@ import (
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 )
@@ -68,41 +72,52 @@ type Service struct {
 
 // Config options for the beacon node RPC server.
 type Config struct {
-	Host                    string
-	Port                    string
-	CertFlag                string
-	KeyFlag                 string
-	BeaconMonitoringHost    string
-	BeaconMonitoringPort    int
-	BeaconDB                db.HeadAccessDatabase
-	ChainInfoFetcher        blockchain.ChainInfoFetcher
-	HeadFetcher             blockchain.HeadFetcher
-	CanonicalFetcher        blockchain.CanonicalFetcher
-	ForkFetcher             blockchain.ForkFetcher
-	FinalizationFetcher     blockchain.FinalizationFetcher
-	AttestationReceiver     blockchain.AttestationReceiver
-	BlockReceiver           blockchain.BlockReceiver
-	POWChainService         powchain.Chain
-	ChainStartFetcher       powchain.ChainStartFetcher
-	GenesisTimeFetcher      blockchain.TimeFetcher
-	GenesisFetcher          blockchain.GenesisFetcher
-	EnableDebugRPCEndpoints bool
-	MockEth1Votes           bool
-	AttestationsPool        attestations.Pool
-	ExitPool                voluntaryexits.PoolManager
-	SlashingsPool           slashings.PoolManager
-	SyncService             chainSync.Checker
-	Broadcaster             p2p.Broadcaster
-	PeersFetcher            p2p.PeersProvider
-	PeerManager             p2p.PeerManager
-	MetadataProvider        p2p.MetadataProvider
-	DepositFetcher          depositcache.DepositFetcher
-	PendingDepositFetcher   depositcache.PendingDepositsFetcher
-	StateNotifier           statefeed.Notifier
-	BlockNotifier           blockfeed.Notifier
-	OperationNotifier       opfeed.Notifier
-	StateGen                *stategen.State
-	MaxMsgSize              int
+	Host                      string
+	Port                      string
+	CertFlag                  string
+	KeyFlag                   string
+	BeaconMonitoringHost      string
+	BeaconMonitoringPort      int
+	BeaconDB                  db.HeadAccessDatabase
+	DatabasePath              string
+	ChainInfoFetcher          blockchain.ChainInfoFetcher
+	HeadFetcher               blockchain.HeadFetcher
+	CanonicalFetcher          blockchain.CanonicalFetcher
+	ForkFetcher               blockchain.ForkFetcher
+	FinalizationFetcher       blockchain.FinalizationFetcher
+	AttestationReceiver       blockchain.AttestationReceiver
+	BlockReceiver             blockchain.BlockReceiver
+	ProposalGuard             blockchain.ProposalGuard
+	WithdrawalCredsFetcher    blockchain.WithdrawalCredentialsFetcher
+	EpochBoundaryStateFetcher blockchain.EpochBoundaryStateFetcher
+	HeadUpdater               blockchain.HeadUpdater
+	ChainHealthFetcher        blockchain.ChainHealthFetcher
+	ReorgFetcher              blockchain.ReorgFetcher
+	POWChainService           powchain.Chain
+	ChainStartFetcher         powchain.ChainStartFetcher
+	ChainStartStatusFetcher   powchain.ChainStartStatusFetcher
+	GenesisTimeFetcher        blockchain.TimeFetcher
+	GenesisFetcher            blockchain.GenesisFetcher
+	EnableDebugRPCEndpoints   bool
+	EnableAdminRPCEndpoints   bool
+	MockEth1Votes             bool
+	AttestationsPool          attestations.Pool
+	ExitPool                  voluntaryexits.PoolManager
+	SlashingsPool             slashings.PoolManager
+	ArrivalRecorder           *timing.Recorder
+	CacheRegistry             *cache.Registry
+	SyncService               chainSync.Checker
+	Broadcaster               p2p.Broadcaster
+	PeersFetcher              p2p.PeersProvider
+	PeerManager               p2p.PeerManager
+	MetadataProvider          p2p.MetadataProvider
+	DepositFetcher            depositcache.DepositFetcher
+	PendingDepositFetcher     depositcache.PendingDepositsFetcher
+	StateNotifier             statefeed.Notifier
+	BlockNotifier             blockfeed.Notifier
+	OperationNotifier         opfeed.Notifier
+	StateGen                  *stategen.State
+	MaxMsgSize                int
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -146,6 +161,7 @@ func (s *Service) Start() {
 			grpc_prometheus.UnaryServerInterceptor,
 			grpc_opentracing.UnaryServerInterceptor(),
 			s.validatorUnaryConnectionInterceptor,
+			s.requestDeadlineUnaryInterceptor,
 		)),
 		grpc.MaxRecvMsgSize(s.cfg.MaxMsgSize),
 	}
@@ -164,31 +180,40 @@ func (s *Service) Start() {
 	s.grpcServer = grpc.NewServer(opts...)
 
 	validatorServer := &validator.Server{
-		Ctx:                    s.ctx,
-		BeaconDB:               s.cfg.BeaconDB,
-		AttestationCache:       cache.NewAttestationCache(),
-		AttPool:                s.cfg.AttestationsPool,
-		ExitPool:               s.cfg.ExitPool,
-		HeadFetcher:            s.cfg.HeadFetcher,
-		ForkFetcher:            s.cfg.ForkFetcher,
-		FinalizationFetcher:    s.cfg.FinalizationFetcher,
-		TimeFetcher:            s.cfg.GenesisTimeFetcher,
-		CanonicalStateChan:     s.canonicalStateChan,
-		BlockFetcher:           s.cfg.POWChainService,
-		DepositFetcher:         s.cfg.DepositFetcher,
-		ChainStartFetcher:      s.cfg.ChainStartFetcher,
-		Eth1InfoFetcher:        s.cfg.POWChainService,
-		SyncChecker:            s.cfg.SyncService,
-		StateNotifier:          s.cfg.StateNotifier,
-		BlockNotifier:          s.cfg.BlockNotifier,
-		OperationNotifier:      s.cfg.OperationNotifier,
-		P2P:                    s.cfg.Broadcaster,
-		BlockReceiver:          s.cfg.BlockReceiver,
-		MockEth1Votes:          s.cfg.MockEth1Votes,
-		Eth1BlockFetcher:       s.cfg.POWChainService,
-		PendingDepositsFetcher: s.cfg.PendingDepositFetcher,
-		SlashingsPool:          s.cfg.SlashingsPool,
-		StateGen:               s.cfg.StateGen,
+		Ctx:                       s.ctx,
+		BeaconDB:                  s.cfg.BeaconDB,
+		AttestationCache:          cache.NewAttestationCache(),
+		AttPool:                   s.cfg.AttestationsPool,
+		ExitPool:                  s.cfg.ExitPool,
+		HeadFetcher:               s.cfg.HeadFetcher,
+		ForkFetcher:               s.cfg.ForkFetcher,
+		FinalizationFetcher:       s.cfg.FinalizationFetcher,
+		TimeFetcher:               s.cfg.GenesisTimeFetcher,
+		CanonicalStateChan:        s.canonicalStateChan,
+		BlockFetcher:              s.cfg.POWChainService,
+		DepositFetcher:            s.cfg.DepositFetcher,
+		ChainStartFetcher:         s.cfg.ChainStartFetcher,
+		Eth1InfoFetcher:           s.cfg.POWChainService,
+		SyncChecker:               s.cfg.SyncService,
+		StateNotifier:             s.cfg.StateNotifier,
+		BlockNotifier:             s.cfg.BlockNotifier,
+		OperationNotifier:         s.cfg.OperationNotifier,
+		P2P:                       s.cfg.Broadcaster,
+		BlockReceiver:             s.cfg.BlockReceiver,
+		ProposalGuard:             s.cfg.ProposalGuard,
+		WithdrawalCredsFetcher:    s.cfg.WithdrawalCredsFetcher,
+		EpochBoundaryStateFetcher: s.cfg.EpochBoundaryStateFetcher,
+		MockEth1Votes:             s.cfg.MockEth1Votes,
+		Eth1BlockFetcher:          s.cfg.POWChainService,
+		PendingDepositsFetcher:    s.cfg.PendingDepositFetcher,
+		SlashingsPool:             s.cfg.SlashingsPool,
+		StateGen:                  s.cfg.StateGen,
+		StateFetcher: statefetcher.StateFetcher{
+			BeaconDB:           s.cfg.BeaconDB,
+			ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
+			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
+			StateGenService:    s.cfg.StateGen,
+		},
 	}
 	nodeServer := &node.Server{
 		LogsStreamer:         logutil.NewStreamServer(),
@@ -200,6 +225,11 @@ func (s *Service) Start() {
 		PeersFetcher:         s.cfg.PeersFetcher,
 		PeerManager:          s.cfg.PeerManager,
 		GenesisFetcher:       s.cfg.GenesisFetcher,
+		ChainStartFetcher:    s.cfg.ChainStartStatusFetcher,
+		ChainHealthFetcher:   s.cfg.ChainHealthFetcher,
+		HeadFetcher:          s.cfg.HeadFetcher,
+		FinalizationFetcher:  s.cfg.FinalizationFetcher,
+		DatabasePath:         s.cfg.DatabasePath,
 		BeaconMonitoringHost: s.cfg.BeaconMonitoringHost,
 		BeaconMonitoringPort: s.cfg.BeaconMonitoringPort,
 	}
@@ -264,21 +294,47 @@ func (s *Service) Start() {
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbv1.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
 	pbrpc.RegisterHealthServer(s.grpcServer, nodeServer)
+	pbrpc.RegisterGenesisServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
+	pbrpc.RegisterArchiveServer(s.grpcServer, beaconChainServer)
+	pbrpc.RegisterHistoryServer(s.grpcServer, beaconChainServer)
 	ethpbv1.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
 	if s.cfg.EnableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debug.Server{
-			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
-			BeaconDB:           s.cfg.BeaconDB,
-			StateGen:           s.cfg.StateGen,
-			HeadFetcher:        s.cfg.HeadFetcher,
-			PeerManager:        s.cfg.PeerManager,
-			PeersFetcher:       s.cfg.PeersFetcher,
+			GenesisTimeFetcher:  s.cfg.GenesisTimeFetcher,
+			BeaconDB:            s.cfg.BeaconDB,
+			StateGen:            s.cfg.StateGen,
+			HeadFetcher:         s.cfg.HeadFetcher,
+			HeadUpdater:         s.cfg.HeadUpdater,
+			FinalizationFetcher: s.cfg.FinalizationFetcher,
+			CanonicalFetcher:    s.cfg.CanonicalFetcher,
+			ReorgFetcher:        s.cfg.ReorgFetcher,
+			PeerManager:         s.cfg.PeerManager,
+			PeersFetcher:        s.cfg.PeersFetcher,
+			ArrivalRecorder:     s.cfg.ArrivalRecorder,
+			CacheRegistry:       s.cfg.CacheRegistry,
 		}
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
 	}
+	if s.cfg.EnableAdminRPCEndpoints {
+		log.Warn("Enabled admin gRPC endpoints")
+		pbrpc.RegisterAdminServer(s.grpcServer, &admin.Server{
+			CacheRegistry: s.cfg.CacheRegistry,
+		})
+	}
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterExitsServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterDutiesServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterDepositsServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterRegistryServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterBlockSubmissionServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterDoppelGangerServer(s.grpcServer, validatorServer)
+
+	// Register the standard gRPC health service, for readiness checks of orchestrators.
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s.grpcServer, healthServer)
+	go s.updateHealthStatus(healthServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
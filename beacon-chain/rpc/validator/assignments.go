@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
@@ -11,6 +12,8 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/rand"
@@ -108,45 +111,81 @@ func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream ethpb.BeaconNode
 	}
 }
 
+// GetEpochDuties returns the attester and proposer duties of the requested validators for
+// an epoch, along with the root of the block those duties depend on.
+func (vs *Server) GetEpochDuties(ctx context.Context, req *pbrpc.EpochDutiesRequest) (*pbrpc.EpochDutiesResponse, error) {
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	res, dependentRoot, err := vs.computeDuties(ctx, &ethpb.DutiesRequest{
+		Epoch:      req.Epoch,
+		PublicKeys: req.PublicKeys,
+	}, true /* withDependentRoot */)
+	if err != nil {
+		return nil, err
+	}
+	return &pbrpc.EpochDutiesResponse{
+		DependentRoot:      dependentRoot[:],
+		CurrentEpochDuties: res.CurrentEpochDuties,
+		NextEpochDuties:    res.NextEpochDuties,
+	}, nil
+}
+
 // Compute the validator duties from the head state's corresponding epoch
 // for validators public key / indices requested.
 func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	res, _, err := vs.computeDuties(ctx, req, false /* withDependentRoot */)
+	return res, err
+}
+
+// computeDuties computes the validator duties and, if requested, the root of the block the
+// duties depend on.
+func (vs *Server) computeDuties(ctx context.Context, req *ethpb.DutiesRequest, withDependentRoot bool) (*ethpb.DutiesResponse, [32]byte, error) {
 	currentEpoch := helpers.SlotToEpoch(vs.TimeFetcher.CurrentSlot())
 	if req.Epoch > currentEpoch+1 {
-		return nil, status.Errorf(codes.Unavailable, "Request epoch %d can not be greater than next epoch %d", req.Epoch, currentEpoch+1)
+		return nil, [32]byte{}, status.Errorf(codes.Unavailable, "Request epoch %d can not be greater than next epoch %d", req.Epoch, currentEpoch+1)
 	}
 
 	s, err := vs.HeadFetcher.HeadState(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+		return nil, [32]byte{}, status.Errorf(codes.Internal, "Could not get head state: %v", err)
 	}
 
 	// Advance state with empty transitions up to the requested epoch start slot.
 	epochStartSlot, err := helpers.StartSlot(req.Epoch)
 	if err != nil {
-		return nil, err
+		return nil, [32]byte{}, err
 	}
 	if s.Slot() < epochStartSlot {
-		s, err = state.ProcessSlots(ctx, s, epochStartSlot)
+		s, err = vs.advanceHeadState(ctx, s, req.Epoch, epochStartSlot)
+		if err != nil {
+			return nil, [32]byte{}, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
+		}
+	}
+	// The dependent root is looked up first, as computing assignments moves the state
+	// through the slots of the epoch.
+	var dependentRoot [32]byte
+	if withDependentRoot {
+		dependentRoot, err = vs.dependentBlockRoot(ctx, s, req.Epoch)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", epochStartSlot, err)
+			return nil, [32]byte{}, status.Errorf(codes.Internal, "Could not get dependent root: %v", err)
 		}
 	}
 	committeeAssignments, proposerIndexToSlots, err := helpers.CommitteeAssignments(s, req.Epoch)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
+		return nil, [32]byte{}, status.Errorf(codes.Internal, "Could not compute committee assignments: %v", err)
 	}
 	// Query the next epoch assignments for committee subnet subscriptions.
 	nextCommitteeAssignments, _, err := helpers.CommitteeAssignments(s, req.Epoch+1)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not compute next committee assignments: %v", err)
+		return nil, [32]byte{}, status.Errorf(codes.Internal, "Could not compute next committee assignments: %v", err)
 	}
 
 	validatorAssignments := make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys))
 	nextValidatorAssignments := make([]*ethpb.DutiesResponse_Duty, 0, len(req.PublicKeys))
 	for _, pubKey := range req.PublicKeys {
 		if ctx.Err() != nil {
-			return nil, status.Errorf(codes.Aborted, "Could not continue fetching assignments: %v", ctx.Err())
+			return nil, [32]byte{}, status.Errorf(codes.Aborted, "Could not continue fetching assignments: %v", ctx.Err())
 		}
 		assignment := &ethpb.DutiesResponse_Duty{
 			PublicKey: pubKey,
@@ -195,7 +234,51 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 		Duties:             validatorAssignments,
 		CurrentEpochDuties: validatorAssignments,
 		NextEpochDuties:    nextValidatorAssignments,
-	}, nil
+	}, dependentRoot, nil
+}
+
+// dependentBlockRoot returns the root of the last block before the given epoch, looked up in
+// a state at or after the start of the epoch. Duties of the epoch and of the next one are all
+// determined by the chain up to that block.
+func (vs *Server) dependentBlockRoot(ctx context.Context, s iface.ReadOnlyBeaconState, epoch types.Epoch) ([32]byte, error) {
+	epochStartSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	// Duties of the genesis epoch depend on the genesis block.
+	var dependentSlot types.Slot
+	if epochStartSlot > 0 {
+		dependentSlot = epochStartSlot - 1
+	}
+	if dependentSlot < s.Slot() {
+		root, err := helpers.BlockRootAtSlot(s, dependentSlot)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		return bytesutil.ToBytes32(root), nil
+	}
+	genesisBlock, err := vs.BeaconDB.GenesisBlock(ctx)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if genesisBlock == nil || genesisBlock.Block == nil {
+		return [32]byte{}, errors.New("no genesis block")
+	}
+	return genesisBlock.Block.HashTreeRoot()
+}
+
+// advanceHeadState advances the head state through empty slots to the start slot of the epoch. The
+// boundary states of the head block are cached by the chain service, so that the duties of the
+// epoch are not computed from a fresh state transition on every request.
+func (vs *Server) advanceHeadState(ctx context.Context, headState iface.BeaconState, epoch types.Epoch, epochStartSlot types.Slot) (iface.BeaconState, error) {
+	if vs.EpochBoundaryStateFetcher == nil {
+		return state.ProcessSlots(ctx, headState, epochStartSlot)
+	}
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return vs.EpochBoundaryStateFetcher.EpochBoundaryState(ctx, bytesutil.ToBytes32(headRoot), epoch)
 }
 
 // assignValidatorToSubnet checks the status and pubkey of a particular validator
@@ -13,9 +13,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -159,6 +161,46 @@ func TestGetDuties_CurrentEpoch_ShouldNotFail(t *testing.T) {
 	assert.Equal(t, 1, len(res.CurrentEpochDuties), "Expected 1 assignment")
 }
 
+func TestGetDuties_NextEpoch_EpochBoundaryState(t *testing.T) {
+	db := dbutil.SetupDB(t)
+
+	genesis := testutil.NewBeaconBlock()
+	depChainStart := params.BeaconConfig().MinGenesisActiveValidatorCount
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(depChainStart)
+	require.NoError(t, err)
+	eth1Data, err := testutil.DeterministicEth1Data(len(deposits))
+	require.NoError(t, err)
+	bState, err := state.GenesisBeaconState(deposits, 0, eth1Data)
+	require.NoError(t, err, "Could not setup genesis state")
+	require.NoError(t, bState.SetSlot(5))
+
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err, "Could not get signing root")
+
+	chain := &mockChain.ChainService{
+		State: bState, Root: genesisRoot[:], Genesis: time.Now(),
+	}
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+	req := &ethpb.DutiesRequest{
+		Epoch:      1,
+		PublicKeys: [][]byte{deposits[0].Data.PublicKey, deposits[1].Data.PublicKey},
+	}
+	want, err := vs.GetDuties(context.Background(), req)
+	require.NoError(t, err)
+
+	// The duties computed from the boundary state of the head block match those computed by
+	// advancing the head state.
+	vs.EpochBoundaryStateFetcher = chain
+	res, err := vs.GetDuties(context.Background(), req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, res)
+}
+
 func TestGetDuties_MultipleKeys_OK(t *testing.T) {
 	db := dbutil.SetupDB(t)
 
@@ -213,6 +255,72 @@ func TestGetDuties_SyncNotReady(t *testing.T) {
 	assert.ErrorContains(t, "Syncing to latest head", err)
 }
 
+func TestGetEpochDuties_OK(t *testing.T) {
+	ctx := context.Background()
+	db := dbutil.SetupDB(t)
+
+	bs, _ := testutil.DeterministicGenesisState(t, 64)
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	pubKeys := make([][]byte, 8)
+	for i := range pubKeys {
+		pubKey := bs.PubkeyAtIndex(types.ValidatorIndex(i))
+		pubKeys[i] = pubKey[:]
+	}
+	// Computing duties moves the head state through the slots of the epoch, so each
+	// request is given a copy of the genesis state.
+	chain := &mockChain.ChainService{
+		Root: genesisRoot[:], Genesis: time.Now(),
+	}
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	t.Run("genesis epoch", func(t *testing.T) {
+		chain.State = bs.Copy()
+		res, err := vs.GetEpochDuties(ctx, &pbrpc.EpochDutiesRequest{PublicKeys: pubKeys})
+		require.NoError(t, err)
+		assert.DeepEqual(t, genesisRoot[:], res.DependentRoot)
+
+		chain.State = bs.Copy()
+		wanted, err := vs.GetDuties(ctx, &ethpb.DutiesRequest{PublicKeys: pubKeys})
+		require.NoError(t, err)
+		assert.DeepEqual(t, wanted.CurrentEpochDuties, res.CurrentEpochDuties)
+		assert.DeepEqual(t, wanted.NextEpochDuties, res.NextEpochDuties)
+	})
+	t.Run("next epoch", func(t *testing.T) {
+		chain.State = bs.Copy()
+		res, err := vs.GetEpochDuties(ctx, &pbrpc.EpochDutiesRequest{Epoch: 1, PublicKeys: pubKeys})
+		require.NoError(t, err)
+
+		advanced, err := state.ProcessSlots(ctx, bs.Copy(), params.BeaconConfig().SlotsPerEpoch)
+		require.NoError(t, err)
+		wantedRoot, err := helpers.BlockRootAtSlot(advanced, params.BeaconConfig().SlotsPerEpoch-1)
+		require.NoError(t, err)
+		assert.DeepEqual(t, wantedRoot, res.DependentRoot)
+		assert.Equal(t, len(pubKeys), len(res.CurrentEpochDuties))
+		for i, duty := range res.CurrentEpochDuties {
+			assert.Equal(t, types.ValidatorIndex(i), duty.ValidatorIndex)
+			assert.Equal(t, types.Epoch(1), helpers.SlotToEpoch(duty.AttesterSlot))
+		}
+	})
+}
+
+func TestGetEpochDuties_SyncNotReady(t *testing.T) {
+	vs := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: true},
+	}
+	_, err := vs.GetEpochDuties(context.Background(), &pbrpc.EpochDutiesRequest{})
+	assert.ErrorContains(t, "Syncing to latest head", err)
+}
+
 func TestStreamDuties_SyncNotReady(t *testing.T) {
 	vs := &Server{
 		SyncChecker: &mockSync.Sync{IsSyncing: true},
@@ -0,0 +1,50 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPendingDeposits returns the eth1 data and deposit index of the head state, the number of
+// deposits known to the beacon node, and the deposits a block built on top of the head would
+// include, so stakers can see where their deposit sits in the pipeline.
+func (vs *Server) GetPendingDeposits(ctx context.Context, _ *empty.Empty) (*pbrpc.PendingDepositsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "ValidatorServer.GetPendingDeposits")
+	defer span.End()
+
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	head, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	resp := &pbrpc.PendingDepositsResponse{
+		HeadEth1Data:      head.Eth1Data(),
+		Eth1DepositIndex:  head.Eth1DepositIndex(),
+		KnownDepositCount: uint64(len(vs.DepositFetcher.AllDeposits(ctx, nil))),
+	}
+
+	// The deposits of the next block depend on its eth1 data vote, which is determined the
+	// same way as when proposing a block at the slot following the head.
+	head, err = state.ProcessSlots(ctx, head, head.Slot()+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not advance head state to next slot: %v", err)
+	}
+	eth1Data, err := vs.eth1DataMajorityVote(ctx, head)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get ETH1 data: %v", err)
+	}
+	resp.NextBlockDeposits, err = vs.deposits(ctx, head, eth1Data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get ETH1 deposits: %v", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,90 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_GetPendingDeposits(t *testing.T) {
+	ctx := context.Background()
+	eth1Height := 10
+	eth1Hash := bytesutil.PadTo([]byte("0x0"), 32)
+	// The only eth1 block is too early for the voting period, so the eth1 data of the head is voted for.
+	p := mockPOW.NewPOWChain().InsertBlock(eth1Height, 1, eth1Hash)
+
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	depositTrie, err := trieutil.NewTrie(params.BeaconConfig().DepositContractTreeDepth)
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		deposit := &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				Signature:             make([]byte, 96),
+				WithdrawalCredentials: make([]byte, 32),
+			},
+		}
+		depositHash, err := deposit.Data.HashTreeRoot()
+		require.NoError(t, err)
+		depositTrie.Insert(depositHash[:], i)
+		// The last deposit was made after the eth1 block of the head.
+		height := uint64(eth1Height)
+		if i == 4 {
+			height++
+		}
+		depositCache.InsertDeposit(ctx, deposit, height, int64(i), depositTrie.Root())
+		if i >= 2 {
+			depositCache.InsertPendingDeposit(ctx, deposit, height, int64(i), depositTrie.Root())
+		}
+	}
+
+	eth1Data := &ethpb.Eth1Data{
+		BlockHash:    eth1Hash,
+		DepositRoot:  make([]byte, 32),
+		DepositCount: 4,
+	}
+	beaconState, _ := testutil.DeterministicGenesisState(t, 16)
+	require.NoError(t, beaconState.SetEth1Data(eth1Data))
+	require.NoError(t, beaconState.SetEth1DepositIndex(2))
+	chain := &mock.ChainService{State: beaconState, ETH1Data: eth1Data}
+
+	vs := &Server{
+		SyncChecker:            &mockSync.Sync{IsSyncing: false},
+		HeadFetcher:            chain,
+		ChainStartFetcher:      p,
+		Eth1InfoFetcher:        p,
+		Eth1BlockFetcher:       p,
+		DepositFetcher:         depositCache,
+		PendingDepositsFetcher: depositCache,
+	}
+	resp, err := vs.GetPendingDeposits(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, eth1Data, resp.HeadEth1Data)
+	assert.Equal(t, uint64(2), resp.Eth1DepositIndex)
+	assert.Equal(t, uint64(5), resp.KnownDepositCount)
+	require.Equal(t, 2, len(resp.NextBlockDeposits))
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{2}, 48), resp.NextBlockDeposits[0].Data.PublicKey)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{3}, 48), resp.NextBlockDeposits[1].Data.PublicKey)
+}
+
+func TestServer_GetPendingDeposits_Syncing(t *testing.T) {
+	vs := &Server{SyncChecker: &mockSync.Sync{IsSyncing: true}}
+	_, err := vs.GetPendingDeposits(context.Background(), &empty.Empty{})
+	assert.ErrorContains(t, "Syncing to latest head", err)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
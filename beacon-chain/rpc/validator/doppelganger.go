@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxDoppelGangerLookback is the maximum number of epochs before the current epoch that a
+// CheckDoppelGanger request can look for activity from.
+const maxDoppelGangerLookback = types.Epoch(8)
+
+// CheckDoppelGanger reports whether the requested validators proposed a block or attested since
+// the start of the requested epoch. Proposals are looked up in the blocks of the database, whether
+// canonical or not, and attestations in those blocks and in the attestation pool, which holds the
+// attestations received over gossip that are not included in a block yet.
+func (vs *Server) CheckDoppelGanger(ctx context.Context, req *pbrpc.DoppelGangerRequest) (*pbrpc.DoppelGangerResponse, error) {
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+	if len(req.ValidatorIndices) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No validator indices to check")
+	}
+	currentSlot := vs.TimeFetcher.CurrentSlot()
+	currentEpoch := helpers.SlotToEpoch(currentSlot)
+	if req.Epoch > currentEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested epoch %d is after the current epoch %d", req.Epoch, currentEpoch)
+	}
+	if req.Epoch+maxDoppelGangerLookback < currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested epoch %d is more than %d epochs before the current epoch %d",
+			req.Epoch,
+			maxDoppelGangerLookback,
+			currentEpoch,
+		)
+	}
+
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	lastActive := make(map[types.ValidatorIndex]types.Slot, len(req.ValidatorIndices))
+	for _, idx := range req.ValidatorIndices {
+		if uint64(idx) >= uint64(headState.NumValidators()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Validator index %d is not in the registry", idx)
+		}
+		lastActive[idx] = 0
+	}
+	live := make(map[types.ValidatorIndex]bool, len(req.ValidatorIndices))
+	markActive := func(idx types.ValidatorIndex, slot types.Slot) {
+		last, ok := lastActive[idx]
+		if !ok {
+			return
+		}
+		live[idx] = true
+		if slot > last {
+			lastActive[idx] = slot
+		}
+	}
+
+	startSlot, err := helpers.StartSlot(req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute start slot: %v", err)
+	}
+	blks, _, err := vs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(currentSlot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get blocks: %v", err)
+	}
+	atts := make([]*ethpb.Attestation, 0)
+	for _, blk := range blks {
+		if blk == nil || blk.Block == nil || blk.Block.Body == nil {
+			continue
+		}
+		markActive(blk.Block.ProposerIndex, blk.Block.Slot)
+		atts = append(atts, blk.Block.Body.Attestations...)
+	}
+	unaggregated, err := vs.AttPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+	}
+	atts = append(atts, unaggregated...)
+	atts = append(atts, vs.AttPool.AggregatedAttestations()...)
+	atts = append(atts, vs.AttPool.ForkchoiceAttestations()...)
+	for _, att := range atts {
+		if att == nil || att.Data == nil || att.Data.Target == nil || att.Data.Target.Epoch < req.Epoch {
+			continue
+		}
+		// Attestations of future epochs can not be verified against the head state.
+		if att.Data.Target.Epoch > currentEpoch {
+			continue
+		}
+		indices, err := attestingIndices(headState, att)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get attesting indices: %v", err)
+		}
+		for _, idx := range indices {
+			markActive(types.ValidatorIndex(idx), att.Data.Slot)
+		}
+	}
+
+	validators := make([]*pbrpc.ValidatorLiveness, len(req.ValidatorIndices))
+	for i, idx := range req.ValidatorIndices {
+		validators[i] = &pbrpc.ValidatorLiveness{
+			ValidatorIndex: idx,
+			IsLive:         live[idx],
+			LastActiveSlot: lastActive[idx],
+		}
+	}
+	return &pbrpc.DoppelGangerResponse{
+		CurrentEpoch: currentEpoch,
+		Validators:   validators,
+	}, nil
+}
+
+// attestingIndices returns the indices of the validators of an attestation, using the committee
+// computed from the head state.
+func attestingIndices(st iface.ReadOnlyBeaconState, att *ethpb.Attestation) ([]uint64, error) {
+	committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	return attestationutil.AttestingIndices(att.AggregationBits, committee)
+}
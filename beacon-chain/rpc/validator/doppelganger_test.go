@@ -0,0 +1,130 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_CheckDoppelGanger(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.UseMinimalConfig()
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	currentSlot := params.BeaconConfig().SlotsPerEpoch + 4
+	chain := &mock.ChainService{State: st, Slot: &currentSlot}
+	pool := attestations.NewPool()
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		AttPool:     pool,
+	}
+
+	attestation := func(slot types.Slot, committeeIndex types.CommitteeIndex, bit uint64) *ethpb.Attestation {
+		att := testutil.HydrateAttestation(&ethpb.Attestation{
+			Data: &ethpb.AttestationData{
+				Slot:           slot,
+				CommitteeIndex: committeeIndex,
+				Target:         &ethpb.Checkpoint{Epoch: helpers.SlotToEpoch(slot)},
+			},
+			AggregationBits: bitfield.NewBitlist(4),
+		})
+		att.AggregationBits.SetBitAt(bit, true)
+		return att
+	}
+	committee := func(slot types.Slot, committeeIndex types.CommitteeIndex) []types.ValidatorIndex {
+		c, err := helpers.BeaconCommitteeFromState(st, slot, committeeIndex)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(c))
+		return c
+	}
+
+	epochStart := params.BeaconConfig().SlotsPerEpoch
+	// A proposal and an attestation before the requested epoch are not counted.
+	early := testutil.NewBeaconBlock()
+	early.Block.Slot = epochStart - 1
+	early.Block.ProposerIndex = 1
+	early.Block.Body.Attestations = []*ethpb.Attestation{attestation(epochStart-2, 0, 0)}
+	proposed := testutil.NewBeaconBlock()
+	proposed.Block.Slot = epochStart + 1
+	proposed.Block.ProposerIndex = 2
+	proposed.Block.Body.Attestations = []*ethpb.Attestation{attestation(epochStart, 0, 1)}
+	require.NoError(t, db.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{early, proposed}))
+	require.NoError(t, pool.SaveUnaggregatedAttestation(attestation(epochStart+3, 1, 2)))
+
+	includedAttester := committee(epochStart, 0)[1]
+	gossipAttester := committee(epochStart+3, 1)[2]
+	earlyAttester := committee(epochStart-2, 0)[0]
+	idle := types.ValidatorIndex(0)
+	for idle == includedAttester || idle == gossipAttester || idle == earlyAttester || idle <= 2 {
+		idle++
+	}
+	indices := []types.ValidatorIndex{2, includedAttester, gossipAttester, idle}
+	if earlyAttester != 2 && earlyAttester != includedAttester && earlyAttester != gossipAttester {
+		indices = append(indices, earlyAttester)
+	}
+	if includedAttester != 1 && gossipAttester != 1 {
+		indices = append(indices, 1)
+	}
+
+	res, err := vs.CheckDoppelGanger(ctx, &pbrpc.DoppelGangerRequest{Epoch: 1, ValidatorIndices: indices})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), res.CurrentEpoch)
+	require.Equal(t, len(indices), len(res.Validators))
+	for i, v := range res.Validators {
+		assert.Equal(t, indices[i], v.ValidatorIndex)
+	}
+	assert.Equal(t, true, res.Validators[0].IsLive)
+	assert.Equal(t, true, res.Validators[1].IsLive)
+	assert.Equal(t, true, res.Validators[2].IsLive)
+	assert.Equal(t, epochStart+3, res.Validators[2].LastActiveSlot)
+	for _, v := range res.Validators[3:] {
+		assert.Equal(t, false, v.IsLive, "Validator %d should not be live", v.ValidatorIndex)
+		assert.Equal(t, types.Slot(0), v.LastActiveSlot)
+	}
+}
+
+func TestServer_CheckDoppelGanger_InvalidRequest(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.UseMinimalConfig()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	currentSlot := params.BeaconConfig().SlotsPerEpoch.Mul(10)
+	chain := &mock.ChainService{State: st, Slot: &currentSlot}
+	vs := &Server{
+		BeaconDB:    dbutil.SetupDB(t),
+		HeadFetcher: chain,
+		TimeFetcher: chain,
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+		AttPool:     attestations.NewPool(),
+	}
+	ctx := context.Background()
+
+	_, err := vs.CheckDoppelGanger(ctx, &pbrpc.DoppelGangerRequest{Epoch: 10})
+	assert.ErrorContains(t, "No validator indices to check", err)
+	_, err = vs.CheckDoppelGanger(ctx, &pbrpc.DoppelGangerRequest{Epoch: 11, ValidatorIndices: []types.ValidatorIndex{0}})
+	assert.ErrorContains(t, "Requested epoch 11 is after the current epoch 10", err)
+	_, err = vs.CheckDoppelGanger(ctx, &pbrpc.DoppelGangerRequest{Epoch: 1, ValidatorIndices: []types.ValidatorIndex{0}})
+	assert.ErrorContains(t, "Requested epoch 1 is more than 8 epochs before the current epoch 10", err)
+	_, err = vs.CheckDoppelGanger(ctx, &pbrpc.DoppelGangerRequest{Epoch: 10, ValidatorIndices: []types.ValidatorIndex{64}})
+	assert.ErrorContains(t, "Validator index 64 is not in the registry", err)
+
+	vs.SyncChecker = &mockSync.Sync{IsSyncing: true}
+	_, err = vs.CheckDoppelGanger(ctx, &pbrpc.DoppelGangerRequest{Epoch: 10, ValidatorIndices: []types.ValidatorIndex{0}})
+	assert.ErrorContains(t, "Syncing to latest head", err)
+}
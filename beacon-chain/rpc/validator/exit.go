@@ -2,11 +2,16 @@ package validator
 
 import (
 	"context"
+	"fmt"
 
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -57,3 +62,71 @@ func (vs *Server) ProposeExit(ctx context.Context, req *ethpb.SignedVoluntaryExi
 		ExitRoot: r[:],
 	}, vs.P2P.Broadcast(ctx, req)
 }
+
+// VerifyVoluntaryExits verifies a batch of signed voluntary exits against the head state
+// without broadcasting them, returning the validity of each exit along with the earliest
+// epoch at which it could be included in a block.
+func (vs *Server) VerifyVoluntaryExits(ctx context.Context, req *pbrpc.VerifyVoluntaryExitsRequest) (*pbrpc.VerifyVoluntaryExitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "nil request")
+	}
+	s, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+
+	results := make([]*pbrpc.VoluntaryExitVerification, len(req.Exits))
+	seen := make(map[types.ValidatorIndex]bool, len(req.Exits))
+	for i, exit := range req.Exits {
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.Canceled, "Context canceled: %v", ctx.Err())
+		}
+		results[i] = verifyVoluntaryExit(s, exit, seen)
+	}
+	return &pbrpc.VerifyVoluntaryExitsResponse{
+		Results: results,
+	}, nil
+}
+
+// verifies a single voluntary exit against the provided state. The seen map tracks validator
+// indices already covered by the batch, as only one exit per validator can ever be included.
+func verifyVoluntaryExit(
+	s iface.ReadOnlyBeaconState,
+	exit *ethpb.SignedVoluntaryExit,
+	seen map[types.ValidatorIndex]bool,
+) *pbrpc.VoluntaryExitVerification {
+	if exit == nil || exit.Exit == nil {
+		return &pbrpc.VoluntaryExitVerification{Error: "voluntary exit does not exist"}
+	}
+	if len(exit.Signature) != params.BeaconConfig().BLSSignatureLength {
+		return &pbrpc.VoluntaryExitVerification{Error: "invalid signature provided"}
+	}
+	idx := exit.Exit.ValidatorIndex
+	val, err := s.ValidatorAtIndexReadOnly(idx)
+	if err != nil {
+		return &pbrpc.VoluntaryExitVerification{Error: "validator index exceeds validator set length"}
+	}
+	if seen[idx] {
+		return &pbrpc.VoluntaryExitVerification{Error: fmt.Sprintf("duplicate exit for validator index %d", idx)}
+	}
+	seen[idx] = true
+
+	earliest := exit.Exit.Epoch
+	if e := val.ActivationEpoch() + params.BeaconConfig().ShardCommitteePeriod; e > earliest {
+		earliest = e
+	}
+	currentEpoch := helpers.SlotToEpoch(s.Slot())
+	if earliest < currentEpoch {
+		earliest = currentEpoch
+	}
+	if err := blocks.VerifyExitAndSignature(val, s.Slot(), s.Fork(), exit, s.GenesisValidatorRoot()); err != nil {
+		return &pbrpc.VoluntaryExitVerification{
+			Error:                  err.Error(),
+			EarliestInclusionEpoch: earliest,
+		}
+	}
+	return &pbrpc.VoluntaryExitVerification{
+		Valid:                  true,
+		EarliestInclusionEpoch: earliest,
+	}
+}
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -156,3 +158,58 @@ func TestProposeExit_NoPanic(t *testing.T) {
 	require.NoError(t, err)
 	assert.DeepEqual(t, expectedRoot[:], resp.ExitRoot)
 }
+
+func TestVerifyVoluntaryExits(t *testing.T) {
+	testutil.ResetCache()
+	deposits, keys, err := testutil.DeterministicDepositsAndKeys(params.BeaconConfig().MinGenesisActiveValidatorCount)
+	require.NoError(t, err)
+	beaconState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{BlockHash: make([]byte, 32)})
+	require.NoError(t, err)
+	epoch := types.Epoch(2048)
+	require.NoError(t, beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))))
+	server := &Server{
+		HeadFetcher: &mockChain.ChainService{State: beaconState},
+	}
+
+	signedExit := func(idx types.ValidatorIndex, exitEpoch types.Epoch) *ethpb.SignedVoluntaryExit {
+		exit := &ethpb.SignedVoluntaryExit{
+			Exit: &ethpb.VoluntaryExit{
+				Epoch:          exitEpoch,
+				ValidatorIndex: idx,
+			},
+		}
+		exit.Signature, err = helpers.ComputeDomainAndSign(beaconState, exitEpoch, exit.Exit, params.BeaconConfig().DomainVoluntaryExit, keys[idx])
+		require.NoError(t, err)
+		return exit
+	}
+	badSig := signedExit(2, epoch)
+	badSig.Signature = signedExit(3, epoch).Signature
+
+	req := &pbrpc.VerifyVoluntaryExitsRequest{
+		Exits: []*ethpb.SignedVoluntaryExit{
+			signedExit(0, epoch),
+			signedExit(1, epoch+10),
+			badSig,
+			signedExit(0, epoch),
+			{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 1 << 40}, Signature: make([]byte, 96)},
+		},
+	}
+	resp, err := server.VerifyVoluntaryExits(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, len(req.Exits), len(resp.Results))
+
+	assert.Equal(t, true, resp.Results[0].Valid)
+	assert.Equal(t, epoch, resp.Results[0].EarliestInclusionEpoch)
+
+	assert.Equal(t, false, resp.Results[1].Valid)
+	assert.Equal(t, epoch+10, resp.Results[1].EarliestInclusionEpoch)
+
+	assert.Equal(t, false, resp.Results[2].Valid)
+	assert.Equal(t, helpers.ErrSigFailedToVerify.Error(), resp.Results[2].Error)
+
+	assert.Equal(t, false, resp.Results[3].Valid)
+	assert.ErrorContains(t, "duplicate exit", errors.New(resp.Results[3].Error))
+
+	assert.Equal(t, false, resp.Results[4].Valid)
+	assert.Equal(t, "validator index exceeds validator set length", resp.Results[4].Error)
+}
@@ -0,0 +1,21 @@
+package validator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	missedProfitableAttsCount = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "proposer_missed_profitable_attestations",
+			Help: "The number of attestations seen on gossip with votes not packed in the last locally proposed block.",
+		},
+	)
+	missedProfitableAttsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "proposer_missed_profitable_attestations_total",
+			Help: "The number of attestations seen on gossip with votes not packed in locally proposed blocks.",
+		},
+	)
+)
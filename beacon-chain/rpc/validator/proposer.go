@@ -135,6 +135,16 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 	}
 	blk.StateRoot = stateRoot
 
+	// Refuse to hand out a block which would be slashable once signed.
+	if vs.ProposalGuard != nil {
+		if err := vs.ProposalGuard.VerifyNotDoubleProposal(ctx, blk); err != nil {
+			if errors.Is(err, blocks.ErrDoubleProposal) {
+				return nil, status.Errorf(codes.FailedPrecondition, "Refusing to build block: %v", err)
+			}
+			return nil, status.Errorf(codes.Internal, "Could not check block for double proposal: %v", err)
+		}
+	}
+
 	return blk, nil
 }
 
@@ -146,6 +156,16 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 		return nil, status.Errorf(codes.Internal, "Could not tree hash block: %v", err)
 	}
 
+	// Refuse to broadcast a block which conflicts with a block already seen from the same proposer.
+	if vs.ProposalGuard != nil {
+		if err := vs.ProposalGuard.VerifyNotDoubleProposal(ctx, blk.Block); err != nil {
+			if errors.Is(err, blocks.ErrDoubleProposal) {
+				return nil, status.Errorf(codes.FailedPrecondition, "Refusing to broadcast block: %v", err)
+			}
+			return nil, status.Errorf(codes.Internal, "Could not check block for double proposal: %v", err)
+		}
+	}
+
 	// Do not block proposal critical path with debug logging or block feed updates.
 	defer func() {
 		log.WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(root[:]))).Debugf(
@@ -168,11 +188,44 @@ func (vs *Server) ProposeBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock
 		return nil, status.Errorf(codes.Internal, "Could not process beacon block: %v", err)
 	}
 
+	if err := vs.recordPackingEfficiency(blk.Block); err != nil {
+		log.WithError(err).Debug("Could not record attestation packing efficiency")
+	}
+
 	return &ethpb.ProposeResponse{
 		BlockRoot: root[:],
 	}, nil
 }
 
+// recordPackingEfficiency compares the attestations packed in a proposed block with the ones seen on
+// gossip for the slots the block could cover, and reports the number of attestations which would have
+// added votes to the block but were left out.
+func (vs *Server) recordPackingEfficiency(blk *ethpb.BeaconBlock) error {
+	if vs.AttPool == nil {
+		return nil
+	}
+	seen := vs.AttPool.AggregatedAttestations()
+	uAtts, err := vs.AttPool.UnaggregatedAttestations()
+	if err != nil {
+		return errors.Wrap(err, "could not get unaggregated attestations")
+	}
+	seen = append(seen, uAtts...)
+
+	included := append(vs.AttPool.BlockAttestations(), blk.Body.Attestations...)
+	missed, err := proposerAtts(seen).missedProfitable(blk.Slot, included)
+	if err != nil {
+		return err
+	}
+	missedProfitableAttsCount.Set(float64(len(missed)))
+	missedProfitableAttsTotal.Add(float64(len(missed)))
+	log.WithFields(logrus.Fields{
+		"slot":             blk.Slot,
+		"packed":           len(blk.Body.Attestations),
+		"missedProfitable": len(missed),
+	}).Debug("Recorded attestation packing efficiency")
+	return nil
+}
+
 // eth1DataMajorityVote determines the appropriate eth1data for a block proposal using
 // an algorithm called Voting with the Majority. The algorithm works as follows:
 //  - Determine the timestamp for the start slot for the eth1 voting period.
@@ -498,13 +551,16 @@ func (vs *Server) depositTrie(ctx context.Context, canonicalEth1DataHeight *big.
 	upToEth1DataDeposits := vs.DepositFetcher.NonFinalizedDeposits(ctx, canonicalEth1DataHeight)
 	insertIndex := finalizedDeposits.MerkleTrieIndex + 1
 
-	for _, dep := range upToEth1DataDeposits {
+	items := make([][]byte, len(upToEth1DataDeposits))
+	for i, dep := range upToEth1DataDeposits {
 		depHash, err := dep.Data.HashTreeRoot()
 		if err != nil {
 			return nil, errors.Wrap(err, "could not hash deposit data")
 		}
-		depositTrie.Insert(depHash[:], int(insertIndex))
-		insertIndex++
+		items[i] = depHash[:]
+	}
+	if err := depositTrie.InsertBatch(items, int(insertIndex)); err != nil {
+		return nil, errors.Wrap(err, "could not insert deposits into trie")
 	}
 
 	return depositTrie, nil
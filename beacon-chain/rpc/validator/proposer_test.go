@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
@@ -33,6 +34,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestProposer_GetBlock_OK(t *testing.T) {
@@ -60,6 +63,7 @@ func TestProposer_GetBlock_OK(t *testing.T) {
 		HeadFetcher:       &mock.ChainService{State: beaconState, Root: parentRoot[:]},
 		SyncChecker:       &mockSync.Sync{IsSyncing: false},
 		BlockReceiver:     &mock.ChainService{},
+		ProposalGuard:     &mock.ChainService{},
 		ChainStartFetcher: &mockPOW.POWChain{},
 		Eth1InfoFetcher:   &mockPOW.POWChain{},
 		Eth1BlockFetcher:  &mockPOW.POWChain{},
@@ -142,6 +146,7 @@ func TestProposer_GetBlock_AddsUnaggregatedAtts(t *testing.T) {
 		HeadFetcher:       &mock.ChainService{State: beaconState, Root: parentRoot[:]},
 		SyncChecker:       &mockSync.Sync{IsSyncing: false},
 		BlockReceiver:     &mock.ChainService{},
+		ProposalGuard:     &mock.ChainService{},
 		ChainStartFetcher: &mockPOW.POWChain{},
 		Eth1InfoFetcher:   &mockPOW.POWChain{},
 		Eth1BlockFetcher:  &mockPOW.POWChain{},
@@ -229,6 +234,7 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 		Eth1InfoFetcher:   &mockPOW.POWChain{},
 		Eth1BlockFetcher:  &mockPOW.POWChain{},
 		BlockReceiver:     c,
+		ProposalGuard:     c,
 		HeadFetcher:       c,
 		BlockNotifier:     c.BlockNotifier(),
 		P2P:               mockp2p.NewTestP2P(t),
@@ -239,6 +245,30 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 	require.NoError(t, db.SaveBlock(ctx, req))
 	_, err = proposerServer.ProposeBlock(context.Background(), req)
 	assert.NoError(t, err, "Could not propose block correctly")
+
+	// Servers without a proposal guard propose without the double proposal check.
+	proposerServer.ProposalGuard = nil
+	req = testutil.NewBeaconBlock()
+	req.Block.Slot = 6
+	req.Block.ParentRoot = c.Root
+	_, err = proposerServer.ProposeBlock(context.Background(), req)
+	assert.NoError(t, err, "Could not propose block correctly")
+}
+
+func TestProposer_ProposeBlock_DoubleProposal(t *testing.T) {
+	c := &mock.ChainService{DoubleProposalErr: errors.Wrap(b.ErrDoubleProposal, "conflicting block")}
+	p2p := mockp2p.NewTestP2P(t)
+	proposerServer := &Server{
+		BlockReceiver: c,
+		ProposalGuard: c,
+		BlockNotifier: c.BlockNotifier(),
+		P2P:           p2p,
+	}
+	_, err := proposerServer.ProposeBlock(context.Background(), testutil.NewBeaconBlock())
+	assert.ErrorContains(t, "Refusing to broadcast block", err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, false, p2p.BroadcastCalled, "Block should not have been broadcast")
+	assert.Equal(t, 0, len(c.BlocksReceived), "Block should not have been processed")
 }
 
 func TestProposer_ComputeStateRoot_OK(t *testing.T) {
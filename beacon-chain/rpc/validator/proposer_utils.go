@@ -159,3 +159,53 @@ func (a proposerAtts) dedup() proposerAtts {
 
 	return uniqAtts
 }
+
+// missedProfitable returns the attestations, from the given gossip attestations, which were eligible for
+// inclusion in a block at the given slot and carry at least one vote not covered by the included attestations.
+func (a proposerAtts) missedProfitable(slot types.Slot, included []*ethpb.Attestation) (proposerAtts, error) {
+	earliestSlot := types.Slot(0)
+	if slot > params.BeaconConfig().SlotsPerEpoch {
+		earliestSlot = slot - params.BeaconConfig().SlotsPerEpoch
+	}
+	latestSlot := types.Slot(0)
+	if slot > params.BeaconConfig().MinAttestationInclusionDelay {
+		latestSlot = slot - params.BeaconConfig().MinAttestationInclusionDelay
+	}
+	inWindow := func(att *ethpb.Attestation) bool {
+		return att.Data.Slot >= earliestSlot && att.Data.Slot <= latestSlot
+	}
+
+	includedBits := make(map[[32]byte]bitfield.Bitlist, len(included))
+	for _, att := range included {
+		if !inWindow(att) {
+			continue
+		}
+		attDataRoot, err := att.Data.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		bits, ok := includedBits[attDataRoot]
+		if !ok || bits.Len() != att.AggregationBits.Len() {
+			includedBits[attDataRoot] = att.AggregationBits
+			continue
+		}
+		includedBits[attDataRoot] = bits.Or(att.AggregationBits)
+	}
+
+	missed := make([]*ethpb.Attestation, 0)
+	for _, att := range a {
+		if !inWindow(att) {
+			continue
+		}
+		attDataRoot, err := att.Data.HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		bits, ok := includedBits[attDataRoot]
+		if ok && bits.Len() == att.AggregationBits.Len() && bits.Contains(att.AggregationBits) {
+			continue
+		}
+		missed = append(missed, att)
+	}
+	return missed, nil
+}
@@ -9,6 +9,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -428,3 +429,42 @@ func TestProposer_ProposerAtts_dedup(t *testing.T) {
 		})
 	}
 }
+
+func TestProposer_ProposerAtts_missedProfitable(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+
+	data := func(slot types.Slot) *ethpb.AttestationData {
+		return testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: slot})
+	}
+	seen := proposerAtts{
+		// Fully covered by a packed attestation.
+		{Data: data(20), AggregationBits: bitfield.Bitlist{0b11000011}},
+		// Covered by the union of packed and previously included attestations.
+		{Data: data(21), AggregationBits: bitfield.Bitlist{0b11000011}},
+		// Carries a vote which was not packed.
+		{Data: data(22), AggregationBits: bitfield.Bitlist{0b10000110}},
+		// No attestation with the same data was packed.
+		{Data: data(23), AggregationBits: bitfield.Bitlist{0b10000001}},
+		// Too old to be included in a block at slot 40.
+		{Data: data(1), AggregationBits: bitfield.Bitlist{0b10000001}},
+		// Too recent to be included in a block at slot 40.
+		{Data: data(40), AggregationBits: bitfield.Bitlist{0b10000001}},
+	}
+	included := []*ethpb.Attestation{
+		{Data: data(20), AggregationBits: bitfield.Bitlist{0b11000111}},
+		{Data: data(21), AggregationBits: bitfield.Bitlist{0b10000001}},
+		{Data: data(21), AggregationBits: bitfield.Bitlist{0b11000010}},
+		{Data: data(22), AggregationBits: bitfield.Bitlist{0b10000011}},
+	}
+
+	missed, err := seen.missedProfitable(40, included)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(missed))
+	assert.Equal(t, seen[2], missed[0])
+	assert.Equal(t, seen[3], missed[1])
+
+	missed, err = seen.missedProfitable(40, append(included, seen...))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(missed))
+}
@@ -0,0 +1,144 @@
+package validator
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListValidatorsByWithdrawalCredentials returns the validators of the head state registry with
+// the requested withdrawal credentials. The lookup is served from an index of the registry, so
+// staking services withdrawing to a shared address can enumerate their validators cheaply.
+func (vs *Server) ListValidatorsByWithdrawalCredentials(
+	ctx context.Context,
+	req *pbrpc.ValidatorsByWithdrawalCredentialsRequest,
+) (*pbrpc.ValidatorsByWithdrawalCredentialsResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "ValidatorServer.ListValidatorsByWithdrawalCredentials")
+	defer span.End()
+
+	if len(req.WithdrawalCredentials) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Withdrawal credentials must be 32 bytes, received %d", len(req.WithdrawalCredentials))
+	}
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	indices, err := vs.WithdrawalCredsFetcher.ValidatorsByWithdrawalCredentials(ctx, bytesutil.ToBytes32(req.WithdrawalCredentials))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not look up validators: %v", err)
+	}
+	head, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	validators := make([]*pbrpc.RegistryValidator, len(indices))
+	for i, idx := range indices {
+		val, err := head.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator at index %d: %v", idx, err)
+		}
+		pubKey := val.PublicKey()
+		validators[i] = &pbrpc.RegistryValidator{
+			Index:     idx,
+			PublicKey: pubKey[:],
+		}
+	}
+	return &pbrpc.ValidatorsByWithdrawalCredentialsResponse{Validators: validators}, nil
+}
+
+// maxValidatorStatusesRequest is the maximum number of public keys and indices of a request to
+// ListValidatorStatuses.
+const maxValidatorStatusesRequest = 10000
+
+// ListValidatorStatuses returns the status, activation and exit epochs, effective balance and
+// balance of the requested validators in the head state. The requested public keys followed by
+// the requested indices are paginated, only the validators of the requested page are looked up.
+func (vs *Server) ListValidatorStatuses(
+	ctx context.Context,
+	req *pbrpc.ValidatorStatusesRequest,
+) (*pbrpc.ValidatorStatusesResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "ValidatorServer.ListValidatorStatuses")
+	defer span.End()
+
+	totalSize := len(req.PublicKeys) + len(req.Indices)
+	if totalSize > maxValidatorStatusesRequest {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested %d validators, can not be more than %d",
+			totalSize, maxValidatorStatusesRequest)
+	}
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, cmd.Get().MaxRPCPageSize)
+	}
+	for i, pubKey := range req.PublicKeys {
+		if len(pubKey) != 48 {
+			return nil, status.Errorf(codes.InvalidArgument, "Public key %d must be 48 bytes, received %d", i, len(pubKey))
+		}
+	}
+	if vs.SyncChecker.Syncing() {
+		return nil, status.Errorf(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	head, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	res := &pbrpc.ValidatorStatusesResponse{
+		Epoch:      helpers.CurrentEpoch(head),
+		Validators: make([]*pbrpc.RegistryValidatorStatus, 0),
+		TotalSize:  int32(totalSize),
+	}
+	if totalSize == 0 {
+		return res, nil
+	}
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), totalSize)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not paginate results: %v", err)
+	}
+	res.NextPageToken = nextPageToken
+
+	numValidators := uint64(head.NumValidators())
+	for i := start; i < end; i++ {
+		v := &pbrpc.RegistryValidatorStatus{Status: ethpb.ValidatorStatus_UNKNOWN_STATUS}
+		res.Validators = append(res.Validators, v)
+		var idx types.ValidatorIndex
+		if i < len(req.PublicKeys) {
+			v.PublicKey = req.PublicKeys[i]
+			var ok bool
+			if idx, ok = head.ValidatorIndexByPubkey(bytesutil.ToBytes48(v.PublicKey)); !ok {
+				continue
+			}
+		} else {
+			idx = req.Indices[i-len(req.PublicKeys)]
+		}
+		if uint64(idx) >= numValidators {
+			v.Index = idx
+			continue
+		}
+		val, err := head.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator at index %d: %v", idx, err)
+		}
+		balance, err := head.BalanceAtIndex(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get balance at index %d: %v", idx, err)
+		}
+		pubKey := val.PublicKey()
+		v.Index = idx
+		v.PublicKey = pubKey[:]
+		v.Status = assignmentStatus(head, idx)
+		v.ActivationEpoch = val.ActivationEpoch()
+		v.ExitEpoch = val.ExitEpoch()
+		v.EffectiveBalance = val.EffectiveBalance()
+		v.Balance = balance
+	}
+	return res, nil
+}
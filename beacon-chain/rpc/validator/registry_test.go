@@ -0,0 +1,137 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListValidatorsByWithdrawalCredentials(t *testing.T) {
+	ctx := context.Background()
+	creds := bytesutil.PadTo([]byte{'a'}, 32)
+	head, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, head.SetValidators([]*ethpb.Validator{
+		{PublicKey: bytesutil.PadTo([]byte{0}, 48), WithdrawalCredentials: creds},
+		{PublicKey: bytesutil.PadTo([]byte{1}, 48), WithdrawalCredentials: make([]byte, 32)},
+		{PublicKey: bytesutil.PadTo([]byte{2}, 48), WithdrawalCredentials: creds},
+	}))
+	chain := &mock.ChainService{State: head}
+	vs := &Server{
+		HeadFetcher:            chain,
+		WithdrawalCredsFetcher: chain,
+		SyncChecker:            &mockSync.Sync{IsSyncing: false},
+	}
+
+	resp, err := vs.ListValidatorsByWithdrawalCredentials(ctx, &pbrpc.ValidatorsByWithdrawalCredentialsRequest{WithdrawalCredentials: creds})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Validators))
+	assert.Equal(t, types.ValidatorIndex(0), resp.Validators[0].Index)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{0}, 48), resp.Validators[0].PublicKey)
+	assert.Equal(t, types.ValidatorIndex(2), resp.Validators[1].Index)
+	assert.DeepEqual(t, bytesutil.PadTo([]byte{2}, 48), resp.Validators[1].PublicKey)
+
+	_, err = vs.ListValidatorsByWithdrawalCredentials(ctx, &pbrpc.ValidatorsByWithdrawalCredentialsRequest{WithdrawalCredentials: []byte{'a'}})
+	assert.ErrorContains(t, "Withdrawal credentials must be 32 bytes", err)
+
+	vs.SyncChecker = &mockSync.Sync{IsSyncing: true}
+	_, err = vs.ListValidatorsByWithdrawalCredentials(ctx, &pbrpc.ValidatorsByWithdrawalCredentialsRequest{WithdrawalCredentials: creds})
+	assert.ErrorContains(t, "Syncing to latest head", err)
+}
+
+func TestServer_ListValidatorStatuses(t *testing.T) {
+	ctx := context.Background()
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	head, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, head.SetSlot(params.BeaconConfig().SlotsPerEpoch*5))
+	require.NoError(t, head.SetValidators([]*ethpb.Validator{
+		{PublicKey: bytesutil.PadTo([]byte{0}, 48), ActivationEpoch: 1, ExitEpoch: farFuture, EffectiveBalance: 32},
+		{PublicKey: bytesutil.PadTo([]byte{1}, 48), ActivationEpoch: 1, ExitEpoch: 3, EffectiveBalance: 31},
+		{PublicKey: bytesutil.PadTo([]byte{2}, 48), ActivationEligibilityEpoch: 4, ActivationEpoch: 6, ExitEpoch: farFuture},
+	}))
+	require.NoError(t, head.SetBalances([]uint64{33, 30, 32}))
+	vs := &Server{
+		HeadFetcher: &mock.ChainService{State: head},
+		SyncChecker: &mockSync.Sync{IsSyncing: false},
+	}
+
+	req := &pbrpc.ValidatorStatusesRequest{
+		PublicKeys: [][]byte{bytesutil.PadTo([]byte{2}, 48), bytesutil.PadTo([]byte{9}, 48)},
+		Indices:    []types.ValidatorIndex{0, 1, 7},
+		PageSize:   3,
+	}
+	res, err := vs.ListValidatorStatuses(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(5), res.Epoch)
+	assert.Equal(t, int32(5), res.TotalSize)
+	assert.Equal(t, "1", res.NextPageToken)
+	assert.DeepEqual(t, []*pbrpc.RegistryValidatorStatus{
+		{
+			Index:           2,
+			PublicKey:       bytesutil.PadTo([]byte{2}, 48),
+			Status:          ethpb.ValidatorStatus_PENDING,
+			ActivationEpoch: 6,
+			ExitEpoch:       farFuture,
+			Balance:         32,
+		},
+		{
+			PublicKey: bytesutil.PadTo([]byte{9}, 48),
+			Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		},
+		{
+			Index:            0,
+			PublicKey:        bytesutil.PadTo([]byte{0}, 48),
+			Status:           ethpb.ValidatorStatus_ACTIVE,
+			ActivationEpoch:  1,
+			ExitEpoch:        farFuture,
+			EffectiveBalance: 32,
+			Balance:          33,
+		},
+	}, res.Validators)
+
+	req.PageToken = res.NextPageToken
+	res, err = vs.ListValidatorStatuses(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "", res.NextPageToken)
+	assert.DeepEqual(t, []*pbrpc.RegistryValidatorStatus{
+		{
+			Index:            1,
+			PublicKey:        bytesutil.PadTo([]byte{1}, 48),
+			Status:           ethpb.ValidatorStatus_EXITED,
+			ActivationEpoch:  1,
+			ExitEpoch:        3,
+			EffectiveBalance: 31,
+			Balance:          30,
+		},
+		{
+			Index:  7,
+			Status: ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		},
+	}, res.Validators)
+
+	res, err = vs.ListValidatorStatuses(ctx, &pbrpc.ValidatorStatusesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Validators))
+
+	_, err = vs.ListValidatorStatuses(ctx, &pbrpc.ValidatorStatusesRequest{PublicKeys: [][]byte{{1}}})
+	assert.ErrorContains(t, "Public key 0 must be 48 bytes", err)
+	_, err = vs.ListValidatorStatuses(ctx, &pbrpc.ValidatorStatusesRequest{Indices: make([]types.ValidatorIndex, maxValidatorStatusesRequest+1)})
+	assert.ErrorContains(t, "can not be more than", err)
+	_, err = vs.ListValidatorStatuses(ctx, &pbrpc.ValidatorStatusesRequest{Indices: []types.ValidatorIndex{0}, PageToken: "1"})
+	assert.ErrorContains(t, "Could not paginate results", err)
+
+	vs.SyncChecker = &mockSync.Sync{IsSyncing: true}
+	_, err = vs.ListValidatorStatuses(ctx, req)
+	assert.ErrorContains(t, "Syncing to latest head", err)
+}
@@ -0,0 +1,135 @@
+package validator
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetStateProposerDuties returns the proposer of every slot of the epoch of the state with the
+// requested state ID. Unlike GetDuties, the duties of past epochs can be queried, the state being
+// regenerated if needed.
+func (vs *Server) GetStateProposerDuties(ctx context.Context, req *pbrpc.StateProposerDutiesRequest) (*pbrpc.StateProposerDutiesResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "ValidatorServer.GetStateProposerDuties")
+	defer span.End()
+
+	st, err := vs.StateFetcher.State(ctx, req.StateId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+	}
+	// Computing the proposers moves the state through the slots of the epoch.
+	st = st.Copy()
+	epoch := helpers.CurrentEpoch(st)
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get start slot of epoch %d: %v", epoch, err)
+	}
+	duties := make([]*pbrpc.StateProposerDuty, 0, params.BeaconConfig().SlotsPerEpoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		// The genesis block has no proposer.
+		if slot == 0 {
+			continue
+		}
+		if err := st.SetSlot(slot); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not set slot: %v", err)
+		}
+		idx, err := helpers.BeaconProposerIndex(st)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute proposer of slot %d: %v", slot, err)
+		}
+		pubKey := st.PubkeyAtIndex(idx)
+		duties = append(duties, &pbrpc.StateProposerDuty{
+			ValidatorIndex: idx,
+			PublicKey:      pubKey[:],
+			Slot:           slot,
+		})
+	}
+	return &pbrpc.StateProposerDutiesResponse{
+		Epoch:  epoch,
+		Duties: duties,
+	}, nil
+}
+
+// GetStateAttesterDuties returns the attester duties of the requested validators for an epoch,
+// computed from the state with the requested state ID. The epoch can be at most the one after the
+// epoch of the state, and validators which are not active in the epoch have no duty.
+func (vs *Server) GetStateAttesterDuties(ctx context.Context, req *pbrpc.StateAttesterDutiesRequest) (*pbrpc.StateAttesterDutiesResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "ValidatorServer.GetStateAttesterDuties")
+	defer span.End()
+
+	st, err := vs.StateFetcher.State(ctx, req.StateId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+	}
+	if nextEpoch := helpers.NextEpoch(st); req.Epoch > nextEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve duties of epoch %d from a state of epoch %d",
+			req.Epoch,
+			helpers.CurrentEpoch(st),
+		)
+	}
+	numVals := types.ValidatorIndex(st.NumValidators())
+	for _, idx := range req.Indices {
+		if idx >= numVals {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid validator index %d, the state has %d validators", idx, numVals)
+		}
+	}
+	startSlot, err := helpers.StartSlot(req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid epoch: %v", err)
+	}
+	activeCount, err := helpers.ActiveValidatorCount(st, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator count: %v", err)
+	}
+	committeesPerSlot := helpers.SlotCommitteeCount(activeCount)
+
+	requested := make(map[types.ValidatorIndex]bool, len(req.Indices))
+	for _, idx := range req.Indices {
+		requested[idx] = true
+	}
+	found := make(map[types.ValidatorIndex]*pbrpc.StateAttesterDuty, len(req.Indices))
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.Aborted, "Could not continue fetching duties: %v", ctx.Err())
+		}
+		for i := uint64(0); i < committeesPerSlot; i++ {
+			committee, err := helpers.BeaconCommitteeFromState(st, slot, types.CommitteeIndex(i))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute committee: %v", err)
+			}
+			for position, idx := range committee {
+				if !requested[idx] {
+					continue
+				}
+				pubKey := st.PubkeyAtIndex(idx)
+				found[idx] = &pbrpc.StateAttesterDuty{
+					ValidatorIndex:          idx,
+					PublicKey:               pubKey[:],
+					Slot:                    slot,
+					CommitteeIndex:          types.CommitteeIndex(i),
+					CommitteeLength:         uint64(len(committee)),
+					CommitteesAtSlot:        committeesPerSlot,
+					ValidatorCommitteeIndex: uint64(position),
+				}
+			}
+		}
+	}
+
+	duties := make([]*pbrpc.StateAttesterDuty, 0, len(found))
+	for _, idx := range req.Indices {
+		if duty, ok := found[idx]; ok {
+			duties = append(duties, duty)
+			// A repeated index is only reported once.
+			delete(found, idx)
+		}
+	}
+	return &pbrpc.StateAttesterDutiesResponse{Duties: duties}, nil
+}
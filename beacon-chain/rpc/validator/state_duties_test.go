@@ -0,0 +1,97 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetStateProposerDuties(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch+3))
+	vs := &Server{
+		StateFetcher: statefetcher.StateFetcher{
+			ChainInfoFetcher: &mockChain.ChainService{State: st},
+		},
+	}
+
+	res, err := vs.GetStateProposerDuties(ctx, &pbrpc.StateProposerDutiesRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), res.Epoch)
+	require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(res.Duties))
+	_, proposerSlots, err := helpers.CommitteeAssignments(st.Copy(), 1)
+	require.NoError(t, err)
+	for i, duty := range res.Duties {
+		assert.Equal(t, params.BeaconConfig().SlotsPerEpoch+types.Slot(i), duty.Slot)
+		pubKey := st.PubkeyAtIndex(duty.ValidatorIndex)
+		assert.DeepEqual(t, pubKey[:], duty.PublicKey)
+		found := false
+		for _, s := range proposerSlots[duty.ValidatorIndex] {
+			found = found || s == duty.Slot
+		}
+		assert.Equal(t, true, found, "Wrong proposer at slot %d", duty.Slot)
+	}
+	// The state itself is left untouched.
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch+3, st.Slot())
+
+	_, err = vs.GetStateProposerDuties(ctx, &pbrpc.StateProposerDutiesRequest{StateId: []byte("foo")})
+	assert.ErrorContains(t, "invalid state ID", err)
+}
+
+func TestGetStateAttesterDuties(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch+3))
+	vs := &Server{
+		StateFetcher: statefetcher.StateFetcher{
+			ChainInfoFetcher: &mockChain.ChainService{State: st},
+		},
+	}
+
+	committeesPerSlot := helpers.SlotCommitteeCount(64)
+	for _, epoch := range []types.Epoch{0, 1, 2} {
+		res, err := vs.GetStateAttesterDuties(ctx, &pbrpc.StateAttesterDutiesRequest{
+			StateId: []byte("head"),
+			Epoch:   epoch,
+			Indices: []types.ValidatorIndex{5, 0, 5},
+		})
+		require.NoError(t, err)
+		assignments, _, err := helpers.CommitteeAssignments(st.Copy(), epoch)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(res.Duties))
+		for i, idx := range []types.ValidatorIndex{5, 0} {
+			duty := res.Duties[i]
+			ca := assignments[idx]
+			assert.Equal(t, idx, duty.ValidatorIndex)
+			assert.Equal(t, ca.AttesterSlot, duty.Slot)
+			assert.Equal(t, ca.CommitteeIndex, duty.CommitteeIndex)
+			assert.Equal(t, uint64(len(ca.Committee)), duty.CommitteeLength)
+			assert.Equal(t, committeesPerSlot, duty.CommitteesAtSlot)
+			assert.Equal(t, idx, ca.Committee[duty.ValidatorCommitteeIndex])
+		}
+	}
+
+	_, err := vs.GetStateAttesterDuties(ctx, &pbrpc.StateAttesterDutiesRequest{
+		StateId: []byte("head"),
+		Epoch:   3,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = vs.GetStateAttesterDuties(ctx, &pbrpc.StateAttesterDutiesRequest{
+		StateId: []byte("head"),
+		Epoch:   1,
+		Indices: []types.ValidatorIndex{64},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSubmittedBlocks is the maximum number of blocks of a SubmitBlocks request.
+const maxSubmittedBlocks = 64
+
+// SubmitBlocks imports a batch of signed blocks built outside of the node, such as the blocks of a
+// relay or of a backup proposer, through the same pipeline as proposed blocks, and reports the
+// outcome for each block. Blocks are imported by increasing slot, so that a block may build on
+// another block of the batch. Unlike ProposeBlock, blocks are only broadcast once imported, so that
+// invalid blocks of the batch are not relayed to peers.
+func (vs *Server) SubmitBlocks(ctx context.Context, req *pbrpc.SubmitBlocksRequest) (*pbrpc.SubmitBlocksResponse, error) {
+	if len(req.Blocks) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No blocks to submit")
+	}
+	if len(req.Blocks) > maxSubmittedBlocks {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Submitted %d blocks, more than the maximum of %d",
+			len(req.Blocks),
+			maxSubmittedBlocks,
+		)
+	}
+
+	order := make([]int, len(req.Blocks))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return blockSlot(req.Blocks[order[i]]) < blockSlot(req.Blocks[order[j]])
+	})
+	results := make([]*pbrpc.BlockSubmissionResult, len(req.Blocks))
+	for _, i := range order {
+		if ctx.Err() != nil {
+			return nil, status.Error(codes.Canceled, "Context canceled")
+		}
+		results[i] = vs.submitBlock(ctx, req.Blocks[i])
+	}
+	return &pbrpc.SubmitBlocksResponse{Results: results}, nil
+}
+
+// submitBlock imports a submitted block, and broadcasts it once imported.
+func (vs *Server) submitBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock) *pbrpc.BlockSubmissionResult {
+	res := &pbrpc.BlockSubmissionResult{}
+	reject := func(err error) *pbrpc.BlockSubmissionResult {
+		res.Status = pbrpc.BlockSubmissionResult_REJECTED
+		res.Error = err.Error()
+		return res
+	}
+	if err := helpers.VerifyNilBeaconBlock(blk); err != nil {
+		return reject(err)
+	}
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return reject(errors.Wrap(err, "could not tree hash block"))
+	}
+	res.BlockRoot = root[:]
+	if vs.BeaconDB.HasBlock(ctx, root) || vs.BlockReceiver.HasInitSyncBlock(root) {
+		res.Status = pbrpc.BlockSubmissionResult_ALREADY_KNOWN
+		return res
+	}
+	parentRoot := bytesutil.ToBytes32(blk.Block.ParentRoot)
+	if !vs.BeaconDB.HasBlock(ctx, parentRoot) && !vs.BlockReceiver.HasInitSyncBlock(parentRoot) {
+		res.Status = pbrpc.BlockSubmissionResult_UNKNOWN_PARENT
+		res.Error = "parent block is not known"
+		return res
+	}
+	if vs.ProposalGuard != nil {
+		if err := vs.ProposalGuard.VerifyNotDoubleProposal(ctx, blk.Block); err != nil {
+			if errors.Is(err, blocks.ErrDoubleProposal) {
+				res.Status = pbrpc.BlockSubmissionResult_DOUBLE_PROPOSAL
+				res.Error = err.Error()
+				return res
+			}
+			return reject(errors.Wrap(err, "could not check block for double proposal"))
+		}
+	}
+
+	vs.BlockNotifier.BlockFeed().Send(&feed.Event{
+		Type: blockfeed.ReceivedBlock,
+		Data: &blockfeed.ReceivedBlockData{SignedBlock: blk},
+	})
+	if err := vs.BlockReceiver.ReceiveBlock(ctx, blk, root); err != nil {
+		return reject(errors.Wrap(err, "could not process block"))
+	}
+	res.Status = pbrpc.BlockSubmissionResult_IMPORTED
+	if err := vs.P2P.Broadcast(ctx, blk); err != nil {
+		res.Error = errors.Wrap(err, "could not broadcast block").Error()
+		return res
+	}
+	res.Broadcast = true
+	return res
+}
+
+// blockSlot returns the slot of a submitted block, or 0 if it is malformed.
+func blockSlot(blk *ethpb.SignedBeaconBlock) types.Slot {
+	if blk == nil || blk.Block == nil {
+		return 0
+	}
+	return blk.Block.Slot
+}
@@ -0,0 +1,113 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_SubmitBlocks(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+
+	c := &mock.ChainService{Root: genesisRoot[:], State: st, DB: db}
+	p2p := mockp2p.NewTestP2P(t)
+	vs := &Server{
+		BeaconDB:      db,
+		BlockReceiver: c,
+		ProposalGuard: c,
+		BlockNotifier: c.BlockNotifier(),
+		P2P:           p2p,
+	}
+
+	first := testutil.NewBeaconBlock()
+	first.Block.Slot = 1
+	first.Block.ParentRoot = genesisRoot[:]
+	firstRoot, err := first.Block.HashTreeRoot()
+	require.NoError(t, err)
+	second := testutil.NewBeaconBlock()
+	second.Block.Slot = 2
+	second.Block.ParentRoot = firstRoot[:]
+	// The mock chain only imports blocks building on its head, so a sibling of the first block is
+	// rejected.
+	sibling := testutil.NewBeaconBlock()
+	sibling.Block.Slot = 3
+	sibling.Block.ParentRoot = genesisRoot[:]
+	orphan := testutil.NewBeaconBlock()
+	orphan.Block.Slot = 3
+	orphan.Block.ParentRoot = bytesutil.PadTo([]byte{'a'}, 32)
+
+	res, err := vs.SubmitBlocks(ctx, &pbrpc.SubmitBlocksRequest{
+		Blocks: []*ethpb.SignedBeaconBlock{second, sibling, first, genesis, orphan, {}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 6, len(res.Results))
+	assert.Equal(t, pbrpc.BlockSubmissionResult_IMPORTED, res.Results[0].Status)
+	assert.Equal(t, true, res.Results[0].Broadcast)
+	assert.Equal(t, pbrpc.BlockSubmissionResult_REJECTED, res.Results[1].Status)
+	assert.ErrorContains(t, "could not process block", errors.New(res.Results[1].Error))
+	assert.Equal(t, false, res.Results[1].Broadcast)
+	assert.Equal(t, pbrpc.BlockSubmissionResult_IMPORTED, res.Results[2].Status)
+	assert.DeepEqual(t, firstRoot[:], res.Results[2].BlockRoot)
+	assert.Equal(t, pbrpc.BlockSubmissionResult_ALREADY_KNOWN, res.Results[3].Status)
+	assert.Equal(t, pbrpc.BlockSubmissionResult_UNKNOWN_PARENT, res.Results[4].Status)
+	assert.Equal(t, pbrpc.BlockSubmissionResult_REJECTED, res.Results[5].Status)
+	assert.Equal(t, 0, len(res.Results[5].BlockRoot))
+
+	require.Equal(t, 2, len(c.BlocksReceived))
+	assert.DeepEqual(t, first, c.BlocksReceived[0])
+	assert.DeepEqual(t, second, c.BlocksReceived[1])
+}
+
+func TestServer_SubmitBlocks_DoubleProposal(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	c := &mock.ChainService{DoubleProposalErr: errors.Wrap(b.ErrDoubleProposal, "conflicting block")}
+	p2p := mockp2p.NewTestP2P(t)
+	vs := &Server{
+		BeaconDB:      db,
+		BlockReceiver: c,
+		ProposalGuard: c,
+		BlockNotifier: c.BlockNotifier(),
+		P2P:           p2p,
+	}
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 1
+	blk.Block.ParentRoot = genesisRoot[:]
+	res, err := vs.SubmitBlocks(ctx, &pbrpc.SubmitBlocksRequest{Blocks: []*ethpb.SignedBeaconBlock{blk}})
+	require.NoError(t, err)
+	assert.Equal(t, pbrpc.BlockSubmissionResult_DOUBLE_PROPOSAL, res.Results[0].Status)
+	assert.Equal(t, false, p2p.BroadcastCalled, "Block should not have been broadcast")
+	assert.Equal(t, 0, len(c.BlocksReceived), "Block should not have been processed")
+}
+
+func TestServer_SubmitBlocks_InvalidRequest(t *testing.T) {
+	vs := &Server{}
+	_, err := vs.SubmitBlocks(context.Background(), &pbrpc.SubmitBlocksRequest{})
+	assert.ErrorContains(t, "No blocks to submit", err)
+	blks := make([]*ethpb.SignedBeaconBlock, maxSubmittedBlocks+1)
+	_, err = vs.SubmitBlocks(context.Background(), &pbrpc.SubmitBlocksRequest{Blocks: blks})
+	assert.ErrorContains(t, "Submitted 65 blocks, more than the maximum of 64", err)
+}
@@ -17,7 +17,11 @@ type BeaconState interface {
 	ReadOnlyBeaconState
 	WriteOnlyBeaconState
 	Copy() BeaconState
+	ReadOnlyView() ReadOnlyBeaconState
 	HashTreeRoot(ctx context.Context) ([32]byte, error)
+	Proof(ctx context.Context, generalizedIndex uint64) ([][]byte, error)
+	FieldRoot(ctx context.Context, index int) ([32]byte, error)
+	FieldSSZ(index int) ([]byte, error)
 }
 
 // ReadOnlyBeaconState defines a struct which only has read access to beacon state methods.
@@ -41,6 +45,7 @@ type ReadOnlyBeaconState interface {
 	Slashings() []uint64
 	FieldReferencesCount() map[string]uint64
 	MarshalSSZ() ([]byte, error)
+	MarshalSSZSnappy() ([]byte, error)
 }
 
 // WriteOnlyBeaconState defines a struct which only has write access to beacon state methods.
@@ -75,6 +80,7 @@ type ReadOnlyValidator interface {
 	WithdrawalCredentials() []byte
 	Slashed() bool
 	IsNil() bool
+	Copy() *ethpb.Validator
 }
 
 // ReadOnlyValidators defines a struct which only has read access to validators methods.
@@ -161,7 +167,7 @@ type WriteOnlyEth1Data interface {
 // WriteOnlyValidators defines a struct which only has write access to validators methods.
 type WriteOnlyValidators interface {
 	SetValidators(val []*ethpb.Validator) error
-	ApplyToEveryValidator(f func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error)) error
+	ApplyToEveryValidator(f func(idx int, val ReadOnlyValidator) (bool, *ethpb.Validator, error)) error
 	UpdateValidatorAtIndex(idx types.ValidatorIndex, val *ethpb.Validator) error
 	AppendValidator(val *ethpb.Validator) error
 }
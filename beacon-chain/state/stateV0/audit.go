@@ -0,0 +1,49 @@
+// +build state_audit
+
+package stateV0
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+var auditLog = logrus.WithField("prefix", "state-audit")
+
+// auditStackDepth is the number of callers recorded for each mutation of a shared state.
+const auditStackDepth = 6
+
+// auditMutation logs the caller of every mutation of a state marked as shared, as such
+// states may be read concurrently and must never be modified in place.
+func (b *BeaconState) auditMutation(field fieldIndex) {
+	if b.sharedAs == "" {
+		return
+	}
+	auditLog.WithFields(logrus.Fields{
+		"state":  b.sharedAs,
+		"field":  field.String(),
+		"slot":   b.state.Slot,
+		"caller": auditCallers(),
+	}).Warn("Shared state was mutated")
+}
+
+// auditCallers returns a shortened stack of the callers of the state setter, skipping
+// the audit and bookkeeping frames of this package.
+func auditCallers() string {
+	pcs := make([]uintptr, auditStackDepth)
+	// Skip runtime.Callers, auditCallers, auditMutation and markFieldAsDirty.
+	n := runtime.Callers(4, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	callers := make([]string, 0, n)
+	for {
+		frame, more := frames.Next()
+		name := strings.TrimPrefix(frame.Function, "github.com/prysmaticlabs/prysm/")
+		callers = append(callers, fmt.Sprintf("%s:%d", name, frame.Line))
+		if !more {
+			break
+		}
+	}
+	return strings.Join(callers, " <- ")
+}
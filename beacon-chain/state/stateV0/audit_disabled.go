@@ -0,0 +1,6 @@
+// +build !state_audit
+
+package stateV0
+
+// auditMutation is a no-op unless the node is built with the state_audit tag.
+func (b *BeaconState) auditMutation(_ fieldIndex) {}
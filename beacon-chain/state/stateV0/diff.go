@@ -0,0 +1,421 @@
+package stateV0
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// StateDiff holds the values of the fields of a beacon state which differ from those of a base
+// state. Vectors and lists which are modified a few elements at a time, such as the validator
+// registry or the balances, are diffed element by element and keyed by element index, while
+// other fields are replaced as a whole. Only the fields listed by Fields are set.
+type StateDiff struct {
+	Slot                        types.Slot
+	Fork                        *pbp2p.Fork
+	LatestBlockHeader           *ethpb.BeaconBlockHeader
+	BlockRoots                  map[uint64][]byte
+	StateRoots                  map[uint64][]byte
+	HistoricalRoots             map[uint64][]byte
+	Eth1Data                    *ethpb.Eth1Data
+	Eth1DataVotes               []*ethpb.Eth1Data
+	Eth1DepositIndex            uint64
+	Validators                  map[types.ValidatorIndex]*ethpb.Validator
+	Balances                    map[types.ValidatorIndex]uint64
+	RandaoMixes                 map[uint64][]byte
+	Slashings                   map[uint64]uint64
+	PreviousEpochAttestations   []*pbp2p.PendingAttestation
+	CurrentEpochAttestations    []*pbp2p.PendingAttestation
+	JustificationBits           bitfield.Bitvector4
+	PreviousJustifiedCheckpoint *ethpb.Checkpoint
+	CurrentJustifiedCheckpoint  *ethpb.Checkpoint
+	FinalizedCheckpoint         *ethpb.Checkpoint
+
+	// fields are the indices of the fields which differ, in ascending order.
+	fields []fieldIndex
+}
+
+// Fields returns the names of the fields which differ, in the order of the fields in the state.
+func (d *StateDiff) Fields() []string {
+	names := make([]string, len(d.fields))
+	for i, f := range d.fields {
+		names[i] = f.String()
+	}
+	return names
+}
+
+// IsEmpty returns true if the states the diff was computed from are equal.
+func (d *StateDiff) IsEmpty() bool {
+	return len(d.fields) == 0
+}
+
+// Diff returns the difference between the beacon state b and the base beacon state a, such that
+// patching a copy of a with the diff results in b. Both states must be of the same chain, and the
+// validator registry and the historical roots of b must extend those of a, as they do when b is a
+// descendant of a.
+func Diff(a, b iface.ReadOnlyBeaconState) (*StateDiff, error) {
+	if a == nil || b == nil {
+		return nil, ErrNilInnerState
+	}
+	if a.GenesisTime() != b.GenesisTime() || !bytes.Equal(a.GenesisValidatorRoot(), b.GenesisValidatorRoot()) {
+		return nil, errors.New("states do not belong to the same chain")
+	}
+	d := &StateDiff{}
+
+	if a.Slot() != b.Slot() {
+		d.Slot = b.Slot()
+		d.fields = append(d.fields, slot)
+	}
+	if !proto.Equal(a.Fork(), b.Fork()) {
+		d.Fork = b.Fork()
+		d.fields = append(d.fields, fork)
+	}
+	if !proto.Equal(a.LatestBlockHeader(), b.LatestBlockHeader()) {
+		d.LatestBlockHeader = b.LatestBlockHeader()
+		d.fields = append(d.fields, latestBlockHeader)
+	}
+	var err error
+	if d.BlockRoots, err = diffVector(a.BlockRoots(), b.BlockRoots()); err != nil {
+		return nil, errors.Wrap(err, "could not diff block roots")
+	}
+	if len(d.BlockRoots) > 0 {
+		d.fields = append(d.fields, blockRoots)
+	}
+	if d.StateRoots, err = diffVector(a.StateRoots(), b.StateRoots()); err != nil {
+		return nil, errors.Wrap(err, "could not diff state roots")
+	}
+	if len(d.StateRoots) > 0 {
+		d.fields = append(d.fields, stateRoots)
+	}
+	if d.HistoricalRoots, err = diffList(a.HistoricalRoots(), b.HistoricalRoots()); err != nil {
+		return nil, errors.Wrap(err, "could not diff historical roots")
+	}
+	if len(d.HistoricalRoots) > 0 {
+		d.fields = append(d.fields, historicalRoots)
+	}
+	if !proto.Equal(a.Eth1Data(), b.Eth1Data()) {
+		d.Eth1Data = b.Eth1Data()
+		d.fields = append(d.fields, eth1Data)
+	}
+	if !eth1DataVotesEqual(a.Eth1DataVotes(), b.Eth1DataVotes()) {
+		d.Eth1DataVotes = b.Eth1DataVotes()
+		d.fields = append(d.fields, eth1DataVotes)
+	}
+	if a.Eth1DepositIndex() != b.Eth1DepositIndex() {
+		d.Eth1DepositIndex = b.Eth1DepositIndex()
+		d.fields = append(d.fields, eth1DepositIndex)
+	}
+	if d.Validators, err = diffValidators(a.Validators(), b.Validators()); err != nil {
+		return nil, err
+	}
+	if len(d.Validators) > 0 {
+		d.fields = append(d.fields, validators)
+	}
+	if d.Balances, err = diffBalances(a.Balances(), b.Balances()); err != nil {
+		return nil, err
+	}
+	if len(d.Balances) > 0 {
+		d.fields = append(d.fields, balances)
+	}
+	if d.RandaoMixes, err = diffVector(a.RandaoMixes(), b.RandaoMixes()); err != nil {
+		return nil, errors.Wrap(err, "could not diff randao mixes")
+	}
+	if len(d.RandaoMixes) > 0 {
+		d.fields = append(d.fields, randaoMixes)
+	}
+	if d.Slashings, err = diffSlashings(a.Slashings(), b.Slashings()); err != nil {
+		return nil, err
+	}
+	if len(d.Slashings) > 0 {
+		d.fields = append(d.fields, slashings)
+	}
+	equal, err := pendingAttestationsEqual(a.PreviousEpochAttestations(), b.PreviousEpochAttestations())
+	if err != nil {
+		return nil, err
+	}
+	if !equal {
+		d.PreviousEpochAttestations = b.PreviousEpochAttestations()
+		d.fields = append(d.fields, previousEpochAttestations)
+	}
+	equal, err = pendingAttestationsEqual(a.CurrentEpochAttestations(), b.CurrentEpochAttestations())
+	if err != nil {
+		return nil, err
+	}
+	if !equal {
+		d.CurrentEpochAttestations = b.CurrentEpochAttestations()
+		d.fields = append(d.fields, currentEpochAttestations)
+	}
+	if !bytes.Equal(a.JustificationBits(), b.JustificationBits()) {
+		d.JustificationBits = b.JustificationBits()
+		d.fields = append(d.fields, justificationBits)
+	}
+	if !proto.Equal(a.PreviousJustifiedCheckpoint(), b.PreviousJustifiedCheckpoint()) {
+		d.PreviousJustifiedCheckpoint = b.PreviousJustifiedCheckpoint()
+		d.fields = append(d.fields, previousJustifiedCheckpoint)
+	}
+	if !proto.Equal(a.CurrentJustifiedCheckpoint(), b.CurrentJustifiedCheckpoint()) {
+		d.CurrentJustifiedCheckpoint = b.CurrentJustifiedCheckpoint()
+		d.fields = append(d.fields, currentJustifiedCheckpoint)
+	}
+	if !proto.Equal(a.FinalizedCheckpoint(), b.FinalizedCheckpoint()) {
+		d.FinalizedCheckpoint = b.FinalizedCheckpoint()
+		d.fields = append(d.fields, finalizedCheckpoint)
+	}
+	return d, nil
+}
+
+// Patch applies a diff to the base state it was computed from, turning it into the state the
+// diff was computed against. Values of the diff are copied into the state, so the same diff
+// can patch several states.
+func Patch(st iface.BeaconState, d *StateDiff) error {
+	if st == nil {
+		return ErrNilInnerState
+	}
+	for _, field := range d.fields {
+		if err := patchField(st, d, field); err != nil {
+			return errors.Wrapf(err, "could not patch field %s", field.String())
+		}
+	}
+	return nil
+}
+
+func patchField(st iface.BeaconState, d *StateDiff, field fieldIndex) error {
+	switch field {
+	case slot:
+		return st.SetSlot(d.Slot)
+	case fork:
+		return st.SetFork(d.Fork)
+	case latestBlockHeader:
+		return st.SetLatestBlockHeader(proto.Clone(d.LatestBlockHeader).(*ethpb.BeaconBlockHeader))
+	case blockRoots:
+		for _, idx := range sortedIndices(d.BlockRoots) {
+			if err := st.UpdateBlockRootAtIndex(idx, bytesutil.ToBytes32(d.BlockRoots[idx])); err != nil {
+				return err
+			}
+		}
+		return nil
+	case stateRoots:
+		for _, idx := range sortedIndices(d.StateRoots) {
+			if err := st.UpdateStateRootAtIndex(idx, bytesutil.ToBytes32(d.StateRoots[idx])); err != nil {
+				return err
+			}
+		}
+		return nil
+	case historicalRoots:
+		roots := st.HistoricalRoots()
+		for _, idx := range sortedIndices(d.HistoricalRoots) {
+			root := bytesutil.SafeCopyBytes(d.HistoricalRoots[idx])
+			switch {
+			case idx < uint64(len(roots)):
+				roots[idx] = root
+			case idx == uint64(len(roots)):
+				roots = append(roots, root)
+			default:
+				return errors.Errorf("historical root %d is past the end of the historical roots", idx)
+			}
+		}
+		return st.SetHistoricalRoots(roots)
+	case eth1Data:
+		return st.SetEth1Data(proto.Clone(d.Eth1Data).(*ethpb.Eth1Data))
+	case eth1DataVotes:
+		votes := make([]*ethpb.Eth1Data, len(d.Eth1DataVotes))
+		for i, v := range d.Eth1DataVotes {
+			votes[i] = proto.Clone(v).(*ethpb.Eth1Data)
+		}
+		return st.SetEth1DataVotes(votes)
+	case eth1DepositIndex:
+		return st.SetEth1DepositIndex(d.Eth1DepositIndex)
+	case validators:
+		indices := make([]types.ValidatorIndex, 0, len(d.Validators))
+		for idx := range d.Validators {
+			indices = append(indices, idx)
+		}
+		sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+		for _, idx := range indices {
+			val := proto.Clone(d.Validators[idx]).(*ethpb.Validator)
+			var err error
+			switch n := types.ValidatorIndex(st.NumValidators()); {
+			case idx < n:
+				err = st.UpdateValidatorAtIndex(idx, val)
+			case idx == n:
+				err = st.AppendValidator(val)
+			default:
+				err = errors.Errorf("validator %d is past the end of the registry", idx)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case balances:
+		indices := make([]types.ValidatorIndex, 0, len(d.Balances))
+		for idx := range d.Balances {
+			indices = append(indices, idx)
+		}
+		sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+		for _, idx := range indices {
+			var err error
+			switch n := types.ValidatorIndex(st.BalancesLength()); {
+			case idx < n:
+				err = st.UpdateBalancesAtIndex(idx, d.Balances[idx])
+			case idx == n:
+				err = st.AppendBalance(d.Balances[idx])
+			default:
+				err = errors.Errorf("balance %d is past the end of the balances", idx)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case randaoMixes:
+		for _, idx := range sortedIndices(d.RandaoMixes) {
+			if err := st.UpdateRandaoMixesAtIndex(idx, bytesutil.SafeCopyBytes(d.RandaoMixes[idx])); err != nil {
+				return err
+			}
+		}
+		return nil
+	case slashings:
+		for idx, val := range d.Slashings {
+			if err := st.UpdateSlashingsAtIndex(idx, val); err != nil {
+				return err
+			}
+		}
+		return nil
+	case previousEpochAttestations:
+		return st.SetPreviousEpochAttestations(copyPendingAttestations(d.PreviousEpochAttestations))
+	case currentEpochAttestations:
+		return st.SetCurrentEpochAttestations(copyPendingAttestations(d.CurrentEpochAttestations))
+	case justificationBits:
+		return st.SetJustificationBits(bytesutil.SafeCopyBytes(d.JustificationBits))
+	case previousJustifiedCheckpoint:
+		return st.SetPreviousJustifiedCheckpoint(proto.Clone(d.PreviousJustifiedCheckpoint).(*ethpb.Checkpoint))
+	case currentJustifiedCheckpoint:
+		return st.SetCurrentJustifiedCheckpoint(proto.Clone(d.CurrentJustifiedCheckpoint).(*ethpb.Checkpoint))
+	case finalizedCheckpoint:
+		return st.SetFinalizedCheckpoint(proto.Clone(d.FinalizedCheckpoint).(*ethpb.Checkpoint))
+	default:
+		return errors.New("field cannot be patched")
+	}
+}
+
+// diffVector returns the elements of b which differ from those of a, for fixed length vectors.
+func diffVector(a, b [][]byte) (map[uint64][]byte, error) {
+	if len(a) != len(b) {
+		return nil, errors.Errorf("vector lengths %d and %d differ", len(a), len(b))
+	}
+	return diffList(a, b)
+}
+
+// diffList returns the elements of b which differ from those of a, including the elements
+// appended to a. The list b cannot be shorter than a.
+func diffList(a, b [][]byte) (map[uint64][]byte, error) {
+	if len(b) < len(a) {
+		return nil, errors.Errorf("list of length %d cannot be diffed against a longer list of length %d", len(b), len(a))
+	}
+	changed := make(map[uint64][]byte)
+	for i := range b {
+		if i >= len(a) || !bytes.Equal(a[i], b[i]) {
+			changed[uint64(i)] = b[i]
+		}
+	}
+	return changed, nil
+}
+
+func diffValidators(a, b []*ethpb.Validator) (map[types.ValidatorIndex]*ethpb.Validator, error) {
+	if len(b) < len(a) {
+		return nil, errors.Errorf("validator registry cannot shrink from %d to %d validators", len(a), len(b))
+	}
+	changed := make(map[types.ValidatorIndex]*ethpb.Validator)
+	for i := range b {
+		if i >= len(a) || !proto.Equal(a[i], b[i]) {
+			changed[types.ValidatorIndex(i)] = b[i]
+		}
+	}
+	return changed, nil
+}
+
+func diffBalances(a, b []uint64) (map[types.ValidatorIndex]uint64, error) {
+	if len(b) < len(a) {
+		return nil, errors.Errorf("balances cannot shrink from %d to %d balances", len(a), len(b))
+	}
+	changed := make(map[types.ValidatorIndex]uint64)
+	for i := range b {
+		if i >= len(a) || a[i] != b[i] {
+			changed[types.ValidatorIndex(i)] = b[i]
+		}
+	}
+	return changed, nil
+}
+
+func diffSlashings(a, b []uint64) (map[uint64]uint64, error) {
+	if len(a) != len(b) {
+		return nil, errors.Errorf("slashings lengths %d and %d differ", len(a), len(b))
+	}
+	changed := make(map[uint64]uint64)
+	for i := range b {
+		if a[i] != b[i] {
+			changed[uint64(i)] = b[i]
+		}
+	}
+	return changed, nil
+}
+
+func eth1DataVotesEqual(a, b []*ethpb.Eth1Data) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// pendingAttestationsEqual compares pending attestations by their SSZ encoding, as their
+// aggregation bitlists cannot be compared as protobuf messages.
+func pendingAttestationsEqual(a, b []*pbp2p.PendingAttestation) (bool, error) {
+	if len(a) != len(b) {
+		return false, nil
+	}
+	for i := range a {
+		encA, err := a[i].MarshalSSZ()
+		if err != nil {
+			return false, err
+		}
+		encB, err := b[i].MarshalSSZ()
+		if err != nil {
+			return false, err
+		}
+		if !bytes.Equal(encA, encB) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func copyPendingAttestations(atts []*pbp2p.PendingAttestation) []*pbp2p.PendingAttestation {
+	copied := make([]*pbp2p.PendingAttestation, len(atts))
+	for i, att := range atts {
+		copied[i] = CopyPendingAttestation(att)
+	}
+	return copied
+}
+
+func sortedIndices(m map[uint64][]byte) []uint64 {
+	indices := make([]uint64, 0, len(m))
+	for idx := range m {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	return indices
+}
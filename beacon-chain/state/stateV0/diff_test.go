@@ -0,0 +1,99 @@
+package stateV0_test
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestDiff_Patch(t *testing.T) {
+	ctx := context.Background()
+	a, _ := testutil.DeterministicGenesisState(t, 16)
+	b := a.Copy()
+	require.NoError(t, b.SetSlot(40))
+	require.NoError(t, b.UpdateBlockRootAtIndex(3, bytesutil.ToBytes32([]byte("block root"))))
+	require.NoError(t, b.AppendHistoricalRoots(bytesutil.ToBytes32([]byte("historical root"))))
+	require.NoError(t, b.AppendEth1DataVotes(&ethpb.Eth1Data{
+		DepositRoot: bytesutil.PadTo([]byte("deposit root"), 32),
+		BlockHash:   bytesutil.PadTo([]byte("block hash"), 32),
+	}))
+	val, err := b.ValidatorAtIndex(5)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, b.UpdateValidatorAtIndex(5, val))
+	val, err = b.ValidatorAtIndex(15)
+	require.NoError(t, err)
+	val.PublicKey = bytesutil.PadTo([]byte("new validator"), 48)
+	require.NoError(t, b.AppendValidator(val))
+	require.NoError(t, b.AppendBalance(1))
+	require.NoError(t, b.UpdateBalancesAtIndex(2, 2))
+	require.NoError(t, b.UpdateRandaoMixesAtIndex(7, bytesutil.PadTo([]byte("mix"), 32)))
+	require.NoError(t, b.UpdateSlashingsAtIndex(1, 10))
+	require.NoError(t, b.AppendCurrentEpochAttestations(&pb.PendingAttestation{
+		AggregationBits: bitfield.NewBitlist(4),
+		Data:            testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 39}),
+		InclusionDelay:  1,
+	}))
+	require.NoError(t, b.SetJustificationBits(bitfield.Bitvector4{0x03}))
+	require.NoError(t, b.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("finalized"), 32)}))
+
+	diff, err := stateV0.Diff(a, b)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{
+		"slot", "blockRoots", "historicalRoots", "eth1DataVotes", "validators", "balances", "randaoMixes",
+		"slashings", "currentEpochAttestations", "justificationBits", "finalizedCheckpoint",
+	}, diff.Fields())
+	assert.Equal(t, 1, len(diff.BlockRoots))
+	assert.Equal(t, 2, len(diff.Validators))
+	assert.Equal(t, 2, len(diff.Balances))
+
+	patched := a.Copy()
+	require.NoError(t, stateV0.Patch(patched, diff))
+	wantedRoot, err := b.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	root, err := patched.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wantedRoot, root)
+
+	// The diff can patch several states without sharing its values with them.
+	val, err = patched.ValidatorAtIndex(16)
+	require.NoError(t, err)
+	val.EffectiveBalance = 0
+	require.NoError(t, patched.UpdateValidatorAtIndex(16, val))
+	patched = a.Copy()
+	require.NoError(t, stateV0.Patch(patched, diff))
+	root, err = patched.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wantedRoot, root)
+}
+
+func TestDiff_EqualStates(t *testing.T) {
+	a, _ := testutil.DeterministicGenesisState(t, 16)
+	diff, err := stateV0.Diff(a, a.Copy())
+	require.NoError(t, err)
+	assert.Equal(t, true, diff.IsEmpty())
+	assert.Equal(t, 0, len(diff.Fields()))
+}
+
+func TestDiff_Errors(t *testing.T) {
+	a, _ := testutil.DeterministicGenesisState(t, 16)
+
+	b := a.Copy()
+	require.NoError(t, b.SetGenesisValidatorRoot(bytesutil.PadTo([]byte("other chain"), 32)))
+	_, err := stateV0.Diff(a, b)
+	assert.ErrorContains(t, "same chain", err)
+
+	b, _ = testutil.DeterministicGenesisState(t, 8)
+	require.NoError(t, b.SetGenesisTime(a.GenesisTime()))
+	require.NoError(t, b.SetGenesisValidatorRoot(a.GenesisValidatorRoot()))
+	_, err = stateV0.Diff(a, b)
+	assert.ErrorContains(t, "validator registry cannot shrink", err)
+}
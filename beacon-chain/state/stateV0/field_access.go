@@ -0,0 +1,169 @@
+package stateV0
+
+import (
+	"context"
+
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// FieldRoot returns the hash tree root of the top level field at the given index of the state.
+// Only the given field is rehashed if it is dirty, the roots of other dirty fields are left
+// to be recomputed by the next call to HashTreeRoot.
+func (b *BeaconState) FieldRoot(ctx context.Context, index int) ([32]byte, error) {
+	_, span := trace.StartSpan(ctx, "beaconState.FieldRoot")
+	defer span.End()
+
+	if !b.hasInnerState() {
+		return [32]byte{}, ErrNilInnerState
+	}
+	field, err := fieldAtIndex(index)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	_, dirty := b.dirtyFields[field]
+	if len(b.merkleLayers) != 0 && !dirty {
+		return bytesutil.ToBytes32(b.merkleLayers[0][field]), nil
+	}
+	root, err := b.rootSelector(field)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	// Without a state trie, every field is rehashed on the next call to HashTreeRoot.
+	if len(b.merkleLayers) != 0 {
+		b.merkleLayers[0][field] = root[:]
+		b.recomputeRoot(int(field))
+		delete(b.dirtyFields, field)
+	}
+	return root, nil
+}
+
+// FieldSSZ returns the SSZ encoding of the top level field at the given index of the state,
+// as it would be serialized on its own rather than within the state.
+func (b *BeaconState) FieldSSZ(index int) ([]byte, error) {
+	if !b.hasInnerState() {
+		return nil, ErrNilInnerState
+	}
+	field, err := fieldAtIndex(index)
+	if err != nil {
+		return nil, err
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	switch field {
+	case genesisTime:
+		return fastssz.MarshalUint64(nil, b.state.GenesisTime), nil
+	case genesisValidatorRoot:
+		return bytesutil.SafeCopyBytes(b.state.GenesisValidatorsRoot), nil
+	case slot:
+		return fastssz.MarshalUint64(nil, uint64(b.state.Slot)), nil
+	case fork:
+		return marshalContainer(field, b.state.Fork == nil, b.state.Fork.MarshalSSZ)
+	case latestBlockHeader:
+		return marshalContainer(field, b.state.LatestBlockHeader == nil, b.state.LatestBlockHeader.MarshalSSZ)
+	case blockRoots:
+		return marshalRoots(b.state.BlockRoots), nil
+	case stateRoots:
+		return marshalRoots(b.state.StateRoots), nil
+	case historicalRoots:
+		return marshalRoots(b.state.HistoricalRoots), nil
+	case eth1Data:
+		return marshalContainer(field, b.state.Eth1Data == nil, b.state.Eth1Data.MarshalSSZ)
+	case eth1DataVotes:
+		var dst []byte
+		for _, v := range b.state.Eth1DataVotes {
+			if dst, err = v.MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		}
+		return dst, nil
+	case eth1DepositIndex:
+		return fastssz.MarshalUint64(nil, b.state.Eth1DepositIndex), nil
+	case validators:
+		var dst []byte
+		for _, v := range b.state.Validators {
+			if dst, err = v.MarshalSSZTo(dst); err != nil {
+				return nil, err
+			}
+		}
+		return dst, nil
+	case balances:
+		return marshalUint64s(b.state.Balances), nil
+	case randaoMixes:
+		return marshalRoots(b.state.RandaoMixes), nil
+	case slashings:
+		return marshalUint64s(b.state.Slashings), nil
+	case previousEpochAttestations:
+		return marshalPendingAttestations(b.state.PreviousEpochAttestations)
+	case currentEpochAttestations:
+		return marshalPendingAttestations(b.state.CurrentEpochAttestations)
+	case justificationBits:
+		return bytesutil.SafeCopyBytes(b.state.JustificationBits), nil
+	case previousJustifiedCheckpoint:
+		return marshalContainer(field, b.state.PreviousJustifiedCheckpoint == nil, b.state.PreviousJustifiedCheckpoint.MarshalSSZ)
+	case currentJustifiedCheckpoint:
+		return marshalContainer(field, b.state.CurrentJustifiedCheckpoint == nil, b.state.CurrentJustifiedCheckpoint.MarshalSSZ)
+	case finalizedCheckpoint:
+		return marshalContainer(field, b.state.FinalizedCheckpoint == nil, b.state.FinalizedCheckpoint.MarshalSSZ)
+	}
+	return nil, errors.Errorf("invalid field index %d", index)
+}
+
+// fieldAtIndex returns the field of the state at the given index.
+func fieldAtIndex(index int) (fieldIndex, error) {
+	if index < 0 || index >= params.BeaconConfig().BeaconStateFieldCount {
+		return 0, errors.Errorf("invalid field index %d", index)
+	}
+	return fieldIndex(index), nil
+}
+
+func marshalContainer(field fieldIndex, isNil bool, marshal func() ([]byte, error)) ([]byte, error) {
+	if isNil {
+		return nil, errors.Errorf("field %s is nil", field.String())
+	}
+	return marshal()
+}
+
+func marshalRoots(roots [][]byte) []byte {
+	dst := make([]byte, 0, len(roots)*32)
+	for _, r := range roots {
+		dst = append(dst, r...)
+	}
+	return dst
+}
+
+func marshalUint64s(vals []uint64) []byte {
+	dst := make([]byte, 0, len(vals)*8)
+	for _, v := range vals {
+		dst = fastssz.MarshalUint64(dst, v)
+	}
+	return dst
+}
+
+// marshalPendingAttestations encodes a list of variable sized pending attestations, whose
+// elements are preceded by their offsets.
+func marshalPendingAttestations(atts []*pbp2p.PendingAttestation) ([]byte, error) {
+	offset := len(atts) * 4
+	dst := make([]byte, 0, offset)
+	for _, att := range atts {
+		dst = fastssz.WriteOffset(dst, offset)
+		offset += att.SizeSSZ()
+	}
+	var err error
+	for _, att := range atts {
+		if dst, err = att.MarshalSSZTo(dst); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
@@ -0,0 +1,101 @@
+package stateV0_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBeaconState_FieldRoot(t *testing.T) {
+	ctx := context.Background()
+	testState, _ := testutil.DeterministicGenesisState(t, 16)
+
+	// 2 represents the enum value of slot
+	root, err := testState.FieldRoot(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, htrutils.Uint64Root(uint64(testState.Slot())), root)
+
+	_, err = testState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.NoError(t, testState.SetSlot(5))
+	require.NoError(t, testState.UpdateBalancesAtIndex(3, 1))
+	root, err = testState.FieldRoot(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, htrutils.Uint64Root(5), root)
+
+	// 12 represents the enum value of balances
+	fresh, err := stateV0.InitializeFromProto(testState.CloneInnerState().(*pbp2p.BeaconState))
+	require.NoError(t, err)
+	wanted, err := fresh.FieldRoot(ctx, 12)
+	require.NoError(t, err)
+	root, err = testState.FieldRoot(ctx, 12)
+	require.NoError(t, err)
+	assert.Equal(t, wanted, root)
+
+	// Roots of single fields are folded into the state trie.
+	wanted, err = fresh.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	root, err = testState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wanted, root)
+
+	_, err = testState.FieldRoot(ctx, params.BeaconConfig().BeaconStateFieldCount)
+	assert.ErrorContains(t, "invalid field index", err)
+	_, err = testState.FieldRoot(ctx, -1)
+	assert.ErrorContains(t, "invalid field index", err)
+}
+
+func TestBeaconState_FieldSSZ(t *testing.T) {
+	testState, _ := testutil.DeterministicGenesisState(t, 16)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, testState.AppendPreviousEpochAttestations(&pbp2p.PendingAttestation{
+			AggregationBits: bitfield.NewBitlist(uint64(8 * (i + 1))),
+			Data:            testutil.HydrateAttestationData(&eth.AttestationData{}),
+			InclusionDelay:  1,
+		}))
+	}
+	require.NoError(t, testState.AppendHistoricalRoots([32]byte{'a'}))
+
+	// The encoding of the state places the fixed size fields and the offsets of the variable
+	// sized fields first, followed by the variable sized fields.
+	variableSized := map[int]bool{7: true, 9: true, 11: true, 12: true, 15: true, 16: true}
+	fieldCount := params.BeaconConfig().BeaconStateFieldCount
+	encodings := make([][]byte, fieldCount)
+	fixedSize := 0
+	for i := 0; i < fieldCount; i++ {
+		enc, err := testState.FieldSSZ(i)
+		require.NoError(t, err)
+		encodings[i] = enc
+		if variableSized[i] {
+			fixedSize += 4
+		} else {
+			fixedSize += len(enc)
+		}
+	}
+	var fixed, variable []byte
+	for i, enc := range encodings {
+		if !variableSized[i] {
+			fixed = append(fixed, enc...)
+			continue
+		}
+		fixed = append(fixed, make([]byte, 4)...)
+		binary.LittleEndian.PutUint32(fixed[len(fixed)-4:], uint32(fixedSize+len(variable)))
+		variable = append(variable, enc...)
+	}
+	wanted, err := testState.MarshalSSZ()
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, append(fixed, variable...))
+
+	_, err = testState.FieldSSZ(fieldCount)
+	assert.ErrorContains(t, "invalid field index", err)
+}
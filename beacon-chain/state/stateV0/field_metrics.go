@@ -0,0 +1,89 @@
+// +build state_field_metrics
+
+package stateV0
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// numFields is the number of fields of the beacon state, across all forks.
+const numFields = int(currentEpochParticipationBits) + 1
+
+// fieldOperationCounts holds the number of operations performed on each field of every
+// beacon state of the node. Counters are only ever incremented atomically, which keeps
+// the overhead of instrumenting the getters and setters of the state negligible.
+var fieldOperationCounts [numFieldOperations][numFields]uint64
+
+var fieldOperationsDesc = prometheus.NewDesc(
+	"beacon_state_field_operations_total",
+	"Count the number of operations performed on each field of the beacon state",
+	[]string{"field", "operation"},
+	nil,
+)
+
+func init() {
+	prometheus.MustRegister(fieldMetricsCollector{})
+}
+
+// recordFieldOperation increments the counter of the operation on the given field.
+func recordFieldOperation(op fieldOperation, field fieldIndex) {
+	atomic.AddUint64(&fieldOperationCounts[op][field], 1)
+}
+
+// HotFields returns the operation counts of every field of the beacon state which has
+// been operated on, sorted from the most to the least operated on field. Copies and
+// rehashes are weighted above plain accesses and mutations as they are far costlier.
+func HotFields() []*FieldMetrics {
+	fields := make([]*FieldMetrics, 0, numFields)
+	for i := 0; i < numFields; i++ {
+		m := &FieldMetrics{
+			Field:     fieldIndex(i).String(),
+			Accesses:  atomic.LoadUint64(&fieldOperationCounts[fieldAccess][i]),
+			Mutations: atomic.LoadUint64(&fieldOperationCounts[fieldMutation][i]),
+			Copies:    atomic.LoadUint64(&fieldOperationCounts[fieldCopy][i]),
+			Rehashes:  atomic.LoadUint64(&fieldOperationCounts[fieldRehash][i]),
+		}
+		if m.Accesses+m.Mutations+m.Copies+m.Rehashes == 0 {
+			continue
+		}
+		fields = append(fields, m)
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		if fields[i].Copies+fields[i].Rehashes != fields[j].Copies+fields[j].Rehashes {
+			return fields[i].Copies+fields[i].Rehashes > fields[j].Copies+fields[j].Rehashes
+		}
+		return fields[i].Accesses+fields[i].Mutations > fields[j].Accesses+fields[j].Mutations
+	})
+	return fields
+}
+
+// fieldMetricsCollector exposes the operation counts of the fields of the beacon state
+// to prometheus, reading the counters at collection time.
+type fieldMetricsCollector struct{}
+
+// Describe implements prometheus.Collector.
+func (fieldMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- fieldOperationsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (fieldMetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	for op := fieldOperation(0); op < numFieldOperations; op++ {
+		for i := 0; i < numFields; i++ {
+			count := atomic.LoadUint64(&fieldOperationCounts[op][i])
+			if count == 0 {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(
+				fieldOperationsDesc,
+				prometheus.CounterValue,
+				float64(count),
+				fieldIndex(i).String(),
+				op.String(),
+			)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// +build !state_field_metrics
+
+package stateV0
+
+// recordFieldOperation is a no-op unless the node is built with the state_field_metrics tag.
+func recordFieldOperation(_ fieldOperation, _ fieldIndex) {}
+
+// HotFields returns no fields unless the node is built with the state_field_metrics tag.
+func HotFields() []*FieldMetrics {
+	return nil
+}
@@ -0,0 +1,79 @@
+// +build state_field_metrics
+
+package stateV0_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func randaoMixesMetrics() stateV0.FieldMetrics {
+	for _, m := range stateV0.HotFields() {
+		if m.Field == "randaoMixes" {
+			return *m
+		}
+	}
+	return stateV0.FieldMetrics{Field: "randaoMixes"}
+}
+
+func TestFieldMetrics(t *testing.T) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	_, err = st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	before := randaoMixesMetrics()
+
+	copied := st.Copy()
+	_ = st.RandaoMixes()
+	require.NoError(t, copied.UpdateRandaoMixesAtIndex(0, make([]byte, 32)))
+	_, err = copied.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	after := randaoMixesMetrics()
+	assert.Equal(t, before.Accesses+1, after.Accesses)
+	assert.Equal(t, before.Mutations+1, after.Mutations)
+	assert.Equal(t, before.Copies+1, after.Copies)
+	assert.Equal(t, before.Rehashes+1, after.Rehashes)
+}
+
+func TestHotFields(t *testing.T) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(1))
+
+	fields := stateV0.HotFields()
+	require.NotEqual(t, 0, len(fields))
+	for i := 1; i < len(fields); i++ {
+		prev, curr := fields[i-1], fields[i]
+		assert.Equal(t, true, prev.Copies+prev.Rehashes >= curr.Copies+curr.Rehashes, "Fields are not sorted")
+	}
+
+	rec := httptest.NewRecorder()
+	stateV0.FieldMetricsHandler(rec, httptest.NewRequest("GET", "/state/fields", nil))
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, true, strings.Contains(rec.Body.String(), "slot"))
+}
+
+func TestFieldMetrics_Prometheus(t *testing.T) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(1))
+
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() == "beacon_state_field_operations_total" {
+			assert.NotEqual(t, 0, len(f.GetMetric()))
+			return
+		}
+	}
+	t.Fatal("State field operation metrics were not registered")
+}
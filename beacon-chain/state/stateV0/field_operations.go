@@ -0,0 +1,70 @@
+package stateV0
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+)
+
+// fieldOperation is an operation performed on a field of the beacon state.
+type fieldOperation int
+
+const (
+	// fieldAccess is a read of the field through a getter.
+	fieldAccess fieldOperation = iota
+	// fieldMutation is a write of the field through a setter.
+	fieldMutation
+	// fieldCopy is a copy of the field, or of its trie, made before writing to a field shared
+	// with other states.
+	fieldCopy
+	// fieldRehash is a recomputation of the root of a dirty field.
+	fieldRehash
+	numFieldOperations
+)
+
+// String returns the name of the field operation.
+func (o fieldOperation) String() string {
+	switch o {
+	case fieldAccess:
+		return "access"
+	case fieldMutation:
+		return "mutation"
+	case fieldCopy:
+		return "copy"
+	case fieldRehash:
+		return "rehash"
+	default:
+		return ""
+	}
+}
+
+// FieldMetrics holds the number of operations performed on a field of the beacon state.
+type FieldMetrics struct {
+	Field     string
+	Accesses  uint64
+	Mutations uint64
+	Copies    uint64
+	Rehashes  uint64
+}
+
+// FieldMetricsHandler reports the operation counts of the fields of the beacon state,
+// hottest fields first. No fields are reported unless the node is built with the
+// state_field_metrics tag.
+func FieldMetricsHandler(w http.ResponseWriter, _ *http.Request) {
+	buf := new(bytes.Buffer)
+	if _, err := fmt.Fprintf(buf, "%-32s %16s %16s %16s %16s\n", "field", "copies", "rehashes", "mutations", "accesses"); err != nil {
+		log.WithError(err).Error("Failed to render state field metrics page")
+		return
+	}
+	for _, m := range HotFields() {
+		if _, err := fmt.Fprintf(buf, "%-32s %16d %16d %16d %16d\n", m.Field, m.Copies, m.Rehashes, m.Mutations, m.Accesses); err != nil {
+			log.WithError(err).Error("Failed to render state field metrics page")
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.WithError(err).Error("Failed to render state field metrics page")
+	}
+}
@@ -0,0 +1,119 @@
+package stateV0
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/shared/mputil"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// fieldRootJob recomputes the root of a field which is expensive to hash. A job only reads
+// the state and writes to the field trie it owns, so jobs of different fields can run
+// concurrently. Updates to the shared maps of the state are made by applyFieldRootJob once
+// the job is done.
+type fieldRootJob struct {
+	field    fieldIndex
+	elements interface{}
+	length   uint64
+	rebuild  bool
+	trie     *FieldTrie
+	indices  []uint64
+
+	root [32]byte
+	err  error
+}
+
+// newFieldRootJob returns a job recomputing the root of the given field, or false if the
+// field is cheap enough to be hashed directly.
+func (b *BeaconState) newFieldRootJob(field fieldIndex) (*fieldRootJob, bool) {
+	job := &fieldRootJob{
+		field:   field,
+		rebuild: b.rebuildTrie[field],
+		trie:    b.stateFieldLeaves[field],
+		indices: b.dirtyIndices[field],
+	}
+	switch field {
+	case blockRoots:
+		job.elements = b.state.BlockRoots
+	case stateRoots:
+		job.elements = b.state.StateRoots
+	case eth1DataVotes:
+		job.elements = b.state.Eth1DataVotes
+	case validators:
+		job.elements = b.state.Validators
+	case balances:
+		job.elements = b.state.Balances
+	case randaoMixes:
+		job.elements = b.state.RandaoMixes
+	case previousEpochAttestations:
+		job.elements = b.state.PreviousEpochAttestations
+	case currentEpochAttestations:
+		job.elements = b.state.CurrentEpochAttestations
+	default:
+		return nil, false
+	}
+	job.length, _ = fieldTrieLimit(field)
+	return job, true
+}
+
+func (j *fieldRootJob) run() {
+	if j.rebuild {
+		fTrie, err := NewFieldTrie(j.field, j.elements, j.length)
+		if err != nil {
+			j.err = err
+			return
+		}
+		j.trie = fTrie
+		j.root, j.err = fTrie.TrieRoot()
+		return
+	}
+	if j.trie.reference.Refs() > 1 {
+		recordFieldOperation(fieldCopy, j.field)
+		j.trie.Lock()
+		j.trie.reference.MinusRef()
+		newTrie := j.trie.CopyTrie()
+		j.trie.Unlock()
+		j.trie = newTrie
+	}
+	// Remove duplicate indices and sort them again.
+	indices := sliceutil.SetUint64(j.indices)
+	sort.Slice(indices, func(i int, k int) bool {
+		return indices[i] < indices[k]
+	})
+	j.indices = indices
+	j.root, j.err = j.trie.RecomputeTrie(indices, j.elements)
+}
+
+// applyFieldRootJob stores the field trie of a finished job in the state and clears the
+// dirty indices of its field if the root was computed successfully.
+func (b *BeaconState) applyFieldRootJob(j *fieldRootJob) error {
+	if j.trie != nil {
+		b.stateFieldLeaves[j.field] = j.trie
+	}
+	if j.err != nil {
+		return j.err
+	}
+	b.dirtyIndices[j.field] = []uint64{}
+	delete(b.rebuildTrie, j.field)
+	return nil
+}
+
+// runFieldRootJobs runs the given jobs concurrently, with at most one worker per processor.
+func runFieldRootJobs(jobs []*fieldRootJob) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+	if len(jobs) == 1 {
+		jobs[0].run()
+		return nil
+	}
+	// Errors are recorded in the jobs themselves, so workers never fail.
+	_, err := mputil.Scatter(len(jobs), func(offset int, entries int, _ *sync.RWMutex) (interface{}, error) {
+		for _, j := range jobs[offset : offset+entries] {
+			j.run()
+		}
+		return nil, nil
+	})
+	return err
+}
@@ -1,6 +1,7 @@
 package stateV0
 
 import (
+	"encoding/binary"
 	"fmt"
 	"reflect"
 	"sync"
@@ -10,7 +11,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
 )
 
 // FieldTrie is the representation of the representative
@@ -20,11 +23,13 @@ type FieldTrie struct {
 	reference   *stateutil.Reference
 	fieldLayers [][]*[32]byte
 	field       fieldIndex
+	numOfElems  int
 }
 
 // NewFieldTrie is the constructor for the field trie data structure. It creates the corresponding
 // trie according to the given parameters. Depending on whether the field is a basic/composite array
-// which is either fixed/variable length, it will appropriately determine the trie.
+// which is either fixed/variable length, it will appropriately determine the trie. For compressed
+// arrays, the length is the maximum number of elements rather than of chunks.
 func NewFieldTrie(field fieldIndex, elements interface{}, length uint64) (*FieldTrie, error) {
 	if elements == nil {
 		return &FieldTrie{
@@ -56,6 +61,18 @@ func NewFieldTrie(field fieldIndex, elements interface{}, length uint64) (*Field
 			reference:   stateutil.NewRef(1),
 			RWMutex:     new(sync.RWMutex),
 		}, nil
+	case compressedArray:
+		numOfElems, err := compressedLength(elements)
+		if err != nil {
+			return nil, err
+		}
+		return &FieldTrie{
+			fieldLayers: stateutil.ReturnTrieLayerVariable(fieldRoots, compressedChunkLimit(field, length)),
+			field:       field,
+			reference:   stateutil.NewRef(1),
+			RWMutex:     new(sync.RWMutex),
+			numOfElems:  numOfElems,
+		}, nil
 	default:
 		return nil, errors.Errorf("unrecognized data type in field map: %v", reflect.TypeOf(datType).Name())
 	}
@@ -64,7 +81,8 @@ func NewFieldTrie(field fieldIndex, elements interface{}, length uint64) (*Field
 
 // RecomputeTrie rebuilds the affected branches in the trie according to the provided
 // changed indices and elements. This recomputes the trie according to the particular
-// field the trie is based on.
+// field the trie is based on. For compressed arrays, the indices are those of the changed
+// chunks rather than of the changed elements.
 func (f *FieldTrie) RecomputeTrie(indices []uint64, elements interface{}) ([32]byte, error) {
 	f.Lock()
 	defer f.Unlock()
@@ -88,17 +106,55 @@ func (f *FieldTrie) RecomputeTrie(indices []uint64, elements interface{}) ([32]b
 		}
 		return fieldRoot, nil
 	case compositeArray:
+		// Elements appended to the list, such as pending attestations, are hashed into the right
+		// edge of the trie together rather than one branch at a time.
+		split := appendedIndicesStart(indices, uint64(len(f.fieldLayers[0])))
+		if split > 0 {
+			fieldRoot, f.fieldLayers, err = stateutil.RecomputeFromLayerVariable(fieldRoots[:split], indices[:split], f.fieldLayers)
+			if err != nil {
+				return [32]byte{}, err
+			}
+		}
+		if split < len(indices) {
+			fieldRoot, f.fieldLayers, err = stateutil.AppendToLayerVariable(fieldRoots[split:], f.fieldLayers)
+			if err != nil {
+				return [32]byte{}, err
+			}
+		}
+		return stateutil.AddInMixin(fieldRoot, uint64(len(f.fieldLayers[0])))
+	case compressedArray:
+		numOfElems, err := compressedLength(elements)
+		if err != nil {
+			return [32]byte{}, err
+		}
 		fieldRoot, f.fieldLayers, err = stateutil.RecomputeFromLayerVariable(fieldRoots, indices, f.fieldLayers)
 		if err != nil {
 			return [32]byte{}, err
 		}
-		return stateutil.AddInMixin(fieldRoot, uint64(len(f.fieldLayers[0])))
+		f.numOfElems = numOfElems
+		return stateutil.AddInMixin(fieldRoot, uint64(numOfElems))
 	default:
 		return [32]byte{}, errors.Errorf("unrecognized data type in field map: %v", reflect.TypeOf(datType).Name())
 	}
 
 }
 
+// appendedIndicesStart returns the position of the first of the sorted indices which appends
+// an element to a trie with the given number of leaves. The indices from that position on must
+// be contiguous, otherwise no index is considered appended.
+func appendedIndicesStart(indices []uint64, numOfLeaves uint64) int {
+	split := len(indices)
+	for split > 0 && indices[split-1] >= numOfLeaves {
+		split--
+	}
+	for i, idx := range indices[split:] {
+		if idx != numOfLeaves+uint64(i) {
+			return len(indices)
+		}
+	}
+	return split
+}
+
 // CopyTrie copies the references to the elements the trie
 // is built on.
 func (f *FieldTrie) CopyTrie() *FieldTrie {
@@ -111,7 +167,11 @@ func (f *FieldTrie) CopyTrie() *FieldTrie {
 	}
 	dstFieldTrie := make([][]*[32]byte, len(f.fieldLayers))
 	for i, layer := range f.fieldLayers {
-		dstFieldTrie[i] = make([]*[32]byte, len(layer))
+		if featureconfig.Get().EnableFieldTriePooling {
+			dstFieldTrie[i] = getLayer(len(layer))
+		} else {
+			dstFieldTrie[i] = make([]*[32]byte, len(layer))
+		}
 		copy(dstFieldTrie[i], layer)
 	}
 	return &FieldTrie{
@@ -119,6 +179,7 @@ func (f *FieldTrie) CopyTrie() *FieldTrie {
 		field:       f.field,
 		reference:   stateutil.NewRef(1),
 		RWMutex:     new(sync.RWMutex),
+		numOfElems:  f.numOfElems,
 	}
 }
 
@@ -134,11 +195,84 @@ func (f *FieldTrie) TrieRoot() ([32]byte, error) {
 	case compositeArray:
 		trieRoot := *f.fieldLayers[len(f.fieldLayers)-1][0]
 		return stateutil.AddInMixin(trieRoot, uint64(len(f.fieldLayers[0])))
+	case compressedArray:
+		trieRoot := *f.fieldLayers[len(f.fieldLayers)-1][0]
+		return stateutil.AddInMixin(trieRoot, uint64(f.numOfElems))
 	default:
 		return [32]byte{}, errors.Errorf("unrecognized data type in field map: %v", reflect.TypeOf(datType).Name())
 	}
 }
 
+// MerkleProof returns the Merkle branch of the leaf at the given index, ordered from the leaf
+// up to the root of the trie. For compressed arrays the index is the index of a chunk. The
+// branch of a list ends with its length mixin, so it proves the leaf against TrieRoot.
+func (f *FieldTrie) MerkleProof(index uint64) ([][]byte, error) {
+	f.RLock()
+	defer f.RUnlock()
+	if len(f.fieldLayers) == 0 || len(f.fieldLayers[0]) == 0 {
+		return nil, errors.New("empty field trie")
+	}
+	if index >= uint64(len(f.fieldLayers[0])) {
+		return nil, fmt.Errorf("index %d out of range of trie with %d leaves", index, len(f.fieldLayers[0]))
+	}
+	proof, err := f.branch(0, index)
+	if err != nil {
+		return nil, err
+	}
+	mixin, ok, err := f.lengthMixin()
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		proof = append(proof, mixin[:])
+	}
+	return proof, nil
+}
+
+// branch returns the siblings of the node at the given layer and index, up to the root of
+// the trie without the length mixin. The caller MUST hold the lock of the trie.
+func (f *FieldTrie) branch(layer int, index uint64) ([][]byte, error) {
+	depth := len(f.fieldLayers) - 1
+	if layer > depth {
+		return nil, fmt.Errorf("layer %d greater than trie depth %d", layer, depth)
+	}
+	proof := make([][]byte, 0, depth-layer)
+	for i := layer; i < depth; i++ {
+		siblingIdx := index ^ 1
+		// Siblings past the end of a variable sized trie are roots of empty subtries.
+		sibling := trieutil.ZeroHashes[i]
+		if siblingIdx < uint64(len(f.fieldLayers[i])) {
+			sibling = *f.fieldLayers[i][siblingIdx]
+		}
+		proof = append(proof, sibling[:])
+		index /= 2
+	}
+	return proof, nil
+}
+
+// lengthMixin returns the chunk mixed in with the root of the trie, or false if the field
+// is a vector. The caller MUST hold the lock of the trie.
+func (f *FieldTrie) lengthMixin() ([32]byte, bool, error) {
+	datType, ok := fieldMap[f.field]
+	if !ok {
+		return [32]byte{}, false, errors.Errorf("unrecognized field in trie")
+	}
+	var length uint64
+	switch datType {
+	case basicArray:
+		return [32]byte{}, false, nil
+	case compositeArray:
+		length = uint64(len(f.fieldLayers[0]))
+	case compressedArray:
+		length = uint64(f.numOfElems)
+	default:
+		return [32]byte{}, false, errors.Errorf("unrecognized data type in field map: %v", reflect.TypeOf(datType).Name())
+	}
+	var mixin [32]byte
+	binary.LittleEndian.PutUint64(mixin[:8], length)
+	return mixin, true, nil
+}
+
 // this converts the corresponding field and the provided elements to the appropriate roots.
 func fieldConverters(field fieldIndex, indices []uint64, elements interface{}, convertAll bool) ([][32]byte, error) {
 	switch field {
@@ -170,6 +304,20 @@ func fieldConverters(field fieldIndex, indices []uint64, elements interface{}, c
 				reflect.TypeOf([]*pb.PendingAttestation{}).Name(), reflect.TypeOf(elements).Name())
 		}
 		return handlePendingAttestation(val, indices, convertAll)
+	case previousEpochParticipationBits, currentEpochParticipationBits:
+		val, ok := elements.([]byte)
+		if !ok {
+			return nil, errors.Errorf("Wanted type of %v but got %v",
+				reflect.TypeOf([]byte{}).Name(), reflect.TypeOf(elements).Name())
+		}
+		return handleParticipationBits(val, indices, convertAll)
+	case balances, inactivityScores:
+		val, ok := elements.([]uint64)
+		if !ok {
+			return nil, errors.Errorf("Wanted type of %v but got %v",
+				reflect.TypeOf([]uint64{}).Name(), reflect.TypeOf(elements).Name())
+		}
+		return handleUint64Slice(val, indices, convertAll)
 	default:
 		return [][32]byte{}, errors.Errorf("got unsupported type of %v", reflect.TypeOf(elements).Name())
 	}
@@ -315,3 +463,81 @@ func handlePendingAttestation(val []*pb.PendingAttestation, indices []uint64, co
 	}
 	return roots, nil
 }
+
+// handleParticipationBits packs participation flags into chunks. The indices are chunk indices.
+func handleParticipationBits(val []byte, indices []uint64, convertAll bool) ([][32]byte, error) {
+	numOfChunks := (uint64(len(val)) + 31) / 32
+	if convertAll {
+		indices = make([]uint64, numOfChunks)
+		for i := range indices {
+			indices[i] = uint64(i)
+		}
+	}
+	roots := make([][32]byte, 0, len(indices))
+	for _, idx := range indices {
+		if idx >= numOfChunks {
+			return nil, fmt.Errorf("chunk index %d greater than number of participation chunks %d", idx, numOfChunks)
+		}
+		var chunk [32]byte
+		copy(chunk[:], val[idx*32:])
+		roots = append(roots, chunk)
+	}
+	return roots, nil
+}
+
+// handleUint64Slice packs uint64 values into chunks. The indices are chunk indices.
+func handleUint64Slice(val []uint64, indices []uint64, convertAll bool) ([][32]byte, error) {
+	numOfChunks := (uint64(len(val)) + 3) / 4
+	if convertAll {
+		indices = make([]uint64, numOfChunks)
+		for i := range indices {
+			indices[i] = uint64(i)
+		}
+	}
+	roots := make([][32]byte, 0, len(indices))
+	for _, idx := range indices {
+		if idx >= numOfChunks {
+			return nil, fmt.Errorf("chunk index %d greater than number of uint64 chunks %d", idx, numOfChunks)
+		}
+		var chunk [32]byte
+		for i := uint64(0); i < 4 && idx*4+i < uint64(len(val)); i++ {
+			binary.LittleEndian.PutUint64(chunk[i*8:], val[idx*4+i])
+		}
+		roots = append(roots, chunk)
+	}
+	return roots, nil
+}
+
+// compressedElemSize returns the size in bytes of the elements of a compressed array field.
+func compressedElemSize(field fieldIndex) uint64 {
+	switch field {
+	case balances, inactivityScores:
+		return 8
+	default:
+		return 1
+	}
+}
+
+// compressedChunkLimit returns the maximum number of chunks of a compressed array field
+// holding at most limit elements.
+func compressedChunkLimit(field fieldIndex, limit uint64) uint64 {
+	return (limit*compressedElemSize(field) + 31) / 32
+}
+
+// compressedChunkIndex returns the index of the chunk holding the given element of a
+// compressed array field.
+func compressedChunkIndex(field fieldIndex, index uint64) uint64 {
+	return index * compressedElemSize(field) / 32
+}
+
+// compressedLength returns the number of elements of a compressed array.
+func compressedLength(elements interface{}) (int, error) {
+	switch val := elements.(type) {
+	case []byte:
+		return len(val), nil
+	case []uint64:
+		return len(val), nil
+	default:
+		return 0, errors.Errorf("got unsupported type of %v", reflect.TypeOf(elements).Name())
+	}
+}
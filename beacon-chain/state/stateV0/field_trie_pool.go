@@ -0,0 +1,53 @@
+package stateV0
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// layerPools hold the released layers of field tries, bucketed by capacity. Bucket i holds
+// layers with a capacity of exactly 1<<i, so that a pooled layer can serve any request of
+// a length up to its capacity.
+var layerPools [64]sync.Pool
+
+// getLayer returns a layer of the given length, reusing a released layer if one is available.
+// The contents of the returned layer are always overwritten by the caller.
+func getLayer(length int) []*[32]byte {
+	if length == 0 {
+		return []*[32]byte{}
+	}
+	bucket := bits.Len(uint(length - 1))
+	if l, ok := layerPools[bucket].Get().(*[]*[32]byte); ok {
+		return (*l)[:length]
+	}
+	return make([]*[32]byte, length, 1<<bucket)
+}
+
+// putLayer releases a layer which is no longer referenced by any trie. Layers which were
+// grown past their pooled capacity are left to the garbage collector.
+func putLayer(layer []*[32]byte) {
+	c := cap(layer)
+	if c == 0 || c&(c-1) != 0 {
+		return
+	}
+	// Clear the layer so that it does not keep the nodes of the released trie alive.
+	layer = layer[:c]
+	for i := range layer {
+		layer[i] = nil
+	}
+	layerPools[bits.Len(uint(c-1))].Put(&layer)
+}
+
+// releaseLayers hands the layers of the trie back to the pool once no state references the
+// trie anymore. The trie must not be used after its layers are released.
+func (f *FieldTrie) releaseLayers() {
+	if f.reference.Refs() != 0 {
+		return
+	}
+	f.Lock()
+	defer f.Unlock()
+	for _, layer := range f.fieldLayers {
+		putLayer(layer)
+	}
+	f.fieldLayers = nil
+}
@@ -0,0 +1,70 @@
+package stateV0
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGetLayer_PutLayer(t *testing.T) {
+	layer := getLayer(5)
+	assert.Equal(t, 5, len(layer))
+	assert.Equal(t, 8, cap(layer))
+	for i := range layer {
+		layer[i] = &[32]byte{byte(i)}
+	}
+	putLayer(layer)
+	for i, node := range layer {
+		assert.Equal(t, (*[32]byte)(nil), node, "Node %d of released layer was not cleared", i)
+	}
+
+	assert.Equal(t, 0, len(getLayer(0)))
+	assert.Equal(t, 1, cap(getLayer(1)))
+}
+
+func TestFieldTrie_CopyTrie_Pooling(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableFieldTriePooling: true})
+	defer resetCfg()
+
+	mixes := make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector)
+	for i := range mixes {
+		mixes[i] = make([]byte, 32)
+		mixes[i][0] = byte(i)
+	}
+	length := uint64(params.BeaconConfig().EpochsPerHistoricalVector)
+	trie, err := NewFieldTrie(randaoMixes, mixes, length)
+	require.NoError(t, err)
+	root, err := trie.TrieRoot()
+	require.NoError(t, err)
+
+	// A trie which is still referenced keeps its layers.
+	newTrie := trie.CopyTrie()
+	trie.reference.AddRef()
+	trie.reference.MinusRef()
+	trie.releaseLayers()
+	require.NotEqual(t, 0, len(trie.fieldLayers))
+
+	trie.reference.MinusRef()
+	trie.releaseLayers()
+	assert.Equal(t, 0, len(trie.fieldLayers))
+	newRoot, err := newTrie.TrieRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, newRoot)
+
+	// Copies built from pooled layers are hashed like any other trie.
+	anotherTrie := newTrie.CopyTrie()
+	mixes[7] = []byte{'A', 'B', 'C'}
+	recomputedRoot, err := anotherTrie.RecomputeTrie([]uint64{7}, mixes)
+	require.NoError(t, err)
+	wantedTrie, err := NewFieldTrie(randaoMixes, mixes, length)
+	require.NoError(t, err)
+	wantedRoot, err := wantedTrie.TrieRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantedRoot, recomputedRoot)
+	newRoot, err = newTrie.TrieRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, newRoot)
+}
@@ -1,11 +1,16 @@
 package stateV0_test
 
 import (
+	"encoding/binary"
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -75,3 +80,65 @@ func TestFieldTrie_CopyTrieImmutable(t *testing.T) {
 		t.Errorf("Wanted roots to be different, but they are the same: %#x", root)
 	}
 }
+
+func TestFieldTrie_InactivityScores(t *testing.T) {
+	scores := make([]uint64, 37)
+	for i := range scores {
+		scores[i] = uint64(i * 3)
+	}
+	// 21 represents the enum value of inactivity scores
+	trie, err := stateV0.NewFieldTrie(21, scores, params.BeaconConfig().ValidatorRegistryLimit)
+	require.NoError(t, err)
+	root, err := trie.TrieRoot()
+	require.NoError(t, err)
+	wanted, err := stateutil.ValidatorBalancesRoot(scores)
+	require.NoError(t, err)
+	assert.Equal(t, wanted, root)
+
+	scores[5] = 100
+	scores = append(scores, 7, 8, 9, 10)
+	// Scores are packed four to a chunk, so the changed chunks are 1, 9 and 10.
+	root, err = trie.RecomputeTrie([]uint64{1, 9, 10}, scores)
+	require.NoError(t, err)
+	wanted, err = stateutil.ValidatorBalancesRoot(scores)
+	require.NoError(t, err)
+	assert.Equal(t, wanted, root)
+}
+
+func TestFieldTrie_ParticipationBits(t *testing.T) {
+	limit := params.BeaconConfig().ValidatorRegistryLimit
+	participationRoot := func(bits []byte) [32]byte {
+		chunks, err := htrutils.Pack([][]byte{bits})
+		require.NoError(t, err)
+		root, err := htrutils.BitwiseMerkleize(hashutil.CustomSHA256Hasher(), chunks, uint64(len(chunks)), (limit+31)/32)
+		require.NoError(t, err)
+		length := make([]byte, 32)
+		binary.LittleEndian.PutUint64(length, uint64(len(bits)))
+		return htrutils.MixInLength(root, length)
+	}
+	bits := make([]byte, 70)
+	for i := range bits {
+		bits[i] = byte(i % 8)
+	}
+	// 24 represents the enum value of previous epoch participation
+	trie, err := stateV0.NewFieldTrie(24, bits, limit)
+	require.NoError(t, err)
+	root, err := trie.TrieRoot()
+	require.NoError(t, err)
+	assert.Equal(t, participationRoot(bits), root)
+
+	bits[2] = 7
+	bits[65] = 7
+	bits = append(bits, 1)
+	// Flags are packed 32 to a chunk, so the changed chunks are 0 and 2.
+	root, err = trie.RecomputeTrie([]uint64{0, 2}, bits)
+	require.NoError(t, err)
+	assert.Equal(t, participationRoot(bits), root)
+}
+
+func TestFieldTrie_SyncCommitteeNotSupported(t *testing.T) {
+	pubkeys := [][]byte{bytesutil.PadTo([]byte{1}, 48)}
+	// 22 represents the enum value of the current sync committee
+	_, err := stateV0.NewFieldTrie(22, pubkeys, uint64(len(pubkeys)))
+	assert.ErrorContains(t, "unrecognized field in trie", err)
+}
@@ -0,0 +1,183 @@
+package stateV0
+
+import (
+	"math/bits"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// FieldGeneralizedIndex returns the generalized index of the root of the named field
+// in the state trie.
+func FieldGeneralizedIndex(name string) (uint64, error) {
+	field, err := fieldByName(name)
+	if err != nil {
+		return 0, err
+	}
+	return fieldGeneralizedIndex(field), nil
+}
+
+// ElementGeneralizedIndex returns the generalized index of the element at the given index of
+// the named field in the state trie. Only fields backed by a field trie have addressable
+// elements. The elements of a compressed array, such as balances, are packed into chunks,
+// so all elements of a chunk share the generalized index of the chunk.
+func ElementGeneralizedIndex(name string, index uint64) (uint64, error) {
+	field, err := fieldByName(name)
+	if err != nil {
+		return 0, err
+	}
+	limit, ok := fieldTrieLimit(field)
+	if !ok {
+		return 0, errors.Errorf("field %s is not backed by a field trie", name)
+	}
+	if index >= limit {
+		return 0, errors.Errorf("index %d out of range of field %s with limit %d", index, name, limit)
+	}
+	dataRoot, depth := fieldDataRoot(field)
+	if fieldMap[field] == compressedArray {
+		index = compressedChunkIndex(field, index)
+	}
+	return dataRoot<<depth + index, nil
+}
+
+// FieldAtGeneralizedIndex returns the name of the field holding the node at the given
+// generalized index of the state trie. If the node is an element of a field backed by a
+// field trie, the index of the element is returned as well. For compressed arrays the
+// index is the one of the first element of the chunk.
+func FieldAtGeneralizedIndex(generalizedIndex uint64) (name string, index uint64, isElement bool, err error) {
+	if generalizedIndex == 0 {
+		return "", 0, false, errors.New("generalized index must be greater than 0")
+	}
+	stateDepth := stateTrieDepth()
+	pathLength := uint8(bits.Len64(generalizedIndex) - 1)
+	if pathLength < stateDepth {
+		return "", 0, false, errors.Errorf("generalized index %d is not within a field", generalizedIndex)
+	}
+	field := fieldIndex(generalizedIndex>>(pathLength-stateDepth) - 1<<stateDepth)
+	if int(field) >= params.BeaconConfig().BeaconStateFieldCount {
+		return "", 0, false, errors.Errorf("generalized index %d is past the fields of the state", generalizedIndex)
+	}
+	if pathLength == stateDepth {
+		return field.String(), 0, false, nil
+	}
+	if _, ok := fieldTrieLimit(field); !ok {
+		return "", 0, false, errors.Errorf("field %s is not backed by a field trie", field.String())
+	}
+	dataRoot, depth := fieldDataRoot(field)
+	dataRootPathLength := uint8(bits.Len64(dataRoot) - 1)
+	if pathLength > dataRootPathLength && generalizedIndex>>(pathLength-dataRootPathLength) != dataRoot {
+		return "", 0, false, errors.Errorf("length of field %s has no children", field.String())
+	}
+	if pathLength > dataRootPathLength+depth {
+		return "", 0, false, errors.Errorf("generalized index %d goes past the leaves of field %s", generalizedIndex, field.String())
+	}
+	// Intermediate nodes of the field trie and the length of lists are not elements.
+	if pathLength < dataRootPathLength+depth {
+		return field.String(), 0, false, nil
+	}
+	index = generalizedIndex - dataRoot<<depth
+	if fieldMap[field] == compressedArray {
+		index = index * 32 / compressedElemSize(field)
+	}
+	return field.String(), index, true, nil
+}
+
+// Node returns the node at the given generalized index, relative to the root of the trie
+// as returned by TrieRoot. For lists, generalized index 2 is the root of the data of the
+// list and 3 is its length mixin. Nodes past the end of a variable sized trie are roots of
+// empty subtries.
+func (f *FieldTrie) Node(generalizedIndex uint64) ([32]byte, error) {
+	if generalizedIndex == 0 {
+		return [32]byte{}, errors.New("generalized index must be greater than 0")
+	}
+	f.RLock()
+	defer f.RUnlock()
+	if len(f.fieldLayers) == 0 {
+		return [32]byte{}, errors.New("empty field trie")
+	}
+	if generalizedIndex == 1 {
+		return f.TrieRoot()
+	}
+	mixin, isList, err := f.lengthMixin()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if isList {
+		pathLength := bits.Len64(generalizedIndex) - 1
+		if generalizedIndex == 3 {
+			return mixin, nil
+		}
+		if generalizedIndex>>(pathLength-1) != 2 {
+			return [32]byte{}, errors.Errorf("length of field %s has no children", f.field.String())
+		}
+		generalizedIndex -= 1 << (pathLength - 1)
+	}
+	depth := len(f.fieldLayers) - 1
+	pathLength := bits.Len64(generalizedIndex) - 1
+	if pathLength > depth {
+		return [32]byte{}, errors.Errorf("generalized index goes past the leaves of field %s", f.field.String())
+	}
+	layer := depth - pathLength
+	index := generalizedIndex - 1<<pathLength
+	if index >= uint64(len(f.fieldLayers[layer])) {
+		return trieutil.ZeroHashes[layer], nil
+	}
+	return *f.fieldLayers[layer][index], nil
+}
+
+// fieldByName returns the field of the state with the given name.
+func fieldByName(name string) (fieldIndex, error) {
+	for i := 0; i < params.BeaconConfig().BeaconStateFieldCount; i++ {
+		if fieldIndex(i).String() == name {
+			return fieldIndex(i), nil
+		}
+	}
+	return 0, errors.Errorf("unknown field %s", name)
+}
+
+// stateTrieDepth returns the depth of the trie of the fields of the state.
+func stateTrieDepth() uint8 {
+	return htrutils.Depth(uint64(params.BeaconConfig().BeaconStateFieldCount))
+}
+
+// fieldGeneralizedIndex returns the generalized index of the root of the field in the state trie.
+func fieldGeneralizedIndex(field fieldIndex) uint64 {
+	return 1<<stateTrieDepth() + uint64(field)
+}
+
+// fieldTrieLimit returns the maximum number of elements of a field backed by a field trie,
+// or false if the field is not backed by one.
+func fieldTrieLimit(field fieldIndex) (uint64, bool) {
+	cfg := params.BeaconConfig()
+	switch field {
+	case blockRoots, stateRoots:
+		return uint64(cfg.SlotsPerHistoricalRoot), true
+	case randaoMixes:
+		return uint64(cfg.EpochsPerHistoricalVector), true
+	case eth1DataVotes:
+		return uint64(cfg.SlotsPerEpoch.Mul(uint64(cfg.EpochsPerEth1VotingPeriod))), true
+	case validators, balances:
+		return cfg.ValidatorRegistryLimit, true
+	case previousEpochAttestations, currentEpochAttestations:
+		return uint64(cfg.SlotsPerEpoch.Mul(cfg.MaxAttestations)), true
+	default:
+		return 0, false
+	}
+}
+
+// fieldDataRoot returns the generalized index, in the state trie, of the root of the data of a
+// field backed by a field trie, along with the depth of the data below it. The data of a list
+// is the left child of the root of the field, whose right child is the length of the list.
+func fieldDataRoot(field fieldIndex) (uint64, uint8) {
+	limit, _ := fieldTrieLimit(field)
+	switch fieldMap[field] {
+	case compositeArray:
+		return fieldGeneralizedIndex(field) << 1, htrutils.Depth(limit)
+	case compressedArray:
+		return fieldGeneralizedIndex(field) << 1, htrutils.Depth(compressedChunkLimit(field, limit))
+	default:
+		return fieldGeneralizedIndex(field), htrutils.Depth(limit)
+	}
+}
@@ -0,0 +1,169 @@
+package stateV0_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestFieldGeneralizedIndex(t *testing.T) {
+	gIndex, err := stateV0.FieldGeneralizedIndex("slot")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(slotGeneralizedIndex), gIndex)
+	gIndex, err = stateV0.FieldGeneralizedIndex("validators")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(validatorsGeneralizedIndex), gIndex)
+
+	_, err = stateV0.FieldGeneralizedIndex("foo")
+	assert.ErrorContains(t, "unknown field foo", err)
+}
+
+func TestElementGeneralizedIndex(t *testing.T) {
+	validatorsDepth := htrutils.Depth(params.BeaconConfig().ValidatorRegistryLimit)
+	balancesDepth := htrutils.Depth(params.BeaconConfig().ValidatorRegistryLimit / 4)
+	tests := []struct {
+		name  string
+		field string
+		index uint64
+		want  uint64
+	}{
+		{
+			name:  "vector",
+			field: "blockRoots",
+			index: 3,
+			want:  uint64(blockRootsGeneralizedIndex)<<htrutils.Depth(uint64(params.BeaconConfig().SlotsPerHistoricalRoot)) + 3,
+		},
+		{
+			name:  "list",
+			field: "validators",
+			index: 7,
+			want:  uint64(validatorsGeneralizedIndex)<<(validatorsDepth+1) + 7,
+		},
+		{
+			name:  "compressed list",
+			field: "balances",
+			index: 5,
+			want:  uint64(32+12)<<(balancesDepth+1) + 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gIndex, err := stateV0.ElementGeneralizedIndex(tt.field, tt.index)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, gIndex)
+		})
+	}
+
+	_, err := stateV0.ElementGeneralizedIndex("slot", 0)
+	assert.ErrorContains(t, "not backed by a field trie", err)
+	_, err = stateV0.ElementGeneralizedIndex("blockRoots", uint64(params.BeaconConfig().SlotsPerHistoricalRoot))
+	assert.ErrorContains(t, "out of range", err)
+}
+
+func TestElementGeneralizedIndex_Proof(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	root, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	val, err := st.ValidatorAtIndex(9)
+	require.NoError(t, err)
+	valRoot, err := stateutil.ValidatorRootWithHasher(hashutil.CustomSHA256Hasher(), val)
+	require.NoError(t, err)
+
+	gIndex, err := stateV0.ElementGeneralizedIndex("validators", 9)
+	require.NoError(t, err)
+	proof, err := st.Proof(ctx, gIndex)
+	require.NoError(t, err)
+	verifyProof(t, root, valRoot, gIndex, proof)
+}
+
+func TestFieldAtGeneralizedIndex(t *testing.T) {
+	for _, field := range []string{"blockRoots", "validators", "balances"} {
+		gIndex, err := stateV0.ElementGeneralizedIndex(field, 8)
+		require.NoError(t, err)
+		name, index, isElement, err := stateV0.FieldAtGeneralizedIndex(gIndex)
+		require.NoError(t, err)
+		assert.Equal(t, field, name)
+		assert.Equal(t, uint64(8), index)
+		assert.Equal(t, true, isElement)
+	}
+
+	name, _, isElement, err := stateV0.FieldAtGeneralizedIndex(slotGeneralizedIndex)
+	require.NoError(t, err)
+	assert.Equal(t, "slot", name)
+	assert.Equal(t, false, isElement)
+
+	// Length of the validators list.
+	name, _, isElement, err = stateV0.FieldAtGeneralizedIndex(validatorsGeneralizedIndex<<1 + 1)
+	require.NoError(t, err)
+	assert.Equal(t, "validators", name)
+	assert.Equal(t, false, isElement)
+
+	// Intermediate node of the validators trie.
+	name, _, isElement, err = stateV0.FieldAtGeneralizedIndex(validatorsGeneralizedIndex << 3)
+	require.NoError(t, err)
+	assert.Equal(t, "validators", name)
+	assert.Equal(t, false, isElement)
+
+	_, _, _, err = stateV0.FieldAtGeneralizedIndex(0)
+	assert.ErrorContains(t, "greater than 0", err)
+	_, _, _, err = stateV0.FieldAtGeneralizedIndex(3)
+	assert.ErrorContains(t, "not within a field", err)
+	_, _, _, err = stateV0.FieldAtGeneralizedIndex(32 + 30)
+	assert.ErrorContains(t, "past the fields", err)
+	_, _, _, err = stateV0.FieldAtGeneralizedIndex(slotGeneralizedIndex << 1)
+	assert.ErrorContains(t, "not backed by a field trie", err)
+	_, _, _, err = stateV0.FieldAtGeneralizedIndex((validatorsGeneralizedIndex<<1 + 1) << 1)
+	assert.ErrorContains(t, "has no children", err)
+	_, _, _, err = stateV0.FieldAtGeneralizedIndex(uint64(blockRootsGeneralizedIndex) << 14)
+	assert.ErrorContains(t, "past the leaves", err)
+}
+
+func TestFieldTrie_Node(t *testing.T) {
+	newState, _ := testutil.DeterministicGenesisState(t, 40)
+
+	// 11 represents the enum value of validators
+	trie, err := stateV0.NewFieldTrie(11, newState.Validators(), params.BeaconConfig().ValidatorRegistryLimit)
+	require.NoError(t, err)
+	depth := htrutils.Depth(params.BeaconConfig().ValidatorRegistryLimit)
+
+	root, err := trie.TrieRoot()
+	require.NoError(t, err)
+	node, err := trie.Node(1)
+	require.NoError(t, err)
+	assert.Equal(t, root, node)
+
+	var length [32]byte
+	binary.LittleEndian.PutUint64(length[:], 40)
+	node, err = trie.Node(3)
+	require.NoError(t, err)
+	assert.Equal(t, length, node)
+
+	val, err := newState.ValidatorAtIndex(33)
+	require.NoError(t, err)
+	valRoot, err := stateutil.ValidatorRootWithHasher(hashutil.CustomSHA256Hasher(), val)
+	require.NoError(t, err)
+	node, err = trie.Node(1<<(depth+1) + 33)
+	require.NoError(t, err)
+	assert.Equal(t, valRoot, node)
+
+	// Past the validators of the trie.
+	node, err = trie.Node(1<<(depth+1) + 40)
+	require.NoError(t, err)
+	assert.Equal(t, trieutil.ZeroHashes[0], node)
+
+	_, err = trie.Node(1 << (depth + 2))
+	assert.ErrorContains(t, "past the leaves", err)
+	_, err = trie.Node(6)
+	assert.ErrorContains(t, "has no children", err)
+}
@@ -64,6 +64,7 @@ func (b *BeaconState) hasInnerState() bool {
 
 // GenesisTime of the beacon state as a uint64.
 func (b *BeaconState) GenesisTime() uint64 {
+	recordFieldOperation(fieldAccess, genesisTime)
 	if !b.hasInnerState() {
 		return 0
 	}
@@ -86,6 +87,7 @@ func (b *BeaconState) genesisTime() uint64 {
 
 // GenesisValidatorRoot of the beacon state.
 func (b *BeaconState) GenesisValidatorRoot() []byte {
+	recordFieldOperation(fieldAccess, genesisValidatorRoot)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -116,6 +118,7 @@ func (b *BeaconState) genesisValidatorRoot() []byte {
 
 // Slot of the current beacon chain state.
 func (b *BeaconState) Slot() types.Slot {
+	recordFieldOperation(fieldAccess, slot)
 	if !b.hasInnerState() {
 		return 0
 	}
@@ -138,6 +141,7 @@ func (b *BeaconState) slot() types.Slot {
 
 // Fork version of the beacon chain.
 func (b *BeaconState) Fork() *pbp2p.Fork {
+	recordFieldOperation(fieldAccess, fork)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -174,6 +178,7 @@ func (b *BeaconState) fork() *pbp2p.Fork {
 
 // LatestBlockHeader stored within the beacon state.
 func (b *BeaconState) LatestBlockHeader() *ethpb.BeaconBlockHeader {
+	recordFieldOperation(fieldAccess, latestBlockHeader)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -217,6 +222,7 @@ func (b *BeaconState) latestBlockHeader() *ethpb.BeaconBlockHeader {
 
 // BlockRoots kept track of in the beacon state.
 func (b *BeaconState) BlockRoots() [][]byte {
+	recordFieldOperation(fieldAccess, blockRoots)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -242,6 +248,7 @@ func (b *BeaconState) blockRoots() [][]byte {
 // BlockRootAtIndex retrieves a specific block root based on an
 // input index value.
 func (b *BeaconState) BlockRootAtIndex(idx uint64) ([]byte, error) {
+	recordFieldOperation(fieldAccess, blockRoots)
 	if !b.hasInnerState() {
 		return nil, ErrNilInnerState
 	}
@@ -267,6 +274,7 @@ func (b *BeaconState) blockRootAtIndex(idx uint64) ([]byte, error) {
 
 // StateRoots kept track of in the beacon state.
 func (b *BeaconState) StateRoots() [][]byte {
+	recordFieldOperation(fieldAccess, stateRoots)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -292,6 +300,7 @@ func (b *BeaconState) stateRoots() [][]byte {
 // StateRootAtIndex retrieves a specific state root based on an
 // input index value.
 func (b *BeaconState) StateRootAtIndex(idx uint64) ([]byte, error) {
+	recordFieldOperation(fieldAccess, stateRoots)
 	if !b.hasInnerState() {
 		return nil, ErrNilInnerState
 	}
@@ -317,6 +326,7 @@ func (b *BeaconState) stateRootAtIndex(idx uint64) ([]byte, error) {
 
 // HistoricalRoots based on epochs stored in the beacon state.
 func (b *BeaconState) HistoricalRoots() [][]byte {
+	recordFieldOperation(fieldAccess, historicalRoots)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -341,6 +351,7 @@ func (b *BeaconState) historicalRoots() [][]byte {
 
 // Eth1Data corresponding to the proof-of-work chain information stored in the beacon state.
 func (b *BeaconState) Eth1Data() *ethpb.Eth1Data {
+	recordFieldOperation(fieldAccess, eth1Data)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -370,6 +381,7 @@ func (b *BeaconState) eth1Data() *ethpb.Eth1Data {
 // Eth1DataVotes corresponds to votes from eth2 on the canonical proof-of-work chain
 // data retrieved from eth1.
 func (b *BeaconState) Eth1DataVotes() []*ethpb.Eth1Data {
+	recordFieldOperation(fieldAccess, eth1DataVotes)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -404,6 +416,7 @@ func (b *BeaconState) eth1DataVotes() []*ethpb.Eth1Data {
 // Eth1DepositIndex corresponds to the index of the deposit made to the
 // validator deposit contract at the time of this state's eth1 data.
 func (b *BeaconState) Eth1DepositIndex() uint64 {
+	recordFieldOperation(fieldAccess, eth1DepositIndex)
 	if !b.hasInnerState() {
 		return 0
 	}
@@ -427,6 +440,7 @@ func (b *BeaconState) eth1DepositIndex() uint64 {
 
 // Validators participating in consensus on the beacon chain.
 func (b *BeaconState) Validators() []*ethpb.Validator {
+	recordFieldOperation(fieldAccess, validators)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -486,6 +500,7 @@ func (b *BeaconState) validatorsReferences() []*ethpb.Validator {
 
 // ValidatorAtIndex is the validator at the provided index.
 func (b *BeaconState) ValidatorAtIndex(idx types.ValidatorIndex) (*ethpb.Validator, error) {
+	recordFieldOperation(fieldAccess, validators)
 	if !b.hasInnerState() {
 		return nil, ErrNilInnerState
 	}
@@ -506,6 +521,7 @@ func (b *BeaconState) ValidatorAtIndex(idx types.ValidatorIndex) (*ethpb.Validat
 // ValidatorAtIndexReadOnly is the validator at the provided index. This method
 // doesn't clone the validator.
 func (b *BeaconState) ValidatorAtIndexReadOnly(idx types.ValidatorIndex) (iface.ReadOnlyValidator, error) {
+	recordFieldOperation(fieldAccess, validators)
 	if !b.hasInnerState() {
 		return ReadOnlyValidator{}, ErrNilInnerState
 	}
@@ -524,6 +540,7 @@ func (b *BeaconState) ValidatorAtIndexReadOnly(idx types.ValidatorIndex) (iface.
 
 // ValidatorIndexByPubkey returns a given validator by its 48-byte public key.
 func (b *BeaconState) ValidatorIndexByPubkey(key [48]byte) (types.ValidatorIndex, bool) {
+	recordFieldOperation(fieldAccess, validators)
 	if b == nil || b.valMapHandler == nil || b.valMapHandler.IsNil() {
 		return 0, false
 	}
@@ -546,6 +563,7 @@ func (b *BeaconState) validatorIndexMap() map[[48]byte]types.ValidatorIndex {
 // PubkeyAtIndex returns the pubkey at the given
 // validator index.
 func (b *BeaconState) PubkeyAtIndex(idx types.ValidatorIndex) [48]byte {
+	recordFieldOperation(fieldAccess, validators)
 	if !b.hasInnerState() {
 		return [48]byte{}
 	}
@@ -563,6 +581,7 @@ func (b *BeaconState) PubkeyAtIndex(idx types.ValidatorIndex) [48]byte {
 
 // NumValidators returns the size of the validator registry.
 func (b *BeaconState) NumValidators() int {
+	recordFieldOperation(fieldAccess, validators)
 	if !b.hasInnerState() {
 		return 0
 	}
@@ -575,6 +594,7 @@ func (b *BeaconState) NumValidators() int {
 // ReadFromEveryValidator reads values from every validator and applies it to the provided function.
 // Warning: This method is potentially unsafe, as it exposes the actual validator registry.
 func (b *BeaconState) ReadFromEveryValidator(f func(idx int, val iface.ReadOnlyValidator) error) error {
+	recordFieldOperation(fieldAccess, validators)
 	if !b.hasInnerState() {
 		return ErrNilInnerState
 	}
@@ -596,6 +616,7 @@ func (b *BeaconState) ReadFromEveryValidator(f func(idx int, val iface.ReadOnlyV
 
 // Balances of validators participating in consensus on the beacon chain.
 func (b *BeaconState) Balances() []uint64 {
+	recordFieldOperation(fieldAccess, balances)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -626,6 +647,7 @@ func (b *BeaconState) balances() []uint64 {
 
 // BalanceAtIndex of validator with the provided index.
 func (b *BeaconState) BalanceAtIndex(idx types.ValidatorIndex) (uint64, error) {
+	recordFieldOperation(fieldAccess, balances)
 	if !b.hasInnerState() {
 		return 0, ErrNilInnerState
 	}
@@ -644,6 +666,7 @@ func (b *BeaconState) BalanceAtIndex(idx types.ValidatorIndex) (uint64, error) {
 
 // BalancesLength returns the length of the balances slice.
 func (b *BeaconState) BalancesLength() int {
+	recordFieldOperation(fieldAccess, balances)
 	if !b.hasInnerState() {
 		return 0
 	}
@@ -672,6 +695,7 @@ func (b *BeaconState) balancesLength() int {
 
 // RandaoMixes of block proposers on the beacon chain.
 func (b *BeaconState) RandaoMixes() [][]byte {
+	recordFieldOperation(fieldAccess, randaoMixes)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -698,6 +722,7 @@ func (b *BeaconState) randaoMixes() [][]byte {
 // RandaoMixAtIndex retrieves a specific block root based on an
 // input index value.
 func (b *BeaconState) RandaoMixAtIndex(idx uint64) ([]byte, error) {
+	recordFieldOperation(fieldAccess, randaoMixes)
 	if !b.hasInnerState() {
 		return nil, ErrNilInnerState
 	}
@@ -724,6 +749,7 @@ func (b *BeaconState) randaoMixAtIndex(idx uint64) ([]byte, error) {
 
 // RandaoMixesLength returns the length of the randao mixes slice.
 func (b *BeaconState) RandaoMixesLength() int {
+	recordFieldOperation(fieldAccess, randaoMixes)
 	if !b.hasInnerState() {
 		return 0
 	}
@@ -752,6 +778,7 @@ func (b *BeaconState) randaoMixesLength() int {
 
 // Slashings of validators on the beacon chain.
 func (b *BeaconState) Slashings() []uint64 {
+	recordFieldOperation(fieldAccess, slashings)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -782,6 +809,7 @@ func (b *BeaconState) slashings() []uint64 {
 
 // PreviousEpochAttestations corresponding to blocks on the beacon chain.
 func (b *BeaconState) PreviousEpochAttestations() []*pbp2p.PendingAttestation {
+	recordFieldOperation(fieldAccess, previousEpochAttestations)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -807,6 +835,7 @@ func (b *BeaconState) previousEpochAttestations() []*pbp2p.PendingAttestation {
 
 // CurrentEpochAttestations corresponding to blocks on the beacon chain.
 func (b *BeaconState) CurrentEpochAttestations() []*pbp2p.PendingAttestation {
+	recordFieldOperation(fieldAccess, currentEpochAttestations)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -832,6 +861,7 @@ func (b *BeaconState) currentEpochAttestations() []*pbp2p.PendingAttestation {
 
 // JustificationBits marking which epochs have been justified in the beacon chain.
 func (b *BeaconState) JustificationBits() bitfield.Bitvector4 {
+	recordFieldOperation(fieldAccess, justificationBits)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -862,6 +892,7 @@ func (b *BeaconState) justificationBits() bitfield.Bitvector4 {
 
 // PreviousJustifiedCheckpoint denoting an epoch and block root.
 func (b *BeaconState) PreviousJustifiedCheckpoint() *ethpb.Checkpoint {
+	recordFieldOperation(fieldAccess, previousJustifiedCheckpoint)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -887,6 +918,7 @@ func (b *BeaconState) previousJustifiedCheckpoint() *ethpb.Checkpoint {
 
 // CurrentJustifiedCheckpoint denoting an epoch and block root.
 func (b *BeaconState) CurrentJustifiedCheckpoint() *ethpb.Checkpoint {
+	recordFieldOperation(fieldAccess, currentJustifiedCheckpoint)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -913,6 +945,7 @@ func (b *BeaconState) currentJustifiedCheckpoint() *ethpb.Checkpoint {
 // MatchCurrentJustifiedCheckpoint returns true if input justified checkpoint matches
 // the current justified checkpoint in state.
 func (b *BeaconState) MatchCurrentJustifiedCheckpoint(c *ethpb.Checkpoint) bool {
+	recordFieldOperation(fieldAccess, currentJustifiedCheckpoint)
 	if !b.hasInnerState() {
 		return false
 	}
@@ -929,6 +962,7 @@ func (b *BeaconState) MatchCurrentJustifiedCheckpoint(c *ethpb.Checkpoint) bool
 // MatchPreviousJustifiedCheckpoint returns true if the input justified checkpoint matches
 // the previous justified checkpoint in state.
 func (b *BeaconState) MatchPreviousJustifiedCheckpoint(c *ethpb.Checkpoint) bool {
+	recordFieldOperation(fieldAccess, previousJustifiedCheckpoint)
 	if !b.hasInnerState() {
 		return false
 	}
@@ -944,6 +978,7 @@ func (b *BeaconState) MatchPreviousJustifiedCheckpoint(c *ethpb.Checkpoint) bool
 
 // FinalizedCheckpoint denoting an epoch and block root.
 func (b *BeaconState) FinalizedCheckpoint() *ethpb.Checkpoint {
+	recordFieldOperation(fieldAccess, finalizedCheckpoint)
 	if !b.hasInnerState() {
 		return nil
 	}
@@ -969,6 +1004,7 @@ func (b *BeaconState) finalizedCheckpoint() *ethpb.Checkpoint {
 
 // FinalizedCheckpointEpoch returns the epoch value of the finalized checkpoint.
 func (b *BeaconState) FinalizedCheckpointEpoch() types.Epoch {
+	recordFieldOperation(fieldAccess, finalizedCheckpoint)
 	if !b.hasInnerState() {
 		return 0
 	}
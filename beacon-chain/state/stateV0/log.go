@@ -0,0 +1,5 @@
+package stateV0
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "state")
@@ -0,0 +1,126 @@
+package stateV0
+
+import (
+	"context"
+	"math/bits"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// Proof returns the Merkle branch of the node at the given generalized index of the state,
+// ordered from the node up to the state root. Nodes of fields backed by a field trie can be
+// proven down to the elements of the field, such as a validator record or a block root,
+// while other fields can only be proven as a whole.
+func (b *BeaconState) Proof(ctx context.Context, generalizedIndex uint64) ([][]byte, error) {
+	_, span := trace.StartSpan(ctx, "beaconState.Proof")
+	defer span.End()
+
+	if !b.hasInnerState() {
+		return nil, ErrNilInnerState
+	}
+	if generalizedIndex == 0 {
+		return nil, errors.New("generalized index must be greater than 0")
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if _, err := b.hashTreeRoot(); err != nil {
+		return nil, err
+	}
+	stateDepth := len(b.merkleLayers) - 1
+	pathLength := bits.Len64(generalizedIndex) - 1
+	if pathLength <= stateDepth {
+		return b.stateBranch(stateDepth-pathLength, generalizedIndex-1<<pathLength), nil
+	}
+
+	// The index points into a field, so the branch within the field is followed by the
+	// branch of the field in the state.
+	fieldPathLength := pathLength - stateDepth
+	field := fieldIndex(generalizedIndex>>fieldPathLength - 1<<stateDepth)
+	if int(field) >= params.BeaconConfig().BeaconStateFieldCount {
+		return nil, errors.Errorf("generalized index goes past the leaves of empty field %d", field)
+	}
+	subIndex := generalizedIndex&(1<<fieldPathLength-1) | 1<<fieldPathLength
+	proof, err := b.fieldProof(field, subIndex)
+	if err != nil {
+		return nil, err
+	}
+	return append(proof, b.stateBranch(0, uint64(field))...), nil
+}
+
+// stateBranch returns the siblings of the node at the given layer and index of the state
+// trie. This method performs map reads and the caller MUST hold the lock.
+func (b *BeaconState) stateBranch(layer int, index uint64) [][]byte {
+	depth := len(b.merkleLayers) - 1
+	proof := make([][]byte, 0, depth-layer)
+	for i := layer; i < depth; i++ {
+		sibling := make([]byte, 32)
+		copy(sibling, b.merkleLayers[i][index^1])
+		proof = append(proof, sibling)
+		index /= 2
+	}
+	return proof
+}
+
+// fieldProof returns the branch of the node at the given generalized index, relative to the
+// root of the field. This method performs map reads and writes, and the caller MUST hold the
+// lock.
+func (b *BeaconState) fieldProof(field fieldIndex, generalizedIndex uint64) ([][]byte, error) {
+	if _, ok := fieldMap[field]; !ok {
+		return nil, errors.Errorf("field %s is not backed by a field trie", field.String())
+	}
+	// Tries of fields which were not modified since the state was initialized are only
+	// built on demand.
+	if b.rebuildTrie[field] {
+		if _, err := b.rootSelector(field); err != nil {
+			return nil, err
+		}
+	}
+	trie, ok := b.stateFieldLeaves[field]
+	if !ok {
+		return nil, errors.Errorf("no field trie for field %s", field.String())
+	}
+	trie.RLock()
+	defer trie.RUnlock()
+	if len(trie.fieldLayers) == 0 {
+		return nil, errors.Errorf("no field trie for field %s", field.String())
+	}
+
+	mixin, isList, err := trie.lengthMixin()
+	if err != nil {
+		return nil, err
+	}
+	pathLength := bits.Len64(generalizedIndex) - 1
+	if !isList {
+		return trie.nodeBranch(field, generalizedIndex)
+	}
+	// The root of a list is the hash of the root of its data and of its length.
+	dataRoot := *trie.fieldLayers[len(trie.fieldLayers)-1][0]
+	switch {
+	case pathLength == 0:
+		return [][]byte{}, nil
+	case generalizedIndex == 3:
+		return [][]byte{dataRoot[:]}, nil
+	case generalizedIndex>>(pathLength-1) != 2:
+		return nil, errors.Errorf("length of field %s has no children", field.String())
+	}
+	proof, err := trie.nodeBranch(field, generalizedIndex-1<<(pathLength-1))
+	if err != nil {
+		return nil, err
+	}
+	return append(proof, mixin[:]), nil
+}
+
+// nodeBranch returns the branch of the node at the given generalized index, relative to the
+// root of the data of the trie. The caller MUST hold the lock of the trie.
+func (f *FieldTrie) nodeBranch(field fieldIndex, generalizedIndex uint64) ([][]byte, error) {
+	depth := len(f.fieldLayers) - 1
+	pathLength := bits.Len64(generalizedIndex) - 1
+	if pathLength > depth {
+		return nil, errors.Errorf("generalized index goes past the leaves of field %s", field.String())
+	}
+	return f.branch(depth-pathLength, generalizedIndex-1<<pathLength)
+}
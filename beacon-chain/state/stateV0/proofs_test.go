@@ -0,0 +1,137 @@
+package stateV0_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// Generalized indices of fields in the state trie, which has 32 leaves.
+const (
+	slotGeneralizedIndex       = 32 + 2
+	blockRootsGeneralizedIndex = 32 + 5
+	validatorsGeneralizedIndex = 32 + 11
+)
+
+// verifyProof folds the branch of the node at the given generalized index into a root.
+func verifyProof(t *testing.T, root [32]byte, leaf [32]byte, generalizedIndex uint64, proof [][]byte) {
+	node := leaf
+	for _, sibling := range proof {
+		if generalizedIndex%2 == 1 {
+			node = hashutil.Hash(append(sibling, node[:]...))
+		} else {
+			node = hashutil.Hash(append(node[:], sibling...))
+		}
+		generalizedIndex /= 2
+	}
+	assert.Equal(t, uint64(1), generalizedIndex, "Branch does not lead to the root")
+	assert.Equal(t, root, node, "Branch does not prove the leaf")
+}
+
+func TestFieldTrie_MerkleProof(t *testing.T) {
+	newState, _ := testutil.DeterministicGenesisState(t, 40)
+
+	// 11 represents the enum value of validators
+	trie, err := stateV0.NewFieldTrie(11, newState.Validators(), params.BeaconConfig().ValidatorRegistryLimit)
+	require.NoError(t, err)
+	root, err := trie.TrieRoot()
+	require.NoError(t, err)
+	val, err := newState.ValidatorAtIndex(33)
+	require.NoError(t, err)
+	valRoot, err := stateutil.ValidatorRootWithHasher(hashutil.CustomSHA256Hasher(), val)
+	require.NoError(t, err)
+
+	proof, err := trie.MerkleProof(33)
+	require.NoError(t, err)
+	depth := htrutils.Depth(params.BeaconConfig().ValidatorRegistryLimit)
+	require.Equal(t, int(depth)+1, len(proof))
+	verifyProof(t, root, valRoot, 1<<(depth+1)+33, proof)
+
+	_, err = trie.MerkleProof(40)
+	assert.ErrorContains(t, "out of range", err)
+}
+
+func TestBeaconState_Proof(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(10))
+	require.NoError(t, st.UpdateBlockRootAtIndex(3, bytesutil.ToBytes32([]byte("block root"))))
+	val, err := st.ValidatorAtIndex(7)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(7, val))
+	root, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	t.Run("slot", func(t *testing.T) {
+		proof, err := st.Proof(ctx, slotGeneralizedIndex)
+		require.NoError(t, err)
+		verifyProof(t, root, htrutils.Uint64Root(10), slotGeneralizedIndex, proof)
+	})
+	t.Run("block root", func(t *testing.T) {
+		depth := htrutils.Depth(uint64(params.BeaconConfig().SlotsPerHistoricalRoot))
+		gIndex := uint64(blockRootsGeneralizedIndex)<<depth + 3
+		proof, err := st.Proof(ctx, gIndex)
+		require.NoError(t, err)
+		verifyProof(t, root, bytesutil.ToBytes32([]byte("block root")), gIndex, proof)
+	})
+	t.Run("validator", func(t *testing.T) {
+		depth := htrutils.Depth(params.BeaconConfig().ValidatorRegistryLimit)
+		gIndex := uint64(validatorsGeneralizedIndex)<<(depth+1) + 7
+		valRoot, err := stateutil.ValidatorRootWithHasher(hashutil.CustomSHA256Hasher(), val)
+		require.NoError(t, err)
+		proof, err := st.Proof(ctx, gIndex)
+		require.NoError(t, err)
+		verifyProof(t, root, valRoot, gIndex, proof)
+	})
+	t.Run("validators length", func(t *testing.T) {
+		gIndex := uint64(validatorsGeneralizedIndex)<<1 + 1
+		var length [32]byte
+		binary.LittleEndian.PutUint64(length[:], 64)
+		proof, err := st.Proof(ctx, gIndex)
+		require.NoError(t, err)
+		verifyProof(t, root, length, gIndex, proof)
+	})
+	t.Run("state root", func(t *testing.T) {
+		proof, err := st.Proof(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(proof))
+	})
+	t.Run("past the leaves of a field", func(t *testing.T) {
+		_, err := st.Proof(ctx, slotGeneralizedIndex<<1)
+		assert.ErrorContains(t, "not backed by a field trie", err)
+	})
+	t.Run("zero generalized index", func(t *testing.T) {
+		_, err := st.Proof(ctx, 0)
+		assert.ErrorContains(t, "greater than 0", err)
+	})
+}
+
+func TestBeaconState_Proof_UnmodifiedField(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	root, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	// A newly initialized state is hashed without building the tries of its fields.
+	pbState, err := stateV0.ProtobufBeaconState(st.InnerStateUnsafe())
+	require.NoError(t, err)
+	newState, err := stateV0.InitializeFromProto(pbState)
+	require.NoError(t, err)
+
+	mixes := newState.RandaoMixes()
+	depth := htrutils.Depth(uint64(params.BeaconConfig().EpochsPerHistoricalVector))
+	gIndex := uint64(32+13)<<depth + 1
+	proof, err := newState.Proof(ctx, gIndex)
+	require.NoError(t, err)
+	verifyProof(t, root, bytesutil.ToBytes32(mixes[1]), gIndex, proof)
+}
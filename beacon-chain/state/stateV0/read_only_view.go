@@ -0,0 +1,579 @@
+package stateV0
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// Ensure the read-only view can not be used to mutate a state, as all of its
+// write methods panic.
+var (
+	_ iface.ReadOnlyBeaconState  = (*readOnlyView)(nil)
+	_ iface.WriteOnlyBeaconState = (*readOnlyView)(nil)
+)
+
+// readOnlyView is a read-only view over a snapshot of the beacon state. The
+// snapshot shares the underlying fields of the state it was taken from, so the
+// getters of the view return these fields as they are, without copying them.
+type readOnlyView struct {
+	state                 *pbp2p.BeaconState
+	valMapHandler         *stateutil.ValidatorMapHandler
+	sharedFieldReferences map[fieldIndex]uint64
+}
+
+// ReadOnlyView returns a read-only view of the beacon state which does not copy any
+// of its underlying arrays. The view shares its fields with the state, which copies
+// them on its next write instead, so the view is unaffected by further mutations of
+// the state. Values returned by the view must not be modified, and any attempt to
+// mutate the view through its write methods panics. This allows read-only callers to
+// avoid a defensive Copy of the state.
+func (b *BeaconState) ReadOnlyView() iface.ReadOnlyBeaconState {
+	if !b.hasInnerState() {
+		return nil
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	v := &readOnlyView{
+		state: &pbp2p.BeaconState{
+			GenesisTime:                 b.state.GenesisTime,
+			GenesisValidatorsRoot:       b.state.GenesisValidatorsRoot,
+			Slot:                        b.state.Slot,
+			Fork:                        b.state.Fork,
+			LatestBlockHeader:           b.state.LatestBlockHeader,
+			BlockRoots:                  b.state.BlockRoots,
+			StateRoots:                  b.state.StateRoots,
+			HistoricalRoots:             b.state.HistoricalRoots,
+			Eth1Data:                    b.state.Eth1Data,
+			Eth1DataVotes:               b.state.Eth1DataVotes,
+			Eth1DepositIndex:            b.state.Eth1DepositIndex,
+			Validators:                  b.state.Validators,
+			Balances:                    b.state.Balances,
+			RandaoMixes:                 b.state.RandaoMixes,
+			Slashings:                   b.state.Slashings,
+			PreviousEpochAttestations:   b.state.PreviousEpochAttestations,
+			CurrentEpochAttestations:    b.state.CurrentEpochAttestations,
+			JustificationBits:           b.state.JustificationBits,
+			PreviousJustifiedCheckpoint: b.state.PreviousJustifiedCheckpoint,
+			CurrentJustifiedCheckpoint:  b.state.CurrentJustifiedCheckpoint,
+			FinalizedCheckpoint:         b.state.FinalizedCheckpoint,
+		},
+		valMapHandler:         b.valMapHandler,
+		sharedFieldReferences: make(map[fieldIndex]uint64, len(b.sharedFieldReferences)),
+	}
+
+	// Hold a reference to every shared field, so the state copies the field
+	// before writing to it instead of mutating it under the view.
+	refs := make([]*stateutil.Reference, 0, len(b.sharedFieldReferences)+1)
+	for field, ref := range b.sharedFieldReferences {
+		ref.AddRef()
+		refs = append(refs, ref)
+		v.sharedFieldReferences[field] = uint64(ref.Refs())
+	}
+	if b.valMapHandler != nil && !b.valMapHandler.IsNil() {
+		b.valMapHandler.AddRef()
+		refs = append(refs, b.valMapHandler.MapRef())
+	}
+
+	// Release the references once the view is garbage collected.
+	runtime.SetFinalizer(v, func(_ *readOnlyView) {
+		for _, ref := range refs {
+			ref.MinusRef()
+		}
+	})
+
+	return v
+}
+
+// InnerStateUnsafe returns the pointer value of the underlying
+// beacon state proto object of the view. Use with care.
+func (v *readOnlyView) InnerStateUnsafe() interface{} {
+	return v.state
+}
+
+// CloneInnerState clones the beacon state of the view into a protobuf for usage.
+func (v *readOnlyView) CloneInnerState() interface{} {
+	return (&BeaconState{state: v.state}).CloneInnerState()
+}
+
+// GenesisTime of the beacon state as a uint64.
+func (v *readOnlyView) GenesisTime() uint64 {
+	return v.state.GenesisTime
+}
+
+// GenesisValidatorRoot of the beacon state.
+func (v *readOnlyView) GenesisValidatorRoot() []byte {
+	return v.state.GenesisValidatorsRoot
+}
+
+// Slot of the current beacon chain state.
+func (v *readOnlyView) Slot() types.Slot {
+	return v.state.Slot
+}
+
+// Fork version of the beacon chain.
+func (v *readOnlyView) Fork() *pbp2p.Fork {
+	return v.state.Fork
+}
+
+// LatestBlockHeader stored within the beacon state.
+func (v *readOnlyView) LatestBlockHeader() *ethpb.BeaconBlockHeader {
+	return v.state.LatestBlockHeader
+}
+
+// BlockRoots kept track of in the beacon state.
+func (v *readOnlyView) BlockRoots() [][]byte {
+	return v.state.BlockRoots
+}
+
+// BlockRootAtIndex retrieves a specific block root based on an
+// input index value.
+func (v *readOnlyView) BlockRootAtIndex(idx uint64) ([]byte, error) {
+	return bytesAtIndex(v.state.BlockRoots, idx)
+}
+
+// StateRoots kept track of in the beacon state.
+func (v *readOnlyView) StateRoots() [][]byte {
+	return v.state.StateRoots
+}
+
+// StateRootAtIndex retrieves a specific state root based on an
+// input index value.
+func (v *readOnlyView) StateRootAtIndex(idx uint64) ([]byte, error) {
+	return bytesAtIndex(v.state.StateRoots, idx)
+}
+
+// HistoricalRoots based on epochs stored in the beacon state.
+func (v *readOnlyView) HistoricalRoots() [][]byte {
+	return v.state.HistoricalRoots
+}
+
+// Eth1Data corresponding to the proof-of-work chain information stored in the beacon state.
+func (v *readOnlyView) Eth1Data() *ethpb.Eth1Data {
+	return v.state.Eth1Data
+}
+
+// Eth1DataVotes corresponds to votes from eth2 on the canonical proof-of-work chain
+// data retrieved from eth1.
+func (v *readOnlyView) Eth1DataVotes() []*ethpb.Eth1Data {
+	return v.state.Eth1DataVotes
+}
+
+// Eth1DepositIndex corresponds to the index of the deposit made to the
+// validator deposit contract at the time of this state's eth1 data.
+func (v *readOnlyView) Eth1DepositIndex() uint64 {
+	return v.state.Eth1DepositIndex
+}
+
+// Validators participating in consensus on the beacon chain.
+func (v *readOnlyView) Validators() []*ethpb.Validator {
+	return v.state.Validators
+}
+
+// ValidatorAtIndex is the validator at the provided index.
+func (v *readOnlyView) ValidatorAtIndex(idx types.ValidatorIndex) (*ethpb.Validator, error) {
+	if v.state.Validators == nil {
+		return &ethpb.Validator{}, nil
+	}
+	if uint64(len(v.state.Validators)) <= uint64(idx) {
+		return nil, fmt.Errorf("index %d out of range", idx)
+	}
+	return v.state.Validators[idx], nil
+}
+
+// ValidatorAtIndexReadOnly is the validator at the provided index.
+func (v *readOnlyView) ValidatorAtIndexReadOnly(idx types.ValidatorIndex) (iface.ReadOnlyValidator, error) {
+	if v.state.Validators == nil {
+		return ReadOnlyValidator{}, nil
+	}
+	if uint64(len(v.state.Validators)) <= uint64(idx) {
+		return ReadOnlyValidator{}, fmt.Errorf("index %d out of range", idx)
+	}
+	return ReadOnlyValidator{v.state.Validators[idx]}, nil
+}
+
+// ValidatorIndexByPubkey returns a given validator by its 48-byte public key.
+func (v *readOnlyView) ValidatorIndexByPubkey(key [48]byte) (types.ValidatorIndex, bool) {
+	if v.valMapHandler == nil || v.valMapHandler.IsNil() {
+		return 0, false
+	}
+	return v.valMapHandler.Get(key)
+}
+
+// PubkeyAtIndex returns the pubkey at the given
+// validator index.
+func (v *readOnlyView) PubkeyAtIndex(idx types.ValidatorIndex) [48]byte {
+	if uint64(idx) >= uint64(len(v.state.Validators)) || v.state.Validators[idx] == nil {
+		return [48]byte{}
+	}
+	return bytesutil.ToBytes48(v.state.Validators[idx].PublicKey)
+}
+
+// NumValidators returns the size of the validator registry.
+func (v *readOnlyView) NumValidators() int {
+	return len(v.state.Validators)
+}
+
+// ReadFromEveryValidator reads values from every validator and applies it to the provided function.
+func (v *readOnlyView) ReadFromEveryValidator(f func(idx int, val iface.ReadOnlyValidator) error) error {
+	if v.state.Validators == nil {
+		return errors.New("nil validators in state")
+	}
+	for i, val := range v.state.Validators {
+		if err := f(i, ReadOnlyValidator{validator: val}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Balances of validators participating in consensus on the beacon chain.
+func (v *readOnlyView) Balances() []uint64 {
+	return v.state.Balances
+}
+
+// BalanceAtIndex of validator with the provided index.
+func (v *readOnlyView) BalanceAtIndex(idx types.ValidatorIndex) (uint64, error) {
+	if v.state.Balances == nil {
+		return 0, nil
+	}
+	if uint64(len(v.state.Balances)) <= uint64(idx) {
+		return 0, fmt.Errorf("index of %d does not exist", idx)
+	}
+	return v.state.Balances[idx], nil
+}
+
+// BalancesLength returns the length of the balances slice.
+func (v *readOnlyView) BalancesLength() int {
+	return len(v.state.Balances)
+}
+
+// RandaoMixes of block proposers on the beacon chain.
+func (v *readOnlyView) RandaoMixes() [][]byte {
+	return v.state.RandaoMixes
+}
+
+// RandaoMixAtIndex retrieves a specific block root based on an
+// input index value.
+func (v *readOnlyView) RandaoMixAtIndex(idx uint64) ([]byte, error) {
+	return bytesAtIndex(v.state.RandaoMixes, idx)
+}
+
+// RandaoMixesLength returns the length of the randao mixes slice.
+func (v *readOnlyView) RandaoMixesLength() int {
+	return len(v.state.RandaoMixes)
+}
+
+// Slashings of validators on the beacon chain.
+func (v *readOnlyView) Slashings() []uint64 {
+	return v.state.Slashings
+}
+
+// PreviousEpochAttestations corresponding to blocks on the beacon chain.
+func (v *readOnlyView) PreviousEpochAttestations() []*pbp2p.PendingAttestation {
+	return v.state.PreviousEpochAttestations
+}
+
+// CurrentEpochAttestations corresponding to blocks on the beacon chain.
+func (v *readOnlyView) CurrentEpochAttestations() []*pbp2p.PendingAttestation {
+	return v.state.CurrentEpochAttestations
+}
+
+// JustificationBits marking which epochs have been justified in the beacon chain.
+func (v *readOnlyView) JustificationBits() bitfield.Bitvector4 {
+	return v.state.JustificationBits
+}
+
+// PreviousJustifiedCheckpoint denoting an epoch and block root.
+func (v *readOnlyView) PreviousJustifiedCheckpoint() *ethpb.Checkpoint {
+	return v.state.PreviousJustifiedCheckpoint
+}
+
+// CurrentJustifiedCheckpoint denoting an epoch and block root.
+func (v *readOnlyView) CurrentJustifiedCheckpoint() *ethpb.Checkpoint {
+	return v.state.CurrentJustifiedCheckpoint
+}
+
+// MatchCurrentJustifiedCheckpoint returns true if input justified checkpoint matches
+// the current justified checkpoint in state.
+func (v *readOnlyView) MatchCurrentJustifiedCheckpoint(c *ethpb.Checkpoint) bool {
+	return matchCheckpoint(c, v.state.CurrentJustifiedCheckpoint)
+}
+
+// MatchPreviousJustifiedCheckpoint returns true if the input justified checkpoint matches
+// the previous justified checkpoint in state.
+func (v *readOnlyView) MatchPreviousJustifiedCheckpoint(c *ethpb.Checkpoint) bool {
+	return matchCheckpoint(c, v.state.PreviousJustifiedCheckpoint)
+}
+
+// FinalizedCheckpoint denoting an epoch and block root.
+func (v *readOnlyView) FinalizedCheckpoint() *ethpb.Checkpoint {
+	return v.state.FinalizedCheckpoint
+}
+
+// FinalizedCheckpointEpoch returns the epoch value of the finalized checkpoint.
+func (v *readOnlyView) FinalizedCheckpointEpoch() types.Epoch {
+	if v.state.FinalizedCheckpoint == nil {
+		return 0
+	}
+	return v.state.FinalizedCheckpoint.Epoch
+}
+
+// FieldReferencesCount returns the reference count held by each shared field of
+// the state at the time the view was taken.
+func (v *readOnlyView) FieldReferencesCount() map[string]uint64 {
+	refMap := make(map[string]uint64, len(v.sharedFieldReferences))
+	for field, refs := range v.sharedFieldReferences {
+		refMap[field.String()] = refs
+	}
+	return refMap
+}
+
+// MarshalSSZ marshals the underlying beacon state to bytes.
+func (v *readOnlyView) MarshalSSZ() ([]byte, error) {
+	return v.state.MarshalSSZ()
+}
+
+// MarshalSSZSnappy marshals the underlying beacon state to its snappy compressed SSZ encoding.
+func (v *readOnlyView) MarshalSSZSnappy() ([]byte, error) {
+	return marshalSSZSnappy(v.state)
+}
+
+func bytesAtIndex(input [][]byte, idx uint64) ([]byte, error) {
+	if input == nil {
+		return nil, nil
+	}
+	if uint64(len(input)) <= idx {
+		return nil, fmt.Errorf("index %d out of range", idx)
+	}
+	return input[idx], nil
+}
+
+func matchCheckpoint(c, stateCheckpoint *ethpb.Checkpoint) bool {
+	if stateCheckpoint == nil {
+		return false
+	}
+	if c.Epoch != stateCheckpoint.Epoch {
+		return false
+	}
+	return bytes.Equal(c.Root, stateCheckpoint.Root)
+}
+
+// mutationAttempt panics, as the view must never be mutated.
+func (v *readOnlyView) mutationAttempt(field fieldIndex) {
+	panic(fmt.Sprintf("attempted to mutate %s of a read-only state view", field))
+}
+
+// SetGenesisTime panics, as the view is read-only.
+func (v *readOnlyView) SetGenesisTime(_ uint64) error {
+	v.mutationAttempt(genesisTime)
+	return nil
+}
+
+// SetGenesisValidatorRoot panics, as the view is read-only.
+func (v *readOnlyView) SetGenesisValidatorRoot(_ []byte) error {
+	v.mutationAttempt(genesisValidatorRoot)
+	return nil
+}
+
+// SetSlot panics, as the view is read-only.
+func (v *readOnlyView) SetSlot(_ types.Slot) error {
+	v.mutationAttempt(slot)
+	return nil
+}
+
+// SetFork panics, as the view is read-only.
+func (v *readOnlyView) SetFork(_ *pbp2p.Fork) error {
+	v.mutationAttempt(fork)
+	return nil
+}
+
+// SetLatestBlockHeader panics, as the view is read-only.
+func (v *readOnlyView) SetLatestBlockHeader(_ *ethpb.BeaconBlockHeader) error {
+	v.mutationAttempt(latestBlockHeader)
+	return nil
+}
+
+// SetBlockRoots panics, as the view is read-only.
+func (v *readOnlyView) SetBlockRoots(_ [][]byte) error {
+	v.mutationAttempt(blockRoots)
+	return nil
+}
+
+// UpdateBlockRootAtIndex panics, as the view is read-only.
+func (v *readOnlyView) UpdateBlockRootAtIndex(_ uint64, _ [32]byte) error {
+	v.mutationAttempt(blockRoots)
+	return nil
+}
+
+// SetStateRoots panics, as the view is read-only.
+func (v *readOnlyView) SetStateRoots(_ [][]byte) error {
+	v.mutationAttempt(stateRoots)
+	return nil
+}
+
+// UpdateStateRootAtIndex panics, as the view is read-only.
+func (v *readOnlyView) UpdateStateRootAtIndex(_ uint64, _ [32]byte) error {
+	v.mutationAttempt(stateRoots)
+	return nil
+}
+
+// SetHistoricalRoots panics, as the view is read-only.
+func (v *readOnlyView) SetHistoricalRoots(_ [][]byte) error {
+	v.mutationAttempt(historicalRoots)
+	return nil
+}
+
+// AppendHistoricalRoots panics, as the view is read-only.
+func (v *readOnlyView) AppendHistoricalRoots(_ [32]byte) error {
+	v.mutationAttempt(historicalRoots)
+	return nil
+}
+
+// SetEth1Data panics, as the view is read-only.
+func (v *readOnlyView) SetEth1Data(_ *ethpb.Eth1Data) error {
+	v.mutationAttempt(eth1Data)
+	return nil
+}
+
+// SetEth1DataVotes panics, as the view is read-only.
+func (v *readOnlyView) SetEth1DataVotes(_ []*ethpb.Eth1Data) error {
+	v.mutationAttempt(eth1DataVotes)
+	return nil
+}
+
+// AppendEth1DataVotes panics, as the view is read-only.
+func (v *readOnlyView) AppendEth1DataVotes(_ *ethpb.Eth1Data) error {
+	v.mutationAttempt(eth1DataVotes)
+	return nil
+}
+
+// SetEth1DepositIndex panics, as the view is read-only.
+func (v *readOnlyView) SetEth1DepositIndex(_ uint64) error {
+	v.mutationAttempt(eth1DepositIndex)
+	return nil
+}
+
+// SetValidators panics, as the view is read-only.
+func (v *readOnlyView) SetValidators(_ []*ethpb.Validator) error {
+	v.mutationAttempt(validators)
+	return nil
+}
+
+// ApplyToEveryValidator panics, as the view is read-only.
+func (v *readOnlyView) ApplyToEveryValidator(_ func(idx int, val iface.ReadOnlyValidator) (bool, *ethpb.Validator, error)) error {
+	v.mutationAttempt(validators)
+	return nil
+}
+
+// UpdateValidatorAtIndex panics, as the view is read-only.
+func (v *readOnlyView) UpdateValidatorAtIndex(_ types.ValidatorIndex, _ *ethpb.Validator) error {
+	v.mutationAttempt(validators)
+	return nil
+}
+
+// AppendValidator panics, as the view is read-only.
+func (v *readOnlyView) AppendValidator(_ *ethpb.Validator) error {
+	v.mutationAttempt(validators)
+	return nil
+}
+
+// SetBalances panics, as the view is read-only.
+func (v *readOnlyView) SetBalances(_ []uint64) error {
+	v.mutationAttempt(balances)
+	return nil
+}
+
+// UpdateBalancesAtIndex panics, as the view is read-only.
+func (v *readOnlyView) UpdateBalancesAtIndex(_ types.ValidatorIndex, _ uint64) error {
+	v.mutationAttempt(balances)
+	return nil
+}
+
+// AppendBalance panics, as the view is read-only.
+func (v *readOnlyView) AppendBalance(_ uint64) error {
+	v.mutationAttempt(balances)
+	return nil
+}
+
+// SetRandaoMixes panics, as the view is read-only.
+func (v *readOnlyView) SetRandaoMixes(_ [][]byte) error {
+	v.mutationAttempt(randaoMixes)
+	return nil
+}
+
+// UpdateRandaoMixesAtIndex panics, as the view is read-only.
+func (v *readOnlyView) UpdateRandaoMixesAtIndex(_ uint64, _ []byte) error {
+	v.mutationAttempt(randaoMixes)
+	return nil
+}
+
+// SetSlashings panics, as the view is read-only.
+func (v *readOnlyView) SetSlashings(_ []uint64) error {
+	v.mutationAttempt(slashings)
+	return nil
+}
+
+// UpdateSlashingsAtIndex panics, as the view is read-only.
+func (v *readOnlyView) UpdateSlashingsAtIndex(_, _ uint64) error {
+	v.mutationAttempt(slashings)
+	return nil
+}
+
+// SetPreviousEpochAttestations panics, as the view is read-only.
+func (v *readOnlyView) SetPreviousEpochAttestations(_ []*pbp2p.PendingAttestation) error {
+	v.mutationAttempt(previousEpochAttestations)
+	return nil
+}
+
+// SetCurrentEpochAttestations panics, as the view is read-only.
+func (v *readOnlyView) SetCurrentEpochAttestations(_ []*pbp2p.PendingAttestation) error {
+	v.mutationAttempt(currentEpochAttestations)
+	return nil
+}
+
+// AppendPreviousEpochAttestations panics, as the view is read-only.
+func (v *readOnlyView) AppendPreviousEpochAttestations(_ *pbp2p.PendingAttestation) error {
+	v.mutationAttempt(previousEpochAttestations)
+	return nil
+}
+
+// AppendCurrentEpochAttestations panics, as the view is read-only.
+func (v *readOnlyView) AppendCurrentEpochAttestations(_ *pbp2p.PendingAttestation) error {
+	v.mutationAttempt(currentEpochAttestations)
+	return nil
+}
+
+// SetJustificationBits panics, as the view is read-only.
+func (v *readOnlyView) SetJustificationBits(_ bitfield.Bitvector4) error {
+	v.mutationAttempt(justificationBits)
+	return nil
+}
+
+// SetPreviousJustifiedCheckpoint panics, as the view is read-only.
+func (v *readOnlyView) SetPreviousJustifiedCheckpoint(_ *ethpb.Checkpoint) error {
+	v.mutationAttempt(previousJustifiedCheckpoint)
+	return nil
+}
+
+// SetCurrentJustifiedCheckpoint panics, as the view is read-only.
+func (v *readOnlyView) SetCurrentJustifiedCheckpoint(_ *ethpb.Checkpoint) error {
+	v.mutationAttempt(currentJustifiedCheckpoint)
+	return nil
+}
+
+// SetFinalizedCheckpoint panics, as the view is read-only.
+func (v *readOnlyView) SetFinalizedCheckpoint(_ *ethpb.Checkpoint) error {
+	v.mutationAttempt(finalizedCheckpoint)
+	return nil
+}
@@ -0,0 +1,89 @@
+package stateV0
+
+import (
+	"runtime"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestReadOnlyView_NoCopy(t *testing.T) {
+	st, err := InitializeFromProtoUnsafe(&p2ppb.BeaconState{
+		Slot:        10,
+		BlockRoots:  [][]byte{bytesutil.PadTo([]byte("foo"), 32)},
+		RandaoMixes: [][]byte{bytesutil.PadTo([]byte("bar"), 32)},
+		Validators:  []*ethpb.Validator{{PublicKey: bytesutil.PadTo([]byte("val"), 48)}},
+		Balances:    []uint64{32},
+	})
+	require.NoError(t, err)
+
+	view := st.ReadOnlyView()
+	assert.Equal(t, st.Slot(), view.Slot())
+	assert.DeepEqual(t, st.BlockRoots(), view.BlockRoots())
+	assert.DeepEqual(t, st.Balances(), view.Balances())
+	idx, ok := view.ValidatorIndexByPubkey(bytesutil.ToBytes48(bytesutil.PadTo([]byte("val"), 48)))
+	require.Equal(t, true, ok)
+	assert.Equal(t, 0, int(idx))
+
+	// The view returns the underlying fields of the state.
+	assert.Equal(t, &st.state.BlockRoots[0], &view.BlockRoots()[0])
+	assert.Equal(t, &st.state.RandaoMixes[0], &view.RandaoMixes()[0])
+	assert.Equal(t, st.state.Validators[0], view.Validators()[0])
+	assert.Equal(t, &st.state.Balances[0], &view.Balances()[0])
+	assert.Equal(t, uint(2), st.sharedFieldReferences[blockRoots].Refs())
+}
+
+func TestReadOnlyView_UnaffectedByStateMutations(t *testing.T) {
+	st, err := InitializeFromProtoUnsafe(&p2ppb.BeaconState{
+		Slot:       10,
+		BlockRoots: [][]byte{bytesutil.PadTo([]byte("foo"), 32)},
+		Balances:   []uint64{32},
+	})
+	require.NoError(t, err)
+
+	view := st.ReadOnlyView()
+	require.NoError(t, st.SetSlot(11))
+	require.NoError(t, st.UpdateBlockRootAtIndex(0, bytesutil.ToBytes32([]byte("bar"))))
+	require.NoError(t, st.UpdateBalancesAtIndex(0, 64))
+	require.NoError(t, st.AppendValidator(&ethpb.Validator{PublicKey: bytesutil.PadTo([]byte("val"), 48)}))
+
+	assert.Equal(t, 10, int(view.Slot()))
+	assert.DeepEqual(t, [][]byte{bytesutil.PadTo([]byte("foo"), 32)}, view.BlockRoots())
+	assert.DeepEqual(t, []uint64{32}, view.Balances())
+	assert.Equal(t, 0, view.NumValidators())
+	_, ok := view.ValidatorIndexByPubkey(bytesutil.ToBytes48(bytesutil.PadTo([]byte("val"), 48)))
+	assert.Equal(t, false, ok)
+}
+
+func TestReadOnlyView_PanicsOnMutation(t *testing.T) {
+	st, err := InitializeFromProtoUnsafe(&p2ppb.BeaconState{Slot: 10})
+	require.NoError(t, err)
+
+	view := st.ReadOnlyView()
+	writable, ok := view.(iface.WriteOnlyBeaconState)
+	require.Equal(t, true, ok)
+	defer func() {
+		assert.NotNil(t, recover(), "Expected mutation of the view to panic")
+	}()
+	_ = writable.SetSlot(11)
+}
+
+func TestReadOnlyView_Finalizer(t *testing.T) {
+	st, err := InitializeFromProtoUnsafe(&p2ppb.BeaconState{RandaoMixes: [][]byte{[]byte("foo")}})
+	require.NoError(t, err)
+
+	func() {
+		// Create the view in a different scope for GC.
+		view := st.ReadOnlyView()
+		assert.Equal(t, uint(2), st.sharedFieldReferences[randaoMixes].Refs())
+		_ = view
+	}()
+
+	runtime.GC() // Should run the finalizer of the view.
+	assert.Equal(t, uint(1), st.sharedFieldReferences[randaoMixes].Refs())
+}
@@ -304,7 +304,7 @@ func TestValidatorReferences_RemainsConsistent(t *testing.T) {
 
 	assert.DeepNotEqual(t, a.state.Validators[0], b.state.Validators[0], "validators are equal when they are supposed to be different")
 	// Modify all validators from copied state.
-	assert.NoError(t, b.ApplyToEveryValidator(func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error) {
+	assert.NoError(t, b.ApplyToEveryValidator(func(idx int, val iface.ReadOnlyValidator) (bool, *ethpb.Validator, error) {
 		return true, &ethpb.Validator{PublicKey: []byte{'V'}}, nil
 	}))
 
@@ -315,6 +315,43 @@ func TestValidatorReferences_RemainsConsistent(t *testing.T) {
 	}))
 }
 
+func TestValidatorReferences_OnlyTouchedValidatorsCloned(t *testing.T) {
+	a, err := InitializeFromProto(&p2ppb.BeaconState{
+		Validators: []*ethpb.Validator{
+			{PublicKey: []byte{'A'}, EffectiveBalance: 1},
+			{PublicKey: []byte{'B'}, EffectiveBalance: 2},
+			{PublicKey: []byte{'C'}, EffectiveBalance: 3},
+		},
+	})
+	require.NoError(t, err)
+	copied := a.Copy()
+	b, ok := copied.(*BeaconState)
+	require.Equal(t, true, ok)
+
+	// Only change the second validator of the copied state.
+	require.NoError(t, b.ApplyToEveryValidator(func(idx int, val iface.ReadOnlyValidator) (bool, *ethpb.Validator, error) {
+		if idx != 1 {
+			return false, nil, nil
+		}
+		newVal := val.Copy()
+		newVal.EffectiveBalance = 20
+		return true, newVal, nil
+	}))
+
+	assertRefCount(t, a, validators, 1)
+	assertRefCount(t, b, validators, 1)
+	assert.Equal(t, a.state.Validators[0], b.state.Validators[0], "Untouched validator is not shared")
+	assert.Equal(t, a.state.Validators[2], b.state.Validators[2], "Untouched validator is not shared")
+	assert.NotEqual(t, a.state.Validators[1], b.state.Validators[1], "Touched validator is shared")
+	assert.Equal(t, uint64(2), a.state.Validators[1].EffectiveBalance)
+	assert.Equal(t, uint64(20), b.state.Validators[1].EffectiveBalance)
+
+	err = b.ApplyToEveryValidator(func(idx int, val iface.ReadOnlyValidator) (bool, *ethpb.Validator, error) {
+		return true, nil, nil
+	})
+	assert.ErrorContains(t, "nil validator returned for index 0", err)
+}
+
 // assertRefCount checks whether reference count for a given state
 // at a given index is equal to expected amount.
 func assertRefCount(t *testing.T, b *BeaconState, idx fieldIndex, want uint) {
@@ -8,6 +8,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -129,6 +130,7 @@ func (b *BeaconState) UpdateBlockRootAtIndex(idx uint64, blockRoot [32]byte) err
 
 	r := b.state.BlockRoots
 	if ref := b.sharedFieldReferences[blockRoots]; ref.Refs() > 1 {
+		recordFieldOperation(fieldCopy, blockRoots)
 		// Copy elements in underlying array by reference.
 		r = make([][]byte, len(b.state.BlockRoots))
 		copy(r, b.state.BlockRoots)
@@ -182,6 +184,7 @@ func (b *BeaconState) UpdateStateRootAtIndex(idx uint64, stateRoot [32]byte) err
 	// Check if we hold the only reference to the shared state roots slice.
 	r := b.state.StateRoots
 	if ref := b.sharedFieldReferences[stateRoots]; ref.Refs() > 1 {
+		recordFieldOperation(fieldCopy, stateRoots)
 		// Copy elements in underlying array by reference.
 		r = make([][]byte, len(b.state.StateRoots))
 		copy(r, b.state.StateRoots)
@@ -256,6 +259,7 @@ func (b *BeaconState) AppendEth1DataVotes(val *ethpb.Eth1Data) error {
 
 	votes := b.state.Eth1DataVotes
 	if b.sharedFieldReferences[eth1DataVotes].Refs() > 1 {
+		recordFieldOperation(fieldCopy, eth1DataVotes)
 		// Copy elements in underlying array by reference.
 		votes = make([]*ethpb.Eth1Data, len(b.state.Eth1DataVotes))
 		copy(votes, b.state.Eth1DataVotes)
@@ -301,14 +305,17 @@ func (b *BeaconState) SetValidators(val []*ethpb.Validator) error {
 }
 
 // ApplyToEveryValidator applies the provided callback function to each validator in the
-// validator registry.
-func (b *BeaconState) ApplyToEveryValidator(f func(idx int, val *ethpb.Validator) (bool, *ethpb.Validator, error)) error {
+// validator registry. Validators are shared with the copies of the state, so the callback
+// only gets read access to them and returns a new validator for each validator it changes.
+// Validators left unchanged remain shared with the copies of the state.
+func (b *BeaconState) ApplyToEveryValidator(f func(idx int, val iface.ReadOnlyValidator) (bool, *ethpb.Validator, error)) error {
 	if !b.hasInnerState() {
 		return ErrNilInnerState
 	}
 	b.lock.Lock()
 	v := b.state.Validators
 	if ref := b.sharedFieldReferences[validators]; ref.Refs() > 1 {
+		recordFieldOperation(fieldCopy, validators)
 		v = b.validatorsReferences()
 		ref.MinusRef()
 		b.sharedFieldReferences[validators] = stateutil.NewRef(1)
@@ -316,11 +323,14 @@ func (b *BeaconState) ApplyToEveryValidator(f func(idx int, val *ethpb.Validator
 	b.lock.Unlock()
 	var changedVals []uint64
 	for i, val := range v {
-		changed, newVal, err := f(i, val)
+		changed, newVal, err := f(i, ReadOnlyValidator{validator: val})
 		if err != nil {
 			return err
 		}
 		if changed {
+			if newVal == nil {
+				return errors.Errorf("nil validator returned for index %d", i)
+			}
 			changedVals = append(changedVals, uint64(i))
 			v[i] = newVal
 		}
@@ -350,6 +360,7 @@ func (b *BeaconState) UpdateValidatorAtIndex(idx types.ValidatorIndex, val *ethp
 
 	v := b.state.Validators
 	if ref := b.sharedFieldReferences[validators]; ref.Refs() > 1 {
+		recordFieldOperation(fieldCopy, validators)
 		v = b.validatorsReferences()
 		ref.MinusRef()
 		b.sharedFieldReferences[validators] = stateutil.NewRef(1)
@@ -377,6 +388,7 @@ func (b *BeaconState) SetBalances(val []uint64) error {
 
 	b.state.Balances = val
 	b.markFieldAsDirty(balances)
+	b.rebuildTrie[balances] = true
 	return nil
 }
 
@@ -394,6 +406,7 @@ func (b *BeaconState) UpdateBalancesAtIndex(idx types.ValidatorIndex, val uint64
 
 	bals := b.state.Balances
 	if b.sharedFieldReferences[balances].Refs() > 1 {
+		recordFieldOperation(fieldCopy, balances)
 		bals = b.balances()
 		b.sharedFieldReferences[balances].MinusRef()
 		b.sharedFieldReferences[balances] = stateutil.NewRef(1)
@@ -402,6 +415,7 @@ func (b *BeaconState) UpdateBalancesAtIndex(idx types.ValidatorIndex, val uint64
 	bals[idx] = val
 	b.state.Balances = bals
 	b.markFieldAsDirty(balances)
+	b.addDirtyChunk(balances, uint64(idx))
 	return nil
 }
 
@@ -437,6 +451,7 @@ func (b *BeaconState) UpdateRandaoMixesAtIndex(idx uint64, val []byte) error {
 
 	mixes := b.state.RandaoMixes
 	if refs := b.sharedFieldReferences[randaoMixes].Refs(); refs > 1 {
+		recordFieldOperation(fieldCopy, randaoMixes)
 		// Copy elements in underlying array by reference.
 		mixes = make([][]byte, len(b.state.RandaoMixes))
 		copy(mixes, b.state.RandaoMixes)
@@ -483,6 +498,7 @@ func (b *BeaconState) UpdateSlashingsAtIndex(idx, val uint64) error {
 
 	s := b.state.Slashings
 	if b.sharedFieldReferences[slashings].Refs() > 1 {
+		recordFieldOperation(fieldCopy, slashings)
 		s = b.slashings()
 		b.sharedFieldReferences[slashings].MinusRef()
 		b.sharedFieldReferences[slashings] = stateutil.NewRef(1)
@@ -543,6 +559,7 @@ func (b *BeaconState) AppendHistoricalRoots(root [32]byte) error {
 
 	roots := b.state.HistoricalRoots
 	if b.sharedFieldReferences[historicalRoots].Refs() > 1 {
+		recordFieldOperation(fieldCopy, historicalRoots)
 		roots = make([][]byte, len(b.state.HistoricalRoots))
 		copy(roots, b.state.HistoricalRoots)
 		b.sharedFieldReferences[historicalRoots].MinusRef()
@@ -565,6 +582,7 @@ func (b *BeaconState) AppendCurrentEpochAttestations(val *pbp2p.PendingAttestati
 
 	atts := b.state.CurrentEpochAttestations
 	if b.sharedFieldReferences[currentEpochAttestations].Refs() > 1 {
+		recordFieldOperation(fieldCopy, currentEpochAttestations)
 		// Copy elements in underlying array by reference.
 		atts = make([]*pbp2p.PendingAttestation, len(b.state.CurrentEpochAttestations))
 		copy(atts, b.state.CurrentEpochAttestations)
@@ -589,6 +607,7 @@ func (b *BeaconState) AppendPreviousEpochAttestations(val *pbp2p.PendingAttestat
 
 	atts := b.state.PreviousEpochAttestations
 	if b.sharedFieldReferences[previousEpochAttestations].Refs() > 1 {
+		recordFieldOperation(fieldCopy, previousEpochAttestations)
 		atts = make([]*pbp2p.PendingAttestation, len(b.state.PreviousEpochAttestations))
 		copy(atts, b.state.PreviousEpochAttestations)
 		b.sharedFieldReferences[previousEpochAttestations].MinusRef()
@@ -613,6 +632,7 @@ func (b *BeaconState) AppendValidator(val *ethpb.Validator) error {
 
 	vals := b.state.Validators
 	if b.sharedFieldReferences[validators].Refs() > 1 {
+		recordFieldOperation(fieldCopy, validators)
 		vals = b.validatorsReferences()
 		b.sharedFieldReferences[validators].MinusRef()
 		b.sharedFieldReferences[validators] = stateutil.NewRef(1)
@@ -646,6 +666,7 @@ func (b *BeaconState) AppendBalance(bal uint64) error {
 
 	bals := b.state.Balances
 	if b.sharedFieldReferences[balances].Refs() > 1 {
+		recordFieldOperation(fieldCopy, balances)
 		bals = b.balances()
 		b.sharedFieldReferences[balances].MinusRef()
 		b.sharedFieldReferences[balances] = stateutil.NewRef(1)
@@ -653,6 +674,7 @@ func (b *BeaconState) AppendBalance(bal uint64) error {
 
 	b.state.Balances = append(bals, bal)
 	b.markFieldAsDirty(balances)
+	b.addDirtyChunk(balances, uint64(len(b.state.Balances)-1))
 	return nil
 }
 
@@ -744,6 +766,8 @@ func (b *BeaconState) recomputeRoot(idx int) {
 }
 
 func (b *BeaconState) markFieldAsDirty(field fieldIndex) {
+	b.auditMutation(field)
+	recordFieldOperation(fieldMutation, field)
 	_, ok := b.dirtyFields[field]
 	if !ok {
 		b.dirtyFields[field] = true
@@ -756,3 +780,15 @@ func (b *BeaconState) markFieldAsDirty(field fieldIndex) {
 func (b *BeaconState) addDirtyIndices(index fieldIndex, indices []uint64) {
 	b.dirtyIndices[index] = append(b.dirtyIndices[index], indices...)
 }
+
+// addDirtyChunk marks the chunk holding the given element of a compressed array field as
+// dirty. Elements are mostly updated in order, so a chunk is only added once for a run of
+// updates to the elements it holds.
+func (b *BeaconState) addDirtyChunk(index fieldIndex, elemIndex uint64) {
+	chunkIdx := compressedChunkIndex(index, elemIndex)
+	indices := b.dirtyIndices[index]
+	if len(indices) > 0 && indices[len(indices)-1] == chunkIdx {
+		return
+	}
+	b.dirtyIndices[index] = append(indices, chunkIdx)
+}
@@ -0,0 +1,63 @@
+package stateV0
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// snappyStreamIdentifier is the chunk every snappy framed stream starts with.
+var snappyStreamIdentifier = []byte("\xff\x06\x00\x00sNaPpY")
+
+// MarshalSSZSnappy marshals the underlying beacon state to its SSZ encoding, compressed
+// with the snappy framing format.
+func (b *BeaconState) MarshalSSZSnappy() ([]byte, error) {
+	if !b.hasInnerState() {
+		return nil, errors.New("nil beacon state")
+	}
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return marshalSSZSnappy(b.state)
+}
+
+// InitializeFromSSZSnappy creates a beacon state from the snappy framed SSZ encoding
+// produced by MarshalSSZSnappy.
+func InitializeFromSSZSnappy(enc []byte) (*BeaconState, error) {
+	r := snappy.NewReader(bytes.NewReader(enc))
+	ssz, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress state")
+	}
+	st := &pbp2p.BeaconState{}
+	if err := st.UnmarshalSSZ(ssz); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal state")
+	}
+	return InitializeFromProtoUnsafe(st)
+}
+
+// IsSSZSnappy returns true if the encoding is a snappy framed stream, as produced by
+// MarshalSSZSnappy.
+func IsSSZSnappy(enc []byte) bool {
+	return bytes.HasPrefix(enc, snappyStreamIdentifier)
+}
+
+// marshalSSZSnappy streams the SSZ encoding of the state through a snappy writer, so that
+// the encoding is compressed chunk by chunk rather than as a single block.
+func marshalSSZSnappy(st *pbp2p.BeaconState) ([]byte, error) {
+	enc, err := st.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	w := snappy.NewBufferedWriter(buf)
+	if _, err := w.Write(enc); err != nil {
+		return nil, errors.Wrap(err, "could not compress state")
+	}
+	if err := w.Close(); err != nil {
+		return nil, errors.Wrap(err, "could not compress state")
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,41 @@
+package stateV0_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestMarshalSSZSnappy_RoundTrip(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(100))
+
+	enc, err := st.MarshalSSZSnappy()
+	require.NoError(t, err)
+	assert.Equal(t, true, stateV0.IsSSZSnappy(enc))
+	ssz, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, true, len(enc) < len(ssz), "Compressed encoding is not smaller than the SSZ encoding")
+
+	decoded, err := stateV0.InitializeFromSSZSnappy(enc)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, st.InnerStateUnsafe(), decoded.InnerStateUnsafe())
+
+	viewEnc, err := st.ReadOnlyView().MarshalSSZSnappy()
+	require.NoError(t, err)
+	assert.DeepEqual(t, enc, viewEnc)
+}
+
+func TestInitializeFromSSZSnappy_InvalidEncoding(t *testing.T) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	ssz, err := st.MarshalSSZ()
+	require.NoError(t, err)
+
+	assert.Equal(t, false, stateV0.IsSSZSnappy(ssz))
+	_, err = stateV0.InitializeFromSSZSnappy(ssz)
+	assert.ErrorContains(t, "could not decompress state", err)
+}
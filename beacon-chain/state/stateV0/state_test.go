@@ -94,3 +94,18 @@ func TestBeaconState_NoDeadlock(t *testing.T) {
 	// Test will not terminate in the event of a deadlock.
 	wg.Wait()
 }
+
+func TestMarkShared_CopiesAreNotShared(t *testing.T) {
+	st, err := InitializeFromProto(&pb.BeaconState{})
+	assert.NoError(t, err)
+	MarkShared(st, "head")
+	assert.Equal(t, "head", st.sharedAs)
+
+	cpy, ok := st.Copy().(*BeaconState)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "", cpy.sharedAs)
+
+	// States which are not backed by a protobuf state are ignored.
+	MarkShared(&BeaconState{}, "head")
+	MarkShared(nil, "head")
+}
@@ -3,7 +3,6 @@ package stateV0
 import (
 	"context"
 	"runtime"
-	"sort"
 	"sync"
 
 	"github.com/gogo/protobuf/proto"
@@ -12,10 +11,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/htrutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"go.opencensus.io/trace"
 )
 
@@ -166,6 +165,9 @@ func (b *BeaconState) Copy() iface.BeaconState {
 			v.MinusRef()
 			if b.stateFieldLeaves[field].reference != nil {
 				b.stateFieldLeaves[field].reference.MinusRef()
+				if featureconfig.Get().EnableFieldTriePooling {
+					b.stateFieldLeaves[field].releaseLayers()
+				}
 			}
 		}
 	})
@@ -173,6 +175,19 @@ func (b *BeaconState) Copy() iface.BeaconState {
 	return dst
 }
 
+// MarkShared flags a state which is shared between callers, such as the head state or
+// a cached state, and so must not be mutated. Mutations of shared states are logged when
+// the node is built with the state_audit tag. Copies of the state are not shared.
+func MarkShared(st iface.ReadOnlyBeaconState, name string) {
+	b, ok := st.(*BeaconState)
+	if !ok || !b.hasInnerState() {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.sharedAs = name
+}
+
 // HashTreeRoot of the beacon state retrieves the Merkle root of the trie
 // representation of the beacon state based on the eth2 Simple Serialize specification.
 func (b *BeaconState) HashTreeRoot(ctx context.Context) ([32]byte, error) {
@@ -181,7 +196,12 @@ func (b *BeaconState) HashTreeRoot(ctx context.Context) ([32]byte, error) {
 
 	b.lock.Lock()
 	defer b.lock.Unlock()
+	return b.hashTreeRoot()
+}
 
+// hashTreeRoot recomputes the dirty fields of the state and returns its root. This method
+// performs map reads and writes, and the caller MUST hold the lock before calling it.
+func (b *BeaconState) hashTreeRoot() ([32]byte, error) {
 	if b.merkleLayers == nil || len(b.merkleLayers) == 0 {
 		fieldRoots, err := computeFieldRoots(b.state)
 		if err != nil {
@@ -192,7 +212,15 @@ func (b *BeaconState) HashTreeRoot(ctx context.Context) ([32]byte, error) {
 		b.dirtyFields = make(map[fieldIndex]interface{}, params.BeaconConfig().BeaconStateFieldCount)
 	}
 
+	// Fields backed by large lists are recomputed concurrently, the others are cheap enough
+	// to be hashed in place.
+	jobs := make([]*fieldRootJob, 0, len(b.dirtyFields))
 	for field := range b.dirtyFields {
+		recordFieldOperation(fieldRehash, field)
+		if job, ok := b.newFieldRootJob(field); ok {
+			jobs = append(jobs, job)
+			continue
+		}
 		root, err := b.rootSelector(field)
 		if err != nil {
 			return [32]byte{}, err
@@ -201,6 +229,24 @@ func (b *BeaconState) HashTreeRoot(ctx context.Context) ([32]byte, error) {
 		b.recomputeRoot(int(field))
 		delete(b.dirtyFields, field)
 	}
+	if err := runFieldRootJobs(jobs); err != nil {
+		return [32]byte{}, err
+	}
+	var jobErr error
+	for _, job := range jobs {
+		if err := b.applyFieldRootJob(job); err != nil {
+			if jobErr == nil {
+				jobErr = err
+			}
+			continue
+		}
+		b.merkleLayers[0][job.field] = job.root[:]
+		b.recomputeRoot(int(job.field))
+		delete(b.dirtyFields, job.field)
+	}
+	if jobErr != nil {
+		return [32]byte{}, jobErr
+	}
 	return bytesutil.ToBytes32(b.merkleLayers[len(b.merkleLayers)-1][0]), nil
 }
 
@@ -225,6 +271,10 @@ func (b *BeaconState) FieldReferencesCount() map[string]uint64 {
 }
 
 func (b *BeaconState) rootSelector(field fieldIndex) ([32]byte, error) {
+	if job, ok := b.newFieldRootJob(field); ok {
+		job.run()
+		return job.root, b.applyFieldRootJob(job)
+	}
 	hasher := hashutil.CustomSHA256Hasher()
 	switch field {
 	case genesisTime:
@@ -239,91 +289,12 @@ func (b *BeaconState) rootSelector(field fieldIndex) ([32]byte, error) {
 		return htrutils.ForkRoot(b.state.Fork)
 	case latestBlockHeader:
 		return stateutil.BlockHeaderRoot(b.state.LatestBlockHeader)
-	case blockRoots:
-		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.state.BlockRoots, uint64(params.BeaconConfig().SlotsPerHistoricalRoot))
-			if err != nil {
-				return [32]byte{}, err
-			}
-			b.dirtyIndices[field] = []uint64{}
-			delete(b.rebuildTrie, field)
-			return b.stateFieldLeaves[field].TrieRoot()
-		}
-		return b.recomputeFieldTrie(blockRoots, b.state.BlockRoots)
-	case stateRoots:
-		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.state.StateRoots, uint64(params.BeaconConfig().SlotsPerHistoricalRoot))
-			if err != nil {
-				return [32]byte{}, err
-			}
-			b.dirtyIndices[field] = []uint64{}
-			delete(b.rebuildTrie, field)
-			return b.stateFieldLeaves[field].TrieRoot()
-		}
-		return b.recomputeFieldTrie(stateRoots, b.state.StateRoots)
 	case historicalRoots:
 		return htrutils.HistoricalRootsRoot(b.state.HistoricalRoots)
 	case eth1Data:
 		return eth1Root(hasher, b.state.Eth1Data)
-	case eth1DataVotes:
-		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.state.Eth1DataVotes, uint64(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(params.BeaconConfig().EpochsPerEth1VotingPeriod))))
-			if err != nil {
-				return [32]byte{}, err
-			}
-			b.dirtyIndices[field] = []uint64{}
-			delete(b.rebuildTrie, field)
-			return b.stateFieldLeaves[field].TrieRoot()
-		}
-		return b.recomputeFieldTrie(field, b.state.Eth1DataVotes)
-	case validators:
-		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.state.Validators, params.BeaconConfig().ValidatorRegistryLimit)
-			if err != nil {
-				return [32]byte{}, err
-			}
-			b.dirtyIndices[validators] = []uint64{}
-			delete(b.rebuildTrie, validators)
-			return b.stateFieldLeaves[field].TrieRoot()
-		}
-		return b.recomputeFieldTrie(validators, b.state.Validators)
-	case balances:
-		return stateutil.ValidatorBalancesRoot(b.state.Balances)
-	case randaoMixes:
-		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.state.RandaoMixes, uint64(params.BeaconConfig().EpochsPerHistoricalVector))
-			if err != nil {
-				return [32]byte{}, err
-			}
-			b.dirtyIndices[field] = []uint64{}
-			delete(b.rebuildTrie, field)
-			return b.stateFieldLeaves[field].TrieRoot()
-		}
-		return b.recomputeFieldTrie(randaoMixes, b.state.RandaoMixes)
 	case slashings:
 		return htrutils.SlashingsRoot(b.state.Slashings)
-	case previousEpochAttestations:
-		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.state.PreviousEpochAttestations, uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().MaxAttestations)))
-			if err != nil {
-				return [32]byte{}, err
-			}
-			b.dirtyIndices[field] = []uint64{}
-			delete(b.rebuildTrie, field)
-			return b.stateFieldLeaves[field].TrieRoot()
-		}
-		return b.recomputeFieldTrie(field, b.state.PreviousEpochAttestations)
-	case currentEpochAttestations:
-		if b.rebuildTrie[field] {
-			err := b.resetFieldTrie(field, b.state.CurrentEpochAttestations, uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().MaxAttestations)))
-			if err != nil {
-				return [32]byte{}, err
-			}
-			b.dirtyIndices[field] = []uint64{}
-			delete(b.rebuildTrie, field)
-			return b.stateFieldLeaves[field].TrieRoot()
-		}
-		return b.recomputeFieldTrie(field, b.state.CurrentEpochAttestations)
 	case justificationBits:
 		return bytesutil.ToBytes32(b.state.JustificationBits), nil
 	case previousJustifiedCheckpoint:
@@ -335,37 +306,3 @@ func (b *BeaconState) rootSelector(field fieldIndex) ([32]byte, error) {
 	}
 	return [32]byte{}, errors.New("invalid field index provided")
 }
-
-func (b *BeaconState) recomputeFieldTrie(index fieldIndex, elements interface{}) ([32]byte, error) {
-	fTrie := b.stateFieldLeaves[index]
-	if fTrie.reference.Refs() > 1 {
-		fTrie.Lock()
-		defer fTrie.Unlock()
-		fTrie.reference.MinusRef()
-		newTrie := fTrie.CopyTrie()
-		b.stateFieldLeaves[index] = newTrie
-		fTrie = newTrie
-	}
-	// remove duplicate indexes
-	b.dirtyIndices[index] = sliceutil.SetUint64(b.dirtyIndices[index])
-	// sort indexes again
-	sort.Slice(b.dirtyIndices[index], func(i int, j int) bool {
-		return b.dirtyIndices[index][i] < b.dirtyIndices[index][j]
-	})
-	root, err := fTrie.RecomputeTrie(b.dirtyIndices[index], elements)
-	if err != nil {
-		return [32]byte{}, err
-	}
-	b.dirtyIndices[index] = []uint64{}
-	return root, nil
-}
-
-func (b *BeaconState) resetFieldTrie(index fieldIndex, elements interface{}, length uint64) error {
-	fTrie, err := NewFieldTrie(index, elements, length)
-	if err != nil {
-		return err
-	}
-	b.stateFieldLeaves[index] = fTrie
-	b.dirtyIndices[index] = []uint64{}
-	return nil
-}
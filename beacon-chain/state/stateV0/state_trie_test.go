@@ -5,7 +5,9 @@ import (
 	"context"
 	"testing"
 
+	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -240,6 +242,140 @@ func TestBeaconState_HashTreeRoot_FieldTrie(t *testing.T) {
 	}
 }
 
+func TestBeaconState_HashTreeRoot_ManyDirtyFields(t *testing.T) {
+	testState, _ := testutil.DeterministicGenesisState(t, 64)
+	_, err := testState.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		// Copies share field tries with the original state, which must not be mutated.
+		original := testState.Copy()
+		originalRoot, err := original.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+
+		val, err := testState.ValidatorAtIndex(types.ValidatorIndex(i))
+		require.NoError(t, err)
+		val.EffectiveBalance -= params.BeaconConfig().EffectiveBalanceIncrement
+		require.NoError(t, testState.UpdateValidatorAtIndex(types.ValidatorIndex(i), val))
+		require.NoError(t, testState.UpdateBalancesAtIndex(types.ValidatorIndex(i), uint64(i)))
+		require.NoError(t, testState.UpdateBlockRootAtIndex(uint64(i), bytesutil.ToBytes32([]byte{'b', byte(i)})))
+		require.NoError(t, testState.UpdateStateRootAtIndex(uint64(i), bytesutil.ToBytes32([]byte{'s', byte(i)})))
+		require.NoError(t, testState.UpdateRandaoMixesAtIndex(uint64(i), bytesutil.PadTo([]byte{'r', byte(i)}, 32)))
+		require.NoError(t, testState.AppendEth1DataVotes(&eth.Eth1Data{
+			DepositRoot: bytesutil.PadTo([]byte{byte(i)}, 32),
+			BlockHash:   bytesutil.PadTo([]byte{byte(i)}, 32),
+		}))
+		require.NoError(t, testState.AppendCurrentEpochAttestations(&pbp2p.PendingAttestation{
+			AggregationBits: bitfield.NewBitlist(8),
+			Data:            testutil.HydrateAttestationData(&eth.AttestationData{Slot: types.Slot(i)}),
+		}))
+		require.NoError(t, testState.SetSlot(types.Slot(i+1)))
+
+		root, err := testState.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		pbState, err := stateV0.ProtobufBeaconState(testState.InnerStateUnsafe())
+		require.NoError(t, err)
+		genericHTR, err := pbState.HashTreeRoot()
+		require.NoError(t, err)
+		assert.DeepEqual(t, genericHTR, root, "Expected hash tree root to match generic")
+
+		copiedRoot, err := original.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, originalRoot, copiedRoot, "Copied state root changed")
+	}
+}
+
+func TestBeaconState_HashTreeRoot_Balances(t *testing.T) {
+	testState, _ := testutil.DeterministicGenesisState(t, 63)
+	_, err := testState.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	assertGenericRoot := func(t *testing.T) {
+		root, err := testState.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		pbState, err := stateV0.ProtobufBeaconState(testState.InnerStateUnsafe())
+		require.NoError(t, err)
+		genericHTR, err := pbState.HashTreeRoot()
+		require.NoError(t, err)
+		assert.DeepEqual(t, genericHTR, root, "Expected hash tree root to match generic")
+	}
+
+	t.Run("update every balance", func(t *testing.T) {
+		for i := 0; i < testState.NumValidators(); i++ {
+			require.NoError(t, testState.UpdateBalancesAtIndex(types.ValidatorIndex(i), uint64(i)))
+		}
+		assertGenericRoot(t)
+	})
+	t.Run("update balances out of order", func(t *testing.T) {
+		for _, i := range []types.ValidatorIndex{40, 3, 41, 2, 62} {
+			require.NoError(t, testState.UpdateBalancesAtIndex(i, uint64(i)*3))
+		}
+		assertGenericRoot(t)
+	})
+	t.Run("append balances", func(t *testing.T) {
+		// The first balance fills the last chunk, the others start new chunks.
+		for i := 0; i < 6; i++ {
+			require.NoError(t, testState.AppendBalance(uint64(i)))
+			require.NoError(t, testState.AppendValidator(&eth.Validator{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+			}))
+		}
+		assertGenericRoot(t)
+	})
+	t.Run("set balances", func(t *testing.T) {
+		require.NoError(t, testState.SetBalances(make([]uint64, testState.NumValidators())))
+		assertGenericRoot(t)
+	})
+}
+
+func TestBeaconState_HashTreeRoot_EpochAttestations(t *testing.T) {
+	testState, _ := testutil.DeterministicGenesisState(t, 16)
+	_, err := testState.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	assertGenericRoot := func(t *testing.T, st iface.BeaconState) {
+		root, err := st.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		pbState, err := stateV0.ProtobufBeaconState(st.InnerStateUnsafe())
+		require.NoError(t, err)
+		genericHTR, err := pbState.HashTreeRoot()
+		require.NoError(t, err)
+		assert.DeepEqual(t, genericHTR, root, "Expected hash tree root to match generic")
+	}
+	appendAtts := func(t *testing.T, st iface.BeaconState, n int) {
+		for i := 0; i < n; i++ {
+			att := &pbp2p.PendingAttestation{
+				AggregationBits: bitfield.Bitlist{0b1101},
+				Data:            testutil.HydrateAttestationData(&eth.AttestationData{Slot: types.Slot(i)}),
+				InclusionDelay:  1,
+			}
+			require.NoError(t, st.AppendCurrentEpochAttestations(att))
+		}
+	}
+
+	t.Run("append to empty list", func(t *testing.T) {
+		appendAtts(t, testState, 1)
+		assertGenericRoot(t, testState)
+	})
+	t.Run("append one at a time", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			appendAtts(t, testState, 1)
+			assertGenericRoot(t, testState)
+		}
+	})
+	t.Run("append many at once", func(t *testing.T) {
+		appendAtts(t, testState, 37)
+		assertGenericRoot(t, testState)
+	})
+	t.Run("append to copy", func(t *testing.T) {
+		copied := testState.Copy()
+		appendAtts(t, copied, 3)
+		assertGenericRoot(t, copied)
+		assertGenericRoot(t, testState)
+	})
+}
+
 func TestBeaconState_AppendValidator_DoesntMutateCopy(t *testing.T) {
 	st0, err := testutil.NewBeaconState()
 	require.NoError(t, err)
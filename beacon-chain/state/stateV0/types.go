@@ -26,6 +26,16 @@ func init() {
 	fieldMap[validators] = compositeArray
 	fieldMap[previousEpochAttestations] = compositeArray
 	fieldMap[currentEpochAttestations] = compositeArray
+
+	// Initialize the compressed arrays.
+	fieldMap[balances] = compressedArray
+
+	// Initialize the Altair fields. The sync committees are containers of both the pubkeys
+	// vector and the aggregate public key, so they are not kept in field tries and are
+	// rehashed whole instead.
+	fieldMap[inactivityScores] = compressedArray
+	fieldMap[previousEpochParticipationBits] = compressedArray
+	fieldMap[currentEpochParticipationBits] = compressedArray
 }
 
 type fieldIndex int
@@ -60,12 +70,21 @@ const (
 	previousJustifiedCheckpoint
 	currentJustifiedCheckpoint
 	finalizedCheckpoint
+	// Altair fields. In the Altair state, the participation fields take the positions of
+	// the phase 0 pending attestation fields.
+	inactivityScores
+	currentSyncCommittee
+	nextSyncCommittee
+	previousEpochParticipationBits
+	currentEpochParticipationBits
 )
 
 // List of current data types the state supports.
 const (
 	basicArray dataType = iota
 	compositeArray
+	// compressedArray is a list of basic elements which are packed into 32 byte chunks.
+	compressedArray
 )
 
 // fieldMap keeps track of each field
@@ -88,6 +107,7 @@ type BeaconState struct {
 	valMapHandler         *stateutil.ValidatorMapHandler
 	merkleLayers          [][][]byte
 	sharedFieldReferences map[fieldIndex]*stateutil.Reference
+	sharedAs              string
 }
 
 // String returns the name of the field index.
@@ -83,6 +83,15 @@ func (v ReadOnlyValidator) Slashed() bool {
 	return v.validator.Slashed
 }
 
+// Copy returns a copy of the read only validator, which can be modified and written back
+// to the state without affecting the other states sharing the validator.
+func (v ReadOnlyValidator) Copy() *ethpb.Validator {
+	if v.IsNil() {
+		return nil
+	}
+	return CopyValidator(v.validator)
+}
+
 // IsNil returns true if the validator is nil.
 func (v ReadOnlyValidator) IsNil() bool {
 	return v.validator == nil
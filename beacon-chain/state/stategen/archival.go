@@ -0,0 +1,219 @@
+package stategen
+
+import (
+	"context"
+	"errors"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// ArchivalMigration describes the changes made to the cold section of the DB when the number of
+// slots per archived point changes.
+type ArchivalMigration struct {
+	PreviousSlotsPerArchivedPoint types.Slot
+	SlotsPerArchivedPoint         types.Slot
+	SavedStates                   int
+	DeletedStates                 int
+}
+
+// ReplayCost describes the work needed to regenerate the state at a slot: the state replay starts
+// from, and the number of blocks and slots processed on top of it.
+type ReplayCost struct {
+	Slot      types.Slot
+	BlockRoot [32]byte
+	StartSlot types.Slot
+	StartRoot [32]byte
+	// InMemory is true when the start state is held in the state caches rather than read from the DB.
+	InMemory bool
+	Blocks   uint64
+	Slots    types.Slot
+}
+
+// SlotsPerArchivedPoint returns the number of slots between the states saved in the cold section of the DB.
+func (s *State) SlotsPerArchivedPoint() types.Slot {
+	s.archivalLock.RLock()
+	defer s.archivalLock.RUnlock()
+	return s.slotsPerArchivedPoint
+}
+
+// SetSlotsPerArchivedPoint changes the number of slots between the states saved in the cold section of
+// the DB, and re-indexes the existing cold section on the new interval. The states of the new archived
+// points up to the finalized slot are generated and saved first, so that an interrupted migration
+// leaves the node on the previous interval with a few extra states. Once the interval is switched, the
+// states of the previous archived points which are not on the new interval are deleted.
+//
+// Regenerating the states of a denser interval replays every finalized block, so this can take a
+// long time on an archival node. The states are generated without holding the archived point
+// interval, so that finalized states keep being migrated to the cold section meanwhile, and only the
+// states of the points finalized in the meantime are generated once the interval is held. Migrations
+// are not persisted: the node resumes with the interval it is started with.
+func (s *State) SetSlotsPerArchivedPoint(ctx context.Context, slots types.Slot) (*ArchivalMigration, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.SetSlotsPerArchivedPoint")
+	defer span.End()
+
+	if slots == 0 {
+		return nil, errors.New("slots per archived point must be positive")
+	}
+	s.migrationLock.Lock()
+	defer s.migrationLock.Unlock()
+
+	migration := &ArchivalMigration{
+		PreviousSlotsPerArchivedPoint: s.SlotsPerArchivedPoint(),
+		SlotsPerArchivedPoint:         slots,
+	}
+	if slots == migration.PreviousSlotsPerArchivedPoint {
+		return migration, nil
+	}
+	kept := make(map[[32]byte]bool)
+	fSlot, _ := s.finalizedPoint()
+	if err := s.saveArchivedPoints(ctx, slots, slots, fSlot, kept, migration); err != nil {
+		return nil, err
+	}
+
+	s.archivalLock.Lock()
+	defer s.archivalLock.Unlock()
+
+	// The finalized slot may have advanced while the states were generated.
+	start := fSlot
+	fSlot, fRoot := s.finalizedPoint()
+	if start%slots != 0 {
+		start += slots - start%slots
+	}
+	if start < slots {
+		start = slots
+	}
+	if err := s.saveArchivedPoints(ctx, slots, start, fSlot, kept, migration); err != nil {
+		return nil, err
+	}
+	s.slotsPerArchivedPoint = slots
+
+	previous := migration.PreviousSlotsPerArchivedPoint
+	var gRoot [32]byte
+	if previous < fSlot {
+		var err error
+		gRoot, err = s.genesisRoot(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for slot := previous; slot < fSlot; slot += previous {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if slot%slots == 0 {
+			continue
+		}
+		root, _, err := s.lastSavedBlock(ctx, slot)
+		if err != nil {
+			return nil, err
+		}
+		if kept[root] || root == gRoot || root == fRoot || !s.beaconDB.HasState(ctx, root) {
+			continue
+		}
+		if err := s.beaconDB.DeleteState(ctx, root); err != nil {
+			return nil, err
+		}
+		migration.DeletedStates++
+	}
+
+	log.WithFields(logrus.Fields{
+		"previousSlotsPerArchivedPoint": previous,
+		"slotsPerArchivedPoint":         slots,
+		"savedStates":                   migration.SavedStates,
+		"deletedStates":                 migration.DeletedStates,
+	}).Info("Changed slots per archived point")
+	return migration, nil
+}
+
+// This returns the slot and the block root of the finalized point.
+func (s *State) finalizedPoint() (types.Slot, [32]byte) {
+	s.finalizedInfo.lock.RLock()
+	defer s.finalizedInfo.lock.RUnlock()
+	return s.finalizedInfo.slot, s.finalizedInfo.root
+}
+
+// This generates and saves the missing states of the archived points of the given interval from the
+// start slot up to the end slot, recording the block roots of the archived points in kept.
+func (s *State) saveArchivedPoints(ctx context.Context, slots, start, end types.Slot, kept map[[32]byte]bool,
+	migration *ArchivalMigration) error {
+	for slot := start; slot < end; slot += slots {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		root, _, err := s.lastSavedBlock(ctx, slot)
+		if err != nil {
+			return err
+		}
+		kept[root] = true
+		if s.beaconDB.HasState(ctx, root) {
+			continue
+		}
+		st, err := s.StateByRoot(ctx, root)
+		if err != nil {
+			return err
+		}
+		if err := s.beaconDB.SaveState(ctx, st, root); err != nil {
+			return err
+		}
+		migration.SavedStates++
+	}
+	return nil
+}
+
+// PruneFinalized deletes the finalized states which are not at an archived point and the finalized
+// blocks which are not canonical from the DB. The archived points can't change while pruning.
+func (s *State) PruneFinalized(ctx context.Context) (*db.PruneStats, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.PruneFinalized")
+	defer span.End()
+
+	s.archivalLock.Lock()
+	defer s.archivalLock.Unlock()
+	return s.beaconDB.PruneFinalized(ctx, s.slotsPerArchivedPoint)
+}
+
+// ReplayCost returns the work needed to regenerate the state at the given slot, without regenerating
+// it. The state at a slot is the state of the last block at or before the slot, advanced to the slot.
+func (s *State) ReplayCost(ctx context.Context, slot types.Slot) (*ReplayCost, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.ReplayCost")
+	defer span.End()
+
+	root, _, err := s.lastSavedBlock(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	cost := &ReplayCost{Slot: slot, BlockRoot: root}
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		b, err := s.beaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if b == nil {
+			return nil, errUnknownBlock
+		}
+		inMemory, err := s.HasStateInCache(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		inMemory = inMemory || s.isFinalizedRoot(root)
+		if inMemory || s.beaconDB.HasState(ctx, root) {
+			cost.StartSlot = b.Block.Slot
+			cost.StartRoot = root
+			cost.InMemory = inMemory
+			cost.Slots = slot - b.Block.Slot
+			return cost, nil
+		}
+		root = bytesutil.ToBytes32(b.Block.ParentRoot)
+		if root == params.BeaconConfig().ZeroHash {
+			return nil, errUnknownState
+		}
+		cost.Blocks++
+	}
+}
@@ -0,0 +1,120 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSetSlotsPerArchivedPoint_ReindexesColdStates(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+	service.slotsPerArchivedPoint = 4
+
+	beaconState, pks := testutil.DeterministicGenesisState(t, 32)
+	genesisStateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	// Blocks at slots 1 to 4, with the state of the archived point at slot 4 saved.
+	roots := make([][32]byte, 5)
+	roots[0] = gRoot
+	st := beaconState.Copy()
+	for slot := types.Slot(1); slot <= 4; slot++ {
+		b, err := testutil.GenerateFullBlock(st, pks, testutil.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		st, err = executeStateTransitionStateGen(ctx, st, b)
+		require.NoError(t, err)
+		roots[slot], err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: slot, Root: roots[slot][:]}))
+	}
+	require.NoError(t, beaconDB.SaveState(ctx, st, roots[4]))
+	service.finalizedInfo = &finalizedInfo{slot: 5, root: roots[4], state: st}
+
+	_, err = service.SetSlotsPerArchivedPoint(ctx, 0)
+	assert.ErrorContains(t, "slots per archived point must be positive", err)
+
+	migration, err := service.SetSlotsPerArchivedPoint(ctx, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ArchivalMigration{
+		PreviousSlotsPerArchivedPoint: 4,
+		SlotsPerArchivedPoint:         2,
+		SavedStates:                   1,
+	}, migration)
+	assert.Equal(t, types.Slot(2), service.SlotsPerArchivedPoint())
+	saved, err := beaconDB.State(ctx, roots[2])
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.Equal(t, types.Slot(2), saved.Slot())
+
+	// The state of slot 2 is no longer on an archived point, while the finalized state is kept.
+	migration, err = service.SetSlotsPerArchivedPoint(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 1, migration.SavedStates)
+	assert.Equal(t, 1, migration.DeletedStates)
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[3]))
+	assert.Equal(t, false, beaconDB.HasState(ctx, roots[2]))
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[4]))
+}
+
+func TestReplayCost(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+
+	beaconState, pks := testutil.DeterministicGenesisState(t, 32)
+	genesisStateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	b1, err := testutil.GenerateFullBlock(beaconState, pks, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, b1))
+	r1, err := b1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	b2 := testutil.NewBeaconBlock()
+	b2.Block.Slot = 3
+	b2.Block.ParentRoot = r1[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, b2))
+	r2, err := b2.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	cost, err := service.ReplayCost(ctx, 5)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ReplayCost{
+		Slot:      5,
+		BlockRoot: r2,
+		StartSlot: 0,
+		StartRoot: gRoot,
+		Blocks:    2,
+		Slots:     5,
+	}, cost)
+
+	service.hotStateCache.put(r1, beaconState)
+	cost, err = service.ReplayCost(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, r1, cost.StartRoot)
+	assert.Equal(t, true, cost.InMemory)
+	assert.Equal(t, uint64(1), cost.Blocks)
+	assert.Equal(t, types.Slot(2), cost.Slots)
+}
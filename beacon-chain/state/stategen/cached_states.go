@@ -0,0 +1,70 @@
+package stategen
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+)
+
+// CachedState describes a state held in memory by the state management service.
+type CachedState struct {
+	// Cache is the name of the cache holding the state.
+	Cache string
+	// Root is the block root of the state.
+	Root [32]byte
+	// Slot is the slot of the state.
+	Slot types.Slot
+}
+
+// CachedStates lists the states held in memory: the finalized state, then the states of the hot
+// state cache, the epoch boundary state cache and the replay state cache, each cache from its
+// least recently added or used state.
+func (s *State) CachedStates() []*CachedState {
+	var states []*CachedState
+	s.finalizedInfo.lock.RLock()
+	if s.finalizedInfo.state != nil {
+		states = append(states, &CachedState{
+			Cache: "finalized",
+			Root:  s.finalizedInfo.root,
+			Slot:  s.finalizedInfo.state.Slot(),
+		})
+	}
+	s.finalizedInfo.lock.RUnlock()
+
+	s.hotStateCache.lock.RLock()
+	states = append(states, lruCachedStates("hot", s.hotStateCache.cache)...)
+	s.hotStateCache.lock.RUnlock()
+
+	s.epochBoundaryStateCache.lock.RLock()
+	for _, item := range s.epochBoundaryStateCache.rootStateCache.List() {
+		info, ok := item.(*rootStateInfo)
+		if !ok || info.state == nil {
+			continue
+		}
+		states = append(states, &CachedState{Cache: "epoch-boundary", Root: info.root, Slot: info.state.Slot()})
+	}
+	s.epochBoundaryStateCache.lock.RUnlock()
+
+	return append(states, lruCachedStates("replay", s.replayStateCache.cache)...)
+}
+
+// lruCachedStates lists the states of a cache keyed by block root, without updating their recency.
+func lruCachedStates(name string, cache *lru.Cache) []*CachedState {
+	var states []*CachedState
+	for _, key := range cache.Keys() {
+		root, ok := key.([32]byte)
+		if !ok {
+			continue
+		}
+		item, ok := cache.Peek(root)
+		if !ok {
+			continue
+		}
+		st, ok := item.(iface.BeaconState)
+		if !ok {
+			continue
+		}
+		states = append(states, &CachedState{Cache: name, Root: root, Slot: st.Slot()})
+	}
+	return states
+}
@@ -0,0 +1,38 @@
+package stategen
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestState_CachedStates(t *testing.T) {
+	service := New(nil)
+	assert.Equal(t, 0, len(service.CachedStates()))
+
+	stateAt := func(slot types.Slot) iface.BeaconState {
+		st, err := stateV0.InitializeFromProto(&pb.BeaconState{Slot: slot})
+		require.NoError(t, err)
+		return st
+	}
+	service.SaveFinalizedState(32, [32]byte{'F'}, stateAt(32))
+	service.hotStateCache.put([32]byte{'A'}, stateAt(33))
+	require.NoError(t, service.epochBoundaryStateCache.put([32]byte{'B'}, stateAt(64)))
+	service.replayStateCache.put([32]byte{'C'}, stateAt(96))
+	service.hotStateCache.put([32]byte{'D'}, stateAt(34))
+	// Entries which are not states are skipped.
+	service.replayStateCache.cache.Add([32]byte{'E'}, "not a state")
+
+	assert.DeepEqual(t, []*CachedState{
+		{Cache: "finalized", Root: [32]byte{'F'}, Slot: 32},
+		{Cache: "hot", Root: [32]byte{'A'}, Slot: 33},
+		{Cache: "hot", Root: [32]byte{'D'}, Slot: 34},
+		{Cache: "epoch-boundary", Root: [32]byte{'B'}, Slot: 64},
+		{Cache: "replay", Root: [32]byte{'C'}, Slot: 96},
+	}, service.CachedStates())
+}
@@ -7,6 +7,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -122,9 +123,11 @@ func (e *epochBoundaryState) put(r [32]byte, s iface.BeaconState) error {
 	}); err != nil {
 		return err
 	}
+	cached := s.Copy()
+	stateV0.MarkShared(cached, "epoch-boundary-state-cache")
 	if err := e.rootStateCache.AddIfNotPresent(&rootStateInfo{
 		root:  r,
-		state: s.Copy(),
+		state: cached,
 	}); err != nil {
 		return err
 	}
@@ -162,6 +162,11 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (iface.
 		return cachedInfo.state, nil
 	}
 
+	// Third, it checks if the state was kept while replaying blocks over an epoch boundary.
+	if replayed := s.replayStateCache.get(blockRoot); replayed != nil {
+		return replayed, nil
+	}
+
 	// Short cut if the cachedState is already in the DB.
 	if s.beaconDB.HasState(ctx, blockRoot) {
 		return s.beaconDB.State(ctx, blockRoot)
@@ -281,6 +286,11 @@ func (s *State) lastAncestorState(ctx context.Context, root [32]byte) (iface.Bea
 			return cachedInfo.state, nil
 		}
 
+		// Does the state exist in replay state cache.
+		if replayed := s.replayStateCache.get(parentRoot); replayed != nil {
+			return replayed, nil
+		}
+
 		// Does the state exists in DB.
 		if s.beaconDB.HasState(ctx, parentRoot) {
 			return s.beaconDB.State(ctx, parentRoot)
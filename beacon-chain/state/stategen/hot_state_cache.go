@@ -6,7 +6,9 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 )
 
 var (
@@ -25,8 +27,9 @@ var (
 
 // hotStateCache is used to store the processed beacon state after finalized check point..
 type hotStateCache struct {
-	cache *lru.Cache
-	lock  sync.RWMutex
+	access cache.AccessCounter
+	cache  *lru.Cache
+	lock   sync.RWMutex
 }
 
 // newHotStateCache initializes the map and underlying cache.
@@ -49,9 +52,11 @@ func (c *hotStateCache) get(root [32]byte) iface.BeaconState {
 
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
+		c.access.Hit()
 		return item.(iface.BeaconState).Copy()
 	}
 	hotStateCacheMiss.Inc()
+	c.access.Miss()
 	return nil
 }
 
@@ -62,9 +67,11 @@ func (c *hotStateCache) getWithoutCopy(root [32]byte) iface.BeaconState {
 	item, exists := c.cache.Get(root)
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
+		c.access.Hit()
 		return item.(iface.BeaconState)
 	}
 	hotStateCacheMiss.Inc()
+	c.access.Miss()
 	return nil
 }
 
@@ -72,6 +79,7 @@ func (c *hotStateCache) getWithoutCopy(root [32]byte) iface.BeaconState {
 func (c *hotStateCache) put(root [32]byte, state iface.BeaconState) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	stateV0.MarkShared(state, "hot-state-cache")
 	c.cache.Add(root, state)
 }
 
@@ -88,3 +96,25 @@ func (c *hotStateCache) delete(root [32]byte) bool {
 	defer c.lock.Unlock()
 	return c.cache.Remove(root)
 }
+
+// Stats returns the number of cached hot states and their estimated size.
+func (c *hotStateCache) Stats() *cache.Stats {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	size := uint64(0)
+	for _, k := range c.cache.Keys() {
+		if item, ok := c.cache.Peek(k); ok {
+			if st, ok := item.(iface.ReadOnlyBeaconState); ok {
+				size += cache.EstimatedStateSize(st)
+			}
+		}
+	}
+	return c.access.Stats(c.cache.Len(), size)
+}
+
+// Flush removes all the hot states from the cache.
+func (c *hotStateCache) Flush() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Purge()
+}
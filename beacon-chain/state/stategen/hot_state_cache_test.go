@@ -32,3 +32,26 @@ func TestHotStateCache_RoundTrip(t *testing.T) {
 	c.delete(root)
 	assert.Equal(t, false, c.has(root), "Cache not supposed to have the object")
 }
+
+func TestHotStateCache_StatsAndFlush(t *testing.T) {
+	c := newHotStateCache()
+	root := [32]byte{'A'}
+	assert.Equal(t, iface.BeaconState(nil), c.get(root))
+
+	state, err := stateV0.InitializeFromProto(&pb.BeaconState{
+		Slot: 10,
+	})
+	require.NoError(t, err)
+	c.put(root, state)
+	assert.NotNil(t, c.getWithoutCopy(root))
+
+	stats := c.Stats()
+	assert.Equal(t, 1, stats.Entries)
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.NotEqual(t, uint64(0), stats.EstimatedBytes)
+
+	c.Flush()
+	assert.Equal(t, false, c.has(root), "Cache not supposed to have the object")
+	assert.Equal(t, 0, c.Stats().Entries)
+}
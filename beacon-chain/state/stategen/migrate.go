@@ -18,6 +18,10 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "stateGen.MigrateToCold")
 	defer span.End()
 
+	// Hold the archived point interval for the whole migration, so that it can't be re-indexed concurrently.
+	s.archivalLock.RLock()
+	defer s.archivalLock.RUnlock()
+
 	s.finalizedInfo.lock.RLock()
 	oldFSlot := s.finalizedInfo.slot
 	s.finalizedInfo.lock.RUnlock()
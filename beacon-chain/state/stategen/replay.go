@@ -7,10 +7,12 @@ import (
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	transition "github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"go.opencensus.io/trace"
 )
 
@@ -18,6 +20,12 @@ import (
 func (s *State) ReplayBlocks(ctx context.Context, state iface.BeaconState, signed []*ethpb.SignedBeaconBlock, targetSlot types.Slot) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.ReplayBlocks")
 	defer span.End()
+	// Deposits processed by the finalized state had their proofs verified when their blocks were processed.
+	ctx = blocks.WithFinalizedDepositCount(ctx, s.finalizedDepositCount())
+
+	if featureconfig.Get().EnableParallelReplay {
+		return s.replayBlocksParallel(ctx, state, signed, targetSlot)
+	}
 
 	var err error
 	// The input block list is sorted in decreasing slots order.
@@ -166,6 +174,9 @@ func processSlotsStateGen(ctx context.Context, state iface.BeaconState, slot typ
 
 	var err error
 	for state.Slot() < slot {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		state, err = transition.ProcessSlot(ctx, state)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not process slot")
@@ -0,0 +1,163 @@
+package stategen
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// replayVerification holds the results of the checks of the blocks of a replay, which run on a
+// pool of workers while the blocks are applied.
+type replayVerification struct {
+	roots [][32]byte
+	errs  []error
+	done  []chan struct{}
+}
+
+// verifyReplayBlocks starts checking the input blocks, ordered by increasing slots, on as many
+// workers as there are usable CPUs. Each block is hashed, checked to be well formed, and its
+// proposer signature is verified against the validator registry of the replay start state.
+// Blocks of proposers which joined the registry after the start state are only checked to be
+// well formed.
+func verifyReplayBlocks(ctx context.Context, st iface.ReadOnlyBeaconState, blks []*ethpb.SignedBeaconBlock) *replayVerification {
+	v := &replayVerification{
+		roots: make([][32]byte, len(blks)),
+		errs:  make([]error, len(blks)),
+		done:  make([]chan struct{}, len(blks)),
+	}
+	jobs := make(chan int, len(blks))
+	for i := range blks {
+		v.done[i] = make(chan struct{})
+		jobs <- i
+	}
+	close(jobs)
+
+	fork, genesisValidatorsRoot, numValidators := st.Fork(), st.GenesisValidatorRoot(), types.ValidatorIndex(st.NumValidators())
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(blks) {
+		workers = len(blks)
+	}
+	for w := 0; w < workers; w++ {
+		go func() {
+			for i := range jobs {
+				if ctx.Err() != nil {
+					v.errs[i] = ctx.Err()
+				} else {
+					v.roots[i], v.errs[i] = verifyReplayBlock(st, fork, genesisValidatorsRoot, numValidators, blks[i])
+				}
+				close(v.done[i])
+			}
+		}()
+	}
+	return v
+}
+
+// wait returns the root of the block of the given index once it is checked.
+func (v *replayVerification) wait(ctx context.Context, i int) ([32]byte, error) {
+	select {
+	case <-v.done[i]:
+		return v.roots[i], v.errs[i]
+	case <-ctx.Done():
+		return [32]byte{}, ctx.Err()
+	}
+}
+
+func verifyReplayBlock(
+	st iface.ReadOnlyBeaconState,
+	fork *pb.Fork,
+	genesisValidatorsRoot []byte,
+	numValidators types.ValidatorIndex,
+	signed *ethpb.SignedBeaconBlock,
+) ([32]byte, error) {
+	if signed == nil || signed.Block == nil || signed.Block.Body == nil {
+		return [32]byte{}, errUnknownBlock
+	}
+	if len(signed.Signature) != params.BeaconConfig().BLSSignatureLength {
+		return [32]byte{}, fmt.Errorf("invalid signature length %d", len(signed.Signature))
+	}
+	root, err := signed.Block.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if signed.Block.ProposerIndex >= numValidators {
+		return root, nil
+	}
+	proposer, err := st.ValidatorAtIndexReadOnly(signed.Block.ProposerIndex)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	pubKey := proposer.PublicKey()
+	domain, err := helpers.Domain(fork, helpers.SlotToEpoch(signed.Block.Slot), params.BeaconConfig().DomainBeaconProposer, genesisValidatorsRoot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if err := helpers.VerifyBlockSigningRoot(signed.Block, pubKey[:], signed.Signature, domain); err != nil {
+		return [32]byte{}, errors.Wrapf(err, "could not verify proposer signature of block at slot %d", signed.Block.Slot)
+	}
+	return root, nil
+}
+
+// replayBlocksParallel replays the input blocks, sorted in decreasing slots order, on the input state
+// until the target slot is reached. The blocks are checked on a pool of workers ahead of their
+// application, while the transitions apply sequentially without verifications. The post states of
+// the blocks crossing an epoch boundary are kept in the replay state cache.
+func (s *State) replayBlocksParallel(ctx context.Context, state iface.BeaconState, signed []*ethpb.SignedBeaconBlock, targetSlot types.Slot) (iface.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.replayBlocksParallel")
+	defer span.End()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	blks := make([]*ethpb.SignedBeaconBlock, 0, len(signed))
+	for i := len(signed) - 1; i >= 0; i-- {
+		if signed[i] == nil || signed[i].Block == nil {
+			return nil, errUnknownBlock
+		}
+		// A node shouldn't process the block if the block slot is lower than the slot of the state
+		// it applies to.
+		if signed[i].Block.Slot <= state.Slot() || (len(blks) > 0 && signed[i].Block.Slot <= blks[len(blks)-1].Block.Slot) {
+			continue
+		}
+		if signed[i].Block.Slot > targetSlot {
+			break
+		}
+		blks = append(blks, signed[i])
+	}
+	verification := verifyReplayBlocks(ctx, state, blks)
+
+	for i, b := range blks {
+		root, err := verification.wait(ctx, i)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not verify block at slot %d", b.Block.Slot)
+		}
+		if i > 0 {
+			parentRoot := verification.roots[i-1]
+			if !bytes.Equal(b.Block.ParentRoot, parentRoot[:]) {
+				return nil, fmt.Errorf("block at slot %d does not descend from the previous block of the replay", b.Block.Slot)
+			}
+		}
+		preEpoch := helpers.SlotToEpoch(state.Slot())
+		state, err = executeStateTransitionStateGen(ctx, state, b)
+		if err != nil {
+			return nil, err
+		}
+		if helpers.SlotToEpoch(state.Slot()) > preEpoch {
+			s.replayStateCache.put(root, state.Copy())
+		}
+	}
+
+	// If there is skip slots at the end.
+	if targetSlot > state.Slot() {
+		return processSlotsStateGen(ctx, state, targetSlot)
+	}
+	return state, nil
+}
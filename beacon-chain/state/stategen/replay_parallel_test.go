@@ -0,0 +1,70 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestReplayBlocks_Parallel(t *testing.T) {
+	ctx := context.Background()
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{
+		EnableParallelReplay: true,
+	})
+	defer resetCfg()
+
+	beaconState, pks := testutil.DeterministicGenesisState(t, 32)
+	st := beaconState.Copy()
+	var blks []*ethpb.SignedBeaconBlock
+	for _, slot := range []types.Slot{1, 2, params.BeaconConfig().SlotsPerEpoch + 1} {
+		b, err := testutil.GenerateFullBlock(st, pks, testutil.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		st, err = executeStateTransitionStateGen(ctx, st, b)
+		require.NoError(t, err)
+		// Blocks are replayed from a list sorted in decreasing slots order.
+		blks = append([]*ethpb.SignedBeaconBlock{b}, blks...)
+	}
+	targetSlot := params.BeaconConfig().SlotsPerEpoch + 3
+	want, err := processSlotsStateGen(ctx, st, targetSlot)
+	require.NoError(t, err)
+
+	service := New(testDB.SetupDB(t))
+	got, err := service.ReplayBlocks(ctx, beaconState.Copy(), blks, targetSlot)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, want.InnerStateUnsafe(), got.InnerStateUnsafe())
+
+	// The post state of the block crossing the epoch boundary is kept for later replays.
+	crossingRoot, err := blks[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	cached := service.replayStateCache.get(crossingRoot)
+	require.NotNil(t, cached)
+	assert.Equal(t, blks[0].Block.Slot, cached.Slot())
+	notCrossingRoot, err := blks[1].Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, nil, service.replayStateCache.get(notCrossingRoot))
+}
+
+func TestReplayBlocks_ParallelRejectsInvalidSignature(t *testing.T) {
+	ctx := context.Background()
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{
+		EnableParallelReplay: true,
+	})
+	defer resetCfg()
+
+	beaconState, pks := testutil.DeterministicGenesisState(t, 32)
+	b, err := testutil.GenerateFullBlock(beaconState, pks, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	b.Signature = make([]byte, params.BeaconConfig().BLSSignatureLength)
+
+	service := New(testDB.SetupDB(t))
+	_, err = service.ReplayBlocks(ctx, beaconState, []*ethpb.SignedBeaconBlock{b}, 1)
+	assert.ErrorContains(t, "could not verify block at slot 1", err)
+}
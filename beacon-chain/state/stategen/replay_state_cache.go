@@ -0,0 +1,59 @@
+package stategen
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+)
+
+var (
+	// replayStateCacheSize defines the max number of intermediate replay states this can cache.
+	// Replays from an archived point cross up to 64 epochs on mainnet, so only the latest
+	// boundaries of the latest replays are kept.
+	replayStateCacheSize = 16
+	// Metrics
+	replayStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "replay_state_cache_hit",
+		Help: "The total number of cache hits on the replay state cache.",
+	})
+	replayStateCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "replay_state_cache_miss",
+		Help: "The total number of cache misses on the replay state cache.",
+	})
+)
+
+// replayStateCache keeps the post states of the blocks crossing an epoch boundary during a replay,
+// keyed by block root, so that later replays over the same blocks can start from them.
+type replayStateCache struct {
+	cache *lru.Cache
+}
+
+// newReplayStateCache initializes the underlying cache.
+func newReplayStateCache() *replayStateCache {
+	cache, err := lru.New(replayStateCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &replayStateCache{
+		cache: cache,
+	}
+}
+
+// get returns a copy of the cached state of the input block root, if any.
+func (c *replayStateCache) get(root [32]byte) iface.BeaconState {
+	item, exists := c.cache.Get(root)
+	if exists && item != nil {
+		replayStateCacheHit.Inc()
+		return item.(iface.BeaconState).Copy()
+	}
+	replayStateCacheMiss.Inc()
+	return nil
+}
+
+// put the state in the cache. The state must not be mutated afterwards.
+func (c *replayStateCache) put(root [32]byte, state iface.BeaconState) {
+	stateV0.MarkShared(state, "replay-state-cache")
+	c.cache.Add(root, state)
+}
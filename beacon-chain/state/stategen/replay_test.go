@@ -47,6 +47,18 @@ func TestReplayBlocks_AllSkipSlots(t *testing.T) {
 	assert.Equal(t, targetSlot, newState.Slot(), "Did not advance slots")
 }
 
+func TestReplayBlocks_SkipSlotsCanceled(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service := New(beaconDB)
+	_, err := service.ReplayBlocks(ctx, beaconState, []*ethpb.SignedBeaconBlock{}, params.BeaconConfig().SlotsPerEpoch-1)
+	assert.ErrorContains(t, context.Canceled.Error(), err)
+	assert.Equal(t, types.Slot(0), beaconState.Slot(), "Slots were processed after cancellation")
+}
+
 func TestReplayBlocks_SameSlot(t *testing.T) {
 	beaconDB := testDB.SetupDB(t)
 
@@ -10,6 +10,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	ethereum_beacon_p2p_v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -44,9 +45,12 @@ type StateManager interface {
 type State struct {
 	beaconDB                db.NoHeadAccessDatabase
 	slotsPerArchivedPoint   types.Slot
+	archivalLock            sync.RWMutex
+	migrationLock           sync.Mutex
 	hotStateCache           *hotStateCache
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
+	replayStateCache        *replayStateCache
 	saveHotStateDB          *saveHotStateDbConfig
 }
 
@@ -71,16 +75,19 @@ type finalizedInfo struct {
 
 // New returns a new state management object.
 func New(beaconDB db.NoHeadAccessDatabase) *State {
-	return &State{
+	s := &State{
 		beaconDB:                beaconDB,
 		hotStateCache:           newHotStateCache(),
 		finalizedInfo:           &finalizedInfo{slot: 0, root: params.BeaconConfig().ZeroHash},
 		slotsPerArchivedPoint:   params.BeaconConfig().SlotsPerArchivedPoint,
 		epochBoundaryStateCache: newBoundaryStateCache(),
+		replayStateCache:        newReplayStateCache(),
 		saveHotStateDB: &saveHotStateDbConfig{
 			duration: defaultHotStateDBInterval,
 		},
 	}
+	cache.DefaultRegistry.Register("hot-state", s.hotStateCache)
+	return s
 }
 
 // Resume resumes a new state management object from previously saved finalized check point in DB.
@@ -106,7 +113,7 @@ func (s *State) Resume(ctx context.Context) (iface.BeaconState, error) {
 	}
 
 	go func() {
-		if err := s.beaconDB.CleanUpDirtyStates(ctx, s.slotsPerArchivedPoint); err != nil {
+		if err := s.beaconDB.CleanUpDirtyStates(ctx, s.SlotsPerArchivedPoint()); err != nil {
 			log.WithError(err).Error("Could not clean up dirty states")
 		}
 	}()
@@ -127,6 +134,16 @@ func (s *State) SaveFinalizedState(fSlot types.Slot, fRoot [32]byte, fState ifac
 	s.finalizedInfo.slot = fSlot
 }
 
+// Returns the number of deposits processed by the cached finalized state.
+func (s *State) finalizedDepositCount() uint64 {
+	s.finalizedInfo.lock.RLock()
+	defer s.finalizedInfo.lock.RUnlock()
+	if s.finalizedInfo.state == nil {
+		return 0
+	}
+	return s.finalizedInfo.state.Eth1DepositIndex()
+}
+
 // Returns true if input root equals to cached finalized root.
 func (s *State) isFinalizedRoot(r [32]byte) bool {
 	s.finalizedInfo.lock.RLock()
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -34,3 +35,29 @@ func TestResume(t *testing.T) {
 	assert.Equal(t, service.finalizedInfo.root, root, "Did not get wanted root")
 	assert.NotNil(t, service.finalizedState(), "Wanted a non nil finalized state")
 }
+
+func TestFinalizedDepositCount(t *testing.T) {
+	service := New(testDB.SetupDB(t))
+	assert.Equal(t, uint64(0), service.finalizedDepositCount())
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	require.NoError(t, beaconState.SetEth1DepositIndex(32))
+	service.SaveFinalizedState(0, [32]byte{'a'}, beaconState)
+	assert.Equal(t, uint64(32), service.finalizedDepositCount())
+}
+
+func TestNew_RegistersHotStateCache(t *testing.T) {
+	s := New(testDB.SetupDB(t))
+	found := false
+	for _, stats := range cache.DefaultRegistry.Stats() {
+		if stats.Name == "hot-state" {
+			found = true
+		}
+	}
+	assert.Equal(t, true, found, "Hot state cache is not registered")
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	s.hotStateCache.put([32]byte{'a'}, st)
+	require.NoError(t, cache.DefaultRegistry.Flush("hot-state"))
+	assert.Equal(t, false, s.hotStateCache.has([32]byte{'a'}))
+}
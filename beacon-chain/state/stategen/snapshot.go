@@ -0,0 +1,42 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"go.opencensus.io/trace"
+)
+
+// Snapshot is the canonical state at a slot along with the block of its latest block header, which
+// together allow a node to start from the state.
+type Snapshot struct {
+	State iface.BeaconState
+	Block *ethpb.SignedBeaconBlock
+}
+
+// SnapshotBySlot materializes the canonical state at the given slot, along with the last block at or
+// before the slot.
+func (s *State) SnapshotBySlot(ctx context.Context, slot types.Slot) (*Snapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.SnapshotBySlot")
+	defer span.End()
+
+	root, _, err := s.lastSavedBlock(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last block of slot")
+	}
+	blk, err := s.beaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil || blk.Block == nil {
+		return nil, errUnknownBlock
+	}
+	st, err := s.StateBySlot(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get state of slot")
+	}
+	return &Snapshot{State: st, Block: blk}, nil
+}
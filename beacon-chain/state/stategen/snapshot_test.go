@@ -0,0 +1,49 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSnapshotBySlot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+
+	beaconState, pks := testutil.DeterministicGenesisState(t, 32)
+	genesisStateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	b, err := testutil.GenerateFullBlock(beaconState, pks, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, b))
+	bRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 1, Root: bRoot[:]}))
+
+	slot := params.BeaconConfig().SlotsPerEpoch
+	snapshot, err := service.SnapshotBySlot(ctx, slot)
+	require.NoError(t, err)
+	assert.Equal(t, slot, snapshot.State.Slot())
+	snapshotRoot, err := snapshot.Block.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, bRoot, snapshotRoot)
+	// The latest block header of the state commits to the block of the snapshot.
+	headerRoot, err := snapshot.State.LatestBlockHeader().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, bRoot, headerRoot)
+}
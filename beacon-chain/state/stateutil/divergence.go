@@ -0,0 +1,229 @@
+package stateutil
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// Divergence is the first leaf at which two beacon states differ. For vectors and lists,
+// Index is the index of the differing element within the field.
+type Divergence struct {
+	Field     string
+	Index     uint64
+	IsElement bool
+}
+
+// String returns the location of the divergence, such as validators[12].
+func (d *Divergence) String() string {
+	if !d.IsElement {
+		return d.Field
+	}
+	return fmt.Sprintf("%s[%d]", d.Field, d.Index)
+}
+
+// stateField describes how to compute the leaves of a field of the beacon state. Fields which
+// are not vectors or lists have a single leaf, their root.
+type stateField struct {
+	name      string
+	isElement bool
+	leaves    func(hasher htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error)
+}
+
+// stateFields lists the fields of the beacon state, in the order of the state trie.
+var stateFields = []stateField{
+	{name: "genesisTime", leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return [][32]byte{htrutils.Uint64Root(st.GenesisTime())}, nil
+	}},
+	{name: "genesisValidatorRoot", leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return [][32]byte{bytesutil.ToBytes32(st.GenesisValidatorRoot())}, nil
+	}},
+	{name: "slot", leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return [][32]byte{htrutils.Uint64Root(uint64(st.Slot()))}, nil
+	}},
+	{name: "fork", leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return singleLeaf(htrutils.ForkRoot(st.Fork()))
+	}},
+	{name: "latestBlockHeader", leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return singleLeaf(BlockHeaderRoot(st.LatestBlockHeader()))
+	}},
+	{name: "blockRoots", isElement: true, leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return rootLeaves(st.BlockRoots()), nil
+	}},
+	{name: "stateRoots", isElement: true, leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return rootLeaves(st.StateRoots()), nil
+	}},
+	{name: "historicalRoots", isElement: true, leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return rootLeaves(st.HistoricalRoots()), nil
+	}},
+	{name: "eth1Data", leaves: func(hasher htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return singleLeaf(Eth1DataRootWithHasher(hasher, st.Eth1Data()))
+	}},
+	{name: "eth1DataVotes", isElement: true, leaves: func(hasher htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		votes := st.Eth1DataVotes()
+		leaves := make([][32]byte, len(votes))
+		for i, v := range votes {
+			r, err := Eth1DataRootWithHasher(hasher, v)
+			if err != nil {
+				return nil, err
+			}
+			leaves[i] = r
+		}
+		return leaves, nil
+	}},
+	{name: "eth1DepositIndex", leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return [][32]byte{htrutils.Uint64Root(st.Eth1DepositIndex())}, nil
+	}},
+	{name: "validators", isElement: true, leaves: func(hasher htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		leaves := make([][32]byte, 0, st.NumValidators())
+		err := st.ReadFromEveryValidator(func(_ int, val iface.ReadOnlyValidator) error {
+			r, err := ValidatorRootWithHasher(hasher, val.Copy())
+			if err != nil {
+				return err
+			}
+			leaves = append(leaves, r)
+			return nil
+		})
+		return leaves, err
+	}},
+	{name: "balances", isElement: true, leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return uint64Leaves(st.Balances()), nil
+	}},
+	{name: "randaoMixes", isElement: true, leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return rootLeaves(st.RandaoMixes()), nil
+	}},
+	{name: "slashings", isElement: true, leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return uint64Leaves(st.Slashings()), nil
+	}},
+	{name: "previousEpochAttestations", isElement: true, leaves: func(hasher htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return pendingAttLeaves(hasher, st.PreviousEpochAttestations())
+	}},
+	{name: "currentEpochAttestations", isElement: true, leaves: func(hasher htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return pendingAttLeaves(hasher, st.CurrentEpochAttestations())
+	}},
+	{name: "justificationBits", leaves: func(_ htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return [][32]byte{bytesutil.ToBytes32(st.JustificationBits())}, nil
+	}},
+	{name: "previousJustifiedCheckpoint", leaves: func(hasher htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return checkpointLeaf(hasher, st.PreviousJustifiedCheckpoint())
+	}},
+	{name: "currentJustifiedCheckpoint", leaves: func(hasher htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return checkpointLeaf(hasher, st.CurrentJustifiedCheckpoint())
+	}},
+	{name: "finalizedCheckpoint", leaves: func(hasher htrutils.HashFn, st iface.ReadOnlyBeaconState) ([][32]byte, error) {
+		return checkpointLeaf(hasher, st.FinalizedCheckpoint())
+	}},
+}
+
+// FirstDivergence locates the first leaf, in the order of the state trie, at which the two
+// beacon states differ, or returns nil if the states are equal. Vectors and lists are compared
+// by walking their tries from the root down to the first differing element, and a list which
+// is a prefix of the other diverges at the first element past its end.
+func FirstDivergence(a, b iface.ReadOnlyBeaconState) (*Divergence, error) {
+	if a == nil || b == nil {
+		return nil, errors.New("nil state")
+	}
+	hasher := hashutil.CustomSHA256Hasher()
+	for _, f := range stateFields {
+		leavesA, err := f.leaves(hasher, a)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute leaves of field %s", f.name)
+		}
+		leavesB, err := f.leaves(hasher, b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute leaves of field %s", f.name)
+		}
+		if idx, ok := firstDifferingLeaf(leavesA, leavesB); ok {
+			return &Divergence{Field: f.name, Index: idx, IsElement: f.isElement}, nil
+		}
+	}
+	return nil, nil
+}
+
+// firstDifferingLeaf returns the index of the first leaf which differs between the two lists
+// of leaves, by descending their tries along the leftmost differing branch.
+func firstDifferingLeaf(a, b [][32]byte) (uint64, bool) {
+	length := len(a)
+	if len(b) > length {
+		length = len(b)
+	}
+	if length == 0 {
+		return 0, false
+	}
+	layersA := ReturnTrieLayerVariable(a, uint64(length))
+	layersB := ReturnTrieLayerVariable(b, uint64(length))
+	depth := len(layersA) - 1
+	if trieNode(layersA, depth, 0) == trieNode(layersB, depth, 0) {
+		// Tries only differing by trailing zero leaves have the same root.
+		if len(a) != len(b) {
+			if len(a) < len(b) {
+				return uint64(len(a)), true
+			}
+			return uint64(len(b)), true
+		}
+		return 0, false
+	}
+	index := uint64(0)
+	for layer := depth; layer > 0; layer-- {
+		index *= 2
+		if trieNode(layersA, layer-1, index) == trieNode(layersB, layer-1, index) {
+			index++
+		}
+	}
+	return index, true
+}
+
+// trieNode returns the node at the given layer and index of a variable sized trie. Nodes past
+// the end of the trie are roots of empty subtries.
+func trieNode(layers [][]*[32]byte, layer int, index uint64) [32]byte {
+	if index < uint64(len(layers[layer])) {
+		return *layers[layer][index]
+	}
+	return trieutil.ZeroHashes[layer]
+}
+
+func singleLeaf(root [32]byte, err error) ([][32]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	return [][32]byte{root}, nil
+}
+
+func rootLeaves(roots [][]byte) [][32]byte {
+	leaves := make([][32]byte, len(roots))
+	for i, r := range roots {
+		leaves[i] = bytesutil.ToBytes32(r)
+	}
+	return leaves
+}
+
+func uint64Leaves(vals []uint64) [][32]byte {
+	leaves := make([][32]byte, len(vals))
+	for i, v := range vals {
+		leaves[i] = htrutils.Uint64Root(v)
+	}
+	return leaves
+}
+
+func pendingAttLeaves(hasher htrutils.HashFn, atts []*pb.PendingAttestation) ([][32]byte, error) {
+	leaves := make([][32]byte, len(atts))
+	for i, att := range atts {
+		r, err := PendingAttRootWithHasher(hasher, att)
+		if err != nil {
+			return nil, err
+		}
+		leaves[i] = r
+	}
+	return leaves, nil
+}
+
+func checkpointLeaf(hasher htrutils.HashFn, cp *ethpb.Checkpoint) ([][32]byte, error) {
+	return singleLeaf(htrutils.CheckpointRoot(hasher, cp))
+}
@@ -0,0 +1,69 @@
+package stateutil_test
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestFirstDivergence(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 64)
+
+	d, err := stateutil.FirstDivergence(base, base.Copy())
+	require.NoError(t, err)
+	assert.Equal(t, true, d == nil, "Expected no divergence between equal states")
+
+	t.Run("field", func(t *testing.T) {
+		st := base.Copy()
+		require.NoError(t, st.SetSlot(base.Slot()+1))
+		d, err := stateutil.FirstDivergence(base, st)
+		require.NoError(t, err)
+		assert.Equal(t, "slot", d.String())
+	})
+	t.Run("first element of the first field", func(t *testing.T) {
+		st := base.Copy()
+		require.NoError(t, st.UpdateBalancesAtIndex(3, 1))
+		val, err := st.ValidatorAtIndex(9)
+		require.NoError(t, err)
+		val.Slashed = true
+		require.NoError(t, st.UpdateValidatorAtIndex(9, val))
+		val, err = st.ValidatorAtIndex(40)
+		require.NoError(t, err)
+		val.Slashed = true
+		require.NoError(t, st.UpdateValidatorAtIndex(40, val))
+		d, err := stateutil.FirstDivergence(base, st)
+		require.NoError(t, err)
+		assert.Equal(t, "validators[9]", d.String())
+	})
+	t.Run("vector", func(t *testing.T) {
+		st := base.Copy()
+		require.NoError(t, st.UpdateBlockRootAtIndex(uint64(params.BeaconConfig().SlotsPerHistoricalRoot-1), bytesutil.ToBytes32([]byte("root"))))
+		d, err := stateutil.FirstDivergence(base, st)
+		require.NoError(t, err)
+		assert.Equal(t, "blockRoots", d.Field)
+		assert.Equal(t, uint64(params.BeaconConfig().SlotsPerHistoricalRoot-1), d.Index)
+	})
+	t.Run("appended element", func(t *testing.T) {
+		st := base.Copy()
+		require.NoError(t, st.AppendValidator(&ethpb.Validator{PublicKey: make([]byte, 48), WithdrawalCredentials: make([]byte, 32)}))
+		d, err := stateutil.FirstDivergence(base, st)
+		require.NoError(t, err)
+		assert.Equal(t, "validators[64]", d.String())
+	})
+	t.Run("appended zero element", func(t *testing.T) {
+		st := base.Copy()
+		require.NoError(t, st.AppendHistoricalRoots([32]byte{}))
+		d, err := stateutil.FirstDivergence(st, base)
+		require.NoError(t, err)
+		assert.Equal(t, "historicalRoots[0]", d.String())
+	})
+
+	_, err = stateutil.FirstDivergence(base, nil)
+	assert.ErrorContains(t, "nil state", err)
+}
@@ -242,6 +242,53 @@ func recomputeRootFromLayerVariable(idx int, item [32]byte, layers [][]*[32]byte
 	return root, layers, nil
 }
 
+// AppendToLayerVariable appends leaves to a variable sized trie. Only the nodes on the right
+// edge of the trie, which depend on the appended leaves, are recomputed, and appended leaves
+// sharing a parent are hashed together, so the nodes of the existing leaves are never rehashed.
+func AppendToLayerVariable(leaves [][32]byte, layers [][]*[32]byte) ([32]byte, [][]*[32]byte, error) {
+	if len(layers) == 0 || len(layers[len(layers)-1]) == 0 {
+		return [32]byte{}, nil, errors.New("trie has no layers")
+	}
+	maxLeaves := uint64(1) << uint64(len(layers)-1)
+	if uint64(len(layers[0])+len(leaves)) > maxLeaves {
+		return [32]byte{}, nil, errors.Errorf("cannot append %d leaves to a trie with %d of at most %d leaves",
+			len(leaves), len(layers[0]), maxLeaves)
+	}
+	hasher := hashutil.CustomSHA256Hasher()
+	changedIdx := len(layers[0])
+	for i := range leaves {
+		leaf := leaves[i]
+		layers[0] = append(layers[0], &leaf)
+	}
+	if len(leaves) == 0 {
+		return *layers[len(layers)-1][0], layers, nil
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	buffer.Grow(64)
+	for i := 0; i < len(layers)-1; i++ {
+		// Recompute the parents of the nodes from the first changed node up to the end of the layer.
+		parentIdx := changedIdx / 2
+		for j := parentIdx; j < (len(layers[i])+1)/2; j++ {
+			right := trieutil.ZeroHashes[i]
+			if 2*j+1 < len(layers[i]) {
+				right = *layers[i][2*j+1]
+			}
+			buffer.Write(layers[i][2*j][:])
+			buffer.Write(right[:])
+			parent := hasher(buffer.Bytes())
+			buffer.Reset()
+			if j < len(layers[i+1]) {
+				layers[i+1][j] = &parent
+			} else {
+				layers[i+1] = append(layers[i+1], &parent)
+			}
+		}
+		changedIdx = parentIdx
+	}
+	return *layers[len(layers)-1][0], layers, nil
+}
+
 // AddInMixin describes a method from which a lenth mixin is added to the
 // provided root.
 func AddInMixin(root [32]byte, length uint64) ([32]byte, error) {
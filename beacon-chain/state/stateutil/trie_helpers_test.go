@@ -110,3 +110,31 @@ func TestRecomputeFromLayer_VariableSizedArray(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, expectedRoot, root)
 }
+
+func TestAppendToLayerVariable(t *testing.T) {
+	limit := uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().MaxAttestations))
+	roots := make([][32]byte, 45)
+	for i := range roots {
+		roots[i] = hashutil.Hash([]byte{byte(i)})
+	}
+
+	for _, numOfLeaves := range []int{0, 1, 20, 31, 32} {
+		layers := stateutil.ReturnTrieLayerVariable(roots[:numOfLeaves], limit)
+		root, layers, err := stateutil.AppendToLayerVariable(roots[numOfLeaves:], layers)
+		require.NoError(t, err)
+
+		wantLayers := stateutil.ReturnTrieLayerVariable(roots, limit)
+		assert.Equal(t, *wantLayers[len(wantLayers)-1][0], root)
+		require.Equal(t, len(wantLayers), len(layers))
+		for i := range wantLayers {
+			require.Equal(t, len(wantLayers[i]), len(layers[i]), "Unexpected length of layer %d", i)
+			for j := range wantLayers[i] {
+				assert.Equal(t, *wantLayers[i][j], *layers[i][j], "Unexpected node %d of layer %d", j, i)
+			}
+		}
+	}
+
+	layers := stateutil.ReturnTrieLayerVariable(roots[:3], 4)
+	_, _, err := stateutil.AppendToLayerVariable(roots[3:5], layers)
+	assert.ErrorContains(t, "cannot append 2 leaves", err)
+}
@@ -0,0 +1,79 @@
+package sync
+
+import (
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// firstSeenAttestation records when valid attestations of an attestation data were first seen
+// on gossip, along with the participation carried by the valid attestations seen since.
+type firstSeenAttestation struct {
+	time time.Time
+	bits bitfield.Bitlist
+}
+
+// Attestations which share their attestation data form an equivalence class, tracked in the
+// seen attestation cache under the root of the data. Unaggregated attestations and aggregates
+// form separate classes, so that an aggregate is only ever compared against the participation
+// of other aggregates, as the spec only ignores aggregates which are a subset of an aggregate
+// already seen.
+func firstSeenAttestationKey(dataRoot [32]byte, aggregate bool) string {
+	if aggregate {
+		return "firstSeenAggregate" + string(dataRoot[:])
+	}
+	return "firstSeen" + string(dataRoot[:])
+}
+
+// Returns true if the attestation is a replay: attestations of its data and kind were first seen
+// longer than the replay window ago, and it carries no participation which was not already seen.
+// Such attestations can be mutated duplicates, whose bits differ from the ones seen before without
+// adding any information, so they would evade the other seen checks.
+func (s *Service) isAttestationReplay(dataRoot [32]byte, bits bitfield.Bitlist, aggregate bool) bool {
+	window := flags.Get().AttestationReplayWindow
+	if window == 0 {
+		return false
+	}
+	s.seenAttestationLock.RLock()
+	defer s.seenAttestationLock.RUnlock()
+	v, ok := s.seenAttestationCache.Get(firstSeenAttestationKey(dataRoot, aggregate))
+	if !ok {
+		return false
+	}
+	seen, ok := v.(*firstSeenAttestation)
+	if !ok || timeutils.Since(seen.time) <= window {
+		return false
+	}
+	// Bitlists of a different length can't be compared, and are rejected by the committee checks.
+	if seen.bits.Len() != bits.Len() {
+		return false
+	}
+	if seen.bits.Contains(bits) {
+		attestationReplayCounter.Inc()
+		return true
+	}
+	return false
+}
+
+// Adds the participation of a valid attestation to its equivalence class, recording the time
+// its data was first seen if it is the first of the class.
+func (s *Service) setAttestationParticipationSeen(dataRoot [32]byte, bits bitfield.Bitlist, aggregate bool) {
+	s.seenAttestationLock.Lock()
+	defer s.seenAttestationLock.Unlock()
+	key := firstSeenAttestationKey(dataRoot, aggregate)
+	v, ok := s.seenAttestationCache.Get(key)
+	if !ok {
+		s.seenAttestationCache.Add(key, &firstSeenAttestation{
+			time: timeutils.Now(),
+			bits: bitfield.Bitlist(append([]byte{}, bits...)),
+		})
+		return
+	}
+	seen, ok := v.(*firstSeenAttestation)
+	if !ok || seen.bits.Len() != bits.Len() {
+		return
+	}
+	seen.bits = seen.bits.Or(bits)
+}
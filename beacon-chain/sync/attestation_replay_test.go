@@ -0,0 +1,58 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_isAttestationReplay(t *testing.T) {
+	resetFlags := flags.Get()
+	replayFlags := *resetFlags
+	replayFlags.AttestationReplayWindow = time.Minute
+	flags.Init(&replayFlags)
+	defer func() {
+		flags.Init(resetFlags)
+	}()
+
+	c, err := lru.New(10)
+	require.NoError(t, err)
+	s := &Service{seenAttestationCache: c}
+	dataRoot := [32]byte{'a'}
+
+	first := bitfield.Bitlist{0b1000_0011}
+	assert.Equal(t, false, s.isAttestationReplay(dataRoot, first, true), "Unseen data is not a replay")
+	s.setAttestationParticipationSeen(dataRoot, first, true)
+	s.setAttestationParticipationSeen(dataRoot, bitfield.Bitlist{0b1000_1000}, true)
+	assert.Equal(t, false, s.isAttestationReplay(dataRoot, first, true), "Data seen within the window is not a replay")
+
+	// Move the first sighting of the data past the replay window.
+	v, ok := c.Get(firstSeenAttestationKey(dataRoot, true))
+	require.Equal(t, true, ok)
+	v.(*firstSeenAttestation).time = time.Now().Add(-2 * time.Minute)
+
+	assert.Equal(t, true, s.isAttestationReplay(dataRoot, first, true), "Seen participation is a replay")
+	assert.Equal(t, true, s.isAttestationReplay(dataRoot, bitfield.Bitlist{0b1000_1010}, true), "Subset of the seen participation is a replay")
+	assert.Equal(t, false, s.isAttestationReplay(dataRoot, bitfield.Bitlist{0b1001_0000}, true), "New participation is not a replay")
+	assert.Equal(t, false, s.isAttestationReplay(dataRoot, bitfield.Bitlist{0b0000_0001, 0b1}, true), "Bitlist of a different length is not a replay")
+	assert.Equal(t, false, s.isAttestationReplay([32]byte{'b'}, first, true), "Attestation of other data is not a replay")
+	assert.Equal(t, false, s.isAttestationReplay(dataRoot, bitfield.Bitlist{0b1000_0010}, false), "Unaggregated attestation is not a replay of aggregates")
+
+	// Participation of unaggregated attestations does not make aggregates replays.
+	otherRoot := [32]byte{'c'}
+	s.setAttestationParticipationSeen(otherRoot, bitfield.Bitlist{0b1000_0001}, false)
+	s.setAttestationParticipationSeen(otherRoot, bitfield.Bitlist{0b1000_0010}, false)
+	v, ok = c.Get(firstSeenAttestationKey(otherRoot, false))
+	require.Equal(t, true, ok)
+	v.(*firstSeenAttestation).time = time.Now().Add(-2 * time.Minute)
+	assert.Equal(t, true, s.isAttestationReplay(otherRoot, bitfield.Bitlist{0b1000_0010}, false), "Seen unaggregated participation is a replay")
+	assert.Equal(t, false, s.isAttestationReplay(otherRoot, bitfield.Bitlist{0b1000_0011}, true), "Aggregate of seen unaggregated participation is not a replay")
+
+	replayFlags.AttestationReplayWindow = 0
+	assert.Equal(t, false, s.isAttestationReplay(dataRoot, first, true), "Check is disabled without a window")
+}
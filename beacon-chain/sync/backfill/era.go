@@ -0,0 +1,84 @@
+package backfill
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/era"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// This backfills the blocks preceding the lowest backfilled block from the era files of the era
+// directory, from the latest era down. The blocks are verified like the blocks downloaded from peers,
+// so the files need not be trusted. It returns the lowest backfilled block, from which backfilling
+// from peers resumes if the files do not reach genesis.
+func (s *Service) backfillFromEras(low *ethpb.SignedBeaconBlock, originState iface.ReadOnlyBeaconState) (*ethpb.SignedBeaconBlock, error) {
+	idx, err := era.ReadIndex(s.cfg.EraDir)
+	if err != nil {
+		return low, err
+	}
+	if idx.GenesisValidatorsRoot != fmt.Sprintf("%#x", bytesutil.ToBytes32(originState.GenesisValidatorRoot())) {
+		return low, errors.New("era files are not from the chain of the node")
+	}
+	for i := len(idx.Eras) - 1; i >= 0; i-- {
+		e := idx.Eras[i]
+		if e.Era == 0 || e.Era.BlocksStartSlot() >= low.Block.Slot {
+			continue
+		}
+		if s.ctx.Err() != nil {
+			return low, s.ctx.Err()
+		}
+		blks, err := readEraBlocks(filepath.Join(s.cfg.EraDir, e.File), low)
+		if err != nil {
+			return low, errors.Wrapf(err, "could not read era %d", e.Era)
+		}
+		for end := len(blks); end > 0; end -= int(batchSize) {
+			start := 0
+			if end > int(batchSize) {
+				start = end - int(batchSize)
+			}
+			if err := verifyBatch(low, blks[start:end], originState); err != nil {
+				return low, errors.Wrapf(err, "invalid blocks in era %d", e.Era)
+			}
+			if err := s.cfg.DB.SaveBackfillBlocks(s.ctx, blks[start:end]); err != nil {
+				return low, errors.Wrap(err, "could not save backfilled blocks")
+			}
+			low = blks[start]
+		}
+		log.WithFields(logrus.Fields{
+			"era":    e.Era,
+			"blocks": len(blks),
+			"slot":   low.Block.Slot,
+		}).Info("Backfilled blocks from era file")
+	}
+	return low, nil
+}
+
+// This reads the blocks of an era file preceding the given block, except the genesis block which the
+// node holds already.
+func readEraBlocks(path string, low *ethpb.SignedBeaconBlock) ([]*ethpb.SignedBeaconBlock, error) {
+	r, err := era.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.WithError(err).Error("Failed to close era file")
+		}
+	}()
+	blks, err := r.Blocks()
+	if err != nil {
+		return nil, err
+	}
+	preceding := make([]*ethpb.SignedBeaconBlock, 0, len(blks))
+	for _, blk := range blks {
+		if blk.Block.Slot > 0 && blk.Block.Slot < low.Block.Slot {
+			preceding = append(preceding, blk)
+		}
+	}
+	return preceding, nil
+}
@@ -0,0 +1,76 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/era"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_BackfillFromEras(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ConfigName = "backfill-test"
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	gs, privs := testutil.DeterministicGenesisState(t, 16)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, gs))
+	genesis, err := beaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// The checkpoint block is at the start of era 1, whose era file holds the preceding blocks.
+	chain := signedChain(t, gs, privs, genesisRoot, 20)
+	originState := saveOrigin(t, beaconDB, gs, chain[len(chain)-1], era.Era(1).StartSlot())
+	dir := filepath.Join(t.TempDir(), "eras")
+	require.NoError(t, fileutil.MkdirAll(dir))
+	w, err := era.Create(filepath.Join(dir, "era-00001.era"), 1)
+	require.NoError(t, err)
+	for _, blk := range append([]*ethpb.SignedBeaconBlock{genesis}, chain...) {
+		require.NoError(t, w.AddBlock(blk))
+	}
+	require.NoError(t, w.Finish(originState))
+	require.NoError(t, era.WriteIndex(dir, &era.Index{
+		GenesisValidatorsRoot: fmt.Sprintf("%#x", bytesutil.ToBytes32(originState.GenesisValidatorRoot())),
+		Eras:                  []*era.IndexEntry{{Era: 1, File: "era-00001.era"}},
+	}))
+
+	defer func(size types.Slot) {
+		batchSize = size
+	}(batchSize)
+	batchSize = 7
+	s := NewService(ctx, &Config{
+		DB:          beaconDB,
+		P2P:         p2ptest.NewTestP2P(t),
+		InitialSync: &mockSync.Sync{},
+		EraDir:      dir,
+	})
+	require.NoError(t, s.run())
+	assert.Equal(t, true, s.Complete())
+
+	lowRoot, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	firstRoot, err := chain[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, firstRoot, lowRoot)
+	for _, blk := range chain {
+		r, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, beaconDB.IsFinalizedBlock(ctx, r), "Block at slot %d is not finalized", blk.Block.Slot)
+	}
+}
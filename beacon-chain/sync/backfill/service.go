@@ -0,0 +1,281 @@
+// Package backfill implements the download of the blocks preceding the checkpoint a beacon node
+// was started from. The blocks are downloaded backwards from the checkpoint to genesis, so that
+// the node serves the whole history of the chain once backfilled.
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/abool"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// batchSize is the number of slots requested from a peer at once.
+var batchSize = types.Slot(64)
+
+// retryInterval is the time to wait before requesting blocks again when no peer could serve them.
+var retryInterval = 5 * time.Second
+
+// errInvalidBatch is returned when a peer responds with blocks which are not the ancestors of the
+// lowest backfilled block.
+var errInvalidBatch = errors.New("blocks are not the ancestors of the lowest backfilled block")
+
+// syncChecker defines the interface for checking whether the node is syncing to the head.
+type syncChecker interface {
+	Syncing() bool
+}
+
+// Config to set up the backfill service.
+type Config struct {
+	DB          db.NoHeadAccessDatabase
+	P2P         p2p.P2P
+	InitialSync syncChecker
+	EraDir      string
+}
+
+// Service downloads the blocks preceding the origin checkpoint of the node from peers.
+type Service struct {
+	cfg      *Config
+	ctx      context.Context
+	cancel   context.CancelFunc
+	complete *abool.AtomicBool
+}
+
+// NewService configures the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:      cfg,
+		ctx:      ctx,
+		cancel:   cancel,
+		complete: abool.New(),
+	}
+}
+
+// Start the backfill service.
+func (s *Service) Start() {
+	if err := s.run(); err != nil && s.ctx.Err() == nil {
+		log.WithError(err).Error("Could not backfill blocks")
+	}
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Complete returns true if the node holds all the blocks from genesis.
+func (s *Service) Complete() bool {
+	return s.complete.IsSet()
+}
+
+// This backfills blocks batch by batch, from the lowest backfilled block down to genesis.
+func (s *Service) run() error {
+	originRoot, err := s.cfg.DB.OriginCheckpointBlockRoot(s.ctx)
+	if err != nil {
+		return err
+	}
+	// Nodes started from genesis hold all the blocks already.
+	if originRoot == params.BeaconConfig().ZeroHash {
+		s.complete.Set()
+		return nil
+	}
+	// The blocks preceding the checkpoint are signed by validators of the checkpoint state, as
+	// validators are never removed from the registry.
+	originState, err := s.cfg.DB.State(s.ctx, originRoot)
+	if err != nil {
+		return err
+	}
+	if originState == nil {
+		return errors.New("origin checkpoint state not found")
+	}
+	genesisBlock, err := s.cfg.DB.GenesisBlock(s.ctx)
+	if err != nil {
+		return err
+	}
+	if genesisBlock == nil || genesisBlock.Block == nil {
+		return errors.New("genesis block not found")
+	}
+	genesisRoot, err := genesisBlock.Block.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	lowRoot, err := s.cfg.DB.BackfillBlockRoot(s.ctx)
+	if err != nil {
+		return err
+	}
+	low, err := s.cfg.DB.Block(s.ctx, lowRoot)
+	if err != nil {
+		return err
+	}
+	if low == nil || low.Block == nil {
+		return errors.Errorf("lowest backfilled block %#x not found", lowRoot)
+	}
+
+	if s.cfg.EraDir != "" {
+		if low, err = s.backfillFromEras(low, originState); err != nil {
+			if s.ctx.Err() != nil {
+				return s.ctx.Err()
+			}
+			log.WithError(err).Warn("Could not backfill blocks from era files, backfilling from peers")
+		}
+	}
+
+	// Backfilling competes for peers with syncing to the head, which is more urgent.
+	for s.cfg.InitialSync.Syncing() {
+		if err := s.wait(); err != nil {
+			return err
+		}
+	}
+
+	originEpoch := helpers.SlotToEpoch(originState.Slot())
+	end := low.Block.Slot
+	for bytesutil.ToBytes32(low.Block.ParentRoot) != genesisRoot {
+		if end <= 1 {
+			return errors.New("backfilled blocks do not descend from the genesis block")
+		}
+		start := types.Slot(1)
+		if end > batchSize+1 {
+			start = end - batchSize
+		}
+		pid, err := s.pickPeer(originEpoch)
+		if err != nil {
+			log.WithError(err).Debug("Could not request blocks")
+			if err := s.wait(); err != nil {
+				return err
+			}
+			continue
+		}
+		blks, err := sync.SendBeaconBlocksByRangeRequest(s.ctx, s.cfg.P2P, pid, &pb.BeaconBlocksByRangeRequest{
+			StartSlot: start,
+			Count:     uint64(end - start),
+			Step:      1,
+		}, nil)
+		if err == nil {
+			err = verifyBatch(low, blks, originState)
+		}
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return s.ctx.Err()
+			}
+			log.WithError(err).WithField("peer", pid).Debug("Could not backfill blocks from peer")
+			if errors.Is(err, errInvalidBatch) {
+				s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+			}
+			// Request the blocks again up to the lowest backfilled block, in case a peer omitted
+			// blocks of previous batches.
+			end = low.Block.Slot
+			continue
+		}
+		// There are no blocks in the range of skipped slots.
+		if len(blks) == 0 {
+			end = start
+			continue
+		}
+		if err := s.cfg.DB.SaveBackfillBlocks(s.ctx, blks); err != nil {
+			return errors.Wrap(err, "could not save backfilled blocks")
+		}
+		low = blks[0]
+		end = low.Block.Slot
+		log.WithFields(logrus.Fields{
+			"slot":    low.Block.Slot,
+			"blocks":  len(blks),
+			"peer":    pid,
+			"percent": fmt.Sprintf("%.2f", 100*(1-float64(low.Block.Slot)/float64(originState.Slot()))),
+		}).Info("Backfilled blocks")
+	}
+	s.complete.Set()
+	log.Info("Backfilled all blocks from genesis")
+	return nil
+}
+
+// This picks a random peer which finalized the origin checkpoint, hence holds the blocks preceding it.
+func (s *Service) pickPeer(originEpoch types.Epoch) (peer.ID, error) {
+	_, pids := s.cfg.P2P.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, originEpoch)
+	if len(pids) == 0 {
+		return "", errors.New("no peers available")
+	}
+	return pids[rand.NewGenerator().Intn(len(pids))], nil
+}
+
+// This waits for the retry interval, unless the service is stopped.
+func (s *Service) wait() error {
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case <-time.After(retryInterval):
+		return nil
+	}
+}
+
+// verifyBatch verifies that the blocks, ordered by slot, are the ancestors of the given block and
+// that they are signed by their proposers.
+func verifyBatch(child *ethpb.SignedBeaconBlock, blks []*ethpb.SignedBeaconBlock, originState iface.ReadOnlyBeaconState) error {
+	parentRoot := bytesutil.ToBytes32(child.Block.ParentRoot)
+	set := bls.NewSet()
+	for i := len(blks) - 1; i >= 0; i-- {
+		blk := blks[i]
+		if blk == nil || blk.Block == nil {
+			return errors.Wrap(errInvalidBatch, "nil block")
+		}
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if root != parentRoot {
+			return errors.Wrapf(errInvalidBatch, "block %#x at slot %d is not the parent %#x", root, blk.Block.Slot, parentRoot)
+		}
+		parentRoot = bytesutil.ToBytes32(blk.Block.ParentRoot)
+
+		proposer, err := originState.ValidatorAtIndexReadOnly(blk.Block.ProposerIndex)
+		if err != nil {
+			return errors.Wrap(errInvalidBatch, err.Error())
+		}
+		pubkey := proposer.PublicKey()
+		domain, err := helpers.Domain(originState.Fork(), helpers.SlotToEpoch(blk.Block.Slot), params.BeaconConfig().DomainBeaconProposer, originState.GenesisValidatorRoot())
+		if err != nil {
+			return err
+		}
+		sigSet, err := helpers.BlockSignatureSet(blk.Block, pubkey[:], blk.Signature, domain)
+		if err != nil {
+			return errors.Wrap(errInvalidBatch, err.Error())
+		}
+		set.Join(sigSet)
+	}
+	if len(set.Signatures) == 0 {
+		return nil
+	}
+	verified, err := set.Verify()
+	if err != nil {
+		return errors.Wrap(errInvalidBatch, err.Error())
+	}
+	if !verified {
+		return errors.Wrap(errInvalidBatch, "invalid block signatures")
+	}
+	return nil
+}
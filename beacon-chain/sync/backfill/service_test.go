@@ -0,0 +1,161 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// This returns a chain of signed blocks from slot 1 to the given slot, skipping every fifth slot.
+func signedChain(t *testing.T, st iface.BeaconState, privs []bls.SecretKey, parentRoot [32]byte, slot types.Slot) []*ethpb.SignedBeaconBlock {
+	var blks []*ethpb.SignedBeaconBlock
+	for i := types.Slot(1); i <= slot; i++ {
+		if i%5 == 0 {
+			continue
+		}
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = i
+		blk.Block.ProposerIndex = types.ValidatorIndex(uint64(i) % uint64(len(privs)))
+		blk.Block.ParentRoot = append([]byte{}, parentRoot[:]...)
+		sig, err := helpers.ComputeDomainAndSign(st, helpers.SlotToEpoch(i), blk.Block, params.BeaconConfig().DomainBeaconProposer, privs[blk.Block.ProposerIndex])
+		require.NoError(t, err)
+		blk.Signature = sig
+		blks = append(blks, blk)
+		parentRoot, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	return blks
+}
+
+// This saves a checkpoint block at the given slot as the origin of the node, along with its state
+// derived from the genesis state.
+func saveOrigin(t *testing.T, beaconDB db.Database, gs iface.BeaconState, parent *ethpb.SignedBeaconBlock, slot types.Slot) iface.BeaconState {
+	ctx := context.Background()
+	parentRoot, err := parent.Block.HashTreeRoot()
+	require.NoError(t, err)
+	origin := testutil.NewBeaconBlock()
+	origin.Block.Slot = slot
+	origin.Block.ParentRoot = parentRoot[:]
+	bodyRoot, err := origin.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	originState := gs.Copy()
+	require.NoError(t, originState.SetSlot(slot))
+	require.NoError(t, originState.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: origin.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := originState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	origin.Block.StateRoot = stateRoot[:]
+	require.NoError(t, beaconDB.SaveOrigin(ctx, originState, origin))
+	return originState
+}
+
+func TestService_Backfill(t *testing.T) {
+	// The mainnet genesis state is embedded, so the chain of the test is named differently.
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ConfigName = "backfill-test"
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	gs, privs := testutil.DeterministicGenesisState(t, 16)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, gs))
+	genesis, err := beaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// The checkpoint block follows the chain, at the start of epoch 2.
+	originSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	chain := signedChain(t, gs, privs, genesisRoot, originSlot-1)
+	saveOrigin(t, beaconDB, gs, chain[len(chain)-1], originSlot)
+
+	// A peer serves the blocks preceding the checkpoint.
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p2.SetStreamHandler(fmt.Sprintf("%s/ssz_snappy", p2p.RPCBlocksByRangeTopic), func(stream network.Stream) {
+		defer func() {
+			assert.NoError(t, stream.Close())
+		}()
+		req := &pb.BeaconBlocksByRangeRequest{}
+		assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, req))
+		for _, blk := range chain {
+			if blk.Block.Slot >= req.StartSlot && blk.Block.Slot < req.StartSlot.Add(req.Count) {
+				assert.NoError(t, sync.WriteChunk(stream, p2.Encoding(), blk))
+			}
+		}
+	})
+	p1.Connect(p2)
+	p1.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirOutbound)
+	p1.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
+	p1.Peers().SetChainState(p2.PeerID(), &pb.Status{
+		FinalizedEpoch: helpers.SlotToEpoch(originSlot),
+	})
+
+	defer func(size types.Slot) {
+		batchSize = size
+	}(batchSize)
+	batchSize = 7
+	s := NewService(ctx, &Config{
+		DB:          beaconDB,
+		P2P:         p1,
+		InitialSync: &mockSync.Sync{},
+	})
+	require.NoError(t, s.run())
+	assert.Equal(t, true, s.Complete())
+
+	lowRoot, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	firstRoot, err := chain[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, firstRoot, lowRoot)
+	for _, blk := range chain {
+		r, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, beaconDB.HasBlock(ctx, r))
+		assert.Equal(t, true, beaconDB.IsFinalizedBlock(ctx, r), "Block at slot %d is not finalized", blk.Block.Slot)
+	}
+}
+
+func TestVerifyBatch(t *testing.T) {
+	gs, privs := testutil.DeterministicGenesisState(t, 16)
+	chain := signedChain(t, gs, privs, [32]byte{'g'}, 10)
+	child := chain[len(chain)-1]
+	batch := chain[:len(chain)-1]
+	require.NoError(t, verifyBatch(child, batch, gs))
+	require.NoError(t, verifyBatch(child, nil, gs))
+
+	// A block is missing from the batch.
+	missing := append([]*ethpb.SignedBeaconBlock{}, batch[:2]...)
+	missing = append(missing, batch[3:]...)
+	assert.ErrorContains(t, errInvalidBatch.Error(), verifyBatch(child, missing, gs))
+
+	// A block is signed by another validator.
+	forged := testutil.NewBeaconBlock()
+	forged.Block = batch[0].Block
+	forged.Signature = batch[1].Signature
+	assert.ErrorContains(t, errInvalidBatch.Error(), verifyBatch(chain[1], []*ethpb.SignedBeaconBlock{forged}, gs))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/paulbellamy/ratecounter"
 	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -131,6 +132,9 @@ func (s *Service) processFetchedData(
 
 	// Use Batch Block Verify to process and verify batches directly.
 	if err := s.processBatchedBlocks(ctx, genesis, data.blocks, s.cfg.Chain.ReceiveBlockBatch); err != nil {
+		if errors.Is(err, blockchain.ErrInvalidBlock) {
+			s.downscorePeer(data.pid, err)
+		}
 		log.WithError(err).Warn("Batch is not processed")
 	}
 }
@@ -151,6 +155,10 @@ func (s *Service) processFetchedDataRegSync(
 			case errors.Is(err, errParentDoesNotExist):
 				log.WithError(err).Debug("Block is not processed")
 				invalidBlocks++
+			case errors.Is(err, blockchain.ErrInvalidBlock):
+				s.downscorePeer(data.pid, err)
+				log.WithError(err).Warn("Block is not processed")
+				invalidBlocks++
 			default:
 				log.WithError(err).Warn("Block is not processed")
 			}
@@ -298,6 +306,15 @@ func (s *Service) updatePeerScorerStats(pid peer.ID, startSlot types.Slot) {
 	}
 }
 
+// downscorePeer penalizes a peer which served blocks that turned out to be invalid.
+func (s *Service) downscorePeer(pid peer.ID, err error) {
+	if pid == "" {
+		return
+	}
+	log.WithError(err).WithField("peer", pid).Debug("Downscoring peer for serving invalid blocks")
+	s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+}
+
 // isProcessedBlock checks DB and local cache for presence of a given block, to avoid duplicates.
 func (s *Service) isProcessedBlock(ctx context.Context, blk *eth.SignedBeaconBlock, blkRoot [32]byte) bool {
 	finalizedSlot, err := helpers.StartSlot(s.cfg.Chain.FinalizedCheckpt().Epoch)
@@ -5,9 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/paulbellamy/ratecounter"
 	types "github.com/prysmaticlabs/eth2-types"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
@@ -627,3 +629,22 @@ func TestService_syncToFinalizedEpoch(t *testing.T) {
 	assert.NoError(t, s.syncToFinalizedEpoch(context.Background(), genesis))
 	assert.LogsContain(t, hook, "Already synced to finalized epoch")
 }
+
+func TestService_downscorePeer(t *testing.T) {
+	p := p2pt.NewTestP2P(t)
+	s := NewService(context.Background(), &Config{
+		P2P:           p,
+		Chain:         &mock.ChainService{},
+		StateNotifier: &mock.MockStateNotifier{},
+	})
+	scorer := p.Peers().Scorers().BadResponsesScorer()
+	pid := peer.ID("peer1")
+	s.downscorePeer(pid, blockchain.ErrInvalidBlock)
+	count, err := scorer.Count(pid)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Empty peer IDs are ignored.
+	s.downscorePeer("", blockchain.ErrInvalidBlock)
+	assert.Equal(t, 0, len(scorer.BadPeers()))
+}
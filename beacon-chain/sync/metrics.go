@@ -41,6 +41,19 @@ var (
 		},
 		[]string{"topic"},
 	)
+	attestationRejectedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_attestation_rejected_total",
+			Help: "Count of attestations rejected by gossip validation, by reason.",
+		},
+		[]string{"reason"},
+	)
+	attestationReplayCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "p2p_attestation_replay_total",
+			Help: "Count of gossip attestations ignored as replays of already seen participation.",
+		},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
@@ -0,0 +1,330 @@
+// Package replica implements a read replica mode for the beacon node. Instead of syncing from
+// peers, the node follows a primary beacon node over gRPC, importing the blocks the primary has
+// already verified, so that read-only APIs can be horizontally scaled across several nodes.
+package replica
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/abool"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// reconnectInterval is the time to wait before reconnecting to the primary node after
+// the block stream has been interrupted.
+var reconnectInterval = 5 * time.Second
+
+// errUnknownParent is returned when the primary node sends a block whose parent has not been
+// imported by the replica, which requires catching up with the primary node again.
+var errUnknownParent = errors.New("parent block is not known")
+
+// blockchainService defines the interface for interaction with block chain service.
+type blockchainService interface {
+	blockchain.BlockReceiver
+	blockchain.HeadFetcher
+}
+
+// Config to set up the replica service.
+type Config struct {
+	Endpoint      string
+	Cert          string
+	MaxMsgSize    int
+	DB            db.ReadOnlyDatabase
+	Chain         blockchainService
+	StateNotifier statefeed.Notifier
+}
+
+// Service follows a primary beacon node, importing the blocks it streams.
+type Service struct {
+	cfg          *Config
+	ctx          context.Context
+	cancel       context.CancelFunc
+	conn         *grpc.ClientConn
+	client       ethpb.BeaconChainClient
+	synced       *abool.AtomicBool
+	chainStarted *abool.AtomicBool
+	genesisChan  chan time.Time
+	runError     error
+}
+
+// NewService configures the replica service responsible for keeping the node in sync
+// with its primary beacon node.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:          cfg,
+		ctx:          ctx,
+		cancel:       cancel,
+		synced:       abool.New(),
+		chainStarted: abool.New(),
+		genesisChan:  make(chan time.Time),
+	}
+	go s.waitForStateInitialization()
+	return s
+}
+
+// Start the replica service.
+func (s *Service) Start() {
+	if s.client == nil {
+		var transportSecurity grpc.DialOption
+		if s.cfg.Cert != "" {
+			creds, err := credentials.NewClientTLSFromFile(s.cfg.Cert, "")
+			if err != nil {
+				s.runError = errors.Wrap(err, "could not get valid credentials")
+				log.WithError(err).Error("Could not get valid credentials")
+				return
+			}
+			transportSecurity = grpc.WithTransportCredentials(creds)
+		} else {
+			transportSecurity = grpc.WithInsecure()
+			log.Warn("You are using an insecure gRPC connection to the primary beacon node, so the signatures of " +
+				"the blocks it streams are verified. Please provide its certificate to use a secure connection")
+		}
+		conn, err := grpc.DialContext(
+			s.ctx,
+			s.cfg.Endpoint,
+			transportSecurity,
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(s.cfg.MaxMsgSize)),
+		)
+		if err != nil {
+			s.runError = errors.Wrapf(err, "could not dial primary beacon node endpoint %s", s.cfg.Endpoint)
+			log.WithError(err).Errorf("Could not dial primary beacon node endpoint: %s", s.cfg.Endpoint)
+			return
+		}
+		s.conn = conn
+		s.client = ethpb.NewBeaconChainClient(conn)
+	}
+
+	// Wait for state initialized event.
+	genesis := <-s.genesisChan
+	if genesis.IsZero() {
+		log.Debug("Exiting Replica Service")
+		return
+	}
+	s.chainStarted.Set()
+	log.WithField("endpoint", s.cfg.Endpoint).Info("Following primary beacon node")
+	go s.follow(genesis)
+}
+
+// Stop the replica service.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.conn != nil {
+		return s.conn.Close()
+	}
+	return nil
+}
+
+// Status of the replica service. It reports the error which prevented the service from
+// connecting to the primary node, if any.
+func (s *Service) Status() error {
+	if s.runError != nil {
+		return s.runError
+	}
+	if s.synced.IsNotSet() && s.chainStarted.IsSet() {
+		return errors.New("syncing")
+	}
+	return nil
+}
+
+// Syncing returns true if the replica has not caught up with its primary node.
+func (s *Service) Syncing() bool {
+	return s.synced.IsNotSet()
+}
+
+// Initialized returns true if the replica has started following its primary node.
+func (s *Service) Initialized() bool {
+	return s.chainStarted.IsSet()
+}
+
+// Resync catches up with the primary node again.
+func (s *Service) Resync() error {
+	s.synced.UnSet()
+	defer func() { s.synced.Set() }() // Reset it at the end of the method.
+	if err := s.catchUp(s.ctx); err != nil {
+		log.WithError(err).Error("Could not catch up with primary beacon node")
+	}
+	log.WithField("slot", s.cfg.Chain.HeadSlot()).Info("Resync attempt complete")
+	return nil
+}
+
+// follow catches up with the primary node and then imports the blocks it streams, catching
+// up again whenever the stream is interrupted.
+func (s *Service) follow(genesis time.Time) {
+	notified := false
+	for {
+		if err := s.catchUp(s.ctx); err != nil {
+			log.WithError(err).Error("Could not catch up with primary beacon node")
+		} else {
+			s.synced.Set()
+			if !notified {
+				s.markSynced(genesis)
+				notified = true
+			}
+			log.WithField("slot", s.cfg.Chain.HeadSlot()).Info("Synced with primary beacon node")
+			err = s.streamBlocks(s.ctx)
+			log.WithError(err).Warn("Block stream from primary beacon node interrupted")
+		}
+		s.synced.UnSet()
+		select {
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			return
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+// catchUp imports the canonical blocks of the primary node from the epoch of the local head
+// up to the epoch of the primary node's head.
+func (s *Service) catchUp(ctx context.Context) error {
+	head, err := s.client.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get chain head from primary beacon node")
+	}
+	for epoch := helpers.SlotToEpoch(s.cfg.Chain.HeadSlot()); epoch <= head.HeadEpoch; epoch++ {
+		ctrs, err := s.blocksByEpoch(ctx, epoch)
+		if err != nil {
+			return err
+		}
+		for _, ctr := range ctrs {
+			if !ctr.Canonical {
+				continue
+			}
+			if err := s.processBlock(ctx, ctr.Block); err != nil {
+				return errors.Wrapf(err, "could not process block at slot %d", ctr.Block.Block.Slot)
+			}
+		}
+	}
+	return nil
+}
+
+// blocksByEpoch retrieves all the blocks of the given epoch from the primary node, ordered by slot.
+func (s *Service) blocksByEpoch(ctx context.Context, epoch types.Epoch) ([]*ethpb.BeaconBlockContainer, error) {
+	var ctrs []*ethpb.BeaconBlockContainer
+	req := &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: epoch},
+		PageSize:    int32(params.BeaconConfig().DefaultPageSize),
+	}
+	for {
+		res, err := s.client.ListBlocks(ctx, req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list blocks of epoch %d", epoch)
+		}
+		ctrs = append(ctrs, res.BlockContainers...)
+		if res.NextPageToken == "" || len(res.BlockContainers) == 0 {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	sort.SliceStable(ctrs, func(i, j int) bool {
+		return ctrs[i].Block.Block.Slot < ctrs[j].Block.Block.Slot
+	})
+	return ctrs, nil
+}
+
+// streamBlocks imports the blocks verified by the primary node as they are streamed. It only
+// returns once the stream is interrupted or a block cannot be imported.
+func (s *Service) streamBlocks(ctx context.Context) error {
+	stream, err := s.client.StreamBlocks(ctx, &ethpb.StreamBlocksRequest{VerifiedOnly: true})
+	if err != nil {
+		return errors.Wrap(err, "could not subscribe to block stream")
+	}
+	for {
+		blk, err := stream.Recv()
+		if err != nil {
+			return errors.Wrap(err, "could not receive block")
+		}
+		if err := s.processBlock(ctx, blk); err != nil {
+			return errors.Wrapf(err, "could not process block at slot %d", blk.Block.Slot)
+		}
+		log.WithFields(logrus.Fields{
+			"slot": blk.Block.Slot,
+		}).Debug("Imported block from primary beacon node")
+	}
+}
+
+// processBlock imports a block received from the primary node, skipping blocks which are already known.
+func (s *Service) processBlock(ctx context.Context, blk *ethpb.SignedBeaconBlock) error {
+	if blk == nil || blk.Block == nil {
+		return errors.New("nil block")
+	}
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if s.hasBlock(ctx, root) {
+		return nil
+	}
+	if !s.hasBlock(ctx, bytesutil.ToBytes32(blk.Block.ParentRoot)) {
+		return errUnknownParent
+	}
+	return s.cfg.Chain.ReceiveBlock(ctx, blk, root)
+}
+
+func (s *Service) hasBlock(ctx context.Context, root [32]byte) bool {
+	return s.cfg.DB.HasBlock(ctx, root) || s.cfg.Chain.HasInitSyncBlock(root)
+}
+
+// waitForStateInitialization makes sure that beacon node is ready to be accessed: it is either
+// already properly configured or system waits up until state initialized event is triggered.
+func (s *Service) waitForStateInitialization() {
+	// Wait for state to be initialized.
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	log.Info("Waiting for state to be initialized")
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.Initialized {
+				data, ok := event.Data.(*statefeed.InitializedData)
+				if !ok {
+					log.Error("Event feed data is not type *statefeed.InitializedData")
+					continue
+				}
+				log.WithField("starttime", data.StartTime).Debug("Received state initialized event")
+				s.genesisChan <- data.StartTime
+				return
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting goroutine")
+			// Send a zero time in the event we are exiting.
+			s.genesisChan <- time.Time{}
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Subscription to state notifier failed")
+			// Send a zero time in the event we are exiting.
+			s.genesisChan <- time.Time{}
+			return
+		}
+	}
+}
+
+// markSynced notifies feed listeners that the node is synced.
+func (s *Service) markSynced(genesis time.Time) {
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Synced,
+		Data: &statefeed.SyncedData{
+			StartTime: genesis,
+		},
+	})
+}
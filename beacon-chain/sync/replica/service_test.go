@@ -0,0 +1,136 @@
+package replica
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// chainOfBlocks returns a chain of blocks at the given slots, descending from a genesis block
+// which is saved in the database.
+func chainOfBlocks(t *testing.T, beaconDB db.Database, chain *mockChain.ChainService, slots ...types.Slot) []*ethpb.SignedBeaconBlock {
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(context.Background(), genesis))
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	chain.Root = bytesutil.SafeCopyBytes(parentRoot[:])
+	chain.State, err = testutil.NewBeaconState()
+	require.NoError(t, err)
+
+	blks := make([]*ethpb.SignedBeaconBlock, len(slots))
+	for i, slot := range slots {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = bytesutil.SafeCopyBytes(parentRoot[:])
+		parentRoot, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		blks[i] = blk
+	}
+	return blks
+}
+
+func container(t *testing.T, blk *ethpb.SignedBeaconBlock, canonical bool) *ethpb.BeaconBlockContainer {
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return &ethpb.BeaconBlockContainer{Block: blk, BlockRoot: root[:], Canonical: canonical}
+}
+
+func TestService_CatchUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	beaconDB := dbtest.SetupDB(t)
+	chain := &mockChain.ChainService{DB: beaconDB}
+	s := &Service{
+		cfg:    &Config{DB: beaconDB, Chain: chain},
+		client: client,
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	blks := chainOfBlocks(t, beaconDB, chain, 1, 2, 3, slotsPerEpoch+1)
+	orphan := testutil.NewBeaconBlock()
+	orphan.Block.Slot = 2
+	orphan.Block.ParentRoot = bytesutil.PadTo([]byte("orphan"), 32)
+
+	client.EXPECT().GetChainHead(gomock.Any(), &ptypes.Empty{}).Return(&ethpb.ChainHead{HeadEpoch: 1}, nil)
+	client.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.ListBlocksRequest, _ ...interface{}) (*ethpb.ListBlocksResponse, error) {
+			epoch := req.QueryFilter.(*ethpb.ListBlocksRequest_Epoch).Epoch
+			switch {
+			case epoch == 0 && req.PageToken == "":
+				return &ethpb.ListBlocksResponse{
+					BlockContainers: []*ethpb.BeaconBlockContainer{
+						container(t, blks[1], true),
+						container(t, orphan, false),
+						container(t, blks[0], true),
+					},
+					NextPageToken: "1",
+				}, nil
+			case epoch == 0 && req.PageToken == "1":
+				return &ethpb.ListBlocksResponse{
+					BlockContainers: []*ethpb.BeaconBlockContainer{container(t, blks[2], true)},
+				}, nil
+			case epoch == 1:
+				return &ethpb.ListBlocksResponse{
+					BlockContainers: []*ethpb.BeaconBlockContainer{container(t, blks[3], true)},
+				}, nil
+			}
+			t.Fatalf("Unexpected request %v", req)
+			return nil, nil
+		}).Times(3)
+
+	require.NoError(t, s.catchUp(context.Background()))
+	assert.DeepEqual(t, blks, chain.BlocksReceived)
+}
+
+func TestService_StreamBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconChainClient(ctrl)
+	stream := mock.NewMockBeaconChain_StreamBlocksClient(ctrl)
+	beaconDB := dbtest.SetupDB(t)
+	chain := &mockChain.ChainService{DB: beaconDB}
+	s := &Service{
+		cfg:    &Config{DB: beaconDB, Chain: chain},
+		client: client,
+	}
+	blks := chainOfBlocks(t, beaconDB, chain, 1, 2, 4)
+
+	client.EXPECT().StreamBlocks(gomock.Any(), &ethpb.StreamBlocksRequest{VerifiedOnly: true}).Return(stream, nil).Times(2)
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(blks[0], nil),
+		// Blocks which are already known are skipped.
+		stream.EXPECT().Recv().Return(blks[0], nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+		stream.EXPECT().Recv().Return(blks[2], nil),
+	)
+	assert.ErrorContains(t, io.EOF.Error(), s.streamBlocks(context.Background()))
+	assert.ErrorContains(t, errUnknownParent.Error(), s.streamBlocks(context.Background()))
+	assert.DeepEqual(t, blks[:1], chain.BlocksReceived)
+}
+
+func TestService_Start_InvalidCert(t *testing.T) {
+	s := NewService(context.Background(), &Config{
+		Endpoint:      "localhost:4000",
+		Cert:          "/nonexistent/cert.pem",
+		StateNotifier: &mockChain.MockStateNotifier{},
+	})
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+	s.Start()
+	assert.ErrorContains(t, "could not get valid credentials", s.Status())
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/timing"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/abool"
@@ -61,6 +62,7 @@ type Config struct {
 	BlockNotifier       blockfeed.Notifier
 	AttestationNotifier operation.Notifier
 	StateGen            *stategen.State
+	ArrivalRecorder     *timing.Recorder
 }
 
 // This defines the interface for interacting with block chain service
@@ -242,12 +244,23 @@ func (s *Service) registerHandlers() {
 					s.markForChainStart()
 				}()
 			case statefeed.Synced:
-				_, ok := event.Data.(*statefeed.SyncedData)
+				data, ok := event.Data.(*statefeed.SyncedData)
 				if !ok {
 					log.Error("Event feed data is not type *statefeed.SyncedData")
 					return
 				}
-				// Register respective pubsub handlers at state synced event.
+				// Register respective pubsub handlers at state synced event. Before genesis, wait
+				// until the gossip warm-up window before genesis to do so.
+				subscribeTime := data.StartTime.Add(-flags.Get().GenesisGossipWarmup)
+				if subscribeTime.After(timeutils.Now()) {
+					log.WithField("subscribeTime", subscribeTime).Info("Waiting for genesis to subscribe to gossip topics")
+					select {
+					case <-time.After(timeutils.Until(subscribeTime)):
+					case <-s.ctx.Done():
+						log.Debug("Context closed, exiting goroutine")
+						return
+					}
+				}
 				s.registerSubscribers()
 				return
 			}
@@ -13,6 +13,7 @@ import (
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/abool"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -258,3 +259,54 @@ func TestSyncService_StopCleanly(t *testing.T) {
 	require.Equal(t, 0, len(r.cfg.P2P.PubSub().GetTopics()))
 	require.Equal(t, 0, len(r.cfg.P2P.Host().Mux().Protocols()))
 }
+
+func TestSyncService_SubscribesWithinGenesisWarmup(t *testing.T) {
+	resetFlags := flags.Get()
+	warmupFlags := *resetFlags
+	warmupFlags.GenesisGossipWarmup = time.Second
+	flags.Init(&warmupFlags)
+	defer func() {
+		flags.Init(resetFlags)
+	}()
+
+	p2p := p2ptest.NewTestP2P(t)
+	genesis := time.Now().Add(2 * time.Second)
+	chainService := &mockChain.ChainService{
+		Genesis:        genesis,
+		ValidatorsRoot: [32]byte{'A'},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r := Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg: &Config{
+			P2P:           p2p,
+			Chain:         chainService,
+			StateNotifier: chainService.StateNotifier(),
+			InitialSync:   &mockSync.Sync{IsSyncing: false},
+		},
+		chainStarted: abool.New(),
+	}
+	var err error
+	p2p.Digest, err = r.forkDigest()
+	require.NoError(t, err)
+
+	go r.registerHandlers()
+	time.Sleep(100 * time.Millisecond)
+	i := r.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Synced,
+		Data: &statefeed.SyncedData{
+			StartTime: genesis,
+		},
+	})
+	if i == 0 {
+		t.Fatal("didn't send genesis time to sync event subscribers")
+	}
+
+	time.Sleep(300 * time.Millisecond)
+	assert.Equal(t, 0, len(r.cfg.P2P.PubSub().GetTopics()), "Subscribed to gossip topics before the warm-up window")
+
+	time.Sleep(1500 * time.Millisecond)
+	assert.NotEqual(t, 0, len(r.cfg.P2P.PubSub().GetTopics()), "Did not subscribe to gossip topics within the warm-up window")
+	require.NoError(t, r.Stop())
+}
@@ -0,0 +1,35 @@
+package timing
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	blockArrivalDelay = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "accepted_block_arrival_delay_milliseconds",
+			Help:    "The time between the start of the slot and the arrival of accepted gossiped blocks.",
+			Buckets: []float64{250, 500, 1000, 1500, 2000, 4000, 8000, 16000},
+		},
+	)
+	aggregateArrivalDelay = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "accepted_aggregate_arrival_delay_milliseconds",
+			Help:    "The time between the start of the slot and the arrival of accepted gossiped aggregate attestations.",
+			Buckets: []float64{4000, 6000, 8000, 10000, 12000, 16000, 24000, 48000},
+		},
+	)
+	lateBlocks = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "late_blocks_total",
+			Help: "The number of blocks arriving after the attestation deadline of their slot.",
+		},
+	)
+	lateAggregates = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "late_aggregates_total",
+			Help: "The number of aggregate attestations arriving after the end of their slot.",
+		},
+	)
+)
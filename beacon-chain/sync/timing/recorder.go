@@ -0,0 +1,121 @@
+// Package timing records the arrival time of gossiped blocks and aggregate attestations relative to
+// the start of their slot, to help diagnose propagation problems and missed head votes.
+package timing
+
+import (
+	"sync"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// DefaultSize is the default number of recent arrival events kept by a recorder.
+const DefaultSize = 1024
+
+// Kind is the kind of gossiped message of an arrival event.
+type Kind uint8
+
+const (
+	// Block is the arrival of a beacon block.
+	Block Kind = iota
+	// Aggregate is the arrival of an aggregate attestation.
+	Aggregate
+)
+
+// Event is the arrival of a gossiped message. The validator index is the proposer of a block or the
+// aggregator of an aggregate attestation, and the root is the block root or the attestation data root.
+type Event struct {
+	Kind           Kind
+	Slot           types.Slot
+	Root           [32]byte
+	ValidatorIndex types.ValidatorIndex
+	Delay          time.Duration
+	Late           bool
+}
+
+// Recorder keeps the most recent arrival events in a ring buffer, and exports the arrival delays as
+// metrics.
+type Recorder struct {
+	lock   sync.RWMutex
+	events []*Event
+	next   int
+	full   bool
+}
+
+// NewRecorder creates a recorder keeping the given number of recent events.
+func NewRecorder(size int) *Recorder {
+	if size <= 0 {
+		size = DefaultSize
+	}
+	return &Recorder{events: make([]*Event, size)}
+}
+
+// RecordBlock records the arrival of a block at the given time. A block is late when it arrives after
+// the attestation deadline of its slot, as attesters then vote for its parent instead.
+func (r *Recorder) RecordBlock(genesisTime time.Time, slot types.Slot, proposer types.ValidatorIndex, root [32]byte, receivedTime time.Time) {
+	delay := arrivalDelay(genesisTime, slot, receivedTime)
+	late := delay > slotDuration()/3
+	blockArrivalDelay.Observe(float64(delay.Milliseconds()))
+	if late {
+		lateBlocks.Inc()
+	}
+	r.add(&Event{Kind: Block, Slot: slot, Root: root, ValidatorIndex: proposer, Delay: delay, Late: late})
+}
+
+// RecordAggregate records the arrival of an aggregate attestation at the given time. An aggregate is
+// late when it arrives after the end of its slot, too late to be included in the next block.
+func (r *Recorder) RecordAggregate(genesisTime time.Time, slot types.Slot, aggregator types.ValidatorIndex, dataRoot [32]byte, receivedTime time.Time) {
+	delay := arrivalDelay(genesisTime, slot, receivedTime)
+	late := delay > slotDuration()
+	aggregateArrivalDelay.Observe(float64(delay.Milliseconds()))
+	if late {
+		lateAggregates.Inc()
+	}
+	r.add(&Event{Kind: Aggregate, Slot: slot, Root: dataRoot, ValidatorIndex: aggregator, Delay: delay, Late: late})
+}
+
+// Recent returns up to limit of the most recent events, newest first. A non positive limit returns
+// all the events kept.
+func (r *Recorder) Recent(limit int) []*Event {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	count := r.next
+	if r.full {
+		count = len(r.events)
+	}
+	if limit <= 0 || limit > count {
+		limit = count
+	}
+	events := make([]*Event, 0, limit)
+	for i := 1; i <= limit; i++ {
+		idx := (r.next - i + len(r.events)) % len(r.events)
+		e := *r.events[idx]
+		events = append(events, &e)
+	}
+	return events
+}
+
+func (r *Recorder) add(e *Event) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.events[r.next] = e
+	r.next++
+	if r.next == len(r.events) {
+		r.next = 0
+		r.full = true
+	}
+}
+
+// arrivalDelay returns the time elapsed between the start of the slot and the arrival. It is
+// negative for messages arriving early, within the allowed clock disparity.
+func arrivalDelay(genesisTime time.Time, slot types.Slot, receivedTime time.Time) time.Duration {
+	slotStart := genesisTime.Add(time.Duration(uint64(slot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	return receivedTime.Sub(slotStart)
+}
+
+func slotDuration() time.Duration {
+	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+}
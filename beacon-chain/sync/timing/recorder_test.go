@@ -0,0 +1,56 @@
+package timing
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRecorder_RecordAndRecent(t *testing.T) {
+	genesis := time.Unix(1000, 0)
+	slotStart := func(slot types.Slot) time.Time {
+		return genesis.Add(time.Duration(uint64(slot)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	}
+	r := NewRecorder(3)
+	assert.Equal(t, 0, len(r.Recent(0)))
+
+	r.RecordBlock(genesis, 1, 7, [32]byte{'a'}, slotStart(1).Add(time.Second))
+	r.RecordBlock(genesis, 2, 8, [32]byte{'b'}, slotStart(2).Add(5*time.Second))
+	events := r.Recent(0)
+	require.Equal(t, 2, len(events))
+	assert.Equal(t, types.Slot(2), events[0].Slot)
+	assert.Equal(t, types.ValidatorIndex(8), events[0].ValidatorIndex)
+	assert.Equal(t, 5*time.Second, events[0].Delay)
+	assert.Equal(t, true, events[0].Late)
+	assert.Equal(t, time.Second, events[1].Delay)
+	assert.Equal(t, false, events[1].Late)
+
+	// Aggregates are only late after the end of their slot.
+	r.RecordAggregate(genesis, 2, 9, [32]byte{'c'}, slotStart(2).Add(8*time.Second))
+	r.RecordAggregate(genesis, 2, 10, [32]byte{'d'}, slotStart(3).Add(time.Second))
+	events = r.Recent(0)
+	require.Equal(t, 3, len(events), "Oldest event should be evicted")
+	assert.Equal(t, Aggregate, events[0].Kind)
+	assert.Equal(t, true, events[0].Late)
+	assert.Equal(t, false, events[1].Late)
+	assert.Equal(t, Block, events[2].Kind)
+	assert.Equal(t, types.Slot(2), events[2].Slot)
+
+	events = r.Recent(1)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, [32]byte{'d'}, events[0].Root)
+}
+
+func TestRecorder_EarlyArrival(t *testing.T) {
+	genesis := time.Unix(1000, 0)
+	r := NewRecorder(0)
+	r.RecordBlock(genesis, 0, 0, [32]byte{}, genesis.Add(-100*time.Millisecond))
+	events := r.Recent(0)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, -100*time.Millisecond, events[0].Delay)
+	assert.Equal(t, false, events[0].Late)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -25,6 +26,7 @@ import (
 // validateAggregateAndProof verifies the aggregated signature and the selection proof is valid before forwarding to the
 // network and downstream services.
 func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+	receivedTime := timeutils.Now()
 	if pid == s.cfg.P2P.PeerID() {
 		return pubsub.ValidationAccept
 	}
@@ -52,7 +54,7 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		return pubsub.ValidationReject
 	}
 	if err := helpers.ValidateNilAttestation(m.Message.Aggregate); err != nil {
-		return pubsub.ValidationReject
+		return rejectAttestation(blocks.RejectNilAttestation)
 	}
 
 	// Broadcast the aggregated attestation on a feed to notify other services in the beacon node
@@ -65,7 +67,7 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	})
 
 	if err := helpers.ValidateSlotTargetEpoch(m.Message.Aggregate.Data); err != nil {
-		return pubsub.ValidationReject
+		return rejectAttestation(blocks.RejectSlotTargetMismatch)
 	}
 	if err := helpers.ValidateAttestationTime(m.Message.Aggregate.Data.Slot, s.cfg.Chain.GenesisTime()); err != nil {
 		traceutil.AnnotateError(span, err)
@@ -76,6 +78,14 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	if s.hasSeenAggregatorIndexEpoch(m.Message.Aggregate.Data.Target.Epoch, m.Message.AggregatorIndex) {
 		return pubsub.ValidationIgnore
 	}
+	dataRoot, err := m.Message.Aggregate.Data.HashTreeRoot()
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	if s.isAttestationReplay(dataRoot, m.Message.Aggregate.AggregationBits, true /* aggregate */) {
+		return pubsub.ValidationIgnore
+	}
 	// Check that the block being voted on isn't invalid.
 	if s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.BeaconBlockRoot)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(m.Message.Aggregate.Data.Target.Root)) ||
@@ -102,9 +112,13 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	}
 
 	s.setAggregatorIndexEpochSeen(m.Message.Aggregate.Data.Target.Epoch, m.Message.AggregatorIndex)
+	s.setAttestationParticipationSeen(dataRoot, m.Message.Aggregate.AggregationBits, true /* aggregate */)
 
 	msg.ValidatorData = m
 
+	if s.cfg.ArrivalRecorder != nil {
+		s.cfg.ArrivalRecorder.RecordAggregate(s.cfg.Chain.GenesisTime(), m.Message.Aggregate.Data.Slot, m.Message.AggregatorIndex, dataRoot, receivedTime)
+	}
 	return pubsub.ValidationAccept
 }
 
@@ -181,7 +195,7 @@ func (s *Service) validateAggregatedAtt(ctx context.Context, signed *ethpb.Signe
 	}
 	if !valid {
 		traceutil.AnnotateError(span, errors.Errorf("Could not verify selection or aggregator or attestation signature"))
-		return pubsub.ValidationReject
+		return rejectAttestation(blocks.RejectInvalidSignature)
 	}
 
 	return pubsub.ValidationAccept
@@ -6,13 +6,13 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
 
-// Clients who receive an attester slashing on this topic MUST validate the conditions within VerifyAttesterSlashing before
+// Clients who receive an attester slashing on this topic MUST validate the conditions within slashings.Validate before
 // forwarding it across the network.
 func (s *Service) validateAttesterSlashing(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
 	// Validation runs on publish (not just subscriptions), so we should approve any message from
@@ -51,7 +51,7 @@ func (s *Service) validateAttesterSlashing(ctx context.Context, pid peer.ID, msg
 	if err != nil {
 		return pubsub.ValidationIgnore
 	}
-	if err := blocks.VerifyAttesterSlashing(ctx, headState, slashing); err != nil {
+	if err := slashings.Validate(ctx, headState, slashing); err != nil {
 		return pubsub.ValidationReject
 	}
 
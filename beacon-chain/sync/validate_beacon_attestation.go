@@ -63,7 +63,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	}
 
 	if err := helpers.ValidateNilAttestation(att); err != nil {
-		return pubsub.ValidationReject
+		return rejectAttestation(blocks.RejectNilAttestation)
 	}
 
 	// Broadcast the unaggregated attestation on a feed to notify other services in the beacon node
@@ -81,13 +81,21 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return pubsub.ValidationIgnore
 	}
 	if err := helpers.ValidateSlotTargetEpoch(att.Data); err != nil {
-		return pubsub.ValidationReject
+		return rejectAttestation(blocks.RejectSlotTargetMismatch)
 	}
 
 	// Verify this the first attestation received for the participating validator for the slot.
 	if s.hasSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits) {
 		return pubsub.ValidationIgnore
 	}
+	dataRoot, err := att.Data.HashTreeRoot()
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return pubsub.ValidationIgnore
+	}
+	if s.isAttestationReplay(dataRoot, att.AggregationBits, false /* aggregate */) {
+		return pubsub.ValidationIgnore
+	}
 
 	// Reject an attestation if it references an invalid block.
 	if s.hasBadBlock(bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) ||
@@ -131,6 +139,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	}
 
 	s.setSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits)
+	s.setAttestationParticipationSeen(dataRoot, att.AggregationBits, false /* aggregate */)
 
 	msg.ValidatorData = att
 
@@ -148,9 +157,9 @@ func (s *Service) validateUnaggregatedAttTopic(ctx context.Context, a *eth.Attes
 		traceutil.AnnotateError(span, err)
 		return pubsub.ValidationIgnore
 	}
-	count := helpers.SlotCommitteeCount(valCount)
-	if uint64(a.Data.CommitteeIndex) > count {
-		return pubsub.ValidationReject
+	if err := blocks.VerifyAttestationCommitteeIndex(ctx, bs, a); err != nil {
+		traceutil.AnnotateError(span, err)
+		return attestationValidationResult(err)
 	}
 	subnet := helpers.ComputeSubnetForAttestation(valCount, a)
 	format := p2p.GossipTypeMapping[reflect.TypeOf(&eth.Attestation{})]
@@ -181,25 +190,45 @@ func (s *Service) validateUnaggregatedAttWithState(ctx context.Context, a *eth.A
 
 	// Verify number of aggregation bits matches the committee size.
 	if err := helpers.VerifyBitfieldLength(a.AggregationBits, uint64(len(committee))); err != nil {
-		return pubsub.ValidationReject
+		return rejectAttestation(blocks.RejectBitfieldMismatch)
 	}
 
 	// Attestation must be unaggregated and the bit index must exist in the range of committee indices.
 	// Note: eth2 spec suggests (len(get_attesting_indices(state, attestation.data, attestation.aggregation_bits)) == 1)
 	// however this validation can be achieved without use of get_attesting_indices which is an O(n) lookup.
 	if a.AggregationBits.Count() != 1 || a.AggregationBits.BitIndices()[0] >= len(committee) {
-		return pubsub.ValidationReject
+		return rejectAttestation(blocks.RejectBitfieldMismatch)
 	}
 
 	if err := blocks.VerifyAttestationSignature(ctx, bs, a); err != nil {
 		log.WithError(err).Debug("Could not verify attestation")
 		traceutil.AnnotateError(span, err)
-		return pubsub.ValidationReject
+		return rejectAttestation(blocks.RejectInvalidSignature)
 	}
 
 	return pubsub.ValidationAccept
 }
 
+// rejectAttestation records the reason an attestation failed validation and rejects it, so that
+// the peer which propagated it is penalized.
+func rejectAttestation(reason blocks.AttestationRejection) pubsub.ValidationResult {
+	attestationRejectedCounter.WithLabelValues(reason.String()).Inc()
+	return pubsub.ValidationReject
+}
+
+// attestationValidationResult maps an attestation validation error to a gossip validation result.
+// Invalid attestations are rejected, while errors which do not imply the attestation is invalid
+// are ignored.
+func attestationValidationResult(err error) pubsub.ValidationResult {
+	if err == nil {
+		return pubsub.ValidationAccept
+	}
+	if reason, ok := blocks.AttestationRejectionReason(err); ok {
+		return rejectAttestation(reason)
+	}
+	return pubsub.ValidationIgnore
+}
+
 // Returns true if the attestation was already seen for the participating validator for the slot.
 func (s *Service) hasSeenCommitteeIndicesSlot(slot types.Slot, committeeID types.CommitteeIndex, aggregateBits []byte) bool {
 	s.seenAttestationLock.RLock()
@@ -154,6 +154,9 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		"blockSlot":          blk.Block.Slot,
 		"sinceSlotStartTime": receivedTime.Sub(startTime),
 	}).Debug("Received block")
+	if s.cfg.ArrivalRecorder != nil {
+		s.cfg.ArrivalRecorder.RecordBlock(s.cfg.Chain.GenesisTime(), blk.Block.Slot, blk.Block.ProposerIndex, blockRoot, receivedTime)
+	}
 	return pubsub.ValidationAccept
 }
 
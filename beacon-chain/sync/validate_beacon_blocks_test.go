@@ -23,6 +23,7 @@ import (
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/timing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/abool"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -222,12 +223,13 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 	}
 	r := &Service{
 		cfg: &Config{
-			DB:            db,
-			P2P:           p,
-			InitialSync:   &mockSync.Sync{IsSyncing: false},
-			Chain:         chainService,
-			BlockNotifier: chainService.BlockNotifier(),
-			StateGen:      stateGen,
+			DB:              db,
+			P2P:             p,
+			InitialSync:     &mockSync.Sync{IsSyncing: false},
+			Chain:           chainService,
+			BlockNotifier:   chainService.BlockNotifier(),
+			StateGen:        stateGen,
+			ArrivalRecorder: timing.NewRecorder(8),
 		},
 		seenBlockCache:      c,
 		badBlockCache:       c2,
@@ -247,6 +249,9 @@ func TestValidateBeaconBlockPubSub_ValidProposerSignature(t *testing.T) {
 	result := r.validateBeaconBlockPubSub(ctx, "", m) == pubsub.ValidationAccept
 	assert.Equal(t, true, result)
 	assert.NotNil(t, m.ValidatorData, "Decoded message was not set on the message validator data")
+	arrivals := r.cfg.ArrivalRecorder.Recent(0)
+	require.Equal(t, 1, len(arrivals), "Accepted block arrival was not recorded")
+	assert.Equal(t, proposerIdx, arrivals[0].ValidatorIndex)
 }
 
 func TestValidateBeaconBlockPubSub_WithLookahead(t *testing.T) {
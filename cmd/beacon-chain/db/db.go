@@ -2,6 +2,7 @@ package db
 
 import (
 	beacondb "github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/tos"
 	"github.com/sirupsen/logrus"
@@ -31,5 +32,118 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "export-state",
+			Description: `exports the canonical state at a slot along with its latest block, to start another node from with --checkpoint-state and --checkpoint-block`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				exportSlotFlag,
+				exportStateFileFlag,
+				exportBlockFileFlag,
+				exportSnappyFlag,
+				cmd.DataDirFlag,
+				cmd.BoltMMapInitialSizeFlag,
+				flags.DBBackend,
+				cmd.ChainConfigFileFlag,
+				flags.SlotsPerArchivedPoint,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := exportState(cliCtx); err != nil {
+					log.Fatalf("Could not export state: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "export-era",
+			Description: `exports the finalized blocks and states to era files, which nodes started from a checkpoint can backfill from with --era-dir`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.EraDir,
+				exportStartEraFlag,
+				exportEndEraFlag,
+				cmd.DataDirFlag,
+				cmd.BoltMMapInitialSizeFlag,
+				flags.DBBackend,
+				cmd.ChainConfigFileFlag,
+				flags.SlotsPerArchivedPoint,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := exportEras(cliCtx); err != nil {
+					log.Fatalf("Could not export eras: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "migrate-backend",
+			Description: `copies the database of a stopped beacon node into a database of the backend given by --db-backend, then deletes the original database`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.DBBackend,
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := migrateBackend(cliCtx); err != nil {
+					log.Fatalf("Could not migrate database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "migrate",
+			Description: `upgrades the database of a stopped beacon node to the latest schema version, resuming an interrupted migration`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				dryRunFlag,
+				cmd.DataDirFlag,
+				cmd.BoltMMapInitialSizeFlag,
+				flags.DBBackend,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := migrateSchema(cliCtx); err != nil {
+					log.Fatalf("Could not migrate database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "inspect",
+			Description: `inspects the database of a stopped beacon node`,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "compact",
+					Description: `rewrites the database without the free pages left by deleted data, to reclaim their disk space`,
+					Flags: cmd.WrapFlags([]cli.Flag{
+						cmd.DataDirFlag,
+						flags.DBBackend,
+					}),
+					Before: tos.VerifyTosAcceptedOrPrompt,
+					Action: func(cliCtx *cli.Context) error {
+						if err := compactDB(cliCtx); err != nil {
+							log.Fatalf("Could not compact database: %v", err)
+						}
+						return nil
+					},
+				},
+				{
+					Name:        "verify",
+					Description: `checks that the blocks and states of the database decode, that block parents are known and that indices refer to saved blocks and states`,
+					Flags: cmd.WrapFlags([]cli.Flag{
+						repairFlag,
+						cmd.DataDirFlag,
+						cmd.BoltMMapInitialSizeFlag,
+						flags.DBBackend,
+					}),
+					Before: tos.VerifyTosAcceptedOrPrompt,
+					Action: func(cliCtx *cli.Context) error {
+						if err := verifyDB(cliCtx); err != nil {
+							log.Fatalf("Could not verify database: %v", err)
+						}
+						return nil
+					},
+				},
+			},
+		},
 	},
 }
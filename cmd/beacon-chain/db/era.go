@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/era"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// exportStartEraFlag specifies the first exported era.
+	exportStartEraFlag = &cli.Uint64Flag{
+		Name:  "start-era",
+		Usage: "The first era to export",
+	}
+	// exportEndEraFlag specifies the last exported era.
+	exportEndEraFlag = &cli.Uint64Flag{
+		Name:  "end-era",
+		Usage: "The last era to export, defaults to the last finalized era",
+	}
+)
+
+// exportEras writes the era files of the requested eras to the era directory, along with their index.
+func exportEras(cliCtx *cli.Context) error {
+	dir := cliCtx.String(flags.EraDir.Name)
+	if dir == "" {
+		return errors.Errorf("--%s is required", flags.EraDir.Name)
+	}
+	ctx := context.Background()
+	d, sg, err := openStateGen(ctx, cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	end, err := era.LastFinalizedEra(ctx, d)
+	if err != nil {
+		return err
+	}
+	if cliCtx.IsSet(exportEndEraFlag.Name) {
+		end = era.Era(cliCtx.Uint64(exportEndEraFlag.Name))
+	}
+	start := era.Era(cliCtx.Uint64(exportStartEraFlag.Name))
+	idx, err := era.Export(ctx, d, sg, dir, start, end)
+	if err != nil {
+		return err
+	}
+	log.WithField("dir", dir).WithField("eras", len(idx.Eras)).Info("Exported eras")
+	return nil
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	beacondb "github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// exportSlotFlag specifies the slot of the exported state.
+	exportSlotFlag = &cli.Uint64Flag{
+		Name:     "slot",
+		Usage:    "The slot of the canonical state to export",
+		Required: true,
+	}
+	// exportStateFileFlag specifies the file the exported state is written to.
+	exportStateFileFlag = &cli.StringFlag{
+		Name:  "state-output",
+		Usage: "Filepath to write the ssz encoded state to",
+		Value: "state.ssz",
+	}
+	// exportBlockFileFlag specifies the file the block of the exported state is written to.
+	exportBlockFileFlag = &cli.StringFlag{
+		Name:  "block-output",
+		Usage: "Filepath to write the ssz encoded latest block of the state to",
+		Value: "block.ssz",
+	}
+	// exportSnappyFlag compresses the exported state with snappy.
+	exportSnappyFlag = &cli.BoolFlag{
+		Name:  "snappy",
+		Usage: "Compress the exported state with snappy",
+	}
+)
+
+// exportState writes the canonical state at the requested slot and its latest block to files, which
+// can be given to another node with --checkpoint-state and --checkpoint-block.
+func exportState(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	d, sg, err := openStateGen(ctx, cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	slot := types.Slot(cliCtx.Uint64(exportSlotFlag.Name))
+	snapshot, err := sg.SnapshotBySlot(ctx, slot)
+	if err != nil {
+		return errors.Wrapf(err, "could not get state of slot %d", slot)
+	}
+	var encState []byte
+	if cliCtx.Bool(exportSnappyFlag.Name) {
+		encState, err = snapshot.State.MarshalSSZSnappy()
+	} else {
+		encState, err = snapshot.State.MarshalSSZ()
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not encode state")
+	}
+	encBlock, err := snapshot.Block.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not encode block")
+	}
+
+	statePath := cliCtx.String(exportStateFileFlag.Name)
+	if err := fileutil.WriteFile(statePath, encState); err != nil {
+		return errors.Wrap(err, "could not write state")
+	}
+	blockPath := cliCtx.String(exportBlockFileFlag.Name)
+	if err := fileutil.WriteFile(blockPath, encBlock); err != nil {
+		return errors.Wrap(err, "could not write block")
+	}
+	log.WithField("slot", slot).WithField("state", statePath).WithField("block", blockPath).Info("Exported state")
+	return nil
+}
+
+// openStateGen opens the database of the data directory and regenerates its states from the
+// finalized state, after loading the chain config given on the command line.
+func openStateGen(ctx context.Context, cliCtx *cli.Context) (beacondb.Database, *stategen.State, error) {
+	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
+		params.LoadChainConfigFile(cliCtx.String(cmd.ChainConfigFileFlag.Name))
+	}
+	if cliCtx.IsSet(flags.SlotsPerArchivedPoint.Name) {
+		c := params.BeaconConfig()
+		c.SlotsPerArchivedPoint = types.Slot(cliCtx.Int(flags.SlotsPerArchivedPoint.Name))
+		params.OverrideBeaconConfig(c)
+	}
+
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	d, err := beacondb.NewDB(ctx, dbPath, &kv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		Backend:         cliCtx.String(flags.DBBackend.Name),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not open database")
+	}
+	sg := stategen.New(d)
+	// States below the finalized slot are regenerated from the archived points, which requires the
+	// finalized state to be known.
+	c, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, nil, closeOnError(d, errors.Wrap(err, "could not get finalized checkpoint"))
+	}
+	if fRoot := bytesutil.ToBytes32(c.Root); fRoot != params.BeaconConfig().ZeroHash {
+		fState, err := sg.StateByRoot(ctx, fRoot)
+		if err != nil {
+			return nil, nil, closeOnError(d, errors.Wrap(err, "could not get finalized state"))
+		}
+		sg.SaveFinalizedState(fState.Slot(), fRoot, fState)
+	}
+	return d, sg, nil
+}
+
+func closeOnError(d beacondb.Database, err error) error {
+	if closeErr := d.Close(); closeErr != nil {
+		log.WithError(closeErr).Error("Failed to close database")
+	}
+	return err
+}
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// repairFlag deletes the dangling index entries found by the integrity check.
+var repairFlag = &cli.BoolFlag{
+	Name:  "repair",
+	Usage: "Delete the index entries which refer to missing blocks or states",
+}
+
+// dryRunFlag logs the pending schema migrations without applying them.
+var dryRunFlag = &cli.BoolFlag{
+	Name:  "dry-run",
+	Usage: "Log the pending schema migrations of the database without applying them",
+}
+
+// compactDB rewrites the database of the data directory without its free pages, or compacts it in
+// place with the LevelDB backend.
+func compactDB(cliCtx *cli.Context) error {
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	log.WithField("database-path", dbPath).Info("Compacting database, this may take a while")
+	reclaimed, err := kv.CompactDatabase(context.Background(), dbPath, cliCtx.String(flags.DBBackend.Name))
+	if err != nil {
+		return err
+	}
+	log.WithField("reclaimedBytes", reclaimed).Info("Compacted database")
+	return nil
+}
+
+// migrateBackend copies the database of the data directory into a database of the selected backend.
+func migrateBackend(cliCtx *cli.Context) error {
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	target := cliCtx.String(flags.DBBackend.Name)
+	log.WithFields(logrus.Fields{
+		"database-path": dbPath,
+		"backend":       target,
+	}).Info("Migrating database, this may take a while")
+	if err := kv.MigrateBackend(context.Background(), dbPath, target); err != nil {
+		return err
+	}
+	log.Info("Migrated database")
+	return nil
+}
+
+// migrateSchema upgrades the database of the data directory to the latest schema version.
+func migrateSchema(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	d, err := kv.NewKVStore(ctx, dbPath, &kv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		Backend:         cliCtx.String(flags.DBBackend.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	if err := d.Migrate(ctx, cliCtx.Bool(dryRunFlag.Name)); err != nil {
+		return err
+	}
+	current, latest, err := d.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"version": current,
+		"latest":  latest,
+	}).Info("Database schema version")
+	return nil
+}
+
+// verifyDB checks the integrity of the database of the data directory, and deletes its dangling index
+// entries if requested. An error is returned if issues remain.
+func verifyDB(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	d, err := kv.NewKVStore(ctx, dbPath, &kv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		Backend:         cliCtx.String(flags.DBBackend.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	report, err := d.VerifyIntegrity(ctx, cliCtx.Bool(repairFlag.Name))
+	if err != nil {
+		return err
+	}
+	for _, issue := range report.Issues {
+		log.Warn(issue)
+	}
+	log.WithFields(logrus.Fields{
+		"blocks":               report.Blocks,
+		"states":               report.States,
+		"stateSummaries":       report.StateSummaries,
+		"issues":               len(report.Issues),
+		"danglingIndexEntries": report.DanglingIndexEntries,
+		"repairedIndexEntries": report.RepairedIndexEntries,
+	}).Info("Verified database")
+	if remaining := len(report.Issues) - report.RepairedIndexEntries; remaining > 0 {
+		return errors.Errorf("database has %d unrepaired issues", remaining)
+	}
+	return nil
+}
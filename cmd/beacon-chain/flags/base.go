@@ -3,6 +3,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
 )
@@ -75,6 +77,12 @@ var (
 			"(browser enforced). This flag has no effect if not used with --grpc-gateway-port.",
 		Value: "http://localhost:4200,http://localhost:7500,http://127.0.0.1:4200,http://127.0.0.1:7500,http://0.0.0.0:4200,http://0.0.0.0:7500",
 	}
+	// EnableGRPCGatewayGraphQL serves a GraphQL endpoint on the gRPC gateway.
+	EnableGRPCGatewayGraphQL = &cli.BoolFlag{
+		Name: "enable-grpc-gateway-graphql",
+		Usage: "Serves a GraphQL endpoint at /graphql on the gRPC gateway, to compose queries across blocks, " +
+			"validators, committees and balances. This flag has no effect if the gateway is disabled.",
+	}
 	// MinSyncPeers specifies the required number of successful peer handshakes in order
 	// to start syncing with external peers.
 	MinSyncPeers = &cli.IntFlag{
@@ -82,6 +90,27 @@ var (
 		Usage: "The required number of valid peers to connect with before syncing.",
 		Value: 3,
 	}
+	// GenesisGossipWarmup specifies how long before genesis the node subscribes to gossip topics.
+	GenesisGossipWarmup = &cli.DurationFlag{
+		Name: "genesis-gossip-warmup",
+		Usage: "How long before genesis the node subscribes to gossip topics, so that its gossip meshes " +
+			"are formed by the time the first block is proposed.",
+		Value: time.Minute,
+	}
+	// AttestationReplayWindow specifies how long after attestations of an attestation data are first
+	// seen, gossip attestations carrying no new participation for the data are ignored as replays.
+	AttestationReplayWindow = &cli.DurationFlag{
+		Name: "attestation-replay-window",
+		Usage: "How long after attestations of an attestation data are first seen, gossip attestations " +
+			"which carry no new participation for the data are ignored as replays. A value of 0, the default, " +
+			"disables the check.",
+	}
+	// ReorgWarningDepth specifies the depth of chain reorgs above which they are logged as warnings.
+	ReorgWarningDepth = &cli.IntFlag{
+		Name:  "reorg-warning-depth",
+		Usage: "Chain reorgs deeper than this number of slots are logged as warnings.",
+		Value: 2,
+	}
 	// ContractDeploymentBlock is the block in which the eth1 deposit contract was deployed.
 	ContractDeploymentBlock = &cli.IntFlag{
 		Name:  "contract-deployment-block",
@@ -106,6 +135,25 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// PruneStates deletes the finalized history which is not needed to regenerate the canonical states on startup.
+	PruneStates = &cli.BoolFlag{
+		Name: "prune-states",
+		Usage: "Deletes the finalized states which are not at an archived point and the finalized blocks which are " +
+			"not canonical from the DB on startup, then compacts the DB to reclaim their disk space.",
+	}
+	// DBBackend selects the key-value store of the beacon node database.
+	DBBackend = &cli.StringFlag{
+		Name: "db-backend",
+		Usage: "The key-value store of the DB, either bolt or leveldb. An existing DB is switched to another " +
+			"backend with the db migrate-backend command.",
+		Value: "bolt",
+	}
+	// IndexAttestationInclusions indexes the inclusions of the attestations of every validator at block import.
+	IndexAttestationInclusions = &cli.BoolFlag{
+		Name: "index-attestation-inclusions",
+		Usage: "Indexes the epoch, inclusion slot and inclusion distance of the attestations of every validator " +
+			"in the DB as blocks are imported, so that attestation inclusions are queried without replaying states.",
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
@@ -131,8 +179,15 @@ var (
 	}
 	// EnableDebugRPCEndpoints as /v1/beacon/state.
 	EnableDebugRPCEndpoints = &cli.BoolFlag{
-		Name:  "enable-debug-rpc-endpoints",
-		Usage: "Enables the debug rpc service, containing utility endpoints such as /eth/v1alpha1/beacon/state.",
+		Name:    "enable-debug-rpc-endpoints",
+		Aliases: []string{"enable-debug-rpc"},
+		Usage:   "Enables the debug rpc service, containing utility endpoints such as /eth/v1alpha1/beacon/state.",
+	}
+	// EnableAdminRPCEndpoints serves the admin gRPC service, which changes the behavior of the running node.
+	EnableAdminRPCEndpoints = &cli.BoolFlag{
+		Name: "enable-admin-rpc-endpoints",
+		Usage: "Enables the admin gRPC service, which toggles runtime-safe feature flags, changes log levels " +
+			"and flushes caches of the running node. Only enable it when the gRPC endpoint is not exposed.",
 	}
 	SubscribeToAllSubnets = &cli.BoolFlag{
 		Name:  "subscribe-all-subnets",
@@ -153,6 +208,22 @@ var (
 		Name:  "network-id",
 		Usage: "Sets the network id of the beacon chain.",
 	}
+	// Eth1FollowDistance overrides the number of eth1 blocks the node waits before considering
+	// an eth1 block for deposits and eth1 data votes.
+	Eth1FollowDistance = &cli.Uint64Flag{
+		Name:  "eth1-follow-distance",
+		Usage: "Overrides the eth1 follow distance (ETH1_FOLLOW_DISTANCE) of the chain config, in eth1 blocks. Intended for private devnets",
+	}
+	// SecondsPerEth1Block overrides the expected eth1 block time.
+	SecondsPerEth1Block = &cli.Uint64Flag{
+		Name:  "seconds-per-eth1-block",
+		Usage: "Overrides the expected eth1 block time (SECONDS_PER_ETH1_BLOCK) of the chain config. Intended for private devnets",
+	}
+	// EpochsPerEth1VotingPeriod overrides the length of the eth1 data voting period.
+	EpochsPerEth1VotingPeriod = &cli.Uint64Flag{
+		Name:  "epochs-per-eth1-voting-period",
+		Usage: "Overrides the eth1 data voting period (EPOCHS_PER_ETH1_VOTING_PERIOD) of the chain config, in epochs. Intended for private devnets",
+	}
 	// WeakSubjectivityCheckpt defines the weak subjectivity checkpoint the node must sync through to defend against long range attacks.
 	WeakSubjectivityCheckpt = &cli.StringFlag{
 		Name: "weak-subjectivity-checkpoint",
@@ -172,4 +243,69 @@ var (
 		Usage: "Load a genesis state from ssz file. Testnet genesis files can be found in the " +
 			"eth2-clients/eth2-testnets repository on github.",
 	}
+	// CheckpointState defines a flag to start the beacon chain from a finalized checkpoint state.
+	CheckpointState = &cli.StringFlag{
+		Name: "checkpoint-state",
+		Usage: "Start the beacon node from a trusted finalized state instead of genesis, given as the path or http(s) URL " +
+			"of an ssz, optionally snappy compressed, encoded BeaconState at the start slot of an epoch. Requires --checkpoint-block and the genesis state.",
+	}
+	// CheckpointBlock defines a flag to start the beacon chain from the block of a finalized checkpoint state.
+	CheckpointBlock = &cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "The path or http(s) URL of the ssz encoded SignedBeaconBlock of the state given by --checkpoint-state.",
+	}
+	// EraDir defines a flag for the directory of the era files to backfill blocks from.
+	EraDir = &cli.StringFlag{
+		Name: "era-dir",
+		Usage: "Directory of era files, as exported with the db export-era command, to backfill the blocks preceding " +
+			"the checkpoint given by --checkpoint-state from before downloading them from peers.",
+	}
+	// ReadReplicaSource defines a flag to run the beacon node as a read replica of another beacon node.
+	ReadReplicaSource = &cli.StringFlag{
+		Name: "read-replica-source",
+		Usage: "gRPC endpoint of a primary beacon node to follow. When set, the node does not sync from peers " +
+			"and instead imports the blocks streamed by the primary node in order to serve read-only APIs.",
+	}
+	// ReplicaTLSCert defines a flag for the TLS certificate of the primary beacon node followed by a read replica.
+	ReplicaTLSCert = &cli.StringFlag{
+		Name: "replica-tls-cert",
+		Usage: "Certificate of the primary beacon node given by --read-replica-source, for a secure gRPC connection. " +
+			"Block signatures are only left unverified by the replica over a secure connection.",
+	}
+	// FinalityStallEpochs defines the number of epochs since the finalized epoch after which finality is considered stalled.
+	FinalityStallEpochs = &cli.Uint64Flag{
+		Name: "finality-stall-epochs",
+		Usage: "Number of epochs since the last finalized epoch after which the node considers finality stalled " +
+			"and logs chain health diagnostics. A value of 0 disables the check.",
+		Value: 4,
+	}
+	// ForkChoicePruneThreshold defines the number of fork choice nodes before the finalized block required to prune them.
+	ForkChoicePruneThreshold = &cli.Uint64Flag{
+		Name: "fork-choice-prune-threshold",
+		Usage: "Minimal number of fork choice nodes before the finalized block for them to be pruned upon new " +
+			"finalization. Lower values keep the memory of fork choice bounded during long periods of non-finality " +
+			"at the cost of pruning more often.",
+		Value: 256,
+	}
+	// NextSlotCacheHeads defines the number of fork choice heads whose states are advanced to the next slot.
+	NextSlotCacheHeads = &cli.IntFlag{
+		Name: "next-slot-cache-heads",
+		Usage: "Number of heaviest fork choice heads whose states are advanced to the next slot at the start of " +
+			"every slot, so that the next block is processed faster whichever head it builds on. Requires " +
+			"--enable-next-slot-state-cache. A value of 0 only caches the states of processed blocks.",
+		Value: 2,
+	}
+	// SkipSlotCacheSize defines the maximum number of states kept by the skip slot cache.
+	SkipSlotCacheSize = &cli.IntFlag{
+		Name: "skip-slot-cache-size",
+		Usage: "Maximum number of states kept by the skip slot cache, which avoids processing the same empty " +
+			"slots again while the chain is missing blocks.",
+		Value: 8,
+	}
+	// SkipSlotCacheMaxMemory defines the memory budget of the skip slot cache in megabytes.
+	SkipSlotCacheMaxMemory = &cli.Uint64Flag{
+		Name: "skip-slot-cache-max-memory-mb",
+		Usage: "Budget in megabytes of the estimated size of the states kept by the skip slot cache. The least " +
+			"recently used states are evicted beyond it. A value of 0 only bounds the cache by its size.",
+	}
 )
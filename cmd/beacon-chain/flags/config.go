@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/urfave/cli/v2"
 )
@@ -15,6 +17,9 @@ type GlobalFlags struct {
 	MinimumSyncPeers           int
 	BlockBatchLimit            int
 	BlockBatchLimitBurstFactor int
+	GenesisGossipWarmup        time.Duration
+	AttestationReplayWindow    time.Duration
+	ReorgWarningDepth          int
 }
 
 var globalConfig *GlobalFlags
@@ -51,6 +56,9 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	cfg.DisableDiscv5 = ctx.Bool(DisableDiscv5.Name)
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
+	cfg.GenesisGossipWarmup = ctx.Duration(GenesisGossipWarmup.Name)
+	cfg.AttestationReplayWindow = ctx.Duration(AttestationReplayWindow.Name)
+	cfg.ReorgWarningDepth = ctx.Int(ReorgWarningDepth.Name)
 	configureMinimumPeers(ctx, cfg)
 
 	Init(cfg)
@@ -29,4 +29,11 @@ var (
 		Name:  "interop-num-validators",
 		Usage: "Specify number of genesis validators to generate for interop. Must be used with --interop-genesis-time",
 	}
+	// InteropModeFlag runs the beacon node from a deterministic genesis state with an in-process validator.
+	InteropModeFlag = &cli.BoolFlag{
+		Name: "interop-mode",
+		Usage: "Start from a deterministic interop genesis state, run validator duties in-process for " +
+			"every interop key and mock eth1 data, so a single node can produce a chain without an eth1 " +
+			"connection. This interop functionality should not be used with public testnets.",
+	}
 )
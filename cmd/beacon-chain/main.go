@@ -23,6 +23,7 @@ import (
 	_ "github.com/prysmaticlabs/prysm/shared/maxprocs"
 	"github.com/prysmaticlabs/prysm/shared/tos"
 	"github.com/prysmaticlabs/prysm/shared/version"
+	interopvalidator "github.com/prysmaticlabs/prysm/tools/interop/validator"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
@@ -40,7 +41,11 @@ var appFlags = []cli.Flag{
 	flags.GRPCGatewayHost,
 	flags.GRPCGatewayPort,
 	flags.GPRCGatewayCorsDomain,
+	flags.EnableGRPCGatewayGraphQL,
 	flags.MinSyncPeers,
+	flags.GenesisGossipWarmup,
+	flags.AttestationReplayWindow,
+	flags.ReorgWarningDepth,
 	flags.ContractDeploymentBlock,
 	flags.SetGCPercent,
 	flags.HeadSync,
@@ -52,15 +57,33 @@ var appFlags = []cli.Flag{
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
+	flags.InteropModeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.PruneStates,
+	flags.DBBackend,
+	flags.IndexAttestationInclusions,
 	flags.EnableDebugRPCEndpoints,
+	flags.EnableAdminRPCEndpoints,
 	flags.SubscribeToAllSubnets,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
+	flags.Eth1FollowDistance,
+	flags.SecondsPerEth1Block,
+	flags.EpochsPerEth1VotingPeriod,
 	flags.WeakSubjectivityCheckpt,
 	flags.Eth1HeaderReqLimit,
 	flags.GenesisStatePath,
+	flags.CheckpointState,
+	flags.CheckpointBlock,
+	flags.EraDir,
+	flags.ReadReplicaSource,
+	flags.ReplicaTLSCert,
+	flags.FinalityStallEpochs,
+	flags.ForkChoicePruneThreshold,
+	flags.NextSlotCacheHeads,
+	flags.SkipSlotCacheSize,
+	flags.SkipSlotCacheMaxMemory,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -76,6 +99,9 @@ var appFlags = []cli.Flag{
 	cmd.P2PHost,
 	cmd.P2PHostDNS,
 	cmd.P2PMaxPeers,
+	cmd.P2PMaxPeersPerClient,
+	cmd.P2PColocationLimit,
+	cmd.P2PMinSubnetPeers,
 	cmd.P2PPrivKey,
 	cmd.P2PMetadata,
 	cmd.P2PAllowList,
@@ -219,7 +245,7 @@ func startNode(ctx *cli.Context) error {
 		gethlog.Root().SetHandler(glogger)
 	}
 
-	beacon, err := node.New(ctx)
+	beacon, err := node.New(ctx, node.WithInteropValidator(interopvalidator.NewService))
 	if err != nil {
 		return err
 	}
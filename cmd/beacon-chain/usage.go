@@ -103,23 +103,41 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GRPCGatewayHost,
 			flags.GRPCGatewayPort,
 			flags.GPRCGatewayCorsDomain,
+			flags.EnableGRPCGatewayGraphQL,
 			flags.HTTPWeb3ProviderFlag,
 			flags.FallbackWeb3ProviderFlag,
 			flags.SetGCPercent,
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.PruneStates,
+			flags.DBBackend,
+			flags.IndexAttestationInclusions,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
+			flags.EnableAdminRPCEndpoints,
 			flags.SubscribeToAllSubnets,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
 			flags.NetworkID,
+			flags.Eth1FollowDistance,
+			flags.SecondsPerEth1Block,
+			flags.EpochsPerEth1VotingPeriod,
 			flags.WeakSubjectivityCheckpt,
 			flags.Eth1HeaderReqLimit,
 			flags.GenesisStatePath,
+			flags.CheckpointState,
+			flags.CheckpointBlock,
+			flags.EraDir,
+			flags.ReadReplicaSource,
+			flags.ReplicaTLSCert,
+			flags.FinalityStallEpochs,
+			flags.ForkChoicePruneThreshold,
+			flags.NextSlotCacheHeads,
+			flags.SkipSlotCacheSize,
+			flags.SkipSlotCacheMaxMemory,
 		},
 	},
 	{
@@ -129,6 +147,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PHost,
 			cmd.P2PHostDNS,
 			cmd.P2PMaxPeers,
+			cmd.P2PMaxPeersPerClient,
+			cmd.P2PColocationLimit,
+			cmd.P2PMinSubnetPeers,
 			cmd.P2PPrivKey,
 			cmd.P2PMetadata,
 			cmd.P2PAllowList,
@@ -136,6 +157,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
+			flags.GenesisGossipWarmup,
+			flags.AttestationReplayWindow,
+			flags.ReorgWarningDepth,
 		},
 	},
 	{
@@ -155,6 +179,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.InteropGenesisStateFlag,
 			flags.InteropGenesisTimeFlag,
 			flags.InteropNumValidatorsFlag,
+			flags.InteropModeFlag,
 		},
 	},
 }
@@ -143,6 +143,37 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "generate",
+			Description: "Derives new validator accounts in an HD wallet from its mnemonic along EIP-2334 paths, and writes their deposit data",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.MnemonicFileFlag,
+				flags.Mnemonic25thWordFileFlag,
+				flags.SkipMnemonic25thWordCheckFlag,
+				flags.NumAccountsFlag,
+				flags.DepositDataDirFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				featureconfig.PraterTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := accounts.GenerateAccountsCli(cliCtx); err != nil {
+					log.Fatalf("Could not generate accounts: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "voluntary-exit",
 			Description: "Performs a voluntary exit on selected accounts",
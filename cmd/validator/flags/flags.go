@@ -238,6 +238,12 @@ var (
 		Usage: "Path to a directory where accounts will be backed up into a zip file",
 		Value: DefaultValidatorDir(),
 	}
+	// DepositDataDirFlag defines the path for the deposit data of newly generated accounts.
+	DepositDataDirFlag = &cli.StringFlag{
+		Name:  "deposit-data-dir",
+		Usage: "Path to a directory where the deposit data JSON file of newly generated accounts will be written",
+		Value: DefaultValidatorDir(),
+	}
 	// SlashingProtectionJSONFileFlag is used to enter the file path of the slashing protection JSON.
 	SlashingProtectionJSONFileFlag = &cli.StringFlag{
 		Name:  "slashing-protection-json-file",
@@ -317,6 +323,15 @@ var (
 		Usage: "Enables more verbose logging for counting down to duty",
 		Value: false,
 	}
+	// DoppelGangerProtectionEpochsFlag defines the number of epochs to observe the network for activity
+	// of the validating keys before performing duties.
+	DoppelGangerProtectionEpochsFlag = &cli.UintFlag{
+		Name: "doppelganger-protection-epochs",
+		Usage: "Number of epochs to observe the network for blocks and attestations of the validating keys " +
+			"before performing duties. The validator client refuses to start if any are seen, as the keys are " +
+			"likely used by another validator client. 0 disables the check",
+		Value: 0,
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
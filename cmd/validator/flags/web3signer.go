@@ -0,0 +1,31 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Flags defined for delegating signing to a Web3Signer compatible remote signer.
+var (
+	Web3SignerURLFlag = &cli.StringFlag{
+		Name: "web3signer-url",
+		Usage: "URL of a Web3Signer compatible remote signer to sign with instead of the keys of a wallet. " +
+			"Example: --web3signer-url=https://signer.example.com:9000",
+	}
+	Web3SignerPublicKeysFlag = &cli.StringSliceFlag{
+		Name: "web3signer-public-keys",
+		Usage: "Comma-separated list of hex encoded public keys to validate with through the remote signer. " +
+			"Defaults to all the public keys listed by the remote signer",
+	}
+	Web3SignerCACertFlag = &cli.StringFlag{
+		Name:  "web3signer-ca-cert",
+		Usage: "Path to the certificate authority of the remote signer's TLS certificate",
+	}
+	Web3SignerClientCertFlag = &cli.StringFlag{
+		Name:  "web3signer-client-cert",
+		Usage: "Path to the client certificate used to authenticate to the remote signer over TLS",
+	}
+	Web3SignerClientKeyFlag = &cli.StringFlag{
+		Name:  "web3signer-client-key",
+		Usage: "Path to the key of the client certificate used to authenticate to the remote signer over TLS",
+	}
+)
@@ -73,6 +73,12 @@ var appFlags = []cli.Flag{
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
 	flags.EnableDutyCountDown,
+	flags.DoppelGangerProtectionEpochsFlag,
+	flags.Web3SignerURLFlag,
+	flags.Web3SignerPublicKeysFlag,
+	flags.Web3SignerCACertFlag,
+	flags.Web3SignerClientCertFlag,
+	flags.Web3SignerClientKeyFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.EnableBackupWebhookFlag,
 	cmd.MinimalConfigFlag,
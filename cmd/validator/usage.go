@@ -106,6 +106,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.WalletPasswordFileFlag,
 			flags.GraffitiFileFlag,
 			flags.EnableDutyCountDown,
+			flags.DoppelGangerProtectionEpochsFlag,
 		},
 	},
 	{
@@ -119,6 +120,16 @@ var appHelpFlagGroups = []flagGroup{
 			flags.InteropStartIndex,
 		},
 	},
+	{
+		Name: "web3signer",
+		Flags: []cli.Flag{
+			flags.Web3SignerURLFlag,
+			flags.Web3SignerPublicKeysFlag,
+			flags.Web3SignerCACertFlag,
+			flags.Web3SignerClientCertFlag,
+			flags.Web3SignerClientKeyFlag,
+		},
+	},
 }
 
 func init() {
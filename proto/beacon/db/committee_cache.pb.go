@@ -0,0 +1,782 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/db/committee_cache.proto
+
+package db
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CommitteeCacheEntries struct {
+	Entries              []*CommitteeCacheEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *CommitteeCacheEntries) Reset()         { *m = CommitteeCacheEntries{} }
+func (m *CommitteeCacheEntries) String() string { return proto.CompactTextString(m) }
+func (*CommitteeCacheEntries) ProtoMessage()    {}
+func (*CommitteeCacheEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c88432438b0edd0e, []int{0}
+}
+func (m *CommitteeCacheEntries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeCacheEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeCacheEntries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeCacheEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeCacheEntries.Merge(m, src)
+}
+func (m *CommitteeCacheEntries) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeCacheEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeCacheEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeCacheEntries proto.InternalMessageInfo
+
+func (m *CommitteeCacheEntries) GetEntries() []*CommitteeCacheEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type CommitteeCacheEntry struct {
+	CommitteeCount       uint64   `protobuf:"varint,1,opt,name=committee_count,json=committeeCount,proto3" json:"committee_count,omitempty"`
+	Seed                 []byte   `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	ShuffledIndices      []uint64 `protobuf:"varint,3,rep,packed,name=shuffled_indices,json=shuffledIndices,proto3" json:"shuffled_indices,omitempty"`
+	SortedIndices        []uint64 `protobuf:"varint,4,rep,packed,name=sorted_indices,json=sortedIndices,proto3" json:"sorted_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitteeCacheEntry) Reset()         { *m = CommitteeCacheEntry{} }
+func (m *CommitteeCacheEntry) String() string { return proto.CompactTextString(m) }
+func (*CommitteeCacheEntry) ProtoMessage()    {}
+func (*CommitteeCacheEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c88432438b0edd0e, []int{1}
+}
+func (m *CommitteeCacheEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeCacheEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeCacheEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeCacheEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeCacheEntry.Merge(m, src)
+}
+func (m *CommitteeCacheEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeCacheEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeCacheEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeCacheEntry proto.InternalMessageInfo
+
+func (m *CommitteeCacheEntry) GetCommitteeCount() uint64 {
+	if m != nil {
+		return m.CommitteeCount
+	}
+	return 0
+}
+
+func (m *CommitteeCacheEntry) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+func (m *CommitteeCacheEntry) GetShuffledIndices() []uint64 {
+	if m != nil {
+		return m.ShuffledIndices
+	}
+	return nil
+}
+
+func (m *CommitteeCacheEntry) GetSortedIndices() []uint64 {
+	if m != nil {
+		return m.SortedIndices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CommitteeCacheEntries)(nil), "prysm.beacon.db.CommitteeCacheEntries")
+	proto.RegisterType((*CommitteeCacheEntry)(nil), "prysm.beacon.db.CommitteeCacheEntry")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/db/committee_cache.proto", fileDescriptor_c88432438b0edd0e)
+}
+
+var fileDescriptor_c88432438b0edd0e = []byte{
+	// 257 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x41, 0x4a, 0xc4, 0x30,
+	0x14, 0x86, 0x89, 0x2d, 0x0a, 0x51, 0xa7, 0x12, 0x11, 0xba, 0x2a, 0x65, 0x70, 0xb0, 0x6e, 0x12,
+	0xd0, 0xad, 0xb8, 0xb0, 0xb8, 0x70, 0xdb, 0x8d, 0xe0, 0x66, 0x68, 0x92, 0x37, 0x36, 0x30, 0x6d,
+	0x86, 0x24, 0x5d, 0xcc, 0x7d, 0x3c, 0x8c, 0x4b, 0x8f, 0x20, 0x3d, 0x89, 0x4c, 0x62, 0x65, 0xa8,
+	0xb3, 0xfb, 0xf3, 0xe5, 0xfb, 0xe1, 0xbd, 0x87, 0x17, 0x1b, 0xa3, 0x9d, 0x66, 0x1c, 0x6a, 0xa1,
+	0x3b, 0x26, 0x39, 0x13, 0xba, 0x6d, 0x95, 0x73, 0x00, 0x4b, 0x51, 0x8b, 0x06, 0xa8, 0xff, 0x27,
+	0xc9, 0xc6, 0x6c, 0x6d, 0x4b, 0x83, 0x46, 0x25, 0x9f, 0xbf, 0xe2, 0xab, 0x72, 0x34, 0xcb, 0x9d,
+	0xf8, 0xdc, 0x39, 0xa3, 0xc0, 0x92, 0x47, 0x7c, 0x02, 0x21, 0xa6, 0x28, 0x8f, 0x8a, 0xd3, 0xbb,
+	0x6b, 0x3a, 0xe9, 0xd2, 0xff, 0xc5, 0x6d, 0x35, 0x96, 0xe6, 0x1f, 0x08, 0x5f, 0x1e, 0x10, 0xc8,
+	0x0d, 0x4e, 0xf6, 0x46, 0xd3, 0x7d, 0xe7, 0x52, 0x94, 0xa3, 0x22, 0xae, 0x66, 0x7f, 0xb8, 0xdc,
+	0x51, 0x42, 0x70, 0x6c, 0x01, 0x64, 0x7a, 0x94, 0xa3, 0xe2, 0xac, 0xf2, 0x99, 0xdc, 0xe2, 0x0b,
+	0xdb, 0xf4, 0xab, 0xd5, 0x1a, 0xe4, 0x52, 0x75, 0x52, 0x09, 0xb0, 0x69, 0x94, 0x47, 0x45, 0x5c,
+	0x25, 0x23, 0x7f, 0x09, 0x98, 0x2c, 0xf0, 0xcc, 0x6a, 0xe3, 0xf6, 0xc4, 0xd8, 0x8b, 0xe7, 0x81,
+	0xfe, 0x6a, 0x4f, 0x0f, 0x9f, 0x43, 0x86, 0xbe, 0x86, 0x0c, 0x7d, 0x0f, 0x19, 0x7a, 0xa3, 0xef,
+	0xca, 0x35, 0x3d, 0xa7, 0x42, 0xb7, 0xcc, 0x6f, 0x5b, 0x3b, 0x25, 0xd6, 0x35, 0xb7, 0xe1, 0xc5,
+	0x26, 0x47, 0xe6, 0xc7, 0x1e, 0xdc, 0xff, 0x0c, 0x00, 0x4f, 0x5a, 0x71, 0x9a, 0x7e, 0x01, 0x00,
+	0x00,
+}
+
+func (m *CommitteeCacheEntries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeCacheEntries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeCacheEntries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommitteeCache(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeCacheEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeCacheEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeCacheEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SortedIndices) > 0 {
+		dAtA2 := make([]byte, len(m.SortedIndices)*10)
+		var j1 int
+		for _, num := range m.SortedIndices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintCommitteeCache(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ShuffledIndices) > 0 {
+		dAtA4 := make([]byte, len(m.ShuffledIndices)*10)
+		var j3 int
+		for _, num := range m.ShuffledIndices {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintCommitteeCache(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintCommitteeCache(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CommitteeCount != 0 {
+		i = encodeVarintCommitteeCache(dAtA, i, uint64(m.CommitteeCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCommitteeCache(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCommitteeCache(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommitteeCacheEntries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovCommitteeCache(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteeCacheEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitteeCount != 0 {
+		n += 1 + sovCommitteeCache(uint64(m.CommitteeCount))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovCommitteeCache(uint64(l))
+	}
+	if len(m.ShuffledIndices) > 0 {
+		l = 0
+		for _, e := range m.ShuffledIndices {
+			l += sovCommitteeCache(uint64(e))
+		}
+		n += 1 + sovCommitteeCache(uint64(l)) + l
+	}
+	if len(m.SortedIndices) > 0 {
+		l = 0
+		for _, e := range m.SortedIndices {
+			l += sovCommitteeCache(uint64(e))
+		}
+		n += 1 + sovCommitteeCache(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCommitteeCache(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCommitteeCache(x uint64) (n int) {
+	return sovCommitteeCache(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CommitteeCacheEntries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommitteeCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeCacheEntries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeCacheEntries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitteeCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommitteeCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitteeCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &CommitteeCacheEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommitteeCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommitteeCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitteeCacheEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommitteeCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeCacheEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeCacheEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeCount", wireType)
+			}
+			m.CommitteeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitteeCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitteeCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommitteeCache
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitteeCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommitteeCache
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShuffledIndices = append(m.ShuffledIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommitteeCache
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCommitteeCache
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCommitteeCache
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShuffledIndices) == 0 {
+					m.ShuffledIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommitteeCache
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShuffledIndices = append(m.ShuffledIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShuffledIndices", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommitteeCache
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SortedIndices = append(m.SortedIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommitteeCache
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCommitteeCache
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCommitteeCache
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SortedIndices) == 0 {
+					m.SortedIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommitteeCache
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SortedIndices = append(m.SortedIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortedIndices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommitteeCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommitteeCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCommitteeCache(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCommitteeCache
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCommitteeCache
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCommitteeCache
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCommitteeCache
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCommitteeCache
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCommitteeCache
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCommitteeCache        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCommitteeCache          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCommitteeCache = fmt.Errorf("proto: unexpected end of group")
+)
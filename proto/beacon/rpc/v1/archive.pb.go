@@ -0,0 +1,2220 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/archive.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ValidatorSetRequest struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	PageSize             int32                                              `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	StartIndex           github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,3,opt,name=start_index,json=startIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"start_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ValidatorSetRequest) Reset()         { *m = ValidatorSetRequest{} }
+func (m *ValidatorSetRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetRequest) ProtoMessage()    {}
+func (*ValidatorSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f45b70bba0a4b33, []int{0}
+}
+func (m *ValidatorSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetRequest.Merge(m, src)
+}
+func (m *ValidatorSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetRequest proto.InternalMessageInfo
+
+func (m *ValidatorSetRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorSetRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ValidatorSetRequest) GetStartIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.StartIndex
+	}
+	return 0
+}
+
+type ValidatorSetPage struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	TotalSize            uint64                                             `protobuf:"varint,2,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Validators           []*ArchivedValidator                               `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+	NextIndex            github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,4,opt,name=next_index,json=nextIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"next_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ValidatorSetPage) Reset()         { *m = ValidatorSetPage{} }
+func (m *ValidatorSetPage) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetPage) ProtoMessage()    {}
+func (*ValidatorSetPage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f45b70bba0a4b33, []int{1}
+}
+func (m *ValidatorSetPage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetPage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetPage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetPage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetPage.Merge(m, src)
+}
+func (m *ValidatorSetPage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetPage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetPage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetPage proto.InternalMessageInfo
+
+func (m *ValidatorSetPage) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorSetPage) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ValidatorSetPage) GetValidators() []*ArchivedValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *ValidatorSetPage) GetNextIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.NextIndex
+	}
+	return 0
+}
+
+type ArchivedValidator struct {
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	Validator            *v1alpha1.Validator                                `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Status               v1alpha1.ValidatorStatus                           `protobuf:"varint,3,opt,name=status,proto3,enum=ethereum.eth.v1alpha1.ValidatorStatus" json:"status,omitempty"`
+	Balance              uint64                                             `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ArchivedValidator) Reset()         { *m = ArchivedValidator{} }
+func (m *ArchivedValidator) String() string { return proto.CompactTextString(m) }
+func (*ArchivedValidator) ProtoMessage()    {}
+func (*ArchivedValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f45b70bba0a4b33, []int{2}
+}
+func (m *ArchivedValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedValidator.Merge(m, src)
+}
+func (m *ArchivedValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedValidator proto.InternalMessageInfo
+
+func (m *ArchivedValidator) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ArchivedValidator) GetValidator() *v1alpha1.Validator {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *ArchivedValidator) GetStatus() v1alpha1.ValidatorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return v1alpha1.ValidatorStatus_UNKNOWN_STATUS
+}
+
+func (m *ArchivedValidator) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type CommitteeSnapshotRequest struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *CommitteeSnapshotRequest) Reset()         { *m = CommitteeSnapshotRequest{} }
+func (m *CommitteeSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitteeSnapshotRequest) ProtoMessage()    {}
+func (*CommitteeSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f45b70bba0a4b33, []int{3}
+}
+func (m *CommitteeSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeSnapshotRequest.Merge(m, src)
+}
+func (m *CommitteeSnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeSnapshotRequest proto.InternalMessageInfo
+
+func (m *CommitteeSnapshotRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type CommitteeSnapshot struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	StateSlot            github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,2,opt,name=state_slot,json=stateSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"state_slot,omitempty"`
+	StateRoot            []byte                                    `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty" ssz-size:"32"`
+	Seed                 []byte                                    `protobuf:"bytes,4,opt,name=seed,proto3" json:"seed,omitempty" ssz-size:"32"`
+	RandaoMix            []byte                                    `protobuf:"bytes,5,opt,name=randao_mix,json=randaoMix,proto3" json:"randao_mix,omitempty" ssz-size:"32"`
+	RandaoMixIndex       uint64                                    `protobuf:"varint,6,opt,name=randao_mix_index,json=randaoMixIndex,proto3" json:"randao_mix_index,omitempty"`
+	GeneralizedIndex     uint64                                    `protobuf:"varint,7,opt,name=generalized_index,json=generalizedIndex,proto3" json:"generalized_index,omitempty"`
+	Proof                [][]byte                                  `protobuf:"bytes,8,rep,name=proof,proto3" json:"proof,omitempty"`
+	ActiveValidatorCount uint64                                    `protobuf:"varint,9,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	Committees           []*EpochCommittee                         `protobuf:"bytes,10,rep,name=committees,proto3" json:"committees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *CommitteeSnapshot) Reset()         { *m = CommitteeSnapshot{} }
+func (m *CommitteeSnapshot) String() string { return proto.CompactTextString(m) }
+func (*CommitteeSnapshot) ProtoMessage()    {}
+func (*CommitteeSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f45b70bba0a4b33, []int{4}
+}
+func (m *CommitteeSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeSnapshot.Merge(m, src)
+}
+func (m *CommitteeSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeSnapshot proto.InternalMessageInfo
+
+func (m *CommitteeSnapshot) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *CommitteeSnapshot) GetStateSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.StateSlot
+	}
+	return 0
+}
+
+func (m *CommitteeSnapshot) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *CommitteeSnapshot) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+func (m *CommitteeSnapshot) GetRandaoMix() []byte {
+	if m != nil {
+		return m.RandaoMix
+	}
+	return nil
+}
+
+func (m *CommitteeSnapshot) GetRandaoMixIndex() uint64 {
+	if m != nil {
+		return m.RandaoMixIndex
+	}
+	return 0
+}
+
+func (m *CommitteeSnapshot) GetGeneralizedIndex() uint64 {
+	if m != nil {
+		return m.GeneralizedIndex
+	}
+	return 0
+}
+
+func (m *CommitteeSnapshot) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *CommitteeSnapshot) GetActiveValidatorCount() uint64 {
+	if m != nil {
+		return m.ActiveValidatorCount
+	}
+	return 0
+}
+
+func (m *CommitteeSnapshot) GetCommittees() []*EpochCommittee {
+	if m != nil {
+		return m.Committees
+	}
+	return nil
+}
+
+type EpochCommittee struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot             `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	CommitteeIndex       github_com_prysmaticlabs_eth2_types.CommitteeIndex   `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.CommitteeIndex" json:"committee_index,omitempty"`
+	ValidatorIndices     []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,3,rep,packed,name=validator_indices,json=validatorIndices,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validator_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                             `json:"-"`
+	XXX_unrecognized     []byte                                               `json:"-"`
+	XXX_sizecache        int32                                                `json:"-"`
+}
+
+func (m *EpochCommittee) Reset()         { *m = EpochCommittee{} }
+func (m *EpochCommittee) String() string { return proto.CompactTextString(m) }
+func (*EpochCommittee) ProtoMessage()    {}
+func (*EpochCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f45b70bba0a4b33, []int{5}
+}
+func (m *EpochCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCommittee.Merge(m, src)
+}
+func (m *EpochCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCommittee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochCommittee proto.InternalMessageInfo
+
+func (m *EpochCommittee) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *EpochCommittee) GetCommitteeIndex() github_com_prysmaticlabs_eth2_types.CommitteeIndex {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *EpochCommittee) GetValidatorIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ValidatorSetRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorSetRequest")
+	proto.RegisterType((*ValidatorSetPage)(nil), "ethereum.beacon.rpc.v1.ValidatorSetPage")
+	proto.RegisterType((*ArchivedValidator)(nil), "ethereum.beacon.rpc.v1.ArchivedValidator")
+	proto.RegisterType((*CommitteeSnapshotRequest)(nil), "ethereum.beacon.rpc.v1.CommitteeSnapshotRequest")
+	proto.RegisterType((*CommitteeSnapshot)(nil), "ethereum.beacon.rpc.v1.CommitteeSnapshot")
+	proto.RegisterType((*EpochCommittee)(nil), "ethereum.beacon.rpc.v1.EpochCommittee")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/archive.proto", fileDescriptor_7f45b70bba0a4b33) }
+
+var fileDescriptor_7f45b70bba0a4b33 = []byte{
+	// 807 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x96, 0xd3, 0xa4, 0xdd, 0xbc, 0x96, 0xd2, 0x0c, 0xd5, 0xca, 0x2a, 0x4b, 0x13, 0x2c, 0xb1,
+	0x72, 0xb5, 0xc4, 0x6e, 0xb2, 0x88, 0x03, 0x87, 0x15, 0xa4, 0x02, 0xb4, 0x02, 0x24, 0xe4, 0x08,
+	0x38, 0x5a, 0x13, 0xe7, 0xad, 0x3d, 0x92, 0xe3, 0x31, 0x9e, 0x49, 0x94, 0xcd, 0x91, 0xbf, 0xc0,
+	0x01, 0x24, 0xc4, 0xaf, 0xe1, 0xc2, 0x71, 0x25, 0xee, 0x11, 0xaa, 0xf8, 0x05, 0x1c, 0xcb, 0x05,
+	0x79, 0xc6, 0xb1, 0x53, 0x75, 0xa3, 0xcd, 0x6e, 0x7b, 0x9b, 0x99, 0xf7, 0xbe, 0xef, 0xcd, 0xbc,
+	0xf7, 0xcd, 0x7b, 0xf0, 0x7e, 0x9a, 0x71, 0xc9, 0xdd, 0x11, 0xd2, 0x80, 0x27, 0x6e, 0x96, 0x06,
+	0xee, 0xac, 0xe7, 0xd2, 0x2c, 0x88, 0xd8, 0x0c, 0x1d, 0x65, 0x23, 0xf7, 0x51, 0x46, 0x98, 0xe1,
+	0x74, 0xe2, 0x68, 0x2f, 0x27, 0x4b, 0x03, 0x67, 0xd6, 0x3b, 0x79, 0x80, 0x32, 0x72, 0x67, 0x3d,
+	0x1a, 0xa7, 0x11, 0xed, 0xb9, 0x33, 0x1a, 0xb3, 0x31, 0x95, 0x3c, 0xd3, 0xa8, 0x93, 0x07, 0x21,
+	0xe7, 0x61, 0x8c, 0x2e, 0x4d, 0x99, 0x4b, 0x93, 0x84, 0x4b, 0x2a, 0x19, 0x4f, 0x44, 0x61, 0xed,
+	0x86, 0x4c, 0x46, 0xd3, 0x91, 0x13, 0xf0, 0x89, 0x1b, 0xf2, 0x90, 0xbb, 0xea, 0x78, 0x34, 0x7d,
+	0xa6, 0x76, 0xfa, 0x4e, 0xf9, 0x4a, 0xbb, 0x5b, 0x2f, 0x0c, 0x78, 0xe7, 0xfb, 0x55, 0x80, 0x21,
+	0x4a, 0x0f, 0x7f, 0x9c, 0xa2, 0x90, 0xe4, 0x02, 0x1a, 0x98, 0xf2, 0x20, 0x32, 0x8d, 0x8e, 0x61,
+	0xd7, 0x07, 0xdd, 0xab, 0x65, 0xfb, 0x6c, 0x8d, 0x39, 0xcd, 0x9e, 0x8b, 0x09, 0x95, 0x2c, 0x88,
+	0xe9, 0x48, 0xb8, 0x28, 0xa3, 0x7e, 0x57, 0x3e, 0x4f, 0x51, 0x38, 0x9f, 0xe7, 0x20, 0x4f, 0x63,
+	0xc9, 0xbb, 0xd0, 0x4c, 0x69, 0x88, 0xbe, 0x60, 0x0b, 0x34, 0x6b, 0x1d, 0xc3, 0x6e, 0x78, 0xf7,
+	0xf2, 0x83, 0x21, 0x5b, 0x20, 0xf9, 0x01, 0xf6, 0x85, 0xa4, 0x99, 0xf4, 0x59, 0x32, 0xc6, 0xb9,
+	0xb9, 0xa3, 0xe2, 0x7c, 0x7c, 0xb5, 0x6c, 0xf7, 0xb7, 0x89, 0x53, 0xde, 0xf9, 0x69, 0x8e, 0xf6,
+	0x40, 0x51, 0xa9, 0xb5, 0xf5, 0x6b, 0x0d, 0x8e, 0xd6, 0x9f, 0xf4, 0x2d, 0x0d, 0xf1, 0x6e, 0xde,
+	0xf3, 0x1e, 0x80, 0xe4, 0x92, 0xc6, 0xd5, 0x83, 0xea, 0x5e, 0x53, 0x9d, 0xa8, 0x17, 0x3d, 0x05,
+	0x28, 0x6b, 0x25, 0xcc, 0x9d, 0xce, 0x8e, 0xbd, 0xdf, 0x3f, 0x73, 0x5e, 0x5e, 0x63, 0xe7, 0x33,
+	0xad, 0x84, 0x71, 0x79, 0x53, 0x6f, 0x0d, 0x4c, 0xbe, 0x03, 0x48, 0x70, 0xbe, 0xca, 0x4d, 0xfd,
+	0x56, 0xb9, 0x69, 0xe6, 0x4c, 0x3a, 0x35, 0xff, 0x19, 0xd0, 0xba, 0x11, 0x98, 0x7c, 0x0d, 0x0d,
+	0x1d, 0xc7, 0xb8, 0x55, 0x1c, 0x4d, 0x42, 0x9e, 0x40, 0xb3, 0x7c, 0x88, 0xca, 0xd1, 0x7e, 0xbf,
+	0x53, 0x25, 0x01, 0x65, 0xe4, 0xac, 0x94, 0x5d, 0x11, 0x78, 0x15, 0x84, 0x3c, 0x81, 0x5d, 0x21,
+	0xa9, 0x9c, 0x0a, 0x25, 0x89, 0xc3, 0xfe, 0xc3, 0x57, 0x81, 0x87, 0xca, 0xdb, 0x2b, 0x50, 0xc4,
+	0x84, 0xbd, 0x11, 0x8d, 0x69, 0x12, 0xa0, 0xce, 0x9b, 0xb7, 0xda, 0x5a, 0x3e, 0x98, 0x17, 0x7c,
+	0x32, 0x61, 0x52, 0x22, 0x0e, 0x13, 0x9a, 0x8a, 0x88, 0xdf, 0xa9, 0xde, 0xad, 0xdf, 0xea, 0xd0,
+	0xba, 0x11, 0xe1, 0x6e, 0xa4, 0xf7, 0x15, 0xe4, 0x12, 0x97, 0xe8, 0x8b, 0x98, 0x4b, 0x2d, 0xbd,
+	0xc1, 0x87, 0x57, 0xcb, 0xb6, 0xbd, 0x0d, 0xd3, 0x30, 0xe6, 0xd2, 0x6b, 0x2a, 0x7c, 0xbe, 0x24,
+	0xe7, 0x2b, 0xb2, 0x8c, 0x73, 0xa9, 0xd2, 0x7c, 0x30, 0x68, 0xfd, 0xbb, 0x6c, 0xbf, 0x25, 0xc4,
+	0xa2, 0x9b, 0x6b, 0xfb, 0x13, 0xeb, 0x71, 0xdf, 0x2a, 0x10, 0x1e, 0xe7, 0x92, 0x7c, 0x00, 0x75,
+	0x81, 0x38, 0x36, 0xeb, 0x9b, 0x7c, 0x95, 0x39, 0x27, 0xce, 0x68, 0x32, 0xa6, 0xdc, 0x9f, 0xb0,
+	0xb9, 0xd9, 0xd8, 0x48, 0xac, 0x9d, 0xbe, 0x61, 0x73, 0x62, 0xc3, 0x51, 0x85, 0x28, 0xe4, 0xbe,
+	0xab, 0xca, 0x76, 0x58, 0x3a, 0x29, 0x79, 0x91, 0x47, 0xd0, 0x0a, 0x31, 0xc1, 0x8c, 0xc6, 0x6c,
+	0x81, 0xe3, 0xc2, 0x75, 0x4f, 0xb9, 0x1e, 0xad, 0x19, 0xb4, 0xf3, 0x31, 0x34, 0xd2, 0x8c, 0xf3,
+	0x67, 0xe6, 0xbd, 0xce, 0x8e, 0x7d, 0xe0, 0xe9, 0x0d, 0xf9, 0x08, 0xee, 0xd3, 0x40, 0xb2, 0x19,
+	0xfa, 0xa5, 0xdc, 0xfc, 0x80, 0x4f, 0x13, 0x69, 0x36, 0x15, 0xcf, 0xb1, 0xb6, 0x96, 0xca, 0xba,
+	0xc8, 0x6d, 0xe4, 0x0b, 0x80, 0x60, 0x55, 0x54, 0x61, 0x82, 0xfa, 0xd6, 0x0f, 0x37, 0x7d, 0x6b,
+	0x55, 0xb4, 0x52, 0x03, 0xde, 0x1a, 0x32, 0xef, 0x4b, 0x87, 0xd7, 0xcd, 0xe4, 0x53, 0xa8, 0xab,
+	0x7a, 0x1a, 0x6f, 0x50, 0x4f, 0x85, 0x24, 0x3e, 0xbc, 0x5d, 0x86, 0x28, 0x72, 0x52, 0x7b, 0xbd,
+	0x5f, 0x5c, 0xde, 0x46, 0xff, 0xe2, 0xc3, 0xe0, 0xda, 0x9e, 0x04, 0xd0, 0xaa, 0x92, 0xc5, 0x92,
+	0x31, 0x0b, 0x50, 0xf7, 0xb6, 0x37, 0x6f, 0x14, 0x47, 0xb3, 0xb5, 0x7d, 0xce, 0xd7, 0xff, 0xa3,
+	0x06, 0x7b, 0x45, 0x5f, 0x22, 0xbf, 0x18, 0x40, 0x86, 0x32, 0x43, 0x3a, 0x59, 0x6f, 0xe2, 0xe4,
+	0xd1, 0xa6, 0x8c, 0xbf, 0x64, 0x7a, 0x9d, 0xd8, 0xdb, 0x38, 0xe7, 0x73, 0xc1, 0xb2, 0x7f, 0xfa,
+	0xeb, 0x9f, 0x9f, 0x6b, 0x16, 0xe9, 0xb8, 0xd7, 0x66, 0x6e, 0x31, 0xa7, 0xab, 0xd9, 0x2b, 0xce,
+	0x0d, 0xf2, 0xbb, 0x01, 0xc7, 0x5f, 0xa2, 0xbc, 0xf9, 0xc3, 0xcf, 0x37, 0x85, 0xdb, 0xd4, 0x6e,
+	0x4e, 0xce, 0xb6, 0x46, 0xbc, 0xea, 0x86, 0x95, 0xc0, 0x06, 0x07, 0x7f, 0x5e, 0x9e, 0x1a, 0x2f,
+	0x2e, 0x4f, 0x8d, 0xbf, 0x2f, 0x4f, 0x8d, 0xd1, 0xae, 0x1a, 0xf0, 0x8f, 0xff, 0x1f, 0x00, 0x6c,
+	0xe6, 0x97, 0xdf, 0x88, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ArchiveClient is the client API for Archive service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ArchiveClient interface {
+	StreamValidatorSet(ctx context.Context, in *ValidatorSetRequest, opts ...grpc.CallOption) (Archive_StreamValidatorSetClient, error)
+	GetCommitteeSnapshot(ctx context.Context, in *CommitteeSnapshotRequest, opts ...grpc.CallOption) (*CommitteeSnapshot, error)
+}
+
+type archiveClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewArchiveClient(cc *grpc.ClientConn) ArchiveClient {
+	return &archiveClient{cc}
+}
+
+func (c *archiveClient) StreamValidatorSet(ctx context.Context, in *ValidatorSetRequest, opts ...grpc.CallOption) (Archive_StreamValidatorSetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Archive_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.Archive/StreamValidatorSet", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &archiveStreamValidatorSetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Archive_StreamValidatorSetClient interface {
+	Recv() (*ValidatorSetPage, error)
+	grpc.ClientStream
+}
+
+type archiveStreamValidatorSetClient struct {
+	grpc.ClientStream
+}
+
+func (x *archiveStreamValidatorSetClient) Recv() (*ValidatorSetPage, error) {
+	m := new(ValidatorSetPage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *archiveClient) GetCommitteeSnapshot(ctx context.Context, in *CommitteeSnapshotRequest, opts ...grpc.CallOption) (*CommitteeSnapshot, error) {
+	out := new(CommitteeSnapshot)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Archive/GetCommitteeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ArchiveServer is the server API for Archive service.
+type ArchiveServer interface {
+	StreamValidatorSet(*ValidatorSetRequest, Archive_StreamValidatorSetServer) error
+	GetCommitteeSnapshot(context.Context, *CommitteeSnapshotRequest) (*CommitteeSnapshot, error)
+}
+
+// UnimplementedArchiveServer can be embedded to have forward compatible implementations.
+type UnimplementedArchiveServer struct {
+}
+
+func (*UnimplementedArchiveServer) StreamValidatorSet(req *ValidatorSetRequest, srv Archive_StreamValidatorSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamValidatorSet not implemented")
+}
+func (*UnimplementedArchiveServer) GetCommitteeSnapshot(ctx context.Context, req *CommitteeSnapshotRequest) (*CommitteeSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitteeSnapshot not implemented")
+}
+
+func RegisterArchiveServer(s *grpc.Server, srv ArchiveServer) {
+	s.RegisterService(&_Archive_serviceDesc, srv)
+}
+
+func _Archive_StreamValidatorSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidatorSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArchiveServer).StreamValidatorSet(m, &archiveStreamValidatorSetServer{stream})
+}
+
+type Archive_StreamValidatorSetServer interface {
+	Send(*ValidatorSetPage) error
+	grpc.ServerStream
+}
+
+type archiveStreamValidatorSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *archiveStreamValidatorSetServer) Send(m *ValidatorSetPage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Archive_GetCommitteeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitteeSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArchiveServer).GetCommitteeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Archive/GetCommitteeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArchiveServer).GetCommitteeSnapshot(ctx, req.(*CommitteeSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Archive_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Archive",
+	HandlerType: (*ArchiveServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCommitteeSnapshot",
+			Handler:    _Archive_GetCommitteeSnapshot_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamValidatorSet",
+			Handler:       _Archive_StreamValidatorSet_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/archive.proto",
+}
+
+func (m *ValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.StartIndex != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.StartIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetPage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetPage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetPage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NextIndex != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.NextIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Balance != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Validator != nil {
+		{
+			size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintArchive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Committees) > 0 {
+		for iNdEx := len(m.Committees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Committees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArchive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ActiveValidatorCount != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.ActiveValidatorCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintArchive(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.GeneralizedIndex != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.GeneralizedIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RandaoMixIndex != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.RandaoMixIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RandaoMix) > 0 {
+		i -= len(m.RandaoMix)
+		copy(dAtA[i:], m.RandaoMix)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.RandaoMix)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintArchive(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StateSlot != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.StateSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidatorIndices) > 0 {
+		dAtA3 := make([]byte, len(m.ValidatorIndices)*10)
+		var j2 int
+		for _, num := range m.ValidatorIndices {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintArchive(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintArchive(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintArchive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovArchive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovArchive(uint64(m.Epoch))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovArchive(uint64(m.PageSize))
+	}
+	if m.StartIndex != 0 {
+		n += 1 + sovArchive(uint64(m.StartIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorSetPage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovArchive(uint64(m.Epoch))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovArchive(uint64(m.TotalSize))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovArchive(uint64(l))
+		}
+	}
+	if m.NextIndex != 0 {
+		n += 1 + sovArchive(uint64(m.NextIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovArchive(uint64(m.Index))
+	}
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovArchive(uint64(m.Status))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovArchive(uint64(m.Balance))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteeSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovArchive(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteeSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovArchive(uint64(m.Epoch))
+	}
+	if m.StateSlot != 0 {
+		n += 1 + sovArchive(uint64(m.StateSlot))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	l = len(m.RandaoMix)
+	if l > 0 {
+		n += 1 + l + sovArchive(uint64(l))
+	}
+	if m.RandaoMixIndex != 0 {
+		n += 1 + sovArchive(uint64(m.RandaoMixIndex))
+	}
+	if m.GeneralizedIndex != 0 {
+		n += 1 + sovArchive(uint64(m.GeneralizedIndex))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovArchive(uint64(l))
+		}
+	}
+	if m.ActiveValidatorCount != 0 {
+		n += 1 + sovArchive(uint64(m.ActiveValidatorCount))
+	}
+	if len(m.Committees) > 0 {
+		for _, e := range m.Committees {
+			l = e.Size()
+			n += 1 + l + sovArchive(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovArchive(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovArchive(uint64(m.CommitteeIndex))
+	}
+	if len(m.ValidatorIndices) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIndices {
+			l += sovArchive(uint64(e))
+		}
+		n += 1 + sovArchive(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovArchive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozArchive(x uint64) (n int) {
+	return sovArchive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartIndex", wireType)
+			}
+			m.StartIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetPage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetPage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetPage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &ArchivedValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIndex", wireType)
+			}
+			m.NextIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Validator == nil {
+				m.Validator = &v1alpha1.Validator{}
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= v1alpha1.ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitteeSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitteeSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateSlot", wireType)
+			}
+			m.StateSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoMix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandaoMix = append(m.RandaoMix[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoMix == nil {
+				m.RandaoMix = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoMixIndex", wireType)
+			}
+			m.RandaoMixIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RandaoMixIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralizedIndex", wireType)
+			}
+			m.GeneralizedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GeneralizedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveValidatorCount", wireType)
+			}
+			m.ActiveValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveValidatorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Committees = append(m.Committees, &EpochCommittee{})
+			if err := m.Committees[len(m.Committees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochCommittee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochCommittee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= github_com_prysmaticlabs_eth2_types.CommitteeIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowArchive
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthArchive
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthArchive
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowArchive
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipArchive(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowArchive
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchive
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthArchive
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupArchive
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthArchive
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthArchive        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowArchive          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupArchive = fmt.Errorf("proto: unexpected end of group")
+)
@@ -34,6 +34,31 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type ArrivalEvent_Kind int32
+
+const (
+	ArrivalEvent_BLOCK     ArrivalEvent_Kind = 0
+	ArrivalEvent_AGGREGATE ArrivalEvent_Kind = 1
+)
+
+var ArrivalEvent_Kind_name = map[int32]string{
+	0: "BLOCK",
+	1: "AGGREGATE",
+}
+
+var ArrivalEvent_Kind_value = map[string]int32{
+	"BLOCK":     0,
+	"AGGREGATE": 1,
+}
+
+func (x ArrivalEvent_Kind) String() string {
+	return proto.EnumName(ArrivalEvent_Kind_name, int32(x))
+}
+
+func (ArrivalEvent_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{20, 0}
+}
+
 type LoggingLevelRequest_Level int32
 
 const (
@@ -59,133 +84,32 @@ func (x LoggingLevelRequest_Level) String() string {
 }
 
 func (LoggingLevelRequest_Level) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{5, 0}
-}
-
-type InclusionSlotRequest struct {
-	Id                   uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
-	XXX_unrecognized     []byte                                   `json:"-"`
-	XXX_sizecache        int32                                    `json:"-"`
-}
-
-func (m *InclusionSlotRequest) Reset()         { *m = InclusionSlotRequest{} }
-func (m *InclusionSlotRequest) String() string { return proto.CompactTextString(m) }
-func (*InclusionSlotRequest) ProtoMessage()    {}
-func (*InclusionSlotRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{0}
-}
-func (m *InclusionSlotRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *InclusionSlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InclusionSlotRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *InclusionSlotRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InclusionSlotRequest.Merge(m, src)
-}
-func (m *InclusionSlotRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *InclusionSlotRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InclusionSlotRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_InclusionSlotRequest proto.InternalMessageInfo
-
-func (m *InclusionSlotRequest) GetId() uint64 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
-}
-
-func (m *InclusionSlotRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
-	if m != nil {
-		return m.Slot
-	}
-	return 0
-}
-
-type InclusionSlotResponse struct {
-	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
-	XXX_unrecognized     []byte                                   `json:"-"`
-	XXX_sizecache        int32                                    `json:"-"`
-}
-
-func (m *InclusionSlotResponse) Reset()         { *m = InclusionSlotResponse{} }
-func (m *InclusionSlotResponse) String() string { return proto.CompactTextString(m) }
-func (*InclusionSlotResponse) ProtoMessage()    {}
-func (*InclusionSlotResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{1}
-}
-func (m *InclusionSlotResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *InclusionSlotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InclusionSlotResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *InclusionSlotResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InclusionSlotResponse.Merge(m, src)
-}
-func (m *InclusionSlotResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *InclusionSlotResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_InclusionSlotResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_InclusionSlotResponse proto.InternalMessageInfo
-
-func (m *InclusionSlotResponse) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
-	if m != nil {
-		return m.Slot
-	}
-	return 0
+	return fileDescriptor_851e5cb2de3d61dd, []int{35, 0}
 }
 
-type BeaconStateRequest struct {
+type SSZDownloadRequest struct {
 	// Types that are valid to be assigned to QueryFilter:
-	//	*BeaconStateRequest_Slot
-	//	*BeaconStateRequest_BlockRoot
-	QueryFilter          isBeaconStateRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	//	*SSZDownloadRequest_Slot
+	//	*SSZDownloadRequest_BlockRoot
+	QueryFilter          isSSZDownloadRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	Snappy               bool                             `protobuf:"varint,3,opt,name=snappy,proto3" json:"snappy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
 	XXX_unrecognized     []byte                           `json:"-"`
 	XXX_sizecache        int32                            `json:"-"`
 }
 
-func (m *BeaconStateRequest) Reset()         { *m = BeaconStateRequest{} }
-func (m *BeaconStateRequest) String() string { return proto.CompactTextString(m) }
-func (*BeaconStateRequest) ProtoMessage()    {}
-func (*BeaconStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{2}
+func (m *SSZDownloadRequest) Reset()         { *m = SSZDownloadRequest{} }
+func (m *SSZDownloadRequest) String() string { return proto.CompactTextString(m) }
+func (*SSZDownloadRequest) ProtoMessage()    {}
+func (*SSZDownloadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{0}
 }
-func (m *BeaconStateRequest) XXX_Unmarshal(b []byte) error {
+func (m *SSZDownloadRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BeaconStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SSZDownloadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BeaconStateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SSZDownloadRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -195,82 +119,91 @@ func (m *BeaconStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *BeaconStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BeaconStateRequest.Merge(m, src)
+func (m *SSZDownloadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSZDownloadRequest.Merge(m, src)
 }
-func (m *BeaconStateRequest) XXX_Size() int {
+func (m *SSZDownloadRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BeaconStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BeaconStateRequest.DiscardUnknown(m)
+func (m *SSZDownloadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSZDownloadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BeaconStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_SSZDownloadRequest proto.InternalMessageInfo
 
-type isBeaconStateRequest_QueryFilter interface {
-	isBeaconStateRequest_QueryFilter()
+type isSSZDownloadRequest_QueryFilter interface {
+	isSSZDownloadRequest_QueryFilter()
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
 
-type BeaconStateRequest_Slot struct {
+type SSZDownloadRequest_Slot struct {
 	Slot github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,oneof,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
 }
-type BeaconStateRequest_BlockRoot struct {
+type SSZDownloadRequest_BlockRoot struct {
 	BlockRoot []byte `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3,oneof" json:"block_root,omitempty"`
 }
 
-func (*BeaconStateRequest_Slot) isBeaconStateRequest_QueryFilter()      {}
-func (*BeaconStateRequest_BlockRoot) isBeaconStateRequest_QueryFilter() {}
+func (*SSZDownloadRequest_Slot) isSSZDownloadRequest_QueryFilter()      {}
+func (*SSZDownloadRequest_BlockRoot) isSSZDownloadRequest_QueryFilter() {}
 
-func (m *BeaconStateRequest) GetQueryFilter() isBeaconStateRequest_QueryFilter {
+func (m *SSZDownloadRequest) GetQueryFilter() isSSZDownloadRequest_QueryFilter {
 	if m != nil {
 		return m.QueryFilter
 	}
 	return nil
 }
 
-func (m *BeaconStateRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
-	if x, ok := m.GetQueryFilter().(*BeaconStateRequest_Slot); ok {
+func (m *SSZDownloadRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x, ok := m.GetQueryFilter().(*SSZDownloadRequest_Slot); ok {
 		return x.Slot
 	}
 	return 0
 }
 
-func (m *BeaconStateRequest) GetBlockRoot() []byte {
-	if x, ok := m.GetQueryFilter().(*BeaconStateRequest_BlockRoot); ok {
+func (m *SSZDownloadRequest) GetBlockRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*SSZDownloadRequest_BlockRoot); ok {
 		return x.BlockRoot
 	}
 	return nil
 }
 
+func (m *SSZDownloadRequest) GetSnappy() bool {
+	if m != nil {
+		return m.Snappy
+	}
+	return false
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
-func (*BeaconStateRequest) XXX_OneofWrappers() []interface{} {
+func (*SSZDownloadRequest) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
-		(*BeaconStateRequest_Slot)(nil),
-		(*BeaconStateRequest_BlockRoot)(nil),
+		(*SSZDownloadRequest_Slot)(nil),
+		(*SSZDownloadRequest_BlockRoot)(nil),
 	}
 }
 
-type BlockRequest struct {
-	BlockRoot            []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+type SSZChunk struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Offset               uint64   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	TotalSize            uint64   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
-func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
-func (*BlockRequest) ProtoMessage()    {}
-func (*BlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{3}
+func (m *SSZChunk) Reset()         { *m = SSZChunk{} }
+func (m *SSZChunk) String() string { return proto.CompactTextString(m) }
+func (*SSZChunk) ProtoMessage()    {}
+func (*SSZChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{1}
 }
-func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
+func (m *SSZChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SSZChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BlockRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SSZChunk.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -280,44 +213,59 @@ func (m *BlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *BlockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BlockRequest.Merge(m, src)
+func (m *SSZChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSZChunk.Merge(m, src)
 }
-func (m *BlockRequest) XXX_Size() int {
+func (m *SSZChunk) XXX_Size() int {
 	return m.Size()
 }
-func (m *BlockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BlockRequest.DiscardUnknown(m)
+func (m *SSZChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSZChunk.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BlockRequest proto.InternalMessageInfo
+var xxx_messageInfo_SSZChunk proto.InternalMessageInfo
 
-func (m *BlockRequest) GetBlockRoot() []byte {
+func (m *SSZChunk) GetData() []byte {
 	if m != nil {
-		return m.BlockRoot
+		return m.Data
 	}
 	return nil
 }
 
-type SSZResponse struct {
-	Encoded              []byte   `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SSZChunk) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
 
-func (m *SSZResponse) Reset()         { *m = SSZResponse{} }
-func (m *SSZResponse) String() string { return proto.CompactTextString(m) }
-func (*SSZResponse) ProtoMessage()    {}
-func (*SSZResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{4}
+func (m *SSZChunk) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
 }
-func (m *SSZResponse) XXX_Unmarshal(b []byte) error {
+
+type ValidatorEpochRewardsRequest struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch            `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Validators           []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,rep,packed,name=validators,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                             `json:"-"`
+	XXX_unrecognized     []byte                                               `json:"-"`
+	XXX_sizecache        int32                                                `json:"-"`
+}
+
+func (m *ValidatorEpochRewardsRequest) Reset()         { *m = ValidatorEpochRewardsRequest{} }
+func (m *ValidatorEpochRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochRewardsRequest) ProtoMessage()    {}
+func (*ValidatorEpochRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{2}
+}
+func (m *ValidatorEpochRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SSZResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorEpochRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SSZResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorEpochRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -327,44 +275,52 @@ func (m *SSZResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SSZResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SSZResponse.Merge(m, src)
+func (m *ValidatorEpochRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochRewardsRequest.Merge(m, src)
 }
-func (m *SSZResponse) XXX_Size() int {
+func (m *ValidatorEpochRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SSZResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SSZResponse.DiscardUnknown(m)
+func (m *ValidatorEpochRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SSZResponse proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorEpochRewardsRequest proto.InternalMessageInfo
 
-func (m *SSZResponse) GetEncoded() []byte {
+func (m *ValidatorEpochRewardsRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
 	if m != nil {
-		return m.Encoded
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewardsRequest) GetValidators() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Validators
 	}
 	return nil
 }
 
-type LoggingLevelRequest struct {
-	Level                LoggingLevelRequest_Level `protobuf:"varint,1,opt,name=level,proto3,enum=ethereum.beacon.rpc.v1.LoggingLevelRequest_Level" json:"level,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+type ValidatorEpochRewardsResponse struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Rewards              []*ValidatorEpochRewards                  `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
 
-func (m *LoggingLevelRequest) Reset()         { *m = LoggingLevelRequest{} }
-func (m *LoggingLevelRequest) String() string { return proto.CompactTextString(m) }
-func (*LoggingLevelRequest) ProtoMessage()    {}
-func (*LoggingLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{5}
+func (m *ValidatorEpochRewardsResponse) Reset()         { *m = ValidatorEpochRewardsResponse{} }
+func (m *ValidatorEpochRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochRewardsResponse) ProtoMessage()    {}
+func (*ValidatorEpochRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{3}
 }
-func (m *LoggingLevelRequest) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorEpochRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LoggingLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorEpochRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LoggingLevelRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorEpochRewardsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -374,48 +330,60 @@ func (m *LoggingLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *LoggingLevelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoggingLevelRequest.Merge(m, src)
+func (m *ValidatorEpochRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochRewardsResponse.Merge(m, src)
 }
-func (m *LoggingLevelRequest) XXX_Size() int {
+func (m *ValidatorEpochRewardsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *LoggingLevelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoggingLevelRequest.DiscardUnknown(m)
+func (m *ValidatorEpochRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochRewardsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoggingLevelRequest proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorEpochRewardsResponse proto.InternalMessageInfo
 
-func (m *LoggingLevelRequest) GetLevel() LoggingLevelRequest_Level {
+func (m *ValidatorEpochRewardsResponse) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
 	if m != nil {
-		return m.Level
+		return m.Epoch
 	}
-	return LoggingLevelRequest_INFO
+	return 0
 }
 
-type ProtoArrayForkChoiceResponse struct {
-	PruneThreshold       uint64                                    `protobuf:"varint,1,opt,name=prune_threshold,json=pruneThreshold,proto3" json:"prune_threshold,omitempty"`
-	JustifiedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=justified_epoch,json=justifiedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"justified_epoch,omitempty"`
-	FinalizedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,3,opt,name=finalized_epoch,json=finalizedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"finalized_epoch,omitempty"`
-	ProtoArrayNodes      []*ProtoArrayNode                         `protobuf:"bytes,4,rep,name=proto_array_nodes,json=protoArrayNodes,proto3" json:"proto_array_nodes,omitempty"`
-	Indices              map[string]uint64                         `protobuf:"bytes,5,rep,name=indices,proto3" json:"indices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
-	XXX_unrecognized     []byte                                    `json:"-"`
-	XXX_sizecache        int32                                     `json:"-"`
+func (m *ValidatorEpochRewardsResponse) GetRewards() []*ValidatorEpochRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
 }
 
-func (m *ProtoArrayForkChoiceResponse) Reset()         { *m = ProtoArrayForkChoiceResponse{} }
-func (m *ProtoArrayForkChoiceResponse) String() string { return proto.CompactTextString(m) }
-func (*ProtoArrayForkChoiceResponse) ProtoMessage()    {}
-func (*ProtoArrayForkChoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{6}
+type ValidatorEpochRewards struct {
+	ValidatorIndex       github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validator_index,omitempty"`
+	SourceReward         uint64                                             `protobuf:"varint,2,opt,name=source_reward,json=sourceReward,proto3" json:"source_reward,omitempty"`
+	TargetReward         uint64                                             `protobuf:"varint,3,opt,name=target_reward,json=targetReward,proto3" json:"target_reward,omitempty"`
+	HeadReward           uint64                                             `protobuf:"varint,4,opt,name=head_reward,json=headReward,proto3" json:"head_reward,omitempty"`
+	InclusionDelayReward uint64                                             `protobuf:"varint,5,opt,name=inclusion_delay_reward,json=inclusionDelayReward,proto3" json:"inclusion_delay_reward,omitempty"`
+	ProposerReward       uint64                                             `protobuf:"varint,6,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	SourcePenalty        uint64                                             `protobuf:"varint,7,opt,name=source_penalty,json=sourcePenalty,proto3" json:"source_penalty,omitempty"`
+	TargetPenalty        uint64                                             `protobuf:"varint,8,opt,name=target_penalty,json=targetPenalty,proto3" json:"target_penalty,omitempty"`
+	HeadPenalty          uint64                                             `protobuf:"varint,9,opt,name=head_penalty,json=headPenalty,proto3" json:"head_penalty,omitempty"`
+	InactivityPenalty    uint64                                             `protobuf:"varint,10,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ValidatorEpochRewards) Reset()         { *m = ValidatorEpochRewards{} }
+func (m *ValidatorEpochRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEpochRewards) ProtoMessage()    {}
+func (*ValidatorEpochRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{4}
 }
-func (m *ProtoArrayForkChoiceResponse) XXX_Unmarshal(b []byte) error {
+func (m *ValidatorEpochRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProtoArrayForkChoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ValidatorEpochRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProtoArrayForkChoiceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ValidatorEpochRewards.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -425,79 +393,107 @@ func (m *ProtoArrayForkChoiceResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ProtoArrayForkChoiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProtoArrayForkChoiceResponse.Merge(m, src)
+func (m *ValidatorEpochRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEpochRewards.Merge(m, src)
 }
-func (m *ProtoArrayForkChoiceResponse) XXX_Size() int {
+func (m *ValidatorEpochRewards) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProtoArrayForkChoiceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProtoArrayForkChoiceResponse.DiscardUnknown(m)
+func (m *ValidatorEpochRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEpochRewards.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProtoArrayForkChoiceResponse proto.InternalMessageInfo
+var xxx_messageInfo_ValidatorEpochRewards proto.InternalMessageInfo
 
-func (m *ProtoArrayForkChoiceResponse) GetPruneThreshold() uint64 {
+func (m *ValidatorEpochRewards) GetValidatorIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
 	if m != nil {
-		return m.PruneThreshold
+		return m.ValidatorIndex
 	}
 	return 0
 }
 
-func (m *ProtoArrayForkChoiceResponse) GetJustifiedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+func (m *ValidatorEpochRewards) GetSourceReward() uint64 {
 	if m != nil {
-		return m.JustifiedEpoch
+		return m.SourceReward
 	}
 	return 0
 }
 
-func (m *ProtoArrayForkChoiceResponse) GetFinalizedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+func (m *ValidatorEpochRewards) GetTargetReward() uint64 {
 	if m != nil {
-		return m.FinalizedEpoch
+		return m.TargetReward
 	}
 	return 0
 }
 
-func (m *ProtoArrayForkChoiceResponse) GetProtoArrayNodes() []*ProtoArrayNode {
+func (m *ValidatorEpochRewards) GetHeadReward() uint64 {
 	if m != nil {
-		return m.ProtoArrayNodes
+		return m.HeadReward
 	}
-	return nil
+	return 0
 }
 
-func (m *ProtoArrayForkChoiceResponse) GetIndices() map[string]uint64 {
+func (m *ValidatorEpochRewards) GetInclusionDelayReward() uint64 {
 	if m != nil {
-		return m.Indices
+		return m.InclusionDelayReward
 	}
-	return nil
+	return 0
 }
 
-type ProtoArrayNode struct {
-	Slot                 github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
-	Root                 []byte                                    `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
-	Parent               uint64                                    `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
-	JustifiedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,4,opt,name=justified_epoch,json=justifiedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"justified_epoch,omitempty"`
-	FinalizedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,5,opt,name=finalized_epoch,json=finalizedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"finalized_epoch,omitempty"`
-	Weight               uint64                                    `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
-	BestChild            uint64                                    `protobuf:"varint,7,opt,name=best_child,json=bestChild,proto3" json:"best_child,omitempty"`
-	BestDescendant       uint64                                    `protobuf:"varint,8,opt,name=best_descendant,json=bestDescendant,proto3" json:"best_descendant,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
-	XXX_unrecognized     []byte                                    `json:"-"`
-	XXX_sizecache        int32                                     `json:"-"`
+func (m *ValidatorEpochRewards) GetProposerReward() uint64 {
+	if m != nil {
+		return m.ProposerReward
+	}
+	return 0
 }
 
-func (m *ProtoArrayNode) Reset()         { *m = ProtoArrayNode{} }
-func (m *ProtoArrayNode) String() string { return proto.CompactTextString(m) }
-func (*ProtoArrayNode) ProtoMessage()    {}
-func (*ProtoArrayNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{7}
+func (m *ValidatorEpochRewards) GetSourcePenalty() uint64 {
+	if m != nil {
+		return m.SourcePenalty
+	}
+	return 0
 }
-func (m *ProtoArrayNode) XXX_Unmarshal(b []byte) error {
+
+func (m *ValidatorEpochRewards) GetTargetPenalty() uint64 {
+	if m != nil {
+		return m.TargetPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetHeadPenalty() uint64 {
+	if m != nil {
+		return m.HeadPenalty
+	}
+	return 0
+}
+
+func (m *ValidatorEpochRewards) GetInactivityPenalty() uint64 {
+	if m != nil {
+		return m.InactivityPenalty
+	}
+	return 0
+}
+
+type FinalizedBlockRootRequest struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *FinalizedBlockRootRequest) Reset()         { *m = FinalizedBlockRootRequest{} }
+func (m *FinalizedBlockRootRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalizedBlockRootRequest) ProtoMessage()    {}
+func (*FinalizedBlockRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{5}
+}
+func (m *FinalizedBlockRootRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProtoArrayNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FinalizedBlockRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProtoArrayNode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FinalizedBlockRootRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -507,93 +503,132 @@ func (m *ProtoArrayNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ProtoArrayNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProtoArrayNode.Merge(m, src)
+func (m *FinalizedBlockRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizedBlockRootRequest.Merge(m, src)
 }
-func (m *ProtoArrayNode) XXX_Size() int {
+func (m *FinalizedBlockRootRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProtoArrayNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProtoArrayNode.DiscardUnknown(m)
+func (m *FinalizedBlockRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizedBlockRootRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProtoArrayNode proto.InternalMessageInfo
+var xxx_messageInfo_FinalizedBlockRootRequest proto.InternalMessageInfo
 
-func (m *ProtoArrayNode) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+func (m *FinalizedBlockRootRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
 	if m != nil {
 		return m.Slot
 	}
 	return 0
 }
 
-func (m *ProtoArrayNode) GetRoot() []byte {
-	if m != nil {
-		return m.Root
+type FinalizedBlockRootResponse struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	BlockRoot            []byte                                   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	StateRoot            []byte                                   `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	StateSlot            github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,4,opt,name=state_slot,json=stateSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"state_slot,omitempty"`
+	GeneralizedIndex     uint64                                   `protobuf:"varint,5,opt,name=generalized_index,json=generalizedIndex,proto3" json:"generalized_index,omitempty"`
+	Proof                [][]byte                                 `protobuf:"bytes,6,rep,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *FinalizedBlockRootResponse) Reset()         { *m = FinalizedBlockRootResponse{} }
+func (m *FinalizedBlockRootResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalizedBlockRootResponse) ProtoMessage()    {}
+func (*FinalizedBlockRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{6}
+}
+func (m *FinalizedBlockRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizedBlockRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizedBlockRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *FinalizedBlockRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizedBlockRootResponse.Merge(m, src)
+}
+func (m *FinalizedBlockRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizedBlockRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizedBlockRootResponse.DiscardUnknown(m)
 }
 
-func (m *ProtoArrayNode) GetParent() uint64 {
+var xxx_messageInfo_FinalizedBlockRootResponse proto.InternalMessageInfo
+
+func (m *FinalizedBlockRootResponse) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
 	if m != nil {
-		return m.Parent
+		return m.Slot
 	}
 	return 0
 }
 
-func (m *ProtoArrayNode) GetJustifiedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+func (m *FinalizedBlockRootResponse) GetBlockRoot() []byte {
 	if m != nil {
-		return m.JustifiedEpoch
+		return m.BlockRoot
 	}
-	return 0
+	return nil
 }
 
-func (m *ProtoArrayNode) GetFinalizedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+func (m *FinalizedBlockRootResponse) GetStateRoot() []byte {
 	if m != nil {
-		return m.FinalizedEpoch
+		return m.StateRoot
 	}
-	return 0
+	return nil
 }
 
-func (m *ProtoArrayNode) GetWeight() uint64 {
+func (m *FinalizedBlockRootResponse) GetStateSlot() github_com_prysmaticlabs_eth2_types.Slot {
 	if m != nil {
-		return m.Weight
+		return m.StateSlot
 	}
 	return 0
 }
 
-func (m *ProtoArrayNode) GetBestChild() uint64 {
+func (m *FinalizedBlockRootResponse) GetGeneralizedIndex() uint64 {
 	if m != nil {
-		return m.BestChild
+		return m.GeneralizedIndex
 	}
 	return 0
 }
 
-func (m *ProtoArrayNode) GetBestDescendant() uint64 {
+func (m *FinalizedBlockRootResponse) GetProof() [][]byte {
 	if m != nil {
-		return m.BestDescendant
+		return m.Proof
 	}
-	return 0
+	return nil
 }
 
-type DebugPeerResponses struct {
-	Responses            []*DebugPeerResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type ExportStateRequest struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	Snappy               bool                                     `protobuf:"varint,2,opt,name=snappy,proto3" json:"snappy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
 }
 
-func (m *DebugPeerResponses) Reset()         { *m = DebugPeerResponses{} }
-func (m *DebugPeerResponses) String() string { return proto.CompactTextString(m) }
-func (*DebugPeerResponses) ProtoMessage()    {}
-func (*DebugPeerResponses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{8}
+func (m *ExportStateRequest) Reset()         { *m = ExportStateRequest{} }
+func (m *ExportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportStateRequest) ProtoMessage()    {}
+func (*ExportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{7}
 }
-func (m *DebugPeerResponses) XXX_Unmarshal(b []byte) error {
+func (m *ExportStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DebugPeerResponses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DebugPeerResponses.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExportStateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -603,52 +638,52 @@ func (m *DebugPeerResponses) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DebugPeerResponses) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugPeerResponses.Merge(m, src)
+func (m *ExportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportStateRequest.Merge(m, src)
 }
-func (m *DebugPeerResponses) XXX_Size() int {
+func (m *ExportStateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DebugPeerResponses) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugPeerResponses.DiscardUnknown(m)
+func (m *ExportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DebugPeerResponses proto.InternalMessageInfo
+var xxx_messageInfo_ExportStateRequest proto.InternalMessageInfo
 
-func (m *DebugPeerResponses) GetResponses() []*DebugPeerResponse {
+func (m *ExportStateRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
 	if m != nil {
-		return m.Responses
+		return m.Slot
 	}
-	return nil
+	return 0
 }
 
-type DebugPeerResponse struct {
-	ListeningAddresses   []string                    `protobuf:"bytes,1,rep,name=listening_addresses,json=listeningAddresses,proto3" json:"listening_addresses,omitempty"`
-	Direction            v1alpha1.PeerDirection      `protobuf:"varint,2,opt,name=direction,proto3,enum=ethereum.eth.v1alpha1.PeerDirection" json:"direction,omitempty"`
-	ConnectionState      v1alpha1.ConnectionState    `protobuf:"varint,3,opt,name=connection_state,json=connectionState,proto3,enum=ethereum.eth.v1alpha1.ConnectionState" json:"connection_state,omitempty"`
-	PeerId               string                      `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
-	Enr                  string                      `protobuf:"bytes,5,opt,name=enr,proto3" json:"enr,omitempty"`
-	PeerInfo             *DebugPeerResponse_PeerInfo `protobuf:"bytes,6,opt,name=peer_info,json=peerInfo,proto3" json:"peer_info,omitempty"`
-	PeerStatus           *v1.Status                  `protobuf:"bytes,7,opt,name=peer_status,json=peerStatus,proto3" json:"peer_status,omitempty"`
-	LastUpdated          uint64                      `protobuf:"varint,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	ScoreInfo            *ScoreInfo                  `protobuf:"bytes,9,opt,name=score_info,json=scoreInfo,proto3" json:"score_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+func (m *ExportStateRequest) GetSnappy() bool {
+	if m != nil {
+		return m.Snappy
+	}
+	return false
 }
 
-func (m *DebugPeerResponse) Reset()         { *m = DebugPeerResponse{} }
-func (m *DebugPeerResponse) String() string { return proto.CompactTextString(m) }
-func (*DebugPeerResponse) ProtoMessage()    {}
-func (*DebugPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{9}
+type ExportStateResponse struct {
+	State                []byte   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Block                []byte   `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *DebugPeerResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *ExportStateResponse) Reset()         { *m = ExportStateResponse{} }
+func (m *ExportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportStateResponse) ProtoMessage()    {}
+func (*ExportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{8}
+}
+func (m *ExportStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DebugPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DebugPeerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExportStateResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -658,105 +693,114 @@ func (m *DebugPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DebugPeerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugPeerResponse.Merge(m, src)
+func (m *ExportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportStateResponse.Merge(m, src)
 }
-func (m *DebugPeerResponse) XXX_Size() int {
+func (m *ExportStateResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DebugPeerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugPeerResponse.DiscardUnknown(m)
+func (m *ExportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DebugPeerResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExportStateResponse proto.InternalMessageInfo
 
-func (m *DebugPeerResponse) GetListeningAddresses() []string {
+func (m *ExportStateResponse) GetState() []byte {
 	if m != nil {
-		return m.ListeningAddresses
+		return m.State
 	}
 	return nil
 }
 
-func (m *DebugPeerResponse) GetDirection() v1alpha1.PeerDirection {
+func (m *ExportStateResponse) GetBlock() []byte {
 	if m != nil {
-		return m.Direction
+		return m.Block
 	}
-	return v1alpha1.PeerDirection_UNKNOWN
+	return nil
 }
 
-func (m *DebugPeerResponse) GetConnectionState() v1alpha1.ConnectionState {
-	if m != nil {
-		return m.ConnectionState
-	}
-	return v1alpha1.ConnectionState_DISCONNECTED
+type PruneDatabaseResponse struct {
+	DeletedStates        uint64   `protobuf:"varint,1,opt,name=deleted_states,json=deletedStates,proto3" json:"deleted_states,omitempty"`
+	DeletedBlocks        uint64   `protobuf:"varint,2,opt,name=deleted_blocks,json=deletedBlocks,proto3" json:"deleted_blocks,omitempty"`
+	ReclaimedBytes       uint64   `protobuf:"varint,3,opt,name=reclaimed_bytes,json=reclaimedBytes,proto3" json:"reclaimed_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DebugPeerResponse) GetPeerId() string {
-	if m != nil {
-		return m.PeerId
-	}
-	return ""
+func (m *PruneDatabaseResponse) Reset()         { *m = PruneDatabaseResponse{} }
+func (m *PruneDatabaseResponse) String() string { return proto.CompactTextString(m) }
+func (*PruneDatabaseResponse) ProtoMessage()    {}
+func (*PruneDatabaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{9}
 }
-
-func (m *DebugPeerResponse) GetEnr() string {
-	if m != nil {
-		return m.Enr
-	}
-	return ""
+func (m *PruneDatabaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *DebugPeerResponse) GetPeerInfo() *DebugPeerResponse_PeerInfo {
-	if m != nil {
-		return m.PeerInfo
+func (m *PruneDatabaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruneDatabaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *PruneDatabaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneDatabaseResponse.Merge(m, src)
+}
+func (m *PruneDatabaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruneDatabaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneDatabaseResponse.DiscardUnknown(m)
 }
 
-func (m *DebugPeerResponse) GetPeerStatus() *v1.Status {
+var xxx_messageInfo_PruneDatabaseResponse proto.InternalMessageInfo
+
+func (m *PruneDatabaseResponse) GetDeletedStates() uint64 {
 	if m != nil {
-		return m.PeerStatus
+		return m.DeletedStates
 	}
-	return nil
+	return 0
 }
 
-func (m *DebugPeerResponse) GetLastUpdated() uint64 {
+func (m *PruneDatabaseResponse) GetDeletedBlocks() uint64 {
 	if m != nil {
-		return m.LastUpdated
+		return m.DeletedBlocks
 	}
 	return 0
 }
 
-func (m *DebugPeerResponse) GetScoreInfo() *ScoreInfo {
+func (m *PruneDatabaseResponse) GetReclaimedBytes() uint64 {
 	if m != nil {
-		return m.ScoreInfo
+		return m.ReclaimedBytes
 	}
-	return nil
+	return 0
 }
 
-type DebugPeerResponse_PeerInfo struct {
-	Metadata             *v1.MetaData `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Protocols            []string     `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols,omitempty"`
-	FaultCount           uint64       `protobuf:"varint,3,opt,name=fault_count,json=faultCount,proto3" json:"fault_count,omitempty"`
-	ProtocolVersion      string       `protobuf:"bytes,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
-	AgentVersion         string       `protobuf:"bytes,5,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
-	PeerLatency          uint64       `protobuf:"varint,6,opt,name=peer_latency,json=peerLatency,proto3" json:"peer_latency,omitempty"`
+type CachesResponse struct {
+	Caches               []*CacheInfo `protobuf:"bytes,1,rep,name=caches,proto3" json:"caches,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *DebugPeerResponse_PeerInfo) Reset()         { *m = DebugPeerResponse_PeerInfo{} }
-func (m *DebugPeerResponse_PeerInfo) String() string { return proto.CompactTextString(m) }
-func (*DebugPeerResponse_PeerInfo) ProtoMessage()    {}
-func (*DebugPeerResponse_PeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{9, 0}
+func (m *CachesResponse) Reset()         { *m = CachesResponse{} }
+func (m *CachesResponse) String() string { return proto.CompactTextString(m) }
+func (*CachesResponse) ProtoMessage()    {}
+func (*CachesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{10}
 }
-func (m *DebugPeerResponse_PeerInfo) XXX_Unmarshal(b []byte) error {
+func (m *CachesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DebugPeerResponse_PeerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CachesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DebugPeerResponse_PeerInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CachesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -766,85 +810,131 @@ func (m *DebugPeerResponse_PeerInfo) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *DebugPeerResponse_PeerInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugPeerResponse_PeerInfo.Merge(m, src)
+func (m *CachesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CachesResponse.Merge(m, src)
 }
-func (m *DebugPeerResponse_PeerInfo) XXX_Size() int {
+func (m *CachesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DebugPeerResponse_PeerInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugPeerResponse_PeerInfo.DiscardUnknown(m)
+func (m *CachesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CachesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DebugPeerResponse_PeerInfo proto.InternalMessageInfo
+var xxx_messageInfo_CachesResponse proto.InternalMessageInfo
 
-func (m *DebugPeerResponse_PeerInfo) GetMetadata() *v1.MetaData {
+func (m *CachesResponse) GetCaches() []*CacheInfo {
 	if m != nil {
-		return m.Metadata
+		return m.Caches
 	}
 	return nil
 }
 
-func (m *DebugPeerResponse_PeerInfo) GetProtocols() []string {
+type CacheInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Entries              uint64   `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	EstimatedBytes       uint64   `protobuf:"varint,3,opt,name=estimated_bytes,json=estimatedBytes,proto3" json:"estimated_bytes,omitempty"`
+	Hits                 uint64   `protobuf:"varint,4,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses               uint64   `protobuf:"varint,5,opt,name=misses,proto3" json:"misses,omitempty"`
+	HitRatio             float64  `protobuf:"fixed64,6,opt,name=hit_ratio,json=hitRatio,proto3" json:"hit_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CacheInfo) Reset()         { *m = CacheInfo{} }
+func (m *CacheInfo) String() string { return proto.CompactTextString(m) }
+func (*CacheInfo) ProtoMessage()    {}
+func (*CacheInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{11}
+}
+func (m *CacheInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CacheInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CacheInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CacheInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheInfo.Merge(m, src)
+}
+func (m *CacheInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CacheInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheInfo proto.InternalMessageInfo
+
+func (m *CacheInfo) GetName() string {
 	if m != nil {
-		return m.Protocols
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *DebugPeerResponse_PeerInfo) GetFaultCount() uint64 {
+func (m *CacheInfo) GetEntries() uint64 {
 	if m != nil {
-		return m.FaultCount
+		return m.Entries
 	}
 	return 0
 }
 
-func (m *DebugPeerResponse_PeerInfo) GetProtocolVersion() string {
+func (m *CacheInfo) GetEstimatedBytes() uint64 {
 	if m != nil {
-		return m.ProtocolVersion
+		return m.EstimatedBytes
 	}
-	return ""
+	return 0
 }
 
-func (m *DebugPeerResponse_PeerInfo) GetAgentVersion() string {
+func (m *CacheInfo) GetHits() uint64 {
 	if m != nil {
-		return m.AgentVersion
+		return m.Hits
 	}
-	return ""
+	return 0
 }
 
-func (m *DebugPeerResponse_PeerInfo) GetPeerLatency() uint64 {
+func (m *CacheInfo) GetMisses() uint64 {
 	if m != nil {
-		return m.PeerLatency
+		return m.Misses
 	}
 	return 0
 }
 
-type ScoreInfo struct {
-	OverallScore         float32                        `protobuf:"fixed32,1,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
-	ProcessedBlocks      uint64                         `protobuf:"varint,2,opt,name=processed_blocks,json=processedBlocks,proto3" json:"processed_blocks,omitempty"`
-	BlockProviderScore   float32                        `protobuf:"fixed32,3,opt,name=block_provider_score,json=blockProviderScore,proto3" json:"block_provider_score,omitempty"`
-	TopicScores          map[string]*TopicScoreSnapshot `protobuf:"bytes,4,rep,name=topic_scores,json=topicScores,proto3" json:"topic_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	GossipScore          float32                        `protobuf:"fixed32,5,opt,name=gossip_score,json=gossipScore,proto3" json:"gossip_score,omitempty"`
-	BehaviourPenalty     float32                        `protobuf:"fixed32,6,opt,name=behaviour_penalty,json=behaviourPenalty,proto3" json:"behaviour_penalty,omitempty"`
-	ValidationError      string                         `protobuf:"bytes,7,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+func (m *CacheInfo) GetHitRatio() float64 {
+	if m != nil {
+		return m.HitRatio
+	}
+	return 0
 }
 
-func (m *ScoreInfo) Reset()         { *m = ScoreInfo{} }
-func (m *ScoreInfo) String() string { return proto.CompactTextString(m) }
-func (*ScoreInfo) ProtoMessage()    {}
-func (*ScoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{10}
+type FlushCacheRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ScoreInfo) XXX_Unmarshal(b []byte) error {
+
+func (m *FlushCacheRequest) Reset()         { *m = FlushCacheRequest{} }
+func (m *FlushCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*FlushCacheRequest) ProtoMessage()    {}
+func (*FlushCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{12}
+}
+func (m *FlushCacheRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ScoreInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FlushCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ScoreInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FlushCacheRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -854,89 +944,209 @@ func (m *ScoreInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ScoreInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScoreInfo.Merge(m, src)
+func (m *FlushCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushCacheRequest.Merge(m, src)
 }
-func (m *ScoreInfo) XXX_Size() int {
+func (m *FlushCacheRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ScoreInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScoreInfo.DiscardUnknown(m)
+func (m *FlushCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushCacheRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScoreInfo proto.InternalMessageInfo
+var xxx_messageInfo_FlushCacheRequest proto.InternalMessageInfo
 
-func (m *ScoreInfo) GetOverallScore() float32 {
+func (m *FlushCacheRequest) GetName() string {
 	if m != nil {
-		return m.OverallScore
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *ScoreInfo) GetProcessedBlocks() uint64 {
+type ArchivalConfig struct {
+	SlotsPerArchivedPoint github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slots_per_archived_point,json=slotsPerArchivedPoint,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slots_per_archived_point,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                                 `json:"-"`
+	XXX_unrecognized      []byte                                   `json:"-"`
+	XXX_sizecache         int32                                    `json:"-"`
+}
+
+func (m *ArchivalConfig) Reset()         { *m = ArchivalConfig{} }
+func (m *ArchivalConfig) String() string { return proto.CompactTextString(m) }
+func (*ArchivalConfig) ProtoMessage()    {}
+func (*ArchivalConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{13}
+}
+func (m *ArchivalConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivalConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivalConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivalConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivalConfig.Merge(m, src)
+}
+func (m *ArchivalConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivalConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivalConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivalConfig proto.InternalMessageInfo
+
+func (m *ArchivalConfig) GetSlotsPerArchivedPoint() github_com_prysmaticlabs_eth2_types.Slot {
 	if m != nil {
-		return m.ProcessedBlocks
+		return m.SlotsPerArchivedPoint
 	}
 	return 0
 }
 
-func (m *ScoreInfo) GetBlockProviderScore() float32 {
+type ArchivalMigrationResponse struct {
+	PreviousSlotsPerArchivedPoint github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=previous_slots_per_archived_point,json=previousSlotsPerArchivedPoint,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"previous_slots_per_archived_point,omitempty"`
+	SlotsPerArchivedPoint         github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=slots_per_archived_point,json=slotsPerArchivedPoint,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slots_per_archived_point,omitempty"`
+	SavedStates                   uint64                                   `protobuf:"varint,3,opt,name=saved_states,json=savedStates,proto3" json:"saved_states,omitempty"`
+	DeletedStates                 uint64                                   `protobuf:"varint,4,opt,name=deleted_states,json=deletedStates,proto3" json:"deleted_states,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{}                                 `json:"-"`
+	XXX_unrecognized              []byte                                   `json:"-"`
+	XXX_sizecache                 int32                                    `json:"-"`
+}
+
+func (m *ArchivalMigrationResponse) Reset()         { *m = ArchivalMigrationResponse{} }
+func (m *ArchivalMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*ArchivalMigrationResponse) ProtoMessage()    {}
+func (*ArchivalMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{14}
+}
+func (m *ArchivalMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivalMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivalMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivalMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivalMigrationResponse.Merge(m, src)
+}
+func (m *ArchivalMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivalMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivalMigrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivalMigrationResponse proto.InternalMessageInfo
+
+func (m *ArchivalMigrationResponse) GetPreviousSlotsPerArchivedPoint() github_com_prysmaticlabs_eth2_types.Slot {
 	if m != nil {
-		return m.BlockProviderScore
+		return m.PreviousSlotsPerArchivedPoint
 	}
 	return 0
 }
 
-func (m *ScoreInfo) GetTopicScores() map[string]*TopicScoreSnapshot {
+func (m *ArchivalMigrationResponse) GetSlotsPerArchivedPoint() github_com_prysmaticlabs_eth2_types.Slot {
 	if m != nil {
-		return m.TopicScores
+		return m.SlotsPerArchivedPoint
 	}
-	return nil
+	return 0
 }
 
-func (m *ScoreInfo) GetGossipScore() float32 {
+func (m *ArchivalMigrationResponse) GetSavedStates() uint64 {
 	if m != nil {
-		return m.GossipScore
+		return m.SavedStates
 	}
 	return 0
 }
 
-func (m *ScoreInfo) GetBehaviourPenalty() float32 {
+func (m *ArchivalMigrationResponse) GetDeletedStates() uint64 {
 	if m != nil {
-		return m.BehaviourPenalty
+		return m.DeletedStates
 	}
 	return 0
 }
 
-func (m *ScoreInfo) GetValidationError() string {
+type ReplayCostsRequest struct {
+	Slots                []github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,rep,packed,name=slots,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *ReplayCostsRequest) Reset()         { *m = ReplayCostsRequest{} }
+func (m *ReplayCostsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayCostsRequest) ProtoMessage()    {}
+func (*ReplayCostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{15}
+}
+func (m *ReplayCostsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayCostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayCostsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayCostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayCostsRequest.Merge(m, src)
+}
+func (m *ReplayCostsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayCostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayCostsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayCostsRequest proto.InternalMessageInfo
+
+func (m *ReplayCostsRequest) GetSlots() []github_com_prysmaticlabs_eth2_types.Slot {
 	if m != nil {
-		return m.ValidationError
+		return m.Slots
 	}
-	return ""
+	return nil
 }
 
-type TopicScoreSnapshot struct {
-	TimeInMesh               uint64   `protobuf:"varint,1,opt,name=time_in_mesh,json=timeInMesh,proto3" json:"time_in_mesh,omitempty"`
-	FirstMessageDeliveries   float32  `protobuf:"fixed32,2,opt,name=first_message_deliveries,json=firstMessageDeliveries,proto3" json:"first_message_deliveries,omitempty"`
-	MeshMessageDeliveries    float32  `protobuf:"fixed32,3,opt,name=mesh_message_deliveries,json=meshMessageDeliveries,proto3" json:"mesh_message_deliveries,omitempty"`
-	InvalidMessageDeliveries float32  `protobuf:"fixed32,4,opt,name=invalid_message_deliveries,json=invalidMessageDeliveries,proto3" json:"invalid_message_deliveries,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
-	XXX_unrecognized         []byte   `json:"-"`
-	XXX_sizecache            int32    `json:"-"`
+type ReplayCostsResponse struct {
+	Costs                []*ReplayCost `protobuf:"bytes,1,rep,name=costs,proto3" json:"costs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *TopicScoreSnapshot) Reset()         { *m = TopicScoreSnapshot{} }
-func (m *TopicScoreSnapshot) String() string { return proto.CompactTextString(m) }
-func (*TopicScoreSnapshot) ProtoMessage()    {}
-func (*TopicScoreSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_851e5cb2de3d61dd, []int{11}
+func (m *ReplayCostsResponse) Reset()         { *m = ReplayCostsResponse{} }
+func (m *ReplayCostsResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplayCostsResponse) ProtoMessage()    {}
+func (*ReplayCostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{16}
 }
-func (m *TopicScoreSnapshot) XXX_Unmarshal(b []byte) error {
+func (m *ReplayCostsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TopicScoreSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReplayCostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TopicScoreSnapshot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReplayCostsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -946,1568 +1156,10093 @@ func (m *TopicScoreSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *TopicScoreSnapshot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopicScoreSnapshot.Merge(m, src)
+func (m *ReplayCostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayCostsResponse.Merge(m, src)
 }
-func (m *TopicScoreSnapshot) XXX_Size() int {
+func (m *ReplayCostsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *TopicScoreSnapshot) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopicScoreSnapshot.DiscardUnknown(m)
+func (m *ReplayCostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayCostsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopicScoreSnapshot proto.InternalMessageInfo
+var xxx_messageInfo_ReplayCostsResponse proto.InternalMessageInfo
 
-func (m *TopicScoreSnapshot) GetTimeInMesh() uint64 {
+func (m *ReplayCostsResponse) GetCosts() []*ReplayCost {
 	if m != nil {
-		return m.TimeInMesh
+		return m.Costs
 	}
-	return 0
+	return nil
 }
 
-func (m *TopicScoreSnapshot) GetFirstMessageDeliveries() float32 {
+type ReplayCost struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	BlockRoot            []byte                                   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	StartSlot            github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,3,opt,name=start_slot,json=startSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"start_slot,omitempty"`
+	StartRoot            []byte                                   `protobuf:"bytes,4,opt,name=start_root,json=startRoot,proto3" json:"start_root,omitempty"`
+	InMemory             bool                                     `protobuf:"varint,5,opt,name=in_memory,json=inMemory,proto3" json:"in_memory,omitempty"`
+	Blocks               uint64                                   `protobuf:"varint,6,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Slots                github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,7,opt,name=slots,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *ReplayCost) Reset()         { *m = ReplayCost{} }
+func (m *ReplayCost) String() string { return proto.CompactTextString(m) }
+func (*ReplayCost) ProtoMessage()    {}
+func (*ReplayCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{17}
+}
+func (m *ReplayCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayCost.Merge(m, src)
+}
+func (m *ReplayCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayCost proto.InternalMessageInfo
+
+func (m *ReplayCost) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
 	if m != nil {
-		return m.FirstMessageDeliveries
+		return m.Slot
 	}
 	return 0
 }
 
-func (m *TopicScoreSnapshot) GetMeshMessageDeliveries() float32 {
+func (m *ReplayCost) GetBlockRoot() []byte {
 	if m != nil {
-		return m.MeshMessageDeliveries
+		return m.BlockRoot
 	}
-	return 0
+	return nil
 }
 
-func (m *TopicScoreSnapshot) GetInvalidMessageDeliveries() float32 {
+func (m *ReplayCost) GetStartSlot() github_com_prysmaticlabs_eth2_types.Slot {
 	if m != nil {
-		return m.InvalidMessageDeliveries
+		return m.StartSlot
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
-	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
-	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
-	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
-	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
-	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
-	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
-	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
-	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
-	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
-	proto.RegisterType((*ScoreInfo)(nil), "ethereum.beacon.rpc.v1.ScoreInfo")
-	proto.RegisterMapType((map[string]*TopicScoreSnapshot)(nil), "ethereum.beacon.rpc.v1.ScoreInfo.TopicScoresEntry")
-	proto.RegisterType((*TopicScoreSnapshot)(nil), "ethereum.beacon.rpc.v1.TopicScoreSnapshot")
+func (m *ReplayCost) GetStartRoot() []byte {
+	if m != nil {
+		return m.StartRoot
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
-
-var fileDescriptor_851e5cb2de3d61dd = []byte{
-	// 1587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf7, 0x52, 0xa2, 0xa4, 0x7d, 0x64, 0x29, 0x7a, 0xe2, 0xc8, 0x2c, 0x6d, 0x4b, 0xf2, 0x3a,
-	0x8d, 0xed, 0x24, 0x5a, 0x56, 0x6c, 0x51, 0x04, 0x41, 0x80, 0xda, 0xfa, 0x88, 0x22, 0xc0, 0x4e,
-	0xdc, 0x95, 0x1d, 0xa0, 0x2d, 0x8a, 0xc5, 0x68, 0xf7, 0x91, 0x3b, 0xf1, 0x6a, 0x67, 0x33, 0x33,
-	0x64, 0xab, 0xf4, 0x56, 0x14, 0x28, 0x7a, 0x69, 0x0f, 0x05, 0x7a, 0xee, 0x9f, 0x53, 0xa0, 0x97,
-	0x02, 0xbd, 0x07, 0x85, 0x11, 0xf4, 0x8f, 0xd0, 0xa9, 0x98, 0x99, 0xdd, 0x25, 0x15, 0x91, 0x89,
-	0x6c, 0x38, 0xb7, 0x79, 0x5f, 0xbf, 0xf7, 0xf6, 0x7d, 0xec, 0xbc, 0x81, 0x8d, 0x5c, 0x70, 0xc5,
-	0x7b, 0xc7, 0x48, 0x23, 0x9e, 0xf5, 0x44, 0x1e, 0xf5, 0xc6, 0xdb, 0xbd, 0x18, 0x8f, 0x47, 0x43,
-	0xdf, 0x48, 0xc8, 0x1a, 0xaa, 0x04, 0x05, 0x8e, 0x4e, 0x7c, 0xab, 0xe3, 0x8b, 0x3c, 0xf2, 0xc7,
-	0xdb, 0xdd, 0xeb, 0xa8, 0x92, 0xde, 0x78, 0x9b, 0xa6, 0x79, 0x42, 0xb7, 0x7b, 0x19, 0x8f, 0xd1,
-	0x1a, 0x74, 0xbd, 0x73, 0x88, 0x79, 0x3f, 0xd7, 0x88, 0x27, 0x28, 0x25, 0x1d, 0xa2, 0x2c, 0x74,
-	0x6e, 0x0e, 0x39, 0x1f, 0xa6, 0xd8, 0xa3, 0x39, 0xeb, 0xd1, 0x2c, 0xe3, 0x8a, 0x2a, 0xc6, 0xb3,
-	0x52, 0x7a, 0xa3, 0x90, 0x1a, 0xea, 0x78, 0x34, 0xe8, 0xe1, 0x49, 0xae, 0x4e, 0x0b, 0xe1, 0xd6,
-	0x90, 0xa9, 0x64, 0x74, 0xec, 0x47, 0xfc, 0xa4, 0x37, 0xe4, 0x43, 0x3e, 0xd1, 0xd2, 0x94, 0xf5,
-	0xad, 0x4f, 0x56, 0xdd, 0x4b, 0xe0, 0xda, 0x61, 0x16, 0xa5, 0x23, 0xc9, 0x78, 0x76, 0x94, 0x72,
-	0x15, 0xe0, 0x17, 0x23, 0x94, 0x8a, 0xb4, 0xa0, 0xc6, 0xe2, 0x8e, 0xb3, 0xe9, 0xdc, 0x5b, 0x0c,
-	0x6a, 0x2c, 0x26, 0x0f, 0x60, 0x51, 0xa6, 0x5c, 0x75, 0x6a, 0x9a, 0xb3, 0xf3, 0xde, 0xd9, 0x57,
-	0x1b, 0xf7, 0xa6, 0x1c, 0xe5, 0xe2, 0x54, 0x9e, 0x50, 0xc5, 0xa2, 0x94, 0x1e, 0xcb, 0x1e, 0xaa,
-	0xa4, 0xbf, 0xa5, 0x4e, 0x73, 0x94, 0xbe, 0x81, 0x34, 0x96, 0xde, 0x2f, 0xe1, 0xcd, 0x6f, 0x78,
-	0x92, 0x39, 0xcf, 0x24, 0xbe, 0x06, 0xe8, 0x3f, 0x3b, 0x40, 0x76, 0x4c, 0x3e, 0x8f, 0x14, 0x55,
-	0x58, 0x7e, 0xc3, 0x4e, 0x01, 0xec, 0xbc, 0x3c, 0xf0, 0xc7, 0x57, 0x2c, 0x34, 0xd9, 0x00, 0x38,
-	0x4e, 0x79, 0xf4, 0x3c, 0x14, 0xbc, 0x08, 0xb1, 0xf9, 0xf1, 0x95, 0xc0, 0x35, 0xbc, 0x80, 0x73,
-	0xb5, 0xd3, 0x82, 0xe6, 0x17, 0x23, 0x14, 0xa7, 0xe1, 0x80, 0xa5, 0x0a, 0x85, 0xb7, 0x05, 0xcd,
-	0x1d, 0x23, 0x2c, 0x82, 0xb8, 0x75, 0x0e, 0x40, 0x87, 0xd2, 0x9c, 0x32, 0xf7, 0xee, 0x42, 0xe3,
-	0xe8, 0xe8, 0x57, 0x55, 0x2e, 0x3a, 0xb0, 0x8c, 0x59, 0xc4, 0x63, 0x8c, 0x0b, 0xd5, 0x92, 0xf4,
-	0xfe, 0xe4, 0xc0, 0x1b, 0x8f, 0xf8, 0x70, 0xc8, 0xb2, 0xe1, 0x23, 0x1c, 0x63, 0x5a, 0xe2, 0x1f,
-	0x40, 0x3d, 0xd5, 0xb4, 0xd1, 0x6f, 0xf5, 0xb7, 0xfd, 0xd9, 0xfd, 0xe8, 0xcf, 0xb0, 0xf5, 0x2d,
-	0x61, 0xed, 0xbd, 0xbb, 0x50, 0x37, 0x34, 0x59, 0x81, 0xc5, 0xc3, 0x4f, 0x3e, 0xfa, 0xb4, 0x7d,
-	0x85, 0xb8, 0x50, 0xdf, 0xdb, 0xdf, 0x79, 0x76, 0xd0, 0x76, 0xf4, 0xf1, 0x69, 0xf0, 0x70, 0x77,
-	0xbf, 0x5d, 0xf3, 0xbe, 0x5e, 0x80, 0x9b, 0x4f, 0x74, 0xf3, 0x3c, 0x14, 0x82, 0x9e, 0x7e, 0xc4,
-	0xc5, 0xf3, 0xdd, 0x84, 0xb3, 0x08, 0xab, 0x8f, 0xb8, 0x0b, 0xab, 0xb9, 0x18, 0x65, 0x18, 0xaa,
-	0x44, 0xa0, 0x4c, 0x78, 0x5a, 0x36, 0x52, 0xcb, 0xb0, 0x9f, 0x96, 0x5c, 0xf2, 0x19, 0xac, 0x7e,
-	0x3e, 0x92, 0x8a, 0x0d, 0x18, 0xc6, 0x21, 0xe6, 0x3c, 0x4a, 0x8a, 0x26, 0xd8, 0x3a, 0xfb, 0x6a,
-	0xe3, 0xfe, 0x65, 0x6a, 0xb5, 0xaf, 0x8d, 0x82, 0x56, 0x85, 0x62, 0x68, 0x8d, 0x3b, 0x60, 0x19,
-	0x4d, 0xd9, 0x97, 0x15, 0xee, 0xc2, 0x2b, 0xe1, 0x56, 0x28, 0x16, 0x37, 0x80, 0xab, 0x66, 0x6a,
-	0x42, 0xaa, 0xbf, 0x3c, 0xd4, 0x43, 0x2d, 0x3b, 0x8b, 0x9b, 0x0b, 0xf7, 0x1a, 0xfd, 0xb7, 0xe7,
-	0xe5, 0x7d, 0x92, 0xa9, 0x4f, 0x78, 0x8c, 0xc1, 0x6a, 0x7e, 0x8e, 0x96, 0xe4, 0xd7, 0xb0, 0xcc,
-	0xb2, 0x98, 0x45, 0x28, 0x3b, 0x75, 0x83, 0xf4, 0xf0, 0xbb, 0x91, 0x2e, 0xe6, 0xdc, 0x3f, 0xb4,
-	0x18, 0xfb, 0x99, 0x12, 0xa7, 0x41, 0x89, 0xd8, 0xfd, 0x00, 0x9a, 0xd3, 0x02, 0xd2, 0x86, 0x85,
-	0xe7, 0x78, 0x6a, 0xaa, 0xe1, 0x06, 0xfa, 0x48, 0xae, 0x41, 0x7d, 0x4c, 0xd3, 0x11, 0xda, 0xc4,
-	0x07, 0x96, 0xf8, 0xa0, 0xf6, 0xbe, 0xe3, 0xfd, 0x65, 0x01, 0x5a, 0xe7, 0x83, 0xaf, 0x26, 0xd5,
-	0x79, 0xd5, 0x49, 0x25, 0x04, 0x16, 0x27, 0x83, 0x14, 0x98, 0x33, 0x59, 0x83, 0xa5, 0x9c, 0x0a,
-	0xcc, 0x94, 0x2d, 0x52, 0x50, 0x50, 0xb3, 0xba, 0x63, 0xf1, 0x7b, 0xea, 0x8e, 0xfa, 0xeb, 0xe8,
-	0x8e, 0x35, 0x58, 0xfa, 0x2d, 0xb2, 0x61, 0xa2, 0x3a, 0x4b, 0xf6, 0x3b, 0x2c, 0x65, 0xfe, 0x00,
-	0x28, 0x55, 0x18, 0x25, 0x2c, 0x8d, 0x3b, 0xcb, 0x46, 0xe6, 0x6a, 0xce, 0xae, 0x66, 0xe8, 0x69,
-	0x31, 0xe2, 0x18, 0x65, 0x84, 0x59, 0x4c, 0x33, 0xd5, 0x59, 0xb1, 0xd3, 0xa2, 0xd9, 0x7b, 0x15,
-	0xd7, 0xfb, 0x0d, 0x90, 0x3d, 0x7d, 0xf1, 0x3c, 0x41, 0x14, 0x65, 0xdd, 0x25, 0x39, 0x00, 0x57,
-	0x94, 0x44, 0xc7, 0x31, 0x1d, 0x74, 0x7f, 0x5e, 0x07, 0x5d, 0x30, 0x0f, 0x26, 0xb6, 0xde, 0x59,
-	0x1d, 0xae, 0x5e, 0x50, 0x20, 0x3d, 0x78, 0x23, 0x65, 0x52, 0x61, 0xc6, 0xb2, 0x61, 0x48, 0xe3,
-	0x58, 0xa0, 0x2c, 0x1d, 0xb9, 0x01, 0xa9, 0x44, 0x0f, 0x4b, 0x09, 0xd9, 0x01, 0x37, 0x66, 0x02,
-	0x23, 0x7d, 0x61, 0x99, 0x32, 0xb7, 0xfa, 0x6f, 0x4d, 0xe2, 0x41, 0x95, 0xf8, 0xe5, 0xa5, 0xe8,
-	0x6b, 0x47, 0x7b, 0xa5, 0x6e, 0x30, 0x31, 0x23, 0xbf, 0x80, 0x76, 0xc4, 0xb3, 0xcc, 0x52, 0xa1,
-	0xd4, 0xff, 0x74, 0xd3, 0x1b, 0xad, 0xe9, 0x31, 0x3b, 0x07, 0xb5, 0x5b, 0xa9, 0xdb, 0x1b, 0x60,
-	0x35, 0x3a, 0xcf, 0x20, 0xd7, 0x61, 0x39, 0x47, 0x14, 0x21, 0x8b, 0x4d, 0x13, 0xb9, 0xc1, 0x92,
-	0x26, 0x0f, 0x63, 0x3d, 0x12, 0x98, 0x09, 0xd3, 0x01, 0x6e, 0xa0, 0x8f, 0xe4, 0x53, 0x70, 0xad,
-	0x6a, 0x36, 0xe0, 0xa6, 0x94, 0x8d, 0x7e, 0xff, 0xd2, 0x19, 0x35, 0x1f, 0x75, 0x98, 0x0d, 0x78,
-	0xb0, 0x92, 0x17, 0x27, 0xf2, 0x73, 0x68, 0x18, 0x40, 0xfd, 0x21, 0x23, 0x69, 0x3a, 0xa0, 0xd1,
-	0x5f, 0xbf, 0x00, 0x99, 0xf7, 0x73, 0x0d, 0x79, 0x64, 0xb4, 0x02, 0xd0, 0x26, 0xf6, 0x4c, 0x6e,
-	0x43, 0x33, 0xa5, 0x52, 0x85, 0xa3, 0x3c, 0xa6, 0x0a, 0xe3, 0xa2, 0x3f, 0x1a, 0x9a, 0xf7, 0xcc,
-	0xb2, 0xc8, 0x03, 0x00, 0x19, 0x71, 0x81, 0x36, 0x6a, 0xd7, 0xb8, 0xb8, 0x3d, 0x2f, 0xea, 0x23,
-	0xad, 0x69, 0x82, 0x74, 0x65, 0x79, 0xec, 0x9e, 0x39, 0xb0, 0x52, 0x06, 0x4f, 0x3e, 0x84, 0x95,
-	0x13, 0x54, 0x34, 0xa6, 0x8a, 0x9a, 0x69, 0x6f, 0xf4, 0x37, 0xe7, 0xc5, 0xfb, 0x18, 0x15, 0xdd,
-	0xa3, 0x8a, 0x06, 0x95, 0x05, 0xb9, 0x09, 0xae, 0xf9, 0xcd, 0x45, 0x3c, 0x95, 0x9d, 0x9a, 0x69,
-	0x95, 0x09, 0x83, 0x6c, 0x40, 0x63, 0x40, 0x47, 0xa9, 0x0a, 0x23, 0x3e, 0xaa, 0x86, 0x1e, 0x0c,
-	0x6b, 0x57, 0x73, 0xc8, 0x7d, 0x68, 0x97, 0xda, 0xe1, 0x18, 0x85, 0x5e, 0x18, 0x8a, 0xa2, 0xad,
-	0x96, 0xfc, 0xcf, 0x2c, 0x9b, 0xdc, 0x81, 0x1f, 0xd0, 0x21, 0x66, 0xaa, 0xd2, 0xb3, 0x75, 0x6c,
-	0x1a, 0x66, 0xa9, 0x74, 0x1b, 0x9a, 0x26, 0xff, 0x29, 0x55, 0x98, 0x45, 0xa7, 0xc5, 0x78, 0x9a,
-	0x9a, 0x3c, 0xb2, 0x2c, 0xef, 0x5f, 0x0b, 0xe0, 0x56, 0x59, 0xd1, 0xa8, 0x7c, 0x8c, 0x82, 0xa6,
-	0x69, 0x68, 0xf2, 0x63, 0x52, 0x50, 0x0b, 0x9a, 0x05, 0xd3, 0x28, 0x16, 0x51, 0x46, 0xba, 0xeb,
-	0xe3, 0xd0, 0x5c, 0xe8, 0xb2, 0xf8, 0x89, 0xae, 0x56, 0x7c, 0xb3, 0x09, 0x48, 0xf2, 0x63, 0xb8,
-	0x66, 0x77, 0x80, 0x5c, 0xf0, 0x31, 0x8b, 0x75, 0x2b, 0x18, 0xd8, 0x05, 0x03, 0x4b, 0x8c, 0xec,
-	0x49, 0x21, 0xb2, 0xe0, 0xcf, 0xa0, 0xa9, 0x78, 0xce, 0x22, 0xab, 0x58, 0x5e, 0x32, 0xfd, 0xef,
-	0x2c, 0xa8, 0xff, 0x54, 0x5b, 0x19, 0xb2, 0xb8, 0x0b, 0x1a, 0x6a, 0xc2, 0xd1, 0x99, 0x18, 0x72,
-	0x29, 0x59, 0x5e, 0x04, 0x50, 0x37, 0x01, 0x34, 0x2c, 0xcf, 0x7a, 0x7e, 0x17, 0xae, 0x1e, 0x63,
-	0x42, 0xc7, 0x8c, 0x8f, 0x44, 0x98, 0x63, 0x46, 0x53, 0x65, 0x33, 0x56, 0x0b, 0xda, 0x95, 0xe0,
-	0x89, 0xe5, 0xeb, 0x1c, 0x8c, 0x69, 0xca, 0x62, 0xb3, 0x9e, 0x86, 0x28, 0x04, 0x17, 0xa6, 0xbd,
-	0xdd, 0x60, 0x75, 0xc2, 0xdf, 0xd7, 0xec, 0xee, 0xe7, 0xd0, 0xfe, 0x66, 0x6c, 0x33, 0xae, 0xa3,
-	0x07, 0xd3, 0xd7, 0x51, 0xa3, 0xff, 0xce, 0xbc, 0x0f, 0x9e, 0x40, 0x1d, 0x65, 0x34, 0x97, 0x09,
-	0x57, 0xd3, 0x57, 0xd7, 0xff, 0x1c, 0x20, 0x17, 0x35, 0xc8, 0x26, 0x34, 0x15, 0x3b, 0xd1, 0x23,
-	0x12, 0x9e, 0xa0, 0x4c, 0x8a, 0xa5, 0x04, 0x34, 0xef, 0x30, 0x7b, 0x8c, 0x32, 0x21, 0xef, 0x43,
-	0x67, 0xc0, 0x84, 0x54, 0x61, 0xb1, 0x8f, 0x87, 0x31, 0xa6, 0x6c, 0x8c, 0x82, 0xa1, 0xad, 0x6d,
-	0x2d, 0x58, 0x33, 0xf2, 0xc7, 0x56, 0xbc, 0x57, 0x49, 0xc9, 0xcf, 0xe0, 0xba, 0xc6, 0x9c, 0x65,
-	0x68, 0xab, 0xfc, 0xa6, 0x16, 0x5f, 0xb4, 0xfb, 0x10, 0xba, 0x2c, 0x33, 0xb9, 0x9a, 0x65, 0xba,
-	0x68, 0x4c, 0x3b, 0x85, 0xc6, 0x05, 0xeb, 0xfe, 0x3f, 0x96, 0xa1, 0x6e, 0x7e, 0x41, 0xe4, 0x8f,
-	0x0e, 0xb4, 0x0e, 0x50, 0x4d, 0x6d, 0xc1, 0x64, 0x6e, 0xf2, 0x2e, 0xae, 0xca, 0xdd, 0x3b, 0x73,
-	0x3b, 0x6b, 0xb2, 0x9c, 0x7a, 0xb7, 0xff, 0xf0, 0x9f, 0xaf, 0xff, 0x56, 0xbb, 0x41, 0x7e, 0xd8,
-	0x3b, 0xf7, 0xb6, 0x31, 0xaf, 0xa1, 0x9e, 0xf9, 0x4b, 0x93, 0xdf, 0xc1, 0x8a, 0x8e, 0x42, 0x37,
-	0x34, 0x79, 0x6b, 0xae, 0xff, 0xa9, 0xfd, 0xf8, 0x35, 0x78, 0x36, 0xe3, 0x43, 0x7e, 0x0f, 0xab,
-	0x47, 0xa8, 0xa6, 0xb7, 0x5c, 0xf2, 0xee, 0x4b, 0xec, 0xc2, 0xdd, 0x35, 0xdf, 0xbe, 0xaa, 0xfc,
-	0xf2, 0xbd, 0xe4, 0xef, 0xeb, 0x57, 0x95, 0x77, 0xc7, 0xb8, 0xbe, 0xe5, 0xdd, 0x98, 0xe5, 0x3a,
-	0xb5, 0x40, 0xe4, 0xaf, 0x0e, 0x5c, 0x3f, 0x40, 0x35, 0x6b, 0x43, 0x23, 0x73, 0x80, 0xbb, 0x3f,
-	0x7d, 0x95, 0x3d, 0xcf, 0x7b, 0xdb, 0x84, 0xb3, 0x49, 0xd6, 0x67, 0x85, 0x33, 0xe0, 0xe2, 0x79,
-	0x64, 0xbd, 0x0a, 0x70, 0x1f, 0x31, 0xa9, 0xf4, 0x0f, 0x5d, 0xce, 0x0d, 0xe1, 0x9d, 0x4b, 0x5f,
-	0x6b, 0xf2, 0xdb, 0x4b, 0x90, 0x1b, 0x37, 0x5f, 0xc2, 0xb2, 0x4e, 0x02, 0xa2, 0x20, 0xde, 0xb7,
-	0x5c, 0xf9, 0x65, 0xc6, 0x2f, 0xbf, 0xa6, 0x78, 0x9b, 0xc6, 0x79, 0x97, 0x74, 0xe6, 0x39, 0x27,
-	0x7f, 0x77, 0xa0, 0x7d, 0x80, 0xea, 0xdc, 0x0b, 0x93, 0xbc, 0x37, 0xcf, 0xc3, 0xac, 0x27, 0x6f,
-	0x77, 0xeb, 0x92, 0xda, 0x45, 0x4c, 0x3f, 0x32, 0x31, 0x6d, 0x90, 0x5b, 0xb3, 0x62, 0x62, 0xa5,
-	0xc9, 0x4e, 0xf3, 0x9f, 0x2f, 0xd6, 0x9d, 0x7f, 0xbf, 0x58, 0x77, 0xfe, 0xfb, 0x62, 0xdd, 0x39,
-	0x5e, 0x32, 0x15, 0xf8, 0xc9, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x0a, 0x6e, 0xd4, 0x57,
-	0x10, 0x00, 0x00,
+func (m *ReplayCost) GetInMemory() bool {
+	if m != nil {
+		return m.InMemory
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ReplayCost) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *ReplayCost) GetSlots() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slots
+	}
+	return 0
+}
 
-// DebugClient is the client API for Debug service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DebugClient interface {
-	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	GetProtoArrayForkChoice(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
-	ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
-	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
-	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+type ArrivalEventsRequest struct {
+	Limit                uint64   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type debugClient struct {
-	cc *grpc.ClientConn
+func (m *ArrivalEventsRequest) Reset()         { *m = ArrivalEventsRequest{} }
+func (m *ArrivalEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ArrivalEventsRequest) ProtoMessage()    {}
+func (*ArrivalEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{18}
+}
+func (m *ArrivalEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArrivalEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArrivalEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArrivalEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrivalEventsRequest.Merge(m, src)
+}
+func (m *ArrivalEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArrivalEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrivalEventsRequest.DiscardUnknown(m)
 }
 
-func NewDebugClient(cc *grpc.ClientConn) DebugClient {
-	return &debugClient{cc}
+var xxx_messageInfo_ArrivalEventsRequest proto.InternalMessageInfo
+
+func (m *ArrivalEventsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ArrivalEventsResponse struct {
+	Events               []*ArrivalEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ArrivalEventsResponse) Reset()         { *m = ArrivalEventsResponse{} }
+func (m *ArrivalEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ArrivalEventsResponse) ProtoMessage()    {}
+func (*ArrivalEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{19}
+}
+func (m *ArrivalEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArrivalEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArrivalEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ArrivalEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrivalEventsResponse.Merge(m, src)
+}
+func (m *ArrivalEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArrivalEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrivalEventsResponse.DiscardUnknown(m)
 }
 
-func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ArrivalEventsResponse proto.InternalMessageInfo
+
+func (m *ArrivalEventsResponse) GetEvents() []*ArrivalEvent {
+	if m != nil {
+		return m.Events
 	}
-	return out, nil
+	return nil
 }
 
-func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ArrivalEvent struct {
+	Kind                 ArrivalEvent_Kind                                  `protobuf:"varint,1,opt,name=kind,proto3,enum=ethereum.beacon.rpc.v1.ArrivalEvent_Kind" json:"kind,omitempty"`
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,2,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	Root                 []byte                                             `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	ValidatorIndex       github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,4,opt,name=validator_index,json=validatorIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validator_index,omitempty"`
+	DelayMilliseconds    int64                                              `protobuf:"varint,5,opt,name=delay_milliseconds,json=delayMilliseconds,proto3" json:"delay_milliseconds,omitempty"`
+	Late                 bool                                               `protobuf:"varint,6,opt,name=late,proto3" json:"late,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ArrivalEvent) Reset()         { *m = ArrivalEvent{} }
+func (m *ArrivalEvent) String() string { return proto.CompactTextString(m) }
+func (*ArrivalEvent) ProtoMessage()    {}
+func (*ArrivalEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{20}
+}
+func (m *ArrivalEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArrivalEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArrivalEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ArrivalEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrivalEvent.Merge(m, src)
+}
+func (m *ArrivalEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArrivalEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrivalEvent.DiscardUnknown(m)
 }
 
-func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
-	out := new(ProtoArrayForkChoiceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ArrivalEvent proto.InternalMessageInfo
+
+func (m *ArrivalEvent) GetKind() ArrivalEvent_Kind {
+	if m != nil {
+		return m.Kind
 	}
-	return out, nil
+	return ArrivalEvent_BLOCK
 }
 
-func (c *debugClient) ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error) {
-	out := new(DebugPeerResponses)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ArrivalEvent) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
 	}
-	return out, nil
+	return 0
 }
 
-func (c *debugClient) GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error) {
-	out := new(DebugPeerResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ArrivalEvent) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	return out, nil
+	return nil
 }
 
-func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error) {
-	out := new(InclusionSlotResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ArrivalEvent) GetValidatorIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ValidatorIndex
 	}
-	return out, nil
+	return 0
 }
 
-// DebugServer is the server API for Debug service.
-type DebugServer interface {
-	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
-	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
-	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*empty.Empty, error)
-	GetProtoArrayForkChoice(context.Context, *empty.Empty) (*ProtoArrayForkChoiceResponse, error)
-	ListPeers(context.Context, *empty.Empty) (*DebugPeerResponses, error)
-	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
-	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+func (m *ArrivalEvent) GetDelayMilliseconds() int64 {
+	if m != nil {
+		return m.DelayMilliseconds
+	}
+	return 0
 }
 
-// UnimplementedDebugServer can be embedded to have forward compatible implementations.
-type UnimplementedDebugServer struct {
+func (m *ArrivalEvent) GetLate() bool {
+	if m != nil {
+		return m.Late
+	}
+	return false
 }
 
-func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
+type RecentReorgsResponse struct {
+	Reorgs               []*Reorg `protobuf:"bytes,1,rep,name=reorgs,proto3" json:"reorgs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+
+func (m *RecentReorgsResponse) Reset()         { *m = RecentReorgsResponse{} }
+func (m *RecentReorgsResponse) String() string { return proto.CompactTextString(m) }
+func (*RecentReorgsResponse) ProtoMessage()    {}
+func (*RecentReorgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{21}
 }
-func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
+func (m *RecentReorgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *empty.Empty) (*ProtoArrayForkChoiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+func (m *RecentReorgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecentReorgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDebugServer) ListPeers(ctx context.Context, req *empty.Empty) (*DebugPeerResponses, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+func (m *RecentReorgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentReorgsResponse.Merge(m, src)
 }
-func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*DebugPeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+func (m *RecentReorgsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
+func (m *RecentReorgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentReorgsResponse.DiscardUnknown(m)
 }
 
-func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
-	s.RegisterService(&_Debug_serviceDesc, srv)
+var xxx_messageInfo_RecentReorgsResponse proto.InternalMessageInfo
+
+func (m *RecentReorgsResponse) GetReorgs() []*Reorg {
+	if m != nil {
+		return m.Reorgs
+	}
+	return nil
 }
 
-func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BeaconStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type Reorg struct {
+	OldSlot              github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=old_slot,json=oldSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"old_slot,omitempty"`
+	OldRoot              []byte                                   `protobuf:"bytes,2,opt,name=old_root,json=oldRoot,proto3" json:"old_root,omitempty"`
+	NewSlot              github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,3,opt,name=new_slot,json=newSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"new_slot,omitempty"`
+	NewRoot              []byte                                   `protobuf:"bytes,4,opt,name=new_root,json=newRoot,proto3" json:"new_root,omitempty"`
+	CommonAncestor       []byte                                   `protobuf:"bytes,5,opt,name=common_ancestor,json=commonAncestor,proto3" json:"common_ancestor,omitempty"`
+	Depth                uint64                                   `protobuf:"varint,6,opt,name=depth,proto3" json:"depth,omitempty"`
+	TimeUnixMilliseconds int64                                    `protobuf:"varint,7,opt,name=time_unix_milliseconds,json=timeUnixMilliseconds,proto3" json:"time_unix_milliseconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *Reorg) Reset()         { *m = Reorg{} }
+func (m *Reorg) String() string { return proto.CompactTextString(m) }
+func (*Reorg) ProtoMessage()    {}
+func (*Reorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{22}
+}
+func (m *Reorg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reorg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBeaconState(ctx, in)
+}
+func (m *Reorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reorg.Merge(m, src)
+}
+func (m *Reorg) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reorg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reorg proto.InternalMessageInfo
+
+func (m *Reorg) GetOldSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.OldSlot
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
+	return 0
+}
+
+func (m *Reorg) GetOldRoot() []byte {
+	if m != nil {
+		return m.OldRoot
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+	return nil
+}
+
+func (m *Reorg) GetNewSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.NewSlot
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Reorg) GetNewRoot() []byte {
+	if m != nil {
+		return m.NewRoot
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBlock(ctx, in)
+	return nil
+}
+
+func (m *Reorg) GetCommonAncestor() []byte {
+	if m != nil {
+		return m.CommonAncestor
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
+	return nil
+}
+
+func (m *Reorg) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
+	return 0
+}
+
+func (m *Reorg) GetTimeUnixMilliseconds() int64 {
+	if m != nil {
+		return m.TimeUnixMilliseconds
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoggingLevelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).SetLoggingLevel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type CachedStatesResponse struct {
+	States               []*CachedState `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*empty.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *CachedStatesResponse) Reset()         { *m = CachedStatesResponse{} }
+func (m *CachedStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*CachedStatesResponse) ProtoMessage()    {}
+func (*CachedStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{23}
 }
-
-func _Debug_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).ListPeers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).ListPeers(ctx, req.(*empty.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *CachedStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Debug_GetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.PeerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetPeer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetPeer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetPeer(ctx, req.(*v1alpha1.PeerRequest))
+func (m *CachedStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CachedStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CachedStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CachedStatesResponse.Merge(m, src)
+}
+func (m *CachedStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CachedStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CachedStatesResponse.DiscardUnknown(m)
 }
 
-func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InclusionSlotRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetInclusionSlot(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetInclusionSlot(ctx, req.(*InclusionSlotRequest))
+var xxx_messageInfo_CachedStatesResponse proto.InternalMessageInfo
+
+func (m *CachedStatesResponse) GetStates() []*CachedState {
+	if m != nil {
+		return m.States
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Debug_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.Debug",
-	HandlerType: (*DebugServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetBeaconState",
-			Handler:    _Debug_GetBeaconState_Handler,
-		},
-		{
-			MethodName: "GetBlock",
-			Handler:    _Debug_GetBlock_Handler,
-		},
-		{
-			MethodName: "SetLoggingLevel",
-			Handler:    _Debug_SetLoggingLevel_Handler,
-		},
-		{
-			MethodName: "GetProtoArrayForkChoice",
-			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
-		},
-		{
-			MethodName: "ListPeers",
-			Handler:    _Debug_ListPeers_Handler,
-		},
-		{
-			MethodName: "GetPeer",
-			Handler:    _Debug_GetPeer_Handler,
-		},
-		{
-			MethodName: "GetInclusionSlot",
-			Handler:    _Debug_GetInclusionSlot_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/debug.proto",
+type CachedState struct {
+	Cache                string                                   `protobuf:"bytes,1,opt,name=cache,proto3" json:"cache,omitempty"`
+	BlockRoot            []byte                                   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,3,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
 }
 
-func (m *InclusionSlotRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CachedState) Reset()         { *m = CachedState{} }
+func (m *CachedState) String() string { return proto.CompactTextString(m) }
+func (*CachedState) ProtoMessage()    {}
+func (*CachedState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{24}
+}
+func (m *CachedState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CachedState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CachedState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *InclusionSlotRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CachedState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CachedState.Merge(m, src)
+}
+func (m *CachedState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CachedState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CachedState.DiscardUnknown(m)
 }
 
-func (m *InclusionSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_CachedState proto.InternalMessageInfo
+
+func (m *CachedState) GetCache() string {
+	if m != nil {
+		return m.Cache
 	}
-	if m.Id != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *CachedState) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *InclusionSlotResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CachedState) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *InclusionSlotResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PruneForkChoiceResponse struct {
+	PrunedNodes          uint64   `protobuf:"varint,1,opt,name=pruned_nodes,json=prunedNodes,proto3" json:"pruned_nodes,omitempty"`
+	NodeCount            uint64   `protobuf:"varint,2,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InclusionSlotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *PruneForkChoiceResponse) Reset()         { *m = PruneForkChoiceResponse{} }
+func (m *PruneForkChoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*PruneForkChoiceResponse) ProtoMessage()    {}
+func (*PruneForkChoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{25}
+}
+func (m *PruneForkChoiceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruneForkChoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruneForkChoiceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *PruneForkChoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneForkChoiceResponse.Merge(m, src)
+}
+func (m *PruneForkChoiceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruneForkChoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneForkChoiceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneForkChoiceResponse proto.InternalMessageInfo
+
+func (m *PruneForkChoiceResponse) GetPrunedNodes() uint64 {
+	if m != nil {
+		return m.PrunedNodes
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PruneForkChoiceResponse) GetNodeCount() uint64 {
+	if m != nil {
+		return m.NodeCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SimulateHeadRequest struct {
+	MissedSlots          github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=missed_slots,json=missedSlots,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"missed_slots,omitempty"`
+	BranchRoot           []byte                                   `protobuf:"bytes,2,opt,name=branch_root,json=branchRoot,proto3" json:"branch_root,omitempty"`
+	BranchAttestations   uint64                                   `protobuf:"varint,3,opt,name=branch_attestations,json=branchAttestations,proto3" json:"branch_attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
 }
 
-func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.QueryFilter != nil {
-		{
-			size := m.QueryFilter.Size()
-			i -= size
-			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *SimulateHeadRequest) Reset()         { *m = SimulateHeadRequest{} }
+func (m *SimulateHeadRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateHeadRequest) ProtoMessage()    {}
+func (*SimulateHeadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{26}
+}
+func (m *SimulateHeadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateHeadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateHeadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SimulateHeadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateHeadRequest.Merge(m, src)
 }
-
-func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+func (m *SimulateHeadRequest) XXX_Size() int {
+	return m.Size()
 }
-func (m *BeaconStateRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SimulateHeadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateHeadRequest.DiscardUnknown(m)
 }
 
-func (m *BeaconStateRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BlockRoot != nil {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_SimulateHeadRequest proto.InternalMessageInfo
+
+func (m *SimulateHeadRequest) GetMissedSlots() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.MissedSlots
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *SimulateHeadRequest) GetBranchRoot() []byte {
+	if m != nil {
+		return m.BranchRoot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SimulateHeadRequest) GetBranchAttestations() uint64 {
+	if m != nil {
+		return m.BranchAttestations
+	}
+	return 0
 }
 
-func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.BlockRoot) > 0 {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type SimulateHeadResponse struct {
+	HeadSlot             github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=head_slot,json=headSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"head_slot,omitempty"`
+	HeadRoot             []byte                                   `protobuf:"bytes,2,opt,name=head_root,json=headRoot,proto3" json:"head_root,omitempty"`
+	SimulatedHeadSlot    github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,3,opt,name=simulated_head_slot,json=simulatedHeadSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"simulated_head_slot,omitempty"`
+	SimulatedHeadRoot    []byte                                   `protobuf:"bytes,4,opt,name=simulated_head_root,json=simulatedHeadRoot,proto3" json:"simulated_head_root,omitempty"`
+	SimulatedHeadWeight  uint64                                   `protobuf:"varint,5,opt,name=simulated_head_weight,json=simulatedHeadWeight,proto3" json:"simulated_head_weight,omitempty"`
+	Reorg                bool                                     `protobuf:"varint,6,opt,name=reorg,proto3" json:"reorg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
 }
 
-func (m *SSZResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SimulateHeadResponse) Reset()         { *m = SimulateHeadResponse{} }
+func (m *SimulateHeadResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateHeadResponse) ProtoMessage()    {}
+func (*SimulateHeadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{27}
+}
+func (m *SimulateHeadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateHeadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateHeadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *SSZResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SimulateHeadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateHeadResponse.Merge(m, src)
+}
+func (m *SimulateHeadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateHeadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateHeadResponse.DiscardUnknown(m)
 }
 
-func (m *SSZResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Encoded) > 0 {
-		i -= len(m.Encoded)
-		copy(dAtA[i:], m.Encoded)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_SimulateHeadResponse proto.InternalMessageInfo
+
+func (m *SimulateHeadResponse) GetHeadSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.HeadSlot
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *LoggingLevelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SimulateHeadResponse) GetHeadRoot() []byte {
+	if m != nil {
+		return m.HeadRoot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LoggingLevelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SimulateHeadResponse) GetSimulatedHeadSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.SimulatedHeadSlot
+	}
+	return 0
 }
 
-func (m *LoggingLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SimulateHeadResponse) GetSimulatedHeadRoot() []byte {
+	if m != nil {
+		return m.SimulatedHeadRoot
 	}
-	if m.Level != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Level))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *SimulateHeadResponse) GetSimulatedHeadWeight() uint64 {
+	if m != nil {
+		return m.SimulatedHeadWeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProtoArrayForkChoiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SimulateHeadResponse) GetReorg() bool {
+	if m != nil {
+		return m.Reorg
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ProtoArrayForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetHeadRequest struct {
+	BlockRoot            []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ProtoArrayForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Indices) > 0 {
-		for k := range m.Indices {
-			v := m.Indices[k]
-			baseI := i
-			i = encodeVarintDebug(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ProtoArrayNodes) > 0 {
-		for iNdEx := len(m.ProtoArrayNodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProtoArrayNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDebug(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *SetHeadRequest) Reset()         { *m = SetHeadRequest{} }
+func (m *SetHeadRequest) String() string { return proto.CompactTextString(m) }
+func (*SetHeadRequest) ProtoMessage()    {}
+func (*SetHeadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{28}
+}
+func (m *SetHeadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetHeadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetHeadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.FinalizedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.JustifiedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PruneThreshold != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.PruneThreshold))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *SetHeadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetHeadRequest.Merge(m, src)
+}
+func (m *SetHeadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetHeadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetHeadRequest.DiscardUnknown(m)
 }
 
-func (m *ProtoArrayNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetHeadRequest proto.InternalMessageInfo
+
+func (m *SetHeadRequest) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ProtoArrayNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+type HeadResponse struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	BlockRoot            []byte                                   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
 
-func (m *ProtoArrayNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.BestDescendant != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.BestDescendant))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.BestChild != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.BestChild))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Weight != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Weight))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.FinalizedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
-		i--
-		dAtA[i] = 0x28
+func (m *HeadResponse) Reset()         { *m = HeadResponse{} }
+func (m *HeadResponse) String() string { return proto.CompactTextString(m) }
+func (*HeadResponse) ProtoMessage()    {}
+func (*HeadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{29}
+}
+func (m *HeadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.JustifiedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *HeadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadResponse.Merge(m, src)
+}
+func (m *HeadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeadResponse proto.InternalMessageInfo
+
+func (m *HeadResponse) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
 	}
-	if m.Parent != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Parent))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *HeadResponse) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
 	}
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+type InclusionSlotRequest struct {
+	Id                   uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *InclusionSlotRequest) Reset()         { *m = InclusionSlotRequest{} }
+func (m *InclusionSlotRequest) String() string { return proto.CompactTextString(m) }
+func (*InclusionSlotRequest) ProtoMessage()    {}
+func (*InclusionSlotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{30}
+}
+func (m *InclusionSlotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InclusionSlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InclusionSlotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *InclusionSlotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InclusionSlotRequest.Merge(m, src)
+}
+func (m *InclusionSlotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InclusionSlotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InclusionSlotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InclusionSlotRequest proto.InternalMessageInfo
+
+func (m *InclusionSlotRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DebugPeerResponses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InclusionSlotRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DebugPeerResponses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type InclusionSlotResponse struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
 }
 
-func (m *DebugPeerResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Responses) > 0 {
-		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDebug(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *InclusionSlotResponse) Reset()         { *m = InclusionSlotResponse{} }
+func (m *InclusionSlotResponse) String() string { return proto.CompactTextString(m) }
+func (*InclusionSlotResponse) ProtoMessage()    {}
+func (*InclusionSlotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{31}
+}
+func (m *InclusionSlotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InclusionSlotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InclusionSlotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *InclusionSlotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InclusionSlotResponse.Merge(m, src)
+}
+func (m *InclusionSlotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InclusionSlotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InclusionSlotResponse.DiscardUnknown(m)
 }
 
-func (m *DebugPeerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InclusionSlotResponse proto.InternalMessageInfo
+
+func (m *InclusionSlotResponse) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DebugPeerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BeaconStateRequest struct {
+	// Types that are valid to be assigned to QueryFilter:
+	//	*BeaconStateRequest_Slot
+	//	*BeaconStateRequest_BlockRoot
+	QueryFilter          isBeaconStateRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
-func (m *DebugPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ScoreInfo != nil {
-		{
-			size, err := m.ScoreInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
+func (m *BeaconStateRequest) Reset()         { *m = BeaconStateRequest{} }
+func (m *BeaconStateRequest) String() string { return proto.CompactTextString(m) }
+func (*BeaconStateRequest) ProtoMessage()    {}
+func (*BeaconStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{32}
+}
+func (m *BeaconStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.LastUpdated != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x40
+		return b[:n], nil
 	}
-	if m.PeerStatus != nil {
-		{
-			size, err := m.PeerStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.PeerInfo != nil {
-		{
-			size, err := m.PeerInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Enr) > 0 {
-		i -= len(m.Enr)
-		copy(dAtA[i:], m.Enr)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Enr)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PeerId) > 0 {
-		i -= len(m.PeerId)
-		copy(dAtA[i:], m.PeerId)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ConnectionState != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.ConnectionState))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Direction != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Direction))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ListeningAddresses) > 0 {
-		for iNdEx := len(m.ListeningAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ListeningAddresses[iNdEx])
-			copy(dAtA[i:], m.ListeningAddresses[iNdEx])
-			i = encodeVarintDebug(dAtA, i, uint64(len(m.ListeningAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+}
+func (m *BeaconStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconStateRequest.Merge(m, src)
+}
+func (m *BeaconStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconStateRequest.DiscardUnknown(m)
 }
 
-func (m *DebugPeerResponse_PeerInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_BeaconStateRequest proto.InternalMessageInfo
+
+type isBeaconStateRequest_QueryFilter interface {
+	isBeaconStateRequest_QueryFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *DebugPeerResponse_PeerInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BeaconStateRequest_Slot struct {
+	Slot github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,1,opt,name=slot,proto3,oneof,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+}
+type BeaconStateRequest_BlockRoot struct {
+	BlockRoot []byte `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3,oneof" json:"block_root,omitempty"`
 }
 
-func (m *DebugPeerResponse_PeerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.PeerLatency != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.PeerLatency))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.AgentVersion) > 0 {
-		i -= len(m.AgentVersion)
-		copy(dAtA[i:], m.AgentVersion)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.AgentVersion)))
-		i--
-		dAtA[i] = 0x2a
+func (*BeaconStateRequest_Slot) isBeaconStateRequest_QueryFilter()      {}
+func (*BeaconStateRequest_BlockRoot) isBeaconStateRequest_QueryFilter() {}
+
+func (m *BeaconStateRequest) GetQueryFilter() isBeaconStateRequest_QueryFilter {
+	if m != nil {
+		return m.QueryFilter
 	}
-	if len(m.ProtocolVersion) > 0 {
-		i -= len(m.ProtocolVersion)
-		copy(dAtA[i:], m.ProtocolVersion)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.ProtocolVersion)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *BeaconStateRequest) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if x, ok := m.GetQueryFilter().(*BeaconStateRequest_Slot); ok {
+		return x.Slot
 	}
-	if m.FaultCount != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FaultCount))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *BeaconStateRequest) GetBlockRoot() []byte {
+	if x, ok := m.GetQueryFilter().(*BeaconStateRequest_BlockRoot); ok {
+		return x.BlockRoot
 	}
-	if len(m.Protocols) > 0 {
-		for iNdEx := len(m.Protocols) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Protocols[iNdEx])
-			copy(dAtA[i:], m.Protocols[iNdEx])
-			i = encodeVarintDebug(dAtA, i, uint64(len(m.Protocols[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BeaconStateRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BeaconStateRequest_Slot)(nil),
+		(*BeaconStateRequest_BlockRoot)(nil),
 	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
+}
+
+type BlockRequest struct {
+	BlockRoot            []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
+func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockRequest) ProtoMessage()    {}
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{33}
+}
+func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRequest.Merge(m, src)
+}
+func (m *BlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRequest.DiscardUnknown(m)
 }
 
-func (m *ScoreInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BlockRequest proto.InternalMessageInfo
+
+func (m *BlockRequest) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ScoreInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SSZResponse struct {
+	Encoded              []byte   `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ScoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ValidationError) > 0 {
-		i -= len(m.ValidationError)
-		copy(dAtA[i:], m.ValidationError)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.ValidationError)))
-		i--
-		dAtA[i] = 0x3a
+func (m *SSZResponse) Reset()         { *m = SSZResponse{} }
+func (m *SSZResponse) String() string { return proto.CompactTextString(m) }
+func (*SSZResponse) ProtoMessage()    {}
+func (*SSZResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{34}
+}
+func (m *SSZResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSZResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSZResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BehaviourPenalty != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BehaviourPenalty))))
-		i--
-		dAtA[i] = 0x35
-	}
-	if m.GossipScore != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GossipScore))))
-		i--
-		dAtA[i] = 0x2d
+}
+func (m *SSZResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSZResponse.Merge(m, src)
+}
+func (m *SSZResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSZResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSZResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSZResponse proto.InternalMessageInfo
+
+func (m *SSZResponse) GetEncoded() []byte {
+	if m != nil {
+		return m.Encoded
 	}
-	if len(m.TopicScores) > 0 {
-		for k := range m.TopicScores {
-			v := m.TopicScores[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintDebug(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
+	return nil
+}
+
+type LoggingLevelRequest struct {
+	Level                LoggingLevelRequest_Level `protobuf:"varint,1,opt,name=level,proto3,enum=ethereum.beacon.rpc.v1.LoggingLevelRequest_Level" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *LoggingLevelRequest) Reset()         { *m = LoggingLevelRequest{} }
+func (m *LoggingLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*LoggingLevelRequest) ProtoMessage()    {}
+func (*LoggingLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{35}
+}
+func (m *LoggingLevelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LoggingLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LoggingLevelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BlockProviderScore != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BlockProviderScore))))
-		i--
-		dAtA[i] = 0x1d
-	}
-	if m.ProcessedBlocks != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.ProcessedBlocks))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.OverallScore != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OverallScore))))
-		i--
-		dAtA[i] = 0xd
-	}
-	return len(dAtA) - i, nil
+}
+func (m *LoggingLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoggingLevelRequest.Merge(m, src)
+}
+func (m *LoggingLevelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LoggingLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoggingLevelRequest.DiscardUnknown(m)
 }
 
-func (m *TopicScoreSnapshot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LoggingLevelRequest proto.InternalMessageInfo
+
+func (m *LoggingLevelRequest) GetLevel() LoggingLevelRequest_Level {
+	if m != nil {
+		return m.Level
 	}
-	return dAtA[:n], nil
+	return LoggingLevelRequest_INFO
 }
 
-func (m *TopicScoreSnapshot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ProtoArrayForkChoiceResponse struct {
+	PruneThreshold       uint64                                    `protobuf:"varint,1,opt,name=prune_threshold,json=pruneThreshold,proto3" json:"prune_threshold,omitempty"`
+	JustifiedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,2,opt,name=justified_epoch,json=justifiedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"justified_epoch,omitempty"`
+	FinalizedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,3,opt,name=finalized_epoch,json=finalizedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"finalized_epoch,omitempty"`
+	ProtoArrayNodes      []*ProtoArrayNode                         `protobuf:"bytes,4,rep,name=proto_array_nodes,json=protoArrayNodes,proto3" json:"proto_array_nodes,omitempty"`
+	Indices              map[string]uint64                         `protobuf:"bytes,5,rep,name=indices,proto3" json:"indices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
 
-func (m *TopicScoreSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.InvalidMessageDeliveries != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.InvalidMessageDeliveries))))
-		i--
-		dAtA[i] = 0x25
-	}
-	if m.MeshMessageDeliveries != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.MeshMessageDeliveries))))
-		i--
-		dAtA[i] = 0x1d
-	}
-	if m.FirstMessageDeliveries != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FirstMessageDeliveries))))
-		i--
-		dAtA[i] = 0x15
-	}
-	if m.TimeInMesh != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.TimeInMesh))
-		i--
-		dAtA[i] = 0x8
+func (m *ProtoArrayForkChoiceResponse) Reset()         { *m = ProtoArrayForkChoiceResponse{} }
+func (m *ProtoArrayForkChoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ProtoArrayForkChoiceResponse) ProtoMessage()    {}
+func (*ProtoArrayForkChoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{36}
+}
+func (m *ProtoArrayForkChoiceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtoArrayForkChoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtoArrayForkChoiceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ProtoArrayForkChoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoArrayForkChoiceResponse.Merge(m, src)
+}
+func (m *ProtoArrayForkChoiceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtoArrayForkChoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoArrayForkChoiceResponse.DiscardUnknown(m)
 }
 
-func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDebug(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ProtoArrayForkChoiceResponse proto.InternalMessageInfo
+
+func (m *ProtoArrayForkChoiceResponse) GetPruneThreshold() uint64 {
+	if m != nil {
+		return m.PruneThreshold
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *InclusionSlotRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovDebug(uint64(m.Id))
-	}
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *ProtoArrayForkChoiceResponse) GetJustifiedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.JustifiedEpoch
 	}
-	return n
+	return 0
 }
 
-func (m *InclusionSlotResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ProtoArrayForkChoiceResponse) GetFinalizedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FinalizedEpoch
 	}
-	return n
+	return 0
 }
 
-func (m *BeaconStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
+func (m *ProtoArrayForkChoiceResponse) GetProtoArrayNodes() []*ProtoArrayNode {
+	if m != nil {
+		return m.ProtoArrayNodes
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (m *ProtoArrayForkChoiceResponse) GetIndices() map[string]uint64 {
+	if m != nil {
+		return m.Indices
 	}
-	return n
+	return nil
 }
 
-func (m *BeaconStateRequest_Slot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovDebug(uint64(m.Slot))
-	return n
+type ProtoArrayNode struct {
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,1,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	Root                 []byte                                    `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Parent               uint64                                    `protobuf:"varint,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	JustifiedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,4,opt,name=justified_epoch,json=justifiedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"justified_epoch,omitempty"`
+	FinalizedEpoch       github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,5,opt,name=finalized_epoch,json=finalizedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"finalized_epoch,omitempty"`
+	Weight               uint64                                    `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	BestChild            uint64                                    `protobuf:"varint,7,opt,name=best_child,json=bestChild,proto3" json:"best_child,omitempty"`
+	BestDescendant       uint64                                    `protobuf:"varint,8,opt,name=best_descendant,json=bestDescendant,proto3" json:"best_descendant,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
-func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockRoot != nil {
-		l = len(m.BlockRoot)
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	return n
+
+func (m *ProtoArrayNode) Reset()         { *m = ProtoArrayNode{} }
+func (m *ProtoArrayNode) String() string { return proto.CompactTextString(m) }
+func (*ProtoArrayNode) ProtoMessage()    {}
+func (*ProtoArrayNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{37}
 }
-func (m *BlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ProtoArrayNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtoArrayNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtoArrayNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ProtoArrayNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoArrayNode.Merge(m, src)
+}
+func (m *ProtoArrayNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtoArrayNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoArrayNode.DiscardUnknown(m)
 }
 
-func (m *SSZResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Encoded)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_ProtoArrayNode proto.InternalMessageInfo
+
+func (m *ProtoArrayNode) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
 	}
-	return n
+	return 0
 }
 
-func (m *LoggingLevelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Level != 0 {
-		n += 1 + sovDebug(uint64(m.Level))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ProtoArrayNode) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	return n
+	return nil
 }
 
-func (m *ProtoArrayForkChoiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PruneThreshold != 0 {
-		n += 1 + sovDebug(uint64(m.PruneThreshold))
-	}
-	if m.JustifiedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
-	}
-	if m.FinalizedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
-	}
-	if len(m.ProtoArrayNodes) > 0 {
-		for _, e := range m.ProtoArrayNodes {
-			l = e.Size()
-			n += 1 + l + sovDebug(uint64(l))
-		}
-	}
-	if len(m.Indices) > 0 {
-		for k, v := range m.Indices {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + 1 + sovDebug(uint64(v))
-			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ProtoArrayNode) GetParent() uint64 {
+	if m != nil {
+		return m.Parent
 	}
-	return n
+	return 0
 }
 
-func (m *ProtoArrayNode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
-	}
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.Parent != 0 {
-		n += 1 + sovDebug(uint64(m.Parent))
-	}
-	if m.JustifiedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
-	}
-	if m.FinalizedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
-	}
-	if m.Weight != 0 {
-		n += 1 + sovDebug(uint64(m.Weight))
+func (m *ProtoArrayNode) GetJustifiedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.JustifiedEpoch
 	}
-	if m.BestChild != 0 {
-		n += 1 + sovDebug(uint64(m.BestChild))
+	return 0
+}
+
+func (m *ProtoArrayNode) GetFinalizedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FinalizedEpoch
 	}
-	if m.BestDescendant != 0 {
-		n += 1 + sovDebug(uint64(m.BestDescendant))
+	return 0
+}
+
+func (m *ProtoArrayNode) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *ProtoArrayNode) GetBestChild() uint64 {
+	if m != nil {
+		return m.BestChild
 	}
-	return n
+	return 0
 }
 
-func (m *DebugPeerResponses) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProtoArrayNode) GetBestDescendant() uint64 {
+	if m != nil {
+		return m.BestDescendant
 	}
-	var l int
-	_ = l
-	if len(m.Responses) > 0 {
-		for _, e := range m.Responses {
-			l = e.Size()
-			n += 1 + l + sovDebug(uint64(l))
+	return 0
+}
+
+type DebugPeerResponses struct {
+	Responses            []*DebugPeerResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DebugPeerResponses) Reset()         { *m = DebugPeerResponses{} }
+func (m *DebugPeerResponses) String() string { return proto.CompactTextString(m) }
+func (*DebugPeerResponses) ProtoMessage()    {}
+func (*DebugPeerResponses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{38}
+}
+func (m *DebugPeerResponses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DebugPeerResponses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DebugPeerResponses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *DebugPeerResponses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugPeerResponses.Merge(m, src)
+}
+func (m *DebugPeerResponses) XXX_Size() int {
+	return m.Size()
+}
+func (m *DebugPeerResponses) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugPeerResponses.DiscardUnknown(m)
 }
 
-func (m *DebugPeerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_DebugPeerResponses proto.InternalMessageInfo
+
+func (m *DebugPeerResponses) GetResponses() []*DebugPeerResponse {
+	if m != nil {
+		return m.Responses
 	}
-	var l int
-	_ = l
-	if len(m.ListeningAddresses) > 0 {
-		for _, s := range m.ListeningAddresses {
-			l = len(s)
-			n += 1 + l + sovDebug(uint64(l))
+	return nil
+}
+
+type DebugPeerResponse struct {
+	ListeningAddresses   []string                    `protobuf:"bytes,1,rep,name=listening_addresses,json=listeningAddresses,proto3" json:"listening_addresses,omitempty"`
+	Direction            v1alpha1.PeerDirection      `protobuf:"varint,2,opt,name=direction,proto3,enum=ethereum.eth.v1alpha1.PeerDirection" json:"direction,omitempty"`
+	ConnectionState      v1alpha1.ConnectionState    `protobuf:"varint,3,opt,name=connection_state,json=connectionState,proto3,enum=ethereum.eth.v1alpha1.ConnectionState" json:"connection_state,omitempty"`
+	PeerId               string                      `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Enr                  string                      `protobuf:"bytes,5,opt,name=enr,proto3" json:"enr,omitempty"`
+	PeerInfo             *DebugPeerResponse_PeerInfo `protobuf:"bytes,6,opt,name=peer_info,json=peerInfo,proto3" json:"peer_info,omitempty"`
+	PeerStatus           *v1.Status                  `protobuf:"bytes,7,opt,name=peer_status,json=peerStatus,proto3" json:"peer_status,omitempty"`
+	LastUpdated          uint64                      `protobuf:"varint,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	ScoreInfo            *ScoreInfo                  `protobuf:"bytes,9,opt,name=score_info,json=scoreInfo,proto3" json:"score_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *DebugPeerResponse) Reset()         { *m = DebugPeerResponse{} }
+func (m *DebugPeerResponse) String() string { return proto.CompactTextString(m) }
+func (*DebugPeerResponse) ProtoMessage()    {}
+func (*DebugPeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{39}
+}
+func (m *DebugPeerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DebugPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DebugPeerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Direction != 0 {
-		n += 1 + sovDebug(uint64(m.Direction))
-	}
-	if m.ConnectionState != 0 {
-		n += 1 + sovDebug(uint64(m.ConnectionState))
-	}
-	l = len(m.PeerId)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
+}
+func (m *DebugPeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugPeerResponse.Merge(m, src)
+}
+func (m *DebugPeerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DebugPeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugPeerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DebugPeerResponse proto.InternalMessageInfo
+
+func (m *DebugPeerResponse) GetListeningAddresses() []string {
+	if m != nil {
+		return m.ListeningAddresses
 	}
-	l = len(m.Enr)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
+	return nil
+}
+
+func (m *DebugPeerResponse) GetDirection() v1alpha1.PeerDirection {
+	if m != nil {
+		return m.Direction
 	}
-	if m.PeerInfo != nil {
-		l = m.PeerInfo.Size()
-		n += 1 + l + sovDebug(uint64(l))
+	return v1alpha1.PeerDirection_UNKNOWN
+}
+
+func (m *DebugPeerResponse) GetConnectionState() v1alpha1.ConnectionState {
+	if m != nil {
+		return m.ConnectionState
 	}
-	if m.PeerStatus != nil {
-		l = m.PeerStatus.Size()
-		n += 1 + l + sovDebug(uint64(l))
+	return v1alpha1.ConnectionState_DISCONNECTED
+}
+
+func (m *DebugPeerResponse) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	if m.LastUpdated != 0 {
-		n += 1 + sovDebug(uint64(m.LastUpdated))
+	return ""
+}
+
+func (m *DebugPeerResponse) GetEnr() string {
+	if m != nil {
+		return m.Enr
 	}
-	if m.ScoreInfo != nil {
-		l = m.ScoreInfo.Size()
-		n += 1 + l + sovDebug(uint64(l))
+	return ""
+}
+
+func (m *DebugPeerResponse) GetPeerInfo() *DebugPeerResponse_PeerInfo {
+	if m != nil {
+		return m.PeerInfo
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (m *DebugPeerResponse) GetPeerStatus() *v1.Status {
+	if m != nil {
+		return m.PeerStatus
 	}
-	return n
+	return nil
 }
 
-func (m *DebugPeerResponse_PeerInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DebugPeerResponse) GetLastUpdated() uint64 {
+	if m != nil {
+		return m.LastUpdated
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDebug(uint64(l))
+	return 0
+}
+
+func (m *DebugPeerResponse) GetScoreInfo() *ScoreInfo {
+	if m != nil {
+		return m.ScoreInfo
 	}
-	if len(m.Protocols) > 0 {
-		for _, s := range m.Protocols {
-			l = len(s)
-			n += 1 + l + sovDebug(uint64(l))
+	return nil
+}
+
+type DebugPeerResponse_PeerInfo struct {
+	Metadata             *v1.MetaData `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Protocols            []string     `protobuf:"bytes,2,rep,name=protocols,proto3" json:"protocols,omitempty"`
+	FaultCount           uint64       `protobuf:"varint,3,opt,name=fault_count,json=faultCount,proto3" json:"fault_count,omitempty"`
+	ProtocolVersion      string       `protobuf:"bytes,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	AgentVersion         string       `protobuf:"bytes,5,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
+	PeerLatency          uint64       `protobuf:"varint,6,opt,name=peer_latency,json=peerLatency,proto3" json:"peer_latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *DebugPeerResponse_PeerInfo) Reset()         { *m = DebugPeerResponse_PeerInfo{} }
+func (m *DebugPeerResponse_PeerInfo) String() string { return proto.CompactTextString(m) }
+func (*DebugPeerResponse_PeerInfo) ProtoMessage()    {}
+func (*DebugPeerResponse_PeerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{39, 0}
+}
+func (m *DebugPeerResponse_PeerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DebugPeerResponse_PeerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DebugPeerResponse_PeerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.FaultCount != 0 {
-		n += 1 + sovDebug(uint64(m.FaultCount))
-	}
-	l = len(m.ProtocolVersion)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	l = len(m.AgentVersion)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.PeerLatency != 0 {
-		n += 1 + sovDebug(uint64(m.PeerLatency))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *DebugPeerResponse_PeerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugPeerResponse_PeerInfo.Merge(m, src)
+}
+func (m *DebugPeerResponse_PeerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DebugPeerResponse_PeerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugPeerResponse_PeerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DebugPeerResponse_PeerInfo proto.InternalMessageInfo
+
+func (m *DebugPeerResponse_PeerInfo) GetMetadata() *v1.MetaData {
+	if m != nil {
+		return m.Metadata
 	}
-	return n
+	return nil
 }
 
-func (m *ScoreInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DebugPeerResponse_PeerInfo) GetProtocols() []string {
+	if m != nil {
+		return m.Protocols
 	}
-	var l int
-	_ = l
-	if m.OverallScore != 0 {
-		n += 5
+	return nil
+}
+
+func (m *DebugPeerResponse_PeerInfo) GetFaultCount() uint64 {
+	if m != nil {
+		return m.FaultCount
 	}
-	if m.ProcessedBlocks != 0 {
-		n += 1 + sovDebug(uint64(m.ProcessedBlocks))
+	return 0
+}
+
+func (m *DebugPeerResponse_PeerInfo) GetProtocolVersion() string {
+	if m != nil {
+		return m.ProtocolVersion
 	}
-	if m.BlockProviderScore != 0 {
-		n += 5
+	return ""
+}
+
+func (m *DebugPeerResponse_PeerInfo) GetAgentVersion() string {
+	if m != nil {
+		return m.AgentVersion
 	}
-	if len(m.TopicScores) > 0 {
-		for k, v := range m.TopicScores {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovDebug(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
-		}
+	return ""
+}
+
+func (m *DebugPeerResponse_PeerInfo) GetPeerLatency() uint64 {
+	if m != nil {
+		return m.PeerLatency
 	}
-	if m.GossipScore != 0 {
-		n += 5
+	return 0
+}
+
+type ScoreInfo struct {
+	OverallScore         float32                        `protobuf:"fixed32,1,opt,name=overall_score,json=overallScore,proto3" json:"overall_score,omitempty"`
+	ProcessedBlocks      uint64                         `protobuf:"varint,2,opt,name=processed_blocks,json=processedBlocks,proto3" json:"processed_blocks,omitempty"`
+	BlockProviderScore   float32                        `protobuf:"fixed32,3,opt,name=block_provider_score,json=blockProviderScore,proto3" json:"block_provider_score,omitempty"`
+	TopicScores          map[string]*TopicScoreSnapshot `protobuf:"bytes,4,rep,name=topic_scores,json=topicScores,proto3" json:"topic_scores,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	GossipScore          float32                        `protobuf:"fixed32,5,opt,name=gossip_score,json=gossipScore,proto3" json:"gossip_score,omitempty"`
+	BehaviourPenalty     float32                        `protobuf:"fixed32,6,opt,name=behaviour_penalty,json=behaviourPenalty,proto3" json:"behaviour_penalty,omitempty"`
+	ValidationError      string                         `protobuf:"bytes,7,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ScoreInfo) Reset()         { *m = ScoreInfo{} }
+func (m *ScoreInfo) String() string { return proto.CompactTextString(m) }
+func (*ScoreInfo) ProtoMessage()    {}
+func (*ScoreInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{40}
+}
+func (m *ScoreInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoreInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScoreInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BehaviourPenalty != 0 {
-		n += 5
+}
+func (m *ScoreInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreInfo.Merge(m, src)
+}
+func (m *ScoreInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoreInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreInfo proto.InternalMessageInfo
+
+func (m *ScoreInfo) GetOverallScore() float32 {
+	if m != nil {
+		return m.OverallScore
 	}
-	l = len(m.ValidationError)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
+	return 0
+}
+
+func (m *ScoreInfo) GetProcessedBlocks() uint64 {
+	if m != nil {
+		return m.ProcessedBlocks
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *ScoreInfo) GetBlockProviderScore() float32 {
+	if m != nil {
+		return m.BlockProviderScore
 	}
-	return n
+	return 0
 }
 
-func (m *TopicScoreSnapshot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScoreInfo) GetTopicScores() map[string]*TopicScoreSnapshot {
+	if m != nil {
+		return m.TopicScores
 	}
-	var l int
-	_ = l
-	if m.TimeInMesh != 0 {
-		n += 1 + sovDebug(uint64(m.TimeInMesh))
+	return nil
+}
+
+func (m *ScoreInfo) GetGossipScore() float32 {
+	if m != nil {
+		return m.GossipScore
 	}
-	if m.FirstMessageDeliveries != 0 {
-		n += 5
+	return 0
+}
+
+func (m *ScoreInfo) GetBehaviourPenalty() float32 {
+	if m != nil {
+		return m.BehaviourPenalty
 	}
-	if m.MeshMessageDeliveries != 0 {
-		n += 5
+	return 0
+}
+
+func (m *ScoreInfo) GetValidationError() string {
+	if m != nil {
+		return m.ValidationError
 	}
-	if m.InvalidMessageDeliveries != 0 {
-		n += 5
+	return ""
+}
+
+type TopicScoreSnapshot struct {
+	TimeInMesh               uint64   `protobuf:"varint,1,opt,name=time_in_mesh,json=timeInMesh,proto3" json:"time_in_mesh,omitempty"`
+	FirstMessageDeliveries   float32  `protobuf:"fixed32,2,opt,name=first_message_deliveries,json=firstMessageDeliveries,proto3" json:"first_message_deliveries,omitempty"`
+	MeshMessageDeliveries    float32  `protobuf:"fixed32,3,opt,name=mesh_message_deliveries,json=meshMessageDeliveries,proto3" json:"mesh_message_deliveries,omitempty"`
+	InvalidMessageDeliveries float32  `protobuf:"fixed32,4,opt,name=invalid_message_deliveries,json=invalidMessageDeliveries,proto3" json:"invalid_message_deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *TopicScoreSnapshot) Reset()         { *m = TopicScoreSnapshot{} }
+func (m *TopicScoreSnapshot) String() string { return proto.CompactTextString(m) }
+func (*TopicScoreSnapshot) ProtoMessage()    {}
+func (*TopicScoreSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{41}
+}
+func (m *TopicScoreSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicScoreSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicScoreSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *TopicScoreSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicScoreSnapshot.Merge(m, src)
+}
+func (m *TopicScoreSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopicScoreSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicScoreSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicScoreSnapshot proto.InternalMessageInfo
+
+func (m *TopicScoreSnapshot) GetTimeInMesh() uint64 {
+	if m != nil {
+		return m.TimeInMesh
 	}
-	return n
+	return 0
 }
 
-func sovDebug(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *TopicScoreSnapshot) GetFirstMessageDeliveries() float32 {
+	if m != nil {
+		return m.FirstMessageDeliveries
+	}
+	return 0
 }
-func sozDebug(x uint64) (n int) {
-	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *TopicScoreSnapshot) GetMeshMessageDeliveries() float32 {
+	if m != nil {
+		return m.MeshMessageDeliveries
+	}
+	return 0
+}
+
+func (m *TopicScoreSnapshot) GetInvalidMessageDeliveries() float32 {
+	if m != nil {
+		return m.InvalidMessageDeliveries
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ArrivalEvent_Kind", ArrivalEvent_Kind_name, ArrivalEvent_Kind_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
+	proto.RegisterType((*SSZDownloadRequest)(nil), "ethereum.beacon.rpc.v1.SSZDownloadRequest")
+	proto.RegisterType((*SSZChunk)(nil), "ethereum.beacon.rpc.v1.SSZChunk")
+	proto.RegisterType((*ValidatorEpochRewardsRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorEpochRewardsRequest")
+	proto.RegisterType((*ValidatorEpochRewardsResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorEpochRewardsResponse")
+	proto.RegisterType((*ValidatorEpochRewards)(nil), "ethereum.beacon.rpc.v1.ValidatorEpochRewards")
+	proto.RegisterType((*FinalizedBlockRootRequest)(nil), "ethereum.beacon.rpc.v1.FinalizedBlockRootRequest")
+	proto.RegisterType((*FinalizedBlockRootResponse)(nil), "ethereum.beacon.rpc.v1.FinalizedBlockRootResponse")
+	proto.RegisterType((*ExportStateRequest)(nil), "ethereum.beacon.rpc.v1.ExportStateRequest")
+	proto.RegisterType((*ExportStateResponse)(nil), "ethereum.beacon.rpc.v1.ExportStateResponse")
+	proto.RegisterType((*PruneDatabaseResponse)(nil), "ethereum.beacon.rpc.v1.PruneDatabaseResponse")
+	proto.RegisterType((*CachesResponse)(nil), "ethereum.beacon.rpc.v1.CachesResponse")
+	proto.RegisterType((*CacheInfo)(nil), "ethereum.beacon.rpc.v1.CacheInfo")
+	proto.RegisterType((*FlushCacheRequest)(nil), "ethereum.beacon.rpc.v1.FlushCacheRequest")
+	proto.RegisterType((*ArchivalConfig)(nil), "ethereum.beacon.rpc.v1.ArchivalConfig")
+	proto.RegisterType((*ArchivalMigrationResponse)(nil), "ethereum.beacon.rpc.v1.ArchivalMigrationResponse")
+	proto.RegisterType((*ReplayCostsRequest)(nil), "ethereum.beacon.rpc.v1.ReplayCostsRequest")
+	proto.RegisterType((*ReplayCostsResponse)(nil), "ethereum.beacon.rpc.v1.ReplayCostsResponse")
+	proto.RegisterType((*ReplayCost)(nil), "ethereum.beacon.rpc.v1.ReplayCost")
+	proto.RegisterType((*ArrivalEventsRequest)(nil), "ethereum.beacon.rpc.v1.ArrivalEventsRequest")
+	proto.RegisterType((*ArrivalEventsResponse)(nil), "ethereum.beacon.rpc.v1.ArrivalEventsResponse")
+	proto.RegisterType((*ArrivalEvent)(nil), "ethereum.beacon.rpc.v1.ArrivalEvent")
+	proto.RegisterType((*RecentReorgsResponse)(nil), "ethereum.beacon.rpc.v1.RecentReorgsResponse")
+	proto.RegisterType((*Reorg)(nil), "ethereum.beacon.rpc.v1.Reorg")
+	proto.RegisterType((*CachedStatesResponse)(nil), "ethereum.beacon.rpc.v1.CachedStatesResponse")
+	proto.RegisterType((*CachedState)(nil), "ethereum.beacon.rpc.v1.CachedState")
+	proto.RegisterType((*PruneForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.PruneForkChoiceResponse")
+	proto.RegisterType((*SimulateHeadRequest)(nil), "ethereum.beacon.rpc.v1.SimulateHeadRequest")
+	proto.RegisterType((*SimulateHeadResponse)(nil), "ethereum.beacon.rpc.v1.SimulateHeadResponse")
+	proto.RegisterType((*SetHeadRequest)(nil), "ethereum.beacon.rpc.v1.SetHeadRequest")
+	proto.RegisterType((*HeadResponse)(nil), "ethereum.beacon.rpc.v1.HeadResponse")
+	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
+	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
+	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
+	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
+	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
+	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
+	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
+	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
+	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
+	proto.RegisterType((*ScoreInfo)(nil), "ethereum.beacon.rpc.v1.ScoreInfo")
+	proto.RegisterMapType((map[string]*TopicScoreSnapshot)(nil), "ethereum.beacon.rpc.v1.ScoreInfo.TopicScoresEntry")
+	proto.RegisterType((*TopicScoreSnapshot)(nil), "ethereum.beacon.rpc.v1.TopicScoreSnapshot")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
+
+var fileDescriptor_851e5cb2de3d61dd = []byte{
+	// 3510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3a, 0x4b, 0x8c, 0x1b, 0x47,
+	0x76, 0x6a, 0x0e, 0xe7, 0xc3, 0x47, 0x8a, 0x33, 0x53, 0x1a, 0x49, 0x63, 0x4a, 0xa3, 0x19, 0xb5,
+	0x6d, 0x69, 0xf4, 0x19, 0xd2, 0xe2, 0x7a, 0x17, 0x8e, 0xe3, 0x20, 0x9e, 0x9f, 0xe9, 0x81, 0xa5,
+	0x95, 0xd2, 0xb4, 0x9c, 0xc4, 0x9b, 0x05, 0xd1, 0xd3, 0x5d, 0x43, 0xf6, 0xaa, 0xd9, 0xd5, 0xdb,
+	0x55, 0xa4, 0x44, 0xc5, 0xa7, 0xc5, 0x02, 0x49, 0x2e, 0x9b, 0x00, 0x01, 0x92, 0x53, 0x0e, 0x39,
+	0x27, 0xb9, 0x26, 0x01, 0x72, 0x0d, 0x90, 0x00, 0xb9, 0x04, 0x09, 0x90, 0xa3, 0x11, 0x08, 0x46,
+	0x80, 0x5c, 0x73, 0xd4, 0x29, 0xa8, 0x57, 0xd5, 0xcd, 0xe6, 0x90, 0x3d, 0xa2, 0x98, 0x71, 0x6e,
+	0x5d, 0xef, 0x5f, 0xf5, 0x5e, 0xbd, 0x7a, 0xfd, 0xaa, 0x60, 0x33, 0x8c, 0x98, 0x60, 0xb5, 0x63,
+	0x6a, 0x3b, 0x2c, 0xa8, 0x45, 0xa1, 0x53, 0xeb, 0x3f, 0xa8, 0xb9, 0xf4, 0xb8, 0xd7, 0xae, 0x22,
+	0x86, 0x5c, 0xa1, 0xa2, 0x43, 0x23, 0xda, 0xeb, 0x56, 0x15, 0x4d, 0x35, 0x0a, 0x9d, 0x6a, 0xff,
+	0x41, 0xe5, 0x2a, 0x15, 0x9d, 0x5a, 0xff, 0x81, 0xed, 0x87, 0x1d, 0xfb, 0x41, 0x2d, 0x60, 0x2e,
+	0x55, 0x0c, 0x15, 0x73, 0x44, 0x62, 0x58, 0x0f, 0xa5, 0xc4, 0x2e, 0xe5, 0xdc, 0x6e, 0x53, 0xae,
+	0x69, 0xae, 0xb7, 0x19, 0x6b, 0xfb, 0xb4, 0x66, 0x87, 0x5e, 0xcd, 0x0e, 0x02, 0x26, 0x6c, 0xe1,
+	0xb1, 0x20, 0xc6, 0x5e, 0xd3, 0x58, 0x1c, 0x1d, 0xf7, 0x4e, 0x6a, 0xb4, 0x1b, 0x8a, 0x81, 0x46,
+	0xee, 0xb4, 0x3d, 0xd1, 0xe9, 0x1d, 0x57, 0x1d, 0xd6, 0xad, 0xb5, 0x59, 0x9b, 0x0d, 0xa9, 0xe4,
+	0x48, 0xe9, 0x96, 0x5f, 0x8a, 0xdc, 0xfc, 0x4b, 0x03, 0x48, 0xb3, 0xf9, 0xf5, 0x01, 0x7b, 0x1e,
+	0xf8, 0xcc, 0x76, 0x2d, 0xfa, 0xf3, 0x1e, 0xe5, 0x82, 0xec, 0x41, 0x9e, 0xfb, 0x4c, 0xac, 0x1b,
+	0x5b, 0xc6, 0x76, 0x7e, 0xef, 0xfe, 0xeb, 0x6f, 0x37, 0xb7, 0x53, 0x72, 0xc3, 0x68, 0xc0, 0xbb,
+	0xb6, 0xf0, 0x1c, 0xdf, 0x3e, 0xe6, 0x35, 0x2a, 0x3a, 0xf5, 0x1d, 0x31, 0x08, 0x29, 0xaf, 0x36,
+	0x7d, 0x26, 0x3e, 0xbf, 0x60, 0x21, 0x2f, 0xd9, 0x04, 0x38, 0xf6, 0x99, 0xf3, 0xac, 0x15, 0x31,
+	0x26, 0xd6, 0x73, 0x5b, 0xc6, 0x76, 0xe9, 0xf3, 0x0b, 0x56, 0x01, 0x61, 0x16, 0x63, 0x82, 0x5c,
+	0x81, 0x05, 0x1e, 0xd8, 0x61, 0x38, 0x58, 0x9f, 0xdb, 0x32, 0xb6, 0x97, 0x2c, 0x3d, 0xda, 0x2b,
+	0x43, 0xe9, 0xe7, 0x3d, 0x1a, 0x0d, 0x5a, 0x27, 0x9e, 0x2f, 0x68, 0x64, 0x3e, 0x85, 0xa5, 0x66,
+	0xf3, 0xeb, 0xfd, 0x4e, 0x2f, 0x78, 0x46, 0x08, 0xe4, 0x5d, 0x5b, 0xd8, 0x68, 0x58, 0xc9, 0xc2,
+	0x6f, 0x29, 0x87, 0x9d, 0x9c, 0x70, 0xaa, 0x94, 0xe4, 0x2d, 0x3d, 0x22, 0x1b, 0x00, 0x82, 0x09,
+	0xdb, 0x6f, 0x71, 0xef, 0x25, 0x45, 0x1d, 0x79, 0xab, 0x80, 0x90, 0xa6, 0xf7, 0x92, 0x9a, 0xff,
+	0x60, 0xc0, 0xf5, 0xaf, 0x6c, 0xdf, 0x73, 0x6d, 0xc1, 0xa2, 0xc3, 0x90, 0x39, 0x1d, 0x8b, 0x3e,
+	0xb7, 0x23, 0x97, 0xc7, 0x8b, 0xb0, 0x0f, 0xf3, 0x54, 0x82, 0xf5, 0x2a, 0xec, 0xbc, 0xfe, 0x76,
+	0xf3, 0xce, 0x34, 0xab, 0xa0, 0x64, 0x29, 0x5e, 0xf2, 0x15, 0x40, 0x3f, 0x56, 0xc2, 0xd7, 0x73,
+	0x5b, 0x73, 0xdb, 0xf9, 0xbd, 0x1f, 0xbd, 0xfe, 0x76, 0xb3, 0x3e, 0x8d, 0xa4, 0xc4, 0xbc, 0xa3,
+	0xc0, 0xa5, 0x2f, 0xac, 0x94, 0x24, 0xf3, 0x6f, 0x0c, 0xd8, 0xc8, 0xb0, 0x9e, 0x87, 0x2c, 0xe0,
+	0xf4, 0x7c, 0xcc, 0x6f, 0xc0, 0x62, 0xa4, 0xe4, 0xa2, 0xed, 0xc5, 0xfa, 0x4e, 0x75, 0x72, 0xc0,
+	0x57, 0x27, 0x1b, 0x13, 0x73, 0x9b, 0xaf, 0xe6, 0xe0, 0xf2, 0x44, 0x12, 0xd2, 0x82, 0xe5, 0x64,
+	0x5e, 0x2d, 0x4f, 0x4e, 0x54, 0x5b, 0x3c, 0xeb, 0x32, 0x95, 0xfb, 0x23, 0x63, 0xf2, 0x2e, 0x5c,
+	0xe4, 0xac, 0x17, 0x39, 0xb4, 0xa5, 0x8c, 0xd1, 0x61, 0x52, 0x52, 0x40, 0x65, 0x86, 0x24, 0x12,
+	0x76, 0xd4, 0xa6, 0x22, 0x26, 0x52, 0xf1, 0x52, 0x52, 0x40, 0x4d, 0xb4, 0x09, 0xc5, 0x0e, 0xb5,
+	0xdd, 0x98, 0x24, 0x8f, 0x24, 0x20, 0x41, 0x9a, 0xe0, 0x43, 0xb8, 0xe2, 0x05, 0x8e, 0xdf, 0xe3,
+	0x1e, 0x0b, 0x5a, 0x2e, 0xf5, 0xed, 0x41, 0x4c, 0x3b, 0x8f, 0xb4, 0x6b, 0x09, 0xf6, 0x40, 0x22,
+	0x35, 0xd7, 0x6d, 0x58, 0x0e, 0x23, 0x16, 0x32, 0x4e, 0xa3, 0x98, 0x7c, 0x01, 0xc9, 0xcb, 0x31,
+	0x58, 0x13, 0xbe, 0x0f, 0x65, 0x3d, 0x93, 0x90, 0x06, 0xb6, 0x2f, 0x06, 0xeb, 0x8b, 0x48, 0xa7,
+	0xe7, 0xf7, 0x44, 0x01, 0x25, 0x99, 0x9e, 0x4b, 0x4c, 0xb6, 0xa4, 0xc8, 0x14, 0x34, 0x26, 0xbb,
+	0x09, 0x25, 0x9c, 0x4d, 0x4c, 0x54, 0x40, 0x22, 0x9c, 0x61, 0x4c, 0xb2, 0x03, 0xc4, 0x0b, 0x6c,
+	0x47, 0x78, 0x7d, 0x4f, 0x0c, 0x12, 0x42, 0x40, 0xc2, 0xd5, 0x21, 0x46, 0x93, 0x9b, 0x3f, 0x85,
+	0x77, 0x3e, 0xf3, 0x02, 0xdb, 0xf7, 0x5e, 0x52, 0x77, 0x2f, 0xde, 0xe7, 0xf1, 0x76, 0xfa, 0x74,
+	0xf6, 0x9c, 0xa2, 0x32, 0x8a, 0xf9, 0xd7, 0x39, 0xa8, 0x4c, 0x92, 0xaf, 0x03, 0xfe, 0xff, 0xac,
+	0x40, 0x66, 0x8c, 0xd3, 0x29, 0x2b, 0x9d, 0xb0, 0x36, 0x00, 0xb8, 0xb0, 0x05, 0x55, 0xe8, 0x39,
+	0x85, 0x46, 0x08, 0xa2, 0xbf, 0x88, 0xd1, 0x68, 0x45, 0x7e, 0x06, 0x2b, 0x94, 0x30, 0xf9, 0x49,
+	0xee, 0xc1, 0x6a, 0x9b, 0x06, 0x34, 0x52, 0x93, 0xd5, 0xfb, 0x42, 0x05, 0xd1, 0x4a, 0x0a, 0xa1,
+	0x22, 0x7c, 0x0d, 0xe6, 0xc3, 0x88, 0xb1, 0x93, 0xf5, 0x85, 0xad, 0xb9, 0xed, 0x92, 0xa5, 0x06,
+	0x66, 0x00, 0xe4, 0xf0, 0x45, 0xc8, 0x22, 0xd1, 0x44, 0x13, 0xcf, 0xcb, 0x0d, 0xa9, 0xbc, 0x9d,
+	0x4b, 0xe7, 0x6d, 0x73, 0x17, 0x2e, 0x8d, 0xe8, 0xd3, 0x6e, 0x59, 0x83, 0x79, 0x9c, 0x96, 0xce,
+	0xd9, 0x6a, 0x20, 0xa1, 0xb8, 0xb0, 0x7a, 0x95, 0xd5, 0xc0, 0xfc, 0x95, 0x01, 0x97, 0x9f, 0x44,
+	0xbd, 0x80, 0x1e, 0xd8, 0xc2, 0x3e, 0xb6, 0xf9, 0x50, 0xca, 0xfb, 0x50, 0x76, 0xa9, 0x4f, 0x05,
+	0x75, 0x5b, 0x28, 0x80, 0xab, 0x09, 0x58, 0x17, 0x35, 0x14, 0x75, 0xf2, 0x34, 0x19, 0x4a, 0xe4,
+	0xeb, 0xb9, 0x11, 0x32, 0x8c, 0x1a, 0x2e, 0x77, 0x5c, 0x44, 0x1d, 0xdf, 0xf6, 0xba, 0x92, 0x70,
+	0x20, 0xc5, 0xa9, 0xfd, 0x5e, 0x4e, 0xc0, 0x7b, 0x12, 0x6a, 0x7e, 0x01, 0xe5, 0x7d, 0xdb, 0xe9,
+	0xd0, 0x61, 0x5a, 0xfd, 0x35, 0x58, 0x70, 0x10, 0xb2, 0x6e, 0x60, 0x42, 0xbc, 0x99, 0x95, 0x10,
+	0x91, 0xef, 0x28, 0x38, 0x61, 0x96, 0x66, 0x30, 0xff, 0xca, 0x80, 0x42, 0x02, 0x95, 0x47, 0x59,
+	0x60, 0x77, 0xd5, 0xb2, 0x14, 0x2c, 0xfc, 0x26, 0xeb, 0xb0, 0x48, 0x03, 0x11, 0x79, 0x34, 0xb6,
+	0x3b, 0x1e, 0x4a, 0x8b, 0x29, 0x17, 0x5e, 0xd7, 0x16, 0xa7, 0x2d, 0x4e, 0xc0, 0x68, 0xb1, 0x14,
+	0xdb, 0xf1, 0x04, 0xd7, 0xc9, 0x09, 0xbf, 0xa5, 0xc7, 0xba, 0x1e, 0xe7, 0x94, 0xeb, 0x08, 0xd2,
+	0x23, 0x72, 0x0d, 0x0a, 0x1d, 0x4f, 0xb4, 0x22, 0x59, 0x5e, 0x60, 0xca, 0x31, 0xac, 0xa5, 0x8e,
+	0x27, 0x2c, 0x39, 0x36, 0x6f, 0xc3, 0xea, 0x67, 0x7e, 0x8f, 0x77, 0xd0, 0xe2, 0x38, 0x7a, 0x26,
+	0x18, 0x6d, 0x3e, 0x87, 0xf2, 0x6e, 0xe4, 0x74, 0xbc, 0xbe, 0xed, 0xef, 0xb3, 0xe0, 0xc4, 0x6b,
+	0x13, 0x0a, 0xeb, 0x32, 0x52, 0x78, 0x2b, 0xa4, 0x51, 0xcb, 0x46, 0x1c, 0x75, 0x5b, 0x21, 0xf3,
+	0x82, 0xd9, 0xe2, 0xee, 0x32, 0x4a, 0x7b, 0x42, 0xa3, 0x5d, 0x2d, 0xeb, 0x89, 0x14, 0x65, 0xfe,
+	0x63, 0x0e, 0xde, 0x89, 0x35, 0x3f, 0xf2, 0xda, 0x38, 0x8d, 0x20, 0x71, 0x54, 0x1f, 0x6e, 0x86,
+	0x11, 0xed, 0x7b, 0xac, 0xc7, 0x5b, 0xe7, 0x6a, 0xcd, 0x46, 0x2c, 0xb6, 0x39, 0xc9, 0xaa, 0x33,
+	0x27, 0x9f, 0x3b, 0xb7, 0xc9, 0xcb, 0xec, 0xcd, 0xed, 0xfe, 0x70, 0x3b, 0xa8, 0x68, 0x28, 0x22,
+	0x6c, 0x7c, 0x33, 0x68, 0xa2, 0xfc, 0x84, 0x3d, 0x63, 0xfe, 0x0e, 0x10, 0x8b, 0x86, 0xbe, 0x3d,
+	0xd8, 0x67, 0x5c, 0xf0, 0x61, 0x09, 0x38, 0x8f, 0x8a, 0x31, 0xcc, 0xdf, 0xd6, 0x66, 0xc5, 0x6a,
+	0x3e, 0x86, 0x4b, 0x23, 0x92, 0xb5, 0x67, 0x3e, 0x82, 0x79, 0x87, 0x71, 0x2d, 0xba, 0x58, 0x37,
+	0xb3, 0x76, 0xd0, 0x90, 0xd7, 0x52, 0x0c, 0xe6, 0xbf, 0xe5, 0x00, 0x86, 0xd0, 0xef, 0x3f, 0xe3,
+	0xab, 0x94, 0x1e, 0x09, 0x95, 0xd2, 0xe7, 0x66, 0x4c, 0xe9, 0x91, 0x68, 0xfa, 0xc9, 0xf1, 0x11,
+	0x09, 0xa5, 0x2b, 0x9f, 0x1c, 0x1f, 0x91, 0x40, 0x5d, 0xd7, 0xa0, 0xe0, 0x05, 0xad, 0x2e, 0xed,
+	0xb2, 0x68, 0x80, 0xfb, 0x74, 0xc9, 0x5a, 0xf2, 0x82, 0x47, 0x38, 0x96, 0x3b, 0x58, 0xe7, 0x33,
+	0x55, 0x19, 0xe8, 0xd1, 0xd0, 0x4b, 0x8b, 0x33, 0xd8, 0xa6, 0xbd, 0x74, 0x1f, 0xd6, 0x76, 0xa3,
+	0x48, 0x6e, 0xa2, 0xc3, 0x3e, 0x0d, 0x86, 0x11, 0xb0, 0x06, 0xf3, 0xbe, 0xd7, 0xf5, 0xf4, 0xf2,
+	0x5a, 0x6a, 0x60, 0x3e, 0x85, 0xcb, 0xa7, 0xa8, 0xb5, 0x57, 0x3f, 0x81, 0x05, 0x8a, 0x10, 0xed,
+	0xd6, 0xf7, 0xb2, 0xdc, 0x9a, 0x66, 0xb7, 0x34, 0x8f, 0xf9, 0xdf, 0x39, 0x28, 0xa5, 0x11, 0xe4,
+	0x37, 0x20, 0xff, 0xcc, 0x0b, 0x5c, 0x54, 0x5e, 0xae, 0xdf, 0x99, 0x46, 0x58, 0xf5, 0x0b, 0x2f,
+	0x70, 0x2d, 0x64, 0x4b, 0x42, 0x23, 0x37, 0x73, 0x68, 0x10, 0xc8, 0xa7, 0xce, 0x79, 0xfc, 0x9e,
+	0x54, 0xab, 0xe6, 0xcf, 0xb5, 0x56, 0xdd, 0x01, 0xa2, 0xca, 0xc6, 0xae, 0xe7, 0xfb, 0x1e, 0xa7,
+	0x0e, 0x0b, 0x5c, 0x95, 0xb5, 0xe7, 0xac, 0x55, 0xc4, 0x3c, 0x4a, 0x21, 0xa4, 0x8d, 0xbe, 0x3c,
+	0x5a, 0x17, 0x30, 0x5c, 0xf0, 0xdb, 0xdc, 0x82, 0xbc, 0x5c, 0x07, 0x52, 0x80, 0xf9, 0xbd, 0x87,
+	0x8f, 0xf7, 0xbf, 0x58, 0xb9, 0x40, 0x2e, 0x42, 0x61, 0xb7, 0xd1, 0xb0, 0x0e, 0x1b, 0xbb, 0x5f,
+	0x1e, 0xae, 0x18, 0xe6, 0x23, 0x58, 0xb3, 0xa8, 0x23, 0x57, 0x9f, 0xb2, 0xa8, 0x3d, 0xf4, 0xe0,
+	0x0f, 0x61, 0x21, 0x42, 0x88, 0xf6, 0xe0, 0x46, 0xf6, 0xc6, 0x64, 0x51, 0xdb, 0xd2, 0xc4, 0x32,
+	0x0d, 0xcf, 0x23, 0x84, 0x34, 0x60, 0x89, 0xf9, 0x6e, 0x6b, 0xe6, 0x3d, 0xb9, 0xc8, 0x7c, 0x57,
+	0x7e, 0x90, 0x77, 0x94, 0xa0, 0xd4, 0xa6, 0x94, 0x28, 0xdc, 0x26, 0x0d, 0x58, 0x0a, 0xe8, 0xf3,
+	0xd9, 0x37, 0xe4, 0x62, 0x40, 0x9f, 0xc7, 0x3a, 0xa4, 0xa0, 0xd4, 0x66, 0x94, 0x28, 0xd4, 0x71,
+	0x1b, 0x96, 0x1d, 0xd6, 0xed, 0xb2, 0xa0, 0x65, 0x07, 0x0e, 0xe5, 0x82, 0x45, 0xe8, 0x82, 0x92,
+	0x55, 0x56, 0xe0, 0x5d, 0x0d, 0x95, 0x5b, 0xc4, 0xa5, 0xa1, 0xe8, 0xe8, 0x5d, 0xa9, 0x06, 0xf2,
+	0x2f, 0x40, 0x78, 0x5d, 0xda, 0xea, 0x05, 0xde, 0x8b, 0x51, 0x47, 0x2e, 0xa2, 0x23, 0xd7, 0x24,
+	0xf6, 0x69, 0xe0, 0xbd, 0x48, 0xfb, 0xd2, 0x6c, 0xc2, 0x1a, 0x1e, 0xb5, 0x3a, 0x2d, 0x27, 0x5e,
+	0xf9, 0x75, 0x58, 0x48, 0x2a, 0x1e, 0xe9, 0x95, 0x77, 0xcf, 0x2c, 0x38, 0x14, 0xb7, 0xa5, 0x59,
+	0xcc, 0x5f, 0x1a, 0x50, 0x4c, 0xc1, 0xa5, 0xc1, 0x58, 0x8c, 0xe8, 0x03, 0x5c, 0x0d, 0xde, 0x94,
+	0x05, 0xe3, 0xbd, 0x34, 0x37, 0x73, 0xe5, 0xfe, 0x13, 0xb8, 0x8a, 0x65, 0xdd, 0x67, 0x2c, 0x7a,
+	0xb6, 0xdf, 0x61, 0x9e, 0x33, 0x2c, 0xec, 0x6e, 0x42, 0x29, 0x94, 0x28, 0xb7, 0x15, 0x30, 0x37,
+	0x29, 0xeb, 0x8a, 0x0a, 0xf6, 0x63, 0x09, 0x92, 0xe6, 0x49, 0x5c, 0xcb, 0x61, 0xbd, 0xf8, 0x0c,
+	0xb5, 0x0a, 0x12, 0xb2, 0x2f, 0x01, 0xe6, 0xdf, 0x1b, 0x70, 0xa9, 0xe9, 0x75, 0x7b, 0x32, 0xfa,
+	0x3f, 0xa7, 0xc3, 0x26, 0xc6, 0x63, 0x28, 0x61, 0x9d, 0xa3, 0x02, 0x92, 0xcf, 0x14, 0x91, 0x45,
+	0x25, 0x41, 0x7e, 0x73, 0xf9, 0xfb, 0x77, 0x1c, 0xd9, 0x81, 0xd3, 0x49, 0xaf, 0x13, 0x28, 0x10,
+	0x2e, 0x54, 0x0d, 0x2e, 0x69, 0x02, 0x5b, 0x08, 0xca, 0x75, 0xdb, 0x46, 0x1f, 0xcd, 0x44, 0xa1,
+	0x76, 0x53, 0x18, 0xf3, 0x3f, 0x72, 0xb0, 0x36, 0x6a, 0xba, 0x5e, 0x95, 0x23, 0x28, 0xe0, 0xbf,
+	0xd9, 0xcc, 0x5b, 0x69, 0x49, 0xb2, 0x37, 0x7d, 0x75, 0xae, 0xa0, 0xa8, 0x94, 0xcd, 0x88, 0x44,
+	0x8b, 0x7f, 0x0f, 0x2e, 0x71, 0xad, 0xdf, 0x6d, 0x0d, 0x35, 0xce, 0xe2, 0xe9, 0xd5, 0x44, 0xd0,
+	0xe7, 0xb1, 0xea, 0xea, 0x98, 0xf4, 0xd4, 0x6e, 0x1b, 0xa5, 0x47, 0x6b, 0xea, 0x70, 0xf9, 0x14,
+	0xfd, 0x73, 0xea, 0xb5, 0x3b, 0x42, 0x97, 0xad, 0x97, 0x46, 0x38, 0x7e, 0x1b, 0x51, 0x32, 0xa2,
+	0x31, 0x0f, 0xe9, 0x1c, 0xa8, 0x06, 0xe6, 0x1e, 0x94, 0x9b, 0x54, 0xa4, 0xa3, 0xe1, 0x83, 0x91,
+	0x18, 0xc7, 0x7f, 0x91, 0xbd, 0xd5, 0xff, 0xf9, 0x76, 0xf3, 0x22, 0xe7, 0x2f, 0x77, 0x64, 0x87,
+	0xe8, 0x63, 0xf3, 0x07, 0x75, 0x33, 0x15, 0xf6, 0x26, 0x83, 0xd2, 0x88, 0x4f, 0xbe, 0xef, 0x6a,
+	0xc3, 0xec, 0xc0, 0xda, 0x51, 0xdc, 0x1f, 0x40, 0x2e, 0x6d, 0x7a, 0x19, 0x72, 0x9e, 0xab, 0x37,
+	0x46, 0xce, 0x3b, 0x87, 0xb3, 0xcd, 0xfc, 0x5d, 0xb8, 0x7c, 0x4a, 0xd3, 0xa9, 0x39, 0xce, 0x2e,
+	0xfa, 0x8f, 0x0c, 0x20, 0x7b, 0x98, 0x97, 0x46, 0x7e, 0x3b, 0xff, 0x3f, 0x3a, 0x8a, 0x63, 0x9d,
+	0xc3, 0x1d, 0x28, 0xa9, 0x36, 0x81, 0x36, 0x62, 0x63, 0x3c, 0x06, 0xd2, 0xeb, 0x7f, 0x1b, 0x8a,
+	0xcd, 0xe6, 0xd7, 0xc9, 0x5a, 0xe0, 0xcf, 0x98, 0xc3, 0x5c, 0xea, 0x6a, 0xd2, 0x78, 0x68, 0xfe,
+	0x81, 0x01, 0x97, 0x1e, 0xb2, 0x76, 0xdb, 0x0b, 0xda, 0x0f, 0x69, 0x9f, 0xfa, 0xb1, 0xfc, 0x06,
+	0xcc, 0xfb, 0x72, 0xac, 0x8b, 0x96, 0x07, 0x59, 0x99, 0x7a, 0x02, 0x6f, 0x55, 0x0d, 0x14, 0xbf,
+	0x79, 0x1b, 0xe6, 0x71, 0x4c, 0x96, 0x20, 0x7f, 0xf4, 0xe3, 0xcf, 0x1e, 0xaf, 0x5c, 0x90, 0xc7,
+	0xf9, 0xc1, 0xe1, 0xde, 0xd3, 0xc6, 0x8a, 0x21, 0x3f, 0xbf, 0xb4, 0x76, 0xf7, 0x0f, 0x57, 0x72,
+	0xe6, 0x77, 0x73, 0x70, 0xfd, 0x49, 0xc4, 0x04, 0xdb, 0x8d, 0x22, 0x7b, 0x30, 0x21, 0xbd, 0x62,
+	0x6f, 0xa9, 0x17, 0xd0, 0x96, 0xe8, 0x44, 0x94, 0x77, 0x98, 0x1f, 0x07, 0x52, 0x19, 0xc1, 0x5f,
+	0xc6, 0x50, 0xf2, 0x15, 0x2c, 0xff, 0xac, 0xc7, 0x85, 0x77, 0xe2, 0x51, 0xb7, 0xa5, 0x1a, 0x87,
+	0xb9, 0x59, 0x1a, 0x87, 0xe5, 0x44, 0xca, 0xa1, 0x6e, 0x80, 0x2e, 0x9f, 0xc4, 0x3d, 0x1b, 0x2d,
+	0x77, 0x6e, 0x26, 0xb9, 0x89, 0x14, 0x25, 0xd7, 0x82, 0x55, 0x6c, 0x61, 0xb7, 0x6c, 0x39, 0x73,
+	0x7d, 0x78, 0xe4, 0xf1, 0x84, 0xbc, 0x95, 0xb5, 0xee, 0xc3, 0x95, 0x92, 0x07, 0x8b, 0xb5, 0x1c,
+	0x8e, 0x8c, 0x39, 0xf9, 0x09, 0x2c, 0x7a, 0x81, 0xeb, 0x39, 0xf8, 0xa3, 0x2c, 0x25, 0xed, 0xbe,
+	0x59, 0xd2, 0xf8, 0x9a, 0x57, 0x8f, 0x94, 0x8c, 0xc3, 0x40, 0x44, 0x03, 0x2b, 0x96, 0x58, 0xf9,
+	0x18, 0x4a, 0x69, 0x04, 0x59, 0x81, 0xb9, 0x67, 0x74, 0xa0, 0x0f, 0x62, 0xf9, 0x29, 0x53, 0x59,
+	0xdf, 0xf6, 0x7b, 0x54, 0x1f, 0x71, 0x6a, 0xf0, 0x71, 0xee, 0x23, 0xc3, 0xfc, 0xd5, 0x1c, 0x94,
+	0x47, 0x8d, 0x3f, 0x87, 0x6c, 0x14, 0x17, 0xb8, 0xb9, 0x54, 0x81, 0x7b, 0x05, 0x16, 0x42, 0x3b,
+	0xa2, 0x81, 0x3e, 0x02, 0x2c, 0x3d, 0x9a, 0x14, 0x1d, 0xf9, 0xef, 0x29, 0x3a, 0xe6, 0xcf, 0x23,
+	0x3a, 0xae, 0xc0, 0x82, 0x3e, 0x3a, 0xf4, 0xff, 0x92, 0x1a, 0x61, 0x06, 0xa0, 0x5c, 0xb4, 0x9c,
+	0x8e, 0xe7, 0xbb, 0xba, 0x7b, 0x5a, 0x90, 0x90, 0x7d, 0x09, 0x90, 0xbb, 0x05, 0xd1, 0x2e, 0xe5,
+	0x0e, 0x0d, 0x5c, 0x3b, 0x10, 0xba, 0x75, 0x5a, 0x96, 0xe0, 0x83, 0x04, 0x6a, 0xfe, 0x14, 0xc8,
+	0x81, 0xbc, 0x05, 0x7a, 0x42, 0x69, 0x14, 0xfb, 0x9d, 0x93, 0x06, 0x14, 0xa2, 0x78, 0xa0, 0xab,
+	0xb5, 0xcc, 0x1f, 0x97, 0x31, 0x76, 0x6b, 0xc8, 0x6b, 0xbe, 0x9e, 0x87, 0xd5, 0x31, 0x02, 0x59,
+	0x5e, 0xf8, 0x1e, 0x17, 0x34, 0xf0, 0x82, 0x76, 0xcb, 0x76, 0xdd, 0x88, 0xf2, 0x58, 0x51, 0xc1,
+	0x22, 0x09, 0x6a, 0x37, 0xc6, 0x90, 0x3d, 0x28, 0xb8, 0x5e, 0x44, 0x1d, 0x59, 0x6c, 0xa0, 0x9b,
+	0xcb, 0xe9, 0xbf, 0x32, 0x2a, 0x3a, 0xd5, 0xf8, 0x86, 0xaa, 0x2a, 0x15, 0x1d, 0xc4, 0xb4, 0xd6,
+	0x90, 0x8d, 0xfc, 0x16, 0xac, 0x38, 0x2c, 0x08, 0xd4, 0x48, 0xf5, 0x11, 0x30, 0x36, 0xca, 0xf5,
+	0x5b, 0x19, 0xa2, 0xf6, 0x13, 0x72, 0x75, 0x02, 0x2c, 0x3b, 0xa3, 0x00, 0x72, 0x15, 0x16, 0x43,
+	0x4a, 0xa3, 0x96, 0xa7, 0x5a, 0xe8, 0x05, 0x6b, 0x41, 0x0e, 0x8f, 0x5c, 0xb9, 0x25, 0x68, 0xa0,
+	0x6a, 0xed, 0x82, 0x25, 0x3f, 0xc9, 0x63, 0x28, 0x28, 0xd2, 0xe0, 0x44, 0x75, 0xa8, 0x8a, 0xf5,
+	0xfa, 0xd4, 0x2b, 0x8a, 0x93, 0xc2, 0x0e, 0xdc, 0x52, 0xa8, 0xbf, 0xc8, 0x6f, 0x42, 0x11, 0x05,
+	0xca, 0x89, 0xf4, 0x54, 0x41, 0x5e, 0xac, 0xdf, 0x18, 0x13, 0x19, 0xd6, 0x43, 0x29, 0xb2, 0x89,
+	0x54, 0x16, 0x48, 0x16, 0xf5, 0x2d, 0xeb, 0x55, 0xdf, 0xe6, 0xa2, 0xd5, 0x0b, 0x5d, 0x59, 0x89,
+	0xe8, 0xf8, 0x28, 0x4a, 0xd8, 0x53, 0x05, 0x22, 0x9f, 0x02, 0x70, 0x87, 0x45, 0x54, 0x59, 0x5d,
+	0xd8, 0x32, 0xce, 0x6a, 0x13, 0x36, 0x25, 0x25, 0x1a, 0x59, 0xe0, 0xf1, 0x67, 0xe5, 0xb5, 0x01,
+	0x4b, 0xb1, 0xf1, 0xe4, 0x13, 0x58, 0xea, 0x52, 0x61, 0x27, 0xf7, 0x5e, 0xc5, 0xfa, 0x56, 0x96,
+	0xbd, 0x8f, 0xa8, 0xb0, 0x65, 0xeb, 0xd4, 0x4a, 0x38, 0xc8, 0x75, 0x28, 0x60, 0x9a, 0x73, 0x98,
+	0xaf, 0xee, 0x70, 0x0a, 0xd6, 0x10, 0x20, 0x4b, 0xda, 0x13, 0xbb, 0xe7, 0x0b, 0x5d, 0x5b, 0xab,
+	0x4d, 0x0f, 0x08, 0xc2, 0xe2, 0x9a, 0xdc, 0x81, 0x95, 0x98, 0xba, 0xd5, 0xa7, 0x91, 0x2c, 0x18,
+	0xb4, 0xd3, 0x96, 0x63, 0xf8, 0x57, 0x0a, 0x2c, 0xaf, 0x50, 0xec, 0x36, 0x0d, 0x44, 0x42, 0xa7,
+	0xfc, 0x58, 0x42, 0x60, 0x4c, 0x24, 0xcb, 0x7d, 0xb9, 0xfe, 0xbe, 0x2d, 0x68, 0xe0, 0x0c, 0xf4,
+	0xf6, 0x44, 0x9f, 0x3c, 0x54, 0x20, 0xf3, 0x5f, 0xe6, 0xa0, 0x90, 0xac, 0x8a, 0x94, 0xca, 0xfa,
+	0x34, 0xb2, 0x7d, 0xbf, 0x85, 0xeb, 0x83, 0x4b, 0x90, 0xb3, 0x4a, 0x1a, 0x88, 0x84, 0xda, 0x4a,
+	0x87, 0x62, 0xb5, 0x3f, 0xd2, 0xf8, 0x5d, 0x4e, 0xe0, 0xba, 0xf5, 0xfb, 0x01, 0xac, 0xa9, 0x1a,
+	0x20, 0x8c, 0x58, 0xdf, 0x73, 0x65, 0x28, 0xa0, 0xd8, 0x39, 0x14, 0x4b, 0x10, 0xf7, 0x44, 0xa3,
+	0x94, 0xf0, 0xa7, 0x50, 0x12, 0x2c, 0xf4, 0x1c, 0x45, 0x18, 0x1f, 0x32, 0xf5, 0x37, 0x3a, 0xb4,
+	0xfa, 0xa5, 0xe4, 0xc2, 0xa1, 0x3e, 0x0b, 0x8a, 0x62, 0x08, 0x91, 0x2b, 0xd1, 0x66, 0x9c, 0x7b,
+	0xa1, 0x36, 0x60, 0x1e, 0x0d, 0x28, 0x2a, 0x98, 0xd2, 0x7c, 0x0f, 0x56, 0x8f, 0x69, 0xc7, 0x96,
+	0xcd, 0xc6, 0x28, 0xb9, 0x7d, 0x59, 0x40, 0xba, 0x95, 0x04, 0x11, 0xdf, 0xd5, 0xdc, 0x81, 0x15,
+	0xdd, 0x4c, 0x90, 0x1b, 0x95, 0x46, 0x11, 0x8b, 0x30, 0xbc, 0x0b, 0xd6, 0xf2, 0x10, 0x7e, 0x28,
+	0xc1, 0x95, 0x9f, 0xc1, 0xca, 0x69, 0xdb, 0x26, 0x1c, 0x47, 0x9f, 0xa6, 0x8f, 0xa3, 0x62, 0xfd,
+	0x6e, 0xd6, 0x84, 0x87, 0xa2, 0x9a, 0x81, 0x1d, 0xf2, 0x8e, 0xec, 0x2c, 0x0d, 0x8f, 0xae, 0xff,
+	0x32, 0x80, 0x8c, 0x53, 0x90, 0x2d, 0x28, 0xe1, 0x3f, 0x32, 0xb6, 0xbc, 0xb8, 0xbe, 0xa4, 0xb4,
+	0x40, 0xc2, 0x8e, 0x82, 0x47, 0x94, 0x77, 0xc8, 0x47, 0xb0, 0x7e, 0xe2, 0x45, 0x5c, 0xb4, 0xf4,
+	0xe5, 0xb8, 0xbc, 0x4f, 0xf3, 0xfa, 0x34, 0x69, 0x8e, 0xe7, 0xac, 0x2b, 0x88, 0x7f, 0xa4, 0xd0,
+	0x07, 0x09, 0x96, 0xfc, 0x08, 0xae, 0x4a, 0x99, 0x93, 0x18, 0x95, 0x97, 0x2f, 0x4b, 0xf4, 0x38,
+	0xdf, 0x27, 0x50, 0xf1, 0x02, 0x5c, 0xab, 0x49, 0xac, 0x79, 0x64, 0x5d, 0xd7, 0x14, 0x63, 0xdc,
+	0xf5, 0x7f, 0xba, 0x06, 0xf3, 0x98, 0x82, 0xc8, 0x2f, 0x0d, 0x28, 0x37, 0xa8, 0x48, 0x55, 0xc1,
+	0x24, 0x73, 0xf1, 0xc6, 0x4b, 0xe5, 0x4a, 0xe6, 0x0f, 0x7e, 0xaa, 0x38, 0x35, 0x6f, 0xfe, 0xe2,
+	0xdf, 0xbf, 0xfb, 0xd3, 0xdc, 0x35, 0xf2, 0x4e, 0x6d, 0xe4, 0xa1, 0x01, 0x3e, 0x4d, 0xa8, 0xa9,
+	0x2b, 0x96, 0x17, 0xb0, 0x24, 0xad, 0x90, 0x01, 0x4d, 0x32, 0x9b, 0x71, 0xe9, 0xfa, 0xf8, 0x1c,
+	0x34, 0xe3, 0xf6, 0x21, 0xbf, 0x0f, 0xcb, 0x4d, 0x2a, 0xd2, 0x55, 0x2e, 0xb9, 0xf7, 0x16, 0xb5,
+	0x70, 0xe5, 0x4a, 0x55, 0x3d, 0x71, 0xa8, 0xc6, 0x8f, 0x17, 0xaa, 0x87, 0xf2, 0x89, 0x83, 0xf9,
+	0x2e, 0xaa, 0xde, 0x30, 0xaf, 0x4d, 0x52, 0xed, 0x2b, 0x41, 0xe4, 0x8f, 0x0d, 0xb8, 0xda, 0xa0,
+	0x62, 0x52, 0x85, 0x46, 0x32, 0x04, 0x57, 0x3e, 0x9c, 0xa5, 0xce, 0x33, 0x6f, 0xa1, 0x39, 0x5b,
+	0xe4, 0xc6, 0x24, 0x73, 0x4e, 0x58, 0xf4, 0xcc, 0x51, 0x5a, 0x39, 0x94, 0x1a, 0xea, 0x67, 0x54,
+	0x05, 0x43, 0x96, 0x15, 0x53, 0x2d, 0xff, 0x99, 0x4a, 0xd1, 0xf1, 0x35, 0xf9, 0xe7, 0x4c, 0xbe,
+	0x81, 0x95, 0x87, 0x1e, 0x17, 0xe9, 0x46, 0x5f, 0xa6, 0xe2, 0xfb, 0xd9, 0x8d, 0xbe, 0xf1, 0x36,
+	0xa1, 0x69, 0xa2, 0x05, 0xd7, 0x49, 0x65, 0x92, 0x05, 0xaa, 0x27, 0x48, 0x7e, 0x61, 0xc0, 0x72,
+	0x83, 0x8a, 0x74, 0x43, 0xeb, 0xed, 0xb5, 0x4f, 0x6a, 0x87, 0x99, 0x77, 0x50, 0xfb, 0xbb, 0xe4,
+	0x66, 0xe6, 0xfc, 0x79, 0x0d, 0xbb, 0x5a, 0x2e, 0x89, 0xa0, 0x20, 0x97, 0x40, 0x1e, 0xa4, 0xd9,
+	0xda, 0xef, 0x4e, 0x5d, 0x4e, 0xf0, 0xb3, 0x43, 0x3f, 0x44, 0x35, 0x2f, 0x61, 0x51, 0x06, 0x1f,
+	0xa5, 0x11, 0x31, 0xcf, 0x28, 0xb5, 0xe2, 0x48, 0x9f, 0xbe, 0x3c, 0x34, 0xb7, 0x50, 0x79, 0x85,
+	0xac, 0x67, 0x29, 0x27, 0x7f, 0x66, 0xc0, 0x4a, 0x83, 0x8a, 0x91, 0x3f, 0x7b, 0x92, 0xb9, 0xba,
+	0x93, 0x5a, 0x0d, 0x95, 0x9d, 0x29, 0xa9, 0xb5, 0x4d, 0xef, 0xa3, 0x4d, 0x9b, 0x64, 0x63, 0x92,
+	0x4d, 0xc9, 0x5b, 0x07, 0x32, 0x80, 0x8b, 0x16, 0x75, 0x58, 0x37, 0xec, 0xa9, 0x36, 0x57, 0xa6,
+	0x33, 0x32, 0xd3, 0x54, 0xba, 0x11, 0x63, 0xde, 0x45, 0xad, 0xef, 0x99, 0xe6, 0x24, 0xad, 0x32,
+	0xf8, 0x6b, 0x51, 0xac, 0x8d, 0x7c, 0x03, 0x8b, 0xba, 0x11, 0x44, 0x32, 0x7f, 0x0b, 0x47, 0x3b,
+	0x45, 0x53, 0x1a, 0x11, 0xe7, 0xa2, 0xf5, 0x2c, 0x23, 0x3e, 0x36, 0xee, 0x92, 0xbf, 0x30, 0xa0,
+	0x94, 0xee, 0xef, 0x65, 0xa7, 0xc1, 0x09, 0x0d, 0xcc, 0xca, 0xfd, 0xe9, 0x88, 0xb5, 0x41, 0x75,
+	0x34, 0xe8, 0xbe, 0x79, 0xfb, 0xec, 0x6c, 0x54, 0x8b, 0x9b, 0x68, 0xd2, 0xbe, 0x3f, 0x34, 0x60,
+	0xf9, 0x54, 0x63, 0x36, 0xd3, 0x37, 0xb5, 0xec, 0x1c, 0x39, 0xb1, 0xb3, 0x6b, 0xde, 0x47, 0x83,
+	0x6e, 0x99, 0xef, 0xbd, 0xc1, 0x20, 0x6c, 0x44, 0xc8, 0xe0, 0x5d, 0x95, 0xbb, 0x75, 0xe4, 0x72,
+	0x29, 0x3b, 0x7a, 0x27, 0xdd, 0x58, 0x55, 0x76, 0xa6, 0xa4, 0xd6, 0x06, 0xbe, 0x87, 0x06, 0xde,
+	0x20, 0xd7, 0x27, 0x19, 0x68, 0x2b, 0x16, 0x4e, 0x42, 0x00, 0x69, 0x97, 0x7a, 0x06, 0x90, 0xb9,
+	0x3a, 0xb7, 0xce, 0x4c, 0x62, 0x53, 0x26, 0x4f, 0xf5, 0x4e, 0x80, 0x0c, 0x00, 0x86, 0x37, 0xef,
+	0x24, 0x33, 0x45, 0x8c, 0xdd, 0xce, 0x67, 0x9e, 0x9b, 0xdb, 0xa8, 0xd4, 0x34, 0xb7, 0xb2, 0x95,
+	0xd6, 0x4e, 0xa4, 0x34, 0x32, 0x80, 0xd5, 0x06, 0x15, 0xa7, 0xae, 0xf3, 0xdf, 0x7a, 0xce, 0xa3,
+	0xfc, 0x6f, 0x5a, 0x67, 0x45, 0x4b, 0xfe, 0xdc, 0x80, 0xd5, 0xe6, 0x98, 0xee, 0x29, 0x75, 0x54,
+	0x1e, 0xbc, 0x89, 0x6e, 0xec, 0x81, 0x80, 0x79, 0x1b, 0xcd, 0xba, 0x69, 0x9e, 0x69, 0x96, 0xde,
+	0xc5, 0xcb, 0xea, 0x2c, 0x4d, 0xee, 0xb2, 0xb3, 0x0b, 0xba, 0xf1, 0xab, 0xf4, 0xca, 0xbd, 0xa9,
+	0x68, 0xb5, 0x55, 0x0f, 0xd0, 0xaa, 0x7b, 0xe4, 0xce, 0x59, 0x56, 0xd5, 0x22, 0xe4, 0x6c, 0xe1,
+	0xad, 0x38, 0xf9, 0x13, 0x03, 0x8a, 0xa9, 0x97, 0x37, 0xd9, 0xb6, 0x8d, 0x3f, 0x07, 0xaa, 0xdc,
+	0x9b, 0x8a, 0x56, 0xdb, 0xa6, 0xe3, 0x88, 0x6c, 0x65, 0xd7, 0x1e, 0x14, 0xd9, 0xc8, 0x37, 0x70,
+	0x71, 0xe4, 0x1d, 0x4f, 0x66, 0x0c, 0xed, 0x9c, 0x99, 0x55, 0x4e, 0x3f, 0x03, 0x8a, 0x43, 0x69,
+	0xb2, 0xcf, 0xdc, 0x63, 0x9d, 0x4b, 0xfe, 0xd6, 0x80, 0x6b, 0x0d, 0x2a, 0xc6, 0xdf, 0x8a, 0xed,
+	0x0d, 0xf0, 0x4c, 0xcc, 0x0c, 0x96, 0xcc, 0xd7, 0x6b, 0x95, 0xfa, 0xdb, 0xb0, 0x68, 0x63, 0x3f,
+	0x40, 0x63, 0xef, 0x92, 0xed, 0x89, 0x09, 0x30, 0xe6, 0xab, 0x0d, 0x5b, 0xd2, 0xc4, 0x86, 0x8b,
+	0xf1, 0x53, 0x5c, 0x55, 0xb7, 0xdf, 0x3d, 0xa3, 0x24, 0x3c, 0xf5, 0x68, 0xb7, 0xb2, 0x75, 0x06,
+	0x2d, 0xbe, 0x9e, 0x35, 0x2f, 0x7c, 0x60, 0x90, 0x36, 0x5c, 0x4a, 0x54, 0x4c, 0xf3, 0x83, 0x32,
+	0xb3, 0xa2, 0xbf, 0x33, 0x60, 0xbd, 0x41, 0xc5, 0xe4, 0x47, 0x9f, 0x1f, 0xbe, 0xdd, 0x33, 0x52,
+	0xad, 0xf8, 0x87, 0x6f, 0xc9, 0xa5, 0xfd, 0x50, 0x45, 0x3f, 0x6c, 0x93, 0x5b, 0x93, 0xfc, 0x30,
+	0x7c, 0x53, 0x5b, 0xd3, 0x6f, 0x55, 0xf7, 0x4a, 0xff, 0xfc, 0xea, 0x86, 0xf1, 0xaf, 0xaf, 0x6e,
+	0x18, 0xff, 0xf9, 0xea, 0x86, 0x71, 0xbc, 0x80, 0x11, 0xfb, 0x83, 0xff, 0x1d, 0x00, 0x4c, 0x26,
+	0x22, 0x68, 0x0b, 0x2e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DebugClient is the client API for Debug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DebugClient interface {
+	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetProtoArrayForkChoice(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
+	GetHeadState(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SSZResponse, error)
+	ListRecentReorgs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RecentReorgsResponse, error)
+	GetCachedStates(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CachedStatesResponse, error)
+	ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
+	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
+	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+	RecomputeHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HeadResponse, error)
+	SetHead(ctx context.Context, in *SetHeadRequest, opts ...grpc.CallOption) (*HeadResponse, error)
+	SimulateHead(ctx context.Context, in *SimulateHeadRequest, opts ...grpc.CallOption) (*SimulateHeadResponse, error)
+	PruneForkChoice(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PruneForkChoiceResponse, error)
+	ListArrivalEvents(ctx context.Context, in *ArrivalEventsRequest, opts ...grpc.CallOption) (*ArrivalEventsResponse, error)
+	ListCaches(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CachesResponse, error)
+	FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetArchivalConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ArchivalConfig, error)
+	SetArchivalConfig(ctx context.Context, in *ArchivalConfig, opts ...grpc.CallOption) (*ArchivalMigrationResponse, error)
+	ListReplayCosts(ctx context.Context, in *ReplayCostsRequest, opts ...grpc.CallOption) (*ReplayCostsResponse, error)
+	ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*ExportStateResponse, error)
+	PruneDatabase(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PruneDatabaseResponse, error)
+	GetFinalizedBlockRootBySlot(ctx context.Context, in *FinalizedBlockRootRequest, opts ...grpc.CallOption) (*FinalizedBlockRootResponse, error)
+	DownloadBlock(ctx context.Context, in *SSZDownloadRequest, opts ...grpc.CallOption) (Debug_DownloadBlockClient, error)
+	DownloadBeaconState(ctx context.Context, in *SSZDownloadRequest, opts ...grpc.CallOption) (Debug_DownloadBeaconStateClient, error)
+	GetValidatorEpochRewards(ctx context.Context, in *ValidatorEpochRewardsRequest, opts ...grpc.CallOption) (*ValidatorEpochRewardsResponse, error)
+}
+
+type debugClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDebugClient(cc *grpc.ClientConn) DebugClient {
+	return &debugClient{cc}
+}
+
+func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
+	out := new(ProtoArrayForkChoiceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetHeadState(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetHeadState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListRecentReorgs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RecentReorgsResponse, error) {
+	out := new(RecentReorgsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListRecentReorgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetCachedStates(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CachedStatesResponse, error) {
+	out := new(CachedStatesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetCachedStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error) {
+	out := new(DebugPeerResponses)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error) {
+	out := new(DebugPeerResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error) {
+	out := new(InclusionSlotResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) RecomputeHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HeadResponse, error) {
+	out := new(HeadResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/RecomputeHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) SetHead(ctx context.Context, in *SetHeadRequest, opts ...grpc.CallOption) (*HeadResponse, error) {
+	out := new(HeadResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) SimulateHead(ctx context.Context, in *SimulateHeadRequest, opts ...grpc.CallOption) (*SimulateHeadResponse, error) {
+	out := new(SimulateHeadResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SimulateHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) PruneForkChoice(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PruneForkChoiceResponse, error) {
+	out := new(PruneForkChoiceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/PruneForkChoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListArrivalEvents(ctx context.Context, in *ArrivalEventsRequest, opts ...grpc.CallOption) (*ArrivalEventsResponse, error) {
+	out := new(ArrivalEventsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListArrivalEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListCaches(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CachesResponse, error) {
+	out := new(CachesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListCaches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/FlushCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetArchivalConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ArchivalConfig, error) {
+	out := new(ArchivalConfig)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetArchivalConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) SetArchivalConfig(ctx context.Context, in *ArchivalConfig, opts ...grpc.CallOption) (*ArchivalMigrationResponse, error) {
+	out := new(ArchivalMigrationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetArchivalConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListReplayCosts(ctx context.Context, in *ReplayCostsRequest, opts ...grpc.CallOption) (*ReplayCostsResponse, error) {
+	out := new(ReplayCostsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListReplayCosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*ExportStateResponse, error) {
+	out := new(ExportStateResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) PruneDatabase(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PruneDatabaseResponse, error) {
+	out := new(PruneDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/PruneDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetFinalizedBlockRootBySlot(ctx context.Context, in *FinalizedBlockRootRequest, opts ...grpc.CallOption) (*FinalizedBlockRootResponse, error) {
+	out := new(FinalizedBlockRootResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetFinalizedBlockRootBySlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) DownloadBlock(ctx context.Context, in *SSZDownloadRequest, opts ...grpc.CallOption) (Debug_DownloadBlockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.Debug/DownloadBlock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &debugDownloadBlockClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Debug_DownloadBlockClient interface {
+	Recv() (*SSZChunk, error)
+	grpc.ClientStream
+}
+
+type debugDownloadBlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *debugDownloadBlockClient) Recv() (*SSZChunk, error) {
+	m := new(SSZChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *debugClient) DownloadBeaconState(ctx context.Context, in *SSZDownloadRequest, opts ...grpc.CallOption) (Debug_DownloadBeaconStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.Debug/DownloadBeaconState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &debugDownloadBeaconStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Debug_DownloadBeaconStateClient interface {
+	Recv() (*SSZChunk, error)
+	grpc.ClientStream
+}
+
+type debugDownloadBeaconStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *debugDownloadBeaconStateClient) Recv() (*SSZChunk, error) {
+	m := new(SSZChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *debugClient) GetValidatorEpochRewards(ctx context.Context, in *ValidatorEpochRewardsRequest, opts ...grpc.CallOption) (*ValidatorEpochRewardsResponse, error) {
+	out := new(ValidatorEpochRewardsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetValidatorEpochRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebugServer is the server API for Debug service.
+type DebugServer interface {
+	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
+	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
+	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*empty.Empty, error)
+	GetProtoArrayForkChoice(context.Context, *empty.Empty) (*ProtoArrayForkChoiceResponse, error)
+	GetHeadState(context.Context, *empty.Empty) (*SSZResponse, error)
+	ListRecentReorgs(context.Context, *empty.Empty) (*RecentReorgsResponse, error)
+	GetCachedStates(context.Context, *empty.Empty) (*CachedStatesResponse, error)
+	ListPeers(context.Context, *empty.Empty) (*DebugPeerResponses, error)
+	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
+	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+	RecomputeHead(context.Context, *empty.Empty) (*HeadResponse, error)
+	SetHead(context.Context, *SetHeadRequest) (*HeadResponse, error)
+	SimulateHead(context.Context, *SimulateHeadRequest) (*SimulateHeadResponse, error)
+	PruneForkChoice(context.Context, *empty.Empty) (*PruneForkChoiceResponse, error)
+	ListArrivalEvents(context.Context, *ArrivalEventsRequest) (*ArrivalEventsResponse, error)
+	ListCaches(context.Context, *empty.Empty) (*CachesResponse, error)
+	FlushCache(context.Context, *FlushCacheRequest) (*empty.Empty, error)
+	GetArchivalConfig(context.Context, *empty.Empty) (*ArchivalConfig, error)
+	SetArchivalConfig(context.Context, *ArchivalConfig) (*ArchivalMigrationResponse, error)
+	ListReplayCosts(context.Context, *ReplayCostsRequest) (*ReplayCostsResponse, error)
+	ExportState(context.Context, *ExportStateRequest) (*ExportStateResponse, error)
+	PruneDatabase(context.Context, *empty.Empty) (*PruneDatabaseResponse, error)
+	GetFinalizedBlockRootBySlot(context.Context, *FinalizedBlockRootRequest) (*FinalizedBlockRootResponse, error)
+	DownloadBlock(*SSZDownloadRequest, Debug_DownloadBlockServer) error
+	DownloadBeaconState(*SSZDownloadRequest, Debug_DownloadBeaconStateServer) error
+	GetValidatorEpochRewards(context.Context, *ValidatorEpochRewardsRequest) (*ValidatorEpochRewardsResponse, error)
+}
+
+// UnimplementedDebugServer can be embedded to have forward compatible implementations.
+type UnimplementedDebugServer struct {
+}
+
+func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
+}
+func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
+}
+func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *empty.Empty) (*ProtoArrayForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+}
+func (*UnimplementedDebugServer) GetHeadState(ctx context.Context, req *empty.Empty) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeadState not implemented")
+}
+func (*UnimplementedDebugServer) ListRecentReorgs(ctx context.Context, req *empty.Empty) (*RecentReorgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecentReorgs not implemented")
+}
+func (*UnimplementedDebugServer) GetCachedStates(ctx context.Context, req *empty.Empty) (*CachedStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCachedStates not implemented")
+}
+func (*UnimplementedDebugServer) ListPeers(ctx context.Context, req *empty.Empty) (*DebugPeerResponses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*DebugPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+}
+func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
+}
+func (*UnimplementedDebugServer) RecomputeHead(ctx context.Context, req *empty.Empty) (*HeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeHead not implemented")
+}
+func (*UnimplementedDebugServer) SetHead(ctx context.Context, req *SetHeadRequest) (*HeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHead not implemented")
+}
+func (*UnimplementedDebugServer) SimulateHead(ctx context.Context, req *SimulateHeadRequest) (*SimulateHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateHead not implemented")
+}
+func (*UnimplementedDebugServer) PruneForkChoice(ctx context.Context, req *empty.Empty) (*PruneForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneForkChoice not implemented")
+}
+func (*UnimplementedDebugServer) ListArrivalEvents(ctx context.Context, req *ArrivalEventsRequest) (*ArrivalEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArrivalEvents not implemented")
+}
+func (*UnimplementedDebugServer) ListCaches(ctx context.Context, req *empty.Empty) (*CachesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCaches not implemented")
+}
+func (*UnimplementedDebugServer) FlushCache(ctx context.Context, req *FlushCacheRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushCache not implemented")
+}
+func (*UnimplementedDebugServer) GetArchivalConfig(ctx context.Context, req *empty.Empty) (*ArchivalConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivalConfig not implemented")
+}
+func (*UnimplementedDebugServer) SetArchivalConfig(ctx context.Context, req *ArchivalConfig) (*ArchivalMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetArchivalConfig not implemented")
+}
+func (*UnimplementedDebugServer) ListReplayCosts(ctx context.Context, req *ReplayCostsRequest) (*ReplayCostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplayCosts not implemented")
+}
+func (*UnimplementedDebugServer) ExportState(ctx context.Context, req *ExportStateRequest) (*ExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportState not implemented")
+}
+func (*UnimplementedDebugServer) PruneDatabase(ctx context.Context, req *empty.Empty) (*PruneDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneDatabase not implemented")
+}
+func (*UnimplementedDebugServer) GetFinalizedBlockRootBySlot(ctx context.Context, req *FinalizedBlockRootRequest) (*FinalizedBlockRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFinalizedBlockRootBySlot not implemented")
+}
+func (*UnimplementedDebugServer) DownloadBlock(req *SSZDownloadRequest, srv Debug_DownloadBlockServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadBlock not implemented")
+}
+func (*UnimplementedDebugServer) DownloadBeaconState(req *SSZDownloadRequest, srv Debug_DownloadBeaconStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadBeaconState not implemented")
+}
+func (*UnimplementedDebugServer) GetValidatorEpochRewards(ctx context.Context, req *ValidatorEpochRewardsRequest) (*ValidatorEpochRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorEpochRewards not implemented")
+}
+
+func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
+	s.RegisterService(&_Debug_serviceDesc, srv)
+}
+
+func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBeaconState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoggingLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SetLoggingLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetHeadState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetHeadState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetHeadState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetHeadState(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListRecentReorgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListRecentReorgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListRecentReorgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListRecentReorgs(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetCachedStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetCachedStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetCachedStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetCachedStates(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListPeers(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetPeer(ctx, req.(*v1alpha1.PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InclusionSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetInclusionSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetInclusionSlot(ctx, req.(*InclusionSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_RecomputeHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).RecomputeHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/RecomputeHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).RecomputeHead(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_SetHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SetHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SetHead(ctx, req.(*SetHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_SimulateHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SimulateHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SimulateHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SimulateHead(ctx, req.(*SimulateHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_PruneForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).PruneForkChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/PruneForkChoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).PruneForkChoice(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListArrivalEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArrivalEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListArrivalEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListArrivalEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListArrivalEvents(ctx, req.(*ArrivalEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListCaches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListCaches(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_FlushCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).FlushCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/FlushCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).FlushCache(ctx, req.(*FlushCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetArchivalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetArchivalConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetArchivalConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetArchivalConfig(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_SetArchivalConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivalConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SetArchivalConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetArchivalConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SetArchivalConfig(ctx, req.(*ArchivalConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListReplayCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListReplayCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListReplayCosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListReplayCosts(ctx, req.(*ReplayCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ExportState(ctx, req.(*ExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_PruneDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).PruneDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/PruneDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).PruneDatabase(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetFinalizedBlockRootBySlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizedBlockRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetFinalizedBlockRootBySlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetFinalizedBlockRootBySlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetFinalizedBlockRootBySlot(ctx, req.(*FinalizedBlockRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_DownloadBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SSZDownloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DebugServer).DownloadBlock(m, &debugDownloadBlockServer{stream})
+}
+
+type Debug_DownloadBlockServer interface {
+	Send(*SSZChunk) error
+	grpc.ServerStream
+}
+
+type debugDownloadBlockServer struct {
+	grpc.ServerStream
+}
+
+func (x *debugDownloadBlockServer) Send(m *SSZChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Debug_DownloadBeaconState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SSZDownloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DebugServer).DownloadBeaconState(m, &debugDownloadBeaconStateServer{stream})
+}
+
+type Debug_DownloadBeaconStateServer interface {
+	Send(*SSZChunk) error
+	grpc.ServerStream
+}
+
+type debugDownloadBeaconStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *debugDownloadBeaconStateServer) Send(m *SSZChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Debug_GetValidatorEpochRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorEpochRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetValidatorEpochRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetValidatorEpochRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetValidatorEpochRewards(ctx, req.(*ValidatorEpochRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Debug_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Debug",
+	HandlerType: (*DebugServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBeaconState",
+			Handler:    _Debug_GetBeaconState_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _Debug_GetBlock_Handler,
+		},
+		{
+			MethodName: "SetLoggingLevel",
+			Handler:    _Debug_SetLoggingLevel_Handler,
+		},
+		{
+			MethodName: "GetProtoArrayForkChoice",
+			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
+		},
+		{
+			MethodName: "GetHeadState",
+			Handler:    _Debug_GetHeadState_Handler,
+		},
+		{
+			MethodName: "ListRecentReorgs",
+			Handler:    _Debug_ListRecentReorgs_Handler,
+		},
+		{
+			MethodName: "GetCachedStates",
+			Handler:    _Debug_GetCachedStates_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _Debug_ListPeers_Handler,
+		},
+		{
+			MethodName: "GetPeer",
+			Handler:    _Debug_GetPeer_Handler,
+		},
+		{
+			MethodName: "GetInclusionSlot",
+			Handler:    _Debug_GetInclusionSlot_Handler,
+		},
+		{
+			MethodName: "RecomputeHead",
+			Handler:    _Debug_RecomputeHead_Handler,
+		},
+		{
+			MethodName: "SetHead",
+			Handler:    _Debug_SetHead_Handler,
+		},
+		{
+			MethodName: "SimulateHead",
+			Handler:    _Debug_SimulateHead_Handler,
+		},
+		{
+			MethodName: "PruneForkChoice",
+			Handler:    _Debug_PruneForkChoice_Handler,
+		},
+		{
+			MethodName: "ListArrivalEvents",
+			Handler:    _Debug_ListArrivalEvents_Handler,
+		},
+		{
+			MethodName: "ListCaches",
+			Handler:    _Debug_ListCaches_Handler,
+		},
+		{
+			MethodName: "FlushCache",
+			Handler:    _Debug_FlushCache_Handler,
+		},
+		{
+			MethodName: "GetArchivalConfig",
+			Handler:    _Debug_GetArchivalConfig_Handler,
+		},
+		{
+			MethodName: "SetArchivalConfig",
+			Handler:    _Debug_SetArchivalConfig_Handler,
+		},
+		{
+			MethodName: "ListReplayCosts",
+			Handler:    _Debug_ListReplayCosts_Handler,
+		},
+		{
+			MethodName: "ExportState",
+			Handler:    _Debug_ExportState_Handler,
+		},
+		{
+			MethodName: "PruneDatabase",
+			Handler:    _Debug_PruneDatabase_Handler,
+		},
+		{
+			MethodName: "GetFinalizedBlockRootBySlot",
+			Handler:    _Debug_GetFinalizedBlockRootBySlot_Handler,
+		},
+		{
+			MethodName: "GetValidatorEpochRewards",
+			Handler:    _Debug_GetValidatorEpochRewards_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadBlock",
+			Handler:       _Debug_DownloadBlock_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadBeaconState",
+			Handler:       _Debug_DownloadBeaconState_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/debug.proto",
+}
+
+func (m *SSZDownloadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSZDownloadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSZDownloadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Snappy {
+		i--
+		if m.Snappy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.QueryFilter != nil {
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSZDownloadRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSZDownloadRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *SSZDownloadRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSZDownloadRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockRoot != nil {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SSZChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSZChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSZChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEpochRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Validators) > 0 {
+		dAtA2 := make([]byte, len(m.Validators)*10)
+		var j1 int
+		for _, num := range m.Validators {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintDebug(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEpochRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorEpochRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorEpochRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorEpochRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InactivityPenalty != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.InactivityPenalty))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HeadPenalty != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.HeadPenalty))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TargetPenalty != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TargetPenalty))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SourcePenalty != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.SourcePenalty))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ProposerReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ProposerReward))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InclusionDelayReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.InclusionDelayReward))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HeadReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.HeadReward))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TargetReward))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceReward != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.SourceReward))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizedBlockRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizedBlockRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizedBlockRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizedBlockRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizedBlockRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizedBlockRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GeneralizedIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.GeneralizedIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StateSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.StateSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Snappy {
+		i--
+		if m.Snappy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExportStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PruneDatabaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruneDatabaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruneDatabaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReclaimedBytes != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ReclaimedBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeletedBlocks != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.DeletedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DeletedStates != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.DeletedStates))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CachesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CachesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CachesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Caches) > 0 {
+		for iNdEx := len(m.Caches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Caches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CacheInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CacheInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CacheInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HitRatio != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.HitRatio))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.Misses != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Hits != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Hits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EstimatedBytes != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.EstimatedBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Entries != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Entries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlushCacheRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushCacheRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlushCacheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivalConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivalConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivalConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SlotsPerArchivedPoint != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.SlotsPerArchivedPoint))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivalMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivalMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivalMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DeletedStates != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.DeletedStates))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SavedStates != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.SavedStates))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SlotsPerArchivedPoint != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.SlotsPerArchivedPoint))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PreviousSlotsPerArchivedPoint != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PreviousSlotsPerArchivedPoint))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayCostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayCostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayCostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Slots) > 0 {
+		dAtA4 := make([]byte, len(m.Slots)*10)
+		var j3 int
+		for _, num := range m.Slots {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintDebug(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayCostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayCostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayCostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Costs) > 0 {
+		for iNdEx := len(m.Costs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Costs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slots != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slots))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InMemory {
+		i--
+		if m.InMemory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.StartRoot) > 0 {
+		i -= len(m.StartRoot)
+		copy(dAtA[i:], m.StartRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.StartRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.StartSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArrivalEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArrivalEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArrivalEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArrivalEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArrivalEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArrivalEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArrivalEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArrivalEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArrivalEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Late {
+		i--
+		if m.Late {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DelayMilliseconds != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.DelayMilliseconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Kind != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecentReorgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecentReorgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecentReorgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reorgs) > 0 {
+		for iNdEx := len(m.Reorgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reorgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Reorg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reorg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reorg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TimeUnixMilliseconds != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TimeUnixMilliseconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Depth != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CommonAncestor) > 0 {
+		i -= len(m.CommonAncestor)
+		copy(dAtA[i:], m.CommonAncestor)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.CommonAncestor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewRoot) > 0 {
+		i -= len(m.NewRoot)
+		copy(dAtA[i:], m.NewRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.NewRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NewSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OldRoot) > 0 {
+		i -= len(m.OldRoot)
+		copy(dAtA[i:], m.OldRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.OldRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OldSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.OldSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CachedStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CachedStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CachedStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.States) > 0 {
+		for iNdEx := len(m.States) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.States[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CachedState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CachedState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CachedState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cache) > 0 {
+		i -= len(m.Cache)
+		copy(dAtA[i:], m.Cache)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Cache)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PruneForkChoiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruneForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruneForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NodeCount != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NodeCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PrunedNodes != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PrunedNodes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateHeadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateHeadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateHeadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BranchAttestations != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BranchAttestations))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BranchRoot) > 0 {
+		i -= len(m.BranchRoot)
+		copy(dAtA[i:], m.BranchRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BranchRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MissedSlots != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.MissedSlots))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateHeadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateHeadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateHeadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reorg {
+		i--
+		if m.Reorg {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SimulatedHeadWeight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.SimulatedHeadWeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SimulatedHeadRoot) > 0 {
+		i -= len(m.SimulatedHeadRoot)
+		copy(dAtA[i:], m.SimulatedHeadRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.SimulatedHeadRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SimulatedHeadSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.SimulatedHeadSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HeadRoot) > 0 {
+		i -= len(m.HeadRoot)
+		copy(dAtA[i:], m.HeadRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.HeadRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HeadSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.HeadSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetHeadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetHeadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetHeadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InclusionSlotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InclusionSlotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InclusionSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InclusionSlotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InclusionSlotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InclusionSlotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QueryFilter != nil {
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *BeaconStateRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockRoot != nil {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSZResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSZResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSZResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Encoded) > 0 {
+		i -= len(m.Encoded)
+		copy(dAtA[i:], m.Encoded)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoggingLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoggingLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoggingLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Level != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtoArrayForkChoiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoArrayForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtoArrayForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		for k := range m.Indices {
+			v := m.Indices[k]
+			baseI := i
+			i = encodeVarintDebug(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ProtoArrayNodes) > 0 {
+		for iNdEx := len(m.ProtoArrayNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtoArrayNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PruneThreshold != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PruneThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtoArrayNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoArrayNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtoArrayNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BestDescendant != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BestDescendant))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BestChild != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BestChild))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Weight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Parent != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ScoreInfo != nil {
+		{
+			size, err := m.ScoreInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastUpdated != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PeerStatus != nil {
+		{
+			size, err := m.PeerStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PeerInfo != nil {
+		{
+			size, err := m.PeerInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Enr) > 0 {
+		i -= len(m.Enr)
+		copy(dAtA[i:], m.Enr)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Enr)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ConnectionState != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ConnectionState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Direction != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ListeningAddresses) > 0 {
+		for iNdEx := len(m.ListeningAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ListeningAddresses[iNdEx])
+			copy(dAtA[i:], m.ListeningAddresses[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.ListeningAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponse_PeerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponse_PeerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponse_PeerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PeerLatency != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PeerLatency))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AgentVersion) > 0 {
+		i -= len(m.AgentVersion)
+		copy(dAtA[i:], m.AgentVersion)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.AgentVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProtocolVersion) > 0 {
+		i -= len(m.ProtocolVersion)
+		copy(dAtA[i:], m.ProtocolVersion)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.ProtocolVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FaultCount != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FaultCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Protocols) > 0 {
+		for iNdEx := len(m.Protocols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Protocols[iNdEx])
+			copy(dAtA[i:], m.Protocols[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.Protocols[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScoreInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScoreInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidationError) > 0 {
+		i -= len(m.ValidationError)
+		copy(dAtA[i:], m.ValidationError)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.ValidationError)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BehaviourPenalty != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BehaviourPenalty))))
+		i--
+		dAtA[i] = 0x35
+	}
+	if m.GossipScore != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GossipScore))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if len(m.TopicScores) > 0 {
+		for k := range m.TopicScores {
+			v := m.TopicScores[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintDebug(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BlockProviderScore != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BlockProviderScore))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.ProcessedBlocks != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ProcessedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OverallScore != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OverallScore))))
+		i--
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicScoreSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicScoreSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicScoreSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InvalidMessageDeliveries != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.InvalidMessageDeliveries))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.MeshMessageDeliveries != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.MeshMessageDeliveries))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.FirstMessageDeliveries != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.FirstMessageDeliveries))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.TimeInMesh != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.TimeInMesh))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDebug(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SSZDownloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.Snappy {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSZDownloadRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovDebug(uint64(m.Slot))
+	return n
+}
+func (m *SSZDownloadRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	return n
+}
+func (m *SSZChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovDebug(uint64(m.Offset))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovDebug(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorEpochRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovDebug(uint64(m.Epoch))
+	}
+	if len(m.Validators) > 0 {
+		l = 0
+		for _, e := range m.Validators {
+			l += sovDebug(uint64(e))
+		}
+		n += 1 + sovDebug(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorEpochRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovDebug(uint64(m.Epoch))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorEpochRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovDebug(uint64(m.ValidatorIndex))
+	}
+	if m.SourceReward != 0 {
+		n += 1 + sovDebug(uint64(m.SourceReward))
+	}
+	if m.TargetReward != 0 {
+		n += 1 + sovDebug(uint64(m.TargetReward))
+	}
+	if m.HeadReward != 0 {
+		n += 1 + sovDebug(uint64(m.HeadReward))
+	}
+	if m.InclusionDelayReward != 0 {
+		n += 1 + sovDebug(uint64(m.InclusionDelayReward))
+	}
+	if m.ProposerReward != 0 {
+		n += 1 + sovDebug(uint64(m.ProposerReward))
+	}
+	if m.SourcePenalty != 0 {
+		n += 1 + sovDebug(uint64(m.SourcePenalty))
+	}
+	if m.TargetPenalty != 0 {
+		n += 1 + sovDebug(uint64(m.TargetPenalty))
+	}
+	if m.HeadPenalty != 0 {
+		n += 1 + sovDebug(uint64(m.HeadPenalty))
+	}
+	if m.InactivityPenalty != 0 {
+		n += 1 + sovDebug(uint64(m.InactivityPenalty))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FinalizedBlockRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FinalizedBlockRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.StateSlot != 0 {
+		n += 1 + sovDebug(uint64(m.StateSlot))
+	}
+	if m.GeneralizedIndex != 0 {
+		n += 1 + sovDebug(uint64(m.GeneralizedIndex))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.Snappy {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PruneDatabaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeletedStates != 0 {
+		n += 1 + sovDebug(uint64(m.DeletedStates))
+	}
+	if m.DeletedBlocks != 0 {
+		n += 1 + sovDebug(uint64(m.DeletedBlocks))
+	}
+	if m.ReclaimedBytes != 0 {
+		n += 1 + sovDebug(uint64(m.ReclaimedBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CachesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Caches) > 0 {
+		for _, e := range m.Caches {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CacheInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Entries != 0 {
+		n += 1 + sovDebug(uint64(m.Entries))
+	}
+	if m.EstimatedBytes != 0 {
+		n += 1 + sovDebug(uint64(m.EstimatedBytes))
+	}
+	if m.Hits != 0 {
+		n += 1 + sovDebug(uint64(m.Hits))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovDebug(uint64(m.Misses))
+	}
+	if m.HitRatio != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FlushCacheRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivalConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlotsPerArchivedPoint != 0 {
+		n += 1 + sovDebug(uint64(m.SlotsPerArchivedPoint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArchivalMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousSlotsPerArchivedPoint != 0 {
+		n += 1 + sovDebug(uint64(m.PreviousSlotsPerArchivedPoint))
+	}
+	if m.SlotsPerArchivedPoint != 0 {
+		n += 1 + sovDebug(uint64(m.SlotsPerArchivedPoint))
+	}
+	if m.SavedStates != 0 {
+		n += 1 + sovDebug(uint64(m.SavedStates))
+	}
+	if m.DeletedStates != 0 {
+		n += 1 + sovDebug(uint64(m.DeletedStates))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplayCostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slots) > 0 {
+		l = 0
+		for _, e := range m.Slots {
+			l += sovDebug(uint64(e))
+		}
+		n += 1 + sovDebug(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplayCostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Costs) > 0 {
+		for _, e := range m.Costs {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplayCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.StartSlot != 0 {
+		n += 1 + sovDebug(uint64(m.StartSlot))
+	}
+	l = len(m.StartRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.InMemory {
+		n += 2
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovDebug(uint64(m.Blocks))
+	}
+	if m.Slots != 0 {
+		n += 1 + sovDebug(uint64(m.Slots))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArrivalEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovDebug(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArrivalEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ArrivalEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovDebug(uint64(m.Kind))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovDebug(uint64(m.ValidatorIndex))
+	}
+	if m.DelayMilliseconds != 0 {
+		n += 1 + sovDebug(uint64(m.DelayMilliseconds))
+	}
+	if m.Late {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecentReorgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reorgs) > 0 {
+		for _, e := range m.Reorgs {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Reorg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldSlot != 0 {
+		n += 1 + sovDebug(uint64(m.OldSlot))
+	}
+	l = len(m.OldRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.NewSlot != 0 {
+		n += 1 + sovDebug(uint64(m.NewSlot))
+	}
+	l = len(m.NewRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.CommonAncestor)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovDebug(uint64(m.Depth))
+	}
+	if m.TimeUnixMilliseconds != 0 {
+		n += 1 + sovDebug(uint64(m.TimeUnixMilliseconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CachedStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for _, e := range m.States {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CachedState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cache)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PruneForkChoiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrunedNodes != 0 {
+		n += 1 + sovDebug(uint64(m.PrunedNodes))
+	}
+	if m.NodeCount != 0 {
+		n += 1 + sovDebug(uint64(m.NodeCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SimulateHeadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissedSlots != 0 {
+		n += 1 + sovDebug(uint64(m.MissedSlots))
+	}
+	l = len(m.BranchRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.BranchAttestations != 0 {
+		n += 1 + sovDebug(uint64(m.BranchAttestations))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SimulateHeadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HeadSlot != 0 {
+		n += 1 + sovDebug(uint64(m.HeadSlot))
+	}
+	l = len(m.HeadRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.SimulatedHeadSlot != 0 {
+		n += 1 + sovDebug(uint64(m.SimulatedHeadSlot))
+	}
+	l = len(m.SimulatedHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.SimulatedHeadWeight != 0 {
+		n += 1 + sovDebug(uint64(m.SimulatedHeadWeight))
+	}
+	if m.Reorg {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetHeadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InclusionSlotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDebug(uint64(m.Id))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InclusionSlotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovDebug(uint64(m.Slot))
+	return n
+}
+func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	return n
+}
+func (m *BlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSZResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Encoded)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LoggingLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovDebug(uint64(m.Level))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoArrayForkChoiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PruneThreshold != 0 {
+		n += 1 + sovDebug(uint64(m.PruneThreshold))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if len(m.ProtoArrayNodes) > 0 {
+		for _, e := range m.ProtoArrayNodes {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		for k, v := range m.Indices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + 1 + sovDebug(uint64(v))
+			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoArrayNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovDebug(uint64(m.Parent))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovDebug(uint64(m.Weight))
+	}
+	if m.BestChild != 0 {
+		n += 1 + sovDebug(uint64(m.BestChild))
+	}
+	if m.BestDescendant != 0 {
+		n += 1 + sovDebug(uint64(m.BestDescendant))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ListeningAddresses) > 0 {
+		for _, s := range m.ListeningAddresses {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.Direction != 0 {
+		n += 1 + sovDebug(uint64(m.Direction))
+	}
+	if m.ConnectionState != 0 {
+		n += 1 + sovDebug(uint64(m.ConnectionState))
+	}
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Enr)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerInfo != nil {
+		l = m.PeerInfo.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerStatus != nil {
+		l = m.PeerStatus.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.LastUpdated != 0 {
+		n += 1 + sovDebug(uint64(m.LastUpdated))
+	}
+	if m.ScoreInfo != nil {
+		l = m.ScoreInfo.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponse_PeerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Protocols) > 0 {
+		for _, s := range m.Protocols {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.FaultCount != 0 {
+		n += 1 + sovDebug(uint64(m.FaultCount))
+	}
+	l = len(m.ProtocolVersion)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.AgentVersion)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerLatency != 0 {
+		n += 1 + sovDebug(uint64(m.PeerLatency))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScoreInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OverallScore != 0 {
+		n += 5
+	}
+	if m.ProcessedBlocks != 0 {
+		n += 1 + sovDebug(uint64(m.ProcessedBlocks))
+	}
+	if m.BlockProviderScore != 0 {
+		n += 5
+	}
+	if len(m.TopicScores) > 0 {
+		for k, v := range m.TopicScores {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovDebug(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+		}
+	}
+	if m.GossipScore != 0 {
+		n += 5
+	}
+	if m.BehaviourPenalty != 0 {
+		n += 5
+	}
+	l = len(m.ValidationError)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TopicScoreSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeInMesh != 0 {
+		n += 1 + sovDebug(uint64(m.TimeInMesh))
+	}
+	if m.FirstMessageDeliveries != 0 {
+		n += 5
+	}
+	if m.MeshMessageDeliveries != 0 {
+		n += 5
+	}
+	if m.InvalidMessageDeliveries != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDebug(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDebug(x uint64) (n int) {
+	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SSZDownloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSZDownloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSZDownloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v github_com_prysmaticlabs_eth2_types.Slot
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &SSZDownloadRequest_Slot{v}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &SSZDownloadRequest_BlockRoot{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snappy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Snappy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSZChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSZChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSZChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEpochRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEpochRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEpochRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Validators = append(m.Validators, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDebug
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDebug
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Validators) == 0 {
+					m.Validators = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Validators = append(m.Validators, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEpochRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEpochRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEpochRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &ValidatorEpochRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorEpochRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorEpochRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorEpochRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceReward", wireType)
+			}
+			m.SourceReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReward", wireType)
+			}
+			m.TargetReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadReward", wireType)
+			}
+			m.HeadReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDelayReward", wireType)
+			}
+			m.InclusionDelayReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDelayReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
+			}
+			m.ProposerReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePenalty", wireType)
+			}
+			m.SourcePenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourcePenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPenalty", wireType)
+			}
+			m.TargetPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadPenalty", wireType)
+			}
+			m.HeadPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenalty", wireType)
+			}
+			m.InactivityPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivityPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizedBlockRootRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizedBlockRootRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizedBlockRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizedBlockRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizedBlockRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizedBlockRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateSlot", wireType)
+			}
+			m.StateSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralizedIndex", wireType)
+			}
+			m.GeneralizedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GeneralizedIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snappy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Snappy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
+			if m.State == nil {
+				m.State = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = append(m.Block[:0], dAtA[iNdEx:postIndex]...)
+			if m.Block == nil {
+				m.Block = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PruneDatabaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruneDatabaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruneDatabaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedStates", wireType)
+			}
+			m.DeletedStates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletedStates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBlocks", wireType)
+			}
+			m.DeletedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReclaimedBytes", wireType)
+			}
+			m.ReclaimedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReclaimedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CachesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CachesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CachesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caches = append(m.Caches, &CacheInfo{})
+			if err := m.Caches[len(m.Caches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CacheInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CacheInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			m.Entries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedBytes", wireType)
+			}
+			m.EstimatedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
+			}
+			m.Hits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HitRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.HitRatio = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlushCacheRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlushCacheRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlushCacheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivalConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivalConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivalConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotsPerArchivedPoint", wireType)
+			}
+			m.SlotsPerArchivedPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotsPerArchivedPoint |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivalMigrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivalMigrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivalMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSlotsPerArchivedPoint", wireType)
+			}
+			m.PreviousSlotsPerArchivedPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousSlotsPerArchivedPoint |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotsPerArchivedPoint", wireType)
+			}
+			m.SlotsPerArchivedPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotsPerArchivedPoint |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SavedStates", wireType)
+			}
+			m.SavedStates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SavedStates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedStates", wireType)
+			}
+			m.DeletedStates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletedStates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayCostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayCostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayCostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.Slot
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Slots = append(m.Slots, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDebug
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDebug
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Slots) == 0 {
+					m.Slots = make([]github_com_prysmaticlabs_eth2_types.Slot, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.Slot
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Slots = append(m.Slots, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayCostsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayCostsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayCostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Costs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Costs = append(m.Costs, &ReplayCost{})
+			if err := m.Costs[len(m.Costs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartRoot = append(m.StartRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartRoot == nil {
+				m.StartRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InMemory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InMemory = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			m.Slots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slots |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArrivalEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArrivalEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArrivalEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArrivalEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArrivalEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArrivalEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &ArrivalEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArrivalEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArrivalEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArrivalEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= ArrivalEvent_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayMilliseconds", wireType)
+			}
+			m.DelayMilliseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayMilliseconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Late", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Late = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecentReorgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecentReorgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecentReorgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reorgs = append(m.Reorgs, &Reorg{})
+			if err := m.Reorgs[len(m.Reorgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reorg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reorg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reorg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldSlot", wireType)
+			}
+			m.OldSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldRoot = append(m.OldRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldRoot == nil {
+				m.OldRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSlot", wireType)
+			}
+			m.NewSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRoot = append(m.NewRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewRoot == nil {
+				m.NewRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommonAncestor = append(m.CommonAncestor[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommonAncestor == nil {
+				m.CommonAncestor = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeUnixMilliseconds", wireType)
+			}
+			m.TimeUnixMilliseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeUnixMilliseconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CachedStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CachedStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CachedStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.States = append(m.States, &CachedState{})
+			if err := m.States[len(m.States)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CachedState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CachedState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CachedState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cache = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PruneForkChoiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruneForkChoiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruneForkChoiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedNodes", wireType)
+			}
+			m.PrunedNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrunedNodes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeCount", wireType)
+			}
+			m.NodeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateHeadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateHeadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateHeadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSlots", wireType)
+			}
+			m.MissedSlots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedSlots |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchRoot = append(m.BranchRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchRoot == nil {
+				m.BranchRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchAttestations", wireType)
+			}
+			m.BranchAttestations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BranchAttestations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateHeadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateHeadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateHeadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeadRoot = append(m.HeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeadRoot == nil {
+				m.HeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimulatedHeadSlot", wireType)
+			}
+			m.SimulatedHeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SimulatedHeadSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimulatedHeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SimulatedHeadRoot = append(m.SimulatedHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.SimulatedHeadRoot == nil {
+				m.SimulatedHeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimulatedHeadWeight", wireType)
+			}
+			m.SimulatedHeadWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SimulatedHeadWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorg", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reorg = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetHeadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetHeadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetHeadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
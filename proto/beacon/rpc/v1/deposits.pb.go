@@ -0,0 +1,570 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/deposits.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type PendingDepositsResponse struct {
+	HeadEth1Data         *v1alpha1.Eth1Data  `protobuf:"bytes,1,opt,name=head_eth1_data,json=headEth1Data,proto3" json:"head_eth1_data,omitempty"`
+	Eth1DepositIndex     uint64              `protobuf:"varint,2,opt,name=eth1_deposit_index,json=eth1DepositIndex,proto3" json:"eth1_deposit_index,omitempty"`
+	KnownDepositCount    uint64              `protobuf:"varint,3,opt,name=known_deposit_count,json=knownDepositCount,proto3" json:"known_deposit_count,omitempty"`
+	NextBlockDeposits    []*v1alpha1.Deposit `protobuf:"bytes,4,rep,name=next_block_deposits,json=nextBlockDeposits,proto3" json:"next_block_deposits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PendingDepositsResponse) Reset()         { *m = PendingDepositsResponse{} }
+func (m *PendingDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingDepositsResponse) ProtoMessage()    {}
+func (*PendingDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdc1d9ddd6c023e8, []int{0}
+}
+func (m *PendingDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDepositsResponse.Merge(m, src)
+}
+func (m *PendingDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDepositsResponse proto.InternalMessageInfo
+
+func (m *PendingDepositsResponse) GetHeadEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.HeadEth1Data
+	}
+	return nil
+}
+
+func (m *PendingDepositsResponse) GetEth1DepositIndex() uint64 {
+	if m != nil {
+		return m.Eth1DepositIndex
+	}
+	return 0
+}
+
+func (m *PendingDepositsResponse) GetKnownDepositCount() uint64 {
+	if m != nil {
+		return m.KnownDepositCount
+	}
+	return 0
+}
+
+func (m *PendingDepositsResponse) GetNextBlockDeposits() []*v1alpha1.Deposit {
+	if m != nil {
+		return m.NextBlockDeposits
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PendingDepositsResponse)(nil), "ethereum.beacon.rpc.v1.PendingDepositsResponse")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/deposits.proto", fileDescriptor_cdc1d9ddd6c023e8)
+}
+
+var fileDescriptor_cdc1d9ddd6c023e8 = []byte{
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xdf, 0xca, 0xd3, 0x30,
+	0x14, 0x27, 0xdb, 0x10, 0xc9, 0x86, 0xb8, 0x0c, 0x66, 0xa9, 0xd2, 0x8d, 0x5d, 0x15, 0x91, 0xc4,
+	0xce, 0x37, 0x98, 0x1b, 0xe2, 0x8d, 0x48, 0x5f, 0xa0, 0xa4, 0xed, 0x71, 0x2d, 0xeb, 0x92, 0xd0,
+	0x9e, 0xd5, 0x79, 0xeb, 0x03, 0xe8, 0x85, 0xb7, 0x3e, 0x90, 0x97, 0x82, 0x2f, 0x20, 0xc3, 0x07,
+	0x91, 0x36, 0xed, 0xe0, 0xfb, 0xf8, 0x76, 0x79, 0xf2, 0xfb, 0x93, 0x5f, 0x7e, 0x27, 0x74, 0x65,
+	0x4a, 0x8d, 0x5a, 0xc4, 0x20, 0x13, 0xad, 0x44, 0x69, 0x12, 0x51, 0x07, 0x22, 0x05, 0xa3, 0xab,
+	0x1c, 0x2b, 0xde, 0x82, 0x6c, 0x0e, 0x98, 0x41, 0x09, 0xa7, 0x23, 0xb7, 0x34, 0x5e, 0x9a, 0x84,
+	0xd7, 0x81, 0xbb, 0x00, 0xcc, 0x44, 0x1d, 0xc8, 0xc2, 0x64, 0x32, 0xe8, 0x2c, 0xa2, 0xb8, 0xd0,
+	0xc9, 0xc1, 0x0a, 0xdd, 0x17, 0x7b, 0xad, 0xf7, 0x05, 0x08, 0x69, 0x72, 0x21, 0x95, 0xd2, 0x28,
+	0x31, 0xd7, 0xaa, 0xb3, 0x75, 0x9f, 0x77, 0x68, 0x3b, 0xc5, 0xa7, 0x4f, 0x02, 0x8e, 0x06, 0xbf,
+	0x58, 0x70, 0xf5, 0x6d, 0x40, 0x9f, 0x7d, 0x04, 0x95, 0xe6, 0x6a, 0xbf, 0xed, 0xd2, 0x84, 0x50,
+	0x19, 0xad, 0x2a, 0x60, 0x3b, 0xfa, 0x24, 0x03, 0x99, 0x46, 0x80, 0x59, 0x10, 0xa5, 0x12, 0xa5,
+	0x43, 0x96, 0xc4, 0x1f, 0xaf, 0x17, 0xfc, 0x1a, 0x14, 0x30, 0xe3, 0x7d, 0x32, 0xbe, 0xc3, 0x2c,
+	0xd8, 0x4a, 0x94, 0xe1, 0xa4, 0x91, 0xf5, 0x13, 0x7b, 0x45, 0x99, 0x75, 0xb0, 0xfe, 0x51, 0xae,
+	0x52, 0x38, 0x3b, 0x83, 0x25, 0xf1, 0x47, 0xe1, 0xd3, 0x06, 0xe9, 0x2e, 0x7e, 0xdf, 0x9c, 0x33,
+	0x4e, 0x67, 0x07, 0xa5, 0x3f, 0xab, 0x2b, 0x3d, 0xd1, 0x27, 0x85, 0xce, 0xb0, 0xa5, 0x4f, 0x5b,
+	0xa8, 0xe3, 0xbf, 0x6d, 0x00, 0xf6, 0x81, 0xce, 0x14, 0x9c, 0xd1, 0xf6, 0xd1, 0x8b, 0x2a, 0x67,
+	0xb4, 0x1c, 0xfa, 0xe3, 0xb5, 0x77, 0x23, 0x69, 0xe7, 0x10, 0x4e, 0x1b, 0xe9, 0xa6, 0x51, 0xf6,
+	0x8f, 0x5f, 0xff, 0x24, 0xf4, 0x71, 0x3f, 0xb0, 0xef, 0x84, 0xb2, 0x77, 0x80, 0xf7, 0x0a, 0x62,
+	0x73, 0x6e, 0x2b, 0xe5, 0x7d, 0xa5, 0x7c, 0xd7, 0x54, 0xea, 0x0a, 0xfe, 0xf0, 0x06, 0xf9, 0x8d,
+	0x86, 0x57, 0xaf, 0xbf, 0xfe, 0xf9, 0xf7, 0x63, 0xf0, 0x92, 0xf9, 0xe2, 0xce, 0x8a, 0x6b, 0x59,
+	0xe4, 0xa9, 0x44, 0x5d, 0x5e, 0x7f, 0x88, 0x30, 0xd6, 0x61, 0x33, 0xf9, 0x75, 0xf1, 0xc8, 0xef,
+	0x8b, 0x47, 0xfe, 0x5e, 0x3c, 0x12, 0x3f, 0x6a, 0x03, 0xbc, 0xf9, 0x3f, 0x00, 0xfe, 0xdb, 0xae,
+	0xa8, 0x5e, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DepositsClient is the client API for Deposits service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DepositsClient interface {
+	GetPendingDeposits(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PendingDepositsResponse, error)
+}
+
+type depositsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDepositsClient(cc *grpc.ClientConn) DepositsClient {
+	return &depositsClient{cc}
+}
+
+func (c *depositsClient) GetPendingDeposits(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PendingDepositsResponse, error) {
+	out := new(PendingDepositsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Deposits/GetPendingDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DepositsServer is the server API for Deposits service.
+type DepositsServer interface {
+	GetPendingDeposits(context.Context, *empty.Empty) (*PendingDepositsResponse, error)
+}
+
+// UnimplementedDepositsServer can be embedded to have forward compatible implementations.
+type UnimplementedDepositsServer struct {
+}
+
+func (*UnimplementedDepositsServer) GetPendingDeposits(ctx context.Context, req *empty.Empty) (*PendingDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingDeposits not implemented")
+}
+
+func RegisterDepositsServer(s *grpc.Server, srv DepositsServer) {
+	s.RegisterService(&_Deposits_serviceDesc, srv)
+}
+
+func _Deposits_GetPendingDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepositsServer).GetPendingDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Deposits/GetPendingDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepositsServer).GetPendingDeposits(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Deposits_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Deposits",
+	HandlerType: (*DepositsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPendingDeposits",
+			Handler:    _Deposits_GetPendingDeposits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/deposits.proto",
+}
+
+func (m *PendingDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextBlockDeposits) > 0 {
+		for iNdEx := len(m.NextBlockDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NextBlockDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDeposits(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.KnownDepositCount != 0 {
+		i = encodeVarintDeposits(dAtA, i, uint64(m.KnownDepositCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Eth1DepositIndex != 0 {
+		i = encodeVarintDeposits(dAtA, i, uint64(m.Eth1DepositIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HeadEth1Data != nil {
+		{
+			size, err := m.HeadEth1Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDeposits(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDeposits(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDeposits(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HeadEth1Data != nil {
+		l = m.HeadEth1Data.Size()
+		n += 1 + l + sovDeposits(uint64(l))
+	}
+	if m.Eth1DepositIndex != 0 {
+		n += 1 + sovDeposits(uint64(m.Eth1DepositIndex))
+	}
+	if m.KnownDepositCount != 0 {
+		n += 1 + sovDeposits(uint64(m.KnownDepositCount))
+	}
+	if len(m.NextBlockDeposits) > 0 {
+		for _, e := range m.NextBlockDeposits {
+			l = e.Size()
+			n += 1 + l + sovDeposits(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDeposits(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDeposits(x uint64) (n int) {
+	return sovDeposits(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeposits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadEth1Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeadEth1Data == nil {
+				m.HeadEth1Data = &v1alpha1.Eth1Data{}
+			}
+			if err := m.HeadEth1Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositIndex", wireType)
+			}
+			m.Eth1DepositIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1DepositIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownDepositCount", wireType)
+			}
+			m.KnownDepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KnownDepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBlockDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextBlockDeposits = append(m.NextBlockDeposits, &v1alpha1.Deposit{})
+			if err := m.NextBlockDeposits[len(m.NextBlockDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeposits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDeposits(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDeposits
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDeposits
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDeposits
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDeposits
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDeposits        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDeposits          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDeposits = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,996 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/doppelganger.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DoppelGangerRequest struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch            `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	ValidatorIndices     []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,rep,packed,name=validator_indices,json=validatorIndices,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validator_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                             `json:"-"`
+	XXX_unrecognized     []byte                                               `json:"-"`
+	XXX_sizecache        int32                                                `json:"-"`
+}
+
+func (m *DoppelGangerRequest) Reset()         { *m = DoppelGangerRequest{} }
+func (m *DoppelGangerRequest) String() string { return proto.CompactTextString(m) }
+func (*DoppelGangerRequest) ProtoMessage()    {}
+func (*DoppelGangerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29897f210b4b285b, []int{0}
+}
+func (m *DoppelGangerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoppelGangerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoppelGangerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoppelGangerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoppelGangerRequest.Merge(m, src)
+}
+func (m *DoppelGangerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoppelGangerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoppelGangerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoppelGangerRequest proto.InternalMessageInfo
+
+func (m *DoppelGangerRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *DoppelGangerRequest) GetValidatorIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+type DoppelGangerResponse struct {
+	CurrentEpoch         github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"current_epoch,omitempty"`
+	Validators           []*ValidatorLiveness                      `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *DoppelGangerResponse) Reset()         { *m = DoppelGangerResponse{} }
+func (m *DoppelGangerResponse) String() string { return proto.CompactTextString(m) }
+func (*DoppelGangerResponse) ProtoMessage()    {}
+func (*DoppelGangerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29897f210b4b285b, []int{1}
+}
+func (m *DoppelGangerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoppelGangerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoppelGangerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoppelGangerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoppelGangerResponse.Merge(m, src)
+}
+func (m *DoppelGangerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoppelGangerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoppelGangerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoppelGangerResponse proto.InternalMessageInfo
+
+func (m *DoppelGangerResponse) GetCurrentEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+func (m *DoppelGangerResponse) GetValidators() []*ValidatorLiveness {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type ValidatorLiveness struct {
+	ValidatorIndex       github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validator_index,omitempty"`
+	IsLive               bool                                               `protobuf:"varint,2,opt,name=is_live,json=isLive,proto3" json:"is_live,omitempty"`
+	LastActiveSlot       github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,3,opt,name=last_active_slot,json=lastActiveSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"last_active_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *ValidatorLiveness) Reset()         { *m = ValidatorLiveness{} }
+func (m *ValidatorLiveness) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLiveness) ProtoMessage()    {}
+func (*ValidatorLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29897f210b4b285b, []int{2}
+}
+func (m *ValidatorLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLiveness.Merge(m, src)
+}
+func (m *ValidatorLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLiveness proto.InternalMessageInfo
+
+func (m *ValidatorLiveness) GetValidatorIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorLiveness) GetIsLive() bool {
+	if m != nil {
+		return m.IsLive
+	}
+	return false
+}
+
+func (m *ValidatorLiveness) GetLastActiveSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.LastActiveSlot
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DoppelGangerRequest)(nil), "ethereum.beacon.rpc.v1.DoppelGangerRequest")
+	proto.RegisterType((*DoppelGangerResponse)(nil), "ethereum.beacon.rpc.v1.DoppelGangerResponse")
+	proto.RegisterType((*ValidatorLiveness)(nil), "ethereum.beacon.rpc.v1.ValidatorLiveness")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/doppelganger.proto", fileDescriptor_29897f210b4b285b)
+}
+
+var fileDescriptor_29897f210b4b285b = []byte{
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x8b, 0x13, 0x31,
+	0x14, 0x26, 0xdd, 0x75, 0x95, 0x58, 0xd7, 0xed, 0x28, 0x5a, 0x8a, 0xb4, 0x65, 0x10, 0x69, 0x75,
+	0x3b, 0xa1, 0x15, 0x3c, 0x78, 0xb3, 0xab, 0xc8, 0x82, 0xa7, 0x11, 0xf6, 0x3a, 0x64, 0xd2, 0xe7,
+	0x4c, 0x70, 0x9a, 0xc4, 0x49, 0x26, 0xec, 0x5e, 0xfd, 0x0b, 0xfe, 0x00, 0xff, 0x82, 0x17, 0xcf,
+	0x5e, 0x3d, 0x0a, 0xde, 0x8b, 0x14, 0xfd, 0x13, 0x3d, 0xc9, 0x64, 0xd6, 0xb5, 0x83, 0x15, 0x8a,
+	0xde, 0xf2, 0x85, 0xef, 0x7d, 0xdf, 0x7b, 0x5f, 0xf2, 0xf0, 0x3d, 0x95, 0x4b, 0x23, 0x49, 0x0c,
+	0x94, 0x49, 0x41, 0x72, 0xc5, 0x88, 0x1d, 0x93, 0x99, 0x54, 0x0a, 0xb2, 0x84, 0x8a, 0x04, 0xf2,
+	0xc0, 0x11, 0xbc, 0x5b, 0x60, 0x52, 0xc8, 0xa1, 0x98, 0x07, 0x15, 0x35, 0xc8, 0x15, 0x0b, 0xec,
+	0xb8, 0x73, 0x27, 0x91, 0x32, 0xc9, 0x80, 0x50, 0xc5, 0x09, 0x15, 0x42, 0x1a, 0x6a, 0xb8, 0x14,
+	0xba, 0xaa, 0xea, 0x8c, 0x12, 0x6e, 0xd2, 0x22, 0x0e, 0x98, 0x9c, 0x93, 0x44, 0x26, 0x92, 0xb8,
+	0xeb, 0xb8, 0x78, 0xe5, 0x50, 0x65, 0x5d, 0x9e, 0x2a, 0xba, 0xff, 0x09, 0xe1, 0x1b, 0x4f, 0x9d,
+	0xf7, 0x73, 0xe7, 0x1d, 0xc2, 0x9b, 0x02, 0xb4, 0xf1, 0x8e, 0xf0, 0x25, 0x50, 0x92, 0xa5, 0x6d,
+	0xd4, 0x47, 0x83, 0xdd, 0xe9, 0x68, 0xb5, 0xe8, 0x0d, 0xd7, 0x94, 0x55, 0x7e, 0xa6, 0xe7, 0xd4,
+	0x70, 0x96, 0xd1, 0x58, 0x13, 0x30, 0xe9, 0x64, 0x64, 0xce, 0x14, 0xe8, 0xe0, 0x59, 0x59, 0x14,
+	0x56, 0xb5, 0x1e, 0xc3, 0x2d, 0x4b, 0x33, 0x3e, 0xa3, 0x46, 0xe6, 0x11, 0x17, 0x33, 0xce, 0x40,
+	0xb7, 0x1b, 0xfd, 0x9d, 0xc1, 0xee, 0xf4, 0xd1, 0x6a, 0xd1, 0x9b, 0x6c, 0x23, 0x78, 0xf2, 0x4b,
+	0xe0, 0x58, 0xcc, 0xe0, 0x34, 0x3c, 0xb0, 0x6b, 0xb8, 0xd4, 0xf3, 0x3f, 0x22, 0x7c, 0xb3, 0x3e,
+	0x81, 0x56, 0x52, 0x68, 0xf0, 0x42, 0x7c, 0x8d, 0x15, 0x79, 0x0e, 0xc2, 0x44, 0xff, 0x31, 0x4a,
+	0xf3, 0x5c, 0xc3, 0x21, 0xef, 0x18, 0xe3, 0x8b, 0x06, 0xaa, 0x51, 0xae, 0x4e, 0x86, 0xc1, 0xe6,
+	0x87, 0xfa, 0xdd, 0xfa, 0x0b, 0x6e, 0x41, 0x80, 0xd6, 0xe1, 0x5a, 0xb1, 0xff, 0x03, 0xe1, 0xd6,
+	0x1f, 0x0c, 0x2f, 0xc2, 0xd7, 0x6b, 0x91, 0xc1, 0xe9, 0x79, 0xdb, 0xff, 0x1a, 0xd8, 0xbe, 0xad,
+	0x61, 0xef, 0x36, 0xbe, 0xcc, 0x75, 0x94, 0x71, 0x0b, 0xed, 0x46, 0x1f, 0x0d, 0xae, 0x84, 0x7b,
+	0x5c, 0x97, 0xee, 0xde, 0x09, 0x3e, 0xc8, 0xa8, 0x36, 0x11, 0x65, 0x86, 0x5b, 0x88, 0x74, 0x26,
+	0x4d, 0x7b, 0xc7, 0x59, 0x1f, 0xae, 0x16, 0xbd, 0xc1, 0x36, 0xd6, 0x2f, 0x33, 0x69, 0xc2, 0xfd,
+	0x52, 0xe5, 0x89, 0x13, 0x29, 0xf1, 0xe4, 0x03, 0xc2, 0xcd, 0xf5, 0xf7, 0xf1, 0xde, 0x23, 0xdc,
+	0x3a, 0x4a, 0x81, 0xbd, 0xae, 0xdd, 0x3e, 0xf8, 0x5b, 0x8a, 0x1b, 0x7e, 0x67, 0xe7, 0x70, 0x3b,
+	0x72, 0xf5, 0x11, 0x7c, 0xf2, 0xf6, 0xeb, 0xf7, 0x77, 0x8d, 0xa1, 0x7f, 0xb7, 0xec, 0x95, 0xd8,
+	0x31, 0xcd, 0x54, 0x4a, 0xc7, 0xe4, 0x22, 0x98, 0xda, 0xf2, 0x3d, 0x46, 0xf7, 0xa7, 0xcd, 0xcf,
+	0xcb, 0x2e, 0xfa, 0xb2, 0xec, 0xa2, 0x6f, 0xcb, 0x2e, 0x8a, 0xf7, 0xdc, 0xa6, 0x3c, 0xfc, 0x39,
+	0x00, 0x9a, 0x48, 0xf2, 0xa0, 0xb8, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DoppelGangerClient is the client API for DoppelGanger service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DoppelGangerClient interface {
+	CheckDoppelGanger(ctx context.Context, in *DoppelGangerRequest, opts ...grpc.CallOption) (*DoppelGangerResponse, error)
+}
+
+type doppelGangerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDoppelGangerClient(cc *grpc.ClientConn) DoppelGangerClient {
+	return &doppelGangerClient{cc}
+}
+
+func (c *doppelGangerClient) CheckDoppelGanger(ctx context.Context, in *DoppelGangerRequest, opts ...grpc.CallOption) (*DoppelGangerResponse, error) {
+	out := new(DoppelGangerResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.DoppelGanger/CheckDoppelGanger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DoppelGangerServer is the server API for DoppelGanger service.
+type DoppelGangerServer interface {
+	CheckDoppelGanger(context.Context, *DoppelGangerRequest) (*DoppelGangerResponse, error)
+}
+
+// UnimplementedDoppelGangerServer can be embedded to have forward compatible implementations.
+type UnimplementedDoppelGangerServer struct {
+}
+
+func (*UnimplementedDoppelGangerServer) CheckDoppelGanger(ctx context.Context, req *DoppelGangerRequest) (*DoppelGangerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDoppelGanger not implemented")
+}
+
+func RegisterDoppelGangerServer(s *grpc.Server, srv DoppelGangerServer) {
+	s.RegisterService(&_DoppelGanger_serviceDesc, srv)
+}
+
+func _DoppelGanger_CheckDoppelGanger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoppelGangerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoppelGangerServer).CheckDoppelGanger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.DoppelGanger/CheckDoppelGanger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoppelGangerServer).CheckDoppelGanger(ctx, req.(*DoppelGangerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DoppelGanger_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.DoppelGanger",
+	HandlerType: (*DoppelGangerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckDoppelGanger",
+			Handler:    _DoppelGanger_CheckDoppelGanger_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/doppelganger.proto",
+}
+
+func (m *DoppelGangerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoppelGangerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoppelGangerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ValidatorIndices) > 0 {
+		dAtA2 := make([]byte, len(m.ValidatorIndices)*10)
+		var j1 int
+		for _, num := range m.ValidatorIndices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintDoppelganger(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDoppelganger(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoppelGangerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoppelGangerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoppelGangerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDoppelganger(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintDoppelganger(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastActiveSlot != 0 {
+		i = encodeVarintDoppelganger(dAtA, i, uint64(m.LastActiveSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsLive {
+		i--
+		if m.IsLive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintDoppelganger(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDoppelganger(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDoppelganger(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DoppelGangerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovDoppelganger(uint64(m.Epoch))
+	}
+	if len(m.ValidatorIndices) > 0 {
+		l = 0
+		for _, e := range m.ValidatorIndices {
+			l += sovDoppelganger(uint64(e))
+		}
+		n += 1 + sovDoppelganger(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DoppelGangerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovDoppelganger(uint64(m.CurrentEpoch))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovDoppelganger(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovDoppelganger(uint64(m.ValidatorIndex))
+	}
+	if m.IsLive {
+		n += 2
+	}
+	if m.LastActiveSlot != 0 {
+		n += 1 + sovDoppelganger(uint64(m.LastActiveSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDoppelganger(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDoppelganger(x uint64) (n int) {
+	return sovDoppelganger(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DoppelGangerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoppelganger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoppelGangerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoppelGangerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoppelganger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDoppelganger
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ValidatorIndices = append(m.ValidatorIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDoppelganger
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDoppelganger
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDoppelganger
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ValidatorIndices) == 0 {
+					m.ValidatorIndices = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDoppelganger
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ValidatorIndices = append(m.ValidatorIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoppelganger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoppelganger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DoppelGangerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoppelganger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoppelGangerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoppelGangerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoppelganger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoppelganger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoppelganger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDoppelganger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &ValidatorLiveness{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoppelganger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoppelganger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDoppelganger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoppelganger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoppelganger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLive = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActiveSlot", wireType)
+			}
+			m.LastActiveSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoppelganger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastActiveSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDoppelganger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDoppelganger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDoppelganger(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDoppelganger
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDoppelganger
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDoppelganger
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDoppelganger
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDoppelganger
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDoppelganger
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDoppelganger        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDoppelganger          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDoppelganger = fmt.Errorf("proto: unexpected end of group")
+)
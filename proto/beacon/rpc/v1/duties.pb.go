@@ -0,0 +1,2447 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/duties.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type EpochDutiesRequest struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	PublicKeys           [][]byte                                  `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *EpochDutiesRequest) Reset()         { *m = EpochDutiesRequest{} }
+func (m *EpochDutiesRequest) String() string { return proto.CompactTextString(m) }
+func (*EpochDutiesRequest) ProtoMessage()    {}
+func (*EpochDutiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07858e0621f6813d, []int{0}
+}
+func (m *EpochDutiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochDutiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochDutiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochDutiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochDutiesRequest.Merge(m, src)
+}
+func (m *EpochDutiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochDutiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochDutiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochDutiesRequest proto.InternalMessageInfo
+
+func (m *EpochDutiesRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochDutiesRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type EpochDutiesResponse struct {
+	DependentRoot        []byte                          `protobuf:"bytes,1,opt,name=dependent_root,json=dependentRoot,proto3" json:"dependent_root,omitempty" ssz-size:"32"`
+	CurrentEpochDuties   []*v1alpha1.DutiesResponse_Duty `protobuf:"bytes,2,rep,name=current_epoch_duties,json=currentEpochDuties,proto3" json:"current_epoch_duties,omitempty"`
+	NextEpochDuties      []*v1alpha1.DutiesResponse_Duty `protobuf:"bytes,3,rep,name=next_epoch_duties,json=nextEpochDuties,proto3" json:"next_epoch_duties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *EpochDutiesResponse) Reset()         { *m = EpochDutiesResponse{} }
+func (m *EpochDutiesResponse) String() string { return proto.CompactTextString(m) }
+func (*EpochDutiesResponse) ProtoMessage()    {}
+func (*EpochDutiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07858e0621f6813d, []int{1}
+}
+func (m *EpochDutiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochDutiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochDutiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochDutiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochDutiesResponse.Merge(m, src)
+}
+func (m *EpochDutiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochDutiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochDutiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochDutiesResponse proto.InternalMessageInfo
+
+func (m *EpochDutiesResponse) GetDependentRoot() []byte {
+	if m != nil {
+		return m.DependentRoot
+	}
+	return nil
+}
+
+func (m *EpochDutiesResponse) GetCurrentEpochDuties() []*v1alpha1.DutiesResponse_Duty {
+	if m != nil {
+		return m.CurrentEpochDuties
+	}
+	return nil
+}
+
+func (m *EpochDutiesResponse) GetNextEpochDuties() []*v1alpha1.DutiesResponse_Duty {
+	if m != nil {
+		return m.NextEpochDuties
+	}
+	return nil
+}
+
+type StateProposerDutiesRequest struct {
+	StateId              []byte   `protobuf:"bytes,1,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateProposerDutiesRequest) Reset()         { *m = StateProposerDutiesRequest{} }
+func (m *StateProposerDutiesRequest) String() string { return proto.CompactTextString(m) }
+func (*StateProposerDutiesRequest) ProtoMessage()    {}
+func (*StateProposerDutiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07858e0621f6813d, []int{2}
+}
+func (m *StateProposerDutiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateProposerDutiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateProposerDutiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateProposerDutiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProposerDutiesRequest.Merge(m, src)
+}
+func (m *StateProposerDutiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateProposerDutiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProposerDutiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProposerDutiesRequest proto.InternalMessageInfo
+
+func (m *StateProposerDutiesRequest) GetStateId() []byte {
+	if m != nil {
+		return m.StateId
+	}
+	return nil
+}
+
+type StateProposerDutiesResponse struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Duties               []*StateProposerDuty                      `protobuf:"bytes,2,rep,name=duties,proto3" json:"duties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *StateProposerDutiesResponse) Reset()         { *m = StateProposerDutiesResponse{} }
+func (m *StateProposerDutiesResponse) String() string { return proto.CompactTextString(m) }
+func (*StateProposerDutiesResponse) ProtoMessage()    {}
+func (*StateProposerDutiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07858e0621f6813d, []int{3}
+}
+func (m *StateProposerDutiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateProposerDutiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateProposerDutiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateProposerDutiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProposerDutiesResponse.Merge(m, src)
+}
+func (m *StateProposerDutiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateProposerDutiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProposerDutiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProposerDutiesResponse proto.InternalMessageInfo
+
+func (m *StateProposerDutiesResponse) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *StateProposerDutiesResponse) GetDuties() []*StateProposerDuty {
+	if m != nil {
+		return m.Duties
+	}
+	return nil
+}
+
+type StateProposerDuty struct {
+	ValidatorIndex       github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validator_index,omitempty"`
+	PublicKey            []byte                                             `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	Slot                 github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,3,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *StateProposerDuty) Reset()         { *m = StateProposerDuty{} }
+func (m *StateProposerDuty) String() string { return proto.CompactTextString(m) }
+func (*StateProposerDuty) ProtoMessage()    {}
+func (*StateProposerDuty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07858e0621f6813d, []int{4}
+}
+func (m *StateProposerDuty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateProposerDuty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateProposerDuty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateProposerDuty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProposerDuty.Merge(m, src)
+}
+func (m *StateProposerDuty) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateProposerDuty) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProposerDuty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProposerDuty proto.InternalMessageInfo
+
+func (m *StateProposerDuty) GetValidatorIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *StateProposerDuty) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *StateProposerDuty) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+type StateAttesterDutiesRequest struct {
+	StateId              []byte                                               `protobuf:"bytes,1,opt,name=state_id,json=stateId,proto3" json:"state_id,omitempty"`
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch            `protobuf:"varint,2,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Indices              []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,3,rep,packed,name=indices,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                             `json:"-"`
+	XXX_unrecognized     []byte                                               `json:"-"`
+	XXX_sizecache        int32                                                `json:"-"`
+}
+
+func (m *StateAttesterDutiesRequest) Reset()         { *m = StateAttesterDutiesRequest{} }
+func (m *StateAttesterDutiesRequest) String() string { return proto.CompactTextString(m) }
+func (*StateAttesterDutiesRequest) ProtoMessage()    {}
+func (*StateAttesterDutiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07858e0621f6813d, []int{5}
+}
+func (m *StateAttesterDutiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateAttesterDutiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateAttesterDutiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateAttesterDutiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateAttesterDutiesRequest.Merge(m, src)
+}
+func (m *StateAttesterDutiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateAttesterDutiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateAttesterDutiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateAttesterDutiesRequest proto.InternalMessageInfo
+
+func (m *StateAttesterDutiesRequest) GetStateId() []byte {
+	if m != nil {
+		return m.StateId
+	}
+	return nil
+}
+
+func (m *StateAttesterDutiesRequest) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *StateAttesterDutiesRequest) GetIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+type StateAttesterDutiesResponse struct {
+	Duties               []*StateAttesterDuty `protobuf:"bytes,1,rep,name=duties,proto3" json:"duties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *StateAttesterDutiesResponse) Reset()         { *m = StateAttesterDutiesResponse{} }
+func (m *StateAttesterDutiesResponse) String() string { return proto.CompactTextString(m) }
+func (*StateAttesterDutiesResponse) ProtoMessage()    {}
+func (*StateAttesterDutiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07858e0621f6813d, []int{6}
+}
+func (m *StateAttesterDutiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateAttesterDutiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateAttesterDutiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateAttesterDutiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateAttesterDutiesResponse.Merge(m, src)
+}
+func (m *StateAttesterDutiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateAttesterDutiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateAttesterDutiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateAttesterDutiesResponse proto.InternalMessageInfo
+
+func (m *StateAttesterDutiesResponse) GetDuties() []*StateAttesterDuty {
+	if m != nil {
+		return m.Duties
+	}
+	return nil
+}
+
+type StateAttesterDuty struct {
+	ValidatorIndex          github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"validator_index,omitempty"`
+	PublicKey               []byte                                             `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	Slot                    github_com_prysmaticlabs_eth2_types.Slot           `protobuf:"varint,3,opt,name=slot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"slot,omitempty"`
+	CommitteeIndex          github_com_prysmaticlabs_eth2_types.CommitteeIndex `protobuf:"varint,4,opt,name=committee_index,json=committeeIndex,proto3,casttype=github.com/prysmaticlabs/eth2-types.CommitteeIndex" json:"committee_index,omitempty"`
+	CommitteeLength         uint64                                             `protobuf:"varint,5,opt,name=committee_length,json=committeeLength,proto3" json:"committee_length,omitempty"`
+	CommitteesAtSlot        uint64                                             `protobuf:"varint,6,opt,name=committees_at_slot,json=committeesAtSlot,proto3" json:"committees_at_slot,omitempty"`
+	ValidatorCommitteeIndex uint64                                             `protobuf:"varint,7,opt,name=validator_committee_index,json=validatorCommitteeIndex,proto3" json:"validator_committee_index,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                                           `json:"-"`
+	XXX_unrecognized        []byte                                             `json:"-"`
+	XXX_sizecache           int32                                              `json:"-"`
+}
+
+func (m *StateAttesterDuty) Reset()         { *m = StateAttesterDuty{} }
+func (m *StateAttesterDuty) String() string { return proto.CompactTextString(m) }
+func (*StateAttesterDuty) ProtoMessage()    {}
+func (*StateAttesterDuty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07858e0621f6813d, []int{7}
+}
+func (m *StateAttesterDuty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateAttesterDuty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateAttesterDuty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateAttesterDuty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateAttesterDuty.Merge(m, src)
+}
+func (m *StateAttesterDuty) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateAttesterDuty) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateAttesterDuty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateAttesterDuty proto.InternalMessageInfo
+
+func (m *StateAttesterDuty) GetValidatorIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *StateAttesterDuty) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *StateAttesterDuty) GetSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *StateAttesterDuty) GetCommitteeIndex() github_com_prysmaticlabs_eth2_types.CommitteeIndex {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *StateAttesterDuty) GetCommitteeLength() uint64 {
+	if m != nil {
+		return m.CommitteeLength
+	}
+	return 0
+}
+
+func (m *StateAttesterDuty) GetCommitteesAtSlot() uint64 {
+	if m != nil {
+		return m.CommitteesAtSlot
+	}
+	return 0
+}
+
+func (m *StateAttesterDuty) GetValidatorCommitteeIndex() uint64 {
+	if m != nil {
+		return m.ValidatorCommitteeIndex
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EpochDutiesRequest)(nil), "ethereum.beacon.rpc.v1.EpochDutiesRequest")
+	proto.RegisterType((*EpochDutiesResponse)(nil), "ethereum.beacon.rpc.v1.EpochDutiesResponse")
+	proto.RegisterType((*StateProposerDutiesRequest)(nil), "ethereum.beacon.rpc.v1.StateProposerDutiesRequest")
+	proto.RegisterType((*StateProposerDutiesResponse)(nil), "ethereum.beacon.rpc.v1.StateProposerDutiesResponse")
+	proto.RegisterType((*StateProposerDuty)(nil), "ethereum.beacon.rpc.v1.StateProposerDuty")
+	proto.RegisterType((*StateAttesterDutiesRequest)(nil), "ethereum.beacon.rpc.v1.StateAttesterDutiesRequest")
+	proto.RegisterType((*StateAttesterDutiesResponse)(nil), "ethereum.beacon.rpc.v1.StateAttesterDutiesResponse")
+	proto.RegisterType((*StateAttesterDuty)(nil), "ethereum.beacon.rpc.v1.StateAttesterDuty")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/duties.proto", fileDescriptor_07858e0621f6813d) }
+
+var fileDescriptor_07858e0621f6813d = []byte{
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xbf, 0x6f, 0xd3, 0x5a,
+	0x14, 0x96, 0x93, 0x34, 0x7d, 0xef, 0xf6, 0xd7, 0xcb, 0xed, 0x53, 0x5f, 0x9a, 0x56, 0x4d, 0x65,
+	0xbd, 0xa1, 0x09, 0x8d, 0x4d, 0x92, 0x0a, 0xaa, 0x2c, 0xd0, 0x14, 0x54, 0x55, 0x30, 0x54, 0xae,
+	0xd4, 0x09, 0xc9, 0x38, 0xf6, 0x21, 0xb6, 0x70, 0x7c, 0x8d, 0xef, 0x4d, 0xd4, 0x74, 0x44, 0x62,
+	0x66, 0x60, 0x63, 0x63, 0xe0, 0xaf, 0xe0, 0x1f, 0x60, 0x42, 0x48, 0xec, 0x11, 0xaa, 0x18, 0x98,
+	0x3b, 0x76, 0x42, 0xbe, 0x76, 0xe2, 0x38, 0x0d, 0x6a, 0x52, 0x98, 0xd8, 0x72, 0xef, 0x3d, 0xdf,
+	0x77, 0xbf, 0x73, 0xce, 0xe7, 0x73, 0x83, 0x36, 0x5d, 0x8f, 0x30, 0x22, 0x37, 0x40, 0xd3, 0x89,
+	0x23, 0x7b, 0xae, 0x2e, 0x77, 0xca, 0xb2, 0xd1, 0x66, 0x16, 0x50, 0x89, 0x1f, 0xe1, 0x15, 0x60,
+	0x26, 0x78, 0xd0, 0x6e, 0x49, 0x41, 0x90, 0xe4, 0xb9, 0xba, 0xd4, 0x29, 0xe7, 0xd6, 0x81, 0x99,
+	0x72, 0xa7, 0xac, 0xd9, 0xae, 0xa9, 0x95, 0xe5, 0x8e, 0x66, 0x5b, 0x86, 0xc6, 0x88, 0x17, 0xa0,
+	0x72, 0xeb, 0x4d, 0x42, 0x9a, 0x36, 0xc8, 0x9a, 0x6b, 0xc9, 0x9a, 0xe3, 0x10, 0xa6, 0x31, 0x8b,
+	0x38, 0x21, 0x67, 0xae, 0xd4, 0xb4, 0x98, 0xd9, 0x6e, 0x48, 0x3a, 0x69, 0xc9, 0x4d, 0xd2, 0x24,
+	0x32, 0xdf, 0x6e, 0xb4, 0x9f, 0xf1, 0x55, 0x20, 0xc9, 0xff, 0x15, 0x84, 0x8b, 0xaf, 0x05, 0x84,
+	0x1f, 0xba, 0x44, 0x37, 0x1f, 0x70, 0x61, 0x0a, 0xbc, 0x68, 0x03, 0x65, 0x78, 0x1f, 0xcd, 0x80,
+	0xbf, 0x9b, 0x15, 0x36, 0x85, 0xad, 0x54, 0xbd, 0x74, 0xd9, 0xcb, 0x17, 0x86, 0x88, 0x5d, 0xaf,
+	0x4b, 0x5b, 0x1a, 0xb3, 0x74, 0x5b, 0x6b, 0x50, 0x19, 0x98, 0x59, 0x29, 0xb1, 0xae, 0x0b, 0x54,
+	0xe2, 0x54, 0x4a, 0x80, 0xc5, 0x3b, 0x68, 0xce, 0x6d, 0x37, 0x6c, 0x4b, 0x57, 0x9f, 0x43, 0x97,
+	0x66, 0x13, 0x9b, 0xc9, 0xad, 0xf9, 0xfa, 0xf2, 0x45, 0x2f, 0xbf, 0x44, 0xe9, 0x59, 0x89, 0x5a,
+	0x67, 0x50, 0x13, 0xef, 0x6d, 0xef, 0xec, 0x8a, 0x0a, 0x0a, 0xe2, 0x1e, 0x41, 0x97, 0x8a, 0xaf,
+	0x12, 0x68, 0x39, 0xa6, 0x88, 0xba, 0xc4, 0xa1, 0x80, 0x77, 0xd1, 0xa2, 0x01, 0x2e, 0x38, 0x06,
+	0x38, 0x4c, 0xf5, 0x08, 0x61, 0x5c, 0xdb, 0x7c, 0x3d, 0x73, 0xd1, 0xcb, 0x2f, 0x44, 0x84, 0xd5,
+	0x8a, 0xa8, 0x2c, 0x0c, 0x02, 0x15, 0x42, 0x18, 0x7e, 0x82, 0xfe, 0xd5, 0xdb, 0x9e, 0xe7, 0xe3,
+	0xb8, 0x30, 0x35, 0x68, 0x02, 0x17, 0x34, 0x57, 0x29, 0x4a, 0x83, 0x2e, 0x00, 0x33, 0xa5, 0x7e,
+	0xd9, 0xa5, 0xf8, 0xf5, 0xfe, 0xb2, 0xab, 0xe0, 0x90, 0x67, 0x48, 0x1f, 0x3e, 0x41, 0x19, 0x07,
+	0x4e, 0x47, 0xa8, 0x93, 0x53, 0x53, 0x2f, 0xf9, 0x24, 0x43, 0xbc, 0xe2, 0x5d, 0x94, 0x3b, 0x66,
+	0x1a, 0x83, 0x23, 0x8f, 0xb8, 0x84, 0x82, 0x17, 0x6f, 0xd0, 0x2a, 0xfa, 0x8b, 0xfa, 0xa7, 0xaa,
+	0x65, 0x04, 0x75, 0x50, 0x66, 0xf9, 0xfa, 0xd0, 0x10, 0xdf, 0x0b, 0x68, 0x6d, 0x2c, 0x32, 0x2c,
+	0xe4, 0x6f, 0xe9, 0xed, 0x1e, 0x4a, 0xc7, 0xaa, 0x58, 0x90, 0xc6, 0x7b, 0x59, 0x1a, 0x55, 0xd2,
+	0x55, 0x42, 0xa0, 0xf8, 0x5d, 0x40, 0x99, 0x2b, 0xa7, 0x58, 0x45, 0x4b, 0x03, 0xc3, 0xab, 0x96,
+	0x63, 0xc0, 0x69, 0xa8, 0xf3, 0xce, 0x65, 0x2f, 0x5f, 0x99, 0x44, 0xe7, 0x49, 0x1f, 0x7e, 0xe8,
+	0xa3, 0x95, 0xc5, 0x4e, 0x6c, 0x8d, 0x6f, 0x23, 0x14, 0xb9, 0x32, 0x9b, 0x18, 0xe7, 0x21, 0xdf,
+	0x92, 0x7f, 0x0f, 0x2c, 0x89, 0xef, 0xa3, 0x14, 0xb5, 0x09, 0xcb, 0x26, 0xb9, 0x8e, 0xed, 0xcb,
+	0x5e, 0x7e, 0x6b, 0x12, 0x1d, 0xc7, 0x36, 0x61, 0x0a, 0x47, 0x8a, 0x9f, 0x84, 0xb0, 0x99, 0x7b,
+	0x8c, 0x01, 0x65, 0x93, 0x37, 0x33, 0x6a, 0x56, 0xe2, 0x17, 0x9a, 0x75, 0x84, 0x66, 0x2d, 0xc7,
+	0xb0, 0xf4, 0xd0, 0x98, 0x37, 0xaf, 0x65, 0x9f, 0x46, 0x7c, 0x8a, 0xd6, 0xc6, 0xe6, 0x13, 0x5a,
+	0x2c, 0x72, 0x87, 0x30, 0x81, 0x3b, 0x86, 0x48, 0x22, 0x77, 0xf4, 0x92, 0x28, 0x73, 0xe5, 0xf4,
+	0x8f, 0x74, 0x87, 0x9f, 0x94, 0x4e, 0x5a, 0x2d, 0x8b, 0x31, 0x80, 0x30, 0xa9, 0xd4, 0x74, 0x49,
+	0xed, 0xf7, 0xe1, 0x61, 0x52, 0x7a, 0x6c, 0x8d, 0x0b, 0xe8, 0x9f, 0xe8, 0x02, 0x1b, 0x9c, 0x26,
+	0x33, 0xb3, 0x33, 0xfe, 0x0d, 0x4a, 0x74, 0xf1, 0x63, 0xbe, 0x8d, 0xb7, 0x11, 0x1e, 0x6c, 0x51,
+	0x55, 0x63, 0x2a, 0xcf, 0x2d, 0xcd, 0x83, 0x23, 0x12, 0xba, 0xc7, 0x7c, 0xfd, 0xb8, 0x86, 0x56,
+	0xa3, 0x76, 0x8c, 0xe6, 0x30, 0xcb, 0x41, 0xff, 0x0d, 0x02, 0xe2, 0x22, 0x2b, 0xef, 0x52, 0x28,
+	0x1d, 0x8e, 0xd0, 0xb7, 0x02, 0x5a, 0x3c, 0x80, 0xd8, 0x54, 0x2d, 0xfe, 0xcc, 0x31, 0x57, 0x1f,
+	0xab, 0xdc, 0xad, 0x89, 0x62, 0x03, 0x6b, 0x8a, 0xf2, 0xcb, 0x2f, 0xdf, 0xde, 0x24, 0x0a, 0xe2,
+	0xff, 0xf2, 0xf8, 0x47, 0x36, 0x7c, 0xa1, 0x65, 0xfe, 0xe5, 0xd4, 0x84, 0x22, 0xfe, 0x20, 0xa0,
+	0x95, 0x03, 0x60, 0x63, 0x26, 0x2a, 0xae, 0x4c, 0x3a, 0xf4, 0x86, 0xc4, 0x56, 0xa7, 0xc2, 0x84,
+	0xa2, 0x77, 0xb9, 0xe8, 0x8a, 0x58, 0xba, 0x46, 0x34, 0x9f, 0x1a, 0xb2, 0x1b, 0x92, 0x8c, 0xaa,
+	0x8f, 0x7f, 0xac, 0xd7, 0xa8, 0x1f, 0x3b, 0xa9, 0x72, 0xd5, 0xa9, 0x30, 0x37, 0x52, 0xaf, 0x85,
+	0x24, 0x35, 0xa1, 0x58, 0x9f, 0xff, 0x78, 0xbe, 0x21, 0x7c, 0x3e, 0xdf, 0x10, 0xbe, 0x9e, 0x6f,
+	0x08, 0x8d, 0x34, 0xff, 0xcb, 0x52, 0xfd, 0x31, 0x00, 0xd4, 0xf7, 0xf6, 0x49, 0x59, 0x09, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DutiesClient is the client API for Duties service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DutiesClient interface {
+	GetEpochDuties(ctx context.Context, in *EpochDutiesRequest, opts ...grpc.CallOption) (*EpochDutiesResponse, error)
+	GetStateProposerDuties(ctx context.Context, in *StateProposerDutiesRequest, opts ...grpc.CallOption) (*StateProposerDutiesResponse, error)
+	GetStateAttesterDuties(ctx context.Context, in *StateAttesterDutiesRequest, opts ...grpc.CallOption) (*StateAttesterDutiesResponse, error)
+}
+
+type dutiesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDutiesClient(cc *grpc.ClientConn) DutiesClient {
+	return &dutiesClient{cc}
+}
+
+func (c *dutiesClient) GetEpochDuties(ctx context.Context, in *EpochDutiesRequest, opts ...grpc.CallOption) (*EpochDutiesResponse, error) {
+	out := new(EpochDutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Duties/GetEpochDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dutiesClient) GetStateProposerDuties(ctx context.Context, in *StateProposerDutiesRequest, opts ...grpc.CallOption) (*StateProposerDutiesResponse, error) {
+	out := new(StateProposerDutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Duties/GetStateProposerDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dutiesClient) GetStateAttesterDuties(ctx context.Context, in *StateAttesterDutiesRequest, opts ...grpc.CallOption) (*StateAttesterDutiesResponse, error) {
+	out := new(StateAttesterDutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Duties/GetStateAttesterDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DutiesServer is the server API for Duties service.
+type DutiesServer interface {
+	GetEpochDuties(context.Context, *EpochDutiesRequest) (*EpochDutiesResponse, error)
+	GetStateProposerDuties(context.Context, *StateProposerDutiesRequest) (*StateProposerDutiesResponse, error)
+	GetStateAttesterDuties(context.Context, *StateAttesterDutiesRequest) (*StateAttesterDutiesResponse, error)
+}
+
+// UnimplementedDutiesServer can be embedded to have forward compatible implementations.
+type UnimplementedDutiesServer struct {
+}
+
+func (*UnimplementedDutiesServer) GetEpochDuties(ctx context.Context, req *EpochDutiesRequest) (*EpochDutiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochDuties not implemented")
+}
+func (*UnimplementedDutiesServer) GetStateProposerDuties(ctx context.Context, req *StateProposerDutiesRequest) (*StateProposerDutiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateProposerDuties not implemented")
+}
+func (*UnimplementedDutiesServer) GetStateAttesterDuties(ctx context.Context, req *StateAttesterDutiesRequest) (*StateAttesterDutiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateAttesterDuties not implemented")
+}
+
+func RegisterDutiesServer(s *grpc.Server, srv DutiesServer) {
+	s.RegisterService(&_Duties_serviceDesc, srv)
+}
+
+func _Duties_GetEpochDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EpochDutiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DutiesServer).GetEpochDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Duties/GetEpochDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DutiesServer).GetEpochDuties(ctx, req.(*EpochDutiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Duties_GetStateProposerDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateProposerDutiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DutiesServer).GetStateProposerDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Duties/GetStateProposerDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DutiesServer).GetStateProposerDuties(ctx, req.(*StateProposerDutiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Duties_GetStateAttesterDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateAttesterDutiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DutiesServer).GetStateAttesterDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Duties/GetStateAttesterDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DutiesServer).GetStateAttesterDuties(ctx, req.(*StateAttesterDutiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Duties_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Duties",
+	HandlerType: (*DutiesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEpochDuties",
+			Handler:    _Duties_GetEpochDuties_Handler,
+		},
+		{
+			MethodName: "GetStateProposerDuties",
+			Handler:    _Duties_GetStateProposerDuties_Handler,
+		},
+		{
+			MethodName: "GetStateAttesterDuties",
+			Handler:    _Duties_GetStateAttesterDuties_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/duties.proto",
+}
+
+func (m *EpochDutiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochDutiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochDutiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintDuties(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDuties(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochDutiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochDutiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochDutiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextEpochDuties) > 0 {
+		for iNdEx := len(m.NextEpochDuties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NextEpochDuties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDuties(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CurrentEpochDuties) > 0 {
+		for iNdEx := len(m.CurrentEpochDuties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentEpochDuties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDuties(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DependentRoot) > 0 {
+		i -= len(m.DependentRoot)
+		copy(dAtA[i:], m.DependentRoot)
+		i = encodeVarintDuties(dAtA, i, uint64(len(m.DependentRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateProposerDutiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateProposerDutiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateProposerDutiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.StateId) > 0 {
+		i -= len(m.StateId)
+		copy(dAtA[i:], m.StateId)
+		i = encodeVarintDuties(dAtA, i, uint64(len(m.StateId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateProposerDutiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateProposerDutiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateProposerDutiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Duties) > 0 {
+		for iNdEx := len(m.Duties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Duties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDuties(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDuties(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateProposerDuty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateProposerDuty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateProposerDuty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDuties(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintDuties(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintDuties(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateAttesterDutiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateAttesterDutiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateAttesterDutiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		dAtA2 := make([]byte, len(m.Indices)*10)
+		var j1 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintDuties(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDuties(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StateId) > 0 {
+		i -= len(m.StateId)
+		copy(dAtA[i:], m.StateId)
+		i = encodeVarintDuties(dAtA, i, uint64(len(m.StateId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateAttesterDutiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateAttesterDutiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateAttesterDutiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Duties) > 0 {
+		for iNdEx := len(m.Duties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Duties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDuties(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateAttesterDuty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateAttesterDuty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateAttesterDuty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ValidatorCommitteeIndex != 0 {
+		i = encodeVarintDuties(dAtA, i, uint64(m.ValidatorCommitteeIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CommitteesAtSlot != 0 {
+		i = encodeVarintDuties(dAtA, i, uint64(m.CommitteesAtSlot))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CommitteeLength != 0 {
+		i = encodeVarintDuties(dAtA, i, uint64(m.CommitteeLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintDuties(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDuties(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintDuties(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintDuties(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDuties(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDuties(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EpochDutiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovDuties(uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovDuties(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochDutiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DependentRoot)
+	if l > 0 {
+		n += 1 + l + sovDuties(uint64(l))
+	}
+	if len(m.CurrentEpochDuties) > 0 {
+		for _, e := range m.CurrentEpochDuties {
+			l = e.Size()
+			n += 1 + l + sovDuties(uint64(l))
+		}
+	}
+	if len(m.NextEpochDuties) > 0 {
+		for _, e := range m.NextEpochDuties {
+			l = e.Size()
+			n += 1 + l + sovDuties(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateProposerDutiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateId)
+	if l > 0 {
+		n += 1 + l + sovDuties(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateProposerDutiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovDuties(uint64(m.Epoch))
+	}
+	if len(m.Duties) > 0 {
+		for _, e := range m.Duties {
+			l = e.Size()
+			n += 1 + l + sovDuties(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateProposerDuty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovDuties(uint64(m.ValidatorIndex))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovDuties(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovDuties(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateAttesterDutiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateId)
+	if l > 0 {
+		n += 1 + l + sovDuties(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovDuties(uint64(m.Epoch))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovDuties(uint64(e))
+		}
+		n += 1 + sovDuties(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateAttesterDutiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Duties) > 0 {
+		for _, e := range m.Duties {
+			l = e.Size()
+			n += 1 + l + sovDuties(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateAttesterDuty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovDuties(uint64(m.ValidatorIndex))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovDuties(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovDuties(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovDuties(uint64(m.CommitteeIndex))
+	}
+	if m.CommitteeLength != 0 {
+		n += 1 + sovDuties(uint64(m.CommitteeLength))
+	}
+	if m.CommitteesAtSlot != 0 {
+		n += 1 + sovDuties(uint64(m.CommitteesAtSlot))
+	}
+	if m.ValidatorCommitteeIndex != 0 {
+		n += 1 + sovDuties(uint64(m.ValidatorCommitteeIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDuties(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDuties(x uint64) (n int) {
+	return sovDuties(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EpochDutiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDuties
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochDutiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochDutiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDuties
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDuties(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochDutiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDuties
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochDutiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochDutiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDuties
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependentRoot = append(m.DependentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DependentRoot == nil {
+				m.DependentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochDuties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDuties
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentEpochDuties = append(m.CurrentEpochDuties, &v1alpha1.DutiesResponse_Duty{})
+			if err := m.CurrentEpochDuties[len(m.CurrentEpochDuties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochDuties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDuties
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextEpochDuties = append(m.NextEpochDuties, &v1alpha1.DutiesResponse_Duty{})
+			if err := m.NextEpochDuties[len(m.NextEpochDuties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDuties(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateProposerDutiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDuties
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateProposerDutiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateProposerDutiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDuties
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateId = append(m.StateId[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateId == nil {
+				m.StateId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDuties(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateProposerDutiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDuties
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateProposerDutiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateProposerDutiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDuties
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duties = append(m.Duties, &StateProposerDuty{})
+			if err := m.Duties[len(m.Duties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDuties(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateProposerDuty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDuties
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateProposerDuty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateProposerDuty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDuties
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDuties(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateAttesterDutiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDuties
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateAttesterDutiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateAttesterDutiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDuties
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateId = append(m.StateId[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateId == nil {
+				m.StateId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDuties
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDuties
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDuties
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDuties
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDuties
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDuties(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateAttesterDutiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDuties
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateAttesterDutiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateAttesterDutiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDuties
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duties = append(m.Duties, &StateAttesterDuty{})
+			if err := m.Duties[len(m.Duties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDuties(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateAttesterDuty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDuties
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateAttesterDuty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateAttesterDuty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDuties
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= github_com_prysmaticlabs_eth2_types.CommitteeIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeLength", wireType)
+			}
+			m.CommitteeLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteesAtSlot", wireType)
+			}
+			m.CommitteesAtSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteesAtSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommitteeIndex", wireType)
+			}
+			m.ValidatorCommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorCommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDuties(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDuties
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDuties(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDuties
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDuties
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDuties
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDuties
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDuties
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDuties        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDuties          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDuties = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,891 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/exits.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type VerifyVoluntaryExitsRequest struct {
+	Exits                []*v1alpha1.SignedVoluntaryExit `protobuf:"bytes,1,rep,name=exits,proto3" json:"exits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *VerifyVoluntaryExitsRequest) Reset()         { *m = VerifyVoluntaryExitsRequest{} }
+func (m *VerifyVoluntaryExitsRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyVoluntaryExitsRequest) ProtoMessage()    {}
+func (*VerifyVoluntaryExitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928c22a4bbfab6aa, []int{0}
+}
+func (m *VerifyVoluntaryExitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyVoluntaryExitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyVoluntaryExitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyVoluntaryExitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyVoluntaryExitsRequest.Merge(m, src)
+}
+func (m *VerifyVoluntaryExitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyVoluntaryExitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyVoluntaryExitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyVoluntaryExitsRequest proto.InternalMessageInfo
+
+func (m *VerifyVoluntaryExitsRequest) GetExits() []*v1alpha1.SignedVoluntaryExit {
+	if m != nil {
+		return m.Exits
+	}
+	return nil
+}
+
+type VerifyVoluntaryExitsResponse struct {
+	Results              []*VoluntaryExitVerification `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *VerifyVoluntaryExitsResponse) Reset()         { *m = VerifyVoluntaryExitsResponse{} }
+func (m *VerifyVoluntaryExitsResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyVoluntaryExitsResponse) ProtoMessage()    {}
+func (*VerifyVoluntaryExitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928c22a4bbfab6aa, []int{1}
+}
+func (m *VerifyVoluntaryExitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyVoluntaryExitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyVoluntaryExitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyVoluntaryExitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyVoluntaryExitsResponse.Merge(m, src)
+}
+func (m *VerifyVoluntaryExitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyVoluntaryExitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyVoluntaryExitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyVoluntaryExitsResponse proto.InternalMessageInfo
+
+func (m *VerifyVoluntaryExitsResponse) GetResults() []*VoluntaryExitVerification {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type VoluntaryExitVerification struct {
+	Valid                  bool                                      `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Error                  string                                    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	EarliestInclusionEpoch github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,3,opt,name=earliest_inclusion_epoch,json=earliestInclusionEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"earliest_inclusion_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                                  `json:"-"`
+	XXX_unrecognized       []byte                                    `json:"-"`
+	XXX_sizecache          int32                                     `json:"-"`
+}
+
+func (m *VoluntaryExitVerification) Reset()         { *m = VoluntaryExitVerification{} }
+func (m *VoluntaryExitVerification) String() string { return proto.CompactTextString(m) }
+func (*VoluntaryExitVerification) ProtoMessage()    {}
+func (*VoluntaryExitVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928c22a4bbfab6aa, []int{2}
+}
+func (m *VoluntaryExitVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoluntaryExitVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoluntaryExitVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoluntaryExitVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoluntaryExitVerification.Merge(m, src)
+}
+func (m *VoluntaryExitVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoluntaryExitVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoluntaryExitVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoluntaryExitVerification proto.InternalMessageInfo
+
+func (m *VoluntaryExitVerification) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *VoluntaryExitVerification) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *VoluntaryExitVerification) GetEarliestInclusionEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.EarliestInclusionEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*VerifyVoluntaryExitsRequest)(nil), "ethereum.beacon.rpc.v1.VerifyVoluntaryExitsRequest")
+	proto.RegisterType((*VerifyVoluntaryExitsResponse)(nil), "ethereum.beacon.rpc.v1.VerifyVoluntaryExitsResponse")
+	proto.RegisterType((*VoluntaryExitVerification)(nil), "ethereum.beacon.rpc.v1.VoluntaryExitVerification")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/exits.proto", fileDescriptor_928c22a4bbfab6aa) }
+
+var fileDescriptor_928c22a4bbfab6aa = []byte{
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x8b, 0x13, 0x31,
+	0x18, 0xc6, 0xc9, 0xae, 0xf5, 0x4f, 0xf4, 0x34, 0x2c, 0xcb, 0x38, 0x2e, 0x6d, 0x19, 0x10, 0xea,
+	0x42, 0x13, 0xa6, 0xeb, 0xc9, 0x93, 0x2c, 0xec, 0x41, 0xbc, 0x8d, 0xb0, 0xd7, 0x92, 0xc9, 0xbe,
+	0x3b, 0x13, 0x36, 0xcd, 0x1b, 0x93, 0xcc, 0x60, 0xaf, 0x7e, 0x05, 0xbf, 0x84, 0x47, 0xf1, 0x53,
+	0x78, 0x14, 0xbc, 0x8b, 0x14, 0x3f, 0x85, 0x27, 0x69, 0x66, 0xbb, 0x58, 0x68, 0x0f, 0xde, 0xe6,
+	0x7d, 0xe7, 0x7d, 0x7e, 0x79, 0xf2, 0xbc, 0xa1, 0x23, 0xeb, 0x30, 0x20, 0xaf, 0x40, 0x48, 0x34,
+	0xdc, 0x59, 0xc9, 0xbb, 0x82, 0xc3, 0x07, 0x15, 0x3c, 0x8b, 0x7f, 0x92, 0x63, 0x08, 0x0d, 0x38,
+	0x68, 0x17, 0xac, 0x9f, 0x61, 0xce, 0x4a, 0xd6, 0x15, 0xd9, 0x08, 0x42, 0xc3, 0xbb, 0x42, 0x68,
+	0xdb, 0x88, 0xe2, 0x56, 0x3f, 0xaf, 0x34, 0xca, 0x9b, 0x5e, 0x98, 0x9d, 0xd4, 0x88, 0xb5, 0x06,
+	0x2e, 0xac, 0xe2, 0xc2, 0x18, 0x0c, 0x22, 0x28, 0x34, 0xb7, 0xd8, 0x6c, 0x5a, 0xab, 0xd0, 0xb4,
+	0x15, 0x93, 0xb8, 0xe0, 0x35, 0xd6, 0xc8, 0x63, 0xbb, 0x6a, 0xaf, 0x63, 0xd5, 0x9b, 0x5a, 0x7f,
+	0xf5, 0xe3, 0xf9, 0x9c, 0x3e, 0xbb, 0x04, 0xa7, 0xae, 0x97, 0x97, 0xa8, 0x5b, 0x13, 0x84, 0x5b,
+	0x5e, 0xac, 0x3d, 0x96, 0xf0, 0xbe, 0x05, 0x1f, 0x92, 0xd7, 0x74, 0x10, 0x3d, 0xa7, 0x64, 0x7c,
+	0x38, 0x79, 0x3c, 0x3b, 0x65, 0x77, 0xa6, 0x21, 0x34, 0x6c, 0xe3, 0x92, 0xbd, 0x53, 0xb5, 0x81,
+	0xab, 0x2d, 0x44, 0xd9, 0x0b, 0xf3, 0x1b, 0x7a, 0xb2, 0xfb, 0x00, 0x6f, 0xd1, 0x78, 0x48, 0xde,
+	0xd2, 0x07, 0x0e, 0x7c, 0xab, 0xef, 0xce, 0x28, 0xd8, 0xee, 0x60, 0xd8, 0x16, 0x20, 0x32, 0x95,
+	0x8c, 0x57, 0x2f, 0x37, 0x84, 0xfc, 0x0b, 0xa1, 0x4f, 0xf7, 0x8e, 0x25, 0x47, 0x74, 0xd0, 0x09,
+	0xad, 0xae, 0x52, 0x32, 0x26, 0x93, 0x87, 0x65, 0x5f, 0xac, 0xbb, 0xe0, 0x1c, 0xba, 0xf4, 0x60,
+	0x4c, 0x26, 0x8f, 0xca, 0xbe, 0x48, 0x6a, 0x9a, 0x82, 0x70, 0x5a, 0x81, 0x0f, 0x73, 0x65, 0xa4,
+	0x6e, 0xbd, 0x42, 0x33, 0x07, 0x8b, 0xb2, 0x49, 0x0f, 0xc7, 0x64, 0x72, 0xef, 0x7c, 0xfa, 0xe7,
+	0xe7, 0xe8, 0xc5, 0x3f, 0x61, 0x5b, 0xb7, 0xf4, 0x0b, 0x11, 0x94, 0xd4, 0xa2, 0xf2, 0x1c, 0x42,
+	0x33, 0x9b, 0x86, 0xa5, 0x05, 0xcf, 0x2e, 0xd6, 0xa2, 0xf2, 0x78, 0x83, 0x7b, 0xb3, 0xa1, 0xc5,
+	0xfe, 0xec, 0x2b, 0xa1, 0x83, 0x98, 0x48, 0xf2, 0x99, 0xd0, 0xa3, 0x5d, 0x51, 0x25, 0x67, 0x7b,
+	0x13, 0xd9, 0xbf, 0xb9, 0xec, 0xe5, 0xff, 0x89, 0xfa, 0x6d, 0xe4, 0xcf, 0x3f, 0xfe, 0xf8, 0xfd,
+	0xe9, 0x60, 0x94, 0x67, 0x7c, 0xeb, 0x15, 0xc6, 0x55, 0xf2, 0x2e, 0x2a, 0x5f, 0x91, 0xd3, 0xf3,
+	0x27, 0xdf, 0x56, 0x43, 0xf2, 0x7d, 0x35, 0x24, 0xbf, 0x56, 0x43, 0x52, 0xdd, 0x8f, 0x4f, 0xe9,
+	0xec, 0xef, 0x00, 0x4e, 0x6e, 0xff, 0x66, 0xf3, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ExitsClient is the client API for Exits service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ExitsClient interface {
+	VerifyVoluntaryExits(ctx context.Context, in *VerifyVoluntaryExitsRequest, opts ...grpc.CallOption) (*VerifyVoluntaryExitsResponse, error)
+}
+
+type exitsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewExitsClient(cc *grpc.ClientConn) ExitsClient {
+	return &exitsClient{cc}
+}
+
+func (c *exitsClient) VerifyVoluntaryExits(ctx context.Context, in *VerifyVoluntaryExitsRequest, opts ...grpc.CallOption) (*VerifyVoluntaryExitsResponse, error) {
+	out := new(VerifyVoluntaryExitsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Exits/VerifyVoluntaryExits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExitsServer is the server API for Exits service.
+type ExitsServer interface {
+	VerifyVoluntaryExits(context.Context, *VerifyVoluntaryExitsRequest) (*VerifyVoluntaryExitsResponse, error)
+}
+
+// UnimplementedExitsServer can be embedded to have forward compatible implementations.
+type UnimplementedExitsServer struct {
+}
+
+func (*UnimplementedExitsServer) VerifyVoluntaryExits(ctx context.Context, req *VerifyVoluntaryExitsRequest) (*VerifyVoluntaryExitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyVoluntaryExits not implemented")
+}
+
+func RegisterExitsServer(s *grpc.Server, srv ExitsServer) {
+	s.RegisterService(&_Exits_serviceDesc, srv)
+}
+
+func _Exits_VerifyVoluntaryExits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyVoluntaryExitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExitsServer).VerifyVoluntaryExits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Exits/VerifyVoluntaryExits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExitsServer).VerifyVoluntaryExits(ctx, req.(*VerifyVoluntaryExitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Exits_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Exits",
+	HandlerType: (*ExitsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyVoluntaryExits",
+			Handler:    _Exits_VerifyVoluntaryExits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/exits.proto",
+}
+
+func (m *VerifyVoluntaryExitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyVoluntaryExitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyVoluntaryExitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Exits) > 0 {
+		for iNdEx := len(m.Exits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Exits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExits(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyVoluntaryExitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyVoluntaryExitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyVoluntaryExitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExits(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoluntaryExitVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoluntaryExitVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoluntaryExitVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EarliestInclusionEpoch != 0 {
+		i = encodeVarintExits(dAtA, i, uint64(m.EarliestInclusionEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintExits(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExits(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExits(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VerifyVoluntaryExitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Exits) > 0 {
+		for _, e := range m.Exits {
+			l = e.Size()
+			n += 1 + l + sovExits(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyVoluntaryExitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovExits(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VoluntaryExitVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovExits(uint64(l))
+	}
+	if m.EarliestInclusionEpoch != 0 {
+		n += 1 + sovExits(uint64(m.EarliestInclusionEpoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovExits(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExits(x uint64) (n int) {
+	return sovExits(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VerifyVoluntaryExitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyVoluntaryExitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyVoluntaryExitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exits = append(m.Exits, &v1alpha1.SignedVoluntaryExit{})
+			if err := m.Exits[len(m.Exits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyVoluntaryExitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyVoluntaryExitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyVoluntaryExitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &VoluntaryExitVerification{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoluntaryExitVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoluntaryExitVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoluntaryExitVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestInclusionEpoch", wireType)
+			}
+			m.EarliestInclusionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarliestInclusionEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExits(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExits
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExits
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExits
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExits
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExits        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExits          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExits = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,555 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/genesis.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GenesisCountdownResponse struct {
+	ChainStarted         bool     `protobuf:"varint,1,opt,name=chain_started,json=chainStarted,proto3" json:"chain_started,omitempty"`
+	GenesisTime          uint64   `protobuf:"varint,2,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	SecondsUntilGenesis  uint64   `protobuf:"varint,3,opt,name=seconds_until_genesis,json=secondsUntilGenesis,proto3" json:"seconds_until_genesis,omitempty"`
+	GenesisValidators    uint64   `protobuf:"varint,4,opt,name=genesis_validators,json=genesisValidators,proto3" json:"genesis_validators,omitempty"`
+	ValidatorsNeeded     uint64   `protobuf:"varint,5,opt,name=validators_needed,json=validatorsNeeded,proto3" json:"validators_needed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenesisCountdownResponse) Reset()         { *m = GenesisCountdownResponse{} }
+func (m *GenesisCountdownResponse) String() string { return proto.CompactTextString(m) }
+func (*GenesisCountdownResponse) ProtoMessage()    {}
+func (*GenesisCountdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef407be40f4d253c, []int{0}
+}
+func (m *GenesisCountdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisCountdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisCountdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisCountdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisCountdownResponse.Merge(m, src)
+}
+func (m *GenesisCountdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisCountdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisCountdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisCountdownResponse proto.InternalMessageInfo
+
+func (m *GenesisCountdownResponse) GetChainStarted() bool {
+	if m != nil {
+		return m.ChainStarted
+	}
+	return false
+}
+
+func (m *GenesisCountdownResponse) GetGenesisTime() uint64 {
+	if m != nil {
+		return m.GenesisTime
+	}
+	return 0
+}
+
+func (m *GenesisCountdownResponse) GetSecondsUntilGenesis() uint64 {
+	if m != nil {
+		return m.SecondsUntilGenesis
+	}
+	return 0
+}
+
+func (m *GenesisCountdownResponse) GetGenesisValidators() uint64 {
+	if m != nil {
+		return m.GenesisValidators
+	}
+	return 0
+}
+
+func (m *GenesisCountdownResponse) GetValidatorsNeeded() uint64 {
+	if m != nil {
+		return m.ValidatorsNeeded
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisCountdownResponse)(nil), "ethereum.beacon.rpc.v1.GenesisCountdownResponse")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/genesis.proto", fileDescriptor_ef407be40f4d253c) }
+
+var fileDescriptor_ef407be40f4d253c = []byte{
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4a, 0x2b, 0x31,
+	0x14, 0xc6, 0x49, 0x6f, 0xef, 0x1f, 0x72, 0x7b, 0xe1, 0x36, 0xe5, 0x96, 0xa1, 0x57, 0x4a, 0x5b,
+	0x45, 0x0a, 0x6a, 0xe2, 0xd4, 0x37, 0x50, 0xa4, 0x3b, 0x17, 0xf5, 0xcf, 0x76, 0x48, 0x67, 0x8e,
+	0x9d, 0xc0, 0x4c, 0x32, 0x4c, 0x32, 0x23, 0x6e, 0xdd, 0xbb, 0x72, 0xe5, 0x1b, 0xb9, 0x14, 0x7c,
+	0x01, 0x29, 0x3e, 0x81, 0x4f, 0x20, 0xcd, 0x64, 0x2c, 0x88, 0x2e, 0xf3, 0xfd, 0xbe, 0x93, 0xef,
+	0xf0, 0x1d, 0x3c, 0xcc, 0x72, 0x65, 0x14, 0x9b, 0x03, 0x0f, 0x95, 0x64, 0x79, 0x16, 0xb2, 0xd2,
+	0x67, 0x0b, 0x90, 0xa0, 0x85, 0xa6, 0x96, 0x91, 0x2e, 0x98, 0x18, 0x72, 0x28, 0x52, 0x5a, 0xb9,
+	0x68, 0x9e, 0x85, 0xb4, 0xf4, 0x7b, 0x1b, 0x0b, 0xa5, 0x16, 0x09, 0x30, 0x9e, 0x09, 0xc6, 0xa5,
+	0x54, 0x86, 0x1b, 0xa1, 0xa4, 0x9b, 0xea, 0xfd, 0x77, 0xd4, 0xbe, 0xe6, 0xc5, 0x25, 0x83, 0x34,
+	0x33, 0xd7, 0x15, 0x1c, 0xbd, 0x22, 0xec, 0x4d, 0xab, 0x90, 0x23, 0x55, 0x48, 0x13, 0xa9, 0x2b,
+	0x39, 0x03, 0x9d, 0x29, 0xa9, 0x81, 0x6c, 0xe2, 0x3f, 0x61, 0xcc, 0x85, 0x0c, 0xb4, 0xe1, 0xb9,
+	0x81, 0xc8, 0x43, 0x03, 0x34, 0xfe, 0x35, 0x6b, 0x59, 0xf1, 0xb4, 0xd2, 0xc8, 0x10, 0xb7, 0xdc,
+	0x96, 0x81, 0x11, 0x29, 0x78, 0x8d, 0x01, 0x1a, 0x37, 0x67, 0xbf, 0x9d, 0x76, 0x26, 0x52, 0x20,
+	0x13, 0xfc, 0x4f, 0x43, 0xa8, 0x64, 0xa4, 0x83, 0x42, 0x1a, 0x91, 0x04, 0x0e, 0x7a, 0xdf, 0xac,
+	0xb7, 0xe3, 0xe0, 0xf9, 0x8a, 0xb9, 0x65, 0xc8, 0x1e, 0x26, 0xf5, 0xb7, 0x25, 0x4f, 0x44, 0xc4,
+	0x8d, 0xca, 0xb5, 0xd7, 0xb4, 0x03, 0x6d, 0x47, 0x2e, 0xde, 0x01, 0xd9, 0xc1, 0xed, 0xb5, 0x2d,
+	0x90, 0x00, 0x11, 0x44, 0xde, 0x77, 0xeb, 0xfe, 0xbb, 0x06, 0x27, 0x56, 0x9f, 0xdc, 0x23, 0xfc,
+	0xb3, 0xce, 0xb9, 0x45, 0xb8, 0x33, 0x05, 0xf3, 0xb1, 0x03, 0xd2, 0xa5, 0x55, 0x6d, 0xb4, 0xae,
+	0x8d, 0x1e, 0xaf, 0x6a, 0xeb, 0xed, 0xd3, 0xcf, 0x8f, 0x40, 0xbf, 0x6a, 0x71, 0xb4, 0x7b, 0xf3,
+	0xf4, 0x72, 0xd7, 0xd8, 0x26, 0x5b, 0x0c, 0x4c, 0xcc, 0x4a, 0x9f, 0x27, 0x59, 0xcc, 0x7d, 0x26,
+	0x55, 0x04, 0xf5, 0x7d, 0x59, 0x58, 0x4f, 0x1d, 0xb6, 0x1e, 0x96, 0x7d, 0xf4, 0xb8, 0xec, 0xa3,
+	0xe7, 0x65, 0x1f, 0xcd, 0x7f, 0xd8, 0xf4, 0x83, 0xb7, 0x01, 0x00, 0xd0, 0x8c, 0x87, 0xab, 0x1d,
+	0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GenesisClient is the client API for Genesis service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GenesisClient interface {
+	GetGenesisCountdown(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenesisCountdownResponse, error)
+}
+
+type genesisClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGenesisClient(cc *grpc.ClientConn) GenesisClient {
+	return &genesisClient{cc}
+}
+
+func (c *genesisClient) GetGenesisCountdown(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenesisCountdownResponse, error) {
+	out := new(GenesisCountdownResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Genesis/GetGenesisCountdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GenesisServer is the server API for Genesis service.
+type GenesisServer interface {
+	GetGenesisCountdown(context.Context, *empty.Empty) (*GenesisCountdownResponse, error)
+}
+
+// UnimplementedGenesisServer can be embedded to have forward compatible implementations.
+type UnimplementedGenesisServer struct {
+}
+
+func (*UnimplementedGenesisServer) GetGenesisCountdown(ctx context.Context, req *empty.Empty) (*GenesisCountdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGenesisCountdown not implemented")
+}
+
+func RegisterGenesisServer(s *grpc.Server, srv GenesisServer) {
+	s.RegisterService(&_Genesis_serviceDesc, srv)
+}
+
+func _Genesis_GetGenesisCountdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenesisServer).GetGenesisCountdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Genesis/GetGenesisCountdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenesisServer).GetGenesisCountdown(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Genesis_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Genesis",
+	HandlerType: (*GenesisServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGenesisCountdown",
+			Handler:    _Genesis_GetGenesisCountdown_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/genesis.proto",
+}
+
+func (m *GenesisCountdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisCountdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisCountdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ValidatorsNeeded != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValidatorsNeeded))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GenesisValidators != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GenesisValidators))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SecondsUntilGenesis != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SecondsUntilGenesis))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GenesisTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GenesisTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainStarted {
+		i--
+		if m.ChainStarted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisCountdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainStarted {
+		n += 2
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovGenesis(uint64(m.GenesisTime))
+	}
+	if m.SecondsUntilGenesis != 0 {
+		n += 1 + sovGenesis(uint64(m.SecondsUntilGenesis))
+	}
+	if m.GenesisValidators != 0 {
+		n += 1 + sovGenesis(uint64(m.GenesisValidators))
+	}
+	if m.ValidatorsNeeded != 0 {
+		n += 1 + sovGenesis(uint64(m.ValidatorsNeeded))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisCountdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisCountdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisCountdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainStarted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChainStarted = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			m.GenesisTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsUntilGenesis", wireType)
+			}
+			m.SecondsUntilGenesis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SecondsUntilGenesis |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisValidators", wireType)
+			}
+			m.GenesisValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsNeeded", wireType)
+			}
+			m.ValidatorsNeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsNeeded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
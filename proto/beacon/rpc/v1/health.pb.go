@@ -5,13 +5,16 @@ package ethereum_beacon_rpc_v1
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -76,30 +79,302 @@ func (m *LogsResponse) GetLogs() []string {
 	return nil
 }
 
+type ChainHealthResponse struct {
+	CurrentSlot                github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,1,opt,name=current_slot,json=currentSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"current_slot,omitempty"`
+	HeadSlot                   github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,2,opt,name=head_slot,json=headSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"head_slot,omitempty"`
+	JustifiedEpoch             github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,3,opt,name=justified_epoch,json=justifiedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"justified_epoch,omitempty"`
+	FinalizedEpoch             github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,4,opt,name=finalized_epoch,json=finalizedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"finalized_epoch,omitempty"`
+	EpochsSinceFinality        github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,5,opt,name=epochs_since_finality,json=epochsSinceFinality,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epochs_since_finality,omitempty"`
+	JustifiedHeadDistance      github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,6,opt,name=justified_head_distance,json=justifiedHeadDistance,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"justified_head_distance,omitempty"`
+	PreviousEpochParticipation float64                                   `protobuf:"fixed64,7,opt,name=previous_epoch_participation,json=previousEpochParticipation,proto3" json:"previous_epoch_participation,omitempty"`
+	PeerCount                  uint64                                    `protobuf:"varint,8,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	Stalled                    bool                                      `protobuf:"varint,9,opt,name=stalled,proto3" json:"stalled,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}                                  `json:"-"`
+	XXX_unrecognized           []byte                                    `json:"-"`
+	XXX_sizecache              int32                                     `json:"-"`
+}
+
+func (m *ChainHealthResponse) Reset()         { *m = ChainHealthResponse{} }
+func (m *ChainHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*ChainHealthResponse) ProtoMessage()    {}
+func (*ChainHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e4b7e98e3e10444, []int{1}
+}
+func (m *ChainHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainHealthResponse.Merge(m, src)
+}
+func (m *ChainHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainHealthResponse proto.InternalMessageInfo
+
+func (m *ChainHealthResponse) GetCurrentSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.CurrentSlot
+	}
+	return 0
+}
+
+func (m *ChainHealthResponse) GetHeadSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *ChainHealthResponse) GetJustifiedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.JustifiedEpoch
+	}
+	return 0
+}
+
+func (m *ChainHealthResponse) GetFinalizedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *ChainHealthResponse) GetEpochsSinceFinality() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.EpochsSinceFinality
+	}
+	return 0
+}
+
+func (m *ChainHealthResponse) GetJustifiedHeadDistance() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.JustifiedHeadDistance
+	}
+	return 0
+}
+
+func (m *ChainHealthResponse) GetPreviousEpochParticipation() float64 {
+	if m != nil {
+		return m.PreviousEpochParticipation
+	}
+	return 0
+}
+
+func (m *ChainHealthResponse) GetPeerCount() uint64 {
+	if m != nil {
+		return m.PeerCount
+	}
+	return 0
+}
+
+func (m *ChainHealthResponse) GetStalled() bool {
+	if m != nil {
+		return m.Stalled
+	}
+	return false
+}
+
+type NodeHealthResponse struct {
+	Syncing                  bool                                      `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	CurrentSlot              github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,2,opt,name=current_slot,json=currentSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"current_slot,omitempty"`
+	HeadSlot                 github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,3,opt,name=head_slot,json=headSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"head_slot,omitempty"`
+	SyncDistance             github_com_prysmaticlabs_eth2_types.Slot  `protobuf:"varint,4,opt,name=sync_distance,json=syncDistance,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"sync_distance,omitempty"`
+	ConnectedPeers           uint64                                    `protobuf:"varint,5,opt,name=connected_peers,json=connectedPeers,proto3" json:"connected_peers,omitempty"`
+	InboundPeers             uint64                                    `protobuf:"varint,6,opt,name=inbound_peers,json=inboundPeers,proto3" json:"inbound_peers,omitempty"`
+	OutboundPeers            uint64                                    `protobuf:"varint,7,opt,name=outbound_peers,json=outboundPeers,proto3" json:"outbound_peers,omitempty"`
+	FinalizedEpoch           github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,8,opt,name=finalized_epoch,json=finalizedEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"finalized_epoch,omitempty"`
+	FinalizedEpochAgeSeconds uint64                                    `protobuf:"varint,9,opt,name=finalized_epoch_age_seconds,json=finalizedEpochAgeSeconds,proto3" json:"finalized_epoch_age_seconds,omitempty"`
+	DiskFreeBytes            uint64                                    `protobuf:"varint,10,opt,name=disk_free_bytes,json=diskFreeBytes,proto3" json:"disk_free_bytes,omitempty"`
+	DiskTotalBytes           uint64                                    `protobuf:"varint,11,opt,name=disk_total_bytes,json=diskTotalBytes,proto3" json:"disk_total_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                                  `json:"-"`
+	XXX_unrecognized         []byte                                    `json:"-"`
+	XXX_sizecache            int32                                     `json:"-"`
+}
+
+func (m *NodeHealthResponse) Reset()         { *m = NodeHealthResponse{} }
+func (m *NodeHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeHealthResponse) ProtoMessage()    {}
+func (*NodeHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e4b7e98e3e10444, []int{2}
+}
+func (m *NodeHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeHealthResponse.Merge(m, src)
+}
+func (m *NodeHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeHealthResponse proto.InternalMessageInfo
+
+func (m *NodeHealthResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *NodeHealthResponse) GetCurrentSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.CurrentSlot
+	}
+	return 0
+}
+
+func (m *NodeHealthResponse) GetHeadSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *NodeHealthResponse) GetSyncDistance() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.SyncDistance
+	}
+	return 0
+}
+
+func (m *NodeHealthResponse) GetConnectedPeers() uint64 {
+	if m != nil {
+		return m.ConnectedPeers
+	}
+	return 0
+}
+
+func (m *NodeHealthResponse) GetInboundPeers() uint64 {
+	if m != nil {
+		return m.InboundPeers
+	}
+	return 0
+}
+
+func (m *NodeHealthResponse) GetOutboundPeers() uint64 {
+	if m != nil {
+		return m.OutboundPeers
+	}
+	return 0
+}
+
+func (m *NodeHealthResponse) GetFinalizedEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *NodeHealthResponse) GetFinalizedEpochAgeSeconds() uint64 {
+	if m != nil {
+		return m.FinalizedEpochAgeSeconds
+	}
+	return 0
+}
+
+func (m *NodeHealthResponse) GetDiskFreeBytes() uint64 {
+	if m != nil {
+		return m.DiskFreeBytes
+	}
+	return 0
+}
+
+func (m *NodeHealthResponse) GetDiskTotalBytes() uint64 {
+	if m != nil {
+		return m.DiskTotalBytes
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*LogsResponse)(nil), "ethereum.beacon.rpc.v1.LogsResponse")
+	proto.RegisterType((*ChainHealthResponse)(nil), "ethereum.beacon.rpc.v1.ChainHealthResponse")
+	proto.RegisterType((*NodeHealthResponse)(nil), "ethereum.beacon.rpc.v1.NodeHealthResponse")
 }
 
 func init() { proto.RegisterFile("proto/beacon/rpc/v1/health.proto", fileDescriptor_2e4b7e98e3e10444) }
 
 var fileDescriptor_2e4b7e98e3e10444 = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
-	0xc9, 0xd7, 0x4f, 0x4a, 0x4d, 0x4c, 0xce, 0xcf, 0xd3, 0x2f, 0x2a, 0x48, 0xd6, 0x2f, 0x33, 0xd4,
-	0xcf, 0x48, 0x4d, 0xcc, 0x29, 0xc9, 0xd0, 0x03, 0x4b, 0x09, 0x89, 0xa5, 0x96, 0x64, 0xa4, 0x16,
-	0xa5, 0x96, 0xe6, 0xea, 0x41, 0x14, 0xe9, 0x15, 0x15, 0x24, 0xeb, 0x95, 0x19, 0x4a, 0xc9, 0xa4,
-	0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5, 0xe5, 0x97, 0x24,
-	0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43, 0x74, 0x49, 0x49, 0x43, 0x65, 0xc1, 0xbc, 0xa4, 0xd2, 0x34,
-	0xfd, 0xd4, 0xdc, 0x82, 0x92, 0x4a, 0x88, 0xa4, 0x92, 0x12, 0x17, 0x8f, 0x4f, 0x7e, 0x7a, 0x71,
-	0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x10, 0x17, 0x4b, 0x4e, 0x7e, 0x7a, 0xb1,
-	0x04, 0xa3, 0x02, 0xb3, 0x06, 0x67, 0x10, 0x98, 0x6d, 0xd4, 0xc6, 0xc8, 0xc5, 0xe6, 0x01, 0x76,
-	0x87, 0x50, 0x0d, 0x97, 0x40, 0x70, 0x49, 0x51, 0x6a, 0x62, 0xae, 0x13, 0xd8, 0x01, 0x20, 0xad,
-	0x42, 0x62, 0x7a, 0x10, 0x0b, 0xf4, 0x60, 0x16, 0xe8, 0xb9, 0x82, 0x2c, 0x90, 0x52, 0xd1, 0xc3,
-	0xee, 0x5c, 0x3d, 0x64, 0x0b, 0x95, 0x34, 0x9a, 0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x24, 0xa4, 0xa0,
-	0x9f, 0x5a, 0x92, 0xa1, 0x5f, 0x66, 0x98, 0x98, 0x53, 0x90, 0x91, 0x08, 0xf3, 0xb7, 0x3e, 0xc8,
-	0x7e, 0xfd, 0x62, 0xb0, 0x8d, 0x06, 0x8c, 0x4e, 0x3c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0x63, 0x12, 0x1b, 0xd8, 0x3e, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xb7, 0x40, 0x67, 0x57, 0x38, 0x01, 0x00, 0x00,
+	// 762 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4d, 0x6f, 0xf3, 0x44,
+	0x10, 0xc7, 0xe5, 0x34, 0x6d, 0x93, 0x6d, 0xfa, 0xa2, 0xad, 0x5a, 0x4c, 0x5a, 0xda, 0x60, 0xde,
+	0xc2, 0x4b, 0x6d, 0x5a, 0xce, 0x48, 0x90, 0xd2, 0x52, 0x24, 0x04, 0xc5, 0x41, 0x5c, 0xad, 0xcd,
+	0x7a, 0x62, 0x2f, 0x38, 0xbb, 0x96, 0x77, 0x1d, 0x29, 0x88, 0x13, 0x5f, 0x81, 0xef, 0xc0, 0x67,
+	0xe1, 0x88, 0xc4, 0xbd, 0x42, 0x15, 0x9f, 0x80, 0x63, 0xb9, 0xa0, 0xdd, 0x75, 0xd2, 0xd0, 0xa6,
+	0xe2, 0x51, 0x9e, 0xde, 0xbc, 0xb3, 0xff, 0xf9, 0xfd, 0x47, 0xe3, 0xf1, 0x18, 0x75, 0xf2, 0x42,
+	0x28, 0x11, 0x0c, 0x80, 0x50, 0xc1, 0x83, 0x22, 0xa7, 0xc1, 0xf8, 0x34, 0x48, 0x81, 0x64, 0x2a,
+	0xf5, 0xcd, 0x15, 0xde, 0x07, 0x95, 0x42, 0x01, 0xe5, 0xc8, 0xb7, 0x22, 0xbf, 0xc8, 0xa9, 0x3f,
+	0x3e, 0x6d, 0x1f, 0x26, 0x42, 0x24, 0x19, 0x04, 0x24, 0x67, 0x01, 0xe1, 0x5c, 0x28, 0xa2, 0x98,
+	0xe0, 0xd2, 0x66, 0xb5, 0x0f, 0xaa, 0x5b, 0x73, 0x1a, 0x94, 0xc3, 0x00, 0x46, 0xb9, 0x9a, 0x54,
+	0x97, 0x27, 0x09, 0x53, 0x69, 0x39, 0xf0, 0xa9, 0x18, 0x05, 0x89, 0x48, 0xc4, 0xbd, 0x4a, 0x9f,
+	0x6c, 0x45, 0xfa, 0xc9, 0xca, 0x3d, 0x0f, 0xb5, 0xbe, 0x14, 0x89, 0x0c, 0x41, 0xe6, 0x82, 0x4b,
+	0xc0, 0x18, 0xd5, 0x33, 0x91, 0x48, 0xd7, 0xe9, 0xac, 0x74, 0x9b, 0xa1, 0x79, 0xf6, 0x7e, 0x5d,
+	0x45, 0xbb, 0xe7, 0x29, 0x61, 0xfc, 0xca, 0xd4, 0x3e, 0xd3, 0x7e, 0x8d, 0x5a, 0xb4, 0x2c, 0x0a,
+	0xe0, 0x2a, 0x92, 0x99, 0x50, 0xae, 0xd3, 0x71, 0xba, 0xf5, 0xde, 0x07, 0x77, 0x37, 0xc7, 0xdd,
+	0xb9, 0x22, 0xf2, 0x62, 0x22, 0x47, 0x44, 0x31, 0x9a, 0x91, 0x81, 0x0c, 0x40, 0xa5, 0x67, 0x27,
+	0x6a, 0x92, 0x83, 0xf4, 0xfb, 0x99, 0x50, 0xe1, 0x46, 0x45, 0xd0, 0x07, 0xfc, 0x05, 0x6a, 0xa6,
+	0x40, 0x62, 0x4b, 0xab, 0x2d, 0x41, 0x6b, 0xe8, 0x74, 0x83, 0xfa, 0x0e, 0x6d, 0x7f, 0x5f, 0x4a,
+	0xc5, 0x86, 0x0c, 0xe2, 0x08, 0x72, 0x41, 0x53, 0x77, 0xc5, 0x00, 0x4f, 0xee, 0x6e, 0x8e, 0xdf,
+	0x7d, 0x11, 0xe0, 0x85, 0x4e, 0x0a, 0xb7, 0x66, 0x14, 0x73, 0xd6, 0xdc, 0x21, 0xe3, 0x24, 0x63,
+	0x3f, 0xce, 0xb8, 0xf5, 0xa5, 0xb8, 0x33, 0x8a, 0xe5, 0x12, 0xb4, 0x67, 0x68, 0x32, 0x92, 0x8c,
+	0x53, 0x88, 0xec, 0xb5, 0x9a, 0xb8, 0xab, 0xcb, 0xd0, 0x77, 0x2d, 0xab, 0xaf, 0x51, 0x97, 0x15,
+	0x09, 0x03, 0x7a, 0xe5, 0xbe, 0x25, 0xa6, 0xcf, 0x31, 0x93, 0x8a, 0x70, 0x0a, 0xee, 0xda, 0x32,
+	0x26, 0x7b, 0x33, 0xda, 0x15, 0x90, 0xf8, 0xb3, 0x8a, 0x85, 0x3f, 0x41, 0x87, 0x79, 0x01, 0x63,
+	0x26, 0x4a, 0x69, 0x1b, 0x14, 0xe5, 0xa4, 0x50, 0x8c, 0xb2, 0xdc, 0x0c, 0xb1, 0xbb, 0xde, 0x71,
+	0xba, 0x4e, 0xd8, 0x9e, 0x6a, 0x0c, 0xeb, 0x7a, 0x5e, 0x81, 0x5f, 0x43, 0x28, 0x07, 0x28, 0x22,
+	0x2a, 0x4a, 0xae, 0xdc, 0x86, 0xae, 0x2d, 0x6c, 0xea, 0xc8, 0xb9, 0x0e, 0x60, 0x17, 0xad, 0x4b,
+	0x45, 0xb2, 0x0c, 0x62, 0xb7, 0xd9, 0x71, 0xba, 0x8d, 0x70, 0x7a, 0xf4, 0xfe, 0xa9, 0x23, 0xfc,
+	0x95, 0x88, 0xe1, 0xc1, 0x9c, 0xea, 0x84, 0x09, 0xa7, 0x8c, 0x27, 0xae, 0x53, 0x25, 0xd8, 0xe3,
+	0xa3, 0x09, 0xae, 0x3d, 0xeb, 0x04, 0xaf, 0xbc, 0xd4, 0x04, 0x7f, 0x83, 0x36, 0x75, 0x99, 0xf7,
+	0x2f, 0xa9, 0xbe, 0x04, 0xae, 0xa5, 0x11, 0xb3, 0x57, 0xf3, 0x0e, 0xda, 0xa6, 0x82, 0x73, 0xa0,
+	0x0a, 0xe2, 0x48, 0x37, 0x54, 0xda, 0xf1, 0x0a, 0xb7, 0x66, 0xe1, 0x6b, 0x1d, 0xc5, 0x6f, 0xa0,
+	0x4d, 0xc6, 0x07, 0xa2, 0xe4, 0x53, 0x99, 0x19, 0x90, 0xb0, 0x55, 0x05, 0xad, 0xe8, 0x2d, 0xb4,
+	0x25, 0x4a, 0x35, 0xaf, 0x5a, 0x37, 0xaa, 0xcd, 0x69, 0xd4, 0xca, 0x16, 0x7c, 0x31, 0x8d, 0xe7,
+	0xf8, 0x62, 0x3e, 0x46, 0x07, 0x0f, 0xb8, 0x11, 0x49, 0x20, 0x92, 0x40, 0x05, 0x8f, 0xa5, 0x19,
+	0x8d, 0x7a, 0xe8, 0xfe, 0x37, 0xe9, 0xd3, 0x04, 0xfa, 0xf6, 0x1e, 0xbf, 0x8d, 0xb6, 0x63, 0x26,
+	0x7f, 0x88, 0x86, 0x05, 0x40, 0x34, 0x98, 0x28, 0x90, 0x2e, 0xb2, 0xe5, 0xeb, 0xf0, 0x65, 0x01,
+	0xd0, 0xd3, 0x41, 0xdc, 0x45, 0x3b, 0x46, 0xa7, 0x84, 0x22, 0x59, 0x25, 0xdc, 0xb0, 0x4d, 0xd3,
+	0xf1, 0x6f, 0x75, 0xd8, 0x28, 0xcf, 0xfe, 0xae, 0xa1, 0x35, 0x3b, 0x79, 0xf8, 0x27, 0xb4, 0xd3,
+	0x57, 0x05, 0x90, 0x51, 0xcf, 0xac, 0x75, 0xbd, 0x61, 0xf1, 0xbe, 0x6f, 0xd7, 0xb6, 0x3f, 0x5d,
+	0xc8, 0xfe, 0x85, 0x5e, 0xdb, 0xed, 0x37, 0xfd, 0xc5, 0x3f, 0x01, 0x7f, 0x7e, 0x2f, 0x7b, 0xdd,
+	0x9f, 0xff, 0xf8, 0xeb, 0x97, 0x9a, 0x87, 0x3b, 0xba, 0x31, 0xc1, 0xf8, 0x94, 0x64, 0x79, 0x4a,
+	0xa6, 0x7f, 0x93, 0x40, 0xaf, 0xe9, 0x40, 0x1a, 0xc7, 0x0f, 0x1d, 0x3c, 0x46, 0x1b, 0x73, 0xeb,
+	0xfa, 0x49, 0xe3, 0xf7, 0x9f, 0x32, 0x5e, 0xb0, 0xeb, 0x3d, 0xcf, 0xf8, 0x1f, 0xe2, 0xf6, 0x42,
+	0x7f, 0xaa, 0x33, 0x70, 0x81, 0x9a, 0x9f, 0x83, 0xfa, 0x1f, 0xd7, 0xf7, 0x9e, 0x72, 0x7d, 0xfc,
+	0xe1, 0x7a, 0xaf, 0x1b, 0xd3, 0x03, 0xfc, 0xea, 0x42, 0x53, 0x2e, 0x62, 0xe8, 0xb5, 0x7e, 0xbb,
+	0x3d, 0x72, 0x7e, 0xbf, 0x3d, 0x72, 0xfe, 0xbc, 0x3d, 0x72, 0x06, 0x6b, 0xc6, 0xec, 0xa3, 0x7f,
+	0x07, 0x00, 0x36, 0xcb, 0xde, 0xb5, 0x7a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -115,6 +390,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type HealthClient interface {
 	StreamBeaconLogs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Health_StreamBeaconLogsClient, error)
+	ChainHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChainHealthResponse, error)
+	GetHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeHealthResponse, error)
 }
 
 type healthClient struct {
@@ -157,9 +434,29 @@ func (x *healthStreamBeaconLogsClient) Recv() (*LogsResponse, error) {
 	return m, nil
 }
 
+func (c *healthClient) ChainHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChainHealthResponse, error) {
+	out := new(ChainHealthResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Health/ChainHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthClient) GetHealth(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeHealthResponse, error) {
+	out := new(NodeHealthResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Health/GetHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HealthServer is the server API for Health service.
 type HealthServer interface {
 	StreamBeaconLogs(*empty.Empty, Health_StreamBeaconLogsServer) error
+	ChainHealth(context.Context, *empty.Empty) (*ChainHealthResponse, error)
+	GetHealth(context.Context, *empty.Empty) (*NodeHealthResponse, error)
 }
 
 // UnimplementedHealthServer can be embedded to have forward compatible implementations.
@@ -169,6 +466,12 @@ type UnimplementedHealthServer struct {
 func (*UnimplementedHealthServer) StreamBeaconLogs(req *empty.Empty, srv Health_StreamBeaconLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamBeaconLogs not implemented")
 }
+func (*UnimplementedHealthServer) ChainHealth(ctx context.Context, req *empty.Empty) (*ChainHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainHealth not implemented")
+}
+func (*UnimplementedHealthServer) GetHealth(ctx context.Context, req *empty.Empty) (*NodeHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
+}
 
 func RegisterHealthServer(s *grpc.Server, srv HealthServer) {
 	s.RegisterService(&_Health_serviceDesc, srv)
@@ -195,10 +498,55 @@ func (x *healthStreamBeaconLogsServer) Send(m *LogsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Health_ChainHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServer).ChainHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Health/ChainHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).ChainHealth(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Health_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServer).GetHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Health/GetHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).GetHealth(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Health_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.Health",
 	HandlerType: (*HealthServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChainHealth",
+			Handler:    _Health_ChainHealth_Handler,
+		},
+		{
+			MethodName: "GetHealth",
+			Handler:    _Health_GetHealth_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "StreamBeaconLogs",
@@ -245,6 +593,171 @@ func (m *LogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Stalled {
+		i--
+		if m.Stalled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PeerCount != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.PeerCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PreviousEpochParticipation != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.PreviousEpochParticipation))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.JustifiedHeadDistance != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.JustifiedHeadDistance))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EpochsSinceFinality != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.EpochsSinceFinality))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HeadSlot != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.HeadSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CurrentSlot != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.CurrentSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DiskTotalBytes != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.DiskTotalBytes))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.DiskFreeBytes != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.DiskFreeBytes))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FinalizedEpochAgeSeconds != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.FinalizedEpochAgeSeconds))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OutboundPeers != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.OutboundPeers))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InboundPeers != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.InboundPeers))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ConnectedPeers != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.ConnectedPeers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SyncDistance != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.SyncDistance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HeadSlot != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.HeadSlot))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentSlot != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.CurrentSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Syncing {
+		i--
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHealth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHealth(v)
 	base := offset
@@ -274,22 +787,106 @@ func (m *LogsResponse) Size() (n int) {
 	return n
 }
 
-func sovHealth(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozHealth(x uint64) (n int) {
-	return sovHealth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ChainHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentSlot != 0 {
+		n += 1 + sovHealth(uint64(m.CurrentSlot))
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovHealth(uint64(m.HeadSlot))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovHealth(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovHealth(uint64(m.FinalizedEpoch))
+	}
+	if m.EpochsSinceFinality != 0 {
+		n += 1 + sovHealth(uint64(m.EpochsSinceFinality))
+	}
+	if m.JustifiedHeadDistance != 0 {
+		n += 1 + sovHealth(uint64(m.JustifiedHeadDistance))
+	}
+	if m.PreviousEpochParticipation != 0 {
+		n += 9
+	}
+	if m.PeerCount != 0 {
+		n += 1 + sovHealth(uint64(m.PeerCount))
+	}
+	if m.Stalled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *LogsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHealth
-			}
+
+func (m *NodeHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Syncing {
+		n += 2
+	}
+	if m.CurrentSlot != 0 {
+		n += 1 + sovHealth(uint64(m.CurrentSlot))
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovHealth(uint64(m.HeadSlot))
+	}
+	if m.SyncDistance != 0 {
+		n += 1 + sovHealth(uint64(m.SyncDistance))
+	}
+	if m.ConnectedPeers != 0 {
+		n += 1 + sovHealth(uint64(m.ConnectedPeers))
+	}
+	if m.InboundPeers != 0 {
+		n += 1 + sovHealth(uint64(m.InboundPeers))
+	}
+	if m.OutboundPeers != 0 {
+		n += 1 + sovHealth(uint64(m.OutboundPeers))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovHealth(uint64(m.FinalizedEpoch))
+	}
+	if m.FinalizedEpochAgeSeconds != 0 {
+		n += 1 + sovHealth(uint64(m.FinalizedEpochAgeSeconds))
+	}
+	if m.DiskFreeBytes != 0 {
+		n += 1 + sovHealth(uint64(m.DiskFreeBytes))
+	}
+	if m.DiskTotalBytes != 0 {
+		n += 1 + sovHealth(uint64(m.DiskTotalBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovHealth(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHealth(x uint64) (n int) {
+	return sovHealth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -363,6 +960,482 @@ func (m *LogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChainHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSlot", wireType)
+			}
+			m.CurrentSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsSinceFinality", wireType)
+			}
+			m.EpochsSinceFinality = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsSinceFinality |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedHeadDistance", wireType)
+			}
+			m.JustifiedHeadDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedHeadDistance |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochParticipation", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.PreviousEpochParticipation = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerCount", wireType)
+			}
+			m.PeerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stalled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stalled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Syncing = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSlot", wireType)
+			}
+			m.CurrentSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncDistance", wireType)
+			}
+			m.SyncDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncDistance |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectedPeers", wireType)
+			}
+			m.ConnectedPeers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectedPeers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundPeers", wireType)
+			}
+			m.InboundPeers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InboundPeers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPeers", wireType)
+			}
+			m.OutboundPeers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundPeers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpochAgeSeconds", wireType)
+			}
+			m.FinalizedEpochAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpochAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskFreeBytes", wireType)
+			}
+			m.DiskFreeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskFreeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskTotalBytes", wireType)
+			}
+			m.DiskTotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskTotalBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipHealth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
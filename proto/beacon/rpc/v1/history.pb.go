@@ -0,0 +1,1672 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/history.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type HistoryRequest struct {
+	StartSlot            github_com_prysmaticlabs_eth2_types.Slot             `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"start_slot,omitempty"`
+	EndSlot              github_com_prysmaticlabs_eth2_types.Slot             `protobuf:"varint,2,opt,name=end_slot,json=endSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"end_slot,omitempty"`
+	ProposerIndices      []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,3,rep,packed,name=proposer_indices,json=proposerIndices,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"proposer_indices,omitempty"`
+	CanonicalOnly        bool                                                 `protobuf:"varint,4,opt,name=canonical_only,json=canonicalOnly,proto3" json:"canonical_only,omitempty"`
+	PageSize             int32                                                `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                                               `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                             `json:"-"`
+	XXX_unrecognized     []byte                                               `json:"-"`
+	XXX_sizecache        int32                                                `json:"-"`
+}
+
+func (m *HistoryRequest) Reset()         { *m = HistoryRequest{} }
+func (m *HistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*HistoryRequest) ProtoMessage()    {}
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce3d62368513f876, []int{0}
+}
+func (m *HistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryRequest.Merge(m, src)
+}
+func (m *HistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryRequest proto.InternalMessageInfo
+
+func (m *HistoryRequest) GetStartSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+func (m *HistoryRequest) GetEndSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.EndSlot
+	}
+	return 0
+}
+
+func (m *HistoryRequest) GetProposerIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.ProposerIndices
+	}
+	return nil
+}
+
+func (m *HistoryRequest) GetCanonicalOnly() bool {
+	if m != nil {
+		return m.CanonicalOnly
+	}
+	return false
+}
+
+func (m *HistoryRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *HistoryRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type BlocksPage struct {
+	BlockContainers      []*v1alpha1.BeaconBlockContainer `protobuf:"bytes,1,rep,name=block_containers,json=blockContainers,proto3" json:"block_containers,omitempty"`
+	NextPageToken        string                           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *BlocksPage) Reset()         { *m = BlocksPage{} }
+func (m *BlocksPage) String() string { return proto.CompactTextString(m) }
+func (*BlocksPage) ProtoMessage()    {}
+func (*BlocksPage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce3d62368513f876, []int{1}
+}
+func (m *BlocksPage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlocksPage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlocksPage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlocksPage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksPage.Merge(m, src)
+}
+func (m *BlocksPage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlocksPage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksPage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksPage proto.InternalMessageInfo
+
+func (m *BlocksPage) GetBlockContainers() []*v1alpha1.BeaconBlockContainer {
+	if m != nil {
+		return m.BlockContainers
+	}
+	return nil
+}
+
+func (m *BlocksPage) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type AttestationsPage struct {
+	Attestations         []*IncludedAttestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	NextPageToken        string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *AttestationsPage) Reset()         { *m = AttestationsPage{} }
+func (m *AttestationsPage) String() string { return proto.CompactTextString(m) }
+func (*AttestationsPage) ProtoMessage()    {}
+func (*AttestationsPage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce3d62368513f876, []int{2}
+}
+func (m *AttestationsPage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationsPage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationsPage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationsPage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationsPage.Merge(m, src)
+}
+func (m *AttestationsPage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationsPage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationsPage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationsPage proto.InternalMessageInfo
+
+func (m *AttestationsPage) GetAttestations() []*IncludedAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *AttestationsPage) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type IncludedAttestation struct {
+	Attestation          *v1alpha1.Attestation                    `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	BlockSlot            github_com_prysmaticlabs_eth2_types.Slot `protobuf:"varint,2,opt,name=block_slot,json=blockSlot,proto3,casttype=github.com/prysmaticlabs/eth2-types.Slot" json:"block_slot,omitempty"`
+	BlockRoot            []byte                                   `protobuf:"bytes,3,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	Canonical            bool                                     `protobuf:"varint,4,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *IncludedAttestation) Reset()         { *m = IncludedAttestation{} }
+func (m *IncludedAttestation) String() string { return proto.CompactTextString(m) }
+func (*IncludedAttestation) ProtoMessage()    {}
+func (*IncludedAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce3d62368513f876, []int{3}
+}
+func (m *IncludedAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncludedAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncludedAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncludedAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncludedAttestation.Merge(m, src)
+}
+func (m *IncludedAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncludedAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncludedAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncludedAttestation proto.InternalMessageInfo
+
+func (m *IncludedAttestation) GetAttestation() *v1alpha1.Attestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+func (m *IncludedAttestation) GetBlockSlot() github_com_prysmaticlabs_eth2_types.Slot {
+	if m != nil {
+		return m.BlockSlot
+	}
+	return 0
+}
+
+func (m *IncludedAttestation) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *IncludedAttestation) GetCanonical() bool {
+	if m != nil {
+		return m.Canonical
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*HistoryRequest)(nil), "ethereum.beacon.rpc.v1.HistoryRequest")
+	proto.RegisterType((*BlocksPage)(nil), "ethereum.beacon.rpc.v1.BlocksPage")
+	proto.RegisterType((*AttestationsPage)(nil), "ethereum.beacon.rpc.v1.AttestationsPage")
+	proto.RegisterType((*IncludedAttestation)(nil), "ethereum.beacon.rpc.v1.IncludedAttestation")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/history.proto", fileDescriptor_ce3d62368513f876) }
+
+var fileDescriptor_ce3d62368513f876 = []byte{
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcd, 0x6f, 0x13, 0x39,
+	0x18, 0xc6, 0xe5, 0x24, 0xfd, 0x88, 0xfb, 0x95, 0xf5, 0x4a, 0xd5, 0xa8, 0xdb, 0x4d, 0xb2, 0xb3,
+	0x6d, 0x35, 0x50, 0x3a, 0x6e, 0x52, 0x89, 0x03, 0x37, 0x02, 0x12, 0x54, 0x20, 0xb5, 0x9a, 0xa2,
+	0x5e, 0x23, 0x67, 0xc6, 0xcc, 0x58, 0x9d, 0xd8, 0xc3, 0xd8, 0xa9, 0x9a, 0x1e, 0x11, 0x37, 0x90,
+	0x38, 0x20, 0x71, 0xe7, 0xbf, 0xe1, 0x88, 0xc4, 0xbd, 0xa0, 0x8a, 0x23, 0x27, 0x4e, 0xa8, 0x27,
+	0x34, 0x9e, 0x24, 0x9d, 0x41, 0x0d, 0x94, 0x4a, 0x70, 0xb3, 0x5f, 0xbf, 0xcf, 0xa3, 0x9f, 0x5e,
+	0x3f, 0x36, 0xfc, 0x2f, 0x8a, 0x85, 0x12, 0xb8, 0x43, 0x89, 0x2b, 0x38, 0x8e, 0x23, 0x17, 0x1f,
+	0x36, 0x70, 0xc0, 0xa4, 0x12, 0x71, 0xdf, 0xd6, 0x67, 0x68, 0x91, 0xaa, 0x80, 0xc6, 0xb4, 0xd7,
+	0xb5, 0xd3, 0x2e, 0x3b, 0x8e, 0x5c, 0xfb, 0xb0, 0xb1, 0x54, 0xa5, 0x2a, 0xc0, 0x87, 0x0d, 0x12,
+	0x46, 0x01, 0x69, 0x60, 0xa2, 0x14, 0x95, 0x8a, 0x28, 0x26, 0x78, 0xaa, 0x5b, 0xaa, 0xe5, 0xce,
+	0x53, 0x6d, 0xdb, 0x0d, 0x08, 0x1b, 0x36, 0x2c, 0xfb, 0x42, 0xf8, 0x21, 0xc5, 0x24, 0x62, 0x98,
+	0x70, 0x2e, 0x52, 0xb5, 0x1c, 0x9c, 0x6e, 0xf8, 0x4c, 0x05, 0xbd, 0x8e, 0xed, 0x8a, 0x2e, 0xf6,
+	0x85, 0x2f, 0xb0, 0x2e, 0x77, 0x7a, 0x8f, 0xf5, 0x2e, 0xc5, 0x4e, 0x56, 0x69, 0xbb, 0xf9, 0xb9,
+	0x00, 0xe7, 0xef, 0xa7, 0xdc, 0x0e, 0x7d, 0xd2, 0xa3, 0x52, 0xa1, 0x07, 0x10, 0x4a, 0x45, 0x62,
+	0xd5, 0x96, 0xa1, 0x50, 0x06, 0xa8, 0x03, 0xab, 0xd4, 0xba, 0x71, 0x76, 0x52, 0xb3, 0x32, 0xce,
+	0x51, 0xdc, 0x97, 0x5d, 0xa2, 0x98, 0x1b, 0x92, 0x8e, 0xc4, 0x54, 0x05, 0xcd, 0x0d, 0xd5, 0x8f,
+	0xa8, 0xb4, 0xf7, 0x42, 0xa1, 0x9c, 0xb2, 0xd6, 0x27, 0x4b, 0x74, 0x0f, 0x4e, 0x53, 0xee, 0xa5,
+	0x56, 0x85, 0x2b, 0x58, 0x4d, 0x51, 0xee, 0x69, 0x23, 0x02, 0x2b, 0x51, 0x2c, 0x22, 0x21, 0x69,
+	0xdc, 0x66, 0xdc, 0x63, 0x2e, 0x95, 0x46, 0xb1, 0x5e, 0xb4, 0x4a, 0xad, 0x9b, 0x67, 0x27, 0xb5,
+	0xe6, 0x65, 0x0c, 0xf7, 0x49, 0xc8, 0x3c, 0xa2, 0x44, 0xbc, 0xcd, 0x3d, 0x7a, 0xe4, 0x2c, 0x0c,
+	0xfd, 0xb6, 0x53, 0x3b, 0xb4, 0x0a, 0xe7, 0x5d, 0xc2, 0x05, 0x67, 0x2e, 0x09, 0xdb, 0x82, 0x87,
+	0x7d, 0xa3, 0x54, 0x07, 0xd6, 0xb4, 0x33, 0x37, 0xaa, 0xee, 0xf0, 0xb0, 0x8f, 0xfe, 0x81, 0xe5,
+	0x88, 0xf8, 0xb4, 0x2d, 0xd9, 0x31, 0x35, 0x26, 0xea, 0xc0, 0x9a, 0x70, 0xa6, 0x93, 0xc2, 0x1e,
+	0x3b, 0xa6, 0xe8, 0x5f, 0x08, 0xf5, 0xa1, 0x12, 0x07, 0x94, 0x1b, 0x93, 0x75, 0x60, 0x95, 0x1d,
+	0xdd, 0xfe, 0x28, 0x29, 0x98, 0x2f, 0x00, 0x84, 0xad, 0x50, 0xb8, 0x07, 0x72, 0x97, 0xf8, 0x14,
+	0xed, 0xc3, 0x4a, 0x27, 0xd9, 0xb5, 0x5d, 0xc1, 0x15, 0x61, 0x9c, 0xc6, 0xd2, 0x00, 0xf5, 0xa2,
+	0x35, 0xd3, 0x5c, 0xb7, 0x47, 0xf1, 0xa1, 0x2a, 0xb0, 0x87, 0x79, 0xb0, 0x5b, 0x3a, 0x0f, 0xda,
+	0xe2, 0xce, 0x50, 0xe3, 0x2c, 0x74, 0x72, 0x7b, 0x89, 0xd6, 0xe0, 0x02, 0xa7, 0x47, 0xaa, 0x9d,
+	0x41, 0x29, 0x68, 0x94, 0xb9, 0xa4, 0xbc, 0x3b, 0xc2, 0x79, 0x0e, 0x60, 0xe5, 0xf6, 0x79, 0x02,
+	0x53, 0xa8, 0x1d, 0x38, 0x9b, 0x49, 0xe5, 0x05, 0x40, 0xb9, 0x3c, 0xdb, 0xdb, 0xdc, 0x0d, 0x7b,
+	0x1e, 0xf5, 0x32, 0x3e, 0x4e, 0xce, 0xe0, 0xd2, 0x34, 0x5f, 0x01, 0xfc, 0xfb, 0x02, 0x37, 0x74,
+	0x17, 0xce, 0x64, 0xfc, 0x74, 0x22, 0x67, 0x9a, 0xe6, 0x98, 0x01, 0x65, 0x31, 0xb2, 0xb2, 0x24,
+	0xd6, 0xe9, 0xac, 0xaf, 0x9c, 0xc5, 0xb2, 0xd6, 0x27, 0x4b, 0xb4, 0x39, 0x34, 0x8b, 0x85, 0x50,
+	0x46, 0xb1, 0x0e, 0xac, 0xd9, 0xd6, 0x5f, 0x5f, 0x4e, 0x6a, 0x73, 0x52, 0x1e, 0x6f, 0x24, 0xc1,
+	0xb8, 0x65, 0x6e, 0x35, 0xcd, 0x81, 0xc2, 0x11, 0x42, 0xa1, 0x65, 0x58, 0x1e, 0xc5, 0x68, 0x90,
+	0xab, 0xf3, 0x42, 0xf3, 0x43, 0x09, 0x4e, 0x0d, 0x9e, 0x21, 0x7a, 0x06, 0x60, 0xe5, 0x21, 0x93,
+	0x4a, 0x5f, 0xf2, 0xb0, 0xb8, 0x36, 0x6e, 0xfc, 0xf9, 0xc7, 0xbb, 0x64, 0x8e, 0xeb, 0x3b, 0x4f,
+	0x9d, 0xb9, 0xf2, 0xf4, 0xfd, 0xa7, 0x57, 0x85, 0x2a, 0x5a, 0xc6, 0xb9, 0xaf, 0x66, 0xf0, 0x7d,
+	0x61, 0xcd, 0x2c, 0xd1, 0x4b, 0x00, 0xd1, 0x9e, 0x8a, 0x29, 0xe9, 0xfe, 0x36, 0x90, 0x75, 0x0d,
+	0xb2, 0x8a, 0xfe, 0xff, 0x11, 0x08, 0x96, 0x1a, 0x62, 0x13, 0xa0, 0xd7, 0x00, 0x2e, 0x26, 0x83,
+	0xc9, 0x5c, 0xf1, 0xaf, 0x52, 0x59, 0xe3, 0xfa, 0xbe, 0x7f, 0x05, 0xe6, 0x75, 0xcd, 0xb6, 0x82,
+	0xcc, 0x8b, 0xd9, 0x72, 0x01, 0x7f, 0x03, 0xa0, 0x91, 0x8e, 0xea, 0x8f, 0xa0, 0x35, 0x34, 0xda,
+	0x3a, 0xba, 0xf6, 0x73, 0xb4, 0xd1, 0xf0, 0x5a, 0xb3, 0x6f, 0x4f, 0xab, 0xe0, 0xdd, 0x69, 0x15,
+	0x7c, 0x3c, 0xad, 0x82, 0xce, 0xa4, 0xfe, 0xfd, 0xb7, 0xbe, 0x0d, 0x00, 0xc1, 0x4a, 0x69, 0x21,
+	0xc8, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// HistoryClient is the client API for History service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HistoryClient interface {
+	ListBlockHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*BlocksPage, error)
+	StreamBlockHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (History_StreamBlockHistoryClient, error)
+	ListAttestationHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*AttestationsPage, error)
+	StreamAttestationHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (History_StreamAttestationHistoryClient, error)
+}
+
+type historyClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewHistoryClient(cc *grpc.ClientConn) HistoryClient {
+	return &historyClient{cc}
+}
+
+func (c *historyClient) ListBlockHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*BlocksPage, error) {
+	out := new(BlocksPage)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.History/ListBlockHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyClient) StreamBlockHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (History_StreamBlockHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_History_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.History/StreamBlockHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &historyStreamBlockHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type History_StreamBlockHistoryClient interface {
+	Recv() (*BlocksPage, error)
+	grpc.ClientStream
+}
+
+type historyStreamBlockHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *historyStreamBlockHistoryClient) Recv() (*BlocksPage, error) {
+	m := new(BlocksPage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *historyClient) ListAttestationHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*AttestationsPage, error) {
+	out := new(AttestationsPage)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.History/ListAttestationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyClient) StreamAttestationHistory(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (History_StreamAttestationHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_History_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.History/StreamAttestationHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &historyStreamAttestationHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type History_StreamAttestationHistoryClient interface {
+	Recv() (*AttestationsPage, error)
+	grpc.ClientStream
+}
+
+type historyStreamAttestationHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *historyStreamAttestationHistoryClient) Recv() (*AttestationsPage, error) {
+	m := new(AttestationsPage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// HistoryServer is the server API for History service.
+type HistoryServer interface {
+	ListBlockHistory(context.Context, *HistoryRequest) (*BlocksPage, error)
+	StreamBlockHistory(*HistoryRequest, History_StreamBlockHistoryServer) error
+	ListAttestationHistory(context.Context, *HistoryRequest) (*AttestationsPage, error)
+	StreamAttestationHistory(*HistoryRequest, History_StreamAttestationHistoryServer) error
+}
+
+// UnimplementedHistoryServer can be embedded to have forward compatible implementations.
+type UnimplementedHistoryServer struct {
+}
+
+func (*UnimplementedHistoryServer) ListBlockHistory(ctx context.Context, req *HistoryRequest) (*BlocksPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockHistory not implemented")
+}
+func (*UnimplementedHistoryServer) StreamBlockHistory(req *HistoryRequest, srv History_StreamBlockHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlockHistory not implemented")
+}
+func (*UnimplementedHistoryServer) ListAttestationHistory(ctx context.Context, req *HistoryRequest) (*AttestationsPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttestationHistory not implemented")
+}
+func (*UnimplementedHistoryServer) StreamAttestationHistory(req *HistoryRequest, srv History_StreamAttestationHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAttestationHistory not implemented")
+}
+
+func RegisterHistoryServer(s *grpc.Server, srv HistoryServer) {
+	s.RegisterService(&_History_serviceDesc, srv)
+}
+
+func _History_ListBlockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServer).ListBlockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.History/ListBlockHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServer).ListBlockHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _History_StreamBlockHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HistoryServer).StreamBlockHistory(m, &historyStreamBlockHistoryServer{stream})
+}
+
+type History_StreamBlockHistoryServer interface {
+	Send(*BlocksPage) error
+	grpc.ServerStream
+}
+
+type historyStreamBlockHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *historyStreamBlockHistoryServer) Send(m *BlocksPage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _History_ListAttestationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServer).ListAttestationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.History/ListAttestationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServer).ListAttestationHistory(ctx, req.(*HistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _History_StreamAttestationHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HistoryServer).StreamAttestationHistory(m, &historyStreamAttestationHistoryServer{stream})
+}
+
+type History_StreamAttestationHistoryServer interface {
+	Send(*AttestationsPage) error
+	grpc.ServerStream
+}
+
+type historyStreamAttestationHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *historyStreamAttestationHistoryServer) Send(m *AttestationsPage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _History_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.History",
+	HandlerType: (*HistoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListBlockHistory",
+			Handler:    _History_ListBlockHistory_Handler,
+		},
+		{
+			MethodName: "ListAttestationHistory",
+			Handler:    _History_ListAttestationHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlockHistory",
+			Handler:       _History_StreamBlockHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAttestationHistory",
+			Handler:       _History_StreamAttestationHistory_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/history.proto",
+}
+
+func (m *HistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CanonicalOnly {
+		i--
+		if m.CanonicalOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerIndices) > 0 {
+		dAtA2 := make([]byte, len(m.ProposerIndices)*10)
+		var j1 int
+		for _, num := range m.ProposerIndices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintHistory(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndSlot != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.EndSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartSlot != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StartSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlocksPage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlocksPage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlocksPage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockContainers) > 0 {
+		for iNdEx := len(m.BlockContainers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockContainers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationsPage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationsPage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationsPage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncludedAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncludedAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncludedAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Canonical {
+		i--
+		if m.Canonical {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockSlot != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.BlockSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHistory(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSlot != 0 {
+		n += 1 + sovHistory(uint64(m.StartSlot))
+	}
+	if m.EndSlot != 0 {
+		n += 1 + sovHistory(uint64(m.EndSlot))
+	}
+	if len(m.ProposerIndices) > 0 {
+		l = 0
+		for _, e := range m.ProposerIndices {
+			l += sovHistory(uint64(e))
+		}
+		n += 1 + sovHistory(uint64(l)) + l
+	}
+	if m.CanonicalOnly {
+		n += 2
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovHistory(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlocksPage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockContainers) > 0 {
+		for _, e := range m.BlockContainers {
+			l = e.Size()
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationsPage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IncludedAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.BlockSlot != 0 {
+		n += 1 + sovHistory(uint64(m.BlockSlot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Canonical {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSlot", wireType)
+			}
+			m.EndSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHistory
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProposerIndices = append(m.ProposerIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHistory
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthHistory
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthHistory
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProposerIndices) == 0 {
+					m.ProposerIndices = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHistory
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProposerIndices = append(m.ProposerIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerIndices", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanonicalOnly = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlocksPage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlocksPage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlocksPage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockContainers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockContainers = append(m.BlockContainers, &v1alpha1.BeaconBlockContainer{})
+			if err := m.BlockContainers[len(m.BlockContainers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationsPage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationsPage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationsPage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &IncludedAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncludedAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncludedAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncludedAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &v1alpha1.Attestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSlot", wireType)
+			}
+			m.BlockSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSlot |= github_com_prysmaticlabs_eth2_types.Slot(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canonical", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Canonical = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
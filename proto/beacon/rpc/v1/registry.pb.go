@@ -0,0 +1,1992 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/registry.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_prysmaticlabs_eth2_types "github.com/prysmaticlabs/eth2-types"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ValidatorsByWithdrawalCredentialsRequest struct {
+	WithdrawalCredentials []byte   `protobuf:"bytes,1,opt,name=withdrawal_credentials,json=withdrawalCredentials,proto3" json:"withdrawal_credentials,omitempty" ssz-size:"32"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) Reset() {
+	*m = ValidatorsByWithdrawalCredentialsRequest{}
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorsByWithdrawalCredentialsRequest) ProtoMessage()    {}
+func (*ValidatorsByWithdrawalCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0507088b2f63fdc, []int{0}
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorsByWithdrawalCredentialsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorsByWithdrawalCredentialsRequest.Merge(m, src)
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorsByWithdrawalCredentialsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorsByWithdrawalCredentialsRequest proto.InternalMessageInfo
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) GetWithdrawalCredentials() []byte {
+	if m != nil {
+		return m.WithdrawalCredentials
+	}
+	return nil
+}
+
+type ValidatorsByWithdrawalCredentialsResponse struct {
+	Validators           []*RegistryValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ValidatorsByWithdrawalCredentialsResponse) Reset() {
+	*m = ValidatorsByWithdrawalCredentialsResponse{}
+}
+func (m *ValidatorsByWithdrawalCredentialsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ValidatorsByWithdrawalCredentialsResponse) ProtoMessage() {}
+func (*ValidatorsByWithdrawalCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0507088b2f63fdc, []int{1}
+}
+func (m *ValidatorsByWithdrawalCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorsByWithdrawalCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorsByWithdrawalCredentialsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorsByWithdrawalCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorsByWithdrawalCredentialsResponse.Merge(m, src)
+}
+func (m *ValidatorsByWithdrawalCredentialsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorsByWithdrawalCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorsByWithdrawalCredentialsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorsByWithdrawalCredentialsResponse proto.InternalMessageInfo
+
+func (m *ValidatorsByWithdrawalCredentialsResponse) GetValidators() []*RegistryValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type RegistryValidator struct {
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	PublicKey            []byte                                             `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *RegistryValidator) Reset()         { *m = RegistryValidator{} }
+func (m *RegistryValidator) String() string { return proto.CompactTextString(m) }
+func (*RegistryValidator) ProtoMessage()    {}
+func (*RegistryValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0507088b2f63fdc, []int{2}
+}
+func (m *RegistryValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistryValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistryValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistryValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistryValidator.Merge(m, src)
+}
+func (m *RegistryValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistryValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistryValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistryValidator proto.InternalMessageInfo
+
+func (m *RegistryValidator) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RegistryValidator) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type ValidatorStatusesRequest struct {
+	PublicKeys           [][]byte                                             `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Indices              []github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,2,rep,packed,name=indices,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"indices,omitempty"`
+	PageSize             int32                                                `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                                               `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                             `json:"-"`
+	XXX_unrecognized     []byte                                               `json:"-"`
+	XXX_sizecache        int32                                                `json:"-"`
+}
+
+func (m *ValidatorStatusesRequest) Reset()         { *m = ValidatorStatusesRequest{} }
+func (m *ValidatorStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorStatusesRequest) ProtoMessage()    {}
+func (*ValidatorStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0507088b2f63fdc, []int{3}
+}
+func (m *ValidatorStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorStatusesRequest.Merge(m, src)
+}
+func (m *ValidatorStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorStatusesRequest proto.InternalMessageInfo
+
+func (m *ValidatorStatusesRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *ValidatorStatusesRequest) GetIndices() []github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+func (m *ValidatorStatusesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ValidatorStatusesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ValidatorStatusesResponse struct {
+	Epoch                github_com_prysmaticlabs_eth2_types.Epoch `protobuf:"varint,1,opt,name=epoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"epoch,omitempty"`
+	Validators           []*RegistryValidatorStatus                `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	NextPageToken        string                                    `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize            int32                                     `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *ValidatorStatusesResponse) Reset()         { *m = ValidatorStatusesResponse{} }
+func (m *ValidatorStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorStatusesResponse) ProtoMessage()    {}
+func (*ValidatorStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0507088b2f63fdc, []int{4}
+}
+func (m *ValidatorStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorStatusesResponse.Merge(m, src)
+}
+func (m *ValidatorStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorStatusesResponse proto.InternalMessageInfo
+
+func (m *ValidatorStatusesResponse) GetEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorStatusesResponse) GetValidators() []*RegistryValidatorStatus {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *ValidatorStatusesResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ValidatorStatusesResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type RegistryValidatorStatus struct {
+	Index                github_com_prysmaticlabs_eth2_types.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3,casttype=github.com/prysmaticlabs/eth2-types.ValidatorIndex" json:"index,omitempty"`
+	PublicKey            []byte                                             `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	Status               v1alpha1.ValidatorStatus                           `protobuf:"varint,3,opt,name=status,proto3,enum=ethereum.eth.v1alpha1.ValidatorStatus" json:"status,omitempty"`
+	ActivationEpoch      github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,4,opt,name=activation_epoch,json=activationEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"activation_epoch,omitempty"`
+	ExitEpoch            github_com_prysmaticlabs_eth2_types.Epoch          `protobuf:"varint,5,opt,name=exit_epoch,json=exitEpoch,proto3,casttype=github.com/prysmaticlabs/eth2-types.Epoch" json:"exit_epoch,omitempty"`
+	EffectiveBalance     uint64                                             `protobuf:"varint,6,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+	Balance              uint64                                             `protobuf:"varint,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *RegistryValidatorStatus) Reset()         { *m = RegistryValidatorStatus{} }
+func (m *RegistryValidatorStatus) String() string { return proto.CompactTextString(m) }
+func (*RegistryValidatorStatus) ProtoMessage()    {}
+func (*RegistryValidatorStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0507088b2f63fdc, []int{5}
+}
+func (m *RegistryValidatorStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistryValidatorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistryValidatorStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistryValidatorStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistryValidatorStatus.Merge(m, src)
+}
+func (m *RegistryValidatorStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistryValidatorStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistryValidatorStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistryValidatorStatus proto.InternalMessageInfo
+
+func (m *RegistryValidatorStatus) GetIndex() github_com_prysmaticlabs_eth2_types.ValidatorIndex {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RegistryValidatorStatus) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *RegistryValidatorStatus) GetStatus() v1alpha1.ValidatorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return v1alpha1.ValidatorStatus_UNKNOWN_STATUS
+}
+
+func (m *RegistryValidatorStatus) GetActivationEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+func (m *RegistryValidatorStatus) GetExitEpoch() github_com_prysmaticlabs_eth2_types.Epoch {
+	if m != nil {
+		return m.ExitEpoch
+	}
+	return 0
+}
+
+func (m *RegistryValidatorStatus) GetEffectiveBalance() uint64 {
+	if m != nil {
+		return m.EffectiveBalance
+	}
+	return 0
+}
+
+func (m *RegistryValidatorStatus) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValidatorsByWithdrawalCredentialsRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorsByWithdrawalCredentialsRequest")
+	proto.RegisterType((*ValidatorsByWithdrawalCredentialsResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorsByWithdrawalCredentialsResponse")
+	proto.RegisterType((*RegistryValidator)(nil), "ethereum.beacon.rpc.v1.RegistryValidator")
+	proto.RegisterType((*ValidatorStatusesRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusesRequest")
+	proto.RegisterType((*ValidatorStatusesResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusesResponse")
+	proto.RegisterType((*RegistryValidatorStatus)(nil), "ethereum.beacon.rpc.v1.RegistryValidatorStatus")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/registry.proto", fileDescriptor_a0507088b2f63fdc)
+}
+
+var fileDescriptor_a0507088b2f63fdc = []byte{
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xce, 0xf6, 0x0f, 0xd0, 0x01, 0x7e, 0xc0, 0x24, 0xf0, 0x5b, 0x2b, 0xd2, 0xba, 0x89, 0xa4,
+	0xa8, 0xdd, 0xa5, 0xc5, 0xa8, 0xe1, 0x60, 0xb4, 0xc4, 0x44, 0x22, 0x89, 0x64, 0x31, 0xea, 0xad,
+	0x99, 0x6e, 0x5f, 0xba, 0x13, 0x96, 0x9d, 0x75, 0x67, 0x5a, 0x28, 0x47, 0xbf, 0x02, 0x1f, 0xc0,
+	0xbb, 0x9f, 0x04, 0x6f, 0x26, 0xde, 0x89, 0x21, 0xfa, 0x05, 0x3c, 0x19, 0x4e, 0x66, 0x67, 0xff,
+	0xb4, 0x60, 0x09, 0x15, 0x0e, 0xde, 0x76, 0xdf, 0x3f, 0xcf, 0x3c, 0xcf, 0x33, 0xef, 0xbe, 0x8b,
+	0x34, 0xcf, 0x67, 0x82, 0x19, 0x0d, 0x20, 0x16, 0x73, 0x0d, 0xdf, 0xb3, 0x8c, 0x4e, 0xc5, 0xf0,
+	0xa1, 0x45, 0xb9, 0xf0, 0xbb, 0xba, 0x4c, 0xe2, 0x39, 0x10, 0x36, 0xf8, 0xd0, 0xde, 0xd5, 0xc3,
+	0x32, 0xdd, 0xf7, 0x2c, 0xbd, 0x53, 0xc9, 0xcf, 0x83, 0xb0, 0x8d, 0x4e, 0x85, 0x38, 0x9e, 0x4d,
+	0x2a, 0x46, 0x87, 0x38, 0xb4, 0x49, 0x04, 0xf3, 0xc3, 0xae, 0xfc, 0x7c, 0x8b, 0xb1, 0x96, 0x03,
+	0x06, 0xf1, 0xa8, 0x41, 0x5c, 0x97, 0x09, 0x22, 0x28, 0x73, 0x79, 0x94, 0x2d, 0xb7, 0xa8, 0xb0,
+	0xdb, 0x0d, 0xdd, 0x62, 0xbb, 0x46, 0x8b, 0xb5, 0x98, 0x21, 0xc3, 0x8d, 0xf6, 0xb6, 0x7c, 0x0b,
+	0x49, 0x05, 0x4f, 0x61, 0xb9, 0x26, 0x50, 0xe9, 0x4d, 0x8c, 0xcf, 0x6b, 0xdd, 0xb7, 0x54, 0xd8,
+	0x4d, 0x9f, 0xec, 0x11, 0x67, 0xcd, 0x87, 0x26, 0xb8, 0x82, 0x12, 0x87, 0x9b, 0xf0, 0xbe, 0x0d,
+	0x5c, 0xe0, 0x17, 0x68, 0x6e, 0x2f, 0xc9, 0xd7, 0xad, 0x5e, 0x81, 0xaa, 0x14, 0x95, 0xd2, 0x44,
+	0x6d, 0xe6, 0xe7, 0x71, 0x61, 0x92, 0xf3, 0x83, 0x32, 0xa7, 0x07, 0xb0, 0xaa, 0xad, 0x54, 0x35,
+	0x73, 0x76, 0x6f, 0x10, 0xa0, 0xd6, 0x41, 0x4b, 0x43, 0x9c, 0xca, 0x3d, 0xe6, 0x72, 0xc0, 0xeb,
+	0x08, 0x25, 0x16, 0x04, 0x47, 0xa5, 0x4b, 0xe3, 0xd5, 0x25, 0x7d, 0xb0, 0x75, 0xba, 0x19, 0x39,
+	0x9c, 0xc0, 0x9b, 0x7d, 0xcd, 0xda, 0xa1, 0x82, 0x66, 0xfe, 0xa8, 0xc0, 0x1b, 0x28, 0x4b, 0xdd,
+	0x26, 0xec, 0x4b, 0x19, 0x99, 0xda, 0xc3, 0xd3, 0xe3, 0x42, 0xb5, 0xcf, 0x45, 0xcf, 0xef, 0xf2,
+	0x5d, 0x22, 0xa8, 0xe5, 0x90, 0x06, 0x37, 0x40, 0xd8, 0xd5, 0xb2, 0xe8, 0x7a, 0xc0, 0xf5, 0x04,
+	0x61, 0x3d, 0xe8, 0x36, 0x43, 0x10, 0xbc, 0x8c, 0x90, 0xd7, 0x6e, 0x38, 0xd4, 0xaa, 0xef, 0x40,
+	0x57, 0x4d, 0x0d, 0x72, 0xe6, 0xc1, 0x63, 0xcd, 0xcc, 0x85, 0x45, 0x2f, 0xa1, 0xab, 0x7d, 0x56,
+	0x90, 0x9a, 0x60, 0x6d, 0x09, 0x22, 0xda, 0x1c, 0x12, 0xd3, 0x0b, 0x68, 0xbc, 0x07, 0x17, 0xca,
+	0x9f, 0x30, 0x51, 0xd2, 0xcc, 0xf1, 0x26, 0x1a, 0xa5, 0x6e, 0x93, 0x5a, 0xc0, 0xd5, 0x54, 0x31,
+	0x7d, 0x0d, 0xfe, 0x31, 0x0c, 0xbe, 0x89, 0x72, 0x1e, 0x69, 0x41, 0x3d, 0x20, 0xab, 0xa6, 0x8b,
+	0x4a, 0x29, 0x6b, 0x8e, 0x05, 0x81, 0x2d, 0x7a, 0x00, 0xf8, 0x16, 0x42, 0x32, 0x29, 0xd8, 0x0e,
+	0xb8, 0x6a, 0xa6, 0xa8, 0x94, 0x72, 0xa6, 0x2c, 0x7f, 0x1d, 0x04, 0xb4, 0x5f, 0x0a, 0xba, 0x31,
+	0x40, 0x4b, 0x74, 0x95, 0x6b, 0x28, 0x0b, 0x1e, 0xb3, 0xec, 0xc8, 0xe9, 0xf2, 0xe9, 0x71, 0x61,
+	0x69, 0x18, 0xa6, 0xcf, 0x83, 0x26, 0x33, 0xec, 0xc5, 0xaf, 0xce, 0xcc, 0x43, 0x4a, 0xce, 0x83,
+	0x31, 0xf4, 0x3c, 0x84, 0x9c, 0xfa, 0xa7, 0x02, 0x2f, 0xa2, 0x29, 0x17, 0xf6, 0x45, 0xbd, 0x4f,
+	0x57, 0x5a, 0xea, 0x9a, 0x0c, 0xc2, 0x9b, 0xb1, 0xb6, 0x40, 0xba, 0x60, 0x82, 0x38, 0xa1, 0x31,
+	0x19, 0x69, 0x4c, 0x4e, 0x46, 0x02, 0x67, 0xb4, 0xa3, 0x34, 0xfa, 0xff, 0x82, 0xe3, 0xfe, 0xf5,
+	0x88, 0xe1, 0x27, 0x68, 0x84, 0x4b, 0x26, 0x52, 0xd9, 0x7f, 0xd5, 0xc5, 0x9e, 0x5f, 0x20, 0x6c,
+	0x3d, 0xde, 0x35, 0xfa, 0x79, 0x9b, 0xa2, 0x2e, 0xfc, 0x0e, 0x4d, 0x13, 0x4b, 0xd0, 0x8e, 0x5c,
+	0x35, 0xf5, 0xf0, 0x0e, 0x33, 0x57, 0xb9, 0xc3, 0xa9, 0x1e, 0x8c, 0x0c, 0xe0, 0x0d, 0x84, 0x60,
+	0x9f, 0x8a, 0x08, 0x33, 0x7b, 0x15, 0xcc, 0x5c, 0x00, 0x10, 0xa2, 0xdd, 0x43, 0x33, 0xb0, 0xbd,
+	0x0d, 0xc1, 0x19, 0x50, 0x6f, 0x10, 0x87, 0xb8, 0x16, 0xa8, 0x23, 0x01, 0xa8, 0x39, 0x9d, 0x24,
+	0x6a, 0x61, 0x1c, 0xab, 0x68, 0x34, 0x2e, 0x19, 0x95, 0x25, 0xf1, 0x6b, 0xf5, 0x63, 0x1a, 0x8d,
+	0xc5, 0x57, 0x89, 0x7f, 0x28, 0xe8, 0xce, 0x06, 0xe5, 0xe2, 0xd2, 0x8d, 0x85, 0x9f, 0x5e, 0x34,
+	0x85, 0xc3, 0xae, 0xd8, 0xfc, 0xb3, 0x6b, 0x20, 0x84, 0xdf, 0x98, 0xf6, 0xe8, 0xc3, 0xd7, 0xef,
+	0x87, 0xa9, 0x0a, 0x36, 0x8c, 0xc1, 0x7f, 0x11, 0x6e, 0x0c, 0x5e, 0xe2, 0xf8, 0x93, 0x82, 0x66,
+	0xcf, 0xe8, 0x8c, 0x3f, 0x5f, 0xbc, 0x7c, 0x29, 0xab, 0x73, 0x5b, 0x2b, 0x5f, 0xf9, 0x8b, 0x8e,
+	0x88, 0xf7, 0x7d, 0xc9, 0x7b, 0x51, 0xbb, 0x7d, 0x21, 0x6f, 0x1e, 0xb5, 0xac, 0x2a, 0x77, 0x6b,
+	0x13, 0x47, 0x27, 0x0b, 0xca, 0x97, 0x93, 0x05, 0xe5, 0xdb, 0xc9, 0x82, 0xd2, 0x18, 0x91, 0x3f,
+	0xb3, 0x95, 0xdf, 0x03, 0x00, 0x72, 0x0b, 0xbd, 0x67, 0x75, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RegistryClient is the client API for Registry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RegistryClient interface {
+	ListValidatorsByWithdrawalCredentials(ctx context.Context, in *ValidatorsByWithdrawalCredentialsRequest, opts ...grpc.CallOption) (*ValidatorsByWithdrawalCredentialsResponse, error)
+	ListValidatorStatuses(ctx context.Context, in *ValidatorStatusesRequest, opts ...grpc.CallOption) (*ValidatorStatusesResponse, error)
+}
+
+type registryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRegistryClient(cc *grpc.ClientConn) RegistryClient {
+	return &registryClient{cc}
+}
+
+func (c *registryClient) ListValidatorsByWithdrawalCredentials(ctx context.Context, in *ValidatorsByWithdrawalCredentialsRequest, opts ...grpc.CallOption) (*ValidatorsByWithdrawalCredentialsResponse, error) {
+	out := new(ValidatorsByWithdrawalCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Registry/ListValidatorsByWithdrawalCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) ListValidatorStatuses(ctx context.Context, in *ValidatorStatusesRequest, opts ...grpc.CallOption) (*ValidatorStatusesResponse, error) {
+	out := new(ValidatorStatusesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Registry/ListValidatorStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegistryServer is the server API for Registry service.
+type RegistryServer interface {
+	ListValidatorsByWithdrawalCredentials(context.Context, *ValidatorsByWithdrawalCredentialsRequest) (*ValidatorsByWithdrawalCredentialsResponse, error)
+	ListValidatorStatuses(context.Context, *ValidatorStatusesRequest) (*ValidatorStatusesResponse, error)
+}
+
+// UnimplementedRegistryServer can be embedded to have forward compatible implementations.
+type UnimplementedRegistryServer struct {
+}
+
+func (*UnimplementedRegistryServer) ListValidatorsByWithdrawalCredentials(ctx context.Context, req *ValidatorsByWithdrawalCredentialsRequest) (*ValidatorsByWithdrawalCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorsByWithdrawalCredentials not implemented")
+}
+func (*UnimplementedRegistryServer) ListValidatorStatuses(ctx context.Context, req *ValidatorStatusesRequest) (*ValidatorStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorStatuses not implemented")
+}
+
+func RegisterRegistryServer(s *grpc.Server, srv RegistryServer) {
+	s.RegisterService(&_Registry_serviceDesc, srv)
+}
+
+func _Registry_ListValidatorsByWithdrawalCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorsByWithdrawalCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).ListValidatorsByWithdrawalCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Registry/ListValidatorsByWithdrawalCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).ListValidatorsByWithdrawalCredentials(ctx, req.(*ValidatorsByWithdrawalCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_ListValidatorStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).ListValidatorStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Registry/ListValidatorStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).ListValidatorStatuses(ctx, req.(*ValidatorStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Registry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Registry",
+	HandlerType: (*RegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListValidatorsByWithdrawalCredentials",
+			Handler:    _Registry_ListValidatorsByWithdrawalCredentials_Handler,
+		},
+		{
+			MethodName: "ListValidatorStatuses",
+			Handler:    _Registry_ListValidatorStatuses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/registry.proto",
+}
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorsByWithdrawalCredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.WithdrawalCredentials) > 0 {
+		i -= len(m.WithdrawalCredentials)
+		copy(dAtA[i:], m.WithdrawalCredentials)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.WithdrawalCredentials)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorsByWithdrawalCredentialsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorsByWithdrawalCredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorsByWithdrawalCredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRegistry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistryValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistryValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistryValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Indices) > 0 {
+		dAtA2 := make([]byte, len(m.Indices)*10)
+		var j1 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintRegistry(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintRegistry(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRegistry(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistryValidatorStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistryValidatorStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistryValidatorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Balance != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EffectiveBalance != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.EffectiveBalance))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExitEpoch != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.ExitEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActivationEpoch != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.ActivationEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRegistry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRegistry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawalCredentials)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorsByWithdrawalCredentialsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovRegistry(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegistryValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovRegistry(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovRegistry(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovRegistry(uint64(e))
+		}
+		n += 1 + sovRegistry(uint64(l)) + l
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRegistry(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovRegistry(uint64(m.Epoch))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovRegistry(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovRegistry(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegistryValidatorStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovRegistry(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRegistry(uint64(m.Status))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovRegistry(uint64(m.ActivationEpoch))
+	}
+	if m.ExitEpoch != 0 {
+		n += 1 + sovRegistry(uint64(m.ExitEpoch))
+	}
+	if m.EffectiveBalance != 0 {
+		n += 1 + sovRegistry(uint64(m.EffectiveBalance))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovRegistry(uint64(m.Balance))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRegistry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRegistry(x uint64) (n int) {
+	return sovRegistry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorsByWithdrawalCredentialsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorsByWithdrawalCredentialsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorsByWithdrawalCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalCredentials", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalCredentials = append(m.WithdrawalCredentials[:0], dAtA[iNdEx:postIndex]...)
+			if m.WithdrawalCredentials == nil {
+				m.WithdrawalCredentials = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorsByWithdrawalCredentialsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorsByWithdrawalCredentialsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorsByWithdrawalCredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &RegistryValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistryValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistryValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistryValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorStatusesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorStatusesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRegistry
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRegistry
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRegistry
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRegistry
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]github_com_prysmaticlabs_eth2_types.ValidatorIndex, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_prysmaticlabs_eth2_types.ValidatorIndex
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRegistry
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorStatusesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorStatusesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &RegistryValidatorStatus{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistryValidatorStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistryValidatorStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistryValidatorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= github_com_prysmaticlabs_eth2_types.ValidatorIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= v1alpha1.ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			}
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitEpoch", wireType)
+			}
+			m.ExitEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitEpoch |= github_com_prysmaticlabs_eth2_types.Epoch(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveBalance", wireType)
+			}
+			m.EffectiveBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRegistry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRegistry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRegistry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRegistry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRegistry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRegistry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRegistry = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,986 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proto/beacon/rpc/v1/submission.proto
+
+package ethereum_beacon_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	v1alpha1 "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type BlockSubmissionResult_Status int32
+
+const (
+	BlockSubmissionResult_IMPORTED        BlockSubmissionResult_Status = 0
+	BlockSubmissionResult_ALREADY_KNOWN   BlockSubmissionResult_Status = 1
+	BlockSubmissionResult_UNKNOWN_PARENT  BlockSubmissionResult_Status = 2
+	BlockSubmissionResult_DOUBLE_PROPOSAL BlockSubmissionResult_Status = 3
+	BlockSubmissionResult_REJECTED        BlockSubmissionResult_Status = 4
+)
+
+var BlockSubmissionResult_Status_name = map[int32]string{
+	0: "IMPORTED",
+	1: "ALREADY_KNOWN",
+	2: "UNKNOWN_PARENT",
+	3: "DOUBLE_PROPOSAL",
+	4: "REJECTED",
+}
+
+var BlockSubmissionResult_Status_value = map[string]int32{
+	"IMPORTED":        0,
+	"ALREADY_KNOWN":   1,
+	"UNKNOWN_PARENT":  2,
+	"DOUBLE_PROPOSAL": 3,
+	"REJECTED":        4,
+}
+
+func (x BlockSubmissionResult_Status) String() string {
+	return proto.EnumName(BlockSubmissionResult_Status_name, int32(x))
+}
+
+func (BlockSubmissionResult_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b48877c24b279b72, []int{2, 0}
+}
+
+type SubmitBlocksRequest struct {
+	Blocks               []*v1alpha1.SignedBeaconBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *SubmitBlocksRequest) Reset()         { *m = SubmitBlocksRequest{} }
+func (m *SubmitBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitBlocksRequest) ProtoMessage()    {}
+func (*SubmitBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48877c24b279b72, []int{0}
+}
+func (m *SubmitBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmitBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitBlocksRequest.Merge(m, src)
+}
+func (m *SubmitBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitBlocksRequest proto.InternalMessageInfo
+
+func (m *SubmitBlocksRequest) GetBlocks() []*v1alpha1.SignedBeaconBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type SubmitBlocksResponse struct {
+	Results              []*BlockSubmissionResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SubmitBlocksResponse) Reset()         { *m = SubmitBlocksResponse{} }
+func (m *SubmitBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitBlocksResponse) ProtoMessage()    {}
+func (*SubmitBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48877c24b279b72, []int{1}
+}
+func (m *SubmitBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmitBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitBlocksResponse.Merge(m, src)
+}
+func (m *SubmitBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitBlocksResponse proto.InternalMessageInfo
+
+func (m *SubmitBlocksResponse) GetResults() []*BlockSubmissionResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type BlockSubmissionResult struct {
+	BlockRoot            []byte                       `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	Status               BlockSubmissionResult_Status `protobuf:"varint,2,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.BlockSubmissionResult_Status" json:"status,omitempty"`
+	Broadcast            bool                         `protobuf:"varint,3,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	Error                string                       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *BlockSubmissionResult) Reset()         { *m = BlockSubmissionResult{} }
+func (m *BlockSubmissionResult) String() string { return proto.CompactTextString(m) }
+func (*BlockSubmissionResult) ProtoMessage()    {}
+func (*BlockSubmissionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b48877c24b279b72, []int{2}
+}
+func (m *BlockSubmissionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockSubmissionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockSubmissionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockSubmissionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSubmissionResult.Merge(m, src)
+}
+func (m *BlockSubmissionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockSubmissionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSubmissionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSubmissionResult proto.InternalMessageInfo
+
+func (m *BlockSubmissionResult) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *BlockSubmissionResult) GetStatus() BlockSubmissionResult_Status {
+	if m != nil {
+		return m.Status
+	}
+	return BlockSubmissionResult_IMPORTED
+}
+
+func (m *BlockSubmissionResult) GetBroadcast() bool {
+	if m != nil {
+		return m.Broadcast
+	}
+	return false
+}
+
+func (m *BlockSubmissionResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.BlockSubmissionResult_Status", BlockSubmissionResult_Status_name, BlockSubmissionResult_Status_value)
+	proto.RegisterType((*SubmitBlocksRequest)(nil), "ethereum.beacon.rpc.v1.SubmitBlocksRequest")
+	proto.RegisterType((*SubmitBlocksResponse)(nil), "ethereum.beacon.rpc.v1.SubmitBlocksResponse")
+	proto.RegisterType((*BlockSubmissionResult)(nil), "ethereum.beacon.rpc.v1.BlockSubmissionResult")
+}
+
+func init() {
+	proto.RegisterFile("proto/beacon/rpc/v1/submission.proto", fileDescriptor_b48877c24b279b72)
+}
+
+var fileDescriptor_b48877c24b279b72 = []byte{
+	// 518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x1c, 0xc6, 0x7f, 0x6e, 0xf7, 0x2b, 0xab, 0xe9, 0xb6, 0xce, 0x1b, 0xa8, 0xaa, 0xa6, 0xae, 0x0a,
+	0x1c, 0xc2, 0x9f, 0xda, 0xb4, 0xe3, 0xb4, 0x13, 0x2d, 0x8d, 0x10, 0x50, 0xda, 0xca, 0xdd, 0x34,
+	0x71, 0x0a, 0x4e, 0x6a, 0xd2, 0x88, 0x36, 0x0e, 0xb6, 0xd3, 0xc3, 0x8e, 0xbc, 0x05, 0x78, 0x11,
+	0x5c, 0x78, 0x1f, 0x1c, 0x91, 0xb8, 0x23, 0x54, 0xf1, 0x0a, 0x78, 0x05, 0x28, 0x4e, 0x3b, 0x18,
+	0x2a, 0x12, 0xdc, 0xf2, 0xb5, 0x9f, 0xe7, 0xf1, 0x27, 0xf6, 0x03, 0x6f, 0xc6, 0x52, 0x68, 0x41,
+	0x3c, 0xce, 0x7c, 0x11, 0x11, 0x19, 0xfb, 0x64, 0xde, 0x24, 0x2a, 0xf1, 0x66, 0xa1, 0x52, 0xa1,
+	0x88, 0xb0, 0xd9, 0x46, 0xd7, 0xb9, 0x9e, 0x70, 0xc9, 0x93, 0x19, 0xce, 0x84, 0x58, 0xc6, 0x3e,
+	0x9e, 0x37, 0xab, 0x87, 0x5c, 0x4f, 0xc8, 0xbc, 0xc9, 0xa6, 0xf1, 0x84, 0x35, 0x97, 0x21, 0xae,
+	0x37, 0x15, 0xfe, 0xab, 0xcc, 0x58, 0x3d, 0x08, 0x84, 0x08, 0xa6, 0x9c, 0xb0, 0x38, 0x24, 0x2c,
+	0x8a, 0x84, 0x66, 0x3a, 0x14, 0x91, 0x5a, 0xee, 0x36, 0x82, 0x50, 0x4f, 0x12, 0x0f, 0xfb, 0x62,
+	0x46, 0x02, 0x11, 0x08, 0x62, 0x96, 0xbd, 0xe4, 0xa5, 0x99, 0x32, 0xb2, 0xf4, 0x2b, 0x93, 0x5b,
+	0x67, 0x70, 0x6f, 0x94, 0x92, 0xe9, 0x4e, 0x7a, 0x82, 0xa2, 0xfc, 0x75, 0xc2, 0x95, 0x46, 0x0f,
+	0x60, 0xc1, 0x1c, 0xa9, 0x2a, 0xa0, 0x9e, 0xb7, 0xaf, 0xb6, 0x6c, 0x7c, 0x41, 0xcb, 0xf5, 0x04,
+	0xaf, 0xf0, 0xf0, 0x28, 0x0c, 0x22, 0x3e, 0xee, 0x18, 0x48, 0x93, 0x40, 0x97, 0x3e, 0xcb, 0x85,
+	0xfb, 0x97, 0x83, 0x55, 0x2c, 0x22, 0xc5, 0xd1, 0x23, 0x78, 0x45, 0x72, 0x95, 0x4c, 0xf5, 0x2a,
+	0xba, 0x81, 0xd7, 0x5f, 0x04, 0x36, 0xc6, 0xd1, 0xc5, 0xb5, 0x51, 0xe3, 0xa2, 0x2b, 0xb7, 0xf5,
+	0x21, 0x07, 0xaf, 0xad, 0x95, 0xa0, 0x7b, 0x10, 0x1a, 0x08, 0x57, 0x0a, 0xa1, 0x2b, 0xa0, 0x0e,
+	0xec, 0x52, 0x67, 0xf7, 0xfb, 0x97, 0xc3, 0x2d, 0xa5, 0xce, 0x1b, 0x2a, 0x3c, 0xe7, 0xc7, 0xd6,
+	0x51, 0xcb, 0xa2, 0x45, 0x23, 0xa2, 0x42, 0x68, 0xd4, 0x83, 0x05, 0xa5, 0x99, 0x4e, 0x54, 0x25,
+	0x57, 0x07, 0xf6, 0x76, 0xeb, 0xfe, 0x3f, 0x31, 0xe1, 0x91, 0xf1, 0xd2, 0x65, 0x06, 0x3a, 0x80,
+	0x45, 0x4f, 0x0a, 0x36, 0xf6, 0x99, 0xd2, 0x95, 0x7c, 0x1d, 0xd8, 0x9b, 0xf4, 0xe7, 0x02, 0xda,
+	0x87, 0xff, 0x73, 0x29, 0x85, 0xac, 0x6c, 0xd4, 0x81, 0x5d, 0xa4, 0xd9, 0x60, 0xbd, 0x80, 0x85,
+	0x2c, 0x05, 0x95, 0xe0, 0xe6, 0xe3, 0x67, 0xc3, 0x01, 0x3d, 0x71, 0xba, 0xe5, 0xff, 0xd0, 0x2e,
+	0xdc, 0x6a, 0xf7, 0xa8, 0xd3, 0xee, 0x3e, 0x77, 0x9f, 0xf6, 0x07, 0x67, 0xfd, 0x32, 0x40, 0x08,
+	0x6e, 0x9f, 0xf6, 0xcd, 0xe0, 0x0e, 0xdb, 0xd4, 0xe9, 0x9f, 0x94, 0x73, 0x68, 0x0f, 0xee, 0x74,
+	0x07, 0xa7, 0x9d, 0x9e, 0xe3, 0x0e, 0xe9, 0x60, 0x38, 0x18, 0xb5, 0x7b, 0xe5, 0x7c, 0x9a, 0x44,
+	0x9d, 0x27, 0xce, 0xc3, 0x34, 0x69, 0xa3, 0xf5, 0x1e, 0xc0, 0x9d, 0xdf, 0xf0, 0xd1, 0x3b, 0x00,
+	0x4b, 0xbf, 0xbe, 0x12, 0xba, 0xf3, 0xa7, 0x1f, 0x5f, 0x53, 0x92, 0xea, 0xdd, 0xbf, 0x13, 0x67,
+	0x0f, 0x6f, 0xdd, 0x7a, 0xf3, 0xf9, 0xdb, 0xdb, 0xdc, 0x0d, 0xab, 0x46, 0x2e, 0x15, 0x7c, 0xce,
+	0xa6, 0xe1, 0x98, 0x69, 0x21, 0x49, 0x56, 0x9c, 0x63, 0x70, 0xbb, 0x53, 0xfa, 0xb8, 0xa8, 0x81,
+	0x4f, 0x8b, 0x1a, 0xf8, 0xba, 0xa8, 0x01, 0xaf, 0x60, 0x9a, 0x7a, 0xf4, 0x63, 0x00, 0xab, 0x64,
+	0x69, 0x4f, 0x57, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlockSubmissionClient is the client API for BlockSubmission service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlockSubmissionClient interface {
+	SubmitBlocks(ctx context.Context, in *SubmitBlocksRequest, opts ...grpc.CallOption) (*SubmitBlocksResponse, error)
+}
+
+type blockSubmissionClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBlockSubmissionClient(cc *grpc.ClientConn) BlockSubmissionClient {
+	return &blockSubmissionClient{cc}
+}
+
+func (c *blockSubmissionClient) SubmitBlocks(ctx context.Context, in *SubmitBlocksRequest, opts ...grpc.CallOption) (*SubmitBlocksResponse, error) {
+	out := new(SubmitBlocksResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BlockSubmission/SubmitBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlockSubmissionServer is the server API for BlockSubmission service.
+type BlockSubmissionServer interface {
+	SubmitBlocks(context.Context, *SubmitBlocksRequest) (*SubmitBlocksResponse, error)
+}
+
+// UnimplementedBlockSubmissionServer can be embedded to have forward compatible implementations.
+type UnimplementedBlockSubmissionServer struct {
+}
+
+func (*UnimplementedBlockSubmissionServer) SubmitBlocks(ctx context.Context, req *SubmitBlocksRequest) (*SubmitBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBlocks not implemented")
+}
+
+func RegisterBlockSubmissionServer(s *grpc.Server, srv BlockSubmissionServer) {
+	s.RegisterService(&_BlockSubmission_serviceDesc, srv)
+}
+
+func _BlockSubmission_SubmitBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockSubmissionServer).SubmitBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BlockSubmission/SubmitBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockSubmissionServer).SubmitBlocks(ctx, req.(*SubmitBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BlockSubmission_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BlockSubmission",
+	HandlerType: (*BlockSubmissionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitBlocks",
+			Handler:    _BlockSubmission_SubmitBlocks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/submission.proto",
+}
+
+func (m *SubmitBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmission(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSubmission(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockSubmissionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockSubmissionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockSubmissionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSubmission(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Broadcast {
+		i--
+		if m.Broadcast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintSubmission(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintSubmission(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubmission(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubmission(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubmitBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovSubmission(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubmitBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovSubmission(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockSubmissionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovSubmission(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSubmission(uint64(m.Status))
+	}
+	if m.Broadcast {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSubmission(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSubmission(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubmission(x uint64) (n int) {
+	return sovSubmission(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubmitBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmission
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &v1alpha1.SignedBeaconBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmission(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmission
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &BlockSubmissionResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmission(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockSubmissionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubmission
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockSubmissionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockSubmissionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BlockSubmissionResult_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broadcast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broadcast = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubmission(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubmission
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSubmission(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSubmission
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubmission
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSubmission
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSubmission
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSubmission
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSubmission        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubmission          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSubmission = fmt.Errorf("proto: unexpected end of group")
+)
@@ -59,6 +59,65 @@ func (KeymanagerKind) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8a5153635bfe042e, []int{0}
 }
 
+type AddedKeystoreStatus_Status int32
+
+const (
+	AddedKeystoreStatus_IMPORTED  AddedKeystoreStatus_Status = 0
+	AddedKeystoreStatus_DUPLICATE AddedKeystoreStatus_Status = 1
+	AddedKeystoreStatus_ERROR     AddedKeystoreStatus_Status = 2
+)
+
+var AddedKeystoreStatus_Status_name = map[int32]string{
+	0: "IMPORTED",
+	1: "DUPLICATE",
+	2: "ERROR",
+}
+
+var AddedKeystoreStatus_Status_value = map[string]int32{
+	"IMPORTED":  0,
+	"DUPLICATE": 1,
+	"ERROR":     2,
+}
+
+func (x AddedKeystoreStatus_Status) String() string {
+	return proto.EnumName(AddedKeystoreStatus_Status_name, int32(x))
+}
+
+func (AddedKeystoreStatus_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{26, 0}
+}
+
+type DeletedKeystoreStatus_Status int32
+
+const (
+	DeletedKeystoreStatus_DELETED    DeletedKeystoreStatus_Status = 0
+	DeletedKeystoreStatus_NOT_ACTIVE DeletedKeystoreStatus_Status = 1
+	DeletedKeystoreStatus_NOT_FOUND  DeletedKeystoreStatus_Status = 2
+	DeletedKeystoreStatus_ERROR      DeletedKeystoreStatus_Status = 3
+)
+
+var DeletedKeystoreStatus_Status_name = map[int32]string{
+	0: "DELETED",
+	1: "NOT_ACTIVE",
+	2: "NOT_FOUND",
+	3: "ERROR",
+}
+
+var DeletedKeystoreStatus_Status_value = map[string]int32{
+	"DELETED":    0,
+	"NOT_ACTIVE": 1,
+	"NOT_FOUND":  2,
+	"ERROR":      3,
+}
+
+func (x DeletedKeystoreStatus_Status) String() string {
+	return proto.EnumName(DeletedKeystoreStatus_Status_name, int32(x))
+}
+
+func (DeletedKeystoreStatus_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{29, 0}
+}
+
 type CreateWalletRequest struct {
 	Keymanager           KeymanagerKind `protobuf:"varint,1,opt,name=keymanager,proto3,enum=ethereum.validator.accounts.v2.KeymanagerKind" json:"keymanager,omitempty"`
 	WalletPassword       string         `protobuf:"bytes,2,opt,name=wallet_password,json=walletPassword,proto3" json:"wallet_password,omitempty"`
@@ -1444,1989 +1503,1855 @@ func (m *BackupAccountsResponse) GetZipFile() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.validator.accounts.v2.KeymanagerKind", KeymanagerKind_name, KeymanagerKind_value)
-	proto.RegisterType((*CreateWalletRequest)(nil), "ethereum.validator.accounts.v2.CreateWalletRequest")
-	proto.RegisterType((*CreateWalletResponse)(nil), "ethereum.validator.accounts.v2.CreateWalletResponse")
-	proto.RegisterType((*EditWalletConfigRequest)(nil), "ethereum.validator.accounts.v2.EditWalletConfigRequest")
-	proto.RegisterType((*GenerateMnemonicResponse)(nil), "ethereum.validator.accounts.v2.GenerateMnemonicResponse")
-	proto.RegisterType((*WalletResponse)(nil), "ethereum.validator.accounts.v2.WalletResponse")
-	proto.RegisterType((*ListAccountsRequest)(nil), "ethereum.validator.accounts.v2.ListAccountsRequest")
-	proto.RegisterType((*ListAccountsResponse)(nil), "ethereum.validator.accounts.v2.ListAccountsResponse")
-	proto.RegisterType((*Account)(nil), "ethereum.validator.accounts.v2.Account")
-	proto.RegisterType((*AccountRequest)(nil), "ethereum.validator.accounts.v2.AccountRequest")
-	proto.RegisterType((*AuthRequest)(nil), "ethereum.validator.accounts.v2.AuthRequest")
-	proto.RegisterType((*AuthResponse)(nil), "ethereum.validator.accounts.v2.AuthResponse")
-	proto.RegisterType((*NodeConnectionResponse)(nil), "ethereum.validator.accounts.v2.NodeConnectionResponse")
-	proto.RegisterType((*LogsEndpointResponse)(nil), "ethereum.validator.accounts.v2.LogsEndpointResponse")
-	proto.RegisterType((*VersionResponse)(nil), "ethereum.validator.accounts.v2.VersionResponse")
-	proto.RegisterType((*ChangePasswordRequest)(nil), "ethereum.validator.accounts.v2.ChangePasswordRequest")
-	proto.RegisterType((*HasWalletResponse)(nil), "ethereum.validator.accounts.v2.HasWalletResponse")
-	proto.RegisterType((*ImportKeystoresRequest)(nil), "ethereum.validator.accounts.v2.ImportKeystoresRequest")
-	proto.RegisterType((*ImportKeystoresResponse)(nil), "ethereum.validator.accounts.v2.ImportKeystoresResponse")
-	proto.RegisterType((*HasUsedWebResponse)(nil), "ethereum.validator.accounts.v2.HasUsedWebResponse")
-	proto.RegisterType((*LogsResponse)(nil), "ethereum.validator.accounts.v2.LogsResponse")
-	proto.RegisterType((*BeaconStatusResponse)(nil), "ethereum.validator.accounts.v2.BeaconStatusResponse")
-	proto.RegisterType((*BackupAccountsRequest)(nil), "ethereum.validator.accounts.v2.BackupAccountsRequest")
-	proto.RegisterType((*BackupAccountsResponse)(nil), "ethereum.validator.accounts.v2.BackupAccountsResponse")
+type ListKeystoresResponse struct {
+	Data                 []*ListKeystoresResponse_Keystore `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("proto/validator/accounts/v2/web_api.proto", fileDescriptor_8a5153635bfe042e)
+func (m *ListKeystoresResponse) Reset()         { *m = ListKeystoresResponse{} }
+func (m *ListKeystoresResponse) String() string { return proto.CompactTextString(m) }
+func (*ListKeystoresResponse) ProtoMessage()    {}
+func (*ListKeystoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{23}
 }
-
-var fileDescriptor_8a5153635bfe042e = []byte{
-	// 2114 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1c, 0x49,
-	0xf5, 0xff, 0xb6, 0xed, 0x4c, 0xc6, 0x6f, 0x26, 0xb6, 0x53, 0x76, 0x9c, 0xc9, 0xc4, 0xb1, 0x9d,
-	0xca, 0x0f, 0x3b, 0x4e, 0xbe, 0x33, 0xc9, 0x84, 0x4d, 0xa2, 0x5c, 0x50, 0x3c, 0x99, 0x4d, 0x22,
-	0xe7, 0x87, 0xe9, 0x64, 0x13, 0x71, 0xd9, 0x56, 0xb9, 0xbb, 0x32, 0x53, 0xf2, 0x4c, 0x77, 0x6f,
-	0x77, 0xcd, 0x24, 0x0e, 0x17, 0x58, 0x56, 0x42, 0x20, 0x71, 0x80, 0x3d, 0x20, 0x24, 0x84, 0x04,
-	0x37, 0x24, 0x2e, 0x48, 0x48, 0xfb, 0x2f, 0xc0, 0x0d, 0x89, 0x2b, 0x12, 0x28, 0xe2, 0x02, 0xfc,
-	0x13, 0xa8, 0xaa, 0xab, 0xfa, 0xc7, 0x64, 0x7a, 0xdb, 0x16, 0xe2, 0xc2, 0xad, 0xeb, 0xfd, 0xfc,
-	0xd4, 0xab, 0x57, 0xaf, 0xde, 0x6b, 0xb8, 0xe2, 0x07, 0x1e, 0xf7, 0x9a, 0x23, 0xd2, 0x67, 0x0e,
-	0xe1, 0x5e, 0xd0, 0x24, 0xb6, 0xed, 0x0d, 0x5d, 0x1e, 0x36, 0x47, 0xad, 0xe6, 0x1b, 0xba, 0x67,
-	0x11, 0x9f, 0x35, 0xa4, 0x0c, 0x5a, 0xa5, 0xbc, 0x47, 0x03, 0x3a, 0x1c, 0x34, 0x62, 0xe9, 0x86,
-	0x96, 0x6e, 0x8c, 0x5a, 0xf5, 0xf5, 0xc8, 0xd4, 0x1e, 0x25, 0xb6, 0xe7, 0x36, 0x03, 0xdf, 0x6e,
-	0x8e, 0x6e, 0x34, 0x7b, 0x94, 0xf4, 0x79, 0x2f, 0xb2, 0x50, 0x5f, 0xa3, 0xbc, 0xd7, 0x1c, 0xdd,
-	0x20, 0x7d, 0xbf, 0x47, 0x6e, 0x28, 0x41, 0xcb, 0xee, 0x11, 0xe6, 0x2a, 0x81, 0xd3, 0x19, 0x01,
-	0xd7, 0x73, 0xa8, 0x62, 0xac, 0x74, 0x3d, 0xaf, 0xdb, 0xa7, 0x4d, 0xe2, 0xb3, 0x26, 0x71, 0x5d,
-	0x8f, 0x13, 0xce, 0x3c, 0x37, 0x54, 0xdc, 0xb3, 0x8a, 0x2b, 0x57, 0x7b, 0xc3, 0xd7, 0x4d, 0x3a,
-	0xf0, 0xf9, 0x41, 0xc4, 0xc4, 0xff, 0x9a, 0x82, 0xc5, 0x76, 0x40, 0x09, 0xa7, 0xaf, 0x48, 0xbf,
-	0x4f, 0xb9, 0x49, 0x3f, 0x1b, 0xd2, 0x90, 0xa3, 0xa7, 0x00, 0xfb, 0xf4, 0x60, 0x40, 0x5c, 0xd2,
-	0xa5, 0x41, 0xcd, 0x58, 0x37, 0x36, 0xe7, 0x5a, 0x8d, 0xc6, 0xd7, 0xef, 0xb1, 0xb1, 0x13, 0x6b,
-	0xec, 0x30, 0xd7, 0x31, 0x53, 0x16, 0xd0, 0x06, 0xcc, 0xbf, 0x91, 0x0e, 0x2c, 0x9f, 0x84, 0xe1,
-	0x1b, 0x2f, 0x70, 0x6a, 0x53, 0xeb, 0xc6, 0xe6, 0xac, 0x39, 0x17, 0x91, 0x77, 0x15, 0x15, 0xd5,
-	0xa1, 0x3c, 0x70, 0xe9, 0xc0, 0x73, 0x99, 0x5d, 0x9b, 0x96, 0x12, 0xf1, 0x1a, 0x9d, 0x87, 0xaa,
-	0x3b, 0x1c, 0x58, 0xda, 0x65, 0x6d, 0x66, 0xdd, 0xd8, 0x9c, 0x31, 0x2b, 0xee, 0x70, 0x70, 0x4f,
-	0x91, 0xd0, 0x1a, 0x54, 0x02, 0x3a, 0xf0, 0x38, 0xb5, 0x88, 0xe3, 0x04, 0xb5, 0x63, 0xd2, 0x02,
-	0x44, 0xa4, 0x7b, 0x8e, 0x13, 0xa0, 0xcb, 0x30, 0xaf, 0x04, 0xec, 0x40, 0x80, 0xe1, 0xbd, 0x5a,
-	0x49, 0x0a, 0x9d, 0x88, 0xc8, 0xed, 0x80, 0xef, 0x12, 0xde, 0x4b, 0xc9, 0xed, 0xd3, 0x83, 0x48,
-	0xee, 0x78, 0x5a, 0x6e, 0x87, 0x1e, 0x48, 0xb9, 0xab, 0x80, 0xb4, 0x3d, 0x92, 0x98, 0x2c, 0x4b,
-	0x51, 0x65, 0xa1, 0x4d, 0x94, 0x51, 0xfc, 0x29, 0x2c, 0x65, 0x83, 0x1d, 0xfa, 0x9e, 0x1b, 0x52,
-	0xf4, 0x31, 0x94, 0xa2, 0x30, 0xc8, 0x48, 0x57, 0x8a, 0x23, 0x9d, 0xd5, 0x37, 0x95, 0x36, 0xfe,
-	0xca, 0x80, 0xd3, 0x1d, 0x87, 0xf1, 0x88, 0xdd, 0xf6, 0xdc, 0xd7, 0xac, 0xab, 0x4f, 0x74, 0x2c,
-	0x32, 0xc6, 0x61, 0x22, 0x33, 0x75, 0xc8, 0xc8, 0x4c, 0x1f, 0x3e, 0x32, 0x33, 0x93, 0x23, 0x73,
-	0x0b, 0x6a, 0x0f, 0xa8, 0x4b, 0x03, 0xc2, 0xe9, 0x13, 0x75, 0xdc, 0x71, 0x74, 0xd2, 0x29, 0x61,
-	0x64, 0x53, 0x02, 0xff, 0xc8, 0x80, 0xb9, 0xb1, 0x60, 0xae, 0x41, 0x25, 0x4e, 0x35, 0xde, 0xd3,
-	0x1b, 0xd5, 0x69, 0xc6, 0x7b, 0xe8, 0x15, 0xcc, 0x27, 0x99, 0x69, 0xed, 0x33, 0x37, 0xca, 0xc5,
-	0xa3, 0x27, 0xf8, 0xdc, 0x7e, 0x66, 0x8d, 0x7f, 0x6a, 0xc0, 0xe2, 0x63, 0x16, 0x72, 0x9d, 0x8d,
-	0x3a, 0xf4, 0xff, 0x0f, 0x8b, 0x5d, 0xca, 0x2d, 0x87, 0xfa, 0x5e, 0xc8, 0xb8, 0xc5, 0xdf, 0x5a,
-	0x0e, 0xe1, 0x44, 0x22, 0x2b, 0x9b, 0x0b, 0x5d, 0xca, 0xef, 0x47, 0x9c, 0x17, 0x6f, 0xef, 0x13,
-	0x4e, 0xd0, 0x59, 0x98, 0xf5, 0x49, 0x97, 0x5a, 0x21, 0x7b, 0x47, 0x25, 0xb2, 0x63, 0x66, 0x59,
-	0x10, 0x9e, 0xb3, 0x77, 0x14, 0x9d, 0x03, 0x90, 0x4c, 0xee, 0xed, 0x53, 0x57, 0x05, 0x5e, 0x8a,
-	0xbf, 0x10, 0x04, 0xb4, 0x00, 0xd3, 0xa4, 0xdf, 0x97, 0x51, 0x2e, 0x9b, 0xe2, 0x13, 0xff, 0xda,
-	0x80, 0xa5, 0x2c, 0x28, 0x15, 0xa7, 0x36, 0x94, 0xe3, 0x9b, 0x64, 0xac, 0x4f, 0x6f, 0x56, 0x5a,
-	0x1b, 0x45, 0xfb, 0x57, 0x36, 0xcc, 0x58, 0x51, 0x24, 0x83, 0x4b, 0xdf, 0x8a, 0x50, 0xc7, 0x98,
-	0x54, 0xd2, 0x08, 0xf2, 0x6e, 0x8c, 0xeb, 0x1c, 0x00, 0xf7, 0x38, 0xe9, 0x47, 0x9b, 0x9a, 0x96,
-	0x9b, 0x9a, 0x95, 0x14, 0xb1, 0x2b, 0xfc, 0x3b, 0x03, 0x8e, 0x2b, 0xe3, 0xa8, 0x05, 0xa7, 0x94,
-	0x77, 0xe6, 0x76, 0x2d, 0x7f, 0xb8, 0xd7, 0x67, 0xb6, 0x48, 0x35, 0x19, 0xaf, 0xaa, 0xb9, 0x98,
-	0x30, 0x77, 0x25, 0x6f, 0x87, 0x1e, 0x88, 0xca, 0xa0, 0x20, 0x59, 0x2e, 0x19, 0x50, 0x85, 0xa1,
-	0xa2, 0x68, 0x4f, 0xc9, 0x80, 0x0a, 0xa4, 0xe3, 0x07, 0x30, 0x2d, 0x0d, 0x9e, 0x70, 0x32, 0xd1,
-	0xdf, 0x10, 0x72, 0x01, 0x1b, 0xc9, 0x1a, 0x9a, 0xce, 0xd9, 0xb9, 0x84, 0x2c, 0x53, 0x76, 0x07,
-	0xe6, 0x74, 0x3c, 0x92, 0x2b, 0x96, 0xc0, 0x8d, 0x82, 0x5a, 0x35, 0xc1, 0xd7, 0x28, 0x43, 0x54,
-	0x83, 0xe3, 0xcc, 0x75, 0x98, 0x4d, 0xc3, 0xda, 0xd4, 0xfa, 0xf4, 0xe6, 0x8c, 0xa9, 0x97, 0xf8,
-	0x53, 0xa8, 0xdc, 0x1b, 0xf2, 0x9e, 0xb6, 0x54, 0x87, 0x72, 0x5c, 0x27, 0x55, 0xca, 0xeb, 0x35,
-	0xba, 0x09, 0xa7, 0xf4, 0xb7, 0x65, 0x8b, 0x2b, 0x1e, 0x0c, 0x24, 0x28, 0xb5, 0xe9, 0x25, 0xcd,
-	0x6c, 0xa7, 0x78, 0xf8, 0x19, 0x54, 0x23, 0xfb, 0xea, 0xf0, 0x97, 0xe0, 0x58, 0x74, 0x5a, 0x91,
-	0xf5, 0x68, 0x81, 0xae, 0xc0, 0x82, 0xfc, 0xb0, 0xe8, 0x5b, 0x9f, 0x05, 0x89, 0xd5, 0x19, 0x73,
-	0x5e, 0xd2, 0x3b, 0x31, 0x19, 0xff, 0xd5, 0x80, 0xe5, 0xa7, 0x9e, 0x43, 0xdb, 0x9e, 0xeb, 0x52,
-	0x5b, 0x90, 0x62, 0xdb, 0xd7, 0x61, 0x49, 0xbd, 0x5e, 0xe2, 0x8d, 0xb2, 0xa8, 0xeb, 0xf8, 0x1e,
-	0x73, 0xb9, 0x72, 0x85, 0x22, 0x9e, 0xd0, 0xed, 0x28, 0x0e, 0x5a, 0x81, 0x59, 0x3b, 0xb2, 0x43,
-	0xa3, 0xbb, 0x58, 0x36, 0x13, 0x82, 0x88, 0x5a, 0x78, 0xe0, 0xda, 0xcc, 0xed, 0xca, 0x13, 0x2b,
-	0x9b, 0x7a, 0x29, 0x8e, 0xbd, 0x4b, 0x5d, 0x1a, 0xb2, 0xd0, 0xe2, 0x6c, 0x40, 0xf5, 0x83, 0xa0,
-	0x68, 0x2f, 0xd8, 0x80, 0xa2, 0x3b, 0x50, 0xd3, 0xc7, 0x6e, 0x7b, 0x2e, 0x0f, 0x88, 0xcd, 0x65,
-	0x01, 0xa4, 0x61, 0x28, 0x5f, 0x87, 0xaa, 0xb9, 0xac, 0xf8, 0x6d, 0xc5, 0xbe, 0x17, 0x71, 0xf1,
-	0x77, 0xc5, 0xc5, 0xf1, 0xba, 0xa1, 0x46, 0x19, 0xef, 0xef, 0x16, 0x9c, 0x8e, 0xaf, 0x87, 0xd5,
-	0xf7, 0xba, 0xe1, 0xf8, 0x16, 0x4f, 0xc5, 0xec, 0xb4, 0x7e, 0x2a, 0x2e, 0x59, 0xa5, 0xa9, 0x74,
-	0x5c, 0xd2, 0x1a, 0xf8, 0x01, 0xcc, 0xbf, 0xa4, 0x41, 0x98, 0x0e, 0xee, 0x32, 0x94, 0x22, 0x41,
-	0xe5, 0x4b, 0xad, 0x44, 0x08, 0x63, 0xaf, 0xca, 0x62, 0x42, 0xc0, 0x5f, 0x1a, 0x70, 0xaa, 0xdd,
-	0x23, 0x6e, 0x97, 0xea, 0x87, 0x56, 0x67, 0xda, 0x15, 0x58, 0xb0, 0x87, 0x41, 0x40, 0xdd, 0xd4,
-	0xcb, 0x1c, 0x59, 0x9e, 0x57, 0xf4, 0xf4, 0xd3, 0x3c, 0xf6, 0x78, 0x1f, 0x22, 0x29, 0xa7, 0xbf,
-	0x26, 0x29, 0xef, 0xc0, 0xc9, 0x87, 0x24, 0x1c, 0x2b, 0xdf, 0x17, 0xe0, 0x84, 0x2a, 0xdf, 0xf4,
-	0x2d, 0x0b, 0x65, 0x6d, 0x12, 0x67, 0x5e, 0x8d, 0x88, 0x1d, 0x49, 0xc3, 0x23, 0x58, 0x7e, 0x34,
-	0xf0, 0xbd, 0x80, 0x8b, 0x6b, 0xc5, 0xbd, 0x80, 0xa6, 0x6a, 0x2d, 0xda, 0xd7, 0x34, 0x8b, 0x49,
-	0x19, 0xea, 0xc8, 0xab, 0x38, 0x6b, 0x9e, 0x8c, 0x39, 0x8f, 0x14, 0x23, 0x2b, 0x3e, 0xb6, 0xbb,
-	0x44, 0x5c, 0x87, 0x00, 0xef, 0xc0, 0xe9, 0x0f, 0xfc, 0x26, 0x59, 0xaf, 0xdd, 0x59, 0x1f, 0x56,
-	0x01, 0xa4, 0x79, 0x71, 0xcd, 0x0a, 0xf1, 0x2b, 0x40, 0x0f, 0x49, 0xf8, 0x49, 0x48, 0x9d, 0x57,
-	0x74, 0x2f, 0xb6, 0x83, 0xe1, 0x44, 0x8f, 0x84, 0x56, 0xc8, 0xba, 0x2e, 0x75, 0xac, 0xa1, 0xaf,
-	0xf6, 0x5f, 0xe9, 0x91, 0xf0, 0xb9, 0xa4, 0x7d, 0xe2, 0x8b, 0x6a, 0x2a, 0x64, 0x54, 0xcf, 0xa0,
-	0x2e, 0x4c, 0x4f, 0x87, 0x12, 0x63, 0xa8, 0x8a, 0x34, 0x8a, 0x4d, 0x22, 0x98, 0x11, 0x19, 0xa7,
-	0xa2, 0x20, 0xbf, 0xf1, 0x2f, 0xa7, 0x60, 0x69, 0x5b, 0xa6, 0xce, 0x73, 0x4e, 0xf8, 0x30, 0xfc,
-	0x1f, 0xbb, 0xbd, 0xe8, 0x9b, 0x00, 0xb2, 0x77, 0xb6, 0x7a, 0x94, 0x38, 0xb2, 0xc5, 0xab, 0xb4,
-	0xd6, 0x93, 0xf7, 0x8d, 0xf2, 0x5e, 0x43, 0xb7, 0xd2, 0x8d, 0xb6, 0x10, 0x7c, 0x48, 0x89, 0x63,
-	0xce, 0xda, 0xfa, 0x13, 0x13, 0x38, 0xb5, 0x4d, 0xec, 0xfd, 0xa1, 0x3f, 0xfe, 0x9a, 0x17, 0x56,
-	0xf9, 0x0d, 0x98, 0xdf, 0x93, 0x9a, 0x1f, 0xf4, 0xba, 0x11, 0x39, 0xce, 0xa6, 0x9b, 0xb0, 0x3c,
-	0xee, 0x42, 0x1d, 0xc2, 0x19, 0x28, 0xbf, 0x63, 0xbe, 0xf5, 0x9a, 0xf5, 0xa9, 0x7a, 0xf6, 0x8e,
-	0xbf, 0x63, 0xfe, 0xc7, 0xac, 0x4f, 0xb7, 0x6e, 0xc3, 0x5c, 0xb6, 0x0d, 0x41, 0x15, 0x38, 0x7e,
-	0xbf, 0x63, 0x3e, 0x7a, 0xd9, 0xb9, 0xbf, 0xf0, 0x7f, 0xa8, 0x0a, 0xe5, 0x47, 0x4f, 0x76, 0x9f,
-	0x99, 0x2f, 0x3a, 0xf7, 0x17, 0x0c, 0x04, 0x50, 0x32, 0x3b, 0x4f, 0x9e, 0xbd, 0xe8, 0x2c, 0x4c,
-	0xb5, 0xfe, 0x31, 0x03, 0xa5, 0x28, 0x41, 0xd0, 0xaf, 0x0c, 0xa8, 0xa6, 0x1b, 0x51, 0x74, 0xb3,
-	0xe8, 0xe5, 0x9f, 0x30, 0x23, 0xd4, 0xbf, 0x71, 0x34, 0xa5, 0x68, 0x6b, 0xf8, 0xf2, 0xe7, 0x7f,
-	0xfe, 0xfb, 0x97, 0x53, 0xeb, 0xf8, 0xac, 0x98, 0xa1, 0x92, 0xc9, 0x2a, 0xca, 0xe5, 0xa6, 0x2d,
-	0x55, 0xee, 0x1a, 0x5b, 0x88, 0x43, 0x35, 0xdd, 0xc6, 0xa2, 0xe5, 0x46, 0x34, 0xc7, 0x34, 0xf4,
-	0x1c, 0xd3, 0xe8, 0x88, 0x39, 0xa6, 0x7e, 0xc4, 0x5e, 0x19, 0xaf, 0x48, 0xff, 0xcb, 0x68, 0x69,
-	0x92, 0x7f, 0xf4, 0x63, 0x03, 0x16, 0xc6, 0x1b, 0xd1, 0x5c, 0xd7, 0x77, 0x8a, 0x5c, 0xe7, 0xb5,
-	0xb4, 0x78, 0x43, 0x82, 0x38, 0x8f, 0xd6, 0xb2, 0x20, 0x74, 0x5b, 0xdb, 0xec, 0x2a, 0x45, 0xf4,
-	0x7b, 0x03, 0xe6, 0xc7, 0x2a, 0x0e, 0xba, 0x55, 0xe4, 0x76, 0x72, 0x69, 0xac, 0xdf, 0x3e, 0xb2,
-	0x9e, 0x42, 0x7b, 0x5d, 0xa2, 0xdd, 0xc2, 0x97, 0x26, 0x1e, 0x59, 0x5c, 0x25, 0x9b, 0x51, 0x8d,
-	0xbb, 0x6b, 0x6c, 0xb5, 0xfe, 0x32, 0x0d, 0xe5, 0x78, 0x26, 0xfb, 0xb9, 0x01, 0xd5, 0x74, 0x07,
-	0x5a, 0x9c, 0x6d, 0x13, 0x9a, 0xe8, 0xe2, 0x6c, 0x9b, 0xd4, 0xe4, 0xe2, 0x55, 0x09, 0xbd, 0x86,
-	0x96, 0xb3, 0xd0, 0xe3, 0xfe, 0xf5, 0x37, 0x06, 0xcc, 0x65, 0xef, 0x20, 0xfa, 0xa8, 0xc8, 0xd1,
-	0xc4, 0xb2, 0x50, 0xbf, 0x75, 0x54, 0x35, 0x85, 0x70, 0x53, 0x22, 0xc4, 0xf8, 0xdc, 0x64, 0x84,
-	0xcd, 0xa8, 0x66, 0x88, 0x1b, 0xf1, 0x03, 0x03, 0xe6, 0xb2, 0x8f, 0x78, 0x31, 0xd6, 0x89, 0x8f,
-	0x7e, 0x3d, 0x27, 0xa1, 0xf3, 0xee, 0xa6, 0x2e, 0x63, 0x4d, 0xea, 0x30, 0x79, 0xbc, 0xdf, 0x2b,
-	0x43, 0x29, 0x7a, 0x3c, 0xd0, 0x17, 0x06, 0xcc, 0x3f, 0xa0, 0x3c, 0xfd, 0x94, 0xe4, 0xde, 0x97,
-	0xc2, 0x23, 0x9c, 0xf4, 0x20, 0xe1, 0x0b, 0x12, 0xd4, 0x39, 0x34, 0x06, 0x4a, 0xfd, 0x49, 0x09,
-	0x23, 0x97, 0x5f, 0x19, 0x70, 0xe6, 0x01, 0xe5, 0x2f, 0x35, 0x7b, 0x97, 0x04, 0x9c, 0xd9, 0xcc,
-	0x97, 0x8d, 0x06, 0xba, 0x9d, 0x53, 0xf8, 0x73, 0x35, 0x74, 0xa0, 0x3e, 0xca, 0x51, 0xcc, 0xd3,
-	0x52, 0x90, 0xb7, 0x24, 0xe4, 0x8b, 0x08, 0x4f, 0x84, 0xec, 0x67, 0xb0, 0xfd, 0xd6, 0x80, 0xd3,
-	0x19, 0x1c, 0x34, 0x78, 0xed, 0x05, 0x03, 0xe2, 0xda, 0x14, 0xb5, 0x0a, 0xdd, 0x27, 0xc2, 0x1a,
-	0xf2, 0xcd, 0x23, 0xe9, 0x64, 0x93, 0x10, 0xad, 0x4f, 0x06, 0x9c, 0x82, 0xf4, 0x43, 0x03, 0x4e,
-	0xa4, 0xe1, 0x86, 0xe8, 0x5a, 0x8e, 0x43, 0x71, 0x1f, 0x13, 0x31, 0x0d, 0xef, 0x7c, 0x11, 0xbc,
-	0x30, 0xaf, 0x38, 0x2a, 0x30, 0xa3, 0xc4, 0xf3, 0x2f, 0x0c, 0x58, 0x4a, 0x63, 0xd9, 0x26, 0x7d,
-	0x81, 0x31, 0x53, 0x60, 0xf2, 0x21, 0x69, 0x69, 0x8d, 0x6c, 0xb3, 0x08, 0x99, 0x56, 0xc0, 0x97,
-	0x24, 0xc0, 0x35, 0x74, 0x6e, 0x22, 0xc0, 0x3d, 0x8d, 0x62, 0x04, 0x27, 0xd3, 0xe8, 0xbe, 0x35,
-	0xa4, 0x43, 0x9a, 0x7b, 0x37, 0x2e, 0x15, 0x79, 0x97, 0xea, 0x18, 0x4b, 0xd7, 0x2b, 0xa8, 0x3e,
-	0xd1, 0xf5, 0x67, 0xd2, 0x85, 0x03, 0xe5, 0x07, 0x94, 0xef, 0x52, 0x1a, 0xe4, 0x5f, 0xc5, 0x95,
-	0x1c, 0x77, 0x52, 0xab, 0xc0, 0x8b, 0x2f, 0x64, 0x5a, 0x7f, 0x3c, 0x06, 0xa5, 0x87, 0xf2, 0xff,
-	0x25, 0xfa, 0x59, 0x94, 0xc2, 0xdb, 0x71, 0x6b, 0x98, 0x0c, 0x85, 0xb9, 0x00, 0x0a, 0xcb, 0xe5,
-	0xe4, 0xe1, 0x12, 0x5f, 0x93, 0xd0, 0x2e, 0xa3, 0x8b, 0x59, 0x68, 0xd1, 0x9f, 0x54, 0xf9, 0x53,
-	0xd4, 0xb2, 0x13, 0xef, 0xd1, 0x73, 0xce, 0xd3, 0x43, 0xd5, 0x7f, 0x50, 0x9e, 0x26, 0x4d, 0x83,
-	0xf8, 0xaa, 0x04, 0x74, 0x09, 0x5d, 0x98, 0x08, 0x48, 0xf4, 0xda, 0x4d, 0x1a, 0xbb, 0xfe, 0x0e,
-	0x80, 0x48, 0x89, 0x68, 0xa6, 0xcb, 0x05, 0xd2, 0x2c, 0x02, 0x32, 0x36, 0x14, 0xe2, 0x8b, 0x12,
-	0xc3, 0x2a, 0x5a, 0x99, 0x88, 0x61, 0xa4, 0xdc, 0x7d, 0xdf, 0x80, 0x85, 0xe7, 0x3c, 0xa0, 0x64,
-	0xb0, 0x1d, 0x8f, 0x9a, 0xb9, 0x18, 0x2e, 0x26, 0x18, 0xa2, 0x63, 0x6f, 0x04, 0xbe, 0xdd, 0x18,
-	0xdd, 0x68, 0xa4, 0x27, 0x0b, 0xdc, 0x94, 0x8e, 0xaf, 0xa0, 0x8d, 0xfc, 0xcd, 0xc7, 0x75, 0x5a,
-	0x38, 0xbe, 0x6e, 0xa0, 0x9f, 0x18, 0xb0, 0x18, 0xa1, 0x78, 0x99, 0x9e, 0x92, 0x73, 0x81, 0x5c,
-	0x3b, 0xcc, 0xa9, 0xc4, 0x80, 0x5a, 0x12, 0xd0, 0x35, 0xb4, 0x95, 0x0f, 0x28, 0xa1, 0x6a, 0x4c,
-	0xad, 0x7f, 0x4e, 0xc3, 0xcc, 0xbd, 0x21, 0xef, 0x89, 0xf3, 0x49, 0x46, 0xb2, 0x5c, 0x48, 0xad,
-	0x22, 0x48, 0x1f, 0x8e, 0x75, 0xf8, 0xbc, 0x04, 0x76, 0x16, 0x9d, 0xc9, 0x02, 0x63, 0x2e, 0xe3,
-	0x8c, 0xf4, 0xd9, 0x3b, 0xea, 0xa0, 0xcf, 0x0d, 0x38, 0xf6, 0xd8, 0xeb, 0x32, 0x17, 0x5d, 0x2d,
-	0xfc, 0x11, 0x97, 0xfc, 0x2b, 0x2a, 0x0e, 0x50, 0xfa, 0xc7, 0x8f, 0x6e, 0x88, 0xf0, 0x62, 0x16,
-	0x47, 0x5f, 0xf8, 0x15, 0x4d, 0xc6, 0x17, 0x06, 0x94, 0xc4, 0x9c, 0x39, 0xf4, 0xff, 0x9b, 0x28,
-	0xd6, 0x24, 0x8a, 0x33, 0x78, 0xac, 0x09, 0x0f, 0xa5, 0x63, 0x01, 0xe3, 0xdb, 0x50, 0x7a, 0xec,
-	0x75, 0xbd, 0x21, 0xcf, 0x3d, 0x84, 0xbc, 0x1e, 0x26, 0xc7, 0x74, 0x5f, 0x5a, 0xbb, 0x6b, 0x6c,
-	0x6d, 0x57, 0xff, 0xf0, 0x7e, 0xd5, 0xf8, 0xd3, 0xfb, 0x55, 0xe3, 0x6f, 0xef, 0x57, 0x8d, 0xbd,
-	0x92, 0x54, 0xbf, 0xf9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xac, 0xc3, 0xc9, 0xeb, 0x19,
-	0x00, 0x00,
+func (m *ListKeystoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListKeystoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListKeystoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListKeystoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListKeystoresResponse.Merge(m, src)
+}
+func (m *ListKeystoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListKeystoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListKeystoresResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ListKeystoresResponse proto.InternalMessageInfo
 
-// WalletClient is the client API for Wallet service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WalletClient interface {
-	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
-	WalletConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WalletResponse, error)
-	GenerateMnemonic(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error)
-	ImportKeystores(ctx context.Context, in *ImportKeystoresRequest, opts ...grpc.CallOption) (*ImportKeystoresResponse, error)
+func (m *ListKeystoresResponse) GetData() []*ListKeystoresResponse_Keystore {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-type walletClient struct {
-	cc *grpc.ClientConn
+type ListKeystoresResponse_Keystore struct {
+	ValidatingPubkey     string   `protobuf:"bytes,1,opt,name=validating_pubkey,proto3" json:"validating_pubkey,omitempty"`
+	DerivationPath       string   `protobuf:"bytes,2,opt,name=derivation_path,proto3" json:"derivation_path,omitempty"`
+	Readonly             bool     `protobuf:"varint,3,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewWalletClient(cc *grpc.ClientConn) WalletClient {
-	return &walletClient{cc}
+func (m *ListKeystoresResponse_Keystore) Reset()         { *m = ListKeystoresResponse_Keystore{} }
+func (m *ListKeystoresResponse_Keystore) String() string { return proto.CompactTextString(m) }
+func (*ListKeystoresResponse_Keystore) ProtoMessage()    {}
+func (*ListKeystoresResponse_Keystore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{23, 0}
 }
-
-func (c *walletClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
-	out := new(CreateWalletResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/CreateWallet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListKeystoresResponse_Keystore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListKeystoresResponse_Keystore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListKeystoresResponse_Keystore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ListKeystoresResponse_Keystore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListKeystoresResponse_Keystore.Merge(m, src)
+}
+func (m *ListKeystoresResponse_Keystore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListKeystoresResponse_Keystore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListKeystoresResponse_Keystore.DiscardUnknown(m)
 }
 
-func (c *walletClient) WalletConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WalletResponse, error) {
-	out := new(WalletResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/WalletConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListKeystoresResponse_Keystore proto.InternalMessageInfo
+
+func (m *ListKeystoresResponse_Keystore) GetValidatingPubkey() string {
+	if m != nil {
+		return m.ValidatingPubkey
 	}
-	return out, nil
+	return ""
 }
 
-func (c *walletClient) GenerateMnemonic(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error) {
-	out := new(GenerateMnemonicResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListKeystoresResponse_Keystore) GetDerivationPath() string {
+	if m != nil {
+		return m.DerivationPath
 	}
-	return out, nil
+	return ""
 }
 
-func (c *walletClient) ImportKeystores(ctx context.Context, in *ImportKeystoresRequest, opts ...grpc.CallOption) (*ImportKeystoresResponse, error) {
-	out := new(ImportKeystoresResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/ImportKeystores", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListKeystoresResponse_Keystore) GetReadonly() bool {
+	if m != nil {
+		return m.Readonly
 	}
-	return out, nil
+	return false
 }
 
-// WalletServer is the server API for Wallet service.
-type WalletServer interface {
-	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
-	WalletConfig(context.Context, *empty.Empty) (*WalletResponse, error)
-	GenerateMnemonic(context.Context, *empty.Empty) (*GenerateMnemonicResponse, error)
-	ImportKeystores(context.Context, *ImportKeystoresRequest) (*ImportKeystoresResponse, error)
+type AddKeystoresRequest struct {
+	Keystores            []string `protobuf:"bytes,1,rep,name=keystores,proto3" json:"keystores,omitempty"`
+	Passwords            []string `protobuf:"bytes,2,rep,name=passwords,proto3" json:"passwords,omitempty"`
+	SlashingProtection   string   `protobuf:"bytes,3,opt,name=slashing_protection,proto3" json:"slashing_protection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedWalletServer can be embedded to have forward compatible implementations.
-type UnimplementedWalletServer struct {
+func (m *AddKeystoresRequest) Reset()         { *m = AddKeystoresRequest{} }
+func (m *AddKeystoresRequest) String() string { return proto.CompactTextString(m) }
+func (*AddKeystoresRequest) ProtoMessage()    {}
+func (*AddKeystoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{24}
 }
-
-func (*UnimplementedWalletServer) CreateWallet(ctx context.Context, req *CreateWalletRequest) (*CreateWalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
+func (m *AddKeystoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedWalletServer) WalletConfig(ctx context.Context, req *empty.Empty) (*WalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WalletConfig not implemented")
+func (m *AddKeystoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddKeystoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedWalletServer) GenerateMnemonic(ctx context.Context, req *empty.Empty) (*GenerateMnemonicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateMnemonic not implemented")
+func (m *AddKeystoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddKeystoresRequest.Merge(m, src)
 }
-func (*UnimplementedWalletServer) ImportKeystores(ctx context.Context, req *ImportKeystoresRequest) (*ImportKeystoresResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportKeystores not implemented")
+func (m *AddKeystoresRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterWalletServer(s *grpc.Server, srv WalletServer) {
-	s.RegisterService(&_Wallet_serviceDesc, srv)
+func (m *AddKeystoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddKeystoresRequest.DiscardUnknown(m)
 }
 
-func _Wallet_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateWalletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletServer).CreateWallet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/CreateWallet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).CreateWallet(ctx, req.(*CreateWalletRequest))
+var xxx_messageInfo_AddKeystoresRequest proto.InternalMessageInfo
+
+func (m *AddKeystoresRequest) GetKeystores() []string {
+	if m != nil {
+		return m.Keystores
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Wallet_WalletConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletServer).WalletConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/WalletConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).WalletConfig(ctx, req.(*empty.Empty))
+func (m *AddKeystoresRequest) GetPasswords() []string {
+	if m != nil {
+		return m.Passwords
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Wallet_GenerateMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletServer).GenerateMnemonic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).GenerateMnemonic(ctx, req.(*empty.Empty))
+func (m *AddKeystoresRequest) GetSlashingProtection() string {
+	if m != nil {
+		return m.SlashingProtection
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Wallet_ImportKeystores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportKeystoresRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletServer).ImportKeystores(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/ImportKeystores",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).ImportKeystores(ctx, req.(*ImportKeystoresRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type AddKeystoresResponse struct {
+	Data                 []*AddedKeystoreStatus `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-var _Wallet_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Wallet",
-	HandlerType: (*WalletServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateWallet",
-			Handler:    _Wallet_CreateWallet_Handler,
-		},
-		{
-			MethodName: "WalletConfig",
-			Handler:    _Wallet_WalletConfig_Handler,
-		},
-		{
-			MethodName: "GenerateMnemonic",
-			Handler:    _Wallet_GenerateMnemonic_Handler,
-		},
-		{
-			MethodName: "ImportKeystores",
-			Handler:    _Wallet_ImportKeystores_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/validator/accounts/v2/web_api.proto",
+func (m *AddKeystoresResponse) Reset()         { *m = AddKeystoresResponse{} }
+func (m *AddKeystoresResponse) String() string { return proto.CompactTextString(m) }
+func (*AddKeystoresResponse) ProtoMessage()    {}
+func (*AddKeystoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{25}
 }
-
-// AccountsClient is the client API for Accounts service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AccountsClient interface {
-	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
-	BackupAccounts(ctx context.Context, in *BackupAccountsRequest, opts ...grpc.CallOption) (*BackupAccountsResponse, error)
-	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+func (m *AddKeystoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddKeystoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddKeystoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddKeystoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddKeystoresResponse.Merge(m, src)
+}
+func (m *AddKeystoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddKeystoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddKeystoresResponse.DiscardUnknown(m)
 }
 
-type accountsClient struct {
-	cc *grpc.ClientConn
+var xxx_messageInfo_AddKeystoresResponse proto.InternalMessageInfo
+
+func (m *AddKeystoresResponse) GetData() []*AddedKeystoreStatus {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func NewAccountsClient(cc *grpc.ClientConn) AccountsClient {
-	return &accountsClient{cc}
+type AddedKeystoreStatus struct {
+	Status               AddedKeystoreStatus_Status `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.validator.accounts.v2.AddedKeystoreStatus_Status" json:"status,omitempty"`
+	Message              string                     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (c *accountsClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
-	out := new(ListAccountsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ListAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddedKeystoreStatus) Reset()         { *m = AddedKeystoreStatus{} }
+func (m *AddedKeystoreStatus) String() string { return proto.CompactTextString(m) }
+func (*AddedKeystoreStatus) ProtoMessage()    {}
+func (*AddedKeystoreStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{26}
+}
+func (m *AddedKeystoreStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddedKeystoreStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddedKeystoreStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AddedKeystoreStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddedKeystoreStatus.Merge(m, src)
+}
+func (m *AddedKeystoreStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddedKeystoreStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddedKeystoreStatus.DiscardUnknown(m)
 }
 
-func (c *accountsClient) BackupAccounts(ctx context.Context, in *BackupAccountsRequest, opts ...grpc.CallOption) (*BackupAccountsResponse, error) {
-	out := new(BackupAccountsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/BackupAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AddedKeystoreStatus proto.InternalMessageInfo
+
+func (m *AddedKeystoreStatus) GetStatus() AddedKeystoreStatus_Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return AddedKeystoreStatus_IMPORTED
 }
 
-func (c *accountsClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ChangePassword", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AddedKeystoreStatus) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return out, nil
+	return ""
 }
 
-// AccountsServer is the server API for Accounts service.
-type AccountsServer interface {
-	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
-	BackupAccounts(context.Context, *BackupAccountsRequest) (*BackupAccountsResponse, error)
-	ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error)
+type DeleteKeystoresRequest struct {
+	Pubkeys              []string `protobuf:"bytes,1,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedAccountsServer can be embedded to have forward compatible implementations.
-type UnimplementedAccountsServer struct {
+func (m *DeleteKeystoresRequest) Reset()         { *m = DeleteKeystoresRequest{} }
+func (m *DeleteKeystoresRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteKeystoresRequest) ProtoMessage()    {}
+func (*DeleteKeystoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{27}
 }
-
-func (*UnimplementedAccountsServer) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+func (m *DeleteKeystoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAccountsServer) BackupAccounts(ctx context.Context, req *BackupAccountsRequest) (*BackupAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BackupAccounts not implemented")
+func (m *DeleteKeystoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteKeystoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAccountsServer) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+func (m *DeleteKeystoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteKeystoresRequest.Merge(m, src)
 }
-
-func RegisterAccountsServer(s *grpc.Server, srv AccountsServer) {
-	s.RegisterService(&_Accounts_serviceDesc, srv)
+func (m *DeleteKeystoresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteKeystoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteKeystoresRequest.DiscardUnknown(m)
 }
 
-func _Accounts_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AccountsServer).ListAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ListAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+var xxx_messageInfo_DeleteKeystoresRequest proto.InternalMessageInfo
+
+func (m *DeleteKeystoresRequest) GetPubkeys() []string {
+	if m != nil {
+		return m.Pubkeys
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Accounts_BackupAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BackupAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AccountsServer).BackupAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Accounts/BackupAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServer).BackupAccounts(ctx, req.(*BackupAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type DeleteKeystoresResponse struct {
+	Data                 []*DeletedKeystoreStatus `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	SlashingProtection   string                   `protobuf:"bytes,2,opt,name=slashing_protection,proto3" json:"slashing_protection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func _Accounts_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AccountsServer).ChangePassword(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ChangePassword",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+func (m *DeleteKeystoresResponse) Reset()         { *m = DeleteKeystoresResponse{} }
+func (m *DeleteKeystoresResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteKeystoresResponse) ProtoMessage()    {}
+func (*DeleteKeystoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{28}
+}
+func (m *DeleteKeystoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteKeystoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteKeystoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Accounts_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Accounts",
-	HandlerType: (*AccountsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListAccounts",
-			Handler:    _Accounts_ListAccounts_Handler,
-		},
-		{
-			MethodName: "BackupAccounts",
-			Handler:    _Accounts_BackupAccounts_Handler,
-		},
-		{
-			MethodName: "ChangePassword",
-			Handler:    _Accounts_ChangePassword_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/validator/accounts/v2/web_api.proto",
+func (m *DeleteKeystoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteKeystoresResponse.Merge(m, src)
 }
-
-// BeaconClient is the client API for Beacon service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BeaconClient interface {
-	GetBeaconStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BeaconStatusResponse, error)
-	GetValidatorParticipation(ctx context.Context, in *v1alpha1.GetValidatorParticipationRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorParticipationResponse, error)
-	GetValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error)
-	GetValidators(ctx context.Context, in *v1alpha1.ListValidatorsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error)
-	GetValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error)
-	GetValidatorQueue(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1.ValidatorQueue, error)
-	GetPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1.Peers, error)
+func (m *DeleteKeystoresResponse) XXX_Size() int {
+	return m.Size()
 }
-
-type beaconClient struct {
-	cc *grpc.ClientConn
+func (m *DeleteKeystoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteKeystoresResponse.DiscardUnknown(m)
 }
 
-func NewBeaconClient(cc *grpc.ClientConn) BeaconClient {
-	return &beaconClient{cc}
-}
+var xxx_messageInfo_DeleteKeystoresResponse proto.InternalMessageInfo
 
-func (c *beaconClient) GetBeaconStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BeaconStatusResponse, error) {
-	out := new(BeaconStatusResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetBeaconStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeleteKeystoresResponse) GetData() []*DeletedKeystoreStatus {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return nil
 }
 
-func (c *beaconClient) GetValidatorParticipation(ctx context.Context, in *v1alpha1.GetValidatorParticipationRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorParticipationResponse, error) {
-	out := new(v1alpha1.ValidatorParticipationResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorParticipation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeleteKeystoresResponse) GetSlashingProtection() string {
+	if m != nil {
+		return m.SlashingProtection
 	}
-	return out, nil
+	return ""
 }
 
-func (c *beaconClient) GetValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error) {
-	out := new(v1alpha1.ValidatorPerformanceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorPerformance", in, out, opts...)
-	if err != nil {
-		return nil, err
+type DeletedKeystoreStatus struct {
+	Status               DeletedKeystoreStatus_Status `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.validator.accounts.v2.DeletedKeystoreStatus_Status" json:"status,omitempty"`
+	Message              string                       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *DeletedKeystoreStatus) Reset()         { *m = DeletedKeystoreStatus{} }
+func (m *DeletedKeystoreStatus) String() string { return proto.CompactTextString(m) }
+func (*DeletedKeystoreStatus) ProtoMessage()    {}
+func (*DeletedKeystoreStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{29}
+}
+func (m *DeletedKeystoreStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletedKeystoreStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletedKeystoreStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DeletedKeystoreStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletedKeystoreStatus.Merge(m, src)
+}
+func (m *DeletedKeystoreStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletedKeystoreStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletedKeystoreStatus.DiscardUnknown(m)
 }
 
-func (c *beaconClient) GetValidators(ctx context.Context, in *v1alpha1.ListValidatorsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error) {
-	out := new(v1alpha1.Validators)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeletedKeystoreStatus proto.InternalMessageInfo
+
+func (m *DeletedKeystoreStatus) GetStatus() DeletedKeystoreStatus_Status {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return DeletedKeystoreStatus_DELETED
 }
 
-func (c *beaconClient) GetValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error) {
-	out := new(v1alpha1.ValidatorBalances)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorBalances", in, out, opts...)
+func (m *DeletedKeystoreStatus) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.validator.accounts.v2.KeymanagerKind", KeymanagerKind_name, KeymanagerKind_value)
+	proto.RegisterEnum("ethereum.validator.accounts.v2.AddedKeystoreStatus_Status", AddedKeystoreStatus_Status_name, AddedKeystoreStatus_Status_value)
+	proto.RegisterEnum("ethereum.validator.accounts.v2.DeletedKeystoreStatus_Status", DeletedKeystoreStatus_Status_name, DeletedKeystoreStatus_Status_value)
+	proto.RegisterType((*CreateWalletRequest)(nil), "ethereum.validator.accounts.v2.CreateWalletRequest")
+	proto.RegisterType((*CreateWalletResponse)(nil), "ethereum.validator.accounts.v2.CreateWalletResponse")
+	proto.RegisterType((*EditWalletConfigRequest)(nil), "ethereum.validator.accounts.v2.EditWalletConfigRequest")
+	proto.RegisterType((*GenerateMnemonicResponse)(nil), "ethereum.validator.accounts.v2.GenerateMnemonicResponse")
+	proto.RegisterType((*WalletResponse)(nil), "ethereum.validator.accounts.v2.WalletResponse")
+	proto.RegisterType((*ListAccountsRequest)(nil), "ethereum.validator.accounts.v2.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "ethereum.validator.accounts.v2.ListAccountsResponse")
+	proto.RegisterType((*Account)(nil), "ethereum.validator.accounts.v2.Account")
+	proto.RegisterType((*AccountRequest)(nil), "ethereum.validator.accounts.v2.AccountRequest")
+	proto.RegisterType((*AuthRequest)(nil), "ethereum.validator.accounts.v2.AuthRequest")
+	proto.RegisterType((*AuthResponse)(nil), "ethereum.validator.accounts.v2.AuthResponse")
+	proto.RegisterType((*NodeConnectionResponse)(nil), "ethereum.validator.accounts.v2.NodeConnectionResponse")
+	proto.RegisterType((*LogsEndpointResponse)(nil), "ethereum.validator.accounts.v2.LogsEndpointResponse")
+	proto.RegisterType((*VersionResponse)(nil), "ethereum.validator.accounts.v2.VersionResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "ethereum.validator.accounts.v2.ChangePasswordRequest")
+	proto.RegisterType((*HasWalletResponse)(nil), "ethereum.validator.accounts.v2.HasWalletResponse")
+	proto.RegisterType((*ImportKeystoresRequest)(nil), "ethereum.validator.accounts.v2.ImportKeystoresRequest")
+	proto.RegisterType((*ImportKeystoresResponse)(nil), "ethereum.validator.accounts.v2.ImportKeystoresResponse")
+	proto.RegisterType((*HasUsedWebResponse)(nil), "ethereum.validator.accounts.v2.HasUsedWebResponse")
+	proto.RegisterType((*LogsResponse)(nil), "ethereum.validator.accounts.v2.LogsResponse")
+	proto.RegisterType((*BeaconStatusResponse)(nil), "ethereum.validator.accounts.v2.BeaconStatusResponse")
+	proto.RegisterType((*BackupAccountsRequest)(nil), "ethereum.validator.accounts.v2.BackupAccountsRequest")
+	proto.RegisterType((*BackupAccountsResponse)(nil), "ethereum.validator.accounts.v2.BackupAccountsResponse")
+	proto.RegisterType((*ListKeystoresResponse)(nil), "ethereum.validator.accounts.v2.ListKeystoresResponse")
+	proto.RegisterType((*ListKeystoresResponse_Keystore)(nil), "ethereum.validator.accounts.v2.ListKeystoresResponse.Keystore")
+	proto.RegisterType((*AddKeystoresRequest)(nil), "ethereum.validator.accounts.v2.AddKeystoresRequest")
+	proto.RegisterType((*AddKeystoresResponse)(nil), "ethereum.validator.accounts.v2.AddKeystoresResponse")
+	proto.RegisterType((*AddedKeystoreStatus)(nil), "ethereum.validator.accounts.v2.AddedKeystoreStatus")
+	proto.RegisterType((*DeleteKeystoresRequest)(nil), "ethereum.validator.accounts.v2.DeleteKeystoresRequest")
+	proto.RegisterType((*DeleteKeystoresResponse)(nil), "ethereum.validator.accounts.v2.DeleteKeystoresResponse")
+	proto.RegisterType((*DeletedKeystoreStatus)(nil), "ethereum.validator.accounts.v2.DeletedKeystoreStatus")
+}
+
+func init() {
+	proto.RegisterFile("proto/validator/accounts/v2/web_api.proto", fileDescriptor_8a5153635bfe042e)
+}
+
+var fileDescriptor_8a5153635bfe042e = []byte{
+	// 2494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xff, 0xaf, 0x28, 0xd3, 0xe4, 0x11, 0x75, 0xf1, 0xe8, 0x46, 0xd3, 0xb2, 0x24, 0x4f, 0xec,
+	0x48, 0x56, 0xf4, 0x27, 0x6d, 0x3a, 0xb1, 0x83, 0xa0, 0x68, 0x20, 0x51, 0x8c, 0x2c, 0xc8, 0xb6,
+	0xd4, 0xb5, 0x6c, 0xa3, 0x2f, 0x59, 0x8c, 0xb8, 0x63, 0x72, 0x21, 0x72, 0x77, 0xb3, 0x3b, 0x54,
+	0x2c, 0x17, 0x28, 0xda, 0x24, 0x40, 0xd1, 0x02, 0x7d, 0x68, 0x03, 0xb4, 0x28, 0x50, 0x14, 0x6d,
+	0xdf, 0x0a, 0xf4, 0xa5, 0x40, 0x8b, 0x7c, 0x84, 0xa6, 0x6f, 0x05, 0xfa, 0x5a, 0xa0, 0x45, 0xd0,
+	0x97, 0x36, 0x5f, 0xa2, 0x98, 0xd9, 0x99, 0xbd, 0x50, 0xbb, 0xa1, 0xe4, 0xa2, 0x2f, 0x7d, 0xe3,
+	0x9e, 0xeb, 0x6f, 0xce, 0x39, 0x33, 0x73, 0xe6, 0x10, 0x6e, 0xba, 0x9e, 0xc3, 0x9c, 0xda, 0x31,
+	0xe9, 0x5a, 0x26, 0x61, 0x8e, 0x57, 0x23, 0xad, 0x96, 0xd3, 0xb7, 0x99, 0x5f, 0x3b, 0xae, 0xd7,
+	0x3e, 0xa4, 0x87, 0x06, 0x71, 0xad, 0xaa, 0x90, 0x41, 0x8b, 0x94, 0x75, 0xa8, 0x47, 0xfb, 0xbd,
+	0x6a, 0x28, 0x5d, 0x55, 0xd2, 0xd5, 0xe3, 0x7a, 0x65, 0x39, 0x30, 0x75, 0x48, 0x49, 0xcb, 0xb1,
+	0x6b, 0x9e, 0xdb, 0xaa, 0x1d, 0xdf, 0xae, 0x75, 0x28, 0xe9, 0xb2, 0x4e, 0x60, 0xa1, 0xb2, 0x44,
+	0x59, 0xa7, 0x76, 0x7c, 0x9b, 0x74, 0xdd, 0x0e, 0xb9, 0x2d, 0x05, 0x8d, 0x56, 0x87, 0x58, 0xb6,
+	0x14, 0x98, 0x4f, 0x08, 0xd8, 0x8e, 0x49, 0x25, 0x63, 0xa1, 0xed, 0x38, 0xed, 0x2e, 0xad, 0x11,
+	0xd7, 0xaa, 0x11, 0xdb, 0x76, 0x18, 0x61, 0x96, 0x63, 0xfb, 0x92, 0x7b, 0x45, 0x72, 0xc5, 0xd7,
+	0x61, 0xff, 0x79, 0x8d, 0xf6, 0x5c, 0x76, 0x12, 0x30, 0xf1, 0x97, 0x23, 0x30, 0xdd, 0xf0, 0x28,
+	0x61, 0xf4, 0x19, 0xe9, 0x76, 0x29, 0xd3, 0xe9, 0x07, 0x7d, 0xea, 0x33, 0xf4, 0x08, 0xe0, 0x88,
+	0x9e, 0xf4, 0x88, 0x4d, 0xda, 0xd4, 0x2b, 0x6b, 0xcb, 0xda, 0xea, 0x44, 0xbd, 0x5a, 0xfd, 0xea,
+	0x35, 0x56, 0x77, 0x43, 0x8d, 0x5d, 0xcb, 0x36, 0xf5, 0x98, 0x05, 0xb4, 0x02, 0x93, 0x1f, 0x0a,
+	0x07, 0x86, 0x4b, 0x7c, 0xff, 0x43, 0xc7, 0x33, 0xcb, 0x23, 0xcb, 0xda, 0x6a, 0x51, 0x9f, 0x08,
+	0xc8, 0xfb, 0x92, 0x8a, 0x2a, 0x50, 0xe8, 0xd9, 0xb4, 0xe7, 0xd8, 0x56, 0xab, 0x9c, 0x13, 0x12,
+	0xe1, 0x37, 0xba, 0x06, 0x25, 0xbb, 0xdf, 0x33, 0x94, 0xcb, 0xf2, 0xe8, 0xb2, 0xb6, 0x3a, 0xaa,
+	0x8f, 0xd9, 0xfd, 0xde, 0x86, 0x24, 0xa1, 0x25, 0x18, 0xf3, 0x68, 0xcf, 0x61, 0xd4, 0x20, 0xa6,
+	0xe9, 0x95, 0x2f, 0x08, 0x0b, 0x10, 0x90, 0x36, 0x4c, 0xd3, 0x43, 0xaf, 0xc3, 0xa4, 0x14, 0x68,
+	0x79, 0x1c, 0x0c, 0xeb, 0x94, 0xf3, 0x42, 0x68, 0x3c, 0x20, 0x37, 0x3c, 0xb6, 0x4f, 0x58, 0x27,
+	0x26, 0x77, 0x44, 0x4f, 0x02, 0xb9, 0x8b, 0x71, 0xb9, 0x5d, 0x7a, 0x22, 0xe4, 0xde, 0x00, 0xa4,
+	0xec, 0x91, 0xc8, 0x64, 0x41, 0x88, 0x4a, 0x0b, 0x0d, 0x22, 0x8d, 0xe2, 0xf7, 0x61, 0x26, 0x19,
+	0x6c, 0xdf, 0x75, 0x6c, 0x9f, 0xa2, 0xf7, 0x20, 0x1f, 0x84, 0x41, 0x44, 0x7a, 0x6c, 0x78, 0xa4,
+	0x93, 0xfa, 0xba, 0xd4, 0xc6, 0x9f, 0x69, 0x30, 0xdf, 0x34, 0x2d, 0x16, 0xb0, 0x1b, 0x8e, 0xfd,
+	0xdc, 0x6a, 0xab, 0x8c, 0x0e, 0x44, 0x46, 0x3b, 0x4b, 0x64, 0x46, 0xce, 0x18, 0x99, 0xdc, 0xd9,
+	0x23, 0x33, 0x9a, 0x1e, 0x99, 0xbb, 0x50, 0xde, 0xa6, 0x36, 0xf5, 0x08, 0xa3, 0x0f, 0x65, 0xba,
+	0xc3, 0xe8, 0xc4, 0x4b, 0x42, 0x4b, 0x96, 0x04, 0xfe, 0x81, 0x06, 0x13, 0x03, 0xc1, 0x5c, 0x82,
+	0xb1, 0xb0, 0xd4, 0x58, 0x47, 0x2d, 0x54, 0x95, 0x19, 0xeb, 0xa0, 0x67, 0x30, 0x19, 0x55, 0xa6,
+	0x71, 0x64, 0xd9, 0x41, 0x2d, 0x9e, 0xbf, 0xc0, 0x27, 0x8e, 0x12, 0xdf, 0xf8, 0xc7, 0x1a, 0x4c,
+	0x3f, 0xb0, 0x7c, 0xa6, 0xaa, 0x51, 0x85, 0xfe, 0xff, 0x61, 0xba, 0x4d, 0x99, 0x61, 0x52, 0xd7,
+	0xf1, 0x2d, 0x66, 0xb0, 0x17, 0x86, 0x49, 0x18, 0x11, 0xc8, 0x0a, 0xfa, 0x54, 0x9b, 0xb2, 0xad,
+	0x80, 0x73, 0xf0, 0x62, 0x8b, 0x30, 0x82, 0xae, 0x40, 0xd1, 0x25, 0x6d, 0x6a, 0xf8, 0xd6, 0x4b,
+	0x2a, 0x90, 0x5d, 0xd0, 0x0b, 0x9c, 0xf0, 0xd8, 0x7a, 0x49, 0xd1, 0x55, 0x00, 0xc1, 0x64, 0xce,
+	0x11, 0xb5, 0x65, 0xe0, 0x85, 0xf8, 0x01, 0x27, 0xa0, 0x29, 0xc8, 0x91, 0x6e, 0x57, 0x44, 0xb9,
+	0xa0, 0xf3, 0x9f, 0xf8, 0xd7, 0x1a, 0xcc, 0x24, 0x41, 0xc9, 0x38, 0x35, 0xa0, 0x10, 0xee, 0x24,
+	0x6d, 0x39, 0xb7, 0x3a, 0x56, 0x5f, 0x19, 0xb6, 0x7e, 0x69, 0x43, 0x0f, 0x15, 0x79, 0x31, 0xd8,
+	0xf4, 0x05, 0x33, 0x62, 0x98, 0x64, 0xd1, 0x70, 0xf2, 0x7e, 0x88, 0xeb, 0x2a, 0x00, 0x73, 0x18,
+	0xe9, 0x06, 0x8b, 0xca, 0x89, 0x45, 0x15, 0x05, 0x85, 0xaf, 0x0a, 0xff, 0x4e, 0x83, 0x8b, 0xd2,
+	0x38, 0xaa, 0xc3, 0xac, 0xf4, 0x6e, 0xd9, 0x6d, 0xc3, 0xed, 0x1f, 0x76, 0xad, 0x16, 0x2f, 0x35,
+	0x11, 0xaf, 0x92, 0x3e, 0x1d, 0x31, 0xf7, 0x05, 0x6f, 0x97, 0x9e, 0xf0, 0x93, 0x41, 0x42, 0x32,
+	0x6c, 0xd2, 0xa3, 0x12, 0xc3, 0x98, 0xa4, 0x3d, 0x22, 0x3d, 0xca, 0x91, 0x0e, 0x26, 0x20, 0x27,
+	0x0c, 0x8e, 0x9b, 0x89, 0xe8, 0xaf, 0x70, 0x39, 0xcf, 0x3a, 0x16, 0x67, 0x68, 0xbc, 0x66, 0x27,
+	0x22, 0xb2, 0x28, 0xd9, 0x5d, 0x98, 0x50, 0xf1, 0x88, 0xb6, 0x58, 0x04, 0x37, 0x08, 0x6a, 0x49,
+	0x07, 0x57, 0xa1, 0xf4, 0x51, 0x19, 0x2e, 0x5a, 0xb6, 0x69, 0xb5, 0xa8, 0x5f, 0x1e, 0x59, 0xce,
+	0xad, 0x8e, 0xea, 0xea, 0x13, 0xbf, 0x0f, 0x63, 0x1b, 0x7d, 0xd6, 0x51, 0x96, 0x2a, 0x50, 0x08,
+	0xcf, 0x49, 0x59, 0xf2, 0xea, 0x1b, 0xdd, 0x81, 0x59, 0xf5, 0xdb, 0x68, 0xf1, 0x2d, 0xee, 0xf5,
+	0x04, 0x28, 0xb9, 0xe8, 0x19, 0xc5, 0x6c, 0xc4, 0x78, 0x78, 0x0f, 0x4a, 0x81, 0x7d, 0x99, 0xfc,
+	0x19, 0xb8, 0x10, 0x64, 0x2b, 0xb0, 0x1e, 0x7c, 0xa0, 0x9b, 0x30, 0x25, 0x7e, 0x18, 0xf4, 0x85,
+	0x6b, 0x79, 0x91, 0xd5, 0x51, 0x7d, 0x52, 0xd0, 0x9b, 0x21, 0x19, 0xff, 0x4d, 0x83, 0xb9, 0x47,
+	0x8e, 0x49, 0x1b, 0x8e, 0x6d, 0xd3, 0x16, 0x27, 0x85, 0xb6, 0x6f, 0xc1, 0x8c, 0xbc, 0xbd, 0xf8,
+	0x1d, 0x65, 0x50, 0xdb, 0x74, 0x1d, 0xcb, 0x66, 0xd2, 0x15, 0x0a, 0x78, 0x5c, 0xb7, 0x29, 0x39,
+	0x68, 0x01, 0x8a, 0xad, 0xc0, 0x0e, 0x0d, 0xf6, 0x62, 0x41, 0x8f, 0x08, 0x3c, 0x6a, 0xfe, 0x89,
+	0xdd, 0xb2, 0xec, 0xb6, 0xc8, 0x58, 0x41, 0x57, 0x9f, 0x3c, 0xed, 0x6d, 0x6a, 0x53, 0xdf, 0xf2,
+	0x0d, 0x66, 0xf5, 0xa8, 0xba, 0x10, 0x24, 0xed, 0xc0, 0xea, 0x51, 0xf4, 0x36, 0x94, 0x55, 0xda,
+	0x5b, 0x8e, 0xcd, 0x3c, 0xd2, 0x62, 0xe2, 0x00, 0xa4, 0xbe, 0x2f, 0x6e, 0x87, 0x92, 0x3e, 0x27,
+	0xf9, 0x0d, 0xc9, 0xde, 0x08, 0xb8, 0xf8, 0x3b, 0x7c, 0xe3, 0x38, 0x6d, 0x5f, 0xa1, 0x0c, 0xd7,
+	0x77, 0x17, 0xe6, 0xc3, 0xed, 0x61, 0x74, 0x9d, 0xb6, 0x3f, 0xb8, 0xc4, 0xd9, 0x90, 0x1d, 0xd7,
+	0x8f, 0xc5, 0x25, 0xa9, 0x34, 0x12, 0x8f, 0x4b, 0x5c, 0x03, 0x6f, 0xc3, 0xe4, 0x53, 0xea, 0xf9,
+	0xf1, 0xe0, 0xce, 0x41, 0x3e, 0x10, 0x94, 0xbe, 0xe4, 0x17, 0x0f, 0x61, 0xe8, 0x55, 0x5a, 0x8c,
+	0x08, 0xf8, 0x53, 0x0d, 0x66, 0x1b, 0x1d, 0x62, 0xb7, 0xa9, 0xba, 0x68, 0x55, 0xa5, 0xdd, 0x84,
+	0xa9, 0x56, 0xdf, 0xf3, 0xa8, 0x1d, 0xbb, 0x99, 0x03, 0xcb, 0x93, 0x92, 0x1e, 0xbf, 0x9a, 0x07,
+	0x2e, 0xef, 0x33, 0x14, 0x65, 0xee, 0x2b, 0x8a, 0xf2, 0x6d, 0xb8, 0x74, 0x9f, 0xf8, 0x03, 0xc7,
+	0xf7, 0x6b, 0x30, 0x2e, 0x8f, 0x6f, 0xfa, 0xc2, 0xf2, 0xc5, 0xd9, 0xc4, 0x73, 0x5e, 0x0a, 0x88,
+	0x4d, 0x41, 0xc3, 0xc7, 0x30, 0xb7, 0xd3, 0x73, 0x1d, 0x8f, 0xf1, 0x6d, 0xc5, 0x1c, 0x8f, 0xc6,
+	0xce, 0x5a, 0x74, 0xa4, 0x68, 0x86, 0x25, 0x64, 0xa8, 0x29, 0xb6, 0x62, 0x51, 0xbf, 0x14, 0x72,
+	0x76, 0x24, 0x23, 0x29, 0x3e, 0xb0, 0xba, 0x48, 0x5c, 0x85, 0x00, 0xef, 0xc2, 0xfc, 0x29, 0xbf,
+	0x51, 0xd5, 0x2b, 0x77, 0xc6, 0xe9, 0x53, 0x00, 0x29, 0x5e, 0x78, 0x66, 0xf9, 0xf8, 0x19, 0xa0,
+	0xfb, 0xc4, 0x7f, 0xe2, 0x53, 0xf3, 0x19, 0x3d, 0x0c, 0xed, 0x60, 0x18, 0xef, 0x10, 0xdf, 0xf0,
+	0xad, 0xb6, 0x4d, 0x4d, 0xa3, 0xef, 0xca, 0xf5, 0x8f, 0x75, 0x88, 0xff, 0x58, 0xd0, 0x9e, 0xb8,
+	0xfc, 0x34, 0xe5, 0x32, 0xb2, 0x67, 0x90, 0x1b, 0xa6, 0xa3, 0x42, 0x89, 0x31, 0x94, 0x78, 0x19,
+	0x85, 0x26, 0x11, 0x8c, 0xf2, 0x8a, 0x93, 0x51, 0x10, 0xbf, 0xf1, 0x2f, 0x46, 0x60, 0x66, 0x53,
+	0x94, 0xce, 0x63, 0x46, 0x58, 0xdf, 0xff, 0x1f, 0xdb, 0xbd, 0xe8, 0x5d, 0x00, 0xd1, 0x3b, 0x1b,
+	0x1d, 0x4a, 0x4c, 0xd1, 0xe2, 0x8d, 0xd5, 0x97, 0xa3, 0xfb, 0x8d, 0xb2, 0x4e, 0x55, 0xb5, 0xd2,
+	0xd5, 0x06, 0x17, 0xbc, 0x4f, 0x89, 0xa9, 0x17, 0x5b, 0xea, 0x27, 0x26, 0x30, 0xbb, 0x49, 0x5a,
+	0x47, 0x7d, 0x77, 0xf0, 0x36, 0x1f, 0x7a, 0xca, 0xaf, 0xc0, 0xe4, 0xa1, 0xd0, 0x3c, 0xd5, 0xeb,
+	0x06, 0xe4, 0xb0, 0x9a, 0xee, 0xc0, 0xdc, 0xa0, 0x0b, 0x99, 0x84, 0xcb, 0x50, 0x78, 0x69, 0xb9,
+	0xc6, 0x73, 0xab, 0x4b, 0xe5, 0xb5, 0x77, 0xf1, 0xa5, 0xe5, 0xbe, 0x67, 0x75, 0x29, 0xfe, 0x52,
+	0x83, 0x59, 0x7e, 0x9f, 0x9f, 0xae, 0x40, 0x1d, 0x46, 0x65, 0x5f, 0xc1, 0x2f, 0xf3, 0xaf, 0x0f,
+	0xbb, 0xcc, 0x53, 0x8d, 0x54, 0x15, 0x45, 0x17, 0xb6, 0x2a, 0xdf, 0x86, 0x82, 0xa2, 0xa0, 0x75,
+	0xb8, 0x94, 0xbc, 0x98, 0xd5, 0xa5, 0x5c, 0xd4, 0x4f, 0x33, 0xd0, 0xea, 0xe9, 0x7b, 0x34, 0x88,
+	0xc2, 0x20, 0x99, 0x9f, 0x2b, 0x1e, 0x25, 0xa6, 0x63, 0x77, 0x4f, 0x64, 0x89, 0x84, 0xdf, 0xf8,
+	0x63, 0x0d, 0xa6, 0x37, 0x4c, 0xf3, 0xd4, 0x36, 0x5f, 0x80, 0x62, 0xb8, 0x3b, 0x65, 0x5d, 0x47,
+	0x04, 0xce, 0x55, 0xa1, 0x0f, 0x6e, 0xda, 0xa2, 0x1e, 0x11, 0xd0, 0x2d, 0x98, 0xf6, 0xbb, 0xc4,
+	0xef, 0x08, 0xb0, 0x9e, 0xc3, 0x82, 0xeb, 0x4b, 0x9e, 0x54, 0x69, 0x2c, 0x6c, 0xc0, 0x4c, 0x12,
+	0x84, 0x8c, 0xf8, 0x76, 0x22, 0xe2, 0x77, 0x86, 0xb6, 0x4f, 0xa6, 0x49, 0x43, 0x2b, 0x72, 0xdb,
+	0x09, 0x03, 0xf8, 0x0f, 0xc1, 0x32, 0x07, 0xb9, 0x48, 0x87, 0xbc, 0x2f, 0x7e, 0xc9, 0x27, 0xd8,
+	0x3b, 0xaf, 0xe0, 0xa2, 0x2a, 0x3d, 0x49, 0x4b, 0x7c, 0x43, 0xf6, 0xa8, 0xef, 0x93, 0xb6, 0x6a,
+	0x93, 0xd4, 0x27, 0xbe, 0x05, 0x79, 0xe9, 0xb7, 0x04, 0x85, 0x9d, 0x87, 0xfb, 0x7b, 0xfa, 0x41,
+	0x73, 0x6b, 0xea, 0xff, 0xd0, 0x38, 0x14, 0xb7, 0x9e, 0xec, 0x3f, 0xd8, 0x69, 0x6c, 0x1c, 0x34,
+	0xa7, 0x34, 0x54, 0x84, 0x0b, 0x4d, 0x5d, 0xdf, 0xd3, 0xa7, 0x46, 0x70, 0x1d, 0xe6, 0xb6, 0x68,
+	0x97, 0x32, 0xaa, 0x5c, 0x86, 0x09, 0x2a, 0xc3, 0xc5, 0xa0, 0x10, 0x54, 0x7a, 0xd4, 0x27, 0xfe,
+	0x89, 0x06, 0xf3, 0xa7, 0x94, 0x64, 0x40, 0x77, 0x12, 0x01, 0x7d, 0x6b, 0xd8, 0x6a, 0x03, 0x33,
+	0xa9, 0x21, 0xcd, 0xca, 0xf2, 0x48, 0x76, 0x96, 0x3f, 0xd7, 0x60, 0x36, 0xd5, 0x22, 0x3a, 0x18,
+	0x48, 0xc3, 0xd7, 0x5e, 0x09, 0xd8, 0xd9, 0x13, 0xf1, 0x6e, 0x98, 0x88, 0x31, 0xb8, 0xb8, 0xd5,
+	0x7c, 0xd0, 0x0c, 0xf2, 0x30, 0x01, 0xf0, 0x68, 0xef, 0xc0, 0xd8, 0x68, 0x1c, 0xec, 0x3c, 0xe5,
+	0x89, 0x18, 0x87, 0x22, 0xff, 0x7e, 0x6f, 0xef, 0xc9, 0xa3, 0xad, 0xa9, 0x91, 0x28, 0x2f, 0xb9,
+	0xb5, 0x7b, 0x30, 0x91, 0x7c, 0xab, 0x04, 0x86, 0xf4, 0x9d, 0xa7, 0xc2, 0x50, 0x3c, 0xbd, 0x1a,
+	0x02, 0xc8, 0xeb, 0xcd, 0x87, 0x7b, 0x07, 0xcd, 0xa9, 0x91, 0xfa, 0x3f, 0x47, 0x21, 0x1f, 0xdc,
+	0x22, 0xe8, 0x57, 0x1a, 0x94, 0xe2, 0xaf, 0x55, 0x34, 0xb4, 0xbe, 0x53, 0x06, 0x09, 0x95, 0x37,
+	0xcf, 0xa7, 0x14, 0xd4, 0x01, 0x7e, 0xfd, 0xa3, 0xbf, 0xfc, 0xe3, 0xd3, 0x91, 0x65, 0x7c, 0x85,
+	0x0f, 0x5a, 0x42, 0xbd, 0x5a, 0x70, 0xe1, 0xd5, 0x5a, 0x42, 0xe5, 0x1d, 0x6d, 0x0d, 0x31, 0x28,
+	0xc5, 0xdf, 0xba, 0x68, 0xae, 0x1a, 0x0c, 0x3b, 0xaa, 0x6a, 0xd8, 0x51, 0x6d, 0xf2, 0x61, 0x47,
+	0xe5, 0x9c, 0x0f, 0x6a, 0xbc, 0x20, 0xfc, 0xcf, 0xa1, 0x99, 0x34, 0xff, 0xe8, 0x87, 0x1a, 0x4c,
+	0x0d, 0xbe, 0x56, 0x33, 0x5d, 0xbf, 0x3d, 0xcc, 0x75, 0xd6, 0xbb, 0x17, 0xaf, 0x08, 0x10, 0xd7,
+	0xd0, 0x52, 0x12, 0x84, 0x7a, 0xfb, 0xd6, 0xda, 0x52, 0x11, 0xfd, 0x5e, 0x83, 0xc9, 0x81, 0xb6,
+	0x04, 0xdd, 0x1d, 0xe6, 0x36, 0xbd, 0x7f, 0xaa, 0xdc, 0x3b, 0xb7, 0x9e, 0x44, 0x7b, 0x4b, 0xa0,
+	0x5d, 0xc3, 0x37, 0x52, 0x53, 0x16, 0x9e, 0xcd, 0xb5, 0xa0, 0x11, 0x7a, 0x47, 0x5b, 0xab, 0xff,
+	0x35, 0x07, 0x85, 0x70, 0x70, 0xf3, 0x33, 0x0d, 0x4a, 0xf1, 0x67, 0xea, 0xf0, 0x6a, 0x4b, 0x79,
+	0x69, 0x57, 0xde, 0x3c, 0x9f, 0x92, 0x84, 0xbe, 0x28, 0xa0, 0x97, 0xd1, 0x5c, 0x12, 0xba, 0xd2,
+	0x43, 0xbf, 0xd1, 0x60, 0x22, 0x79, 0x51, 0xa3, 0xa1, 0x47, 0x53, 0x6a, 0xef, 0x50, 0xb9, 0x7b,
+	0x5e, 0x35, 0x89, 0x70, 0x55, 0x20, 0xc4, 0xf8, 0x6a, 0x3a, 0xc2, 0x5a, 0xd0, 0x58, 0xf0, 0x1d,
+	0xf1, 0x3d, 0x0d, 0x26, 0x92, 0x9d, 0xfe, 0x70, 0xac, 0xa9, 0x2f, 0x83, 0x4a, 0x46, 0x41, 0x67,
+	0xed, 0x4d, 0x75, 0xbf, 0xd6, 0xa8, 0x69, 0x89, 0xf4, 0x7e, 0xb7, 0x00, 0xf9, 0xa0, 0xc3, 0x44,
+	0x9f, 0x68, 0x30, 0xb9, 0x4d, 0x59, 0xbc, 0xdf, 0xcc, 0xdc, 0x2f, 0x43, 0x53, 0x98, 0xd6, 0xb5,
+	0xe2, 0xd7, 0x04, 0xa8, 0xab, 0x68, 0x00, 0x94, 0x1c, 0xb7, 0xca, 0x03, 0xf7, 0x33, 0x0d, 0x2e,
+	0x6f, 0x53, 0xf6, 0x54, 0xb1, 0xf7, 0x89, 0xc7, 0xac, 0x96, 0xe5, 0x8a, 0x5e, 0x04, 0xdd, 0xcb,
+	0xe8, 0x0e, 0x33, 0x35, 0x54, 0xa0, 0xde, 0xca, 0x50, 0xcc, 0xd2, 0x92, 0x90, 0xd7, 0x04, 0xe4,
+	0xeb, 0x08, 0xa7, 0x42, 0x76, 0x13, 0xd8, 0x7e, 0xab, 0xc1, 0x7c, 0x02, 0x07, 0xf5, 0x9e, 0x3b,
+	0x5e, 0x8f, 0xd8, 0x2d, 0x8a, 0xea, 0x43, 0xdd, 0x47, 0xc2, 0x0a, 0xf2, 0x9d, 0x73, 0xe9, 0x24,
+	0x8b, 0x10, 0x2d, 0xa7, 0x03, 0x8e, 0x41, 0xfa, 0xbe, 0x06, 0xe3, 0x71, 0xb8, 0x3e, 0x5a, 0xcf,
+	0x70, 0xc8, 0xf7, 0x63, 0x24, 0xa6, 0xe0, 0x5d, 0x1b, 0x06, 0xcf, 0xcf, 0x3a, 0x1c, 0x25, 0x98,
+	0xe3, 0xc8, 0xf3, 0xcf, 0x35, 0x98, 0x89, 0x63, 0xd9, 0x24, 0x5d, 0x8e, 0x31, 0x71, 0xc0, 0x64,
+	0x43, 0x52, 0xd2, 0x0a, 0xd9, 0xea, 0x30, 0x64, 0x4a, 0x01, 0xdf, 0x10, 0x00, 0x97, 0xd0, 0xd5,
+	0x54, 0x80, 0x87, 0x0a, 0xc5, 0x31, 0x5c, 0x8a, 0xa3, 0xfb, 0x46, 0x9f, 0xf6, 0x69, 0xe6, 0xde,
+	0xb8, 0x31, 0xcc, 0xbb, 0x50, 0xc7, 0x58, 0xb8, 0x5e, 0x40, 0x95, 0x54, 0xd7, 0x1f, 0x08, 0x17,
+	0x26, 0x14, 0xb6, 0x29, 0xdb, 0xa7, 0xd4, 0xcb, 0xde, 0x8a, 0x0b, 0x19, 0xee, 0x84, 0xd6, 0x10,
+	0x2f, 0x2e, 0x97, 0xa9, 0xff, 0xe9, 0x02, 0xe4, 0xef, 0x8b, 0x3f, 0x39, 0xd0, 0x4f, 0x83, 0x12,
+	0xde, 0x0c, 0xdf, 0x8f, 0xd1, 0xe4, 0x28, 0x13, 0xc0, 0xd0, 0xe3, 0x32, 0x7d, 0x02, 0x85, 0xd7,
+	0x05, 0xb4, 0xd7, 0xd1, 0xf5, 0x24, 0xb4, 0xe0, 0xef, 0x16, 0xf1, 0xcf, 0x89, 0xd1, 0x8a, 0xbc,
+	0x07, 0xd7, 0x39, 0x8b, 0x4f, 0x5e, 0xfe, 0x83, 0xe3, 0x29, 0x6d, 0x64, 0x84, 0xdf, 0x10, 0x80,
+	0x6e, 0xa0, 0xd7, 0x52, 0x01, 0xf1, 0x07, 0x79, 0x8d, 0x86, 0xae, 0xbf, 0x05, 0xc0, 0x4b, 0x22,
+	0x18, 0xfc, 0x64, 0x02, 0xa9, 0x0d, 0x03, 0x32, 0x30, 0x39, 0xc2, 0xd7, 0x05, 0x86, 0x45, 0xb4,
+	0x90, 0x8a, 0xe1, 0x58, 0xba, 0xfb, 0x58, 0x83, 0xa9, 0xc7, 0xcc, 0xa3, 0xa4, 0xb7, 0x19, 0xce,
+	0xa3, 0x32, 0x31, 0x5c, 0x8f, 0x30, 0x04, 0x69, 0xaf, 0x7a, 0x6e, 0xab, 0x7a, 0x7c, 0xbb, 0x1a,
+	0x1f, 0x3f, 0xe0, 0x9a, 0x70, 0x7c, 0x13, 0xad, 0x64, 0x2f, 0x3e, 0x3c, 0xa7, 0xb9, 0xe3, 0x5b,
+	0x1a, 0xfa, 0x91, 0x06, 0xd3, 0x01, 0x8a, 0xa7, 0xf1, 0x51, 0x5a, 0x26, 0x90, 0xf5, 0xb3, 0x64,
+	0x25, 0x04, 0x54, 0x17, 0x80, 0xd6, 0xd1, 0x5a, 0x36, 0xa0, 0x88, 0xaa, 0x30, 0xd5, 0xff, 0x95,
+	0x83, 0x51, 0x3e, 0x43, 0xe5, 0xf9, 0x89, 0xe6, 0x36, 0x99, 0x90, 0xea, 0xc3, 0x20, 0x9d, 0x9e,
+	0xfd, 0xe0, 0x6b, 0x02, 0xd8, 0x15, 0x74, 0x39, 0x09, 0xcc, 0xb2, 0x2d, 0x66, 0x91, 0xae, 0xf5,
+	0x92, 0x9a, 0xe8, 0x23, 0x0d, 0x2e, 0x3c, 0x70, 0xda, 0x96, 0x8d, 0xde, 0x18, 0xfa, 0x16, 0x8c,
+	0x06, 0xca, 0x95, 0xf5, 0xb3, 0x09, 0x27, 0x1b, 0x22, 0x3c, 0x9d, 0xc4, 0xd1, 0xe5, 0x7e, 0x79,
+	0x93, 0xf1, 0x89, 0x06, 0x79, 0x3e, 0x8c, 0xea, 0xbb, 0xff, 0x4d, 0x14, 0x4b, 0x02, 0xc5, 0x65,
+	0x3c, 0xd0, 0x84, 0xfb, 0xc2, 0x31, 0x87, 0xf1, 0x4d, 0xc8, 0x3f, 0x70, 0xda, 0x4e, 0x9f, 0x65,
+	0x26, 0x21, 0x83, 0x9e, 0x65, 0xba, 0x2b, 0xac, 0xf1, 0xe6, 0xe5, 0x8f, 0x39, 0x18, 0xdf, 0xa5,
+	0x27, 0x0f, 0xc5, 0x0b, 0xaa, 0x47, 0x6d, 0x86, 0x4e, 0x60, 0x3c, 0x31, 0x31, 0xc9, 0xf4, 0xf9,
+	0xd6, 0x2b, 0x0d, 0x5e, 0xf0, 0x65, 0x01, 0x69, 0x1a, 0x5d, 0xaa, 0x05, 0x7f, 0xf3, 0x46, 0x2d,
+	0x33, 0x3f, 0x3a, 0x4b, 0xf1, 0xf9, 0x03, 0x3a, 0xcb, 0xa4, 0xe1, 0x54, 0x67, 0xff, 0xe6, 0xf9,
+	0x94, 0x92, 0x2f, 0x21, 0x7c, 0x1a, 0x16, 0xcf, 0xc0, 0x2f, 0x35, 0x98, 0x1c, 0x78, 0xcb, 0x0f,
+	0x7f, 0x79, 0xa4, 0x4f, 0x0c, 0x2a, 0xf7, 0xce, 0xad, 0x97, 0x84, 0xb8, 0x96, 0x0a, 0x71, 0xb3,
+	0xf4, 0xf9, 0x17, 0x8b, 0xda, 0x9f, 0xbf, 0x58, 0xd4, 0xfe, 0xfe, 0xc5, 0xa2, 0x76, 0x98, 0x17,
+	0xc9, 0xba, 0xf3, 0xef, 0x01, 0x00, 0x08, 0x9c, 0x1d, 0x4c, 0xda, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WalletClient is the client API for Wallet service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WalletClient interface {
+	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
+	WalletConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WalletResponse, error)
+	GenerateMnemonic(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error)
+	ImportKeystores(ctx context.Context, in *ImportKeystoresRequest, opts ...grpc.CallOption) (*ImportKeystoresResponse, error)
+}
+
+type walletClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletClient(cc *grpc.ClientConn) WalletClient {
+	return &walletClient{cc}
+}
+
+func (c *walletClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
+	out := new(CreateWalletResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/CreateWallet", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *beaconClient) GetValidatorQueue(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1.ValidatorQueue, error) {
-	out := new(v1alpha1.ValidatorQueue)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorQueue", in, out, opts...)
+func (c *walletClient) WalletConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WalletResponse, error) {
+	out := new(WalletResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/WalletConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *beaconClient) GetPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1.Peers, error) {
-	out := new(v1alpha1.Peers)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetPeers", in, out, opts...)
+func (c *walletClient) GenerateMnemonic(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error) {
+	out := new(GenerateMnemonicResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// BeaconServer is the server API for Beacon service.
-type BeaconServer interface {
-	GetBeaconStatus(context.Context, *empty.Empty) (*BeaconStatusResponse, error)
-	GetValidatorParticipation(context.Context, *v1alpha1.GetValidatorParticipationRequest) (*v1alpha1.ValidatorParticipationResponse, error)
-	GetValidatorPerformance(context.Context, *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error)
-	GetValidators(context.Context, *v1alpha1.ListValidatorsRequest) (*v1alpha1.Validators, error)
-	GetValidatorBalances(context.Context, *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error)
-	GetValidatorQueue(context.Context, *empty.Empty) (*v1alpha1.ValidatorQueue, error)
-	GetPeers(context.Context, *empty.Empty) (*v1alpha1.Peers, error)
+func (c *walletClient) ImportKeystores(ctx context.Context, in *ImportKeystoresRequest, opts ...grpc.CallOption) (*ImportKeystoresResponse, error) {
+	out := new(ImportKeystoresResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/ImportKeystores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedBeaconServer can be embedded to have forward compatible implementations.
-type UnimplementedBeaconServer struct {
+// WalletServer is the server API for Wallet service.
+type WalletServer interface {
+	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
+	WalletConfig(context.Context, *empty.Empty) (*WalletResponse, error)
+	GenerateMnemonic(context.Context, *empty.Empty) (*GenerateMnemonicResponse, error)
+	ImportKeystores(context.Context, *ImportKeystoresRequest) (*ImportKeystoresResponse, error)
 }
 
-func (*UnimplementedBeaconServer) GetBeaconStatus(ctx context.Context, req *empty.Empty) (*BeaconStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconStatus not implemented")
-}
-func (*UnimplementedBeaconServer) GetValidatorParticipation(ctx context.Context, req *v1alpha1.GetValidatorParticipationRequest) (*v1alpha1.ValidatorParticipationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorParticipation not implemented")
-}
-func (*UnimplementedBeaconServer) GetValidatorPerformance(ctx context.Context, req *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformance not implemented")
-}
-func (*UnimplementedBeaconServer) GetValidators(ctx context.Context, req *v1alpha1.ListValidatorsRequest) (*v1alpha1.Validators, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidators not implemented")
-}
-func (*UnimplementedBeaconServer) GetValidatorBalances(ctx context.Context, req *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorBalances not implemented")
-}
-func (*UnimplementedBeaconServer) GetValidatorQueue(ctx context.Context, req *empty.Empty) (*v1alpha1.ValidatorQueue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorQueue not implemented")
-}
-func (*UnimplementedBeaconServer) GetPeers(ctx context.Context, req *empty.Empty) (*v1alpha1.Peers, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeers not implemented")
+// UnimplementedWalletServer can be embedded to have forward compatible implementations.
+type UnimplementedWalletServer struct {
 }
 
-func RegisterBeaconServer(s *grpc.Server, srv BeaconServer) {
-	s.RegisterService(&_Beacon_serviceDesc, srv)
+func (*UnimplementedWalletServer) CreateWallet(ctx context.Context, req *CreateWalletRequest) (*CreateWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
 }
-
-func _Beacon_GetBeaconStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconServer).GetBeaconStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetBeaconStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetBeaconStatus(ctx, req.(*empty.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedWalletServer) WalletConfig(ctx context.Context, req *empty.Empty) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletConfig not implemented")
 }
-
-func _Beacon_GetValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.GetValidatorParticipationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconServer).GetValidatorParticipation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorParticipation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetValidatorParticipation(ctx, req.(*v1alpha1.GetValidatorParticipationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedWalletServer) GenerateMnemonic(ctx context.Context, req *empty.Empty) (*GenerateMnemonicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateMnemonic not implemented")
+}
+func (*UnimplementedWalletServer) ImportKeystores(ctx context.Context, req *ImportKeystoresRequest) (*ImportKeystoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKeystores not implemented")
 }
 
-func _Beacon_GetValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.ValidatorPerformanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconServer).GetValidatorPerformance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorPerformance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetValidatorPerformance(ctx, req.(*v1alpha1.ValidatorPerformanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterWalletServer(s *grpc.Server, srv WalletServer) {
+	s.RegisterService(&_Wallet_serviceDesc, srv)
 }
 
-func _Beacon_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.ListValidatorsRequest)
+func _Wallet_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWalletRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServer).GetValidators(ctx, in)
+		return srv.(WalletServer).CreateWallet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidators",
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/CreateWallet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetValidators(ctx, req.(*v1alpha1.ListValidatorsRequest))
+		return srv.(WalletServer).CreateWallet(ctx, req.(*CreateWalletRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Beacon_GetValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.ListValidatorBalancesRequest)
+func _Wallet_WalletConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServer).GetValidatorBalances(ctx, in)
+		return srv.(WalletServer).WalletConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorBalances",
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/WalletConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetValidatorBalances(ctx, req.(*v1alpha1.ListValidatorBalancesRequest))
+		return srv.(WalletServer).WalletConfig(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Beacon_GetValidatorQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Wallet_GenerateMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServer).GetValidatorQueue(ctx, in)
+		return srv.(WalletServer).GenerateMnemonic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorQueue",
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetValidatorQueue(ctx, req.(*empty.Empty))
+		return srv.(WalletServer).GenerateMnemonic(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Beacon_GetPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _Wallet_ImportKeystores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportKeystoresRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServer).GetPeers(ctx, in)
+		return srv.(WalletServer).ImportKeystores(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetPeers",
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/ImportKeystores",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServer).GetPeers(ctx, req.(*empty.Empty))
+		return srv.(WalletServer).ImportKeystores(ctx, req.(*ImportKeystoresRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Beacon_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Beacon",
-	HandlerType: (*BeaconServer)(nil),
+var _Wallet_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Wallet",
+	HandlerType: (*WalletServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetBeaconStatus",
-			Handler:    _Beacon_GetBeaconStatus_Handler,
-		},
-		{
-			MethodName: "GetValidatorParticipation",
-			Handler:    _Beacon_GetValidatorParticipation_Handler,
+			MethodName: "CreateWallet",
+			Handler:    _Wallet_CreateWallet_Handler,
 		},
 		{
-			MethodName: "GetValidatorPerformance",
-			Handler:    _Beacon_GetValidatorPerformance_Handler,
+			MethodName: "WalletConfig",
+			Handler:    _Wallet_WalletConfig_Handler,
 		},
 		{
-			MethodName: "GetValidators",
-			Handler:    _Beacon_GetValidators_Handler,
+			MethodName: "GenerateMnemonic",
+			Handler:    _Wallet_GenerateMnemonic_Handler,
 		},
 		{
-			MethodName: "GetValidatorBalances",
-			Handler:    _Beacon_GetValidatorBalances_Handler,
-		},
-		{
-			MethodName: "GetValidatorQueue",
-			Handler:    _Beacon_GetValidatorQueue_Handler,
-		},
-		{
-			MethodName: "GetPeers",
-			Handler:    _Beacon_GetPeers_Handler,
+			MethodName: "ImportKeystores",
+			Handler:    _Wallet_ImportKeystores_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
 
-// HealthClient is the client API for Health service.
+// AccountsClient is the client API for Accounts service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type HealthClient interface {
-	GetBeaconNodeConnection(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error)
-	GetLogsEndpoints(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LogsEndpointResponse, error)
-	GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
-	StreamBeaconLogs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Health_StreamBeaconLogsClient, error)
-	StreamValidatorLogs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Health_StreamValidatorLogsClient, error)
+type AccountsClient interface {
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	BackupAccounts(ctx context.Context, in *BackupAccountsRequest, opts ...grpc.CallOption) (*BackupAccountsResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
-type healthClient struct {
+type accountsClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewHealthClient(cc *grpc.ClientConn) HealthClient {
-	return &healthClient{cc}
+func NewAccountsClient(cc *grpc.ClientConn) AccountsClient {
+	return &accountsClient{cc}
 }
 
-func (c *healthClient) GetBeaconNodeConnection(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error) {
-	out := new(NodeConnectionResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection", in, out, opts...)
+func (c *accountsClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ListAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *healthClient) GetLogsEndpoints(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LogsEndpointResponse, error) {
-	out := new(LogsEndpointResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetLogsEndpoints", in, out, opts...)
+func (c *accountsClient) BackupAccounts(ctx context.Context, in *BackupAccountsRequest, opts ...grpc.CallOption) (*BackupAccountsResponse, error) {
+	out := new(BackupAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/BackupAccounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *healthClient) GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
-	out := new(VersionResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetVersion", in, out, opts...)
+func (c *accountsClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ChangePassword", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *healthClient) StreamBeaconLogs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Health_StreamBeaconLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Health_serviceDesc.Streams[0], "/ethereum.validator.accounts.v2.Health/StreamBeaconLogs", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &healthStreamBeaconLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type Health_StreamBeaconLogsClient interface {
-	Recv() (*v1.LogsResponse, error)
-	grpc.ClientStream
-}
-
-type healthStreamBeaconLogsClient struct {
-	grpc.ClientStream
-}
-
-func (x *healthStreamBeaconLogsClient) Recv() (*v1.LogsResponse, error) {
-	m := new(v1.LogsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func (c *healthClient) StreamValidatorLogs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Health_StreamValidatorLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Health_serviceDesc.Streams[1], "/ethereum.validator.accounts.v2.Health/StreamValidatorLogs", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &healthStreamValidatorLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type Health_StreamValidatorLogsClient interface {
-	Recv() (*LogsResponse, error)
-	grpc.ClientStream
-}
-
-type healthStreamValidatorLogsClient struct {
-	grpc.ClientStream
-}
-
-func (x *healthStreamValidatorLogsClient) Recv() (*LogsResponse, error) {
-	m := new(LogsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-// HealthServer is the server API for Health service.
-type HealthServer interface {
-	GetBeaconNodeConnection(context.Context, *empty.Empty) (*NodeConnectionResponse, error)
-	GetLogsEndpoints(context.Context, *empty.Empty) (*LogsEndpointResponse, error)
-	GetVersion(context.Context, *empty.Empty) (*VersionResponse, error)
-	StreamBeaconLogs(*empty.Empty, Health_StreamBeaconLogsServer) error
-	StreamValidatorLogs(*empty.Empty, Health_StreamValidatorLogsServer) error
+// AccountsServer is the server API for Accounts service.
+type AccountsServer interface {
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	BackupAccounts(context.Context, *BackupAccountsRequest) (*BackupAccountsResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error)
 }
 
-// UnimplementedHealthServer can be embedded to have forward compatible implementations.
-type UnimplementedHealthServer struct {
+// UnimplementedAccountsServer can be embedded to have forward compatible implementations.
+type UnimplementedAccountsServer struct {
 }
 
-func (*UnimplementedHealthServer) GetBeaconNodeConnection(ctx context.Context, req *empty.Empty) (*NodeConnectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconNodeConnection not implemented")
-}
-func (*UnimplementedHealthServer) GetLogsEndpoints(ctx context.Context, req *empty.Empty) (*LogsEndpointResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLogsEndpoints not implemented")
-}
-func (*UnimplementedHealthServer) GetVersion(ctx context.Context, req *empty.Empty) (*VersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+func (*UnimplementedAccountsServer) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
 }
-func (*UnimplementedHealthServer) StreamBeaconLogs(req *empty.Empty, srv Health_StreamBeaconLogsServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamBeaconLogs not implemented")
+func (*UnimplementedAccountsServer) BackupAccounts(ctx context.Context, req *BackupAccountsRequest) (*BackupAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupAccounts not implemented")
 }
-func (*UnimplementedHealthServer) StreamValidatorLogs(req *empty.Empty, srv Health_StreamValidatorLogsServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamValidatorLogs not implemented")
+func (*UnimplementedAccountsServer) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
 
-func RegisterHealthServer(s *grpc.Server, srv HealthServer) {
-	s.RegisterService(&_Health_serviceDesc, srv)
+func RegisterAccountsServer(s *grpc.Server, srv AccountsServer) {
+	s.RegisterService(&_Accounts_serviceDesc, srv)
 }
 
-func _Health_GetBeaconNodeConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _Accounts_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServer).GetBeaconNodeConnection(ctx, in)
+		return srv.(AccountsServer).ListAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection",
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ListAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServer).GetBeaconNodeConnection(ctx, req.(*empty.Empty))
+		return srv.(AccountsServer).ListAccounts(ctx, req.(*ListAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Health_GetLogsEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _Accounts_BackupAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServer).GetLogsEndpoints(ctx, in)
+		return srv.(AccountsServer).BackupAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Health/GetLogsEndpoints",
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/BackupAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServer).GetLogsEndpoints(ctx, req.(*empty.Empty))
+		return srv.(AccountsServer).BackupAccounts(ctx, req.(*BackupAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Health_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _Accounts_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthServer).GetVersion(ctx, in)
+		return srv.(AccountsServer).ChangePassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Health/GetVersion",
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ChangePassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServer).GetVersion(ctx, req.(*empty.Empty))
+		return srv.(AccountsServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Health_StreamBeaconLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(HealthServer).StreamBeaconLogs(m, &healthStreamBeaconLogsServer{stream})
+var _Accounts_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Accounts",
+	HandlerType: (*AccountsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAccounts",
+			Handler:    _Accounts_ListAccounts_Handler,
+		},
+		{
+			MethodName: "BackupAccounts",
+			Handler:    _Accounts_BackupAccounts_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _Accounts_ChangePassword_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
 
-type Health_StreamBeaconLogsServer interface {
-	Send(*v1.LogsResponse) error
-	grpc.ServerStream
+// BeaconClient is the client API for Beacon service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconClient interface {
+	GetBeaconStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BeaconStatusResponse, error)
+	GetValidatorParticipation(ctx context.Context, in *v1alpha1.GetValidatorParticipationRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorParticipationResponse, error)
+	GetValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error)
+	GetValidators(ctx context.Context, in *v1alpha1.ListValidatorsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error)
+	GetValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error)
+	GetValidatorQueue(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1.ValidatorQueue, error)
+	GetPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1.Peers, error)
 }
 
-type healthStreamBeaconLogsServer struct {
-	grpc.ServerStream
+type beaconClient struct {
+	cc *grpc.ClientConn
 }
 
-func (x *healthStreamBeaconLogsServer) Send(m *v1.LogsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func NewBeaconClient(cc *grpc.ClientConn) BeaconClient {
+	return &beaconClient{cc}
 }
 
-func _Health_StreamValidatorLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *beaconClient) GetBeaconStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BeaconStatusResponse, error) {
+	out := new(BeaconStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetBeaconStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(HealthServer).StreamValidatorLogs(m, &healthStreamValidatorLogsServer{stream})
-}
-
-type Health_StreamValidatorLogsServer interface {
-	Send(*LogsResponse) error
-	grpc.ServerStream
+	return out, nil
 }
 
-type healthStreamValidatorLogsServer struct {
-	grpc.ServerStream
+func (c *beaconClient) GetValidatorParticipation(ctx context.Context, in *v1alpha1.GetValidatorParticipationRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorParticipationResponse, error) {
+	out := new(v1alpha1.ValidatorParticipationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorParticipation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *healthStreamValidatorLogsServer) Send(m *LogsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (c *beaconClient) GetValidatorPerformance(ctx context.Context, in *v1alpha1.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorPerformanceResponse, error) {
+	out := new(v1alpha1.ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-var _Health_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Health",
-	HandlerType: (*HealthServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetBeaconNodeConnection",
-			Handler:    _Health_GetBeaconNodeConnection_Handler,
-		},
-		{
-			MethodName: "GetLogsEndpoints",
-			Handler:    _Health_GetLogsEndpoints_Handler,
-		},
-		{
-			MethodName: "GetVersion",
-			Handler:    _Health_GetVersion_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "StreamBeaconLogs",
-			Handler:       _Health_StreamBeaconLogs_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "StreamValidatorLogs",
-			Handler:       _Health_StreamValidatorLogs_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "proto/validator/accounts/v2/web_api.proto",
-}
-
-// AuthClient is the client API for Auth service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AuthClient interface {
-	HasUsedWeb(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HasUsedWebResponse, error)
-	Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
-	Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
-	Logout(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
-}
-
-type authClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewAuthClient(cc *grpc.ClientConn) AuthClient {
-	return &authClient{cc}
-}
-
-func (c *authClient) HasUsedWeb(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HasUsedWebResponse, error) {
-	out := new(HasUsedWebResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/HasUsedWeb", in, out, opts...)
+func (c *beaconClient) GetValidators(ctx context.Context, in *v1alpha1.ListValidatorsRequest, opts ...grpc.CallOption) (*v1alpha1.Validators, error) {
+	out := new(v1alpha1.Validators)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidators", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authClient) Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
-	out := new(AuthResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Login", in, out, opts...)
+func (c *beaconClient) GetValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error) {
+	out := new(v1alpha1.ValidatorBalances)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorBalances", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authClient) Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
-	out := new(AuthResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Signup", in, out, opts...)
+func (c *beaconClient) GetValidatorQueue(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1.ValidatorQueue, error) {
+	out := new(v1alpha1.ValidatorQueue)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetValidatorQueue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *authClient) Logout(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Logout", in, out, opts...)
+func (c *beaconClient) GetPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1.Peers, error) {
+	out := new(v1alpha1.Peers)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetPeers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// AuthServer is the server API for Auth service.
-type AuthServer interface {
-	HasUsedWeb(context.Context, *empty.Empty) (*HasUsedWebResponse, error)
-	Login(context.Context, *AuthRequest) (*AuthResponse, error)
-	Signup(context.Context, *AuthRequest) (*AuthResponse, error)
-	Logout(context.Context, *empty.Empty) (*empty.Empty, error)
+// BeaconServer is the server API for Beacon service.
+type BeaconServer interface {
+	GetBeaconStatus(context.Context, *empty.Empty) (*BeaconStatusResponse, error)
+	GetValidatorParticipation(context.Context, *v1alpha1.GetValidatorParticipationRequest) (*v1alpha1.ValidatorParticipationResponse, error)
+	GetValidatorPerformance(context.Context, *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error)
+	GetValidators(context.Context, *v1alpha1.ListValidatorsRequest) (*v1alpha1.Validators, error)
+	GetValidatorBalances(context.Context, *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error)
+	GetValidatorQueue(context.Context, *empty.Empty) (*v1alpha1.ValidatorQueue, error)
+	GetPeers(context.Context, *empty.Empty) (*v1alpha1.Peers, error)
 }
 
-// UnimplementedAuthServer can be embedded to have forward compatible implementations.
-type UnimplementedAuthServer struct {
+// UnimplementedBeaconServer can be embedded to have forward compatible implementations.
+type UnimplementedBeaconServer struct {
 }
 
-func (*UnimplementedAuthServer) HasUsedWeb(ctx context.Context, req *empty.Empty) (*HasUsedWebResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HasUsedWeb not implemented")
+func (*UnimplementedBeaconServer) GetBeaconStatus(ctx context.Context, req *empty.Empty) (*BeaconStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconStatus not implemented")
 }
-func (*UnimplementedAuthServer) Login(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+func (*UnimplementedBeaconServer) GetValidatorParticipation(ctx context.Context, req *v1alpha1.GetValidatorParticipationRequest) (*v1alpha1.ValidatorParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorParticipation not implemented")
 }
-func (*UnimplementedAuthServer) Signup(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Signup not implemented")
+func (*UnimplementedBeaconServer) GetValidatorPerformance(ctx context.Context, req *v1alpha1.ValidatorPerformanceRequest) (*v1alpha1.ValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorPerformance not implemented")
 }
-func (*UnimplementedAuthServer) Logout(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+func (*UnimplementedBeaconServer) GetValidators(ctx context.Context, req *v1alpha1.ListValidatorsRequest) (*v1alpha1.Validators, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidators not implemented")
+}
+func (*UnimplementedBeaconServer) GetValidatorBalances(ctx context.Context, req *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorBalances not implemented")
+}
+func (*UnimplementedBeaconServer) GetValidatorQueue(ctx context.Context, req *empty.Empty) (*v1alpha1.ValidatorQueue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorQueue not implemented")
+}
+func (*UnimplementedBeaconServer) GetPeers(ctx context.Context, req *empty.Empty) (*v1alpha1.Peers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeers not implemented")
 }
 
-func RegisterAuthServer(s *grpc.Server, srv AuthServer) {
-	s.RegisterService(&_Auth_serviceDesc, srv)
+func RegisterBeaconServer(s *grpc.Server, srv BeaconServer) {
+	s.RegisterService(&_Beacon_serviceDesc, srv)
 }
 
-func _Auth_HasUsedWeb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Beacon_GetBeaconStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).HasUsedWeb(ctx, in)
+		return srv.(BeaconServer).GetBeaconStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Auth/HasUsedWeb",
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetBeaconStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).HasUsedWeb(ctx, req.(*empty.Empty))
+		return srv.(BeaconServer).GetBeaconStatus(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthRequest)
+func _Beacon_GetValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.GetValidatorParticipationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).Login(ctx, in)
+		return srv.(BeaconServer).GetValidatorParticipation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Auth/Login",
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorParticipation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).Login(ctx, req.(*AuthRequest))
+		return srv.(BeaconServer).GetValidatorParticipation(ctx, req.(*v1alpha1.GetValidatorParticipationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_Signup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthRequest)
+func _Beacon_GetValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ValidatorPerformanceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).Signup(ctx, in)
+		return srv.(BeaconServer).GetValidatorPerformance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Auth/Signup",
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorPerformance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).Signup(ctx, req.(*AuthRequest))
+		return srv.(BeaconServer).GetValidatorPerformance(ctx, req.(*v1alpha1.ValidatorPerformanceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Auth_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Beacon_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ListValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServer).GetValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServer).GetValidators(ctx, req.(*v1alpha1.ListValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Beacon_GetValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.ListValidatorBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServer).GetValidatorBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServer).GetValidatorBalances(ctx, req.(*v1alpha1.ListValidatorBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Beacon_GetValidatorQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AuthServer).Logout(ctx, in)
+		return srv.(BeaconServer).GetValidatorQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Auth/Logout",
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetValidatorQueue",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).Logout(ctx, req.(*empty.Empty))
+		return srv.(BeaconServer).GetValidatorQueue(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Auth_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Auth",
-	HandlerType: (*AuthServer)(nil),
+func _Beacon_GetPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServer).GetPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServer).GetPeers(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Beacon_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Beacon",
+	HandlerType: (*BeaconServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "HasUsedWeb",
-			Handler:    _Auth_HasUsedWeb_Handler,
+			MethodName: "GetBeaconStatus",
+			Handler:    _Beacon_GetBeaconStatus_Handler,
 		},
 		{
-			MethodName: "Login",
-			Handler:    _Auth_Login_Handler,
+			MethodName: "GetValidatorParticipation",
+			Handler:    _Beacon_GetValidatorParticipation_Handler,
 		},
 		{
-			MethodName: "Signup",
-			Handler:    _Auth_Signup_Handler,
+			MethodName: "GetValidatorPerformance",
+			Handler:    _Beacon_GetValidatorPerformance_Handler,
 		},
 		{
-			MethodName: "Logout",
-			Handler:    _Auth_Logout_Handler,
+			MethodName: "GetValidators",
+			Handler:    _Beacon_GetValidators_Handler,
+		},
+		{
+			MethodName: "GetValidatorBalances",
+			Handler:    _Beacon_GetValidatorBalances_Handler,
+		},
+		{
+			MethodName: "GetValidatorQueue",
+			Handler:    _Beacon_GetValidatorQueue_Handler,
+		},
+		{
+			MethodName: "GetPeers",
+			Handler:    _Beacon_GetPeers_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
 
-func (m *CreateWalletRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateWalletRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// HealthClient is the client API for Health service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HealthClient interface {
+	GetBeaconNodeConnection(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error)
+	GetLogsEndpoints(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LogsEndpointResponse, error)
+	GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*VersionResponse, error)
+	StreamBeaconLogs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Health_StreamBeaconLogsClient, error)
+	StreamValidatorLogs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Health_StreamValidatorLogsClient, error)
 }
 
-func (m *CreateWalletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RemoteCaCrtPath) > 0 {
-		i -= len(m.RemoteCaCrtPath)
-		copy(dAtA[i:], m.RemoteCaCrtPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCaCrtPath)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.RemoteKeyPath) > 0 {
-		i -= len(m.RemoteKeyPath)
-		copy(dAtA[i:], m.RemoteKeyPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteKeyPath)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.RemoteCrtPath) > 0 {
-		i -= len(m.RemoteCrtPath)
-		copy(dAtA[i:], m.RemoteCrtPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCrtPath)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.RemoteAddr) > 0 {
-		i -= len(m.RemoteAddr)
-		copy(dAtA[i:], m.RemoteAddr)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteAddr)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.NumAccounts != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.NumAccounts))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Mnemonic) > 0 {
-		i -= len(m.Mnemonic)
-		copy(dAtA[i:], m.Mnemonic)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Mnemonic)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.WalletPassword) > 0 {
-		i -= len(m.WalletPassword)
-		copy(dAtA[i:], m.WalletPassword)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.WalletPassword)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Keymanager != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.Keymanager))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type healthClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *CreateWalletResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewHealthClient(cc *grpc.ClientConn) HealthClient {
+	return &healthClient{cc}
+}
+
+func (c *healthClient) GetBeaconNodeConnection(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error) {
+	out := new(NodeConnectionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CreateWalletResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *healthClient) GetLogsEndpoints(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LogsEndpointResponse, error) {
+	out := new(LogsEndpointResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetLogsEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CreateWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Wallet != nil {
-		{
-			size, err := m.Wallet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWebApi(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *healthClient) GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *EditWalletConfigRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *healthClient) StreamBeaconLogs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Health_StreamBeaconLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Health_serviceDesc.Streams[0], "/ethereum.validator.accounts.v2.Health/StreamBeaconLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &healthStreamBeaconLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *EditWalletConfigRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Health_StreamBeaconLogsClient interface {
+	Recv() (*v1.LogsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *EditWalletConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RemoteCaCrtPath) > 0 {
-		i -= len(m.RemoteCaCrtPath)
-		copy(dAtA[i:], m.RemoteCaCrtPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCaCrtPath)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RemoteKeyPath) > 0 {
-		i -= len(m.RemoteKeyPath)
-		copy(dAtA[i:], m.RemoteKeyPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteKeyPath)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RemoteCrtPath) > 0 {
-		i -= len(m.RemoteCrtPath)
-		copy(dAtA[i:], m.RemoteCrtPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCrtPath)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RemoteAddr) > 0 {
-		i -= len(m.RemoteAddr)
-		copy(dAtA[i:], m.RemoteAddr)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteAddr)))
-		i--
-		dAtA[i] = 0xa
+type healthStreamBeaconLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *healthStreamBeaconLogsClient) Recv() (*v1.LogsResponse, error) {
+	m := new(v1.LogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *GenerateMnemonicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *healthClient) StreamValidatorLogs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Health_StreamValidatorLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Health_serviceDesc.Streams[1], "/ethereum.validator.accounts.v2.Health/StreamValidatorLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &healthStreamValidatorLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *GenerateMnemonicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Health_StreamValidatorLogsClient interface {
+	Recv() (*LogsResponse, error)
+	grpc.ClientStream
 }
 
-func (m *GenerateMnemonicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Mnemonic) > 0 {
-		i -= len(m.Mnemonic)
-		copy(dAtA[i:], m.Mnemonic)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Mnemonic)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type healthStreamValidatorLogsClient struct {
+	grpc.ClientStream
 }
 
-func (m *WalletResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *healthStreamValidatorLogsClient) Recv() (*LogsResponse, error) {
+	m := new(LogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *WalletResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// HealthServer is the server API for Health service.
+type HealthServer interface {
+	GetBeaconNodeConnection(context.Context, *empty.Empty) (*NodeConnectionResponse, error)
+	GetLogsEndpoints(context.Context, *empty.Empty) (*LogsEndpointResponse, error)
+	GetVersion(context.Context, *empty.Empty) (*VersionResponse, error)
+	StreamBeaconLogs(*empty.Empty, Health_StreamBeaconLogsServer) error
+	StreamValidatorLogs(*empty.Empty, Health_StreamValidatorLogsServer) error
 }
 
-func (m *WalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.KeymanagerKind != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.KeymanagerKind))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.WalletPath) > 0 {
-		i -= len(m.WalletPath)
-		copy(dAtA[i:], m.WalletPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.WalletPath)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedHealthServer can be embedded to have forward compatible implementations.
+type UnimplementedHealthServer struct {
 }
 
-func (m *ListAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedHealthServer) GetBeaconNodeConnection(ctx context.Context, req *empty.Empty) (*NodeConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconNodeConnection not implemented")
+}
+func (*UnimplementedHealthServer) GetLogsEndpoints(ctx context.Context, req *empty.Empty) (*LogsEndpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogsEndpoints not implemented")
+}
+func (*UnimplementedHealthServer) GetVersion(ctx context.Context, req *empty.Empty) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (*UnimplementedHealthServer) StreamBeaconLogs(req *empty.Empty, srv Health_StreamBeaconLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBeaconLogs not implemented")
+}
+func (*UnimplementedHealthServer) StreamValidatorLogs(req *empty.Empty, srv Health_StreamValidatorLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamValidatorLogs not implemented")
 }
 
-func (m *ListAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterHealthServer(s *grpc.Server, srv HealthServer) {
+	s.RegisterService(&_Health_serviceDesc, srv)
 }
 
-func (m *ListAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.All {
-		i--
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func _Health_GetBeaconNodeConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PageToken) > 0 {
-		i -= len(m.PageToken)
-		copy(dAtA[i:], m.PageToken)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PageToken)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(HealthServer).GetBeaconNodeConnection(ctx, in)
 	}
-	if m.PageSize != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection",
 	}
-	if m.GetDepositTxData {
-		i--
-		if m.GetDepositTxData {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).GetBeaconNodeConnection(ctx, req.(*empty.Empty))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Health_GetLogsEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(HealthServer).GetLogsEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Health/GetLogsEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).GetLogsEndpoints(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _Health_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TotalSize != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.TotalSize))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(HealthServer).GetVersion(ctx, in)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Health/GetVersion",
 	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintWebApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).GetVersion(ctx, req.(*empty.Empty))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Account) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _Health_StreamBeaconLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(HealthServer).StreamBeaconLogs(m, &healthStreamBeaconLogsServer{stream})
 }
 
-func (m *Account) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Health_StreamBeaconLogsServer interface {
+	Send(*v1.LogsResponse) error
+	grpc.ServerStream
 }
 
-func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DerivationPath) > 0 {
-		i -= len(m.DerivationPath)
-		copy(dAtA[i:], m.DerivationPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DerivationPath)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.DepositTxData) > 0 {
-		i -= len(m.DepositTxData)
-		copy(dAtA[i:], m.DepositTxData)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositTxData)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AccountName) > 0 {
-		i -= len(m.AccountName)
-		copy(dAtA[i:], m.AccountName)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.AccountName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatingPublicKey) > 0 {
-		i -= len(m.ValidatingPublicKey)
-		copy(dAtA[i:], m.ValidatingPublicKey)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.ValidatingPublicKey)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type healthStreamBeaconLogsServer struct {
+	grpc.ServerStream
 }
 
-func (m *AccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (x *healthStreamBeaconLogsServer) Send(m *v1.LogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Health_StreamValidatorLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(HealthServer).StreamValidatorLogs(m, &healthStreamValidatorLogsServer{stream})
 }
 
-func (m *AccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Health_StreamValidatorLogsServer interface {
+	Send(*LogsResponse) error
+	grpc.ServerStream
 }
 
-func (m *AccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Indices) > 0 {
-		dAtA3 := make([]byte, len(m.Indices)*10)
-		var j2 int
-		for _, num := range m.Indices {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintWebApi(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PublicKeys) > 0 {
-		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PublicKeys[iNdEx])
-			copy(dAtA[i:], m.PublicKeys[iNdEx])
-			i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type healthStreamValidatorLogsServer struct {
+	grpc.ServerStream
 }
 
-func (m *AuthRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *healthStreamValidatorLogsServer) Send(m *LogsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *AuthRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var _Health_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Health",
+	HandlerType: (*HealthServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBeaconNodeConnection",
+			Handler:    _Health_GetBeaconNodeConnection_Handler,
+		},
+		{
+			MethodName: "GetLogsEndpoints",
+			Handler:    _Health_GetLogsEndpoints_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _Health_GetVersion_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBeaconLogs",
+			Handler:       _Health_StreamBeaconLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamValidatorLogs",
+			Handler:       _Health_StreamValidatorLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
 
-func (m *AuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.PasswordConfirmation) > 0 {
-		i -= len(m.PasswordConfirmation)
-		copy(dAtA[i:], m.PasswordConfirmation)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PasswordConfirmation)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// AuthClient is the client API for Auth service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuthClient interface {
+	HasUsedWeb(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HasUsedWebResponse, error)
+	Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Logout(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
-func (m *AuthResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type authClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuthClient(cc *grpc.ClientConn) AuthClient {
+	return &authClient{cc}
+}
+
+func (c *authClient) HasUsedWeb(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*HasUsedWebResponse, error) {
+	out := new(HasUsedWebResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/HasUsedWeb", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AuthResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *authClient) Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.TokenExpiration != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.TokenExpiration))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
+func (c *authClient) Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Signup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *NodeConnectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *authClient) Logout(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Logout", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *NodeConnectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AuthServer is the server API for Auth service.
+type AuthServer interface {
+	HasUsedWeb(context.Context, *empty.Empty) (*HasUsedWebResponse, error)
+	Login(context.Context, *AuthRequest) (*AuthResponse, error)
+	Signup(context.Context, *AuthRequest) (*AuthResponse, error)
+	Logout(context.Context, *empty.Empty) (*empty.Empty, error)
 }
 
-func (m *NodeConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DepositContractAddress) > 0 {
-		i -= len(m.DepositContractAddress)
-		copy(dAtA[i:], m.DepositContractAddress)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositContractAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.GenesisTime != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.GenesisTime))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Syncing {
-		i--
-		if m.Syncing {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Connected {
-		i--
-		if m.Connected {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.BeaconNodeEndpoint) > 0 {
-		i -= len(m.BeaconNodeEndpoint)
-		copy(dAtA[i:], m.BeaconNodeEndpoint)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconNodeEndpoint)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedAuthServer can be embedded to have forward compatible implementations.
+type UnimplementedAuthServer struct {
 }
 
-func (m *LogsEndpointResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedAuthServer) HasUsedWeb(ctx context.Context, req *empty.Empty) (*HasUsedWebResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasUsedWeb not implemented")
+}
+func (*UnimplementedAuthServer) Login(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedAuthServer) Signup(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signup not implemented")
+}
+func (*UnimplementedAuthServer) Logout(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
 }
 
-func (m *LogsEndpointResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterAuthServer(s *grpc.Server, srv AuthServer) {
+	s.RegisterService(&_Auth_serviceDesc, srv)
 }
 
-func (m *LogsEndpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _Auth_HasUsedWeb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BeaconLogsEndpoint) > 0 {
-		i -= len(m.BeaconLogsEndpoint)
-		copy(dAtA[i:], m.BeaconLogsEndpoint)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconLogsEndpoint)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(AuthServer).HasUsedWeb(ctx, in)
 	}
-	if len(m.ValidatorLogsEndpoint) > 0 {
-		i -= len(m.ValidatorLogsEndpoint)
-		copy(dAtA[i:], m.ValidatorLogsEndpoint)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.ValidatorLogsEndpoint)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/HasUsedWeb",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).HasUsedWeb(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Auth_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(AuthServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).Login(ctx, req.(*AuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Auth_Signup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).Signup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/Signup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).Signup(ctx, req.(*AuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _Auth_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(AuthServer).Logout(ctx, in)
 	}
-	if len(m.Beacon) > 0 {
-		i -= len(m.Beacon)
-		copy(dAtA[i:], m.Beacon)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Beacon)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/Logout",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).Logout(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ChangePasswordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var _Auth_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Auth",
+	HandlerType: (*AuthServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HasUsedWeb",
+			Handler:    _Auth_HasUsedWeb_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Auth_Login_Handler,
+		},
+		{
+			MethodName: "Signup",
+			Handler:    _Auth_Signup_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Auth_Logout_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/validator/accounts/v2/web_api.proto",
+}
+
+// KeyManagementClient is the client API for KeyManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type KeyManagementClient interface {
+	ListKeystores(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListKeystoresResponse, error)
+	AddKeystores(ctx context.Context, in *AddKeystoresRequest, opts ...grpc.CallOption) (*AddKeystoresResponse, error)
+	DeleteKeystores(ctx context.Context, in *DeleteKeystoresRequest, opts ...grpc.CallOption) (*DeleteKeystoresResponse, error)
+}
+
+type keyManagementClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewKeyManagementClient(cc *grpc.ClientConn) KeyManagementClient {
+	return &keyManagementClient{cc}
+}
+
+func (c *keyManagementClient) ListKeystores(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListKeystoresResponse, error) {
+	out := new(ListKeystoresResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.KeyManagement/ListKeystores", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ChangePasswordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *keyManagementClient) AddKeystores(ctx context.Context, in *AddKeystoresRequest, opts ...grpc.CallOption) (*AddKeystoresResponse, error) {
+	out := new(AddKeystoresResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.KeyManagement/AddKeystores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ChangePasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *keyManagementClient) DeleteKeystores(ctx context.Context, in *DeleteKeystoresRequest, opts ...grpc.CallOption) (*DeleteKeystoresResponse, error) {
+	out := new(DeleteKeystoresResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.KeyManagement/DeleteKeystores", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PasswordConfirmation) > 0 {
-		i -= len(m.PasswordConfirmation)
-		copy(dAtA[i:], m.PasswordConfirmation)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PasswordConfirmation)))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+// KeyManagementServer is the server API for KeyManagement service.
+type KeyManagementServer interface {
+	ListKeystores(context.Context, *empty.Empty) (*ListKeystoresResponse, error)
+	AddKeystores(context.Context, *AddKeystoresRequest) (*AddKeystoresResponse, error)
+	DeleteKeystores(context.Context, *DeleteKeystoresRequest) (*DeleteKeystoresResponse, error)
+}
+
+// UnimplementedKeyManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedKeyManagementServer struct {
+}
+
+func (*UnimplementedKeyManagementServer) ListKeystores(ctx context.Context, req *empty.Empty) (*ListKeystoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeystores not implemented")
+}
+func (*UnimplementedKeyManagementServer) AddKeystores(ctx context.Context, req *AddKeystoresRequest) (*AddKeystoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddKeystores not implemented")
+}
+func (*UnimplementedKeyManagementServer) DeleteKeystores(ctx context.Context, req *DeleteKeystoresRequest) (*DeleteKeystoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKeystores not implemented")
+}
+
+func RegisterKeyManagementServer(s *grpc.Server, srv KeyManagementServer) {
+	s.RegisterService(&_KeyManagement_serviceDesc, srv)
+}
+
+func _KeyManagement_ListKeystores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(KeyManagementServer).ListKeystores(ctx, in)
 	}
-	if len(m.CurrentPassword) > 0 {
-		i -= len(m.CurrentPassword)
-		copy(dAtA[i:], m.CurrentPassword)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.CurrentPassword)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.KeyManagement/ListKeystores",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyManagementServer).ListKeystores(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *HasWalletResponse) Marshal() (dAtA []byte, err error) {
+func _KeyManagement_AddKeystores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddKeystoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyManagementServer).AddKeystores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.KeyManagement/AddKeystores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyManagementServer).AddKeystores(ctx, req.(*AddKeystoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyManagement_DeleteKeystores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKeystoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyManagementServer).DeleteKeystores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.KeyManagement/DeleteKeystores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyManagementServer).DeleteKeystores(ctx, req.(*DeleteKeystoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _KeyManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.KeyManagement",
+	HandlerType: (*KeyManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListKeystores",
+			Handler:    _KeyManagement_ListKeystores_Handler,
+		},
+		{
+			MethodName: "AddKeystores",
+			Handler:    _KeyManagement_AddKeystores_Handler,
+		},
+		{
+			MethodName: "DeleteKeystores",
+			Handler:    _KeyManagement_DeleteKeystores_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/validator/accounts/v2/web_api.proto",
+}
+
+func (m *CreateWalletRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3436,12 +3361,12 @@ func (m *HasWalletResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HasWalletResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateWalletRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HasWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateWalletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3450,20 +3375,62 @@ func (m *HasWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WalletExists {
-		i--
-		if m.WalletExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.RemoteCaCrtPath) > 0 {
+		i -= len(m.RemoteCaCrtPath)
+		copy(dAtA[i:], m.RemoteCaCrtPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCaCrtPath)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RemoteKeyPath) > 0 {
+		i -= len(m.RemoteKeyPath)
+		copy(dAtA[i:], m.RemoteKeyPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteKeyPath)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RemoteCrtPath) > 0 {
+		i -= len(m.RemoteCrtPath)
+		copy(dAtA[i:], m.RemoteCrtPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCrtPath)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RemoteAddr) > 0 {
+		i -= len(m.RemoteAddr)
+		copy(dAtA[i:], m.RemoteAddr)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteAddr)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NumAccounts != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.NumAccounts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Mnemonic) > 0 {
+		i -= len(m.Mnemonic)
+		copy(dAtA[i:], m.Mnemonic)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Mnemonic)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WalletPassword) > 0 {
+		i -= len(m.WalletPassword)
+		copy(dAtA[i:], m.WalletPassword)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.WalletPassword)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Keymanager != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.Keymanager))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ImportKeystoresRequest) Marshal() (dAtA []byte, err error) {
+func (m *CreateWalletResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3473,12 +3440,12 @@ func (m *ImportKeystoresRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ImportKeystoresRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateWalletResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ImportKeystoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3487,26 +3454,22 @@ func (m *ImportKeystoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.KeystoresPassword) > 0 {
-		i -= len(m.KeystoresPassword)
-		copy(dAtA[i:], m.KeystoresPassword)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.KeystoresPassword)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.KeystoresImported) > 0 {
-		for iNdEx := len(m.KeystoresImported) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.KeystoresImported[iNdEx])
-			copy(dAtA[i:], m.KeystoresImported[iNdEx])
-			i = encodeVarintWebApi(dAtA, i, uint64(len(m.KeystoresImported[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if m.Wallet != nil {
+		{
+			size, err := m.Wallet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWebApi(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ImportKeystoresResponse) Marshal() (dAtA []byte, err error) {
+func (m *EditWalletConfigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3516,12 +3479,12 @@ func (m *ImportKeystoresResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ImportKeystoresResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EditWalletConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ImportKeystoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EditWalletConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3530,19 +3493,38 @@ func (m *ImportKeystoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ImportedPublicKeys) > 0 {
-		for iNdEx := len(m.ImportedPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ImportedPublicKeys[iNdEx])
-			copy(dAtA[i:], m.ImportedPublicKeys[iNdEx])
-			i = encodeVarintWebApi(dAtA, i, uint64(len(m.ImportedPublicKeys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.RemoteCaCrtPath) > 0 {
+		i -= len(m.RemoteCaCrtPath)
+		copy(dAtA[i:], m.RemoteCaCrtPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCaCrtPath)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RemoteKeyPath) > 0 {
+		i -= len(m.RemoteKeyPath)
+		copy(dAtA[i:], m.RemoteKeyPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteKeyPath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RemoteCrtPath) > 0 {
+		i -= len(m.RemoteCrtPath)
+		copy(dAtA[i:], m.RemoteCrtPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCrtPath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RemoteAddr) > 0 {
+		i -= len(m.RemoteAddr)
+		copy(dAtA[i:], m.RemoteAddr)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HasUsedWebResponse) Marshal() (dAtA []byte, err error) {
+func (m *GenerateMnemonicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3552,12 +3534,12 @@ func (m *HasUsedWebResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HasUsedWebResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GenerateMnemonicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HasUsedWebResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GenerateMnemonicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3566,30 +3548,17 @@ func (m *HasUsedWebResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.HasWallet {
-		i--
-		if m.HasWallet {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.HasSignedUp {
-		i--
-		if m.HasSignedUp {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Mnemonic) > 0 {
+		i -= len(m.Mnemonic)
+		copy(dAtA[i:], m.Mnemonic)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Mnemonic)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LogsResponse) Marshal() (dAtA []byte, err error) {
+func (m *WalletResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3599,12 +3568,12 @@ func (m *LogsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LogsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *WalletResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3613,19 +3582,22 @@ func (m *LogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Logs[iNdEx])
-			copy(dAtA[i:], m.Logs[iNdEx])
-			i = encodeVarintWebApi(dAtA, i, uint64(len(m.Logs[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.KeymanagerKind != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.KeymanagerKind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.WalletPath) > 0 {
+		i -= len(m.WalletPath)
+		copy(dAtA[i:], m.WalletPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.WalletPath)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BeaconStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListAccountsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3635,12 +3607,12 @@ func (m *BeaconStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BeaconStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BeaconStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3649,61 +3621,42 @@ func (m *BeaconStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChainHead != nil {
-		{
-			size, err := m.ChainHead.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWebApi(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.DepositContractAddress) > 0 {
-		i -= len(m.DepositContractAddress)
-		copy(dAtA[i:], m.DepositContractAddress)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositContractAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.GenesisTime != 0 {
-		i = encodeVarintWebApi(dAtA, i, uint64(m.GenesisTime))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Syncing {
+	if m.All {
 		i--
-		if m.Syncing {
+		if m.All {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	if m.Connected {
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PageToken)))
 		i--
-		if m.Connected {
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GetDepositTxData {
+		i--
+		if m.GetDepositTxData {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.BeaconNodeEndpoint) > 0 {
-		i -= len(m.BeaconNodeEndpoint)
-		copy(dAtA[i:], m.BeaconNodeEndpoint)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconNodeEndpoint)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BackupAccountsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListAccountsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3713,12 +3666,12 @@ func (m *BackupAccountsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BackupAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3727,26 +3680,36 @@ func (m *BackupAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.BackupPassword) > 0 {
-		i -= len(m.BackupPassword)
-		copy(dAtA[i:], m.BackupPassword)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BackupPassword)))
+	if m.TotalSize != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.NextPageToken)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.PublicKeys) > 0 {
-		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PublicKeys[iNdEx])
-			copy(dAtA[i:], m.PublicKeys[iNdEx])
-			i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
-			i--
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWebApi(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BackupAccountsResponse) Marshal() (dAtA []byte, err error) {
+func (m *Account) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3756,12 +3719,12 @@ func (m *BackupAccountsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BackupAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Account) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3770,552 +3733,2779 @@ func (m *BackupAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ZipFile) > 0 {
-		i -= len(m.ZipFile)
-		copy(dAtA[i:], m.ZipFile)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.ZipFile)))
+	if len(m.DerivationPath) > 0 {
+		i -= len(m.DerivationPath)
+		copy(dAtA[i:], m.DerivationPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DerivationPath)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DepositTxData) > 0 {
+		i -= len(m.DepositTxData)
+		copy(dAtA[i:], m.DepositTxData)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositTxData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountName) > 0 {
+		i -= len(m.AccountName)
+		copy(dAtA[i:], m.AccountName)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.AccountName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatingPublicKey) > 0 {
+		i -= len(m.ValidatingPublicKey)
+		copy(dAtA[i:], m.ValidatingPublicKey)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.ValidatingPublicKey)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWebApi(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWebApi(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreateWalletRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Keymanager != 0 {
-		n += 1 + sovWebApi(uint64(m.Keymanager))
-	}
-	l = len(m.WalletPassword)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.Mnemonic)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.NumAccounts != 0 {
-		n += 1 + sovWebApi(uint64(m.NumAccounts))
-	}
-	l = len(m.RemoteAddr)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.RemoteCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.RemoteKeyPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.RemoteCaCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if len(m.Indices) > 0 {
+		dAtA3 := make([]byte, len(m.Indices)*10)
+		var j2 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintWebApi(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateWalletResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Wallet != nil {
-		l = m.Wallet.Size()
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AuthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EditWalletConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AuthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RemoteAddr)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.RemoteCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.RemoteKeyPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.RemoteCaCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if len(m.PasswordConfirmation) > 0 {
+		i -= len(m.PasswordConfirmation)
+		copy(dAtA[i:], m.PasswordConfirmation)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PasswordConfirmation)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GenerateMnemonicResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Mnemonic)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AuthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WalletResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AuthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.WalletPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.KeymanagerKind != 0 {
-		n += 1 + sovWebApi(uint64(m.KeymanagerKind))
+	if m.TokenExpiration != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.TokenExpiration))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GetDepositTxData {
-		n += 2
-	}
-	if m.PageSize != 0 {
-		n += 1 + sovWebApi(uint64(m.PageSize))
-	}
-	l = len(m.PageToken)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.All {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *NodeConnectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovWebApi(uint64(l))
-		}
-	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.TotalSize != 0 {
-		n += 1 + sovWebApi(uint64(m.TotalSize))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *NodeConnectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Account) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NodeConnectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatingPublicKey)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.AccountName)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.DepositTxData)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.DerivationPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *AccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.DepositContractAddress) > 0 {
+		i -= len(m.DepositContractAddress)
+		copy(dAtA[i:], m.DepositContractAddress)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositContractAddress)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovWebApi(uint64(l))
+	if m.GenesisTime != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.GenesisTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Syncing {
+		i--
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Indices) > 0 {
-		l = 0
-		for _, e := range m.Indices {
-			l += sovWebApi(uint64(e))
+	if m.Connected {
+		i--
+		if m.Connected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovWebApi(uint64(l)) + l
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.BeaconNodeEndpoint) > 0 {
+		i -= len(m.BeaconNodeEndpoint)
+		copy(dAtA[i:], m.BeaconNodeEndpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconNodeEndpoint)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AuthRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LogsEndpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LogsEndpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogsEndpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.PasswordConfirmation)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if len(m.BeaconLogsEndpoint) > 0 {
+		i -= len(m.BeaconLogsEndpoint)
+		copy(dAtA[i:], m.BeaconLogsEndpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconLogsEndpoint)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ValidatorLogsEndpoint) > 0 {
+		i -= len(m.ValidatorLogsEndpoint)
+		copy(dAtA[i:], m.ValidatorLogsEndpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.ValidatorLogsEndpoint)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AuthResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TokenExpiration != 0 {
-		n += 1 + sovWebApi(uint64(m.TokenExpiration))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Beacon) > 0 {
+		i -= len(m.Beacon)
+		copy(dAtA[i:], m.Beacon)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Beacon)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NodeConnectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChangePasswordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangePasswordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangePasswordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BeaconNodeEndpoint)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Connected {
-		n += 2
+	if len(m.PasswordConfirmation) > 0 {
+		i -= len(m.PasswordConfirmation)
+		copy(dAtA[i:], m.PasswordConfirmation)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PasswordConfirmation)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Syncing {
-		n += 2
-	}
-	if m.GenesisTime != 0 {
-		n += 1 + sovWebApi(uint64(m.GenesisTime))
-	}
-	l = len(m.DepositContractAddress)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.CurrentPassword) > 0 {
+		i -= len(m.CurrentPassword)
+		copy(dAtA[i:], m.CurrentPassword)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.CurrentPassword)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LogsEndpointResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorLogsEndpoint)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.BeaconLogsEndpoint)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *HasWalletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *VersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Beacon)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *HasWalletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChangePasswordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *HasWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CurrentPassword)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.PasswordConfirmation)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.WalletExists {
+		i--
+		if m.WalletExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *HasWalletResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ImportKeystoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportKeystoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportKeystoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.WalletExists {
-		n += 2
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *ImportKeystoresRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.KeystoresPassword) > 0 {
+		i -= len(m.KeystoresPassword)
+		copy(dAtA[i:], m.KeystoresPassword)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.KeystoresPassword)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if len(m.KeystoresImported) > 0 {
-		for _, s := range m.KeystoresImported {
-			l = len(s)
-			n += 1 + l + sovWebApi(uint64(l))
+		for iNdEx := len(m.KeystoresImported) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeystoresImported[iNdEx])
+			copy(dAtA[i:], m.KeystoresImported[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.KeystoresImported[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.KeystoresPassword)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ImportKeystoresResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ImportKeystoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportKeystoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImportKeystoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.ImportedPublicKeys) > 0 {
-		for _, b := range m.ImportedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovWebApi(uint64(l))
+		for iNdEx := len(m.ImportedPublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ImportedPublicKeys[iNdEx])
+			copy(dAtA[i:], m.ImportedPublicKeys[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.ImportedPublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HasUsedWebResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HasUsedWebResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HasUsedWebResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HasUsedWebResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HasSignedUp {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.HasWallet {
-		n += 2
+		i--
+		if m.HasWallet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.HasSignedUp {
+		i--
+		if m.HasSignedUp {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LogsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Logs) > 0 {
-		for _, s := range m.Logs {
-			l = len(s)
-			n += 1 + l + sovWebApi(uint64(l))
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Logs[iNdEx])
+			copy(dAtA[i:], m.Logs[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.Logs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ChainHead != nil {
+		{
+			size, err := m.ChainHead.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWebApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DepositContractAddress) > 0 {
+		i -= len(m.DepositContractAddress)
+		copy(dAtA[i:], m.DepositContractAddress)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DepositContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GenesisTime != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.GenesisTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Syncing {
+		i--
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Connected {
+		i--
+		if m.Connected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BeaconNodeEndpoint) > 0 {
+		i -= len(m.BeaconNodeEndpoint)
+		copy(dAtA[i:], m.BeaconNodeEndpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BeaconNodeEndpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BeaconStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BeaconNodeEndpoint)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Connected {
-		n += 2
+	if len(m.BackupPassword) > 0 {
+		i -= len(m.BackupPassword)
+		copy(dAtA[i:], m.BackupPassword)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.BackupPassword)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Syncing {
-		n += 2
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.GenesisTime != 0 {
-		n += 1 + sovWebApi(uint64(m.GenesisTime))
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.DepositContractAddress)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *BackupAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ChainHead != nil {
-		l = m.ChainHead.Size()
-		n += 1 + l + sovWebApi(uint64(l))
+	if len(m.ZipFile) > 0 {
+		i -= len(m.ZipFile)
+		copy(dAtA[i:], m.ZipFile)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.ZipFile)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListKeystoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListKeystoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListKeystoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWebApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BackupAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListKeystoresResponse_Keystore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListKeystoresResponse_Keystore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListKeystoresResponse_Keystore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Readonly {
+		i--
+		if m.Readonly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DerivationPath) > 0 {
+		i -= len(m.DerivationPath)
+		copy(dAtA[i:], m.DerivationPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.DerivationPath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatingPubkey) > 0 {
+		i -= len(m.ValidatingPubkey)
+		copy(dAtA[i:], m.ValidatingPubkey)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.ValidatingPubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddKeystoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddKeystoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddKeystoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SlashingProtection) > 0 {
+		i -= len(m.SlashingProtection)
+		copy(dAtA[i:], m.SlashingProtection)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.SlashingProtection)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Passwords) > 0 {
+		for iNdEx := len(m.Passwords) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Passwords[iNdEx])
+			copy(dAtA[i:], m.Passwords[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.Passwords[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Keystores) > 0 {
+		for iNdEx := len(m.Keystores) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keystores[iNdEx])
+			copy(dAtA[i:], m.Keystores[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.Keystores[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddKeystoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddKeystoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddKeystoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWebApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddedKeystoreStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddedKeystoreStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddedKeystoreStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteKeystoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteKeystoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteKeystoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Pubkeys) > 0 {
+		for iNdEx := len(m.Pubkeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pubkeys[iNdEx])
+			copy(dAtA[i:], m.Pubkeys[iNdEx])
+			i = encodeVarintWebApi(dAtA, i, uint64(len(m.Pubkeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteKeystoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteKeystoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteKeystoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SlashingProtection) > 0 {
+		i -= len(m.SlashingProtection)
+		copy(dAtA[i:], m.SlashingProtection)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.SlashingProtection)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Data[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWebApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeletedKeystoreStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletedKeystoreStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletedKeystoreStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWebApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWebApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreateWalletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keymanager != 0 {
+		n += 1 + sovWebApi(uint64(m.Keymanager))
+	}
+	l = len(m.WalletPassword)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.NumAccounts != 0 {
+		n += 1 + sovWebApi(uint64(m.NumAccounts))
+	}
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCrtPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteKeyPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCaCrtPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateWalletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Wallet != nil {
+		l = m.Wallet.Size()
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EditWalletConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCrtPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteKeyPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCaCrtPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GenerateMnemonicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WalletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.KeymanagerKind != 0 {
+		n += 1 + sovWebApi(uint64(m.KeymanagerKind))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetDepositTxData {
+		n += 2
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovWebApi(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.All {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovWebApi(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatingPublicKey)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.AccountName)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.DepositTxData)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.DerivationPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovWebApi(uint64(e))
+		}
+		n += 1 + sovWebApi(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.PasswordConfirmation)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.TokenExpiration != 0 {
+		n += 1 + sovWebApi(uint64(m.TokenExpiration))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeConnectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BeaconNodeEndpoint)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.Connected {
+		n += 2
+	}
+	if m.Syncing {
+		n += 2
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovWebApi(uint64(m.GenesisTime))
+	}
+	l = len(m.DepositContractAddress)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogsEndpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorLogsEndpoint)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.BeaconLogsEndpoint)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Beacon)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePasswordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrentPassword)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.PasswordConfirmation)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HasWalletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WalletExists {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportKeystoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeystoresImported) > 0 {
+		for _, s := range m.KeystoresImported {
+			l = len(s)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	l = len(m.KeystoresPassword)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ImportKeystoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ImportedPublicKeys) > 0 {
+		for _, b := range m.ImportedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HasUsedWebResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasSignedUp {
+		n += 2
+	}
+	if m.HasWallet {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, s := range m.Logs {
+			l = len(s)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BeaconNodeEndpoint)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.Connected {
+		n += 2
+	}
+	if m.Syncing {
+		n += 2
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovWebApi(uint64(m.GenesisTime))
+	}
+	l = len(m.DepositContractAddress)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.ChainHead != nil {
+		l = m.ChainHead.Size()
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	l = len(m.BackupPassword)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ZipFile)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListKeystoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListKeystoresResponse_Keystore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatingPubkey)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.DerivationPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.Readonly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddKeystoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keystores) > 0 {
+		for _, s := range m.Keystores {
+			l = len(s)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if len(m.Passwords) > 0 {
+		for _, s := range m.Passwords {
+			l = len(s)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	l = len(m.SlashingProtection)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddKeystoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddedKeystoreStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovWebApi(uint64(m.Status))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteKeystoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pubkeys) > 0 {
+		for _, s := range m.Pubkeys {
+			l = len(s)
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteKeystoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	l = len(m.SlashingProtection)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletedKeystoreStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovWebApi(uint64(m.Status))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWebApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWebApi(x uint64) (n int) {
+	return sovWebApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateWalletRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keymanager", wireType)
+			}
+			m.Keymanager = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keymanager |= KeymanagerKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletPassword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumAccounts", wireType)
+			}
+			m.NumAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumAccounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCrtPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCrtPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteKeyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCaCrtPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCaCrtPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateWalletResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateWalletResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wallet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wallet == nil {
+				m.Wallet = &WalletResponse{}
+			}
+			if err := m.Wallet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditWalletConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditWalletConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCrtPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCrtPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteKeyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCaCrtPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCaCrtPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenerateMnemonicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateMnemonicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateMnemonicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WalletResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WalletResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeymanagerKind", wireType)
+			}
+			m.KeymanagerKind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeymanagerKind |= KeymanagerKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetDepositTxData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GetDepositTxData = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &Account{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	l = len(m.BackupPassword)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *BackupAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ZipFile)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovWebApi(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozWebApi(x uint64) (n int) {
-	return sovWebApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
+func (m *Account) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4338,17 +6528,17 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateWalletRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keymanager", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatingPublicKey", wireType)
 			}
-			m.Keymanager = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4358,14 +6548,29 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Keymanager |= KeymanagerKind(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatingPublicKey = append(m.ValidatingPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatingPublicKey == nil {
+				m.ValidatingPublicKey = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4393,13 +6598,13 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletPassword = string(dAtA[iNdEx:postIndex])
+			m.AccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositTxData", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4409,46 +6614,29 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			m.DepositTxData = append(m.DepositTxData[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositTxData == nil {
+				m.DepositTxData = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumAccounts", wireType)
-			}
-			m.NumAccounts = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumAccounts |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4476,13 +6664,64 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
+			m.DerivationPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCrtPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4492,27 +6731,154 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWebApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWebApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthWebApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthWebApi
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWebApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteCrtPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteKeyPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4540,11 +6906,11 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteKeyPath = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCaCrtPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordConfirmation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4572,7 +6938,7 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteCaCrtPath = string(dAtA[iNdEx:postIndex])
+			m.PasswordConfirmation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4596,7 +6962,7 @@ func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateWalletResponse) Unmarshal(dAtA []byte) error {
+func (m *AuthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4619,17 +6985,17 @@ func (m *CreateWalletResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateWalletResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wallet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4639,28 +7005,43 @@ func (m *CreateWalletResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Wallet == nil {
-				m.Wallet = &WalletResponse{}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenExpiration", wireType)
 			}
-			if err := m.Wallet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TokenExpiration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenExpiration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -4683,7 +7064,7 @@ func (m *CreateWalletResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *NodeConnectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4706,15 +7087,15 @@ func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EditWalletConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeConnectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EditWalletConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeConnectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconNodeEndpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4742,13 +7123,13 @@ func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
+			m.BeaconNodeEndpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCrtPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connected", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4758,29 +7139,17 @@ func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemoteCrtPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Connected = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteKeyPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4790,29 +7159,17 @@ func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemoteKeyPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Syncing = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCaCrtPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
 			}
-			var stringLen uint64
+			m.GenesisTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4822,80 +7179,16 @@ func (m *EditWalletConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GenesisTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemoteCaCrtPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipWebApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenerateMnemonicResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWebApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenerateMnemonicResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenerateMnemonicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -4905,23 +7198,25 @@ func (m *GenerateMnemonicResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			m.DepositContractAddress = append(m.DepositContractAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositContractAddress == nil {
+				m.DepositContractAddress = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4945,7 +7240,7 @@ func (m *GenerateMnemonicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WalletResponse) Unmarshal(dAtA []byte) error {
+func (m *LogsEndpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4968,15 +7263,15 @@ func (m *WalletResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WalletResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogsEndpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogsEndpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLogsEndpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5004,13 +7299,13 @@ func (m *WalletResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletPath = string(dAtA[iNdEx:postIndex])
+			m.ValidatorLogsEndpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeymanagerKind", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconLogsEndpoint", wireType)
 			}
-			m.KeymanagerKind = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5020,11 +7315,24 @@ func (m *WalletResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeymanagerKind |= KeymanagerKind(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeaconLogsEndpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -5047,7 +7355,7 @@ func (m *WalletResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5070,17 +7378,17 @@ func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetDepositTxData", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5090,34 +7398,27 @@ func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.GetDepositTxData = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
 			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beacon = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5145,28 +7446,8 @@ func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PageToken = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.All = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -5189,7 +7470,7 @@ func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *ChangePasswordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5212,17 +7493,17 @@ func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePasswordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPassword", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5232,29 +7513,27 @@ func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &Account{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CurrentPassword = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5282,13 +7561,13 @@ func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordConfirmation", wireType)
 			}
-			m.TotalSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5298,11 +7577,24 @@ func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSize |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PasswordConfirmation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -5325,7 +7617,7 @@ func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Account) Unmarshal(dAtA []byte) error {
+func (m *HasWalletResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5348,17 +7640,17 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+			return fmt.Errorf("proto: HasWalletResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HasWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatingPublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletExists", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5368,29 +7660,66 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthWebApi
+			m.WalletExists = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatingPublicKey = append(m.ValidatingPublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatingPublicKey == nil {
-				m.ValidatingPublicKey = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportKeystoresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportKeystoresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportKeystoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeystoresImported", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5418,45 +7747,11 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositTxData", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DepositTxData = append(m.DepositTxData[:0], dAtA[iNdEx:postIndex]...)
-			if m.DepositTxData == nil {
-				m.DepositTxData = []byte{}
-			}
+			m.KeystoresImported = append(m.KeystoresImported, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivationPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeystoresPassword", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5484,7 +7779,7 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivationPath = string(dAtA[iNdEx:postIndex])
+			m.KeystoresPassword = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5508,7 +7803,7 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountRequest) Unmarshal(dAtA []byte) error {
+func (m *ImportKeystoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5531,15 +7826,15 @@ func (m *AccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportKeystoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportKeystoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportedPublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5566,85 +7861,9 @@ func (m *AccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.ImportedPublicKeys = append(m.ImportedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ImportedPublicKeys[len(m.ImportedPublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowWebApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Indices = append(m.Indices, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowWebApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthWebApi
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthWebApi
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Indices) == 0 {
-					m.Indices = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowWebApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Indices = append(m.Indices, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -5667,7 +7886,7 @@ func (m *AccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthRequest) Unmarshal(dAtA []byte) error {
+func (m *HasUsedWebResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5690,17 +7909,17 @@ func (m *AuthRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HasUsedWebResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HasUsedWebResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasSignedUp", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5710,29 +7929,17 @@ func (m *AuthRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.HasSignedUp = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordConfirmation", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasWallet", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -5742,24 +7949,12 @@ func (m *AuthRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PasswordConfirmation = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.HasWallet = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -5782,7 +7977,7 @@ func (m *AuthRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AuthResponse) Unmarshal(dAtA []byte) error {
+func (m *LogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5805,15 +8000,15 @@ func (m *AuthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5841,27 +8036,8 @@ func (m *AuthResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Logs = append(m.Logs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenExpiration", wireType)
-			}
-			m.TokenExpiration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TokenExpiration |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -5884,7 +8060,7 @@ func (m *AuthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeConnectionResponse) Unmarshal(dAtA []byte) error {
+func (m *BeaconStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5907,10 +8083,10 @@ func (m *NodeConnectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeConnectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BeaconStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeConnectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BeaconStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6018,114 +8194,31 @@ func (m *NodeConnectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DepositContractAddress = append(m.DepositContractAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DepositContractAddress == nil {
-				m.DepositContractAddress = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipWebApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LogsEndpointResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWebApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LogsEndpointResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogsEndpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLogsEndpoint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorLogsEndpoint = string(dAtA[iNdEx:postIndex])
+			m.DepositContractAddress = append(m.DepositContractAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositContractAddress == nil {
+				m.DepositContractAddress = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconLogsEndpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainHead", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -6135,23 +8228,27 @@ func (m *LogsEndpointResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BeaconLogsEndpoint = string(dAtA[iNdEx:postIndex])
+			if m.ChainHead == nil {
+				m.ChainHead = &v1alpha1.ChainHead{}
+			}
+			if err := m.ChainHead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6175,7 +8272,7 @@ func (m *LogsEndpointResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VersionResponse) Unmarshal(dAtA []byte) error {
+func (m *BackupAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6198,17 +8295,17 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -6218,27 +8315,27 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Beacon = string(dAtA[iNdEx:postIndex])
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupPassword", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6266,7 +8363,7 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.BackupPassword = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6290,7 +8387,7 @@ func (m *VersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePasswordRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6313,49 +8410,17 @@ func (m *ChangePasswordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePasswordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPassword", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrentPassword = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZipFile", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -6365,55 +8430,25 @@ func (m *ChangePasswordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordConfirmation", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ZipFile = append(m.ZipFile[:0], dAtA[iNdEx:postIndex]...)
+			if m.ZipFile == nil {
+				m.ZipFile = []byte{}
 			}
-			m.PasswordConfirmation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6437,7 +8472,7 @@ func (m *ChangePasswordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HasWalletResponse) Unmarshal(dAtA []byte) error {
+func (m *ListKeystoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6460,17 +8495,17 @@ func (m *HasWalletResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HasWalletResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListKeystoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HasWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListKeystoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletExists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -6480,12 +8515,26 @@ func (m *HasWalletResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WalletExists = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &ListKeystoresResponse_Keystore{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -6508,7 +8557,7 @@ func (m *HasWalletResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImportKeystoresRequest) Unmarshal(dAtA []byte) error {
+func (m *ListKeystoresResponse_Keystore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6531,15 +8580,15 @@ func (m *ImportKeystoresRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImportKeystoresRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Keystore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImportKeystoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Keystore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeystoresImported", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatingPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6567,11 +8616,11 @@ func (m *ImportKeystoresRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeystoresImported = append(m.KeystoresImported, string(dAtA[iNdEx:postIndex]))
+			m.ValidatingPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeystoresPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6599,8 +8648,28 @@ func (m *ImportKeystoresRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeystoresPassword = string(dAtA[iNdEx:postIndex])
+			m.DerivationPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readonly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Readonly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -6623,7 +8692,7 @@ func (m *ImportKeystoresRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImportKeystoresResponse) Unmarshal(dAtA []byte) error {
+func (m *AddKeystoresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6646,17 +8715,81 @@ func (m *ImportKeystoresResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImportKeystoresResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddKeystoresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImportKeystoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddKeystoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImportedPublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keystores", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keystores = append(m.Keystores, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passwords", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Passwords = append(m.Passwords, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingProtection", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -6666,23 +8799,23 @@ func (m *ImportKeystoresResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImportedPublicKeys = append(m.ImportedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ImportedPublicKeys[len(m.ImportedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.SlashingProtection = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6706,7 +8839,7 @@ func (m *ImportKeystoresResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HasUsedWebResponse) Unmarshal(dAtA []byte) error {
+func (m *AddKeystoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6729,17 +8862,17 @@ func (m *HasUsedWebResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HasUsedWebResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddKeystoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HasUsedWebResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddKeystoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasSignedUp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -6749,32 +8882,26 @@ func (m *HasUsedWebResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasSignedUp = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasWallet", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthWebApi
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
 			}
-			m.HasWallet = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &AddedKeystoreStatus{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWebApi(dAtA[iNdEx:])
@@ -6797,7 +8924,7 @@ func (m *HasUsedWebResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogsResponse) Unmarshal(dAtA []byte) error {
+func (m *AddedKeystoreStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6820,15 +8947,34 @@ func (m *LogsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddedKeystoreStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddedKeystoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AddedKeystoreStatus_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6856,7 +9002,7 @@ func (m *LogsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6880,7 +9026,7 @@ func (m *LogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BeaconStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteKeystoresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6903,15 +9049,15 @@ func (m *BeaconStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BeaconStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteKeystoresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeaconStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteKeystoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconNodeEndpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6939,136 +9085,7 @@ func (m *BeaconStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BeaconNodeEndpoint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connected", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Connected = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Syncing = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
-			}
-			m.GenesisTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GenesisTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositContractAddress", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DepositContractAddress = append(m.DepositContractAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.DepositContractAddress == nil {
-				m.DepositContractAddress = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainHead", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthWebApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChainHead == nil {
-				m.ChainHead = &v1alpha1.ChainHead{}
-			}
-			if err := m.ChainHead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Pubkeys = append(m.Pubkeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7092,7 +9109,7 @@ func (m *BeaconStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteKeystoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7115,17 +9132,17 @@ func (m *BackupAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteKeystoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteKeystoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -7135,27 +9152,29 @@ func (m *BackupAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data, &DeletedKeystoreStatus{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackupPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingProtection", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7183,7 +9202,7 @@ func (m *BackupAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BackupPassword = string(dAtA[iNdEx:postIndex])
+			m.SlashingProtection = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7207,7 +9226,7 @@ func (m *BackupAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *DeletedKeystoreStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7230,17 +9249,36 @@ func (m *BackupAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeletedKeystoreStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeletedKeystoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DeletedKeystoreStatus_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZipFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWebApi
@@ -7250,25 +9288,23 @@ func (m *BackupAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWebApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthWebApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ZipFile = append(m.ZipFile[:0], dAtA[iNdEx:postIndex]...)
-			if m.ZipFile == nil {
-				m.ZipFile = []byte{}
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
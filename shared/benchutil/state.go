@@ -0,0 +1,97 @@
+package benchutil
+
+import (
+	"encoding/binary"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateV0"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ValidatorCounts are the registry sizes the scaling benchmarks are run with, going from
+// the size of the pregenerated states up to a registry larger than mainnet's.
+var ValidatorCounts = []uint64{16384, 100000, 250000}
+
+// NewBeaconState returns a beacon state at the given epoch with the given number of active
+// validators, all at maximum effective balance. Deriving a key per validator is too slow for
+// large registries, so every validator shares the returned secret key.
+func NewBeaconState(numValidators uint64, epoch types.Epoch) (iface.BeaconState, bls.SecretKey, error) {
+	cfg := params.BeaconConfig()
+	secretKey, err := bls.SecretKeyFromBytes(bytesutil.PadTo([]byte{1}, 32))
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey := secretKey.PublicKey().Marshal()
+	vals := make([]*ethpb.Validator, numValidators)
+	bals := make([]uint64, numValidators)
+	for i := uint64(0); i < numValidators; i++ {
+		vals[i] = &ethpb.Validator{
+			PublicKey:                  pubKey,
+			WithdrawalCredentials:      filledBytes(i, 32),
+			EffectiveBalance:           cfg.MaxEffectiveBalance,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  cfg.FarFutureEpoch,
+			WithdrawableEpoch:          cfg.FarFutureEpoch,
+		}
+		bals[i] = cfg.MaxEffectiveBalance
+	}
+
+	st := &pb.BeaconState{
+		Slot: cfg.SlotsPerEpoch.Mul(uint64(epoch)),
+		Fork: &pb.Fork{
+			PreviousVersion: cfg.GenesisForkVersion,
+			CurrentVersion:  cfg.GenesisForkVersion,
+		},
+		LatestBlockHeader: &ethpb.BeaconBlockHeader{
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		BlockRoots:        filledRoots(uint64(cfg.SlotsPerHistoricalRoot)),
+		StateRoots:        filledRoots(uint64(cfg.SlotsPerHistoricalRoot)),
+		HistoricalRoots:   make([][]byte, 0),
+		Eth1Data:          &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32), DepositCount: numValidators},
+		Eth1DataVotes:     make([]*ethpb.Eth1Data, 0),
+		Eth1DepositIndex:  numValidators,
+		Validators:        vals,
+		Balances:          bals,
+		RandaoMixes:       filledRoots(uint64(cfg.EpochsPerHistoricalVector)),
+		Slashings:         make([]uint64, cfg.EpochsPerSlashingsVector),
+		JustificationBits: bitfield.Bitvector4{0x0},
+
+		PreviousEpochAttestations:   make([]*pb.PendingAttestation, 0),
+		CurrentEpochAttestations:    make([]*pb.PendingAttestation, 0),
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Root: make([]byte, 32)},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Root: make([]byte, 32)},
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Root: make([]byte, 32)},
+		GenesisValidatorsRoot:       make([]byte, 32),
+	}
+	beaconState, err := stateV0.InitializeFromProtoUnsafe(st)
+	if err != nil {
+		return nil, nil, err
+	}
+	return beaconState, secretKey, nil
+}
+
+// filledBytes returns a byte slice of the given length starting with the encoded index, so
+// that roots are distinct from one another.
+func filledBytes(index uint64, length int) []byte {
+	b := make([]byte, length)
+	binary.LittleEndian.PutUint64(b, index+1)
+	return b
+}
+
+func filledRoots(length uint64) [][]byte {
+	roots := make([][]byte, length)
+	for i := uint64(0); i < length; i++ {
+		roots[i] = filledBytes(i, 32)
+	}
+	return roots
+}
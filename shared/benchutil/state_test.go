@@ -0,0 +1,27 @@
+package benchutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestNewBeaconState(t *testing.T) {
+	st, secretKey, err := NewBeaconState(64, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 64, st.NumValidators())
+	assert.Equal(t, params.BeaconConfig().SlotsPerEpoch*2, st.Slot())
+
+	pubKey := bytesutil.ToBytes48(secretKey.PublicKey().Marshal())
+	val, err := st.ValidatorAtIndexReadOnly(63)
+	require.NoError(t, err)
+	assert.Equal(t, pubKey, val.PublicKey())
+	assert.Equal(t, params.BeaconConfig().FarFutureEpoch, val.ExitEpoch())
+
+	_, err = st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+}
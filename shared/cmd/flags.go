@@ -152,6 +152,24 @@ var (
 		Usage: "The max number of p2p peers to maintain.",
 		Value: 45,
 	}
+	// P2PMaxPeersPerClient defines a flag to specify the max number of peers running the same client.
+	P2PMaxPeersPerClient = &cli.IntFlag{
+		Name:  "p2p-max-peers-per-client",
+		Usage: "The max number of p2p peers running the same client implementation to maintain. The default of 0 disables the limit.",
+		Value: 0,
+	}
+	// P2PColocationLimit defines a flag to specify the max number of peers sharing a single ip address.
+	P2PColocationLimit = &cli.IntFlag{
+		Name:  "p2p-colocation-limit",
+		Usage: "The max number of p2p peers allowed to connect from a single ip address.",
+		Value: 5,
+	}
+	// P2PMinSubnetPeers defines a flag to specify the number of peers per attestation subnet protected from pruning.
+	P2PMinSubnetPeers = &cli.IntFlag{
+		Name:  "p2p-min-subnet-peers",
+		Usage: "The minimum number of p2p peers to retain in each attestation subnet when pruning peers. The default of 0 disables the protection.",
+		Value: 0,
+	}
 	// P2PAllowList defines a CIDR subnet to exclusively allow connections.
 	P2PAllowList = &cli.StringFlag{
 		Name: "p2p-allowlist",
@@ -49,6 +49,7 @@ type Flags struct {
 	DisableAttestingHistoryDBCache     bool // DisableAttestingHistoryDBCache for the validator client increases disk reads/writes.
 	UpdateHeadTimely                   bool // UpdateHeadTimely updates head right after state transition.
 	ProposerAttsSelectionUsingMaxCover bool // ProposerAttsSelectionUsingMaxCover enables max-cover algorithm when selecting attestations for proposing.
+	SkipFinalizedDepositProofs         bool // SkipFinalizedDepositProofs skips verifying the Merkle proofs of deposits which have already been finalized.
 
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
@@ -60,6 +61,9 @@ type Flags struct {
 	// Cache toggles.
 	EnableSSZCache           bool // EnableSSZCache see https://github.com/prysmaticlabs/prysm/pull/4558.
 	EnableNextSlotStateCache bool // EnableNextSlotStateCache enables next slot state cache to improve validator performance.
+	EnableFieldTriePooling   bool // EnableFieldTriePooling reuses the layers of released field tries when copying field tries.
+	EnableStatePrehashing    bool // EnableStatePrehashing hashes the head state while waiting for the block of the slot.
+	EnableParallelReplay     bool // EnableParallelReplay checks the blocks replayed by state gen in parallel ahead of applying them.
 
 	// Bug fixes related flags.
 	AttestTimely bool // AttestTimely fixes #8185. It is gated behind a flag to ensure beacon node's fix can safely roll out first. We'll invert this in v1.1.0.
@@ -188,6 +192,18 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.WithField(enableNextSlotStateCache.Name, enableNextSlotStateCache.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableNextSlotStateCache = true
 	}
+	if ctx.Bool(enableFieldTriePooling.Name) {
+		log.WithField(enableFieldTriePooling.Name, enableFieldTriePooling.Usage).Warn(enabledFeatureFlag)
+		cfg.EnableFieldTriePooling = true
+	}
+	if ctx.Bool(enableStatePrehashing.Name) {
+		log.WithField(enableStatePrehashing.Name, enableStatePrehashing.Usage).Warn(enabledFeatureFlag)
+		cfg.EnableStatePrehashing = true
+	}
+	if ctx.Bool(enableParallelReplay.Name) {
+		log.WithField(enableParallelReplay.Name, enableParallelReplay.Usage).Warn(enabledFeatureFlag)
+		cfg.EnableParallelReplay = true
+	}
 	if ctx.Bool(updateHeadTimely.Name) {
 		log.WithField(updateHeadTimely.Name, updateHeadTimely.Usage).Warn(enabledFeatureFlag)
 		cfg.UpdateHeadTimely = true
@@ -196,6 +212,10 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.WithField(proposerAttsSelectionUsingMaxCover.Name, proposerAttsSelectionUsingMaxCover.Usage).Warn(enabledFeatureFlag)
 		cfg.ProposerAttsSelectionUsingMaxCover = true
 	}
+	if ctx.Bool(skipFinalizedDepositProofs.Name) {
+		log.WithField(skipFinalizedDepositProofs.Name, skipFinalizedDepositProofs.Usage).Warn(enabledFeatureFlag)
+		cfg.SkipFinalizedDepositProofs = true
+	}
 	Init(cfg)
 }
 
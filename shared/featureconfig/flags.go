@@ -110,6 +110,18 @@ var (
 		Name:  "enable-next-slot-state-cache",
 		Usage: "Improves attesting and proposing efficiency by caching the next slot state at the end of the current slot",
 	}
+	enableFieldTriePooling = &cli.BoolFlag{
+		Name:  "enable-field-trie-pooling",
+		Usage: "Reduces garbage collection pressure by reusing the layers of released field tries when copying beacon states",
+	}
+	enableStatePrehashing = &cli.BoolFlag{
+		Name:  "enable-state-prehashing",
+		Usage: "Reduces block processing time by hashing the head state while waiting for the block of the slot",
+	}
+	enableParallelReplay = &cli.BoolFlag{
+		Name:  "enable-parallel-replay",
+		Usage: "Speeds up state regeneration by checking replayed blocks in parallel and caching the replayed epoch boundary states",
+	}
 	updateHeadTimely = &cli.BoolFlag{
 		Name:  "update-head-timely",
 		Usage: "Improves update head time by updating head right after state transition",
@@ -118,6 +130,10 @@ var (
 		Name:  "proposer-atts-selection-using-max-cover",
 		Usage: "Rely on max-cover algorithm when selecting attestations for proposer",
 	}
+	skipFinalizedDepositProofs = &cli.BoolFlag{
+		Name:  "skip-finalized-deposit-proofs",
+		Usage: "Skips verifying the Merkle proofs of deposits which were already verified in finalized blocks when replaying them",
+	}
 	enableSlashingProtectionPruning = &cli.BoolFlag{
 		Name:  "enable-slashing-protection-pruning",
 		Usage: "Enables the pruning of the validator client's slashing protectin database",
@@ -178,9 +194,13 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	checkPtInfoCache,
 	disableBroadcastSlashingFlag,
 	enableNextSlotStateCache,
+	enableFieldTriePooling,
+	enableStatePrehashing,
+	enableParallelReplay,
 	forceOptMaxCoverAggregationStategy,
 	updateHeadTimely,
 	proposerAttsSelectionUsingMaxCover,
+	skipFinalizedDepositProofs,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
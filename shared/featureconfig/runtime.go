@@ -0,0 +1,46 @@
+package featureconfig
+
+import (
+	"github.com/pkg/errors"
+)
+
+// runtimeFlags are the feature flags which are read where the feature is used rather than when
+// the node starts, so that toggling them on a running node takes effect right away. They are
+// keyed by the name of their command line flag.
+var runtimeFlags = map[string]func(cfg *Flags) *bool{
+	disableGRPCConnectionLogging.Name:       func(cfg *Flags) *bool { return &cfg.DisableGRPCConnectionLogs },
+	disableBroadcastSlashingFlag.Name:       func(cfg *Flags) *bool { return &cfg.DisableBroadcastSlashings },
+	enableParallelReplay.Name:               func(cfg *Flags) *bool { return &cfg.EnableParallelReplay },
+	proposerAttsSelectionUsingMaxCover.Name: func(cfg *Flags) *bool { return &cfg.ProposerAttsSelectionUsingMaxCover },
+	writeSSZStateTransitionsFlag.Name:       func(cfg *Flags) *bool { return &cfg.WriteSSZStateTransitions },
+}
+
+// RuntimeFlags returns the values of the feature flags which can be toggled on a running node,
+// by flag name.
+func RuntimeFlags() map[string]bool {
+	cfg := Get()
+	values := make(map[string]bool, len(runtimeFlags))
+	for name, field := range runtimeFlags {
+		values[name] = *field(cfg)
+	}
+	return values
+}
+
+// SetRuntimeFlag toggles a feature flag of a running node. The global config is replaced by an
+// updated copy, so that readers of the previous config are not raced.
+func SetRuntimeFlag(name string, enabled bool) error {
+	field, ok := runtimeFlags[name]
+	if !ok {
+		return errors.Errorf("flag %s can not be changed at runtime", name)
+	}
+	featureConfigLock.Lock()
+	defer featureConfigLock.Unlock()
+	cfg := &Flags{}
+	if featureConfig != nil {
+		*cfg = *featureConfig
+	}
+	*field(cfg) = enabled
+	featureConfig = cfg
+	log.WithField("flag", name).WithField("enabled", enabled).Warn("Changed feature flag at runtime")
+	return nil
+}
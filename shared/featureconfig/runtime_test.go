@@ -0,0 +1,27 @@
+package featureconfig
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSetRuntimeFlag(t *testing.T) {
+	resetCfg := InitWithReset(&Flags{PyrmontTestnet: true})
+	defer resetCfg()
+	prev := Get()
+
+	require.NoError(t, SetRuntimeFlag(enableParallelReplay.Name, true))
+	assert.Equal(t, true, Get().EnableParallelReplay)
+	assert.Equal(t, true, Get().PyrmontTestnet)
+	assert.Equal(t, false, prev.EnableParallelReplay, "Previous config was modified")
+	assert.Equal(t, true, RuntimeFlags()[enableParallelReplay.Name])
+	assert.Equal(t, false, RuntimeFlags()[disableBroadcastSlashingFlag.Name])
+
+	require.NoError(t, SetRuntimeFlag(enableParallelReplay.Name, false))
+	assert.Equal(t, false, Get().EnableParallelReplay)
+
+	assert.ErrorContains(t, "flag pyrmont can not be changed at runtime", SetRuntimeFlag(PyrmontTestnet.Name, false))
+	assert.Equal(t, true, Get().PyrmontTestnet)
+}
@@ -0,0 +1,17 @@
+// +build linux darwin
+
+package fileutil
+
+import (
+	"syscall"
+)
+
+// DiskUsage returns the free and total bytes of the filesystem a path is on. Free bytes are the
+// ones available to unprivileged users.
+func DiskUsage(path string) (free, total uint64, err error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, 0, err
+	}
+	return stat.Bavail * uint64(stat.Bsize), stat.Blocks * uint64(stat.Bsize), nil
+}
@@ -0,0 +1,12 @@
+// +build !linux,!darwin
+
+package fileutil
+
+import (
+	"fmt"
+)
+
+// DiskUsage returns an error on platforms other than Linux and macOS.
+func DiskUsage(_ string) (free, total uint64, err error) {
+	return 0, 0, fmt.Errorf("disk usage is not supported in this platform")
+}
@@ -0,0 +1,65 @@
+package logutil
+
+import (
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	moduleLevelsLock sync.RWMutex
+	moduleLevels     map[string]logrus.Level
+	baseLevel        logrus.Level
+)
+
+// moduleFormatter drops the entries of the modules with a level of their own which are below that
+// level, and formats the other entries with the wrapped formatter.
+type moduleFormatter struct {
+	logrus.Formatter
+}
+
+// Format formats the entry, or returns no output if the module of the entry logs at a lower level.
+func (f *moduleFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if !moduleLevelEnabled(entry) {
+		return nil, nil
+	}
+	return f.Formatter.Format(entry)
+}
+
+// SetModuleLevel sets the log level of a module, identified by the prefix field of the logger of
+// its package. An empty module sets the level of the modules without a level of their own.
+func SetModuleLevel(module string, level logrus.Level) {
+	moduleLevelsLock.Lock()
+	defer moduleLevelsLock.Unlock()
+	if moduleLevels == nil {
+		moduleLevels = make(map[string]logrus.Level)
+		baseLevel = logrus.GetLevel()
+		logrus.SetFormatter(&moduleFormatter{Formatter: logrus.StandardLogger().Formatter})
+	}
+	if module == "" {
+		baseLevel = level
+	} else {
+		moduleLevels[module] = level
+	}
+	// The standard logger drops the entries above its own level before formatting them, so it
+	// logs at the most verbose level of all modules.
+	maxLevel := baseLevel
+	for _, l := range moduleLevels {
+		if l > maxLevel {
+			maxLevel = l
+		}
+	}
+	logrus.SetLevel(maxLevel)
+}
+
+func moduleLevelEnabled(entry *logrus.Entry) bool {
+	moduleLevelsLock.RLock()
+	defer moduleLevelsLock.RUnlock()
+	level := baseLevel
+	if module, ok := entry.Data["prefix"].(string); ok {
+		if l, ok := moduleLevels[module]; ok {
+			level = l
+		}
+	}
+	return entry.Level <= level
+}
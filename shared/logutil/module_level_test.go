@@ -0,0 +1,45 @@
+package logutil
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/sirupsen/logrus"
+)
+
+func TestSetModuleLevel(t *testing.T) {
+	logger := logrus.StandardLogger()
+	prevOut, prevFormatter, prevLevel := logger.Out, logger.Formatter, logger.GetLevel()
+	defer func() {
+		moduleLevels = nil
+		logrus.SetOutput(prevOut)
+		logrus.SetFormatter(prevFormatter)
+		logrus.SetLevel(prevLevel)
+	}()
+	var buf bytes.Buffer
+	logrus.SetOutput(&buf)
+	logrus.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+	logrus.SetLevel(logrus.InfoLevel)
+	syncLog := logrus.WithField("prefix", "sync")
+	p2pLog := logrus.WithField("prefix", "p2p")
+
+	SetModuleLevel("sync", logrus.DebugLevel)
+	assert.Equal(t, logrus.DebugLevel, logrus.GetLevel())
+	syncLog.Debug("sync debug")
+	p2pLog.Debug("p2p debug")
+	p2pLog.Info("p2p info")
+	assert.Equal(t, true, strings.Contains(buf.String(), "sync debug"))
+	assert.Equal(t, false, strings.Contains(buf.String(), "p2p debug"))
+	assert.Equal(t, true, strings.Contains(buf.String(), "p2p info"))
+
+	buf.Reset()
+	SetModuleLevel("", logrus.WarnLevel)
+	SetModuleLevel("sync", logrus.ErrorLevel)
+	assert.Equal(t, logrus.WarnLevel, logrus.GetLevel())
+	syncLog.Warn("sync warn")
+	p2pLog.Info("p2p info")
+	p2pLog.Warn("p2p warn")
+	assert.Equal(t, "level=warning msg=\"p2p warn\" prefix=p2p\n", buf.String())
+}
@@ -121,6 +121,10 @@ type BeaconChainConfig struct {
 	// Slashing protection constants.
 	SlashingProtectionPruningEpochs types.Epoch // SlashingProtectionPruningEpochs defines a period after which all prior epochs are pruned in the validator database.
 
+	// Fork choice values.
+	ProposerScoreBoost uint64 `yaml:"PROPOSER_SCORE_BOOST"` // ProposerScoreBoost defines the percentage of the committee weight of a slot added in fork choice to the timely block of the current slot.
+	IntervalsPerSlot   uint64 `yaml:"INTERVALS_PER_SLOT"`   // IntervalsPerSlot defines the number of intervals in a slot, a block being timely if received in the first interval of its slot.
+
 	// Fork-related values.
 	GenesisForkVersion  []byte                 `yaml:"GENESIS_FORK_VERSION" spec:"true"` // GenesisForkVersion is used to track fork version between state transitions.
 	NextForkVersion     []byte                 `yaml:"NEXT_FORK_VERSION"`                // NextForkVersion is used to track the upcoming fork version, if any.
@@ -167,6 +167,10 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	PruneSlasherStoragePeriod:       10,
 	SlashingProtectionPruningEpochs: 512,
 
+	// Fork choice values.
+	ProposerScoreBoost: 70,
+	IntervalsPerSlot:   3,
+
 	// Weak subjectivity values.
 	SafetyDecay: 10,
 
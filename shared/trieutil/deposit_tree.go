@@ -0,0 +1,285 @@
+package trieutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+var (
+	// ErrDepositTreeFull is returned when inserting into a deposit tree with no remaining capacity.
+	ErrDepositTreeFull = errors.New("deposit tree is full")
+	// ErrFinalizedDeposit is returned when requesting data which has been pruned through finalization.
+	ErrFinalizedDeposit = errors.New("deposit has been finalized")
+)
+
+// DepositTree is an append-only deposit Merkle tree as described in EIP-4881. Rather than
+// keeping every leaf, it only retains the roots of the subtrees which are fully finalized
+// along with the leaves which have not been finalized yet. This keeps memory and storage
+// bounded by the number of non-finalized deposits, while still allowing proofs to be
+// generated for any non-finalized deposit.
+type DepositTree struct {
+	depth          uint64
+	finalized      [][32]byte // roots of the finalized subtrees, ordered from left to right.
+	finalizedCount uint64
+	leaves         [][32]byte           // non-finalized leaves, starting at index finalizedCount.
+	nodes          map[nodeKey][32]byte // roots of the complete subtrees above the non-finalized leaves.
+	executionHash  [32]byte
+	executionDepth uint64
+}
+
+// nodeKey identifies a node of the tree by its level, where level 0 refers to the leaves,
+// and its index within the level.
+type nodeKey struct {
+	level uint64
+	index uint64
+}
+
+// NewDepositTree returns an empty deposit tree of the given depth.
+func NewDepositTree(depth uint64) *DepositTree {
+	return &DepositTree{depth: depth}
+}
+
+// DepositTreeFromSnapshot restores a deposit tree from a snapshot of its finalized portion.
+// The snapshot's deposit root is checked against the root of the restored tree.
+func DepositTreeFromSnapshot(snapshot *protodb.DepositSnapshot, depth uint64) (*DepositTree, error) {
+	if snapshot == nil {
+		return nil, errors.New("nil deposit snapshot")
+	}
+	if depth < 64 && snapshot.DepositCount > 1<<depth {
+		return nil, fmt.Errorf("deposit count %d exceeds capacity of tree with depth %d", snapshot.DepositCount, depth)
+	}
+	if len(snapshot.Finalized) != bits.OnesCount64(snapshot.DepositCount) {
+		return nil, fmt.Errorf("expected %d finalized roots for deposit count %d, received %d",
+			bits.OnesCount64(snapshot.DepositCount), snapshot.DepositCount, len(snapshot.Finalized))
+	}
+	t := &DepositTree{
+		depth:          depth,
+		finalized:      make([][32]byte, len(snapshot.Finalized)),
+		finalizedCount: snapshot.DepositCount,
+		executionHash:  bytesutil.ToBytes32(snapshot.ExecutionHash),
+		executionDepth: snapshot.ExecutionDepth,
+	}
+	for i, r := range snapshot.Finalized {
+		t.finalized[i] = bytesutil.ToBytes32(r)
+	}
+	root, err := t.root()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(root[:], snapshot.DepositRoot) {
+		return nil, fmt.Errorf("snapshot deposit root %#x does not match computed root %#x", snapshot.DepositRoot, root)
+	}
+	return t, nil
+}
+
+// NumOfItems returns the total number of deposits inserted into the tree,
+// including those which have been finalized.
+func (t *DepositTree) NumOfItems() int {
+	return int(t.finalizedCount) + len(t.leaves)
+}
+
+// FinalizedCount returns the number of deposits which have been finalized.
+func (t *DepositTree) FinalizedCount() uint64 {
+	return t.finalizedCount
+}
+
+// Insert appends an item to the tree. Deposits are append-only, so the index must be
+// equal to the current number of items in the tree.
+func (t *DepositTree) Insert(item []byte, index int) error {
+	if index != t.NumOfItems() {
+		return fmt.Errorf("expected deposit index %d, received %d", t.NumOfItems(), index)
+	}
+	if t.depth < 64 && uint64(index) >= 1<<t.depth {
+		return ErrDepositTreeFull
+	}
+	t.leaves = append(t.leaves, bytesutil.ToBytes32(item))
+
+	// Memoize the roots of the subtrees completed by this leaf. As the tree is append-only,
+	// complete subtrees never change, so roots and proofs only rehash the right edge of the tree.
+	count := uint64(t.NumOfItems())
+	for level := uint64(1); level <= t.depth && count%(1<<level) == 0; level++ {
+		key := nodeKey{level: level, index: count>>level - 1}
+		r, err := t.nodeRoot(level, key.index)
+		if err != nil {
+			return err
+		}
+		if t.nodes == nil {
+			t.nodes = make(map[nodeKey][32]byte)
+		}
+		t.nodes[key] = r
+	}
+	return nil
+}
+
+// InsertBatch appends contiguous items to the tree, starting at the given index.
+func (t *DepositTree) InsertBatch(items [][]byte, index int) error {
+	for i, item := range items {
+		if err := t.Insert(item, index+i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Root returns the root of the tree mixed in with the number of deposits, as defined by the deposit contract.
+func (t *DepositTree) Root() [32]byte {
+	root, err := t.root()
+	if err != nil {
+		// The tree is only ever built through Insert and Finalize, which always retain
+		// the nodes needed to compute the root.
+		panic(err)
+	}
+	return root
+}
+
+// MerkleProof computes a proof for the deposit at the given index. The last element of
+// the proof is the number of deposits in the tree, matching SparseMerkleTrie.MerkleProof.
+func (t *DepositTree) MerkleProof(index int) ([][]byte, error) {
+	if index < 0 || index >= t.NumOfItems() {
+		return nil, fmt.Errorf("merkle index out of range in tree, max range: %d, received: %d", t.NumOfItems(), index)
+	}
+	if uint64(index) < t.finalizedCount {
+		return nil, ErrFinalizedDeposit
+	}
+	proof := make([][]byte, t.depth+1)
+	for i := uint64(0); i < t.depth; i++ {
+		sibling, err := t.nodeRoot(i, (uint64(index)>>i)^1)
+		if err != nil {
+			return nil, err
+		}
+		proof[i] = sibling[:]
+	}
+	enc := [32]byte{}
+	binary.LittleEndian.PutUint64(enc[:], uint64(t.NumOfItems()))
+	proof[t.depth] = enc[:]
+	return proof, nil
+}
+
+// Finalize prunes every deposit below the given count, retaining only the roots of the
+// subtrees they form. The execution block hash and height are those of the block in which
+// the last finalized deposit was included, and are carried over into snapshots.
+func (t *DepositTree) Finalize(count uint64, executionHash [32]byte, executionDepth uint64) error {
+	if count <= t.finalizedCount {
+		return nil
+	}
+	if count > uint64(t.NumOfItems()) {
+		return fmt.Errorf("cannot finalize %d deposits in tree with %d deposits", count, t.NumOfItems())
+	}
+	finalized := make([][32]byte, 0, bits.OnesCount64(count))
+	start := uint64(0)
+	for level := int(t.depth); level >= 0; level-- {
+		if count&(1<<uint64(level)) == 0 {
+			continue
+		}
+		r, err := t.nodeRoot(uint64(level), start>>uint64(level))
+		if err != nil {
+			return err
+		}
+		finalized = append(finalized, r)
+		start += 1 << uint64(level)
+	}
+	leaves := make([][32]byte, len(t.leaves)-int(count-t.finalizedCount))
+	copy(leaves, t.leaves[count-t.finalizedCount:])
+	for key := range t.nodes {
+		if (key.index+1)<<key.level <= count {
+			delete(t.nodes, key)
+		}
+	}
+	t.finalized = finalized
+	t.leaves = leaves
+	t.finalizedCount = count
+	t.executionHash = executionHash
+	t.executionDepth = executionDepth
+	return nil
+}
+
+// Snapshot returns a snapshot of the finalized portion of the tree, which can be
+// used to restore it through DepositTreeFromSnapshot.
+func (t *DepositTree) Snapshot() (*protodb.DepositSnapshot, error) {
+	finalizedTree := &DepositTree{
+		depth:          t.depth,
+		finalized:      t.finalized,
+		finalizedCount: t.finalizedCount,
+	}
+	root, err := finalizedTree.root()
+	if err != nil {
+		return nil, err
+	}
+	finalized := make([][]byte, len(t.finalized))
+	for i := range t.finalized {
+		r := t.finalized[i]
+		finalized[i] = r[:]
+	}
+	executionHash := t.executionHash
+	return &protodb.DepositSnapshot{
+		Finalized:      finalized,
+		DepositRoot:    root[:],
+		DepositCount:   t.finalizedCount,
+		ExecutionHash:  executionHash[:],
+		ExecutionDepth: t.executionDepth,
+	}, nil
+}
+
+func (t *DepositTree) root() ([32]byte, error) {
+	node, err := t.nodeRoot(t.depth, 0)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	enc := [32]byte{}
+	binary.LittleEndian.PutUint64(enc[:], uint64(t.NumOfItems()))
+	return hashutil.Hash(append(node[:], enc[:]...)), nil
+}
+
+// nodeRoot computes the root of the node at the given level and index, where level 0
+// refers to the leaves. The node covers the leaves [index*2^level, (index+1)*2^level).
+func (t *DepositTree) nodeRoot(level, index uint64) ([32]byte, error) {
+	start := index << level
+	end := start + 1<<level
+	if start >= uint64(t.NumOfItems()) {
+		return ZeroHashes[level], nil
+	}
+	if end <= t.finalizedCount {
+		return t.finalizedRoot(level, start)
+	}
+	if level == 0 {
+		return t.leaves[start-t.finalizedCount], nil
+	}
+	if r, ok := t.nodes[nodeKey{level: level, index: index}]; ok {
+		return r, nil
+	}
+	left, err := t.nodeRoot(level-1, index*2)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	right, err := t.nodeRoot(level-1, index*2+1)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return hashutil.Hash(append(left[:], right[:]...)), nil
+}
+
+// finalizedRoot looks up the finalized subtree of the given level starting at the given leaf.
+// The finalized subtrees follow the binary decomposition of the finalized deposit count, from
+// the largest subtree to the smallest.
+func (t *DepositTree) finalizedRoot(level, start uint64) ([32]byte, error) {
+	offset := uint64(0)
+	i := 0
+	for l := int(t.depth); l >= 0; l-- {
+		if t.finalizedCount&(1<<uint64(l)) == 0 {
+			continue
+		}
+		if offset == start && uint64(l) == level {
+			return t.finalized[i], nil
+		}
+		offset += 1 << uint64(l)
+		i++
+	}
+	return [32]byte{}, ErrFinalizedDeposit
+}
@@ -0,0 +1,161 @@
+package trieutil
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func depositTreeTestItems(n int) [][]byte {
+	items := make([][]byte, n)
+	for i := range items {
+		h := hashutil.Hash([]byte(fmt.Sprintf("deposit %d", i)))
+		items[i] = h[:]
+	}
+	return items
+}
+
+func TestDepositTree_MatchesSparseMerkleTrie(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	items := depositTreeTestItems(37)
+	tree := NewDepositTree(depth)
+	for i, item := range items {
+		require.NoError(t, tree.Insert(item, i))
+		trie, err := GenerateTrieFromItems(items[:i+1], depth)
+		require.NoError(t, err)
+		assert.Equal(t, trie.Root(), tree.Root(), "Roots differ after inserting %d items", i+1)
+	}
+	trie, err := GenerateTrieFromItems(items, depth)
+	require.NoError(t, err)
+	for i := range items {
+		proof, err := tree.MerkleProof(i)
+		require.NoError(t, err)
+		want, err := trie.MerkleProof(i)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, proof)
+	}
+}
+
+func TestDepositTree_InsertBatch(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	items := depositTreeTestItems(100)
+	tree := NewDepositTree(depth)
+	require.NoError(t, tree.InsertBatch(items[:64], 0))
+	require.NoError(t, tree.Finalize(40, [32]byte{}, 0))
+	require.NoError(t, tree.InsertBatch(items[64:], 64))
+	assert.ErrorContains(t, "expected deposit index 100", tree.InsertBatch(items[:1], 0))
+
+	trie, err := GenerateTrieFromItems(items, depth)
+	require.NoError(t, err)
+	assert.Equal(t, trie.Root(), tree.Root())
+	for i := 40; i < len(items); i++ {
+		proof, err := tree.MerkleProof(i)
+		require.NoError(t, err)
+		want, err := trie.MerkleProof(i)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, proof)
+	}
+}
+
+func TestDepositTree_Insert_WrongIndex(t *testing.T) {
+	tree := NewDepositTree(params.BeaconConfig().DepositContractTreeDepth)
+	items := depositTreeTestItems(2)
+	require.NoError(t, tree.Insert(items[0], 0))
+	assert.ErrorContains(t, "expected deposit index 1", tree.Insert(items[1], 0))
+	assert.ErrorContains(t, "expected deposit index 1", tree.Insert(items[1], 2))
+}
+
+func TestDepositTree_Insert_Full(t *testing.T) {
+	tree := NewDepositTree(2)
+	items := depositTreeTestItems(5)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, tree.Insert(items[i], i))
+	}
+	assert.ErrorContains(t, ErrDepositTreeFull.Error(), tree.Insert(items[4], 4))
+}
+
+func TestDepositTree_Finalize(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	items := depositTreeTestItems(45)
+	trie, err := GenerateTrieFromItems(items, depth)
+	require.NoError(t, err)
+	for _, count := range []uint64{0, 1, 7, 16, 31, 45} {
+		t.Run(fmt.Sprintf("finalize %d", count), func(t *testing.T) {
+			tree := NewDepositTree(depth)
+			for i, item := range items {
+				require.NoError(t, tree.Insert(item, i))
+			}
+			require.NoError(t, tree.Finalize(count, [32]byte{'a'}, 100))
+			assert.Equal(t, count, tree.FinalizedCount())
+			assert.Equal(t, len(items), tree.NumOfItems())
+			assert.Equal(t, trie.Root(), tree.Root())
+			for i := range items {
+				proof, err := tree.MerkleProof(i)
+				if uint64(i) < count {
+					assert.ErrorContains(t, ErrFinalizedDeposit.Error(), err)
+					continue
+				}
+				require.NoError(t, err)
+				want, err := trie.MerkleProof(i)
+				require.NoError(t, err)
+				assert.DeepEqual(t, want, proof)
+			}
+		})
+	}
+}
+
+func TestDepositTree_Finalize_Incremental(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	items := depositTreeTestItems(40)
+	tree := NewDepositTree(depth)
+	for i, item := range items {
+		require.NoError(t, tree.Insert(item, i))
+		if i%3 == 0 {
+			require.NoError(t, tree.Finalize(uint64(i), [32]byte{}, uint64(i)))
+		}
+		trie, err := GenerateTrieFromItems(items[:i+1], depth)
+		require.NoError(t, err)
+		assert.Equal(t, trie.Root(), tree.Root())
+	}
+	// Finalizing fewer deposits than already finalized is a no-op.
+	require.NoError(t, tree.Finalize(3, [32]byte{}, 3))
+	assert.Equal(t, uint64(39), tree.FinalizedCount())
+	assert.ErrorContains(t, "cannot finalize 41 deposits", tree.Finalize(41, [32]byte{}, 41))
+}
+
+func TestDepositTree_SnapshotRoundtrip(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	items := depositTreeTestItems(30)
+	tree := NewDepositTree(depth)
+	for i, item := range items {
+		require.NoError(t, tree.Insert(item, i))
+	}
+	require.NoError(t, tree.Finalize(21, [32]byte{'b'}, 1234))
+	snapshot, err := tree.Snapshot()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(21), snapshot.DepositCount)
+	assert.Equal(t, uint64(1234), snapshot.ExecutionDepth)
+	finalizedTrie, err := GenerateTrieFromItems(items[:21], depth)
+	require.NoError(t, err)
+	finalizedRoot := finalizedTrie.Root()
+	assert.DeepEqual(t, finalizedRoot[:], snapshot.DepositRoot)
+
+	restored, err := DepositTreeFromSnapshot(snapshot, depth)
+	require.NoError(t, err)
+	for i := 21; i < len(items); i++ {
+		require.NoError(t, restored.Insert(items[i], i))
+	}
+	assert.Equal(t, tree.Root(), restored.Root())
+
+	snapshot.DepositRoot = make([]byte, 32)
+	_, err = DepositTreeFromSnapshot(snapshot, depth)
+	assert.ErrorContains(t, "does not match computed root", err)
+
+	snapshot.Finalized = snapshot.Finalized[1:]
+	_, err = DepositTreeFromSnapshot(snapshot, depth)
+	assert.ErrorContains(t, "finalized roots for deposit count", err)
+}
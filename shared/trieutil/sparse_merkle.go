@@ -127,6 +127,60 @@ func (m *SparseMerkleTrie) Insert(item []byte, index int) {
 	}
 }
 
+// InsertBatch inserts contiguous items into the trie starting at the given index. Unlike
+// calling Insert for each item, every intermediate node covering the batch is hashed only
+// once, which makes inserting a large number of items close to linear in their count.
+func (m *SparseMerkleTrie) InsertBatch(items [][]byte, index int) error {
+	if len(items) == 0 {
+		return nil
+	}
+	if index < 0 || index > len(m.originalItems) {
+		return fmt.Errorf("batch index %d out of range in trie with %d items", index, len(m.originalItems))
+	}
+	last := index + len(items) - 1
+	if m.depth < 64 && uint64(last) >= 1<<m.depth {
+		return fmt.Errorf("batch exceeds capacity of trie with depth %d", m.depth)
+	}
+	for last >= len(m.branches[0]) {
+		m.branches[0] = append(m.branches[0], ZeroHashes[0][:])
+	}
+	for i, item := range items {
+		leaf := bytesutil.ToBytes32(item)
+		m.branches[0][index+i] = leaf[:]
+		if index+i >= len(m.originalItems) {
+			m.originalItems = append(m.originalItems, leaf[:])
+		} else {
+			m.originalItems[index+i] = leaf[:]
+		}
+	}
+
+	// Rehash the nodes covering the batch one layer at a time.
+	lo, hi := index, last
+	for i := 0; i < int(m.depth); i++ {
+		lo, hi = lo/2, hi/2
+		for hi >= len(m.branches[i+1]) {
+			m.branches[i+1] = append(m.branches[i+1], ZeroHashes[i+1][:])
+		}
+		for parentIdx := lo; parentIdx <= hi; parentIdx++ {
+			node := make([]byte, 0, 64)
+			node = append(node, m.node(i, 2*parentIdx)...)
+			node = append(node, m.node(i, 2*parentIdx+1)...)
+			parentHash := hashutil.Hash(node)
+			m.branches[i+1][parentIdx] = parentHash[:]
+		}
+	}
+	return nil
+}
+
+// node returns the node of the given layer at the given index, defaulting to the
+// zero hash of the layer for nodes which have not been filled.
+func (m *SparseMerkleTrie) node(layer, index int) []byte {
+	if index >= len(m.branches[layer]) {
+		return ZeroHashes[layer][:]
+	}
+	return m.branches[layer][index]
+}
+
 // MerkleProof computes a proof from a trie's branches using a Merkle index.
 func (m *SparseMerkleTrie) MerkleProof(index int) ([][]byte, error) {
 	merkleIndex := uint(index)
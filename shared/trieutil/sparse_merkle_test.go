@@ -257,3 +257,45 @@ func BenchmarkVerifyMerkleBranch(b *testing.B) {
 		}
 	}
 }
+
+func TestMerkleTrie_InsertBatch(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	items := make([][]byte, 37)
+	for i := range items {
+		h := hashutil.Hash([]byte(strconv.Itoa(i)))
+		items[i] = h[:]
+	}
+	want, err := GenerateTrieFromItems(items, depth)
+	require.NoError(t, err)
+
+	m, err := NewTrie(depth)
+	require.NoError(t, err)
+	require.NoError(t, m.InsertBatch(items[:10], 0))
+	require.NoError(t, m.InsertBatch(items[10:11], 10))
+	require.NoError(t, m.InsertBatch(items[11:], 11))
+	require.Equal(t, want.HashTreeRoot(), m.HashTreeRoot())
+	require.DeepEqual(t, want.Items(), m.Items())
+	for i := range items {
+		wantProof, err := want.MerkleProof(i)
+		require.NoError(t, err)
+		proof, err := m.MerkleProof(i)
+		require.NoError(t, err)
+		require.DeepEqual(t, wantProof, proof)
+	}
+
+	// Overwriting items matches inserting them one by one.
+	h := hashutil.Hash([]byte("overwritten"))
+	want.Insert(h[:], 3)
+	want.Insert(h[:], 4)
+	require.NoError(t, m.InsertBatch([][]byte{h[:], h[:]}, 3))
+	require.Equal(t, want.HashTreeRoot(), m.HashTreeRoot())
+}
+
+func TestMerkleTrie_InsertBatch_OutOfRange(t *testing.T) {
+	m, err := NewTrie(2)
+	require.NoError(t, err)
+	items := [][]byte{{1}, {2}, {3}, {4}, {5}}
+	require.ErrorContains(t, "out of range", m.InsertBatch(items[:1], 2))
+	require.ErrorContains(t, "exceeds capacity", m.InsertBatch(items, 0))
+	require.NoError(t, m.InsertBatch(items[:4], 0))
+}
@@ -0,0 +1,77 @@
+// Package main converts the output of `go test -bench` into JSON, so the results of two
+// benchmark runs can be stored and compared with one another.
+//
+// Usage:
+//
+//	go test -run='^$' -bench=. -benchmem ./beacon-chain/core/state/ | benchjson > bench.json
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/tools/benchmark/parse"
+)
+
+var echo = flag.Bool("echo", false, "Echo the benchmark output to stderr while it is being parsed.")
+
+// result of a single benchmark.
+type result struct {
+	Package     string  `json:"package"`
+	Name        string  `json:"name"`
+	Iterations  int     `json:"iterations"`
+	NsPerOp     float64 `json:"ns_per_op"`
+	BytesPerOp  uint64  `json:"bytes_per_op,omitempty"`
+	AllocsPerOp uint64  `json:"allocs_per_op,omitempty"`
+}
+
+func main() {
+	flag.Parse()
+	var in io.Reader = os.Stdin
+	if *echo {
+		in = io.TeeReader(os.Stdin, os.Stderr)
+	}
+	results, err := parseResults(in)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not parse benchmark output: %v\n", err)
+		os.Exit(1)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(results); err != nil {
+		fmt.Fprintf(os.Stderr, "Could not encode benchmark results: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func parseResults(r io.Reader) ([]*result, error) {
+	results := make([]*result, 0)
+	pkg := ""
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "pkg: ") {
+			pkg = strings.TrimPrefix(line, "pkg: ")
+			continue
+		}
+		b, err := parse.ParseLine(line)
+		if err != nil {
+			// Not a benchmark result line.
+			continue
+		}
+		results = append(results, &result{
+			Package:     pkg,
+			Name:        b.Name,
+			Iterations:  b.N,
+			NsPerOp:     b.NsPerOp,
+			BytesPerOp:  b.AllocedBytesPerOp,
+			AllocsPerOp: b.AllocsPerOp,
+		})
+	}
+	return results, scanner.Err()
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// blockRecord is the JSON representation of a finalized block, written as a single line.
+type blockRecord struct {
+	Slot          types.Slot           `json:"slot"`
+	ProposerIndex types.ValidatorIndex `json:"proposer_index"`
+	BlockRoot     string               `json:"block_root"`
+	ParentRoot    string               `json:"parent_root"`
+	StateRoot     string               `json:"state_root"`
+	BodyRoot      string               `json:"body_root"`
+	Operations    operationCounts      `json:"operations"`
+}
+
+// operationCounts holds the number of operations of each kind included in a block.
+type operationCounts struct {
+	Attestations      int `json:"attestations"`
+	Deposits          int `json:"deposits"`
+	VoluntaryExits    int `json:"voluntary_exits"`
+	ProposerSlashings int `json:"proposer_slashings"`
+	AttesterSlashings int `json:"attester_slashings"`
+}
+
+// cursor records how far the finalized chain was exported, so that an export can be resumed.
+type cursor struct {
+	// NextSlot is the first slot which was not exported yet.
+	NextSlot types.Slot `json:"next_slot"`
+	// LastBlockRoot is the root of the last exported block, if any.
+	LastBlockRoot string `json:"last_block_root,omitempty"`
+}
+
+// exporter writes the finalized blocks of a beacon node database in slot order, one JSON
+// record per line. Blocks are exported in batches of slots and the cursor is saved after
+// each batch, so an interrupted export resumes at the start of the batch it was writing.
+// Consumers should deduplicate records by block root.
+type exporter struct {
+	db         db.ReadOnlyDatabase
+	batchSlots types.Slot
+}
+
+// export writes the finalized blocks from the cursor up to the finalized checkpoint and
+// returns the number of exported blocks.
+func (e *exporter) export(ctx context.Context, w io.Writer, c *cursor, saveCursor func(*cursor) error) (int, error) {
+	if e.batchSlots == 0 {
+		return 0, errors.New("batch size must be greater than 0")
+	}
+	if c.LastBlockRoot != "" {
+		root, err := hexutil.Decode(c.LastBlockRoot)
+		if err != nil {
+			return 0, errors.Wrap(err, "could not decode last block root of cursor")
+		}
+		if !e.db.IsFinalizedBlock(ctx, bytesutil.ToBytes32(root)) {
+			return 0, fmt.Errorf("last block %s of cursor is not finalized in this database", c.LastBlockRoot)
+		}
+	}
+	finalizedRoot, finalizedSlot, err := e.finalizedBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	exported := 0
+	enc := json.NewEncoder(w)
+	for start := c.NextSlot; start <= finalizedSlot; start = c.NextSlot {
+		end := start + e.batchSlots - 1
+		if end > finalizedSlot || end < start {
+			end = finalizedSlot
+		}
+		blks, roots, err := e.db.Blocks(ctx, filters.NewFilter().SetStartSlot(start).SetEndSlot(end))
+		if err != nil {
+			return exported, errors.Wrapf(err, "could not get blocks from slot %d to %d", start, end)
+		}
+		order := make([]int, len(blks))
+		for i := range order {
+			order[i] = i
+		}
+		sort.Slice(order, func(i, j int) bool {
+			return blks[order[i]].Block.Slot < blks[order[j]].Block.Slot
+		})
+		for _, i := range order {
+			// Blocks of the finalized epoch are indexed as finalized even when they are not
+			// canonical, so only the finalized block itself is kept at its slot.
+			if !e.db.IsFinalizedBlock(ctx, roots[i]) {
+				continue
+			}
+			if blks[i].Block.Slot == finalizedSlot && roots[i] != finalizedRoot {
+				continue
+			}
+			record, err := newBlockRecord(blks[i], roots[i])
+			if err != nil {
+				return exported, err
+			}
+			if err := enc.Encode(record); err != nil {
+				return exported, errors.Wrap(err, "could not write block record")
+			}
+			c.LastBlockRoot = record.BlockRoot
+			exported++
+		}
+		c.NextSlot = end + 1
+		if err := saveCursor(c); err != nil {
+			return exported, errors.Wrap(err, "could not save cursor")
+		}
+	}
+	return exported, nil
+}
+
+// finalizedBlock returns the root and slot of the block of the finalized checkpoint, which
+// is the genesis block until the first epoch is finalized.
+func (e *exporter) finalizedBlock(ctx context.Context) ([32]byte, types.Slot, error) {
+	cp, err := e.db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return [32]byte{}, 0, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	var blk *ethpb.SignedBeaconBlock
+	root := bytesutil.ToBytes32(cp.Root)
+	if root == params.BeaconConfig().ZeroHash {
+		blk, err = e.db.GenesisBlock(ctx)
+		if err != nil {
+			return [32]byte{}, 0, errors.Wrap(err, "could not get genesis block")
+		}
+		if blk == nil || blk.Block == nil {
+			return [32]byte{}, 0, errors.New("no genesis block in database")
+		}
+		root, err = blk.Block.HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, 0, err
+		}
+	} else {
+		blk, err = e.db.Block(ctx, root)
+		if err != nil {
+			return [32]byte{}, 0, errors.Wrap(err, "could not get finalized block")
+		}
+		if blk == nil || blk.Block == nil {
+			return [32]byte{}, 0, fmt.Errorf("finalized block %#x not found in database", root)
+		}
+	}
+	return root, blk.Block.Slot, nil
+}
+
+func newBlockRecord(signed *ethpb.SignedBeaconBlock, root [32]byte) (*blockRecord, error) {
+	b := signed.Block
+	if b.Body == nil {
+		return nil, fmt.Errorf("block %#x has no body", root)
+	}
+	bodyRoot, err := b.Body.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not hash body of block %#x", root)
+	}
+	return &blockRecord{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		BlockRoot:     fmt.Sprintf("%#x", root),
+		ParentRoot:    fmt.Sprintf("%#x", b.ParentRoot),
+		StateRoot:     fmt.Sprintf("%#x", b.StateRoot),
+		BodyRoot:      fmt.Sprintf("%#x", bodyRoot),
+		Operations: operationCounts{
+			Attestations:      len(b.Body.Attestations),
+			Deposits:          len(b.Body.Deposits),
+			VoluntaryExits:    len(b.Body.VoluntaryExits),
+			ProposerSlashings: len(b.Body.ProposerSlashings),
+			AttesterSlashings: len(b.Body.AttesterSlashings),
+		},
+	}, nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func readRecords(t *testing.T, buf *bytes.Buffer) []*blockRecord {
+	var records []*blockRecord
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		record := &blockRecord{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestExporter_Export(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	// The canonical chain has a block at every slot, with an attestation in each block.
+	roots := map[types.Slot][32]byte{0: genesisRoot}
+	parentRoot := genesisRoot
+	for slot := types.Slot(1); slot <= 3*slotsPerEpoch; slot++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		parent := parentRoot
+		blk.Block.ParentRoot = parent[:]
+		blk.Block.Body.Attestations = []*ethpb.Attestation{testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.NewBitlist(1)})}
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		parentRoot, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[slot] = parentRoot
+	}
+	// A fork block which never becomes finalized.
+	fork := testutil.NewBeaconBlock()
+	fork.Block.Slot = 3
+	fork.Block.ParentRoot = genesisRoot[:]
+	fork.Block.Body.Graffiti = bytesutil.PadTo([]byte("fork"), 32)
+	require.NoError(t, db.SaveBlock(ctx, fork))
+
+	finalize := func(epoch types.Epoch, slot types.Slot) {
+		root := roots[slot]
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveState(ctx, st, root))
+		require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: epoch, Root: root[:]}))
+	}
+	checkRecords := func(records []*blockRecord, from, to types.Slot) {
+		require.Equal(t, int(to-from+1), len(records))
+		for i, record := range records {
+			slot := from + types.Slot(i)
+			assert.Equal(t, slot, record.Slot)
+			assert.Equal(t, fmt.Sprintf("%#x", roots[slot]), record.BlockRoot)
+			if slot > 0 {
+				assert.Equal(t, fmt.Sprintf("%#x", roots[slot-1]), record.ParentRoot)
+				assert.Equal(t, 1, record.Operations.Attestations)
+			}
+		}
+	}
+
+	finalize(2, 2*slotsPerEpoch)
+	e := &exporter{db: db, batchSlots: 5}
+	c := &cursor{}
+	saves := 0
+	save := func(*cursor) error {
+		saves++
+		return nil
+	}
+	buf := new(bytes.Buffer)
+	exported, err := e.export(ctx, buf, c, save)
+	require.NoError(t, err)
+	assert.Equal(t, int(2*slotsPerEpoch+1), exported)
+	checkRecords(readRecords(t, buf), 0, 2*slotsPerEpoch)
+	assert.Equal(t, 2*slotsPerEpoch+1, c.NextSlot)
+	assert.Equal(t, fmt.Sprintf("%#x", roots[2*slotsPerEpoch]), c.LastBlockRoot)
+	assert.Equal(t, int((2*slotsPerEpoch+5)/5), saves)
+
+	// Nothing is exported until the finalized checkpoint advances.
+	exported, err = e.export(ctx, buf, c, save)
+	require.NoError(t, err)
+	assert.Equal(t, 0, exported)
+	assert.Equal(t, 0, buf.Len())
+
+	// The export resumes from the cursor.
+	finalize(3, 3*slotsPerEpoch)
+	exported, err = e.export(ctx, buf, c, save)
+	require.NoError(t, err)
+	assert.Equal(t, int(slotsPerEpoch), exported)
+	checkRecords(readRecords(t, buf), 2*slotsPerEpoch+1, 3*slotsPerEpoch)
+}
+
+func TestExporter_Export_CursorNotFinalized(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	e := &exporter{db: db, batchSlots: 5}
+	c := &cursor{NextSlot: 10, LastBlockRoot: fmt.Sprintf("%#x", bytesutil.PadTo([]byte("root"), 32))}
+	_, err := e.export(ctx, new(bytes.Buffer), c, func(*cursor) error { return nil })
+	assert.ErrorContains(t, "is not finalized", err)
+}
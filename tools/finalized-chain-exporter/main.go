@@ -0,0 +1,112 @@
+/**
+ * Finalized chain exporter
+ *
+ * Given a DB, this tool writes the finalized blocks of the chain to a file as newline-delimited
+ * JSON, with the block header, the block root and the number of operations of each kind per
+ * line. It is meant for consumers which cannot read SSZ or use the gRPC API. The progress of
+ * the export is saved in a cursor file, so running the tool again appends the blocks which
+ * were finalized since the previous run.
+ */
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var (
+	// Required fields
+	datadir = flag.String("datadir", "", "Path to data directory.")
+	output  = flag.String("output", "", "Path of the JSON lines file the finalized blocks are appended to.")
+
+	cursorPath = flag.String("cursor", "", "Path of the cursor file used to resume the export. Defaults to the output path with a .cursor suffix.")
+	batchSlots = flag.Uint64("batch-slots", 1024, "Number of slots exported between two saves of the cursor.")
+)
+
+func main() {
+	flag.Parse()
+	if *datadir == "" || *output == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if *cursorPath == "" {
+		*cursorPath = *output + ".cursor"
+	}
+	ctx := context.Background()
+	d, err := db.NewDB(ctx, *datadir, &kv.Config{})
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			panic(err)
+		}
+	}()
+
+	c, err := loadCursor(*cursorPath)
+	if err != nil {
+		panic(err)
+	}
+	f, err := os.OpenFile(*output, os.O_APPEND|os.O_CREATE|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			panic(err)
+		}
+	}()
+
+	e := &exporter{db: d, batchSlots: types.Slot(*batchSlots)}
+	exported, err := e.export(ctx, f, c, func(c *cursor) error {
+		// Records have to be on disk before the cursor moves past them.
+		if err := f.Sync(); err != nil {
+			return err
+		}
+		return saveCursor(*cursorPath, c)
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Exported %d finalized blocks, next slot to export is %d\n", exported, c.NextSlot)
+}
+
+// loadCursor reads the cursor file, starting a new export from genesis if it does not exist.
+func loadCursor(path string) (*cursor, error) {
+	if !fileutil.FileExists(path) {
+		return &cursor{}, nil
+	}
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &cursor{}
+	if err := json.Unmarshal(enc, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// saveCursor replaces the cursor file, writing the new cursor to a temporary file first so
+// that the cursor file is never left partially written.
+func saveCursor(path string, c *cursor) error {
+	enc, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := fileutil.WriteFile(tmpPath, enc); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,88 @@
+// Package validator runs validator duties for the deterministic interop keys in-process with
+// a beacon node, so that a single beacon node can produce a chain on its own.
+package validator
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var log = logrus.WithField("prefix", "interop-validator")
+
+const (
+	// validatorDirName is the directory, relative to the data directory, holding the
+	// slashing protection database of the in-process validator.
+	validatorDirName = "interop-validator"
+	// grpcRetries and grpcRetryDelay match the validator client defaults.
+	grpcRetries    = 5
+	grpcRetryDelay = time.Second
+)
+
+// Service runs the validator client for the interop keys, and owns the slashing protection
+// database of those keys.
+type Service struct {
+	*client.ValidatorService
+	valDB *kv.Store
+}
+
+// NewService creates a validator service for every interop genesis validator, which connects
+// to the RPC server of the beacon node configured by the given flags.
+func NewService(ctx context.Context, cliCtx *cli.Context) (shared.Service, error) {
+	numValidators := cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
+	keyManager, err := imported.NewInteropKeymanager(ctx, 0 /* offset */, numValidators)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create interop keymanager")
+	}
+	pubKeys, err := keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch interop public keys")
+	}
+
+	dataDir := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), validatorDirName)
+	valDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{PubKeys: pubKeys})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open interop validator database")
+	}
+
+	endpoint := net.JoinHostPort(cliCtx.String(flags.RPCHost.Name), cliCtx.String(flags.RPCPort.Name))
+	v, err := client.NewValidatorService(ctx, &client.Config{
+		Endpoint:                   endpoint,
+		DataDir:                    dataDir,
+		KeyManager:                 keyManager,
+		ValDB:                      valDB,
+		CertFlag:                   cliCtx.String(flags.CertFlag.Name),
+		GrpcMaxCallRecvMsgSizeFlag: cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		GrpcRetriesFlag:            grpcRetries,
+		GrpcRetryDelay:             grpcRetryDelay,
+		GraffitiStruct:             &graffiti.Graffiti{},
+	})
+	if err != nil {
+		if closeErr := valDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close interop validator database")
+		}
+		return nil, errors.Wrap(err, "could not initialize interop validator service")
+	}
+	log.WithField("endpoint", endpoint).Infof("Running %d interop validators in-process", numValidators)
+	return &Service{ValidatorService: v, valDB: valDB}, nil
+}
+
+// Stop stops the validator client, then closes its database.
+func (s *Service) Stop() error {
+	if err := s.ValidatorService.Stop(); err != nil {
+		return err
+	}
+	return s.valDB.Close()
+}
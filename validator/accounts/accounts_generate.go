@@ -0,0 +1,108 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/urfave/cli/v2"
+)
+
+const depositDataPromptText = "Enter the directory where the deposit data of the new accounts will be written to"
+
+// GenerateAccountsConfig to run the generate accounts function.
+type GenerateAccountsConfig struct {
+	Keymanager       *derived.Keymanager
+	Mnemonic         string
+	Mnemonic25thWord string
+	NumAccounts      int
+	DepositDataDir   string
+}
+
+// GenerateAccountsCli derives a batch of new validator accounts in an HD wallet from the
+// mnemonic of the wallet, and writes their deposit data to a JSON file. This uses the CLI
+// to extract necessary values to run the function.
+func GenerateAccountsCli(cliCtx *cli.Context) error {
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize wallet")
+	}
+	if w.KeymanagerKind() != keymanager.Derived {
+		return errors.New("only HD wallets can generate accounts from a mnemonic")
+	}
+	km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	if err != nil {
+		return errors.Wrap(err, ErrCouldNotInitializeKeymanager)
+	}
+	derivedKM, ok := km.(*derived.Keymanager)
+	if !ok {
+		return errors.New("could not assert keymanager interface to concrete type")
+	}
+	mnemonic, err := inputMnemonic(cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not get mnemonic phrase")
+	}
+	mnemonicPassphrase, err := inputMnemonic25thWord(cliCtx)
+	if err != nil {
+		return err
+	}
+	numAccounts, err := inputNumAccounts(cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not get number of accounts to generate")
+	}
+	depositDataDir, err := prompt.InputDirectory(cliCtx, depositDataPromptText, flags.DepositDataDirFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not parse deposit data directory")
+	}
+	_, err = GenerateAccounts(cliCtx.Context, &GenerateAccountsConfig{
+		Keymanager:       derivedKM,
+		Mnemonic:         mnemonic,
+		Mnemonic25thWord: mnemonicPassphrase,
+		NumAccounts:      int(numAccounts),
+		DepositDataDir:   depositDataDir,
+	})
+	return err
+}
+
+// GenerateAccounts derives a batch of new accounts from a mnemonic into an HD wallet and
+// writes their deposit data, for the maximum effective balance, to a JSON file in the format
+// of the eth2.0-deposit-cli. It returns the path of the deposit data file.
+func GenerateAccounts(ctx context.Context, cfg *GenerateAccountsConfig) (string, error) {
+	accounts, err := cfg.Keymanager.GenerateAccountsFromMnemonic(ctx, cfg.Mnemonic, cfg.Mnemonic25thWord, cfg.NumAccounts)
+	if err != nil {
+		return "", errors.Wrap(err, "could not generate accounts")
+	}
+	depositData, err := derived.DepositDataForAccounts(accounts, params.BeaconConfig().MaxEffectiveBalance)
+	if err != nil {
+		return "", err
+	}
+	enc, err := json.MarshalIndent(depositData, "", "\t")
+	if err != nil {
+		return "", errors.Wrap(err, "could not encode deposit data")
+	}
+	if err := fileutil.MkdirAll(cfg.DepositDataDir); err != nil {
+		return "", errors.Wrap(err, "could not create deposit data directory")
+	}
+	depositDataPath := filepath.Join(cfg.DepositDataDir, fmt.Sprintf("deposit_data-%d.json", timeutils.Now().Unix()))
+	if err := fileutil.WriteFile(depositDataPath, enc); err != nil {
+		return "", errors.Wrap(err, "could not write deposit data")
+	}
+	log.WithField("depositDataPath", depositDataPath).Infof(
+		"Successfully generated %d accounts. Please use `accounts list` to view details for your accounts",
+		len(accounts),
+	)
+	return depositDataPath, nil
+}
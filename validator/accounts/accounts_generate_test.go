@@ -0,0 +1,90 @@
+package accounts
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/urfave/cli/v2"
+)
+
+func createGenerateCliCtx(t *testing.T, cfg *recoverCfgStruct, depositDataDir string) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.WalletDirFlag.Name, cfg.walletDir, "")
+	set.String(flags.WalletPasswordFileFlag.Name, cfg.passwordFilePath, "")
+	set.String(flags.MnemonicFileFlag.Name, cfg.mnemonicFilePath, "")
+	set.Bool(flags.SkipMnemonic25thWordCheckFlag.Name, true, "")
+	set.Int64(flags.NumAccountsFlag.Name, cfg.numAccounts, "")
+	set.String(flags.DepositDataDirFlag.Name, depositDataDir, "")
+	assert.NoError(t, set.Set(flags.WalletDirFlag.Name, cfg.walletDir))
+	assert.NoError(t, set.Set(flags.WalletPasswordFileFlag.Name, cfg.passwordFilePath))
+	assert.NoError(t, set.Set(flags.MnemonicFileFlag.Name, cfg.mnemonicFilePath))
+	assert.NoError(t, set.Set(flags.SkipMnemonic25thWordCheckFlag.Name, "true"))
+	assert.NoError(t, set.Set(flags.NumAccountsFlag.Name, "3"))
+	assert.NoError(t, set.Set(flags.DepositDataDirFlag.Name, depositDataDir))
+	return cli.NewContext(&app, set, nil)
+}
+
+func TestGenerateAccountsCli(t *testing.T) {
+	cfg := setupRecoverCfg(t)
+	cfg.numAccounts = 2
+	require.NoError(t, RecoverWalletCli(createRecoverCliCtx(t, cfg)))
+
+	depositDataDir := filepath.Join(t.TempDir(), "deposits")
+	cliCtx := createGenerateCliCtx(t, cfg, depositDataDir)
+	require.NoError(t, GenerateAccountsCli(cliCtx))
+
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{
+		WalletDir:      cfg.walletDir,
+		WalletPassword: password,
+	})
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	pubKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	assert.Equal(t, 5, len(pubKeys))
+
+	files, err := ioutil.ReadDir(depositDataDir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	enc, err := ioutil.ReadFile(filepath.Join(depositDataDir, files[0].Name()))
+	require.NoError(t, err)
+	var depositData []*derived.DepositDataJSON
+	require.NoError(t, json.Unmarshal(enc, &depositData))
+	require.Equal(t, 3, len(depositData))
+	for _, dd := range depositData {
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, dd.Amount)
+	}
+}
+
+func TestGenerateAccountsCli_ImportedWallet(t *testing.T) {
+	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:           walletDir,
+		passwordsDir:        passwordFilePath,
+		keymanagerKind:      keymanager.Imported,
+		walletPasswordFile:  passwordFilePath,
+		accountPasswordFile: passwordFilePath,
+	})
+	_, err := CreateWalletWithKeymanager(cliCtx.Context, &CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      walletDir,
+			KeymanagerKind: keymanager.Imported,
+			WalletPassword: password,
+		},
+	})
+	require.NoError(t, err)
+	assert.ErrorContains(t, "only HD wallets can generate accounts", GenerateAccountsCli(cliCtx))
+}
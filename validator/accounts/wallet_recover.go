@@ -49,35 +49,11 @@ func RecoverWalletCli(cliCtx *cli.Context) error {
 	config := &RecoverWalletConfig{
 		Mnemonic: mnemonic,
 	}
-	skipMnemonic25thWord := cliCtx.IsSet(flags.SkipMnemonic25thWordCheckFlag.Name)
-	has25thWordFile := cliCtx.IsSet(flags.Mnemonic25thWordFileFlag.Name)
-	if !skipMnemonic25thWord && !has25thWordFile {
-		resp, err := promptutil.ValidatePrompt(
-			os.Stdin, mnemonicPassphraseYesNoText, promptutil.ValidateYesOrNo,
-		)
-		if err != nil {
-			return errors.Wrap(err, "could not validate choice")
-		}
-		if strings.EqualFold(resp, "y") {
-			mnemonicPassphrase, err := promptutil.InputPassword(
-				cliCtx,
-				flags.Mnemonic25thWordFileFlag,
-				mnemonicPassphrasePromptText,
-				"Confirm mnemonic passphrase",
-				false, /* Should confirm password */
-				func(input string) error {
-					if strings.TrimSpace(input) == "" {
-						return errors.New("input cannot be empty")
-					}
-					return nil
-				},
-			)
-			if err != nil {
-				return err
-			}
-			config.Mnemonic25thWord = mnemonicPassphrase
-		}
+	mnemonicPassphrase, err := inputMnemonic25thWord(cliCtx)
+	if err != nil {
+		return err
 	}
+	config.Mnemonic25thWord = mnemonicPassphrase
 	walletDir, err := prompt.InputDirectory(cliCtx, prompt.WalletDirPromptText, flags.WalletDirFlag)
 	if err != nil {
 		return err
@@ -197,6 +173,40 @@ func inputMnemonic(cliCtx *cli.Context) (mnemonicPhrase string, err error) {
 	return mnemonicPhrase, nil
 }
 
+// inputMnemonic25thWord prompts for the optional '25th word' passphrase of a mnemonic,
+// unless the check is skipped or the passphrase is given as a file.
+func inputMnemonic25thWord(cliCtx *cli.Context) (string, error) {
+	skipMnemonic25thWord := cliCtx.IsSet(flags.SkipMnemonic25thWordCheckFlag.Name)
+	has25thWordFile := cliCtx.IsSet(flags.Mnemonic25thWordFileFlag.Name)
+	if skipMnemonic25thWord {
+		return "", nil
+	}
+	if !has25thWordFile {
+		resp, err := promptutil.ValidatePrompt(
+			os.Stdin, mnemonicPassphraseYesNoText, promptutil.ValidateYesOrNo,
+		)
+		if err != nil {
+			return "", errors.Wrap(err, "could not validate choice")
+		}
+		if !strings.EqualFold(resp, "y") {
+			return "", nil
+		}
+	}
+	return promptutil.InputPassword(
+		cliCtx,
+		flags.Mnemonic25thWordFileFlag,
+		mnemonicPassphrasePromptText,
+		"Confirm mnemonic passphrase",
+		false, /* Should confirm password */
+		func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return errors.New("input cannot be empty")
+			}
+			return nil
+		},
+	)
+}
+
 func inputNumAccounts(cliCtx *cli.Context) (int64, error) {
 	if cliCtx.IsSet(flags.NumAccountsFlag.Name) {
 		numAccounts := cliCtx.Int64(flags.NumAccountsFlag.Name)
@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
@@ -21,7 +20,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
-	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -37,19 +35,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubK
 
 	v.waitOneThirdOrValidBlock(ctx, slot)
 
-	var b strings.Builder
-	if err := b.WriteByte(byte(iface.RoleAttester)); err != nil {
-		log.WithError(err).Error("Could not write role byte for lock key")
-		traceutil.AnnotateError(span, err)
-		return
-	}
-	_, err := b.Write(pubKey[:])
-	if err != nil {
-		log.WithError(err).Error("Could not write pubkey bytes for lock key")
-		traceutil.AnnotateError(span, err)
-		return
-	}
-	lock := mputil.NewMultilock(b.String())
+	lock := mputil.NewMultilock(attesterLockKey(pubKey))
 	lock.Lock()
 	defer lock.Unlock()
 
@@ -15,6 +15,7 @@ import (
 
 var failedAttLocalProtectionErr = "attempted to make slashable attestation, rejected by local slashing protection"
 var failedPostAttSignExternalErr = "attempted to make slashable attestation, rejected by external slasher service"
+var failedAttBlacklistedErr = "attempted to sign an attestation with a public key found slashable in an imported slashing protection history"
 
 // Checks if an attestation is slashable by comparing it with the attesting
 // history for the given public key in our DB. If it is not, we then update the history
@@ -28,6 +29,10 @@ func (v *validator) slashableAttestationCheck(
 	ctx, span := trace.StartSpan(ctx, "validator.postAttSignUpdate")
 	defer span.End()
 
+	if v.isEIPImportBlacklisted(pubKey) {
+		return errors.New(failedAttBlacklistedErr)
+	}
+
 	// Based on EIP3076, validator should refuse to sign any attestation with source epoch less
 	// than the minimum source epoch present in that signer’s attestations.
 	lowestSourceEpoch, exists, err := v.db.LowestSignedSourceEpoch(ctx, pubKey)
@@ -168,3 +168,29 @@ func Test_slashableAttestationCheck_GenesisEpoch(t *testing.T) {
 	require.Equal(t, true, exists)
 	require.Equal(t, types.Epoch(0), e)
 }
+
+func Test_slashableAttestationCheck_RefusesBlacklistedKey(t *testing.T) {
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 2},
+		Data: &ethpb.AttestationData{
+			Slot:            5,
+			CommitteeIndex:  2,
+			BeaconBlockRoot: bytesutil.PadTo([]byte("great block"), 32),
+			Source: &ethpb.Checkpoint{
+				Epoch: 4,
+				Root:  bytesutil.PadTo([]byte("good source"), 32),
+			},
+			Target: &ethpb.Checkpoint{
+				Epoch: 10,
+				Root:  bytesutil.PadTo([]byte("good target"), 32),
+			},
+		},
+	}
+	validator.eipImportBlacklistedPublicKeys = map[[48]byte]bool{pubKey: true}
+	err := validator.slashableAttestationCheck(context.Background(), att, pubKey, [32]byte{1})
+	require.ErrorContains(t, failedAttBlacklistedErr, err)
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errDoppelGangerDetected = errors.New("activity of validating keys detected, they are likely used by another validator client")
+
+// CheckDoppelGanger observes the network for blocks and attestations of the validating keys during
+// the configured number of epochs, before any duty is performed, and returns an error if any are
+// seen as the keys are then likely used by another validator client. The observation starts at the
+// epoch after the current one, so that the attestations made by this validator client before a
+// restart are not mistaken for the ones of a doppelganger.
+func (v *validator) CheckDoppelGanger(ctx context.Context) error {
+	if v.doppelGangerEpochs == 0 {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.CheckDoppelGanger")
+	defer span.End()
+
+	indices, err := v.signingValidatorIndices(ctx)
+	if err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+	startEpoch := slotutil.EpochsSinceGenesis(time.Unix(int64(v.genesisTime), 0)) + 1
+	endEpoch := startEpoch + v.doppelGangerEpochs
+	log.WithFields(logrus.Fields{
+		"startEpoch":    startEpoch,
+		"endEpoch":      endEpoch,
+		"numValidators": len(indices),
+	}).Info("Observing the network for activity of the validating keys before performing duties")
+	for epoch := startEpoch + 1; epoch <= endEpoch; epoch++ {
+		// Check one slot into the epoch, so that the attestations of the last slot of the previous
+		// epoch are received.
+		slot, err := helpers.StartSlot(epoch)
+		if err != nil {
+			return err
+		}
+		if err := waitUntil(ctx, slotutil.SlotStartTime(v.genesisTime, slot+1)); err != nil {
+			return err
+		}
+		// Attestations can be included up to an epoch after their target, so the previous epoch is
+		// checked again.
+		from := startEpoch
+		if epoch > startEpoch+1 {
+			from = epoch - 2
+		}
+		if err := v.checkDoppelGanger(ctx, indices, from); err != nil {
+			return err
+		}
+	}
+	log.Info("No activity of the validating keys detected")
+	return nil
+}
+
+// signingValidatorIndices returns the indices of the validating keys which can sign blocks or
+// attestations.
+func (v *validator) signingValidatorIndices(ctx context.Context) ([]types.ValidatorIndex, error) {
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, msgCouldNotFetchKeys)
+	}
+	publicKeys := make([][]byte, len(validatingKeys))
+	for i := range validatingKeys {
+		publicKeys[i] = validatingKeys[i][:]
+	}
+	resp, err := v.validatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{PublicKeys: publicKeys})
+	if err != nil {
+		return nil, errors.Wrap(iface.ErrConnectionIssue, errors.Wrap(err, "could not get validator statuses").Error())
+	}
+	if len(resp.Statuses) != len(resp.Indices) {
+		return nil, errors.New("number of statuses did not match number of indices")
+	}
+	var indices []types.ValidatorIndex
+	for i, st := range resp.Statuses {
+		switch st.Status {
+		case ethpb.ValidatorStatus_ACTIVE, ethpb.ValidatorStatus_EXITING, ethpb.ValidatorStatus_SLASHING:
+			indices = append(indices, resp.Indices[i])
+		}
+	}
+	return indices, nil
+}
+
+// checkDoppelGanger returns an error if any of the validators proposed a block or attested since the
+// start of the epoch.
+func (v *validator) checkDoppelGanger(ctx context.Context, indices []types.ValidatorIndex, epoch types.Epoch) error {
+	resp, err := v.doppelGangerClient.CheckDoppelGanger(ctx, &pbrpc.DoppelGangerRequest{
+		Epoch:            epoch,
+		ValidatorIndices: indices,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return errors.New("beacon node does not support doppelganger checks")
+	}
+	if err != nil {
+		return errors.Wrap(iface.ErrConnectionIssue, errors.Wrap(err, "could not check for doppelgangers").Error())
+	}
+	var live []types.ValidatorIndex
+	for _, val := range resp.Validators {
+		if !val.IsLive {
+			continue
+		}
+		live = append(live, val.ValidatorIndex)
+		log.WithFields(logrus.Fields{
+			"validatorIndex": val.ValidatorIndex,
+			"lastActiveSlot": val.LastActiveSlot,
+		}).Error("Detected activity of validating key")
+	}
+	if len(live) > 0 {
+		return errors.Wrap(errDoppelGangerDetected, fmt.Sprintf("validator indices %v", live))
+	}
+	return nil
+}
+
+// waitUntil blocks until the given time or until the context is canceled.
+func waitUntil(ctx context.Context, t time.Time) error {
+	wait := timeutils.Until(t)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
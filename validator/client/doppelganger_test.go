@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/validator/client/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDoppelGangerClient reports the validators of its live set as live, and records the requests.
+type fakeDoppelGangerClient struct {
+	live     map[types.ValidatorIndex]bool
+	err      error
+	requests []*pbrpc.DoppelGangerRequest
+}
+
+func (f *fakeDoppelGangerClient) CheckDoppelGanger(_ context.Context, req *pbrpc.DoppelGangerRequest, _ ...grpc.CallOption) (*pbrpc.DoppelGangerResponse, error) {
+	f.requests = append(f.requests, req)
+	if f.err != nil {
+		return nil, f.err
+	}
+	res := &pbrpc.DoppelGangerResponse{}
+	for _, idx := range req.ValidatorIndices {
+		liveness := &pbrpc.ValidatorLiveness{ValidatorIndex: idx, IsLive: f.live[idx]}
+		if liveness.IsLive {
+			liveness.LastActiveSlot = 10
+		}
+		res.Validators = append(res.Validators, liveness)
+	}
+	return res, nil
+}
+
+// doppelGangerValidator returns a validator with three keys of indices 0, 1 and 2, of which the
+// first and last can sign.
+func doppelGangerValidator(t *testing.T, ctrl *gomock.Controller, dc *fakeDoppelGangerClient) *validator {
+	km := &mockKeymanager{keysMap: make(map[[48]byte]bls.SecretKey)}
+	var pubKeys [][]byte
+	for i := 0; i < 3; i++ {
+		privKey, err := bls.RandKey()
+		require.NoError(t, err)
+		pubKey := [48]byte{}
+		copy(pubKey[:], privKey.PublicKey().Marshal())
+		km.keysMap[pubKey] = privKey
+		pubKeys = append(pubKeys, pubKey[:])
+	}
+	resp := testutil.GenerateMultipleValidatorStatusResponse(pubKeys)
+	resp.Statuses[0].Status = ethpb.ValidatorStatus_ACTIVE
+	resp.Statuses[1].Status = ethpb.ValidatorStatus_PENDING
+	resp.Statuses[2].Status = ethpb.ValidatorStatus_EXITING
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.MultipleValidatorStatusRequest, _ ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error) {
+			// Keys are fetched from a map, so order the response as requested.
+			ordered := testutil.GenerateMultipleValidatorStatusResponse(req.PublicKeys)
+			for i, key := range req.PublicKeys {
+				for j := range pubKeys {
+					if string(pubKeys[j]) == string(key) {
+						ordered.Statuses[i] = resp.Statuses[j]
+						ordered.Indices[i] = resp.Indices[j]
+					}
+				}
+			}
+			return ordered, nil
+		}).AnyTimes()
+	return &validator{
+		keyManager:         km,
+		validatorClient:    client,
+		doppelGangerClient: dc,
+		doppelGangerEpochs: 1,
+	}
+}
+
+func TestCheckDoppelGanger_Disabled(t *testing.T) {
+	v := &validator{}
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+}
+
+func TestCheckDoppelGanger_NoActivity(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SlotsPerEpoch = 1
+	cfg.SecondsPerSlot = 1
+	params.OverrideBeaconConfig(cfg)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	hook := logTest.NewGlobal()
+
+	dc := &fakeDoppelGangerClient{live: map[types.ValidatorIndex]bool{1: true}}
+	v := doppelGangerValidator(t, ctrl, dc)
+	v.genesisTime = uint64(time.Now().Unix())
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+	require.Equal(t, 1, len(dc.requests))
+	assert.Equal(t, true, dc.requests[0].Epoch > 0)
+	indices := dc.requests[0].ValidatorIndices
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	assert.DeepEqual(t, []types.ValidatorIndex{0, 2}, indices)
+	assert.LogsContain(t, hook, "No activity of the validating keys detected")
+}
+
+func TestCheckDoppelGanger_ActivityDetected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	hook := logTest.NewGlobal()
+
+	dc := &fakeDoppelGangerClient{live: map[types.ValidatorIndex]bool{2: true}}
+	v := doppelGangerValidator(t, ctrl, dc)
+	indices, err := v.signingValidatorIndices(context.Background())
+	require.NoError(t, err)
+	err = v.checkDoppelGanger(context.Background(), indices, 3)
+	require.ErrorContains(t, "validator indices [2]", err)
+	assert.Equal(t, true, errors.Is(err, errDoppelGangerDetected))
+	assert.Equal(t, types.Epoch(3), dc.requests[0].Epoch)
+	assert.LogsContain(t, hook, "Detected activity of validating key")
+}
+
+func TestCheckDoppelGanger_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dc := &fakeDoppelGangerClient{err: status.Error(codes.Unimplemented, "unknown service")}
+	v := doppelGangerValidator(t, ctrl, dc)
+	err := v.checkDoppelGanger(context.Background(), []types.ValidatorIndex{0}, 0)
+	require.ErrorContains(t, "beacon node does not support doppelganger checks", err)
+	assert.Equal(t, false, errors.Is(err, iface.ErrConnectionIssue))
+
+	dc.err = status.Error(codes.Unavailable, "syncing")
+	err = v.checkDoppelGanger(context.Background(), []types.ValidatorIndex{0}, 0)
+	assert.Equal(t, true, errors.Is(err, iface.ErrConnectionIssue))
+}
@@ -15,11 +15,18 @@ import (
 var failedPreBlockSignLocalErr = "attempted to sign a double proposal, block rejected by local protection"
 var failedPreBlockSignExternalErr = "attempted a double proposal, block rejected by remote slashing protection"
 var failedPostBlockSignErr = "made a double proposal, considered slashable by remote slashing protection"
+var failedPreBlockSignBlacklistedErr = "attempted to sign a block with a public key found slashable in an imported slashing protection history"
 
 func (v *validator) preBlockSignValidations(
 	ctx context.Context, pubKey [48]byte, block *ethpb.BeaconBlock, signingRoot [32]byte,
 ) error {
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	if v.isEIPImportBlacklisted(pubKey) {
+		if v.emitAccountMetrics {
+			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		return errors.New(failedPreBlockSignBlacklistedErr)
+	}
 
 	prevSigningRoot, proposalAtSlotExists, err := v.db.ProposalHistoryForSlot(ctx, pubKey, block.Slot)
 	if err != nil {
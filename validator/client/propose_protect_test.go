@@ -158,3 +158,19 @@ func TestPostBlockSignUpdate(t *testing.T) {
 	err = validator.postBlockSignUpdate(context.Background(), pubKey, emptyBlock, [32]byte{})
 	require.NoError(t, err, "Expected allowed block not to throw error")
 }
+
+func TestPreBlockSignLocalValidation_RefusesBlacklistedKey(t *testing.T) {
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	block := &ethpb.BeaconBlock{
+		Slot:          10,
+		ProposerIndex: 0,
+	}
+	require.NoError(t, validator.preBlockSignValidations(context.Background(), pubKey, block, [32]byte{2}))
+
+	validator.eipImportBlacklistedPublicKeys = map[[48]byte]bool{pubKey: true}
+	err := validator.preBlockSignValidations(context.Background(), pubKey, block, [32]byte{2})
+	require.ErrorContains(t, failedPreBlockSignBlacklistedErr, err)
+}
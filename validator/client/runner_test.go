@@ -61,6 +61,12 @@ func TestCancelledContext_WaitsForActivation(t *testing.T) {
 	assert.Equal(t, 1, v.WaitForActivationCalled, "Expected WaitForActivation() to be called")
 }
 
+func TestCancelledContext_ChecksDoppelGanger(t *testing.T) {
+	v := &testutil.FakeValidator{Keymanager: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
+	run(cancelledContext(), v)
+	assert.Equal(t, 1, v.CheckDoppelGangerCalled, "Expected CheckDoppelGanger() to be called")
+}
+
 func TestCancelledContext_ChecksSlasherReady(t *testing.T) {
 	v := &testutil.FakeValidator{Keymanager: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
 	cfg := &featureconfig.Flags{
@@ -0,0 +1,34 @@
+package client
+
+import (
+	"github.com/prysmaticlabs/prysm/shared/mputil"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+)
+
+// proposerLockKeys returns the keys of the lock held while proposing a block with a public key.
+func proposerLockKeys(pubKey [48]byte) []string {
+	return []string{string(rune(iface.RoleProposer)), string(pubKey[:])}
+}
+
+// attesterLockKey returns the key of the lock held while attesting with a public key.
+func attesterLockKey(pubKey [48]byte) string {
+	return string([]byte{byte(iface.RoleAttester)}) + string(pubKey[:])
+}
+
+// LockSigning stops the validator client from proposing blocks and attesting with the given
+// public keys until the returned function is called. It waits for the duties in progress with
+// those keys to complete, including the update of their slashing protection history, so that
+// the history can be exported without missing anything signed concurrently.
+func LockSigning(pubKeys [][48]byte) (unlock func()) {
+	if len(pubKeys) == 0 {
+		return func() {}
+	}
+	keys := make([]string, 0, 3*len(pubKeys))
+	for _, pubKey := range pubKeys {
+		keys = append(keys, proposerLockKeys(pubKey)...)
+		keys = append(keys, attesterLockKey(pubKey))
+	}
+	lock := mputil.NewMultilock(keys...)
+	lock.Lock()
+	return lock.Unlock
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/mputil"
+)
+
+func TestLockSigning(t *testing.T) {
+	pubKey, otherPubKey := [48]byte{1}, [48]byte{2}
+	unlock := LockSigning([][48]byte{pubKey})
+
+	// Duties of other keys are not blocked.
+	lock := mputil.NewMultilock(attesterLockKey(otherPubKey))
+	lock.Lock()
+	lock.Unlock()
+
+	attested := make(chan struct{})
+	go func() {
+		lock := mputil.NewMultilock(attesterLockKey(pubKey))
+		lock.Lock()
+		defer lock.Unlock()
+		close(attested)
+	}()
+	select {
+	case <-attested:
+		t.Fatal("Attested with a key whose signing is locked")
+	case <-time.After(100 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-attested:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not attest once signing was unlocked")
+	}
+}
@@ -33,6 +33,7 @@ type FakeValidator struct {
 	WaitForChainStartCalled           int
 	WaitForSyncCalled                 int
 	WaitForActivationCalled           int
+	CheckDoppelGangerCalled           int
 	CanonicalHeadSlotCalled           int
 	ReceiveBlocksCalled               int
 	RetryTillSuccess                  int
@@ -85,6 +86,12 @@ func (fv *FakeValidator) WaitForActivation(_ context.Context, _ chan [][48]byte)
 	return nil
 }
 
+// CheckDoppelGanger for mocking.
+func (fv *FakeValidator) CheckDoppelGanger(_ context.Context) error {
+	fv.CheckDoppelGangerCalled++
+	return nil
+}
+
 // WaitForSync for mocking.
 func (fv *FakeValidator) WaitForSync(_ context.Context) error {
 	fv.WaitForSyncCalled++
@@ -22,6 +22,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -37,6 +38,8 @@ import (
 	slashingiface "github.com/prysmaticlabs/prysm/validator/slashing-protection/iface"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // reconnectPeriod is the frequency that we try to restart our
@@ -57,6 +60,7 @@ type validator struct {
 	useWeb                             bool
 	emitAccountMetrics                 bool
 	logDutyCountDown                   bool
+	doppelGangerEpochs                 types.Epoch
 	domainDataLock                     sync.Mutex
 	attLogsLock                        sync.Mutex
 	aggregatedSlotCommitteeIDCacheLock sync.Mutex
@@ -71,12 +75,15 @@ type validator struct {
 	ticker                             slotutil.Ticker
 	prevBalance                        map[[48]byte]uint64
 	duties                             *ethpb.DutiesResponse
+	dutiesDependentRoot                [32]byte
 	startBalances                      map[[48]byte]uint64
 	attLogs                            map[[32]byte]*attSubmitted
 	node                               ethpb.NodeClient
 	keyManager                         keymanager.IKeymanager
 	beaconClient                       ethpb.BeaconChainClient
 	validatorClient                    ethpb.BeaconNodeValidatorClient
+	dutiesClient                       pbrpc.DutiesClient
+	doppelGangerClient                 pbrpc.DoppelGangerClient
 	protector                          slashingiface.Protector
 	db                                 vdb.Database
 	graffiti                           []byte
@@ -394,9 +401,8 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 
 	// Filter out the slashable public keys from the duties request.
 	filteredKeys := make([][48]byte, 0, len(validatingKeys))
-	v.slashableKeysLock.RLock()
 	for _, pubKey := range validatingKeys {
-		if ok := v.eipImportBlacklistedPublicKeys[pubKey]; !ok {
+		if !v.isEIPImportBlacklisted(pubKey) {
 			filteredKeys = append(filteredKeys, pubKey)
 		} else {
 			log.WithField(
@@ -405,7 +411,6 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 				"in request to update validator duties")
 		}
 	}
-	v.slashableKeysLock.RUnlock()
 
 	req := &ethpb.DutiesRequest{
 		Epoch:      types.Epoch(slot / params.BeaconConfig().SlotsPerEpoch),
@@ -413,7 +418,7 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	}
 
 	// If duties is nil it means we have had no prior duties and just started up.
-	resp, err := v.validatorClient.GetDuties(ctx, req)
+	resp, err := v.fetchDuties(ctx, req)
 	if err != nil {
 		v.duties = nil // Clear assignments so we know to retry the request.
 		log.Error(err)
@@ -433,6 +438,39 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	return nil
 }
 
+// fetchDuties fetches all the duties of the epoch in a single request to the duties service of
+// the beacon node, falling back to the validator service for beacon nodes without it.
+func (v *validator) fetchDuties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	if v.dutiesClient == nil {
+		return v.validatorClient.GetDuties(ctx, req)
+	}
+	resp, err := v.dutiesClient.GetEpochDuties(ctx, &pbrpc.EpochDutiesRequest{
+		Epoch:      req.Epoch,
+		PublicKeys: req.PublicKeys,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		log.Debug("Beacon node does not serve epoch duties, falling back to fetching duties from the validator service")
+		v.dutiesClient = nil
+		return v.validatorClient.GetDuties(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	dependentRoot := bytesutil.ToBytes32(resp.DependentRoot)
+	if v.duties != nil && v.dutiesDependentRoot != dependentRoot {
+		log.WithFields(logrus.Fields{
+			"epoch":         req.Epoch,
+			"dependentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(dependentRoot[:])),
+		}).Debug("Duties dependent root changed")
+	}
+	v.dutiesDependentRoot = dependentRoot
+	return &ethpb.DutiesResponse{
+		Duties:             resp.CurrentEpochDuties,
+		CurrentEpochDuties: resp.CurrentEpochDuties,
+		NextEpochDuties:    resp.NextEpochDuties,
+	}, nil
+}
+
 // subscribeToSubnets iterates through each validator duty, signs each slot, and asks beacon node
 // to eagerly subscribe to subnets so that the aggregator has attestations to aggregate.
 func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesResponse) error {
@@ -541,6 +579,14 @@ func (v *validator) RolesAt(ctx context.Context, slot types.Slot) (map[[48]byte]
 	return rolesAt, nil
 }
 
+// isEIPImportBlacklisted returns whether the public key was found slashable when importing a
+// slashing protection history, in which case the validator refuses to sign with it.
+func (v *validator) isEIPImportBlacklisted(pubKey [48]byte) bool {
+	v.slashableKeysLock.RLock()
+	defer v.slashableKeysLock.RUnlock()
+	return v.eipImportBlacklistedPublicKeys[pubKey]
+}
+
 // GetKeymanager returns the underlying validator's keymanager.
 func (v *validator) GetKeymanager() keymanager.IKeymanager {
 	return v.keyManager
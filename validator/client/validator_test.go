@@ -12,6 +12,7 @@ import (
 	"github.com/golang/mock/gomock"
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -25,6 +26,9 @@ import (
 	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func init() {
@@ -574,6 +578,106 @@ func TestUpdateDuties_OK(t *testing.T) {
 	assert.Equal(t, resp.Duties[0].ValidatorIndex, v.duties.Duties[0].ValidatorIndex, "Unexpected validator assignments")
 }
 
+// fakeDutiesClient serves epoch duties from a canned response.
+type fakeDutiesClient struct {
+	pbrpc.DutiesClient
+	resp *pbrpc.EpochDutiesResponse
+	err  error
+}
+
+func (f *fakeDutiesClient) GetEpochDuties(_ context.Context, _ *pbrpc.EpochDutiesRequest, _ ...grpc.CallOption) (*pbrpc.EpochDutiesResponse, error) {
+	return f.resp, f.err
+}
+
+func TestUpdateDuties_EpochDuties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	slot := params.BeaconConfig().SlotsPerEpoch
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := [48]byte{}
+	copy(pubKey[:], privKey.PublicKey().Marshal())
+	km := &mockKeymanager{
+		keysMap: map[[48]byte]bls.SecretKey{
+			pubKey: privKey,
+		},
+	}
+	duty := &ethpb.DutiesResponse_Duty{
+		AttesterSlot:   params.BeaconConfig().SlotsPerEpoch,
+		ValidatorIndex: 200,
+		CommitteeIndex: 100,
+		Committee:      []types.ValidatorIndex{0, 1, 2, 3},
+		PublicKey:      pubKey[:],
+		ProposerSlots:  []types.Slot{params.BeaconConfig().SlotsPerEpoch + 1},
+	}
+	dependentRoot := bytesutil.PadTo([]byte("dependent root"), 32)
+	v := validator{
+		keyManager:      km,
+		validatorClient: client,
+		dutiesClient: &fakeDutiesClient{resp: &pbrpc.EpochDutiesResponse{
+			DependentRoot:      dependentRoot,
+			CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{duty},
+			NextEpochDuties:    []*ethpb.DutiesResponse_Duty{{ValidatorIndex: 200, PublicKey: pubKey[:]}},
+		}},
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	client.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ *ethpb.CommitteeSubnetsSubscribeRequest) (*ptypes.Empty, error) {
+		wg.Done()
+		return nil, nil
+	})
+
+	require.NoError(t, v.UpdateDuties(context.Background(), slot), "Could not update assignments")
+	testutil.WaitTimeout(&wg, 3*time.Second)
+
+	assert.DeepEqual(t, duty, v.duties.CurrentEpochDuties[0], "Unexpected validator assignments")
+	assert.DeepEqual(t, duty, v.duties.Duties[0], "Unexpected validator assignments")
+	assert.Equal(t, 1, len(v.duties.NextEpochDuties))
+	assert.Equal(t, bytesutil.ToBytes32(dependentRoot), v.dutiesDependentRoot)
+}
+
+func TestUpdateDuties_EpochDutiesUnimplemented(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := [48]byte{}
+	copy(pubKey[:], privKey.PublicKey().Marshal())
+	km := &mockKeymanager{
+		keysMap: map[[48]byte]bls.SecretKey{
+			pubKey: privKey,
+		},
+	}
+	v := validator{
+		keyManager:      km,
+		validatorClient: client,
+		dutiesClient:    &fakeDutiesClient{err: status.Error(codes.Unimplemented, "unknown service")},
+	}
+	resp := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{ValidatorIndex: 200, PublicKey: pubKey[:]}},
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(resp, nil)
+	client.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, nil).AnyTimes()
+
+	require.NoError(t, v.UpdateDuties(context.Background(), params.BeaconConfig().SlotsPerEpoch))
+	assert.Equal(t, resp, v.duties)
+	assert.Equal(t, true, v.dutiesClient == nil, "Expected fallback to the validator service")
+}
+
 func TestUpdateDuties_OK_FilterBlacklistedPublicKeys(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctrl := gomock.NewController(t)
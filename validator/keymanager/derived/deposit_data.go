@@ -0,0 +1,53 @@
+package derived
+
+import (
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/depositutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// DepositDataJSON is the deposit data of an account in the JSON format written by the
+// eth2.0-deposit-cli, which can be uploaded to the eth2 launchpad.
+type DepositDataJSON struct {
+	PublicKey             string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+}
+
+// DepositDataForAccounts creates the deposit data of the given accounts, with withdrawal
+// credentials committing to the withdrawal key of each account.
+func DepositDataForAccounts(accounts []*Account, amountInGwei uint64) ([]*DepositDataJSON, error) {
+	forkVersion := params.BeaconConfig().GenesisForkVersion
+	depositData := make([]*DepositDataJSON, len(accounts))
+	for i, acc := range accounts {
+		data, dataRoot, err := depositutil.DepositInput(acc.ValidatingKey, acc.WithdrawalKey, amountInGwei)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create deposit data of account %d", acc.Index)
+		}
+		messageRoot, err := (&pb.DepositMessage{
+			PublicKey:             data.PublicKey,
+			WithdrawalCredentials: data.WithdrawalCredentials,
+			Amount:                data.Amount,
+		}).HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not hash deposit message of account %d", acc.Index)
+		}
+		depositData[i] = &DepositDataJSON{
+			PublicKey:             hex.EncodeToString(data.PublicKey),
+			WithdrawalCredentials: hex.EncodeToString(data.WithdrawalCredentials),
+			Amount:                data.Amount,
+			Signature:             hex.EncodeToString(data.Signature),
+			DepositMessageRoot:    hex.EncodeToString(messageRoot[:]),
+			DepositDataRoot:       hex.EncodeToString(dataRoot[:]),
+			ForkVersion:           hex.EncodeToString(forkVersion),
+		}
+	}
+	return depositData, nil
+}
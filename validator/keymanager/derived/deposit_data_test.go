@@ -0,0 +1,49 @@
+package derived
+
+import (
+	"encoding/hex"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/depositutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	constant "github.com/prysmaticlabs/prysm/validator/testing"
+)
+
+func TestDepositDataForAccounts(t *testing.T) {
+	accounts, err := DeriveAccounts(constant.TestMnemonic, "", 0, 2)
+	require.NoError(t, err)
+	amount := params.BeaconConfig().MaxEffectiveBalance
+	depositData, err := DepositDataForAccounts(accounts, amount)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(depositData))
+
+	domain, err := helpers.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	require.NoError(t, err)
+	for i, dd := range depositData {
+		assert.Equal(t, hex.EncodeToString(accounts[i].ValidatingKey.PublicKey().Marshal()), dd.PublicKey)
+		assert.Equal(t, hex.EncodeToString(depositutil.WithdrawalCredentialsHash(accounts[i].WithdrawalKey)), dd.WithdrawalCredentials)
+		assert.Equal(t, amount, dd.Amount)
+		assert.Equal(t, hex.EncodeToString(params.BeaconConfig().GenesisForkVersion), dd.ForkVersion)
+
+		pubKey, err := hex.DecodeString(dd.PublicKey)
+		require.NoError(t, err)
+		creds, err := hex.DecodeString(dd.WithdrawalCredentials)
+		require.NoError(t, err)
+		sig, err := hex.DecodeString(dd.Signature)
+		require.NoError(t, err)
+		data := &ethpb.Deposit_Data{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: creds,
+			Amount:                dd.Amount,
+			Signature:             sig,
+		}
+		require.NoError(t, depositutil.VerifyDepositSignature(data, domain))
+		root, err := data.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, hex.EncodeToString(root[:]), dd.DepositDataRoot)
+	}
+}
@@ -0,0 +1,65 @@
+package derived
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	util "github.com/wealdtech/go-eth2-util"
+)
+
+// WithdrawalKeyDerivationPathTemplate defining the hierarchical path for withdrawal
+// keys for Prysm eth2 validators. According to EIP-2334, the format is as follows:
+// m / purpose / coin_type / account_index / withdrawal_key
+const WithdrawalKeyDerivationPathTemplate = "m/12381/3600/%d/0"
+
+// Account of a derived wallet, holding the withdrawal and validating keys derived
+// from a mnemonic along the EIP-2334 paths of the account index.
+type Account struct {
+	Index         int
+	WithdrawalKey bls.SecretKey
+	ValidatingKey bls.SecretKey
+}
+
+// DeriveAccounts derives numAccounts consecutive accounts from a mnemonic, starting at
+// the account index startIndex. The same mnemonic and passphrase always derive the
+// same keys, which allows recovering them after losing the device holding the wallet.
+func DeriveAccounts(mnemonic, mnemonicPassphrase string, startIndex, numAccounts int) ([]*Account, error) {
+	seed, err := seedFromMnemonic(mnemonic, mnemonicPassphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize new wallet seed file")
+	}
+	return deriveAccountsFromSeed(seed, startIndex, numAccounts)
+}
+
+func deriveAccountsFromSeed(seed []byte, startIndex, numAccounts int) ([]*Account, error) {
+	if startIndex < 0 || numAccounts < 0 {
+		return nil, errors.New("account index and number of accounts cannot be negative")
+	}
+	accounts := make([]*Account, numAccounts)
+	for i := 0; i < numAccounts; i++ {
+		index := startIndex + i
+		withdrawalKey, err := secretKeyFromSeedAndPath(seed, fmt.Sprintf(WithdrawalKeyDerivationPathTemplate, index))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not derive withdrawal key of account %d", index)
+		}
+		validatingKey, err := secretKeyFromSeedAndPath(seed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, index))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not derive validating key of account %d", index)
+		}
+		accounts[i] = &Account{
+			Index:         index,
+			WithdrawalKey: withdrawalKey,
+			ValidatingKey: validatingKey,
+		}
+	}
+	return accounts, nil
+}
+
+func secretKeyFromSeedAndPath(seed []byte, path string) (bls.SecretKey, error) {
+	privKey, err := util.PrivateKeyFromSeedAndPath(seed, path)
+	if err != nil {
+		return nil, err
+	}
+	return bls.SecretKeyFromBytes(privKey.Marshal())
+}
@@ -0,0 +1,41 @@
+package derived
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	constant "github.com/prysmaticlabs/prysm/validator/testing"
+	util "github.com/wealdtech/go-eth2-util"
+)
+
+func TestDeriveAccounts(t *testing.T) {
+	seed, err := seedFromMnemonic(constant.TestMnemonic, "")
+	require.NoError(t, err)
+	accounts, err := DeriveAccounts(constant.TestMnemonic, "", 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(accounts))
+	for i, acc := range accounts {
+		index := 2 + i
+		assert.Equal(t, index, acc.Index)
+		withdrawalKey, err := util.PrivateKeyFromSeedAndPath(seed, fmt.Sprintf("m/12381/3600/%d/0", index))
+		require.NoError(t, err)
+		validatingKey, err := util.PrivateKeyFromSeedAndPath(seed, fmt.Sprintf("m/12381/3600/%d/0/0", index))
+		require.NoError(t, err)
+		assert.DeepEqual(t, withdrawalKey.Marshal(), acc.WithdrawalKey.Marshal())
+		assert.DeepEqual(t, validatingKey.Marshal(), acc.ValidatingKey.Marshal())
+	}
+
+	// Deriving a batch from another start index yields the same keys for shared indices.
+	batch, err := DeriveAccounts(constant.TestMnemonic, "", 3, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, accounts[1].ValidatingKey.Marshal(), batch[0].ValidatingKey.Marshal())
+}
+
+func TestDeriveAccounts_InvalidInput(t *testing.T) {
+	_, err := DeriveAccounts("not a mnemonic", "", 0, 1)
+	assert.ErrorContains(t, "could not initialize new wallet seed file", err)
+	_, err = DeriveAccounts(constant.TestMnemonic, "", -1, 1)
+	assert.ErrorContains(t, "cannot be negative", err)
+}
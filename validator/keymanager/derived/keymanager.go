@@ -2,16 +2,15 @@ package derived
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/pkg/errors"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
-	util "github.com/wealdtech/go-eth2-util"
 )
 
 const (
@@ -58,21 +57,56 @@ func NewKeymanager(
 func (km *Keymanager) RecoverAccountsFromMnemonic(
 	ctx context.Context, mnemonic, mnemonicPassphrase string, numAccounts int,
 ) error {
+	accounts, err := DeriveAccounts(mnemonic, mnemonicPassphrase, 0 /* start index */, numAccounts)
+	if err != nil {
+		return err
+	}
+	return km.importAccounts(ctx, accounts)
+}
+
+// GenerateAccountsFromMnemonic derives a batch of N new accounts from a mnemonic phrase and
+// imports their validating keys into the wallet. Account indices already present in the
+// wallet are skipped, so new accounts continue after the existing ones. The derived accounts
+// are returned, including their withdrawal keys, in order to create their deposit data.
+func (km *Keymanager) GenerateAccountsFromMnemonic(
+	ctx context.Context, mnemonic, mnemonicPassphrase string, numAccounts int,
+) ([]*Account, error) {
 	seed, err := seedFromMnemonic(mnemonic, mnemonicPassphrase)
 	if err != nil {
-		return errors.Wrap(err, "could not initialize new wallet seed file")
+		return nil, errors.Wrap(err, "could not initialize new wallet seed file")
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch validating public keys")
 	}
-	privKeys := make([][]byte, numAccounts)
-	pubKeys := make([][]byte, numAccounts)
-	for i := 0; i < numAccounts; i++ {
-		privKey, err := util.PrivateKeyFromSeedAndPath(
-			seed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, i),
-		)
+	existing := make(map[[48]byte]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		existing[pubKey] = true
+	}
+	accounts := make([]*Account, 0, numAccounts)
+	for index := 0; len(accounts) < numAccounts; index++ {
+		next, err := deriveAccountsFromSeed(seed, index, 1)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if existing[bytesutil.ToBytes48(next[0].ValidatingKey.PublicKey().Marshal())] {
+			continue
 		}
-		privKeys[i] = privKey.Marshal()
-		pubKeys[i] = privKey.PublicKey().Marshal()
+		accounts = append(accounts, next[0])
+	}
+	if err := km.importAccounts(ctx, accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// importAccounts imports the validating keys of the given accounts into the wallet.
+func (km *Keymanager) importAccounts(ctx context.Context, accounts []*Account) error {
+	privKeys := make([][]byte, len(accounts))
+	pubKeys := make([][]byte, len(accounts))
+	for i, acc := range accounts {
+		privKeys[i] = acc.ValidatingKey.Marshal()
+		pubKeys[i] = acc.ValidatingKey.PublicKey().Marshal()
 	}
 	return km.importedKM.ImportKeypairs(ctx, privKeys, pubKeys)
 }
@@ -207,3 +207,35 @@ func TestDerivedKeymanager_Sign_NoPublicKeyInCache(t *testing.T) {
 	_, err := dr.Sign(context.Background(), req)
 	assert.ErrorContains(t, "no signing key found", err)
 }
+
+func TestDerivedKeymanager_GenerateAccountsFromMnemonic(t *testing.T) {
+	ctx := context.Background()
+	wallet := &mock.Wallet{
+		Files:            make(map[string]map[string][]byte),
+		AccountPasswords: make(map[string]string),
+		WalletPassword:   password,
+	}
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		Wallet:           wallet,
+		ListenForChanges: false,
+	})
+	require.NoError(t, err)
+	require.NoError(t, km.RecoverAccountsFromMnemonic(ctx, constant.TestMnemonic, "", 2))
+
+	// New accounts continue after the accounts already in the wallet.
+	accounts, err := km.GenerateAccountsFromMnemonic(ctx, constant.TestMnemonic, "", 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(accounts))
+	for i, acc := range accounts {
+		assert.Equal(t, 2+i, acc.Index)
+	}
+
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(pubKeys))
+	wanted, err := DeriveAccounts(constant.TestMnemonic, "", 0, 5)
+	require.NoError(t, err)
+	for i, acc := range wanted {
+		assert.DeepEqual(t, acc.ValidatingKey.PublicKey().Marshal(), pubKeys[i][:])
+	}
+}
@@ -0,0 +1,257 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+	// requestTimeout bounds the duration of a request to the signer, which must answer well
+	// within a slot for duties to be performed in time.
+	requestTimeout = 5 * time.Second
+	// maxResponseSize bounds the size of the responses read from the signer.
+	maxResponseSize = 1 << 20
+)
+
+var (
+	// ErrSigningFailed defines a failure from the signer when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in the remote signer")
+	// ErrSigningDenied defines a signing operation denied by the signer, such as by its own
+	// slashing protection.
+	ErrSigningDenied = errors.New("signing request was denied by the remote signer")
+)
+
+// SetupConfig includes configuration values for initializing a keymanager which delegates
+// signing to a Web3Signer compatible remote signer.
+type SetupConfig struct {
+	// BaseURL of the signer, such as https://signer.example.com:9000.
+	BaseURL string
+	// PublicKeys to validate with. The public keys are listed by the signer if empty.
+	PublicKeys [][48]byte
+	// CACertPath is the path to the certificate authority of the signer's certificate, which
+	// defaults to the system certificate pool.
+	CACertPath string
+	// ClientCertPath and ClientKeyPath are the paths to the client certificate and key used to
+	// authenticate to the signer, if it requires TLS client authentication.
+	ClientCertPath string
+	ClientKeyPath  string
+}
+
+// Keymanager implementation delegating signing to a Web3Signer compatible remote signer over
+// HTTP, so that validating keys can be kept in a signer backed by a hardware security module.
+// Each request carries the signing root computed by the validator client, which keeps its own
+// slashing protection as the signer can not check the signed objects.
+type Keymanager struct {
+	baseURL             string
+	client              *http.Client
+	publicKeys          [][48]byte
+	accountsChangedFeed *event.Feed
+}
+
+// signRequest is the JSON body of a Web3Signer signing request.
+type signRequest struct {
+	Type        string `json:"type"`
+	SigningRoot string `json:"signingRoot"`
+}
+
+// signResponse is the JSON body of a Web3Signer signing response.
+type signResponse struct {
+	Signature string `json:"signature"`
+}
+
+// NewKeymanager instantiates a new keymanager for the remote signer of the configuration.
+func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.BaseURL == "" {
+		return nil, errors.New("remote signer URL is required")
+	}
+	if !strings.HasPrefix(cfg.BaseURL, "http://") && !strings.HasPrefix(cfg.BaseURL, "https://") {
+		return nil, fmt.Errorf("remote signer URL %s must use the http or https scheme", cfg.BaseURL)
+	}
+	tlsCfg, err := tlsConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(cfg.BaseURL, "http://") {
+		log.Warn("Connecting to the remote signer without TLS, signing requests are sent in clear text")
+	}
+	return &Keymanager{
+		baseURL: strings.TrimSuffix(cfg.BaseURL, "/"),
+		client: &http.Client{
+			Timeout:   requestTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsCfg},
+		},
+		publicKeys:          cfg.PublicKeys,
+		accountsChangedFeed: new(event.Feed),
+	}, nil
+}
+
+// tlsConfig loads the certificate authority and the client certificate of the configuration.
+func tlsConfig(cfg *SetupConfig) (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CACertPath != "" {
+		caCert, err := ioutil.ReadFile(cfg.CACertPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the remote signer's CA certificate")
+		}
+		cp := x509.NewCertPool()
+		if !cp.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("failed to add the remote signer's CA certificate to pool")
+		}
+		tlsCfg.RootCAs = cp
+	}
+	if (cfg.ClientCertPath == "") != (cfg.ClientKeyPath == "") {
+		return nil, errors.New("client certificate and client key must be set together")
+	}
+	if cfg.ClientCertPath != "" {
+		clientPair, err := tls.LoadX509KeyPair(cfg.ClientCertPath, cfg.ClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
+		}
+		tlsCfg.Certificates = []tls.Certificate{clientPair}
+	}
+	return tlsCfg, nil
+}
+
+// FetchValidatingPublicKeys returns the configured public keys, or lists the public keys of the
+// signer if none were configured.
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	if len(km.publicKeys) > 0 {
+		return km.publicKeys, nil
+	}
+	body, status, err := km.do(ctx, http.MethodGet, publicKeysPath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list public keys from remote signer")
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("could not list public keys from remote signer: unexpected status %d", status)
+	}
+	var hexKeys []string
+	if err := json.Unmarshal(body, &hexKeys); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal public keys from remote signer")
+	}
+	pubKeys := make([][48]byte, len(hexKeys))
+	for i, hexKey := range hexKeys {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+		if err != nil || len(pubKey) != 48 {
+			return nil, fmt.Errorf("remote signer returned invalid public key %s", hexKey)
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	return pubKeys, nil
+}
+
+// Sign signs the signing root of a request with the signer.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	signingType, err := signingType(req)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(&signRequest{
+		Type:        signingType,
+		SigningRoot: fmt.Sprintf("%#x", req.SigningRoot),
+	})
+	if err != nil {
+		return nil, err
+	}
+	body, status, err := km.do(ctx, http.MethodPost, signPath+fmt.Sprintf("%#x", req.PublicKey), payload)
+	if err != nil {
+		return nil, err
+	}
+	switch status {
+	case http.StatusOK:
+	case http.StatusPreconditionFailed:
+		return nil, ErrSigningDenied
+	case http.StatusNotFound:
+		return nil, errors.Wrapf(ErrSigningFailed, "public key %#x is not known", bytesutil.Trunc(req.PublicKey))
+	default:
+		return nil, errors.Wrapf(ErrSigningFailed, "unexpected status %d", status)
+	}
+	sigHex := strings.TrimSpace(string(body))
+	// Signers answer with a JSON object or with the bare hex signature.
+	if strings.HasPrefix(sigHex, "{") {
+		resp := &signResponse{}
+		if err := json.Unmarshal(body, resp); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal signature from remote signer")
+		}
+		sigHex = resp.Signature
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer returned an invalid signature")
+	}
+	return bls.SignatureFromBytes(sig)
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel to listen for public key
+// changes. The public keys of a remote signer do not change at runtime.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// do sends a request to the signer and returns the status and the body of its response.
+func (km *Keymanager) do(ctx context.Context, method, path string, payload []byte) ([]byte, int, error) {
+	var reqBody io.Reader
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, km.baseURL+path, reqBody)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := km.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Failed to close response body")
+		}
+	}()
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not read response from remote signer")
+	}
+	return body, resp.StatusCode, nil
+}
+
+// signingType returns the Web3Signer type of the object of a signing request.
+func signingType(req *validatorpb.SignRequest) (string, error) {
+	switch req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		return "BLOCK", nil
+	case *validatorpb.SignRequest_AttestationData:
+		return "ATTESTATION", nil
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		return "AGGREGATE_AND_PROOF", nil
+	case *validatorpb.SignRequest_Exit:
+		return "VOLUNTARY_EXIT", nil
+	case *validatorpb.SignRequest_Slot:
+		return "AGGREGATION_SLOT", nil
+	case *validatorpb.SignRequest_Epoch:
+		return "RANDAO_REVEAL", nil
+	default:
+		return "", fmt.Errorf("unsupported signing request object %T", req.Object)
+	}
+}
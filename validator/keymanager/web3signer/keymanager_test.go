@@ -0,0 +1,180 @@
+package web3signer
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// mockSigner serves the Web3Signer API for a single secret key, recording the signing requests.
+type mockSigner struct {
+	t         *testing.T
+	secretKey bls.SecretKey
+	plain     bool
+	status    int
+	requests  []*signRequest
+}
+
+func (m *mockSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	pubKey := fmt.Sprintf("%#x", m.secretKey.PublicKey().Marshal())
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == publicKeysPath:
+		require.NoError(m.t, json.NewEncoder(w).Encode([]string{pubKey}))
+	case r.Method == http.MethodPost && r.URL.Path == signPath+pubKey:
+		req := &signRequest{}
+		require.NoError(m.t, json.NewDecoder(r.Body).Decode(req))
+		m.requests = append(m.requests, req)
+		if m.status != 0 {
+			w.WriteHeader(m.status)
+			return
+		}
+		root, err := hex.DecodeString(strings.TrimPrefix(req.SigningRoot, "0x"))
+		require.NoError(m.t, err)
+		sig := fmt.Sprintf("%#x", m.secretKey.Sign(root).Marshal())
+		if m.plain {
+			_, err := w.Write([]byte(sig))
+			require.NoError(m.t, err)
+			return
+		}
+		require.NoError(m.t, json.NewEncoder(w).Encode(&signResponse{Signature: sig}))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newMockSigner(t *testing.T) *mockSigner {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	return &mockSigner{t: t, secretKey: secretKey}
+}
+
+func TestNewKeymanager_InvalidConfig(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewKeymanager(ctx, &SetupConfig{})
+	assert.ErrorContains(t, "remote signer URL is required", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{BaseURL: "localhost:9000"})
+	assert.ErrorContains(t, "must use the http or https scheme", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{BaseURL: "https://localhost:9000", ClientCertPath: "client.crt"})
+	assert.ErrorContains(t, "client certificate and client key must be set together", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{BaseURL: "https://localhost:9000", CACertPath: "missing.crt"})
+	assert.ErrorContains(t, "failed to read the remote signer's CA certificate", err)
+}
+
+func TestKeymanager_FetchValidatingPublicKeys(t *testing.T) {
+	signer := newMockSigner(t)
+	srv := httptest.NewServer(signer)
+	defer srv.Close()
+	ctx := context.Background()
+
+	km, err := NewKeymanager(ctx, &SetupConfig{BaseURL: srv.URL})
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	assert.DeepEqual(t, signer.secretKey.PublicKey().Marshal(), keys[0][:])
+
+	// Configured public keys are not listed from the signer.
+	configured := [][48]byte{{1}, {2}}
+	km, err = NewKeymanager(ctx, &SetupConfig{BaseURL: srv.URL, PublicKeys: configured})
+	require.NoError(t, err)
+	keys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, configured, keys)
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	signer := newMockSigner(t)
+	srv := httptest.NewServer(signer)
+	defer srv.Close()
+	ctx := context.Background()
+	km, err := NewKeymanager(ctx, &SetupConfig{BaseURL: srv.URL})
+	require.NoError(t, err)
+
+	pubKey := signer.secretKey.PublicKey().Marshal()
+	root := make([]byte, 32)
+	root[0] = 'a'
+	req := &validatorpb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: root,
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 3},
+	}
+	sig, err := km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(signer.secretKey.PublicKey(), root))
+	require.Equal(t, 1, len(signer.requests))
+	assert.Equal(t, "RANDAO_REVEAL", signer.requests[0].Type)
+	assert.Equal(t, fmt.Sprintf("%#x", root), signer.requests[0].SigningRoot)
+
+	signer.plain = true
+	req.Object = &validatorpb.SignRequest_Slot{Slot: 3}
+	sig, err = km.Sign(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(signer.secretKey.PublicKey(), root))
+	assert.Equal(t, "AGGREGATION_SLOT", signer.requests[1].Type)
+}
+
+func TestKeymanager_Sign_Errors(t *testing.T) {
+	signer := newMockSigner(t)
+	srv := httptest.NewServer(signer)
+	defer srv.Close()
+	ctx := context.Background()
+	km, err := NewKeymanager(ctx, &SetupConfig{BaseURL: srv.URL})
+	require.NoError(t, err)
+
+	req := &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+	}
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, "unsupported signing request object", err)
+	assert.Equal(t, 0, len(signer.requests))
+
+	req.Object = &validatorpb.SignRequest_Epoch{Epoch: 3}
+	signer.status = http.StatusPreconditionFailed
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+
+	signer.status = http.StatusInternalServerError
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, ErrSigningFailed.Error(), err)
+
+	signer.status = 0
+	req.PublicKey = make([]byte, 48)
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, "is not known", err)
+}
+
+func TestKeymanager_TLS(t *testing.T) {
+	signer := newMockSigner(t)
+	srv := httptest.NewTLSServer(signer)
+	defer srv.Close()
+	ctx := context.Background()
+
+	// The certificate of the test server is not trusted by default.
+	km, err := NewKeymanager(ctx, &SetupConfig{BaseURL: srv.URL})
+	require.NoError(t, err)
+	_, err = km.FetchValidatingPublicKeys(ctx)
+	assert.ErrorContains(t, "certificate", err)
+
+	caCertPath := filepath.Join(t.TempDir(), "ca.crt")
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	require.NoError(t, ioutil.WriteFile(caCertPath, caCert, 0600))
+	km, err = NewKeymanager(ctx, &SetupConfig{BaseURL: srv.URL, CACertPath: caCertPath})
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+}
@@ -5,6 +5,7 @@ package node
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"os/signal"
@@ -17,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -34,6 +36,7 @@ import (
 	g "github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/prysmaticlabs/prysm/validator/rpc"
 	"github.com/prysmaticlabs/prysm/validator/rpc/gateway"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
@@ -174,6 +177,16 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "could not generate interop keys")
 		}
+	} else if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
+		cfg, err := web3SignerConfig(cliCtx)
+		if err != nil {
+			return err
+		}
+		keyManager, err = web3signer.NewKeymanager(cliCtx.Context, cfg)
+		if err != nil {
+			return errors.Wrap(err, "could not initialize remote signer keymanager")
+		}
+		log.WithField("url", cfg.BaseURL).Info("Delegating signing to remote signer")
 	} else {
 		// Read the wallet from the specified path.
 		w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
@@ -261,6 +274,25 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	return nil
 }
 
+// web3SignerConfig builds the configuration of the remote signer keymanager from the CLI flags.
+func web3SignerConfig(cliCtx *cli.Context) (*web3signer.SetupConfig, error) {
+	var pubKeys [][48]byte
+	for _, hexKey := range cliCtx.StringSlice(flags.Web3SignerPublicKeysFlag.Name) {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+		if err != nil || len(pubKey) != 48 {
+			return nil, fmt.Errorf("invalid public key %s in --%s", hexKey, flags.Web3SignerPublicKeysFlag.Name)
+		}
+		pubKeys = append(pubKeys, bytesutil.ToBytes48(pubKey))
+	}
+	return &web3signer.SetupConfig{
+		BaseURL:        cliCtx.String(flags.Web3SignerURLFlag.Name),
+		PublicKeys:     pubKeys,
+		CACertPath:     cliCtx.String(flags.Web3SignerCACertFlag.Name),
+		ClientCertPath: cliCtx.String(flags.Web3SignerClientCertFlag.Name),
+		ClientKeyPath:  cliCtx.String(flags.Web3SignerClientKeyFlag.Name),
+	}, nil
+}
+
 func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 	var keyManager keymanager.IKeymanager
 	var err error
@@ -420,6 +452,7 @@ func (c *ValidatorClient) registerValidatorService(
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		DoppelGangerEpochs:         c.cliCtx.Uint(flags.DoppelGangerProtectionEpochsFlag.Name),
 	})
 
 	if err != nil {
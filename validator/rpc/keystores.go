@@ -0,0 +1,236 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	slashingProtectionFormat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListKeystores lists the validating public keys of the validator client. Keys which are not
+// stored in the wallet, such as the keys of a remote signer, are reported as read-only.
+func (s *Server) ListKeystores(ctx context.Context, _ *empty.Empty) (*pb.ListKeystoresResponse, error) {
+	if s.keymanager == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No keymanager initialized")
+	}
+	keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating public keys: %v", err)
+	}
+	_, isImported := s.keymanager.(*imported.Keymanager)
+	_, isDerived := s.keymanager.(*derived.Keymanager)
+	data := make([]*pb.ListKeystoresResponse_Keystore, len(keys))
+	for i := 0; i < len(keys); i++ {
+		data[i] = &pb.ListKeystoresResponse_Keystore{
+			ValidatingPubkey: fmt.Sprintf("%#x", keys[i]),
+			Readonly:         !isImported && !isDerived,
+		}
+		if isDerived {
+			data[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
+		}
+	}
+	return &pb.ListKeystoresResponse{
+		Data: data,
+	}, nil
+}
+
+// AddKeystores imports EIP-2335 keystores, each decrypted with its own password, into an
+// imported wallet. The slashing protection history of the request is imported first, so that
+// the keys are never used without it. The import of each keystore is reported separately.
+func (s *Server) AddKeystores(ctx context.Context, req *pb.AddKeystoresRequest) (*pb.AddKeystoresResponse, error) {
+	if s.wallet == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No wallet initialized")
+	}
+	km, ok := s.keymanager.(*imported.Keymanager)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "Only imported wallets can import more keystores")
+	}
+	if len(req.Keystores) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No keystores included for import")
+	}
+	if len(req.Keystores) != len(req.Passwords) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Number of keystores %d does not match number of passwords %d",
+			len(req.Keystores),
+			len(req.Passwords),
+		)
+	}
+	if req.SlashingProtection != "" {
+		if err := slashingProtectionFormat.ImportStandardProtectionJSON(
+			ctx, s.valDB, bytes.NewBufferString(req.SlashingProtection),
+		); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not import slashing protection history: %v", err)
+		}
+	}
+	existingKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating public keys: %v", err)
+	}
+	existing := make(map[[48]byte]bool, len(existingKeys))
+	for _, key := range existingKeys {
+		existing[key] = true
+	}
+
+	decryptor := keystorev4.New()
+	statuses := make([]*pb.AddedKeystoreStatus, len(req.Keystores))
+	var privKeys, pubKeys [][]byte
+	for i := 0; i < len(req.Keystores); i++ {
+		privKey, pubKey, err := decryptKeystore(decryptor, req.Keystores[i], req.Passwords[i])
+		if err != nil {
+			statuses[i] = &pb.AddedKeystoreStatus{
+				Status:  pb.AddedKeystoreStatus_ERROR,
+				Message: err.Error(),
+			}
+			continue
+		}
+		pubKey48 := bytesutil.ToBytes48(pubKey)
+		if existing[pubKey48] {
+			statuses[i] = &pb.AddedKeystoreStatus{
+				Status:  pb.AddedKeystoreStatus_DUPLICATE,
+				Message: fmt.Sprintf("Public key %#x is already in the wallet", pubKey),
+			}
+			continue
+		}
+		existing[pubKey48] = true
+		privKeys = append(privKeys, privKey)
+		pubKeys = append(pubKeys, pubKey)
+		statuses[i] = &pb.AddedKeystoreStatus{
+			Status: pb.AddedKeystoreStatus_IMPORTED,
+		}
+	}
+	if len(pubKeys) > 0 {
+		if err := km.ImportKeypairs(ctx, privKeys, pubKeys); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not import keystores: %v", err)
+		}
+	}
+	return &pb.AddKeystoresResponse{
+		Data: statuses,
+	}, nil
+}
+
+// DeleteKeystores deletes keys from an imported wallet and returns the slashing protection
+// history of the requested keys, so that they can safely be used by another validator client.
+// Keys which are not in the wallet but have slashing protection history are reported as not
+// active, and their history is returned as well.
+func (s *Server) DeleteKeystores(ctx context.Context, req *pb.DeleteKeystoresRequest) (*pb.DeleteKeystoresResponse, error) {
+	if s.wallet == nil {
+		return nil, status.Error(codes.FailedPrecondition, "No wallet initialized")
+	}
+	km, ok := s.keymanager.(*imported.Keymanager)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "Only imported wallets can delete keystores")
+	}
+	if len(req.Pubkeys) == 0 {
+		return &pb.DeleteKeystoresResponse{
+			Data: make([]*pb.DeletedKeystoreStatus, 0),
+		}, nil
+	}
+	pubKeys := make([][48]byte, len(req.Pubkeys))
+	for i, hexKey := range req.Pubkeys {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+		if err != nil || len(pubKey) != 48 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid public key %s", hexKey)
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	// Signing with the keys is locked across their deletion and the export of their history, so
+	// that the history includes anything signed with the keys before they were deleted.
+	unlock := client.LockSigning(pubKeys)
+	defer unlock()
+	existingKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating public keys: %v", err)
+	}
+	existing := make(map[[48]byte]bool, len(existingKeys))
+	for _, key := range existingKeys {
+		existing[key] = true
+	}
+	// Keys are deleted before their history is exported, so that they can't sign anything
+	// missing from the history.
+	statuses := make([]*pb.DeletedKeystoreStatus, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		if !existing[pubKey] {
+			continue
+		}
+		if err := km.DeleteAccounts(ctx, [][]byte{pubKey[:]}); err != nil {
+			statuses[i] = &pb.DeletedKeystoreStatus{
+				Status:  pb.DeletedKeystoreStatus_ERROR,
+				Message: err.Error(),
+			}
+			continue
+		}
+		delete(existing, pubKey)
+		statuses[i] = &pb.DeletedKeystoreStatus{Status: pb.DeletedKeystoreStatus_DELETED}
+	}
+	history, err := slashingProtectionFormat.ExportStandardProtectionJSON(ctx, s.valDB)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not export slashing protection history: %v", err)
+	}
+	historyByPubKey := make(map[string]*format.ProtectionData, len(history.Data))
+	for _, data := range history.Data {
+		historyByPubKey[data.Pubkey] = data
+	}
+	exported := make([]*format.ProtectionData, 0)
+	seen := make(map[[48]byte]bool, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		data, hasHistory := historyByPubKey[fmt.Sprintf("%#x", pubKey)]
+		if hasHistory && !seen[pubKey] {
+			exported = append(exported, data)
+		}
+		seen[pubKey] = true
+		if statuses[i] != nil {
+			continue
+		}
+		if hasHistory {
+			statuses[i] = &pb.DeletedKeystoreStatus{Status: pb.DeletedKeystoreStatus_NOT_ACTIVE}
+		} else {
+			statuses[i] = &pb.DeletedKeystoreStatus{Status: pb.DeletedKeystoreStatus_NOT_FOUND}
+		}
+	}
+	history.Data = exported
+	encodedHistory, err := json.Marshal(history)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not marshal slashing protection history: %v", err)
+	}
+	return &pb.DeleteKeystoresResponse{
+		Data:               statuses,
+		SlashingProtection: string(encodedHistory),
+	}, nil
+}
+
+// decryptKeystore decrypts a JSON-encoded EIP-2335 keystore, returning its private and public keys.
+func decryptKeystore(decryptor *keystorev4.Encryptor, encoded, password string) ([]byte, []byte, error) {
+	keystore := &keymanager.Keystore{}
+	if err := json.Unmarshal([]byte(encoded), keystore); err != nil {
+		return nil, nil, fmt.Errorf("not a valid EIP-2335 keystore JSON file: %v", err)
+	}
+	privKey, err := decryptor.Decrypt(keystore.Crypto, password)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid checksum") {
+			return nil, nil, fmt.Errorf("incorrect password for keystore %s", keystore.Pubkey)
+		}
+		return nil, nil, fmt.Errorf("could not decrypt keystore: %v", err)
+	}
+	secretKey, err := bls.SecretKeyFromBytes(privKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("not a valid BLS private key in keystore: %v", err)
+	}
+	return privKey, secretKey.PublicKey().Marshal(), nil
+}
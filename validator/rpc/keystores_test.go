@@ -0,0 +1,244 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// keystoresServer returns a server with an empty imported wallet and a validator database.
+func keystoresServer(t *testing.T) *Server {
+	imported.ResetCaches()
+	ctx := context.Background()
+	w, err := accounts.CreateWalletWithKeymanager(ctx, &accounts.CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      setupWalletDir(t),
+			KeymanagerKind: keymanager.Imported,
+			WalletPassword: strongPass,
+		},
+		SkipMnemonicConfirm: true,
+	})
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	return &Server{
+		keymanager:            km,
+		wallet:                w,
+		walletInitializedFeed: new(event.Feed),
+		valDB:                 dbtest.SetupDB(t, [][48]byte{}),
+	}
+}
+
+// encodedKeystore returns a JSON-encoded EIP-2335 keystore of a private key.
+func encodedKeystore(t *testing.T, privKey bls.SecretKey, password string) string {
+	encryptor := keystorev4.New()
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	cryptoFields, err := encryptor.Encrypt(privKey.Marshal(), password)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(&keymanager.Keystore{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Pubkey:  fmt.Sprintf("%x", privKey.PublicKey().Marshal()),
+		Name:    encryptor.Name(),
+	})
+	require.NoError(t, err)
+	return string(encoded)
+}
+
+func TestServer_ListKeystores(t *testing.T) {
+	ctx := context.Background()
+	_, err := (&Server{}).ListKeystores(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "No keymanager initialized", err)
+
+	s := keystoresServer(t)
+	res, err := s.ListKeystores(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Data))
+
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	km, ok := s.keymanager.(*imported.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, km.ImportKeypairs(ctx, [][]byte{privKey.Marshal()}, [][]byte{privKey.PublicKey().Marshal()}))
+	res, err = s.ListKeystores(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", privKey.PublicKey().Marshal()), res.Data[0].ValidatingPubkey)
+	assert.Equal(t, false, res.Data[0].Readonly)
+}
+
+func TestServer_AddKeystores(t *testing.T) {
+	ctx := context.Background()
+	s := keystoresServer(t)
+
+	_, err := s.AddKeystores(ctx, &pb.AddKeystoresRequest{})
+	assert.ErrorContains(t, "No keystores included for import", err)
+	_, err = s.AddKeystores(ctx, &pb.AddKeystoresRequest{Keystores: []string{"{}"}})
+	assert.ErrorContains(t, "Number of keystores 1 does not match number of passwords 0", err)
+
+	privKeys := make([]bls.SecretKey, 2)
+	for i := range privKeys {
+		privKeys[i], err = bls.RandKey()
+		require.NoError(t, err)
+	}
+	protection := &format.EIPSlashingProtectionFormat{
+		Data: []*format.ProtectionData{{
+			Pubkey:       fmt.Sprintf("%#x", privKeys[0].PublicKey().Marshal()),
+			SignedBlocks: []*format.SignedBlock{{Slot: "5"}},
+		}},
+	}
+	protection.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	protection.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", bytesutil.PadTo([]byte{1}, 32))
+	encodedProtection, err := json.Marshal(protection)
+	require.NoError(t, err)
+
+	res, err := s.AddKeystores(ctx, &pb.AddKeystoresRequest{
+		Keystores: []string{
+			encodedKeystore(t, privKeys[0], strongPass),
+			encodedKeystore(t, privKeys[1], "wrongpass"),
+			encodedKeystore(t, privKeys[0], strongPass),
+			"badjson",
+		},
+		Passwords:          []string{strongPass, strongPass, strongPass, strongPass},
+		SlashingProtection: string(encodedProtection),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(res.Data))
+	assert.Equal(t, pb.AddedKeystoreStatus_IMPORTED, res.Data[0].Status)
+	assert.Equal(t, pb.AddedKeystoreStatus_ERROR, res.Data[1].Status)
+	assert.Equal(t, true, strings.Contains(res.Data[1].Message, "incorrect password"))
+	assert.Equal(t, pb.AddedKeystoreStatus_DUPLICATE, res.Data[2].Status)
+	assert.Equal(t, pb.AddedKeystoreStatus_ERROR, res.Data[3].Status)
+	assert.Equal(t, true, strings.Contains(res.Data[3].Message, "not a valid EIP-2335 keystore"))
+
+	keys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	assert.DeepEqual(t, privKeys[0].PublicKey().Marshal(), keys[0][:])
+	_, exists, err := s.valDB.ProposalHistoryForSlot(ctx, keys[0], 5)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+}
+
+func TestServer_DeleteKeystores(t *testing.T) {
+	ctx := context.Background()
+	s := keystoresServer(t)
+
+	privKeys := make([]bls.SecretKey, 3)
+	pubKeys := make([][48]byte, 3)
+	for i := range privKeys {
+		var err error
+		privKeys[i], err = bls.RandKey()
+		require.NoError(t, err)
+		pubKeys[i] = bytesutil.ToBytes48(privKeys[i].PublicKey().Marshal())
+	}
+	km, ok := s.keymanager.(*imported.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, km.ImportKeypairs(ctx, [][]byte{privKeys[0].Marshal()}, [][]byte{pubKeys[0][:]}))
+	// The first key is in the wallet and the second one only has slashing protection history.
+	require.NoError(t, s.valDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 3, make([]byte, 32)))
+	require.NoError(t, s.valDB.SaveProposalHistoryForSlot(ctx, pubKeys[1], 4, make([]byte, 32)))
+
+	_, err := s.DeleteKeystores(ctx, &pb.DeleteKeystoresRequest{Pubkeys: []string{"0x01"}})
+	assert.ErrorContains(t, "Invalid public key 0x01", err)
+
+	res, err := s.DeleteKeystores(ctx, &pb.DeleteKeystoresRequest{
+		Pubkeys: []string{
+			fmt.Sprintf("%#x", pubKeys[0]),
+			fmt.Sprintf("%#x", pubKeys[1]),
+			fmt.Sprintf("%#x", pubKeys[2]),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Data))
+	assert.Equal(t, pb.DeletedKeystoreStatus_DELETED, res.Data[0].Status)
+	assert.Equal(t, pb.DeletedKeystoreStatus_NOT_ACTIVE, res.Data[1].Status)
+	assert.Equal(t, pb.DeletedKeystoreStatus_NOT_FOUND, res.Data[2].Status)
+
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	protection := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(res.SlashingProtection), protection))
+	require.Equal(t, 2, len(protection.Data))
+	exported := map[string]string{}
+	for _, data := range protection.Data {
+		require.Equal(t, 1, len(data.SignedBlocks))
+		exported[data.Pubkey] = data.SignedBlocks[0].Slot
+	}
+	assert.Equal(t, "3", exported[fmt.Sprintf("%#x", pubKeys[0])])
+	assert.Equal(t, "4", exported[fmt.Sprintf("%#x", pubKeys[1])])
+}
+
+func TestServer_DeleteKeystores_ConcurrentSigning(t *testing.T) {
+	ctx := context.Background()
+	s := keystoresServer(t)
+
+	privKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := bytesutil.ToBytes48(privKey.PublicKey().Marshal())
+	km, ok := s.keymanager.(*imported.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, km.ImportKeypairs(ctx, [][]byte{privKey.Marshal()}, [][]byte{pubKey[:]}))
+
+	// A block proposal is in progress with the key while it is deleted. The proposal only
+	// records its slashing protection history after signing.
+	signing := make(chan struct{})
+	signed := make(chan error, 1)
+	go func() {
+		unlock := client.LockSigning([][48]byte{pubKey})
+		defer unlock()
+		close(signing)
+		time.Sleep(100 * time.Millisecond)
+		signingRoot := make([]byte, 32)
+		if _, err := km.Sign(ctx, &pb.SignRequest{
+			PublicKey:   pubKey[:],
+			SigningRoot: signingRoot,
+			Object:      &pb.SignRequest_Slot{Slot: 7},
+		}); err != nil {
+			signed <- err
+			return
+		}
+		signed <- s.valDB.SaveProposalHistoryForSlot(ctx, pubKey, 7, signingRoot)
+	}()
+	<-signing
+
+	res, err := s.DeleteKeystores(ctx, &pb.DeleteKeystoresRequest{
+		Pubkeys: []string{fmt.Sprintf("%#x", pubKey)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, <-signed)
+	require.Equal(t, 1, len(res.Data))
+	assert.Equal(t, pb.DeletedKeystoreStatus_DELETED, res.Data[0].Status)
+
+	// The exported history includes the block signed before the deletion.
+	protection := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(res.SlashingProtection), protection))
+	require.Equal(t, 1, len(protection.Data))
+	require.Equal(t, 1, len(protection.Data[0].SignedBlocks))
+	assert.Equal(t, "7", protection.Data[0].SignedBlocks[0].Slot)
+}
@@ -76,7 +76,10 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 
 	// We validate and filter out public keys parsed from JSON to ensure we are
 	// not importing those which are slashable with respect to other data within the same JSON.
-	slashableProposerKeys := filterSlashablePubKeysFromBlocks(ctx, proposalHistoryByPubKey)
+	slashableProposerKeys, err := filterSlashablePubKeysFromBlocks(ctx, validatorDB, proposalHistoryByPubKey)
+	if err != nil {
+		return errors.Wrap(err, "could not filter slashable proposer public keys from JSON data")
+	}
 	slashableAttesterKeys, err := filterSlashablePubKeysFromAttestations(
 		ctx, validatorDB, attestingHistoryByPubKey,
 	)
@@ -85,15 +88,28 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 	}
 
 	slashablePublicKeys := make([][48]byte, 0, len(slashableAttesterKeys)+len(slashableProposerKeys))
+	seenSlashableKeys := make(map[[48]byte]bool)
 	for _, pubKey := range slashableProposerKeys {
 		delete(proposalHistoryByPubKey, pubKey)
-		slashablePublicKeys = append(slashablePublicKeys, pubKey)
+		if !seenSlashableKeys[pubKey] {
+			seenSlashableKeys[pubKey] = true
+			slashablePublicKeys = append(slashablePublicKeys, pubKey)
+		}
 	}
 	for _, pubKey := range slashableAttesterKeys {
 		delete(attestingHistoryByPubKey, pubKey)
-		slashablePublicKeys = append(slashablePublicKeys, pubKey)
+		if !seenSlashableKeys[pubKey] {
+			seenSlashableKeys[pubKey] = true
+			slashablePublicKeys = append(slashablePublicKeys, pubKey)
+		}
 	}
 
+	for _, pubKey := range slashablePublicKeys {
+		log.WithField(
+			"publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+		).Warn("Imported history of public key conflicts with itself or with the existing history, " +
+			"not importing it and refusing to sign with this key")
+	}
 	if err := validatorDB.SaveEIPImportBlacklistedPublicKeys(ctx, slashablePublicKeys); err != nil {
 		return errors.Wrap(err, "could not save slashable public keys to database")
 	}
@@ -235,7 +251,11 @@ func parseAttestationsForUniquePublicKeys(data []*format.ProtectionData) (map[[4
 	return signedAttestationsByPubKey, nil
 }
 
-func filterSlashablePubKeysFromBlocks(ctx context.Context, historyByPubKey map[[48]byte]kv.ProposalHistoryForPubkey) [][48]byte {
+func filterSlashablePubKeysFromBlocks(
+	ctx context.Context,
+	validatorDB db.Database,
+	historyByPubKey map[[48]byte]kv.ProposalHistoryForPubkey,
+) ([][48]byte, error) {
 	// Given signing roots are optional in the EIP standard, we behave as follows:
 	// For a given block:
 	//   If we have a previous block with the same slot in our history:
@@ -255,7 +275,22 @@ func filterSlashablePubKeysFromBlocks(ctx context.Context, historyByPubKey map[[
 			seenSigningRootsBySlot[blk.Slot] = blk.SigningRoot
 		}
 	}
-	return slashablePubKeys
+	// Then, we merge the proposals with our database, where a proposal at the slot of an existing
+	// proposal with a different or an empty signing root is slashable. Otherwise saving the
+	// imported proposal would overwrite the existing one.
+	for pubKey, proposals := range historyByPubKey {
+		for _, blk := range proposals.Proposals {
+			existingSigningRoot, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, blk.Slot)
+			if err != nil {
+				return nil, err
+			}
+			if exists && slashutil.SigningRootsDiffer(existingSigningRoot, bytesutil.ToBytes32(blk.SigningRoot)) {
+				slashablePubKeys = append(slashablePubKeys, pubKey)
+				break
+			}
+		}
+	}
+	return slashablePubKeys, nil
 }
 
 func filterSlashablePubKeysFromAttestations(
@@ -11,6 +11,7 @@ import (
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
@@ -167,6 +168,49 @@ func TestStore_ImportInterchangeData_OK(t *testing.T) {
 	}
 }
 
+func TestStore_ImportInterchangeData_MergesWithExistingHistory(t *testing.T) {
+	ctx := context.Background()
+	publicKeys, err := valtest.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+	existingRoot := [32]byte{1}
+	for _, pubKey := range publicKeys {
+		require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 5, existingRoot[:]))
+	}
+
+	// The history of the first key extends the existing history, while the history of the second
+	// key conflicts with it.
+	proposalHistory := []kv.ProposalHistoryForPubkey{
+		{Proposals: []kv.Proposal{
+			{Slot: 5, SigningRoot: existingRoot[:]},
+			{Slot: 6, SigningRoot: bytesutil.PadTo([]byte{2}, 32)},
+		}},
+		{Proposals: []kv.Proposal{
+			{Slot: 5, SigningRoot: bytesutil.PadTo([]byte{3}, 32)},
+			{Slot: 7, SigningRoot: bytesutil.PadTo([]byte{4}, 32)},
+		}},
+	}
+	standardProtectionFormat, err := valtest.MockSlashingProtectionJSON(publicKeys, nil, proposalHistory)
+	require.NoError(t, err)
+	blob, err := json.Marshal(standardProtectionFormat)
+	require.NoError(t, err)
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+
+	proposals, err := validatorDB.ProposalHistoryForPubKey(ctx, publicKeys[0])
+	require.NoError(t, err)
+	require.Equal(t, 2, len(proposals))
+	assert.Equal(t, types.Slot(6), proposals[1].Slot)
+
+	// The conflicting history is not imported, and its key is blacklisted.
+	proposals, err = validatorDB.ProposalHistoryForPubKey(ctx, publicKeys[1])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposals))
+	assert.DeepEqual(t, existingRoot[:], proposals[0].SigningRoot)
+	blacklisted, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{publicKeys[1]}, blacklisted)
+}
+
 func Test_validateMetadata(t *testing.T) {
 	goodRoot := [32]byte{1}
 	goodStr := make([]byte, hex.EncodedLen(len(goodRoot)))
@@ -904,7 +948,8 @@ func Test_filterSlashablePubKeysFromBlocks(t *testing.T) {
 				require.NoError(t, err)
 				historyByPubKey[pubKey] = *proposalHistory
 			}
-			slashablePubKeys := filterSlashablePubKeysFromBlocks(context.Background(), historyByPubKey)
+			slashablePubKeys, err := filterSlashablePubKeysFromBlocks(ctx, dbtest.SetupDB(t, nil), historyByPubKey)
+			require.NoError(t, err)
 			wantedPubKeys := make(map[[48]byte]bool)
 			for _, pk := range tt.expected {
 				wantedPubKeys[pk] = true